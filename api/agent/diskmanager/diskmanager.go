@@ -6,10 +6,12 @@ package diskmanager
 import (
 	"context"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/core/storage"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -53,6 +55,43 @@ func (st *State) SetMachineBlockDevices(ctx context.Context, devices []blockdevi
 	return results.OneError()
 }
 
+// SetMachineFilesystemUsage records the usage of the filesystems mounted
+// on the machine identified by the authenticated machine tag. An error
+// satisfying [errors.NotSupported] is returned if the controller does not
+// support recording filesystem usage.
+func (st *State) SetMachineFilesystemUsage(ctx context.Context, usage []storage.FilesystemUsage) error {
+	if st.facade.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("recording filesystem usage")
+	}
+	args := params.SetMachineFilesystemUsage{
+		MachineFilesystemUsage: []params.MachineFilesystemUsage{{
+			Machine: st.tag.String(),
+			Usage:   filesystemUsageToParams(usage),
+		}},
+	}
+	var results params.ErrorResults
+	err := st.facade.FacadeCall(ctx, "SetMachineFilesystemUsage", args, &results)
+	if err != nil {
+		return err
+	}
+	return results.OneError()
+}
+
+func filesystemUsageToParams(in []storage.FilesystemUsage) []params.FilesystemUsage {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]params.FilesystemUsage, len(in))
+	for i, u := range in {
+		out[i] = params.FilesystemUsage{
+			MountPoint: u.MountPoint,
+			SizeMiB:    u.SizeMiB,
+			UsedMiB:    u.UsedMiB,
+		}
+	}
+	return out
+}
+
 func blockDevicesToParams(in []blockdevice.BlockDevice) []params.BlockDevice {
 	if len(in) == 0 {
 		return nil
@@ -8,12 +8,14 @@ import (
 	"fmt"
 	stdtesting "testing"
 
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/agent/diskmanager"
 	"github.com/juju/juju/api/base/testing"
 	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/core/storage"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
@@ -174,3 +176,55 @@ func (s *DiskManagerSuite) TestSetMachineBlockDevicesResultCountInvalid(c *tc.C)
 		c.Check(err, tc.ErrorMatches, fmt.Sprintf("expected 1 result, got %d", n))
 	}
 }
+
+func (s *DiskManagerSuite) TestSetMachineFilesystemUsage(c *tc.C) {
+	usage := []storage.FilesystemUsage{{
+		MountPoint: "/srv/data",
+		SizeMiB:    1024,
+		UsedMiB:    512,
+	}}
+
+	var callCount int
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Check(objType, tc.Equals, "DiskManager")
+			c.Check(version, tc.Equals, 4)
+			c.Check(request, tc.Equals, "SetMachineFilesystemUsage")
+			c.Check(arg, tc.DeepEquals, params.SetMachineFilesystemUsage{
+				MachineFilesystemUsage: []params.MachineFilesystemUsage{{
+					Machine: "machine-123",
+					Usage: []params.FilesystemUsage{{
+						MountPoint: "/srv/data",
+						SizeMiB:    1024,
+						UsedMiB:    512,
+					}},
+				}},
+			})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				Results: []params.ErrorResult{{Error: nil}},
+			}
+			callCount++
+			return nil
+		}),
+		BestVersion: 4,
+	}
+
+	st := diskmanager.NewState(apiCaller, names.NewMachineTag("123"))
+	err := st.SetMachineFilesystemUsage(c.Context(), usage)
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(callCount, tc.Equals, 1)
+}
+
+func (s *DiskManagerSuite) TestSetMachineFilesystemUsageNotSupported(c *tc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Fatalf("unexpected api call %q", request)
+			return nil
+		}),
+		BestVersion: 3,
+	}
+
+	st := diskmanager.NewState(apiCaller, names.NewMachineTag("123"))
+	err := st.SetMachineFilesystemUsage(c.Context(), nil)
+	c.Check(err, tc.Satisfies, jujuerrors.IsNotSupported)
+}
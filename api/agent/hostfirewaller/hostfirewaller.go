@@ -0,0 +1,107 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hostfirewaller
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/base"
+	apiwatcher "github.com/juju/juju/api/watcher"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+const hostFirewallerFacade = "HostFirewaller"
+
+// Rules describes the inbound traffic a machine should accept, and the
+// backend used to enforce it.
+type Rules struct {
+	// Backend is the value of the host-firewall model config.
+	Backend string
+
+	// IngressRules are the port ranges opened by the exposed units on the
+	// machine, along with the CIDRs allowed to connect to them.
+	IngressRules firewall.IngressRules
+
+	// ModelCIDRs are the CIDRs of the model's subnets.
+	ModelCIDRs []string
+}
+
+// Client provides access to the HostFirewaller API facade.
+type Client struct {
+	facade base.FacadeCaller
+	tag    names.MachineTag
+}
+
+// NewClient creates a new client-side HostFirewaller facade for the
+// machine identified by tag.
+func NewClient(caller base.APICaller, tag names.MachineTag, options ...Option) *Client {
+	return &Client{
+		facade: base.NewFacadeCaller(caller, hostFirewallerFacade, options...),
+		tag:    tag,
+	}
+}
+
+// WatchHostFirewallRules returns a NotifyWatcher which fires when the
+// inbound traffic the machine should accept may have changed.
+func (c *Client) WatchHostFirewallRules(ctx context.Context) (watcher.NotifyWatcher, error) {
+	var results params.NotifyWatchResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: c.tag.String()}},
+	}
+	err := c.facade.FacadeCall(ctx, "WatchHostFirewallRules", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return newNotifyWatcher(c.facade.RawAPICaller(), result), nil
+}
+
+var newNotifyWatcher = apiwatcher.NewNotifyWatcher
+
+// HostFirewallRules returns the inbound traffic the machine should accept.
+func (c *Client) HostFirewallRules(ctx context.Context) (Rules, error) {
+	var results params.HostFirewallRulesResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: c.tag.String()}},
+	}
+	err := c.facade.FacadeCall(ctx, "HostFirewallRules", args, &results)
+	if err != nil {
+		return Rules{}, err
+	}
+	if len(results.Results) != 1 {
+		return Rules{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return Rules{}, result.Error
+	}
+
+	rules := Rules{
+		Backend:      result.Backend,
+		IngressRules: make(firewall.IngressRules, len(result.IngressRules)),
+		ModelCIDRs:   result.ModelCIDRs,
+	}
+	for i, rule := range result.IngressRules {
+		rules.IngressRules[i] = firewall.NewIngressRule(rule.PortRange.NetworkPortRange(), rule.SourceCIDRs...)
+	}
+	return rules, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hostfirewaller_test
+
+import (
+	stdtesting "testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/agent/hostfirewaller"
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/core/watcher"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+func TestHostFirewallerSuite(t *stdtesting.T) {
+	tc.Run(t, &HostFirewallerSuite{})
+}
+
+type HostFirewallerSuite struct {
+	coretesting.BaseSuite
+}
+
+func (s *HostFirewallerSuite) TestHostFirewallRules(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "HostFirewaller")
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "HostFirewallRules")
+		c.Check(arg, tc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "machine-0"}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.HostFirewallRulesResults{})
+		*(result.(*params.HostFirewallRulesResults)) = params.HostFirewallRulesResults{
+			Results: []params.HostFirewallRulesResult{{
+				Backend: "nftables",
+				IngressRules: []params.IngressRule{{
+					PortRange:   params.PortRange{FromPort: 80, ToPort: 80, Protocol: "tcp"},
+					SourceCIDRs: []string{"0.0.0.0/0"},
+				}},
+				ModelCIDRs: []string{"10.0.0.0/24"},
+			}},
+		}
+		return nil
+	})
+
+	client := hostfirewaller.NewClient(apiCaller, names.NewMachineTag("0"))
+	rules, err := client.HostFirewallRules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rules, tc.DeepEquals, hostfirewaller.Rules{
+		Backend: "nftables",
+		IngressRules: firewall.IngressRules{
+			firewall.NewIngressRule(network.MustParsePortRange("80/tcp"), "0.0.0.0/0"),
+		},
+		ModelCIDRs: []string{"10.0.0.0/24"},
+	})
+}
+
+func (s *HostFirewallerSuite) TestHostFirewallRulesError(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		*(result.(*params.HostFirewallRulesResults)) = params.HostFirewallRulesResults{
+			Results: []params.HostFirewallRulesResult{{
+				Error: &params.Error{Message: "boom"},
+			}},
+		}
+		return nil
+	})
+
+	client := hostfirewaller.NewClient(apiCaller, names.NewMachineTag("0"))
+	_, err := client.HostFirewallRules(c.Context())
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *HostFirewallerSuite) TestWatchHostFirewallRules(c *tc.C) {
+	res := params.NotifyWatchResult{NotifyWatcherId: "4242"}
+	fake := &struct {
+		watcher.NotifyWatcher
+	}{}
+	s.PatchValue(hostfirewaller.NewNotifyWatcher, func(caller base.APICaller, result params.NotifyWatchResult) watcher.NotifyWatcher {
+		c.Assert(result, tc.DeepEquals, res)
+		return fake
+	})
+
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "HostFirewaller")
+		c.Check(request, tc.Equals, "WatchHostFirewallRules")
+		c.Check(arg, tc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "machine-0"}},
+		})
+		*(result.(*params.NotifyWatchResults)) = params.NotifyWatchResults{
+			Results: []params.NotifyWatchResult{res},
+		}
+		return nil
+	})
+
+	client := hostfirewaller.NewClient(apiCaller, names.NewMachineTag("0"))
+	w, err := client.WatchHostFirewallRules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(w, tc.Equals, fake)
+}
@@ -367,6 +367,27 @@ func (st *Client) FilesystemAttachmentParams(ctx context.Context, ids []params.M
 	return results.Results, nil
 }
 
+// FilesystemEncryptionKeys returns the encryption keys for the filesystems
+// of the specified filesystem attachments. An empty key is returned for
+// filesystems that are not encrypted. An error satisfying
+// [errors.NotSupported] is returned if the controller does not support
+// filesystem encryption.
+func (st *Client) FilesystemEncryptionKeys(ctx context.Context, ids []params.MachineStorageId) ([]params.StringResult, error) {
+	if st.facade.BestAPIVersion() < 8 {
+		return nil, errors.NotSupportedf("filesystem encryption")
+	}
+	args := params.MachineStorageIds{ids}
+	var results params.StringResults
+	err := st.facade.FacadeCall(ctx, "FilesystemEncryptionKeys", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(ids) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(ids), len(results.Results))
+	}
+	return results.Results, nil
+}
+
 // SetVolumeInfo records the details of newly provisioned volumes.
 func (st *Client) SetVolumeInfo(ctx context.Context, volumes []params.Volume) ([]params.ErrorResult, error) {
 	args := params.Volumes{Volumes: volumes}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	stdtesting "testing"
 
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
@@ -645,6 +646,54 @@ func (s *provisionerSuite) TestFilesystemAttachmentParams(c *tc.C) {
 	c.Assert(filesystemParams, tc.DeepEquals, paramsResults)
 }
 
+func (s *provisionerSuite) TestFilesystemEncryptionKeys(c *tc.C) {
+	var callCount int
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Check(objType, tc.Equals, "StorageProvisioner")
+			c.Check(version, tc.Equals, 8)
+			c.Check(id, tc.Equals, "")
+			c.Check(request, tc.Equals, "FilesystemEncryptionKeys")
+			c.Check(arg, tc.DeepEquals, params.MachineStorageIds{
+				Ids: []params.MachineStorageId{{
+					MachineTag: "machine-100", AttachmentTag: "filesystem-100",
+				}},
+			})
+			c.Assert(result, tc.FitsTypeOf, &params.StringResults{})
+			*(result.(*params.StringResults)) = params.StringResults{
+				Results: []params.StringResult{{Result: "sekrit"}},
+			}
+			callCount++
+			return nil
+		}),
+		BestVersion: 8,
+	}
+
+	st, err := storageprovisioner.NewClient(apiCaller)
+	c.Assert(err, tc.ErrorIsNil)
+	keys, err := st.FilesystemEncryptionKeys(c.Context(), []params.MachineStorageId{{
+		MachineTag: "machine-100", AttachmentTag: "filesystem-100",
+	}})
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(callCount, tc.Equals, 1)
+	c.Assert(keys, tc.DeepEquals, []params.StringResult{{Result: "sekrit"}})
+}
+
+func (s *provisionerSuite) TestFilesystemEncryptionKeysNotSupported(c *tc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Fatalf("unexpected api call %q", request)
+			return nil
+		}),
+		BestVersion: 7,
+	}
+
+	st, err := storageprovisioner.NewClient(apiCaller)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.FilesystemEncryptionKeys(c.Context(), nil)
+	c.Check(err, tc.Satisfies, jujuerrors.IsNotSupported)
+}
+
 func (s *provisionerSuite) TestSetVolumeInfo(c *tc.C) {
 	var callCount int
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
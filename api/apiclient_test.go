@@ -1395,36 +1395,40 @@ func (s *apiclientSuite) TestWatchDebugLogParamsEncoded(c *tc.C) {
 	s.PatchValue(&api.WebsocketDial, catcher.RecordLocation)
 
 	params := common.DebugLogParams{
-		IncludeEntity: []string{"a", "b"},
-		IncludeModule: []string{"c", "d"},
-		IncludeLabels: map[string]string{"e": "f"},
-		ExcludeEntity: []string{"g", "h"},
-		ExcludeModule: []string{"i", "j"},
-		ExcludeLabels: map[string]string{"k": "l"},
-		Limit:         100,
-		Backlog:       200,
-		Level:         loggo.ERROR,
-		Replay:        true,
-		NoTail:        true,
-		Firehose:      true,
-		StartTime:     time.Date(2016, 11, 30, 11, 48, 0, 100, time.UTC),
+		IncludeEntity:  []string{"a", "b"},
+		IncludeModule:  []string{"c", "d"},
+		IncludeLabels:  map[string]string{"e": "f"},
+		ExcludeEntity:  []string{"g", "h"},
+		ExcludeModule:  []string{"i", "j"},
+		ExcludeLabels:  map[string]string{"k": "l"},
+		IncludeMessage: []string{"m"},
+		ExcludeMessage: []string{"n"},
+		Limit:          100,
+		Backlog:        200,
+		Level:          loggo.ERROR,
+		Replay:         true,
+		NoTail:         true,
+		Firehose:       true,
+		StartTime:      time.Date(2016, 11, 30, 11, 48, 0, 100, time.UTC),
 	}
 
 	urlValues := url.Values{
-		"version":       []string{"2"},
-		"includeEntity": params.IncludeEntity,
-		"includeModule": params.IncludeModule,
-		"includeLabels": []string{"e=f"},
-		"excludeEntity": params.ExcludeEntity,
-		"excludeModule": params.ExcludeModule,
-		"excludeLabels": []string{"k=l"},
-		"maxLines":      {"100"},
-		"backlog":       {"200"},
-		"level":         {"ERROR"},
-		"replay":        {"true"},
-		"noTail":        {"true"},
-		"firehose":      {"true"},
-		"startTime":     {"2016-11-30T11:48:00.0000001Z"},
+		"version":        []string{"2"},
+		"includeEntity":  params.IncludeEntity,
+		"includeModule":  params.IncludeModule,
+		"includeLabels":  []string{"e=f"},
+		"excludeEntity":  params.ExcludeEntity,
+		"excludeModule":  params.ExcludeModule,
+		"excludeLabels":  []string{"k=l"},
+		"includeMessage": []string{"m"},
+		"excludeMessage": []string{"n"},
+		"maxLines":       {"100"},
+		"backlog":        {"200"},
+		"level":          {"ERROR"},
+		"replay":         {"true"},
+		"noTail":         {"true"},
+		"firehose":       {"true"},
+		"startTime":      {"2016-11-30T11:48:00.0000001Z"},
 	}
 
 	info := s.APIInfo()
@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/core/crossmodel"
 	"github.com/juju/juju/core/devices"
 	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/core/storage"
 	"github.com/juju/juju/domain/deployment/charm"
 	internallogger "github.com/juju/juju/internal/logger"
@@ -712,6 +713,56 @@ func (c *Client) Unexpose(ctx context.Context, application string, endpoints []s
 	return c.facade.FacadeCall(ctx, "Unexpose", args, nil)
 }
 
+// GetEgressRules returns the egress rules for the named application. An
+// empty list means that no rules have been set for the application.
+func (c *Client) GetEgressRules(ctx context.Context, application string) (firewall.EgressRules, error) {
+	if c.BestAPIVersion() < 23 {
+		return nil, errors.NotSupportedf("egress rules on this version of Juju")
+	}
+	args := params.Entities{Entities: []params.Entity{{Tag: names.NewApplicationTag(application).String()}}}
+	var results params.ApplicationEgressRulesResults
+	if err := c.facade.FacadeCall(ctx, "GetApplicationEgressRules", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if resultsLen := len(results.Results); resultsLen != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", resultsLen)
+	}
+	if err := results.Results[0].Error; err != nil {
+		return nil, apiservererrors.RestoreError(err)
+	}
+
+	rules := make(firewall.EgressRules, len(results.Results[0].Rules))
+	for i, rule := range results.Results[0].Rules {
+		rules[i] = firewall.NewEgressRule(rule.PortRange.NetworkPortRange(), rule.DestinationCIDRs...)
+	}
+	return rules, nil
+}
+
+// SetEgressRules replaces the egress rules for the named application.
+// Passing an empty list removes all the rules for the application.
+func (c *Client) SetEgressRules(ctx context.Context, application string, rules firewall.EgressRules) error {
+	if c.BestAPIVersion() < 23 {
+		return errors.NotSupportedf("egress rules on this version of Juju")
+	}
+	arg := params.ApplicationEgressRules{
+		ApplicationTag: names.NewApplicationTag(application).String(),
+		Rules:          make([]params.EgressRule, len(rules)),
+	}
+	for i, rule := range rules {
+		arg.Rules[i] = params.EgressRule{
+			PortRange:        params.FromNetworkPortRange(rule.PortRange),
+			DestinationCIDRs: rule.DestinationCIDRs.SortedValues(),
+		}
+	}
+
+	var results params.ErrorResults
+	args := params.SetApplicationEgressRulesArgs{Args: []params.ApplicationEgressRules{arg}}
+	if err := c.facade.FacadeCall(ctx, "SetApplicationEgressRules", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // Get returns the configuration for the named application.
 func (c *Client) Get(ctx context.Context, application string) (*params.ApplicationGetResults, error) {
 	var results params.ApplicationGetResults
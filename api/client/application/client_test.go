@@ -21,6 +21,8 @@ import (
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/crossmodel"
 	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/core/relation"
 	"github.com/juju/juju/core/storage"
 	"github.com/juju/juju/domain/deployment/charm"
@@ -1668,6 +1670,86 @@ func (s *applicationSuite) TestGetApplicationStorageServerError(c *tc.C) {
 	c.Assert(info.Error, tc.ErrorIs, errors.NotFound)
 }
 
+func (s *applicationSuite) TestGetEgressRules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "application-mysql"}}}
+	result := new(params.ApplicationEgressRulesResults)
+	results := params.ApplicationEgressRulesResults{
+		Results: []params.ApplicationEgressRulesResult{{
+			Rules: []params.EgressRule{{
+				PortRange:        params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+				DestinationCIDRs: []string{"10.0.0.0/8"},
+			}},
+		}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GetApplicationEgressRules", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+
+	rules, err := client.GetEgressRules(c.Context(), "mysql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	})
+}
+
+func (s *applicationSuite) TestSetEgressRules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	args := params.SetApplicationEgressRulesArgs{
+		Args: []params.ApplicationEgressRules{{
+			ApplicationTag: "application-mysql",
+			Rules: []params.EgressRule{{
+				PortRange:        params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+				DestinationCIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"},
+			}},
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{
+			Error: &params.Error{Message: "boom", Code: params.CodeNotFound},
+		}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SetApplicationEgressRules", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+
+	err := client.SetEgressRules(c.Context(), "mysql", firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "192.168.0.0/16", "10.0.0.0/8"),
+	})
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *applicationSuite) TestEgressRulesAPIVersionNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(22).AnyTimes()
+
+	client := application.NewClientFromCaller(mocks.NewMockFacadeCaller(ctrl))
+	client.ClientFacade = mockClientFacade
+
+	_, err := client.GetEgressRules(c.Context(), "mysql")
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+	err = client.SetEgressRules(c.Context(), "mysql", nil)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
 func (s *applicationSuite) TestUpdateApplicationStorageSuccessful(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -0,0 +1,26 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// List returns the status of the scheduled backups kept by the controller,
+// oldest first.
+func (c *Client) List(ctx context.Context) ([]params.BackupsIndexEntry, error) {
+	if c.facade.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("listing backups")
+	}
+
+	var result params.BackupsListResult
+	if err := c.facade.FacadeCall(ctx, "List", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.List, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+type listSuite struct {
+	baseSuite
+}
+
+func TestListSuite(t *testing.T) {
+	tc.Run(t, &listSuite{})
+}
+
+func (s *listSuite) TestList(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := params.BackupsListResult{
+		List: []params.BackupsIndexEntry{{
+			Filename: "juju-backup-20260101-000000.tar.gz",
+			Started:  started,
+			Finished: started.Add(time.Minute),
+			Size:     42,
+			Checksum: "checksum",
+			Status:   "succeeded",
+		}},
+	}
+
+	s.facade.EXPECT().BestAPIVersion().Return(4)
+	s.facade.EXPECT().FacadeCall(gomock.Any(), "List", nil, gomock.Any()).SetArg(3, result)
+
+	client := s.newClient()
+	got, err := client.List(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, result.List)
+}
+
+func (s *listSuite) TestListNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.facade.EXPECT().BestAPIVersion().Return(3)
+
+	client := s.newClient()
+	_, err := client.List(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
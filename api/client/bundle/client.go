@@ -64,3 +64,40 @@ func (c *Client) ExportBundle(ctx context.Context, includeDefaults bool) (string
 
 	return result.Result, nil
 }
+
+// SetDesiredBundle sets the bundle the model is expected to match. The
+// controller periodically compares the model with the bundle and reports
+// any differences.
+func (c *Client) SetDesiredBundle(ctx context.Context, bundleDataYAML string) error {
+	if c.facade.BestAPIVersion() < 9 {
+		return errors.NotSupportedf("desired bundles on this version of Juju")
+	}
+	arg := params.SetDesiredBundleParams{
+		BundleDataYAML: bundleDataYAML,
+	}
+	return errors.Trace(c.facade.FacadeCall(ctx, "SetDesiredBundle", arg, nil))
+}
+
+// GetDesiredBundle returns the desired bundle of the model and the
+// differences between the model and the bundle found by the last check.
+func (c *Client) GetDesiredBundle(ctx context.Context) (params.DesiredBundleResult, error) {
+	if c.facade.BestAPIVersion() < 9 {
+		return params.DesiredBundleResult{}, errors.NotSupportedf("desired bundles on this version of Juju")
+	}
+	var result params.DesiredBundleResult
+	if err := c.facade.FacadeCall(ctx, "GetDesiredBundle", nil, &result); err != nil {
+		return params.DesiredBundleResult{}, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return params.DesiredBundleResult{}, errors.Trace(result.Error)
+	}
+	return result, nil
+}
+
+// RemoveDesiredBundle removes the desired bundle of the model.
+func (c *Client) RemoveDesiredBundle(ctx context.Context) error {
+	if c.facade.BestAPIVersion() < 9 {
+		return errors.NotSupportedf("desired bundles on this version of Juju")
+	}
+	return errors.Trace(c.facade.FacadeCall(ctx, "RemoveDesiredBundle", nil, nil))
+}
@@ -6,6 +6,7 @@ package bundle_test
 import (
 	"testing"
 
+	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
@@ -142,3 +143,75 @@ func (s *bundleMockSuite) TestExportBundleLatest(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(result, tc.DeepEquals, bundleStr)
 }
+
+func (s *bundleMockSuite) TestSetDesiredBundle(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.SetDesiredBundleParams{
+		BundleDataYAML: "applications: {}",
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(9)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SetDesiredBundle", args, nil).Return(nil)
+	client := bundle.NewClientFromCaller(mockFacadeCaller)
+	err := client.SetDesiredBundle(c.Context(), "applications: {}")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *bundleMockSuite) TestSetDesiredBundleNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	client := bundle.NewClientFromCaller(mockFacadeCaller)
+	err := client.SetDesiredBundle(c.Context(), "applications: {}")
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *bundleMockSuite) TestGetDesiredBundle(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.DesiredBundleResult)
+	results := params.DesiredBundleResult{
+		BundleDataYAML: "applications: {}",
+		Drift:          "applications: {}\n",
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(9)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GetDesiredBundle", nil, res).SetArg(3, results).Return(nil)
+	client := bundle.NewClientFromCaller(mockFacadeCaller)
+	result, err := client.GetDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, results)
+}
+
+func (s *bundleMockSuite) TestGetDesiredBundleNotFound(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.DesiredBundleResult)
+	results := params.DesiredBundleResult{
+		Error: &params.Error{Code: params.CodeNotFound, Message: "desired bundle not found"},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(9)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GetDesiredBundle", nil, res).SetArg(3, results).Return(nil)
+	client := bundle.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.GetDesiredBundle(c.Context())
+	c.Assert(err, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *bundleMockSuite) TestRemoveDesiredBundle(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(9)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveDesiredBundle", nil, nil).Return(nil)
+	client := bundle.NewClientFromCaller(mockFacadeCaller)
+	err := client.RemoveDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
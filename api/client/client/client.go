@@ -9,15 +9,18 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/common"
+	apiwatcher "github.com/juju/juju/api/watcher"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/internal/tools"
 	"github.com/juju/juju/rpc/params"
 )
@@ -71,6 +74,45 @@ func (c *Client) Status(ctx context.Context, args *StatusArgs) (*params.FullStat
 	return &result, nil
 }
 
+// WatchStatus returns a watcher that notifies when the status of an
+// application, unit, machine or relation in the model changes.
+func (c *Client) WatchStatus(ctx context.Context) (watcher.NotifyWatcher, error) {
+	if c.BestAPIVersion() < 9 {
+		return nil, errors.NotSupportedf("watching status on this version of Juju")
+	}
+	var result params.NotifyWatchResult
+	if err := c.facade.FacadeCall(ctx, "WatchStatus", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return apiwatcher.NewNotifyWatcher(c.facade.RawAPICaller(), result), nil
+}
+
+// CheckStatusConditions evaluates conditions, each of the form
+// <field><operator><value>, on the status of the named entity of the given
+// kind on the controller, and returns a description of each condition that
+// is not met.
+func (c *Client) CheckStatusConditions(ctx context.Context, kind, name string, conditions []string) ([]string, error) {
+	if c.BestAPIVersion() < 9 {
+		return nil, errors.NotSupportedf("checking status conditions on this version of Juju")
+	}
+	var result params.StatusConditionsResult
+	args := params.StatusConditionsArgs{
+		Kind:       kind,
+		Name:       name,
+		Conditions: conditions,
+	}
+	if err := c.facade.FacadeCall(ctx, "CheckStatusConditions", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Unmet, nil
+}
+
 // StatusHistory retrieves the last <size> results of
 // <kind:combined|agent|workload|machine|machineinstance|container|containerinstance> status
 // for <name> unit
@@ -117,6 +159,57 @@ func (c *Client) StatusHistory(ctx context.Context, kind status.HistoryKind, tag
 	return history, nil
 }
 
+// StatusHistoryEntry is a single status history record for an entity in the
+// model.
+type StatusHistoryEntry struct {
+	Kind   status.HistoryKind
+	ID     string
+	Status status.DetailedStatus
+}
+
+// ExportStatusHistory returns the status history of every entity in the
+// model recorded by the controller the client is connected to, optionally
+// bounded by the given times. The history is fetched a page at a time.
+func (c *Client) ExportStatusHistory(ctx context.Context, from, to *time.Time) ([]StatusHistoryEntry, error) {
+	if c.BestAPIVersion() < 9 {
+		return nil, errors.NotSupportedf("exporting status history on this version of Juju")
+	}
+	var entries []StatusHistoryEntry
+	args := params.ExportStatusHistoryArgs{From: from, To: to}
+	for {
+		var result params.ExportStatusHistoryResult
+		if err := c.facade.FacadeCall(ctx, "ExportStatusHistory", args, &result); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		entries = append(entries, statusHistoryEntries(result.Entries)...)
+		if result.NextCursor == 0 {
+			return entries, nil
+		}
+		args.Cursor = result.NextCursor
+	}
+}
+
+func statusHistoryEntries(in []params.StatusHistoryEntry) []StatusHistoryEntry {
+	entries := make([]StatusHistoryEntry, len(in))
+	for i, entry := range in {
+		entries[i] = StatusHistoryEntry{
+			Kind: status.HistoryKind(entry.Kind),
+			ID:   entry.ID,
+			Status: status.DetailedStatus{
+				Status: status.Status(entry.Status.Status),
+				Info:   entry.Status.Info,
+				Data:   entry.Status.Data,
+				Since:  entry.Status.Since,
+				Kind:   status.HistoryKind(entry.Status.Kind),
+			},
+		}
+	}
+	return entries
+}
+
 // Close closes the Client's underlying State connection
 // Client is unique among the api.State facades in closing its own State
 // connection, but it is conventional to use a Client object without any access
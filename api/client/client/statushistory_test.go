@@ -0,0 +1,121 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/core/status"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type exportStatusHistorySuite struct{}
+
+func TestExportStatusHistorySuite(t *testing.T) {
+	tc.Run(t, &exportStatusHistorySuite{})
+}
+
+func (s *exportStatusHistorySuite) TestExportStatusHistory(c *tc.C) {
+	now := time.Now()
+	from := now.Add(-time.Hour)
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Check(objType, tc.Equals, "Client")
+			c.Check(version, tc.Equals, 9)
+			c.Check(request, tc.Equals, "ExportStatusHistory")
+			c.Check(arg, tc.DeepEquals, params.ExportStatusHistoryArgs{From: &from})
+			c.Assert(result, tc.FitsTypeOf, &params.ExportStatusHistoryResult{})
+			*(result.(*params.ExportStatusHistoryResult)) = params.ExportStatusHistoryResult{
+				Entries: []params.StatusHistoryEntry{{
+					Kind: "workload",
+					ID:   "foo/0",
+					Status: params.DetailedStatus{
+						Kind:   "workload",
+						Status: "active",
+						Info:   "ready",
+						Since:  &now,
+					},
+				}},
+			}
+			return nil
+		}),
+		BestVersion: 9,
+	}
+	entries, err := client.NewClient(apiCaller, loggertesting.WrapCheckLog(c)).ExportStatusHistory(c.Context(), &from, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.DeepEquals, []client.StatusHistoryEntry{{
+		Kind: status.KindWorkload,
+		ID:   "foo/0",
+		Status: status.DetailedStatus{
+			Kind:   status.KindWorkload,
+			Status: status.Active,
+			Info:   "ready",
+			Since:  &now,
+		},
+	}})
+}
+
+func (s *exportStatusHistorySuite) TestExportStatusHistoryPaged(c *tc.C) {
+	var cursors []int
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			args := arg.(params.ExportStatusHistoryArgs)
+			cursors = append(cursors, args.Cursor)
+			next := 0
+			if args.Cursor == 0 {
+				next = 1
+			}
+			*(result.(*params.ExportStatusHistoryResult)) = params.ExportStatusHistoryResult{
+				Entries: []params.StatusHistoryEntry{{
+					Kind: "application",
+					ID:   "foo",
+					Status: params.DetailedStatus{
+						Kind:   "application",
+						Status: "active",
+					},
+				}},
+				NextCursor: next,
+			}
+			return nil
+		}),
+		BestVersion: 9,
+	}
+	entries, err := client.NewClient(apiCaller, loggertesting.WrapCheckLog(c)).ExportStatusHistory(c.Context(), nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 2)
+	c.Check(cursors, tc.DeepEquals, []int{0, 1})
+}
+
+func (s *exportStatusHistorySuite) TestExportStatusHistoryError(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			*(result.(*params.ExportStatusHistoryResult)) = params.ExportStatusHistoryResult{
+				Error: &params.Error{Message: "boom"},
+			}
+			return nil
+		}),
+		BestVersion: 9,
+	}
+	_, err := client.NewClient(apiCaller, loggertesting.WrapCheckLog(c)).ExportStatusHistory(c.Context(), nil, nil)
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *exportStatusHistorySuite) TestExportStatusHistoryNotSupported(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Fatalf("unexpected call to %s.%s", objType, request)
+			return nil
+		}),
+		BestVersion: 8,
+	}
+	_, err := client.NewClient(apiCaller, loggertesting.WrapCheckLog(c)).ExportStatusHistory(c.Context(), nil, nil)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package client_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/client/client"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type watchStatusSuite struct{}
+
+func TestWatchStatusSuite(t *testing.T) {
+	tc.Run(t, &watchStatusSuite{})
+}
+
+func (s *watchStatusSuite) TestWatchStatus(c *tc.C) {
+	var calls []string
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			calls = append(calls, objType+"."+request)
+			switch objType {
+			case "Client":
+				c.Check(version, tc.Equals, 9)
+				c.Check(request, tc.Equals, "WatchStatus")
+				c.Assert(result, tc.FitsTypeOf, &params.NotifyWatchResult{})
+				*(result.(*params.NotifyWatchResult)) = params.NotifyWatchResult{NotifyWatcherId: "42"}
+			case "NotifyWatcher":
+				c.Check(id, tc.Equals, "42")
+			}
+			return nil
+		}),
+		BestVersion: 9,
+	}
+	w, err := client.NewClient(apiCaller, loggertesting.WrapCheckLog(c)).WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	w.Kill()
+	c.Assert(w.Wait(), tc.ErrorIsNil)
+	c.Check(calls[0], tc.Equals, "Client.WatchStatus")
+}
+
+func (s *watchStatusSuite) TestWatchStatusError(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			*(result.(*params.NotifyWatchResult)) = params.NotifyWatchResult{
+				Error: &params.Error{Message: "boom"},
+			}
+			return nil
+		}),
+		BestVersion: 9,
+	}
+	_, err := client.NewClient(apiCaller, loggertesting.WrapCheckLog(c)).WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *watchStatusSuite) TestWatchStatusNotSupported(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Fatalf("unexpected call to %s.%s", objType, request)
+			return nil
+		}),
+		BestVersion: 8,
+	}
+	_, err := client.NewClient(apiCaller, loggertesting.WrapCheckLog(c)).WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *watchStatusSuite) TestCheckStatusConditions(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Check(objType, tc.Equals, "Client")
+			c.Check(version, tc.Equals, 9)
+			c.Check(request, tc.Equals, "CheckStatusConditions")
+			c.Check(arg, tc.DeepEquals, params.StatusConditionsArgs{
+				Kind:       "application",
+				Name:       "mysql",
+				Conditions: []string{"units>=3"},
+			})
+			c.Assert(result, tc.FitsTypeOf, &params.StatusConditionsResult{})
+			*(result.(*params.StatusConditionsResult)) = params.StatusConditionsResult{
+				Unmet: []string{"units>=3 (units=1)"},
+			}
+			return nil
+		}),
+		BestVersion: 9,
+	}
+	unmet, err := client.NewClient(apiCaller, loggertesting.WrapCheckLog(c)).CheckStatusConditions(
+		c.Context(), "application", "mysql", []string{"units>=3"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unmet, tc.DeepEquals, []string{"units>=3 (units=1)"})
+}
+
+func (s *watchStatusSuite) TestCheckStatusConditionsError(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			*(result.(*params.StatusConditionsResult)) = params.StatusConditionsResult{
+				Error: &params.Error{Message: "boom"},
+			}
+			return nil
+		}),
+		BestVersion: 9,
+	}
+	_, err := client.NewClient(apiCaller, loggertesting.WrapCheckLog(c)).CheckStatusConditions(c.Context(), "model", "test", nil)
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *watchStatusSuite) TestCheckStatusConditionsNotSupported(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Fatalf("unexpected call to %s.%s", objType, request)
+			return nil
+		}),
+		BestVersion: 8,
+	}
+	_, err := client.NewClient(apiCaller, loggertesting.WrapCheckLog(c)).CheckStatusConditions(c.Context(), "model", "test", nil)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
@@ -167,3 +167,19 @@ func (api *Client) RemoveSecretBackend(ctx context.Context, name string, force b
 	}
 	return params.TranslateWellKnownError(results.OneError())
 }
+
+// RotateSecretEncryptionKey rewraps the secret data key of each model with
+// the active secret encryption master key, returning the number of data
+// keys rewrapped.
+func (api *Client) RotateSecretEncryptionKey(ctx context.Context) (int, error) {
+	if api.BestAPIVersion() < 2 {
+		return 0, errors.NotSupportedf("secret encryption key rotation on this juju version")
+	}
+
+	var result params.RotateSecretEncryptionKeyResult
+	err := api.facade.FacadeCall(ctx, "RotateSecretEncryptionKey", nil, &result)
+	if err != nil {
+		return 0, errors.Trace(params.TranslateWellKnownError(err))
+	}
+	return result.Rewrapped, nil
+}
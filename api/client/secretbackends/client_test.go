@@ -7,6 +7,7 @@ import (
 	stdtesting "testing"
 	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/base/testing"
@@ -175,3 +176,36 @@ func (s *SecretBackendsSuite) TestUpdateSecretsBackend(c *tc.C) {
 	err := client.UpdateSecretBackend(c.Context(), backend, true)
 	c.Assert(err, tc.ErrorMatches, "FAIL")
 }
+
+func (s *SecretBackendsSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Check(objType, tc.Equals, "SecretBackends")
+			c.Check(version, tc.Equals, 2)
+			c.Check(id, tc.Equals, "")
+			c.Check(request, tc.Equals, "RotateSecretEncryptionKey")
+			c.Check(arg, tc.IsNil)
+			c.Assert(result, tc.FitsTypeOf, &params.RotateSecretEncryptionKeyResult{})
+			*(result.(*params.RotateSecretEncryptionKeyResult)) = params.RotateSecretEncryptionKeyResult{
+				Rewrapped: 2,
+			}
+			return nil
+		}), BestVersion: 2,
+	}
+	client := secretbackends.NewClient(apiCaller)
+	rewrapped, err := client.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rewrapped, tc.Equals, 2)
+}
+
+func (s *SecretBackendsSuite) TestRotateSecretEncryptionKeyNotSupported(c *tc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Fatalf("unexpected call to %s", request)
+			return nil
+		}), BestVersion: 1,
+	}
+	client := secretbackends.NewClient(apiCaller)
+	_, err := client.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
@@ -5,6 +5,7 @@ package secrets
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -143,7 +144,6 @@ func (c *Client) CreateSecret(ctx context.Context, name, description string, dat
 	if c.BestAPIVersion() < 2 {
 		return "", errors.NotSupportedf("user secrets")
 	}
-	var results params.StringResults
 	arg := params.CreateSecretArg{
 		UpsertSecretArg: params.UpsertSecretArg{
 			Content: params.SecretContentParams{Data: data},
@@ -155,7 +155,29 @@ func (c *Client) CreateSecret(ctx context.Context, name, description string, dat
 	if description != "" {
 		arg.Description = &description
 	}
+	return c.createSecret(ctx, arg)
+}
 
+// CreateExternalSecret creates a user secret whose content is synced from
+// the specified secret backend path.
+func (c *Client) CreateExternalSecret(ctx context.Context, name, description string, source secrets.ExternalSource) (string, error) {
+	if c.BestAPIVersion() < 3 {
+		return "", errors.NotSupportedf("external secret sources")
+	}
+	arg := params.CreateSecretArg{
+		Source: new(source.String()),
+	}
+	if name != "" {
+		arg.Label = &name
+	}
+	if description != "" {
+		arg.Description = &description
+	}
+	return c.createSecret(ctx, arg)
+}
+
+func (c *Client) createSecret(ctx context.Context, arg params.CreateSecretArg) (string, error) {
+	var results params.StringResults
 	err := c.facade.FacadeCall(ctx, "CreateSecrets", params.CreateSecretArgs{Args: []params.CreateSecretArg{arg}}, &results)
 	if err != nil {
 		return "", errors.Trace(err)
@@ -245,6 +267,100 @@ func (c *Client) RemoveSecret(ctx context.Context, uri *secrets.URI, name string
 	return nil
 }
 
+// RollbackSecret creates a new revision of a user secret with the content
+// of an earlier revision, returning the new revision.
+func (c *Client) RollbackSecret(ctx context.Context, uri *secrets.URI, name string, revision int) (int, error) {
+	if c.BestAPIVersion() < 3 {
+		return 0, errors.NotSupportedf("secret rollback")
+	}
+	arg := params.RollbackSecretArg{
+		URI:      uri.String(),
+		Label:    name,
+		Revision: revision,
+	}
+
+	var result params.IntResult
+	err := c.facade.FacadeCall(ctx, "RollbackSecret", arg, &result)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return 0, params.TranslateWellKnownError(result.Error)
+	}
+	return result.Result, nil
+}
+
+// SecretKeyChange describes a key of the content of a secret which was
+// added, removed or changed between two revisions.
+type SecretKeyChange struct {
+	Key    string
+	Change string
+	// From and To are the decoded values of the key, only set if they
+	// were revealed.
+	From string
+	To   string
+}
+
+// SecretRevisionDiff holds the changes to the content of a secret between
+// two revisions.
+type SecretRevisionDiff struct {
+	URI     *secrets.URI
+	Changes []SecretKeyChange
+}
+
+// SecretRevisionDiff returns the keys of the content of a secret which were
+// added, removed or changed between two revisions. The values of the keys
+// are only returned if reveal is true.
+func (c *Client) SecretRevisionDiff(
+	ctx context.Context, uri *secrets.URI, name string, fromRevision, toRevision int, reveal bool,
+) (SecretRevisionDiff, error) {
+	if c.BestAPIVersion() < 3 {
+		return SecretRevisionDiff{}, errors.NotSupportedf("secret revision diff")
+	}
+	arg := params.SecretRevisionDiffArg{
+		Label:        name,
+		FromRevision: fromRevision,
+		ToRevision:   toRevision,
+		Reveal:       reveal,
+	}
+	if uri != nil {
+		arg.URI = uri.String()
+	}
+
+	var result params.SecretRevisionDiffResult
+	err := c.facade.FacadeCall(ctx, "SecretRevisionDiff", arg, &result)
+	if err != nil {
+		return SecretRevisionDiff{}, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return SecretRevisionDiff{}, params.TranslateWellKnownError(result.Error)
+	}
+	diffURI, err := secrets.ParseURI(result.URI)
+	if err != nil {
+		return SecretRevisionDiff{}, errors.Trace(err)
+	}
+	diff := SecretRevisionDiff{
+		URI:     diffURI,
+		Changes: make([]SecretKeyChange, len(result.Changes)),
+	}
+	for i, change := range result.Changes {
+		values, err := secrets.NewSecretValue(map[string]string{
+			"from": change.From,
+			"to":   change.To,
+		}).Values()
+		if err != nil {
+			return SecretRevisionDiff{}, errors.Annotatef(err, "decoding key %q", change.Key)
+		}
+		diff.Changes[i] = SecretKeyChange{
+			Key:    change.Key,
+			Change: change.Change,
+			From:   values["from"],
+			To:     values["to"],
+		}
+	}
+	return diff, nil
+}
+
 // GrantSecret grants access to a secret to the specified applications.
 func (c *Client) GrantSecret(ctx context.Context, uri *secrets.URI, name string, apps []string) ([]error, error) {
 	if c.BestAPIVersion() < 2 {
@@ -309,3 +425,124 @@ func (c *Client) RevokeSecret(ctx context.Context, uri *secrets.URI, name string
 	}
 	return processErrors(results), nil
 }
+
+// SecretAccessLogFilter selects entries of the secret access log.
+// Zero valued fields match every entry.
+type SecretAccessLogFilter struct {
+	URI      *secrets.URI
+	Accessor names.Tag
+	Since    *time.Time
+	Until    *time.Time
+	Limit    int
+}
+
+// SecretAccessLogEntry records a read of a secret revision.
+type SecretAccessLogEntry struct {
+	URI        *secrets.URI
+	Revision   int
+	Accessor   names.Tag
+	AccessedAt time.Time
+}
+
+// SecretAccessLog returns the recorded reads of secret revisions matching
+// the filter, most recent first.
+func (c *Client) SecretAccessLog(ctx context.Context, filter SecretAccessLogFilter) ([]SecretAccessLogEntry, error) {
+	if c.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("secret access log")
+	}
+	arg := params.SecretAccessLogArgs{
+		Since: filter.Since,
+		Until: filter.Until,
+		Limit: filter.Limit,
+	}
+	if filter.URI != nil {
+		uri := filter.URI.String()
+		arg.URI = &uri
+	}
+	if filter.Accessor != nil {
+		tag := filter.Accessor.String()
+		arg.AccessorTag = &tag
+	}
+
+	var results params.SecretAccessLogResults
+	err := c.facade.FacadeCall(ctx, "SecretAccessLog", arg, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	entries := make([]SecretAccessLogEntry, len(results.Results))
+	for i, r := range results.Results {
+		uri, err := secrets.ParseURI(r.URI)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		accessor, err := names.ParseTag(r.AccessorTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		entries[i] = SecretAccessLogEntry{
+			URI:        uri,
+			Revision:   r.Revision,
+			Accessor:   accessor,
+			AccessedAt: r.AccessedAt,
+		}
+	}
+	return entries, nil
+}
+
+// MigrateSecretsArgs holds the arguments for moving secret revisions
+// between secret backends.
+type MigrateSecretsArgs struct {
+	FromBackend string
+	ToBackend   string
+	URI         *secrets.URI
+	DryRun      bool
+}
+
+// SecretRevisionMigration holds the outcome of migrating a secret revision.
+type SecretRevisionMigration struct {
+	URI      *secrets.URI
+	Revision int
+	Status   string
+	Checksum string
+	Error    error
+}
+
+// MigrateSecrets moves the content of secret revisions from one secret
+// backend to another, returning the outcome for each revision.
+func (c *Client) MigrateSecrets(ctx context.Context, args MigrateSecretsArgs) ([]SecretRevisionMigration, error) {
+	if c.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("secret migration")
+	}
+	arg := params.MigrateSecretsArgs{
+		FromBackend: args.FromBackend,
+		ToBackend:   args.ToBackend,
+		DryRun:      args.DryRun,
+	}
+	if args.URI != nil {
+		uri := args.URI.String()
+		arg.URI = &uri
+	}
+
+	var results params.SecretMigrationResults
+	err := c.facade.FacadeCall(ctx, "MigrateSecrets", arg, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	migrations := make([]SecretRevisionMigration, len(results.Results))
+	for i, r := range results.Results {
+		uri, err := secrets.ParseURI(r.URI)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		migrations[i] = SecretRevisionMigration{
+			URI:      uri,
+			Revision: r.Revision,
+			Status:   r.Status,
+			Checksum: r.Checksum,
+		}
+		if r.Error != nil {
+			migrations[i].Error = r.Error
+		}
+	}
+	return migrations, nil
+}
@@ -7,6 +7,8 @@ import (
 	stdtesting "testing"
 	"time"
 
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/base/testing"
@@ -190,6 +192,47 @@ func (s *SecretsSuite) TestCreateSecret(c *tc.C) {
 	c.Assert(result, tc.DeepEquals, uri.String())
 }
 
+func (s *SecretsSuite) TestCreateExternalSecretNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	_, err := client.CreateExternalSecret(c.Context(), "my-secret", "", secrets.ExternalSource{
+		Backend: "myvault", Path: "kv/prod/db",
+	})
+	c.Assert(err, tc.ErrorMatches, "external secret sources not supported")
+}
+
+func (s *SecretsSuite) TestCreateExternalSecret(c *tc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "CreateSecrets")
+		c.Assert(arg, tc.DeepEquals, params.CreateSecretArgs{
+			Args: []params.CreateSecretArg{{
+				UpsertSecretArg: params.UpsertSecretArg{
+					Label: new("my-secret"),
+				},
+				Source: new("myvault:kv/prod/db#password"),
+			}},
+		})
+		*(result.(*params.StringResults)) = params.StringResults{
+			Results: []params.StringResult{
+				{Result: uri.String()},
+			},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	result, err := client.CreateExternalSecret(c.Context(), "my-secret", "", secrets.ExternalSource{
+		Backend: "myvault", Path: "kv/prod/db", Key: "password",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.Equals, uri.String())
+}
+
 func (s *SecretsSuite) TestUpdateSecretError(c *tc.C) {
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
 		return nil
@@ -451,3 +494,192 @@ func (s *SecretsSuite) TestRevokeSecretByName(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(result, tc.DeepEquals, []error{nil})
 }
+
+func (s *SecretsSuite) TestSecretAccessLogNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	_, err := client.SecretAccessLog(c.Context(), apisecrets.SecretAccessLogFilter{})
+	c.Assert(err, tc.ErrorMatches, "secret access log not supported")
+}
+
+func (s *SecretsSuite) TestSecretAccessLog(c *tc.C) {
+	uri := secrets.NewURI()
+	now := time.Now()
+	since := now.Add(-time.Hour)
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "SecretAccessLog")
+		c.Assert(arg, tc.DeepEquals, params.SecretAccessLogArgs{
+			URI:         new(uri.String()),
+			AccessorTag: new("unit-mysql-0"),
+			Since:       &since,
+			Limit:       5,
+		})
+		*(result.(*params.SecretAccessLogResults)) = params.SecretAccessLogResults{
+			Results: []params.SecretAccessLogEntry{{
+				URI:         uri.String(),
+				Revision:    2,
+				AccessorTag: "unit-mysql-0",
+				AccessedAt:  now,
+			}},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	result, err := client.SecretAccessLog(c.Context(), apisecrets.SecretAccessLogFilter{
+		URI:      uri,
+		Accessor: names.NewUnitTag("mysql/0"),
+		Since:    &since,
+		Limit:    5,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, []apisecrets.SecretAccessLogEntry{{
+		URI:        uri,
+		Revision:   2,
+		Accessor:   names.NewUnitTag("mysql/0"),
+		AccessedAt: now,
+	}})
+}
+
+func (s *SecretsSuite) TestMigrateSecretsNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	_, err := client.MigrateSecrets(c.Context(), apisecrets.MigrateSecretsArgs{})
+	c.Assert(err, tc.ErrorMatches, "secret migration not supported")
+}
+
+func (s *SecretsSuite) TestMigrateSecrets(c *tc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "MigrateSecrets")
+		c.Assert(arg, tc.DeepEquals, params.MigrateSecretsArgs{
+			FromBackend: "internal",
+			ToBackend:   "myvault",
+			URI:         new(uri.String()),
+			DryRun:      true,
+		})
+		*(result.(*params.SecretMigrationResults)) = params.SecretMigrationResults{
+			Results: []params.SecretRevisionMigrationResult{{
+				URI:      uri.String(),
+				Revision: 1,
+				Status:   "planned",
+				Checksum: "checksum-1",
+			}, {
+				URI:      uri.String(),
+				Revision: 2,
+				Status:   "failed",
+				Error:    &params.Error{Message: "boom"},
+			}},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	result, err := client.MigrateSecrets(c.Context(), apisecrets.MigrateSecretsArgs{
+		FromBackend: "internal",
+		ToBackend:   "myvault",
+		URI:         uri,
+		DryRun:      true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 2)
+	c.Check(result[0], tc.DeepEquals, apisecrets.SecretRevisionMigration{
+		URI:      uri,
+		Revision: 1,
+		Status:   "planned",
+		Checksum: "checksum-1",
+	})
+	c.Check(result[1].Status, tc.Equals, "failed")
+	c.Check(result[1].Error, tc.ErrorMatches, "boom")
+}
+
+func (s *SecretsSuite) TestRollbackSecretNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	_, err := client.RollbackSecret(c.Context(), secrets.NewURI(), "", 1)
+	c.Assert(err, tc.ErrorMatches, "secret rollback not supported")
+}
+
+func (s *SecretsSuite) TestRollbackSecret(c *tc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "RollbackSecret")
+		c.Assert(arg, tc.DeepEquals, params.RollbackSecretArg{
+			URI:      uri.String(),
+			Revision: 2,
+		})
+		*(result.(*params.IntResult)) = params.IntResult{Result: 4}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	revision, err := client.RollbackSecret(c.Context(), uri, "", 2)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(revision, tc.Equals, 4)
+}
+
+func (s *SecretsSuite) TestRollbackSecretError(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		*(result.(*params.IntResult)) = params.IntResult{
+			Error: &params.Error{Code: params.CodeNotFound, Message: "secret revision not found"},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	_, err := client.RollbackSecret(c.Context(), nil, "my-secret", 7)
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *SecretsSuite) TestSecretRevisionDiffNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	_, err := client.SecretRevisionDiff(c.Context(), secrets.NewURI(), "", 1, 2, false)
+	c.Assert(err, tc.ErrorMatches, "secret revision diff not supported")
+}
+
+func (s *SecretsSuite) TestSecretRevisionDiff(c *tc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "SecretRevisionDiff")
+		c.Assert(arg, tc.DeepEquals, params.SecretRevisionDiffArg{
+			URI:          uri.String(),
+			FromRevision: 1,
+			ToRevision:   3,
+			Reveal:       true,
+		})
+		*(result.(*params.SecretRevisionDiffResult)) = params.SecretRevisionDiffResult{
+			URI: uri.String(),
+			Changes: []params.SecretKeyChange{
+				{Key: "foo", Change: "changed", From: "YmFy", To: "YmF6"},
+				{Key: "new", Change: "added", To: "bmV3"},
+			},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	diff, err := client.SecretRevisionDiff(c.Context(), uri, "", 1, 3, true)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(diff.URI.ID, tc.Equals, uri.ID)
+	c.Assert(diff.Changes, tc.DeepEquals, []apisecrets.SecretKeyChange{
+		{Key: "foo", Change: "changed", From: "bar", To: "baz"},
+		{Key: "new", Change: "added", To: "new"},
+	})
+}
@@ -135,6 +135,25 @@ func (api *API) RenameSpace(ctx context.Context, oldName string, newName string)
 	return nil
 }
 
+// SetSpaceAddressPreference sets the type of IP address, ipv4 or ipv6,
+// preferred on dual-stack machines in the named space.
+func (api *API) SetSpaceAddressPreference(ctx context.Context, name string, addressType string) error {
+	if api.facade.BestAPIVersion() < 7 {
+		return errors.NotSupportedf("space address preferences on this version of Juju")
+	}
+	var response params.ErrorResults
+	args := params.SetSpaceAddressPreferencesParams{
+		Preferences: []params.SpaceAddressPreference{{
+			SpaceTag:    names.NewSpaceTag(name).String(),
+			AddressType: addressType,
+		}},
+	}
+	if err := api.facade.FacadeCall(ctx, "SetSpaceAddressPreferences", args, &response); err != nil {
+		return errors.Trace(err)
+	}
+	return response.OneError()
+}
+
 // RemoveSpace removes a space.
 func (api *API) RemoveSpace(ctx context.Context, name string, force bool, dryRun bool) (params.RemoveSpaceResult, error) {
 	var response params.RemoveSpaceResults
@@ -240,6 +240,30 @@ func (s *spacesSuite) TestRenameSpaceError(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "bam")
 }
 
+func (s *spacesSuite) TestSetSpaceAddressPreference(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	resultSource := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	args := params.SetSpaceAddressPreferencesParams{
+		Preferences: []params.SpaceAddressPreference{{
+			SpaceTag:    names.NewSpaceTag("rack1").String(),
+			AddressType: "ipv6",
+		}},
+	}
+	s.fCaller.EXPECT().BestAPIVersion().Return(7)
+	s.fCaller.EXPECT().FacadeCall(gomock.Any(), "SetSpaceAddressPreferences", args, gomock.Any()).SetArg(3, resultSource).Return(nil)
+
+	err := s.API.SetSpaceAddressPreference(c.Context(), "rack1", "ipv6")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *spacesSuite) TestSetSpaceAddressPreferenceNotSupported(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.fCaller.EXPECT().BestAPIVersion().Return(6)
+
+	err := s.API.SetSpaceAddressPreference(c.Context(), "rack1", "ipv6")
+	c.Assert(err, tc.ErrorMatches, "space address preferences on this version of Juju not supported")
+}
+
 func (s *spacesSuite) TestCreateSpace(c *tc.C) {
 	defer s.setUpMocks(c).Finish()
 	name := "foo"
@@ -188,6 +188,11 @@ func (c *Client) ListFilesystems(ctx context.Context, machines []string) ([]para
 
 // AddToUnit adds specified storage to desired units.
 func (c *Client) AddToUnit(ctx context.Context, storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
+	for _, storage := range storages {
+		if storage.SnapshotId != "" && c.BestAPIVersion() < 9 {
+			return nil, errors.NotSupportedf("adding storage from a snapshot on this version of Juju")
+		}
+	}
 	out := params.AddStorageResults{}
 	in := params.StoragesAddParams{Storages: storages}
 	err := c.facade.FacadeCall(ctx, "AddToUnit", in, &out)
@@ -289,6 +294,63 @@ func (c *Client) Detach(ctx context.Context, storageIds []string, force *bool, m
 	return results.Results, nil
 }
 
+// Resize requests that the specified storage instance be grown to
+// the given size, in MiB. Shrinking storage is not supported.
+func (c *Client) Resize(ctx context.Context, storageId string, sizeMiB uint64) error {
+	if c.BestAPIVersion() < 8 {
+		return errors.NotSupportedf("resizing storage on this version of Juju")
+	}
+	if !names.IsValidStorage(storageId) {
+		return errors.NotValidf("storage ID %q", storageId)
+	}
+	args := params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: names.NewStorageTag(storageId).String(),
+			SizeMiB:    sizeMiB,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "ResizeStorage", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateSnapshot takes a snapshot of the specified storage instance and
+// returns the id of the snapshot, which can be used to add storage from the
+// snapshot. A name is generated for the snapshot when name is empty.
+func (c *Client) CreateSnapshot(ctx context.Context, storageId, name string) (string, error) {
+	if c.BestAPIVersion() < 9 {
+		return "", errors.NotSupportedf("snapshotting storage on this version of Juju")
+	}
+	if !names.IsValidStorage(storageId) {
+		return "", errors.NotValidf("storage ID %q", storageId)
+	}
+	args := params.CreateStorageSnapshotArgs{
+		Storage: []params.CreateStorageSnapshotArg{{
+			StorageTag: names.NewStorageTag(storageId).String(),
+			Name:       name,
+		}},
+	}
+	var results params.StringResults
+	if err := c.facade.FacadeCall(ctx, "CreateStorageSnapshots", args, &results); err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return "", err
+	}
+	return results.Results[0].Result, nil
+}
+
 // Import imports storage into the model.
 func (c *Client) Import(
 	ctx context.Context,
@@ -889,3 +889,130 @@ func (s *storageMockSuite) TestUpdatePoolFacadeCallError(c *tc.C) {
 	err := storageClient.UpdatePool(c.Context(), "", "", nil)
 	c.Assert(err, tc.ErrorMatches, msg)
 }
+
+func (s *storageMockSuite) TestResize(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	expectedArgs := params.ResizeStorageArgs{Storage: []params.ResizeStorageArg{{
+		StorageTag: "storage-data-0",
+		SizeMiB:    204800,
+	}}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ResizeStorage", expectedArgs, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(8).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	err := storageClient.Resize(c.Context(), "data/0", 204800)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *storageMockSuite) TestResizeError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Message: "qux"},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ResizeStorage", gomock.AssignableToTypeOf(params.ResizeStorageArgs{}), result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(8).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	err := storageClient.Resize(c.Context(), "data/0", 1024)
+	c.Assert(err, tc.ErrorMatches, "qux")
+}
+
+func (s *storageMockSuite) TestResizeNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(7).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	err := storageClient.Resize(c.Context(), "data/0", 1024)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageMockSuite) TestAddToUnitFromSnapshotNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(8).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	_, err := storageClient.AddToUnit(c.Context(), []params.StorageAddParams{
+		{UnitTag: "unit-mysql-0", StorageName: "data", SnapshotId: "snap-1"},
+	})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageMockSuite) TestCreateSnapshot(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	expectedArgs := params.CreateStorageSnapshotArgs{Storage: []params.CreateStorageSnapshotArg{{
+		StorageTag: "storage-data-0",
+		Name:       "nightly",
+	}}}
+	result := new(params.StringResults)
+	results := params.StringResults{Results: []params.StringResult{{
+		Result: "default:data-0/nightly",
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "CreateStorageSnapshots", expectedArgs, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(9).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	id, err := storageClient.CreateSnapshot(c.Context(), "data/0", "nightly")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(id, tc.Equals, "default:data-0/nightly")
+}
+
+func (s *storageMockSuite) TestCreateSnapshotError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	result := new(params.StringResults)
+	results := params.StringResults{Results: []params.StringResult{{
+		Error: &params.Error{Message: "qux"},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "CreateStorageSnapshots", gomock.AssignableToTypeOf(params.CreateStorageSnapshotArgs{}), result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(9).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	_, err := storageClient.CreateSnapshot(c.Context(), "data/0", "")
+	c.Assert(err, tc.ErrorMatches, "qux")
+}
+
+func (s *storageMockSuite) TestCreateSnapshotNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(8).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	_, err := storageClient.CreateSnapshot(c.Context(), "data/0", "")
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
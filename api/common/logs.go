@@ -41,6 +41,13 @@ type DebugLogParams struct {
 	ExcludeModule []string
 	// ExcludeLabel lists logging labels to exclude from the response.
 	ExcludeLabels map[string]string
+	// IncludeMessage lists regular expressions matched against the log
+	// message. If any are set, only messages matching at least one of them
+	// are included in the response.
+	IncludeMessage []string
+	// ExcludeMessage lists regular expressions matched against the log
+	// message. Messages matching any of them are excluded from the response.
+	ExcludeMessage []string
 
 	// Limit defines the maximum number of lines to return. Once this many
 	// have been sent, the socket is closed.  If zero, all filtered lines are
@@ -72,6 +79,12 @@ func (args DebugLogParams) URLQuery() url.Values {
 		"excludeEntity": args.ExcludeEntity,
 		"excludeModule": args.ExcludeModule,
 	}
+	if len(args.IncludeMessage) > 0 {
+		attrs["includeMessage"] = args.IncludeMessage
+	}
+	if len(args.ExcludeMessage) > 0 {
+		attrs["excludeMessage"] = args.ExcludeMessage
+	}
 	attrs.Set("version", "2")
 	var includeLabels []string
 	for k, v := range args.IncludeLabels {
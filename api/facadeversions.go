@@ -25,16 +25,16 @@ var facadeVersions = facades.FacadeVersions{
 	"Agent":             {3},
 	"AgentLifeFlag":     {1},
 	"Annotations":       {2},
-	"Application":       {19, 20, 21, 22},
+	"Application":       {19, 20, 21, 22, 23},
 	"ApplicationOffers": {5, 6},
-	"Backups":           {3},
+	"Backups":           {3, 4},
 	"Block":             {2},
 	// Note that this version of Juju does not implement version 6 of the
 	// facade, but 3.6 does. Care must be taken not to break client
 	// compatibility with the prior version.
 	// Version 8 here just reports the inability of Juju 4+ to export bundles.
 	// We should probably just remove the facade altogether.
-	"Bundle":                       {6, 8},
+	"Bundle":                       {6, 8, 9},
 	"CAASAgent":                    {2},
 	"CAASAdmission":                {1},
 	"CAASApplication":              {1},
@@ -43,7 +43,7 @@ var facadeVersions = facades.FacadeVersions{
 	"CAASModelOperator":            {1},
 	"CAASOperatorUpgrader":         {1},
 	"Charms":                       {7},
-	"Client":                       {8},
+	"Client":                       {8, 9},
 	"Cloud":                        {7},
 	"Controller":                   {12, 13, 14},
 	"CredentialManager":            {1},
@@ -52,12 +52,13 @@ var facadeVersions = facades.FacadeVersions{
 	"CrossModelRelations":          {3},
 	"CrossModelSecrets":            {1, 2},
 	"Deployer":                     {1},
-	"DiskManager":                  {2, 3},
+	"DiskManager":                  {2, 3, 4},
 	"EntityWatcher":                {2},
 	"ExternalControllerUpdater":    {1},
 	"FilesystemAttachmentsWatcher": {2},
 	"Firewaller":                   {7},
 	"HighAvailability":             {2, 3},
+	"HostFirewaller":               {1},
 	"HostKeyReporter":              {1},
 	"ImageMetadata":                {3},
 	"ImageMetadataManager":         {1},
@@ -94,19 +95,19 @@ var facadeVersions = facades.FacadeVersions{
 	"ResourcesHookContext":         {1},
 	"RetryStrategy":                {1},
 	"SecretsTriggerWatcher":        {1},
-	"SecretBackends":               {1},
+	"SecretBackends":               {1, 2},
 	"SecretBackendsManager":        {1},
 	"SecretBackendsRotateWatcher":  {1},
 	"SecretsRevisionWatcher":       {1},
-	"Secrets":                      {1, 2},
+	"Secrets":                      {1, 2, 3},
 	"SecretsManager":               {4},
 	"SecretsDrain":                 {1},
 	"UserSecretsDrain":             {1},
 	"UserSecretsManager":           {1},
-	"Spaces":                       {6},
+	"Spaces":                       {6, 7},
 	"SSHClient":                    {4, 5},
-	"Storage":                      {6, 7},
-	"StorageProvisioner":           {5, 6, 7},
+	"Storage":                      {6, 7, 8, 9},
+	"StorageProvisioner":           {5, 6, 7, 8},
 	"StringsWatcher":               {1},
 	"Subnets":                      {5},
 	"Uniter":                       {19, 20, 21, 22},
@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/agent/credentialvalidator"
 	"github.com/juju/juju/apiserver/facades/agent/deployer"
 	"github.com/juju/juju/apiserver/facades/agent/diskmanager"
+	"github.com/juju/juju/apiserver/facades/agent/hostfirewaller"
 	"github.com/juju/juju/apiserver/facades/agent/hostkeyreporter"
 	"github.com/juju/juju/apiserver/facades/agent/instancemutater"
 	"github.com/juju/juju/apiserver/facades/agent/keyupdater"
@@ -180,6 +181,7 @@ func AllFacades() *facade.Registry {
 	diskmanager.Register(registry)
 	firewaller.Register(registry)
 	highavailability.Register(registry)
+	hostfirewaller.Register(registry)
 	hostkeyreporter.Register(registry)
 	imagemetadata.Register(registry)
 	imagemetadatamanager.Register(registry)
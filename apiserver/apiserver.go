@@ -848,6 +848,12 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 
 	modelToolsDownloadHandler := srv.monitoredHandler(newToolsDownloadHandler(httpCtxt), "tools")
 
+	backupsDownloadHandler := srv.monitoredHandler(newBackupsDownloadHandler(
+		func(ctx context.Context) (objectstore.ObjectStore, error) {
+			return srv.shared.objectStoreGetter.GetObjectStore(ctx, database.ControllerNS)
+		},
+	), "backups")
+
 	resourceAuthFunc := func(req *http.Request, tagKinds ...string) (names.Tag, error) {
 		return httpCtxt.authenticatedTagFromRequest(req, tagKinds...)
 	}
@@ -962,6 +968,11 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		pattern:         modelRoutePrefix + "/tools/:version",
 		handler:         modelToolsDownloadHandler,
 		unauthenticated: true,
+	}, {
+		pattern:    modelRoutePrefix + "/backups",
+		methods:    []string{"GET"},
+		handler:    backupsDownloadHandler,
+		authorizer: controllerAdminAuthorizer,
 	}, {
 		pattern:    modelRoutePrefix + "/applications/:application/resources/:resource",
 		handler:    resourcesHandler,
@@ -1021,6 +1032,11 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		pattern:         "/tools/:version",
 		handler:         modelToolsDownloadHandler,
 		unauthenticated: true,
+	}, {
+		pattern:    "/backups",
+		methods:    []string{"GET"},
+		handler:    backupsDownloadHandler,
+		authorizer: controllerAdminAuthorizer,
 	}, {
 		pattern: "/log",
 		handler: debugLogHandler,
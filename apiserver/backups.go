@@ -0,0 +1,99 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/juju/errors"
+
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/backups"
+	"github.com/juju/juju/rpc/params"
+)
+
+// controllerObjectStoreGetter returns the controller object store.
+type controllerObjectStoreGetter func(context.Context) (objectstore.ObjectStore, error)
+
+// backupsDownloadHandler serves the archives of the scheduled backups kept
+// in the controller object store.
+type backupsDownloadHandler struct {
+	storeGetter controllerObjectStoreGetter
+}
+
+func newBackupsDownloadHandler(storeGetter controllerObjectStoreGetter) *backupsDownloadHandler {
+	return &backupsDownloadHandler{
+		storeGetter: storeGetter,
+	}
+}
+
+func (h *backupsDownloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		reader, size, err := h.getBackupForRequest(r)
+		if err != nil {
+			logger.Errorf(r.Context(), "GET(%s) failed: %v", r.URL, err)
+			if err := sendError(w, err); err != nil {
+				logger.Errorf(r.Context(), "%v", err)
+			}
+			return
+		}
+		defer func() { _ = reader.Close() }()
+
+		w.Header().Set("Content-Type", params.ContentTypeRaw)
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		if _, err := io.Copy(w, reader); err != nil {
+			// Having begun writing, it is too late to send an error response here.
+			logger.Errorf(r.Context(), "failed to send backup archive: %v", err)
+		}
+	default:
+		if err := sendError(w, errors.MethodNotAllowedf("unsupported method: %q", r.Method)); err != nil {
+			logger.Errorf(r.Context(), "%v", err)
+		}
+	}
+}
+
+// getBackupForRequest returns a reader for the archive of the scheduled
+// backup named in the request, along with its size. Only archives of
+// backups recorded as succeeded in the index can be downloaded.
+func (h *backupsDownloadHandler) getBackupForRequest(r *http.Request) (_ io.ReadCloser, _ int64, err error) {
+	var args params.BackupsDownloadArgs
+	if err := json.NewDecoder(r.Body).Decode(&args); err != nil {
+		return nil, 0, errors.NewBadRequest(err, "invalid backups download request")
+	}
+	if args.ID == "" {
+		return nil, 0, errors.BadRequestf("missing backup filename")
+	}
+
+	ctx := r.Context()
+	store, err := h.storeGetter(ctx)
+	if err != nil {
+		return nil, 0, errors.Trace(err)
+	}
+	index, err := backups.ReadIndex(ctx, store)
+	if err != nil {
+		return nil, 0, errors.Trace(err)
+	}
+	found := false
+	for _, entry := range index.Entries {
+		if entry.Filename == args.ID && entry.Status == corebackups.StatusSucceeded {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, 0, errors.NotFoundf("backup %q", args.ID)
+	}
+
+	reader, digest, err := store.Get(ctx, corebackups.ObjectStorePath(args.ID))
+	if err != nil {
+		return nil, 0, errors.Annotatef(err, "reading backup %q", args.ID)
+	}
+	return reader, digest.Size, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type backupsSuite struct {
+	store *fakeBackupsStore
+}
+
+func TestBackupsSuite(t *testing.T) {
+	tc.Run(t, &backupsSuite{})
+}
+
+func (s *backupsSuite) SetUpTest(c *tc.C) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	index := corebackups.Index{Entries: []corebackups.IndexEntry{{
+		Filename: "juju-backup-1.tar.gz",
+		Started:  now,
+		Status:   corebackups.StatusSucceeded,
+	}, {
+		Filename: "juju-backup-2.tar.gz",
+		Started:  now.Add(time.Hour),
+		Status:   corebackups.StatusFailed,
+	}}}
+	data, err := index.Bytes()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.store = &fakeBackupsStore{objects: map[string][]byte{
+		corebackups.IndexPath:                               data,
+		corebackups.ObjectStorePath("juju-backup-1.tar.gz"): []byte("archive"),
+	}}
+}
+
+func (s *backupsSuite) download(c *tc.C, method, filename string) *http.Response {
+	handler := newBackupsDownloadHandler(func(context.Context) (objectstore.ObjectStore, error) {
+		return s.store, nil
+	})
+	body, err := json.Marshal(params.BackupsDownloadArgs{ID: filename})
+	c.Assert(err, tc.ErrorIsNil)
+	req := httptest.NewRequest(method, "/backups", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Result()
+}
+
+func (s *backupsSuite) TestDownload(c *tc.C) {
+	resp := s.download(c, "GET", "juju-backup-1.tar.gz")
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+	c.Check(resp.Header.Get("Content-Type"), tc.Equals, params.ContentTypeRaw)
+	c.Check(resp.Header.Get("Content-Length"), tc.Equals, "7")
+	data, err := io.ReadAll(resp.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "archive")
+}
+
+func (s *backupsSuite) TestDownloadFailedBackup(c *tc.C) {
+	resp := s.download(c, "GET", "juju-backup-2.tar.gz")
+	c.Check(resp.StatusCode, tc.Equals, http.StatusNotFound)
+}
+
+func (s *backupsSuite) TestDownloadNotInIndex(c *tc.C) {
+	// Only archives recorded in the index can be downloaded, so other
+	// objects in the controller object store can't be read.
+	resp := s.download(c, "GET", "../index.json")
+	c.Check(resp.StatusCode, tc.Equals, http.StatusNotFound)
+}
+
+func (s *backupsSuite) TestDownloadMissingFilename(c *tc.C) {
+	resp := s.download(c, "GET", "")
+	c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest)
+}
+
+func (s *backupsSuite) TestDownloadMethodNotAllowed(c *tc.C) {
+	resp := s.download(c, "POST", "juju-backup-1.tar.gz")
+	c.Check(resp.StatusCode, tc.Equals, http.StatusMethodNotAllowed)
+}
+
+// fakeBackupsStore is an in-memory object store that can only be read.
+type fakeBackupsStore struct {
+	objectstore.ObjectStore
+	objects map[string][]byte
+}
+
+func (f *fakeBackupsStore) Get(_ context.Context, p string) (io.ReadCloser, objectstore.Digest, error) {
+	data, ok := f.objects[p]
+	if !ok {
+		return nil, objectstore.Digest{}, objectstoreerrors.ObjectNotFound
+	}
+	return io.NopCloser(strings.NewReader(string(data))), objectstore.Digest{Size: int64(len(data))}, nil
+}
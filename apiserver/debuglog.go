@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"syscall"
@@ -87,6 +88,10 @@ func newDebugLogHandler(
 //	excludeEntity -> []string - lists entity tags to exclude from the response
 //	   - as with include, it may finish with a '*'
 //	excludeModule -> []string - lists logging modules to exclude from the response
+//	includeMessage -> []string - lists regular expressions, one of which the
+//	   message must match to be included in the response
+//	excludeMessage -> []string - lists regular expressions, none of which the
+//	   message may match to be included in the response
 //	limit -> uint - show *at most* this many lines
 //	backlog -> uint
 //	   - go back this many lines from the end before starting to filter
@@ -223,19 +228,21 @@ func (s *debugLogSocketImpl) sendLogRecord(record *params.LogMessage, version in
 
 // debugLogParams contains the parsed debuglog API request parameters.
 type debugLogParams struct {
-	version       int
-	startTime     time.Time
-	fromTheStart  bool
-	noTail        bool
-	firehose      bool
-	initialLines  uint
-	filterLevel   corelogger.Level
-	includeEntity []string
-	excludeEntity []string
-	includeModule []string
-	excludeModule []string
-	includeLabels map[string]string
-	excludeLabels map[string]string
+	version        int
+	startTime      time.Time
+	fromTheStart   bool
+	noTail         bool
+	firehose       bool
+	initialLines   uint
+	filterLevel    corelogger.Level
+	includeEntity  []string
+	excludeEntity  []string
+	includeModule  []string
+	excludeModule  []string
+	includeLabels  map[string]string
+	excludeLabels  map[string]string
+	includeMessage []string
+	excludeMessage []string
 }
 
 func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
@@ -312,6 +319,19 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 	params.includeModule = queryMap["includeModule"]
 	params.excludeModule = queryMap["excludeModule"]
 
+	params.includeMessage = queryMap["includeMessage"]
+	for _, expr := range params.includeMessage {
+		if _, err := regexp.Compile(expr); err != nil {
+			return debugLogParams{}, errors.NewNotValid(err, fmt.Sprintf("include message pattern %q", expr))
+		}
+	}
+	params.excludeMessage = queryMap["excludeMessage"]
+	for _, expr := range params.excludeMessage {
+		if _, err := regexp.Compile(expr); err != nil {
+			return debugLogParams{}, errors.NewNotValid(err, fmt.Sprintf("exclude message pattern %q", expr))
+		}
+	}
+
 	params.includeLabels = make(map[string]string)
 	if labels, ok := queryMap["includeLabels"]; ok {
 		for _, label := range labels {
@@ -76,18 +76,20 @@ func handleDebugLogRequest(
 
 func makeLogTailerParams(reqParams debugLogParams) logtailer.LogTailerParams {
 	return logtailer.LogTailerParams{
-		MinLevel:      reqParams.filterLevel,
-		NoTail:        reqParams.noTail,
-		Firehose:      reqParams.firehose,
-		StartTime:     reqParams.startTime,
-		InitialLines:  int(reqParams.initialLines),
-		IncludeEntity: reqParams.includeEntity,
-		ExcludeEntity: reqParams.excludeEntity,
-		IncludeModule: reqParams.includeModule,
-		ExcludeModule: reqParams.excludeModule,
-		IncludeLabels: reqParams.includeLabels,
-		ExcludeLabels: reqParams.excludeLabels,
-		FromTheStart:  reqParams.fromTheStart,
+		MinLevel:       reqParams.filterLevel,
+		NoTail:         reqParams.noTail,
+		Firehose:       reqParams.firehose,
+		StartTime:      reqParams.startTime,
+		InitialLines:   int(reqParams.initialLines),
+		IncludeEntity:  reqParams.includeEntity,
+		ExcludeEntity:  reqParams.excludeEntity,
+		IncludeModule:  reqParams.includeModule,
+		ExcludeModule:  reqParams.excludeModule,
+		IncludeLabels:  reqParams.includeLabels,
+		ExcludeLabels:  reqParams.excludeLabels,
+		IncludeMessage: reqParams.includeMessage,
+		ExcludeMessage: reqParams.excludeMessage,
+		FromTheStart:   reqParams.fromTheStart,
 	}
 }
 
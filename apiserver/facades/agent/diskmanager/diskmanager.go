@@ -15,12 +15,13 @@ import (
 	"github.com/juju/juju/core/blockdevice"
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/storage"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/rpc/params"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package diskmanager -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/diskmanager MachineService,BlockDeviceService
+//go:generate go run go.uber.org/mock/mockgen -typed -package diskmanager -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/diskmanager MachineService,BlockDeviceService,StorageService
 
 type MachineService interface {
 	// GetMachineUUID returns the UUID of a machine identified by its name.
@@ -36,8 +37,23 @@ type BlockDeviceService interface {
 	) error
 }
 
+// StorageService provides access to the storage in the model.
+type StorageService interface {
+	// SetMachineFilesystemUsage records the usage of the filesystems
+	// mounted on the specified machine.
+	SetMachineFilesystemUsage(
+		ctx context.Context, machineUUID machine.UUID,
+		usage []storage.FilesystemUsage,
+	) error
+}
+
 // DiskManagerAPIV2 did not have the provenance field on block devices.
 type DiskManagerAPIV2 struct {
+	*DiskManagerAPIV3
+}
+
+// DiskManagerAPIV3 did not have SetMachineFilesystemUsage.
+type DiskManagerAPIV3 struct {
 	*DiskManagerAPI
 }
 
@@ -59,10 +75,14 @@ func (d *DiskManagerAPIV2) SetMachineBlockDevices(
 	return d.DiskManagerAPI.SetMachineBlockDevices(ctx, args)
 }
 
+// SetMachineFilesystemUsage is not available on V3 and earlier.
+func (*DiskManagerAPIV3) SetMachineFilesystemUsage(_, _ struct{}) {}
+
 // DiskManagerAPI provides access to the DiskManager API facade.
 type DiskManagerAPI struct {
 	machineService     MachineService
 	blockDeviceService BlockDeviceService
+	storageService     StorageService
 	authorizer         facade.Authorizer
 	getAuthFunc        common.GetAuthFunc
 }
@@ -78,16 +98,18 @@ func (d *DiskManagerAPI) SetMachineBlockDevices(
 		return result, err
 	}
 	one := func(arg params.MachineBlockDevices) error {
-		tag, err := names.ParseMachineTag(arg.Machine)
+		tag, machineUUID, err := d.machineUUID(ctx, canAccess, arg.Machine)
 		if err != nil {
-			return apiservererrors.ErrPerm
+			return err
 		}
-		if !canAccess(tag) {
-			return apiservererrors.ErrPerm
+
+		blockdevices, err := blockDevicesFromParams(arg.BlockDevices)
+		if err != nil {
+			return errors.Capture(err)
 		}
 
-		machineUUID, err := d.machineService.GetMachineUUID(
-			ctx, machine.Name(tag.Id()))
+		err = d.blockDeviceService.UpdateBlockDevicesForMachine(
+			ctx, machineUUID, blockdevices)
 		if errors.Is(err, machineerrors.MachineNotFound) {
 			return errors.Errorf(
 				"machine %q not found", tag.Id(),
@@ -96,13 +118,45 @@ func (d *DiskManagerAPI) SetMachineBlockDevices(
 			return err
 		}
 
-		blockdevices, err := blockDevicesFromParams(arg.BlockDevices)
+		return nil
+	}
+	for i, arg := range args.MachineBlockDevices {
+		err := one(arg)
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+// SetMachineFilesystemUsage records the usage of the filesystems mounted
+// on machines, as measured by their machine agents.
+func (d *DiskManagerAPI) SetMachineFilesystemUsage(
+	ctx context.Context, args params.SetMachineFilesystemUsage,
+) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.MachineFilesystemUsage)),
+	}
+	canAccess, err := d.getAuthFunc(ctx)
+	if err != nil {
+		return result, err
+	}
+	one := func(arg params.MachineFilesystemUsage) error {
+		tag, machineUUID, err := d.machineUUID(ctx, canAccess, arg.Machine)
 		if err != nil {
-			return errors.Capture(err)
+			return err
 		}
 
-		err = d.blockDeviceService.UpdateBlockDevicesForMachine(
-			ctx, machineUUID, blockdevices)
+		usage := transform.Slice(
+			arg.Usage,
+			func(u params.FilesystemUsage) storage.FilesystemUsage {
+				return storage.FilesystemUsage{
+					MountPoint: u.MountPoint,
+					SizeMiB:    u.SizeMiB,
+					UsedMiB:    u.UsedMiB,
+				}
+			},
+		)
+		err = d.storageService.SetMachineFilesystemUsage(
+			ctx, machineUUID, usage)
 		if errors.Is(err, machineerrors.MachineNotFound) {
 			return errors.Errorf(
 				"machine %q not found", tag.Id(),
@@ -113,13 +167,38 @@ func (d *DiskManagerAPI) SetMachineBlockDevices(
 
 		return nil
 	}
-	for i, arg := range args.MachineBlockDevices {
+	for i, arg := range args.MachineFilesystemUsage {
 		err := one(arg)
 		result.Results[i].Error = apiservererrors.ServerError(err)
 	}
 	return result, nil
 }
 
+// machineUUID returns the tag and UUID of the machine identified by the
+// supplied tag string, if the caller is allowed to access it.
+func (d *DiskManagerAPI) machineUUID(
+	ctx context.Context, canAccess common.AuthFunc, machineTag string,
+) (names.MachineTag, machine.UUID, error) {
+	tag, err := names.ParseMachineTag(machineTag)
+	if err != nil {
+		return names.MachineTag{}, "", apiservererrors.ErrPerm
+	}
+	if !canAccess(tag) {
+		return names.MachineTag{}, "", apiservererrors.ErrPerm
+	}
+
+	machineUUID, err := d.machineService.GetMachineUUID(
+		ctx, machine.Name(tag.Id()))
+	if errors.Is(err, machineerrors.MachineNotFound) {
+		return names.MachineTag{}, "", errors.Errorf(
+			"machine %q not found", tag.Id(),
+		).Add(coreerrors.NotFound)
+	} else if err != nil {
+		return names.MachineTag{}, "", err
+	}
+	return tag, machineUUID, nil
+}
+
 func blockDevicesFromParams(in []params.BlockDevice) ([]blockdevice.BlockDevice, error) {
 	if len(in) == 0 {
 		return nil, nil
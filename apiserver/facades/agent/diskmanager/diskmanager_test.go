@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/blockdevice"
 	"github.com/juju/juju/core/machine"
 	machinetesting "github.com/juju/juju/core/machine/testing"
+	"github.com/juju/juju/core/storage"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	"github.com/juju/juju/rpc/params"
 )
@@ -30,6 +31,7 @@ type DiskManagerSuite struct {
 
 	machineService     *MockMachineService
 	blockDeviceService *MockBlockDeviceService
+	storageService     *MockStorageService
 }
 
 func (s *DiskManagerSuite) setupMocks(c *tc.C) *gomock.Controller {
@@ -40,10 +42,12 @@ func (s *DiskManagerSuite) setupMocks(c *tc.C) *gomock.Controller {
 
 	s.machineService = NewMockMachineService(ctrl)
 	s.blockDeviceService = NewMockBlockDeviceService(ctrl)
+	s.storageService = NewMockStorageService(ctrl)
 
 	s.api = &DiskManagerAPI{
 		machineService:     s.machineService,
 		blockDeviceService: s.blockDeviceService,
+		storageService:     s.storageService,
 		authorizer:         s.authorizer,
 		getAuthFunc: func(ctx context.Context) (common.AuthFunc, error) {
 			return func(t names.Tag) bool {
@@ -56,6 +60,7 @@ func (s *DiskManagerSuite) setupMocks(c *tc.C) *gomock.Controller {
 		s.authorizer = nil
 		s.machineService = nil
 		s.blockDeviceService = nil
+		s.storageService = nil
 	})
 
 	return ctrl
@@ -154,6 +159,63 @@ func (s *DiskManagerSuite) TestSetMachineBlockDevicesMachineNotFound(c *tc.C) {
 	})
 }
 
+func (s *DiskManagerSuite) TestSetMachineFilesystemUsage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	machineUUID := machinetesting.GenUUID(c)
+	s.machineService.EXPECT().GetMachineUUID(
+		gomock.Any(), machine.Name("0")).Return(machineUUID, nil)
+	s.storageService.EXPECT().SetMachineFilesystemUsage(
+		gomock.Any(), machineUUID, []storage.FilesystemUsage{{
+			MountPoint: "/srv/data",
+			SizeMiB:    1024,
+			UsedMiB:    512,
+		}}).Return(nil)
+
+	results, err := s.api.SetMachineFilesystemUsage(c.Context(), params.SetMachineFilesystemUsage{
+		MachineFilesystemUsage: []params.MachineFilesystemUsage{{
+			Machine: "machine-0",
+			Usage: []params.FilesystemUsage{{
+				MountPoint: "/srv/data",
+				SizeMiB:    1024,
+				UsedMiB:    512,
+			}},
+		}, {
+			Machine: "machine-1",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{
+			Error: nil,
+		}, {
+			Error: &params.Error{Message: "permission denied", Code: "unauthorized access"},
+		}},
+	})
+}
+
+func (s *DiskManagerSuite) TestSetMachineFilesystemUsageMachineNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	machineUUID := machinetesting.GenUUID(c)
+	s.machineService.EXPECT().GetMachineUUID(
+		gomock.Any(), machine.Name("0")).Return(machineUUID, nil)
+	s.storageService.EXPECT().SetMachineFilesystemUsage(
+		gomock.Any(), machineUUID, gomock.Any()).Return(machineerrors.MachineNotFound)
+
+	results, err := s.api.SetMachineFilesystemUsage(c.Context(), params.SetMachineFilesystemUsage{
+		MachineFilesystemUsage: []params.MachineFilesystemUsage{{
+			Machine: "machine-0",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{
+			Error: &params.Error{Message: `machine "0" not found`, Code: "not found"},
+		}},
+	})
+}
+
 func TestDiskManagerV2Suite(t *testing.T) {
 	tc.Run(t, &DiskManagerV2Suite{})
 }
@@ -176,14 +238,16 @@ func (s *DiskManagerV2Suite) setupMocks(c *tc.C) *gomock.Controller {
 	s.blockDeviceService = NewMockBlockDeviceService(ctrl)
 
 	s.api = &DiskManagerAPIV2{
-		DiskManagerAPI: &DiskManagerAPI{
-			machineService:     s.machineService,
-			blockDeviceService: s.blockDeviceService,
-			authorizer:         s.authorizer,
-			getAuthFunc: func(ctx context.Context) (common.AuthFunc, error) {
-				return func(t names.Tag) bool {
-					return t == tag
-				}, nil
+		DiskManagerAPIV3: &DiskManagerAPIV3{
+			DiskManagerAPI: &DiskManagerAPI{
+				machineService:     s.machineService,
+				blockDeviceService: s.blockDeviceService,
+				authorizer:         s.authorizer,
+				getAuthFunc: func(ctx context.Context) (common.AuthFunc, error) {
+					return func(t names.Tag) bool {
+						return t == tag
+					}, nil
+				},
 			},
 		},
 	}
@@ -21,17 +21,31 @@ func Register(registry facade.FacadeRegistry) {
 		return newDiskManagerAPIV2(ctx)
 	}, reflect.TypeFor[*DiskManagerAPIV2]())
 	registry.MustRegister("DiskManager", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newDiskManagerAPI(ctx)
+		return newDiskManagerAPIV3(ctx)
+	}, reflect.TypeFor[*DiskManagerAPIV3]())
+	registry.MustRegister("DiskManager", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newDiskManagerAPI(ctx) // add SetMachineFilesystemUsage.
 	}, reflect.TypeFor[*DiskManagerAPI]())
 }
 
 // newDiskManagerAPIV2 creates a new server-side DiskManager API V2 facade.
 func newDiskManagerAPIV2(ctx facade.ModelContext) (*DiskManagerAPIV2, error) {
-	dm, err := newDiskManagerAPI(ctx)
+	dm, err := newDiskManagerAPIV3(ctx)
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
 	return &DiskManagerAPIV2{
+		DiskManagerAPIV3: dm,
+	}, nil
+}
+
+// newDiskManagerAPIV3 creates a new server-side DiskManager API V3 facade.
+func newDiskManagerAPIV3(ctx facade.ModelContext) (*DiskManagerAPIV3, error) {
+	dm, err := newDiskManagerAPI(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return &DiskManagerAPIV3{
 		DiskManagerAPI: dm,
 	}, nil
 }
@@ -54,6 +68,7 @@ func newDiskManagerAPI(ctx facade.ModelContext) (*DiskManagerAPI, error) {
 	return &DiskManagerAPI{
 		machineService:     ctx.DomainServices().Machine(),
 		blockDeviceService: ctx.DomainServices().BlockDevice(),
+		storageService:     ctx.DomainServices().Storage(),
 		authorizer:         authorizer,
 		getAuthFunc:        getAuthFunc,
 	}, nil
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/diskmanager (interfaces: MachineService,BlockDeviceService)
+// Source: github.com/juju/juju/apiserver/facades/agent/diskmanager (interfaces: MachineService,BlockDeviceService,StorageService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package diskmanager -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/diskmanager MachineService,BlockDeviceService
+//	mockgen -typed -package diskmanager -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/diskmanager MachineService,BlockDeviceService,StorageService
 //
 
 // Package diskmanager is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 
 	blockdevice "github.com/juju/juju/core/blockdevice"
 	machine "github.com/juju/juju/core/machine"
+	storage "github.com/juju/juju/core/storage"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -140,3 +141,64 @@ func (c *MockBlockDeviceServiceUpdateBlockDevicesForMachineCall) DoAndReturn(f f
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockStorageService is a mock of StorageService interface.
+type MockStorageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageServiceMockRecorder
+}
+
+// MockStorageServiceMockRecorder is the mock recorder for MockStorageService.
+type MockStorageServiceMockRecorder struct {
+	mock *MockStorageService
+}
+
+// NewMockStorageService creates a new mock instance.
+func NewMockStorageService(ctrl *gomock.Controller) *MockStorageService {
+	mock := &MockStorageService{ctrl: ctrl}
+	mock.recorder = &MockStorageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
+	return m.recorder
+}
+
+// SetMachineFilesystemUsage mocks base method.
+func (m *MockStorageService) SetMachineFilesystemUsage(arg0 context.Context, arg1 machine.UUID, arg2 []storage.FilesystemUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMachineFilesystemUsage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMachineFilesystemUsage indicates an expected call of SetMachineFilesystemUsage.
+func (mr *MockStorageServiceMockRecorder) SetMachineFilesystemUsage(arg0, arg1, arg2 any) *MockStorageServiceSetMachineFilesystemUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMachineFilesystemUsage", reflect.TypeOf((*MockStorageService)(nil).SetMachineFilesystemUsage), arg0, arg1, arg2)
+	return &MockStorageServiceSetMachineFilesystemUsageCall{Call: call}
+}
+
+// MockStorageServiceSetMachineFilesystemUsageCall wrap *gomock.Call
+type MockStorageServiceSetMachineFilesystemUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceSetMachineFilesystemUsageCall) Return(arg0 error) *MockStorageServiceSetMachineFilesystemUsageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceSetMachineFilesystemUsageCall) Do(f func(context.Context, machine.UUID, []storage.FilesystemUsage) error) *MockStorageServiceSetMachineFilesystemUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceSetMachineFilesystemUsageCall) DoAndReturn(f func(context.Context, machine.UUID, []storage.FilesystemUsage) error) *MockStorageServiceSetMachineFilesystemUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
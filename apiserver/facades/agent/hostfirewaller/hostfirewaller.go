@@ -0,0 +1,424 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hostfirewaller
+
+import (
+	"context"
+	"sort"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/names/v6"
+	"github.com/juju/worker/v5"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/internal"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	corerelation "github.com/juju/juju/core/relation"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/domain/relation"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package hostfirewaller -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/hostfirewaller MachineService,PortService,ApplicationService,RelationService,NetworkService,ModelConfigService
+
+// HostFirewallerAPI provides access to the HostFirewaller API facade, which
+// supplies machine agents with the inbound traffic to accept on their
+// machine.
+type HostFirewallerAPI struct {
+	machineService     MachineService
+	portService        PortService
+	applicationService ApplicationService
+	relationService    RelationService
+	networkService     NetworkService
+	modelConfigService ModelConfigService
+	watcherRegistry    facade.WatcherRegistry
+	authorizer         facade.Authorizer
+}
+
+// modelInfo holds the model wide details used to compute the ingress rules
+// of a machine.
+type modelInfo struct {
+	spaces  network.SpaceInfos
+	exposed map[string]map[string]application.ExposedEndpoint
+
+	// relationNetworkPolicy is true when units only accept connections
+	// from the units related to them, in which case relations holds all
+	// the relations of the model.
+	relationNetworkPolicy bool
+	relations             []relation.RelationDetailsResult
+
+	// relatedCIDRs caches the CIDRs of the units of an application in
+	// scope of a relation, keyed by relation UUID and application name.
+	relatedCIDRs map[string][]string
+}
+
+// WatchHostFirewallRules returns a NotifyWatcher for each given machine,
+// which fires when the inbound traffic to accept on the machine may have
+// changed. That is, when the opened ports or expose settings of the units,
+// the subnets of the model, the relations and addresses of units or the model
+// config change.
+func (api *HostFirewallerAPI) WatchHostFirewallRules(ctx context.Context, args params.Entities) params.NotifyWatchResults {
+	result := params.NotifyWatchResults{
+		Results: make([]params.NotifyWatchResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		id, err := api.watchOne(ctx, entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].NotifyWatcherId = id
+	}
+	return result
+}
+
+func (api *HostFirewallerAPI) watchOne(ctx context.Context, tag string) (_ string, err error) {
+	machineTag, err := api.machineTag(tag)
+	if err != nil {
+		return "", err
+	}
+
+	// The watchers started so far are stopped if a later one cannot be
+	// started, since nothing else owns them until the multi-watcher does.
+	var started []worker.Worker
+	defer func() {
+		if err != nil {
+			for _, w := range started {
+				_ = worker.Stop(w)
+			}
+		}
+	}()
+
+	configWatcher, err := api.modelConfigService.Watch(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	started = append(started, configWatcher)
+	portsWatcher, err := api.portService.WatchOpenedPorts(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	started = append(started, portsWatcher)
+	unitsWatcher, err := api.applicationService.WatchUnitAddRemoveOnMachine(ctx, machine.Name(machineTag.Id()))
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	started = append(started, unitsWatcher)
+	subnetsWatcher, err := api.networkService.WatchSubnets(ctx, set.NewStrings())
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	started = append(started, subnetsWatcher)
+	exposedWatcher, err := api.applicationService.WatchAllApplicationsExposed(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	started = append(started, exposedWatcher)
+	relationsWatcher, err := api.relationService.WatchAllRelations(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	started = append(started, relationsWatcher)
+	addressesWatcher, err := api.applicationService.WatchAllUnitAddresses(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	started = append(started, addressesWatcher)
+
+	watchers := []eventsource.Watcher[struct{}]{exposedWatcher, relationsWatcher, addressesWatcher}
+	for _, w := range []watcher.StringsWatcher{configWatcher, portsWatcher, unitsWatcher, subnetsWatcher} {
+		notifyWatcher, err := watcher.Normalise(w)
+		if err != nil {
+			return "", errors.Capture(err)
+		}
+		started = append(started, notifyWatcher)
+		watchers = append(watchers, notifyWatcher)
+	}
+
+	w, err := eventsource.NewMultiNotifyWatcher(ctx, watchers...)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	id, _, err := internal.EnsureRegisterWatcher(ctx, api.watcherRegistry, w)
+	return id, errors.Capture(err)
+}
+
+// HostFirewallRules returns the inbound traffic to accept on each given
+// machine, along with the backend configured to enforce it.
+func (api *HostFirewallerAPI) HostFirewallRules(ctx context.Context, args params.Entities) (params.HostFirewallRulesResults, error) {
+	result := params.HostFirewallRulesResults{
+		Results: make([]params.HostFirewallRulesResult, len(args.Entities)),
+	}
+	if len(args.Entities) == 0 {
+		return result, nil
+	}
+
+	cfg, err := api.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return result, errors.Capture(err)
+	}
+	spaces, err := api.networkService.GetAllSpaces(ctx)
+	if err != nil {
+		return result, errors.Capture(err)
+	}
+	exposed, err := api.applicationService.GetAllExposedEndpoints(ctx)
+	if err != nil {
+		return result, errors.Capture(err)
+	}
+	info := &modelInfo{
+		spaces:                spaces,
+		exposed:               exposed,
+		relationNetworkPolicy: cfg.RelationNetworkPolicy(),
+		relatedCIDRs:          make(map[string][]string),
+	}
+
+	// Connections from the model's subnets are accepted on any port,
+	// unless units only accept connections from their related units.
+	var modelCIDRs []string
+	if info.relationNetworkPolicy {
+		info.relations, err = api.relationService.GetAllRelationDetails(ctx)
+		if err != nil {
+			return result, errors.Capture(err)
+		}
+	} else {
+		subnets, err := spaces.AllSubnetInfos()
+		if err != nil {
+			return result, errors.Capture(err)
+		}
+		cidrs := set.NewStrings()
+		for _, subnet := range subnets {
+			cidrs.Add(subnet.CIDR)
+		}
+		modelCIDRs = cidrs.SortedValues()
+	}
+
+	for i, entity := range args.Entities {
+		rules, err := api.ingressRules(ctx, entity.Tag, info)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i] = params.HostFirewallRulesResult{
+			Backend:      cfg.HostFirewall(),
+			IngressRules: rules,
+			ModelCIDRs:   modelCIDRs,
+		}
+	}
+	return result, nil
+}
+
+// ingressRules returns the ingress rules for the ports opened by the units
+// on the machine, for the CIDRs they are exposed to and, when the relation
+// network policy is enabled, the units related to them.
+func (api *HostFirewallerAPI) ingressRules(ctx context.Context, tag string, info *modelInfo) ([]params.IngressRule, error) {
+	machineTag, err := api.machineTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	machineUUID, err := api.machineService.GetMachineUUID(ctx, machine.Name(machineTag.Id()))
+	if errors.Is(err, machineerrors.MachineNotFound) {
+		return nil, errors.Errorf("machine %q not found", machineTag.Id()).Add(coreerrors.NotFound)
+	} else if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	openedPorts, err := api.portService.GetMachineOpenedPorts(ctx, machineUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rules firewall.IngressRules
+	for unitName, portRanges := range openedPorts {
+		if exposedEndpoints, ok := info.exposed[unitName.Application()]; ok {
+			rules = append(rules, ingressRulesForExposedUnit(exposedEndpoints, portRanges, info.spaces)...)
+		}
+		if info.relationNetworkPolicy {
+			relatedRules, err := api.ingressRulesForRelatedUnits(ctx, unitName, portRanges, info)
+			if err != nil {
+				return nil, errors.Capture(err)
+			}
+			rules = append(rules, relatedRules...)
+		}
+	}
+	rules = rules.UniqueRules()
+	sort.Slice(rules, func(i, j int) bool { return rules[i].LessThan(rules[j]) })
+
+	result := make([]params.IngressRule, len(rules))
+	for i, rule := range rules {
+		result[i] = params.IngressRule{
+			PortRange:   params.FromNetworkPortRange(rule.PortRange),
+			SourceCIDRs: rule.SourceCIDRs.SortedValues(),
+		}
+	}
+	return result, nil
+}
+
+// ingressRulesForRelatedUnits returns the ingress rules allowing the units
+// related to a unit to connect to the port ranges it opened. The port ranges
+// opened for an endpoint are only accessible through the relations of that
+// endpoint, while those opened for all endpoints are accessible through all
+// relations. Relations which are suspended or not alive are ignored.
+func (api *HostFirewallerAPI) ingressRulesForRelatedUnits(
+	ctx context.Context,
+	unitName coreunit.Name,
+	portRanges network.GroupedPortRanges,
+	info *modelInfo,
+) (firewall.IngressRules, error) {
+	appName := unitName.Application()
+
+	var rules firewall.IngressRules
+	for _, rel := range info.relations {
+		if rel.Life != life.Alive || rel.Suspended {
+			continue
+		}
+
+		var endpointName string
+		var relatedApps []string
+		for _, ep := range rel.Endpoints {
+			if ep.ApplicationName == appName {
+				endpointName = ep.Name
+			} else {
+				relatedApps = append(relatedApps, ep.ApplicationName)
+			}
+		}
+		if endpointName == "" {
+			continue
+		}
+		if len(rel.Endpoints) == 1 {
+			// Units of a peer relation are related to each other.
+			relatedApps = []string{appName}
+		}
+
+		cidrs := set.NewStrings()
+		for _, relatedApp := range relatedApps {
+			relatedCIDRs, err := api.relatedCIDRs(ctx, rel.UUID, relatedApp, info)
+			if err != nil {
+				return nil, errors.Capture(err)
+			}
+			cidrs = cidrs.Union(set.NewStrings(relatedCIDRs...))
+		}
+		if cidrs.IsEmpty() {
+			continue
+		}
+
+		for _, portRange := range append(portRanges[endpointName], portRanges[""]...) {
+			rules = append(rules, firewall.NewIngressRule(portRange, cidrs.Values()...))
+		}
+	}
+	return rules, nil
+}
+
+// relatedCIDRs returns the host CIDRs of the private addresses of the units
+// of the application in scope of the relation. Units without a private
+// address are ignored.
+func (api *HostFirewallerAPI) relatedCIDRs(
+	ctx context.Context,
+	relationUUID corerelation.UUID,
+	appName string,
+	info *modelInfo,
+) ([]string, error) {
+	key := relationUUID.String() + "/" + appName
+	if cidrs, ok := info.relatedCIDRs[key]; ok {
+		return cidrs, nil
+	}
+
+	appUUID, err := api.applicationService.GetApplicationUUIDByName(ctx, appName)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		info.relatedCIDRs[key] = nil
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Capture(err)
+	}
+	unitNames, err := api.relationService.GetInScopeUnits(ctx, appUUID, relationUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var cidrs []string
+	for _, unitName := range unitNames {
+		addr, err := api.networkService.GetUnitPrivateAddress(ctx, unitName)
+		if network.IsNoAddressError(err) || errors.Is(err, applicationerrors.UnitNotFound) {
+			continue
+		} else if err != nil {
+			return nil, errors.Capture(err)
+		}
+		switch addr.AddressType() {
+		case network.IPv4Address:
+			cidrs = append(cidrs, addr.Value+"/32")
+		case network.IPv6Address:
+			cidrs = append(cidrs, addr.Value+"/128")
+		}
+	}
+	info.relatedCIDRs[key] = cidrs
+	return cidrs, nil
+}
+
+// ingressRulesForExposedUnit returns the ingress rules for the port ranges
+// opened by a unit, according to the expose settings of its application.
+// A named endpoint gets both the port ranges opened for it and for all
+// endpoints, while the wildcard entry applies to every endpoint without
+// expose settings of its own.
+func ingressRulesForExposedUnit(
+	exposedEndpoints map[string]application.ExposedEndpoint,
+	portRanges network.GroupedPortRanges,
+	spaces network.SpaceInfos,
+) firewall.IngressRules {
+	var rules firewall.IngressRules
+	for exposedEndpoint, exposeDetails := range exposedEndpoints {
+		cidrs := set.NewStrings(exposeDetails.ExposeToCIDRs.Values()...)
+		for _, spaceID := range exposeDetails.ExposeToSpaceIDs.Values() {
+			sp := spaces.GetByID(network.SpaceUUID(spaceID))
+			if sp == nil {
+				continue
+			}
+			for _, subnet := range sp.Subnets {
+				cidrs.Add(subnet.CIDR)
+			}
+		}
+		if cidrs.IsEmpty() {
+			continue
+		}
+
+		for endpointName, endpointPortRanges := range portRanges {
+			switch {
+			case exposedEndpoint == "":
+				// This endpoint has its own entry which overrides the
+				// wildcard entry.
+				if _, hasExposeOverride := exposedEndpoints[endpointName]; hasExposeOverride && endpointName != "" {
+					continue
+				}
+			case endpointName != exposedEndpoint && endpointName != "":
+				continue
+			}
+			for _, portRange := range endpointPortRanges {
+				rules = append(rules, firewall.NewIngressRule(portRange, cidrs.Values()...))
+			}
+		}
+	}
+	return rules
+}
+
+// machineTag parses the tag and checks that the authenticated machine agent
+// can access it.
+func (api *HostFirewallerAPI) machineTag(tag string) (names.MachineTag, error) {
+	machineTag, err := names.ParseMachineTag(tag)
+	if err != nil {
+		return names.MachineTag{}, apiservererrors.ErrPerm
+	}
+	if !api.authorizer.AuthOwner(machineTag) {
+		return names.MachineTag{}, apiservererrors.ErrPerm
+	}
+	return machineTag, nil
+}
@@ -0,0 +1,324 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hostfirewaller
+
+import (
+	"testing"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/machine"
+	machinetesting "github.com/juju/juju/core/machine/testing"
+	"github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/deployment/charm"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/domain/relation"
+	"github.com/juju/juju/internal/errors"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+func TestHostFirewallerSuite(t *testing.T) {
+	tc.Run(t, &HostFirewallerSuite{})
+}
+
+type HostFirewallerSuite struct {
+	api *HostFirewallerAPI
+
+	machineService     *MockMachineService
+	portService        *MockPortService
+	applicationService *MockApplicationService
+	relationService    *MockRelationService
+	networkService     *MockNetworkService
+	modelConfigService *MockModelConfigService
+	watcherRegistry    *facademocks.MockWatcherRegistry
+}
+
+func (s *HostFirewallerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.machineService = NewMockMachineService(ctrl)
+	s.portService = NewMockPortService(ctrl)
+	s.applicationService = NewMockApplicationService(ctrl)
+	s.relationService = NewMockRelationService(ctrl)
+	s.networkService = NewMockNetworkService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	s.watcherRegistry = facademocks.NewMockWatcherRegistry(ctrl)
+
+	s.api = &HostFirewallerAPI{
+		machineService:     s.machineService,
+		portService:        s.portService,
+		applicationService: s.applicationService,
+		relationService:    s.relationService,
+		networkService:     s.networkService,
+		modelConfigService: s.modelConfigService,
+		watcherRegistry:    s.watcherRegistry,
+		authorizer:         &apiservertesting.FakeAuthorizer{Tag: names.NewMachineTag("0")},
+	}
+
+	c.Cleanup(func() {
+		s.api = nil
+		s.machineService = nil
+		s.portService = nil
+		s.applicationService = nil
+		s.relationService = nil
+		s.networkService = nil
+		s.modelConfigService = nil
+		s.watcherRegistry = nil
+	})
+
+	return ctrl
+}
+
+func (s *HostFirewallerSuite) expectModel(c *tc.C, exposed map[string]map[string]application.ExposedEndpoint) {
+	s.expectModelWithConfig(c, exposed, coretesting.Attrs{
+		"host-firewall": "nftables",
+	})
+}
+
+func (s *HostFirewallerSuite) expectModelWithConfig(c *tc.C, exposed map[string]map[string]application.ExposedEndpoint, attrs coretesting.Attrs) {
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(coretesting.CustomModelConfig(c, attrs), nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(network.SpaceInfos{{
+		ID:   "space0-uuid",
+		Name: "space0",
+		Subnets: network.SubnetInfos{
+			{CIDR: "10.0.0.0/24"},
+			{CIDR: "10.0.1.0/24"},
+		},
+	}, {
+		ID:   "space1-uuid",
+		Name: "space1",
+		Subnets: network.SubnetInfos{
+			{CIDR: "192.168.0.0/24"},
+			{CIDR: "10.0.0.0/24"},
+		},
+	}}, nil)
+	s.applicationService.EXPECT().GetAllExposedEndpoints(gomock.Any()).Return(exposed, nil)
+}
+
+func (s *HostFirewallerSuite) TestHostFirewallRules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	machineUUID := machinetesting.GenUUID(c)
+	s.expectModel(c, map[string]map[string]application.ExposedEndpoint{
+		"mysql": {
+			"": {ExposeToCIDRs: set.NewStrings("0.0.0.0/0")},
+		},
+		"wordpress": {
+			"website": {ExposeToSpaceIDs: set.NewStrings("space1-uuid")},
+		},
+	})
+	s.machineService.EXPECT().GetMachineUUID(gomock.Any(), machine.Name("0")).Return(machineUUID, nil)
+	s.portService.EXPECT().GetMachineOpenedPorts(gomock.Any(), machineUUID).Return(map[coreunit.Name]network.GroupedPortRanges{
+		"mysql/0": {
+			"db": {network.MustParsePortRange("3306/tcp")},
+		},
+		"wordpress/0": {
+			"website": {network.MustParsePortRange("80/tcp")},
+			"admin":   {network.MustParsePortRange("8080/tcp")},
+			"":        {network.MustParsePortRange("icmp")},
+		},
+		"ubuntu/0": {
+			"": {network.MustParsePortRange("22/tcp")},
+		},
+	}, nil)
+
+	result, err := s.api.HostFirewallRules(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.HostFirewallRulesResults{
+		Results: []params.HostFirewallRulesResult{{
+			Backend: "nftables",
+			IngressRules: []params.IngressRule{{
+				PortRange:   params.PortRange{FromPort: -1, ToPort: -1, Protocol: "icmp"},
+				SourceCIDRs: []string{"10.0.0.0/24", "192.168.0.0/24"},
+			}, {
+				PortRange:   params.PortRange{FromPort: 80, ToPort: 80, Protocol: "tcp"},
+				SourceCIDRs: []string{"10.0.0.0/24", "192.168.0.0/24"},
+			}, {
+				PortRange:   params.PortRange{FromPort: 3306, ToPort: 3306, Protocol: "tcp"},
+				SourceCIDRs: []string{"0.0.0.0/0"},
+			}},
+			ModelCIDRs: []string{"10.0.0.0/24", "10.0.1.0/24", "192.168.0.0/24"},
+		}},
+	})
+}
+
+func (s *HostFirewallerSuite) TestHostFirewallRulesRelationNetworkPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	machineUUID := machinetesting.GenUUID(c)
+	s.expectModelWithConfig(c, map[string]map[string]application.ExposedEndpoint{
+		"wordpress": {
+			"": {ExposeToCIDRs: set.NewStrings("0.0.0.0/0")},
+		},
+	}, coretesting.Attrs{
+		"host-firewall":           "nftables",
+		"relation-network-policy": true,
+	})
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return([]relation.RelationDetailsResult{{
+		UUID: "rel-db",
+		Life: life.Alive,
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "mysql", Relation: charm.Relation{Name: "db"}},
+			{ApplicationName: "wordpress", Relation: charm.Relation{Name: "db"}},
+		},
+	}, {
+		UUID: "rel-cluster",
+		Life: life.Alive,
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "mysql", Relation: charm.Relation{Name: "cluster"}},
+		},
+	}, {
+		UUID:      "rel-suspended",
+		Life:      life.Alive,
+		Suspended: true,
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "mysql", Relation: charm.Relation{Name: "db"}},
+			{ApplicationName: "backup", Relation: charm.Relation{Name: "db"}},
+		},
+	}, {
+		UUID: "rel-other",
+		Life: life.Alive,
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "wordpress", Relation: charm.Relation{Name: "cache"}},
+			{ApplicationName: "memcached", Relation: charm.Relation{Name: "cache"}},
+		},
+	}}, nil)
+	s.machineService.EXPECT().GetMachineUUID(gomock.Any(), machine.Name("0")).Return(machineUUID, nil)
+	s.portService.EXPECT().GetMachineOpenedPorts(gomock.Any(), machineUUID).Return(map[coreunit.Name]network.GroupedPortRanges{
+		"mysql/0": {
+			"db":      {network.MustParsePortRange("3306/tcp")},
+			"cluster": {network.MustParsePortRange("4567/tcp")},
+			"":        {network.MustParsePortRange("9104/tcp")},
+		},
+	}, nil)
+	s.applicationService.EXPECT().GetApplicationUUIDByName(gomock.Any(), "wordpress").Return("wordpress-uuid", nil)
+	s.applicationService.EXPECT().GetApplicationUUIDByName(gomock.Any(), "mysql").Return("mysql-uuid", nil)
+	s.relationService.EXPECT().GetInScopeUnits(gomock.Any(), coreapplication.UUID("wordpress-uuid"), corerelation.UUID("rel-db")).Return([]coreunit.Name{"wordpress/0", "wordpress/1"}, nil)
+	s.relationService.EXPECT().GetInScopeUnits(gomock.Any(), coreapplication.UUID("mysql-uuid"), corerelation.UUID("rel-cluster")).Return([]coreunit.Name{"mysql/0", "mysql/1"}, nil)
+	s.networkService.EXPECT().GetUnitPrivateAddress(gomock.Any(), coreunit.Name("wordpress/0")).Return(network.NewSpaceAddress("10.0.0.10"), nil)
+	s.networkService.EXPECT().GetUnitPrivateAddress(gomock.Any(), coreunit.Name("wordpress/1")).Return(network.SpaceAddress{}, network.NoAddressError("private"))
+	s.networkService.EXPECT().GetUnitPrivateAddress(gomock.Any(), coreunit.Name("mysql/0")).Return(network.NewSpaceAddress("10.0.0.20"), nil)
+	s.networkService.EXPECT().GetUnitPrivateAddress(gomock.Any(), coreunit.Name("mysql/1")).Return(network.NewSpaceAddress("2001:db8::21"), nil)
+
+	result, err := s.api.HostFirewallRules(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.HostFirewallRulesResults{
+		Results: []params.HostFirewallRulesResult{{
+			Backend: "nftables",
+			IngressRules: []params.IngressRule{{
+				PortRange:   params.PortRange{FromPort: 3306, ToPort: 3306, Protocol: "tcp"},
+				SourceCIDRs: []string{"10.0.0.10/32"},
+			}, {
+				PortRange:   params.PortRange{FromPort: 4567, ToPort: 4567, Protocol: "tcp"},
+				SourceCIDRs: []string{"10.0.0.20/32", "2001:db8::21/128"},
+			}, {
+				PortRange:   params.PortRange{FromPort: 9104, ToPort: 9104, Protocol: "tcp"},
+				SourceCIDRs: []string{"10.0.0.10/32"},
+			}, {
+				PortRange:   params.PortRange{FromPort: 9104, ToPort: 9104, Protocol: "tcp"},
+				SourceCIDRs: []string{"10.0.0.20/32", "2001:db8::21/128"},
+			}},
+		}},
+	})
+}
+
+func (s *HostFirewallerSuite) TestHostFirewallRulesMachineNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModel(c, nil)
+	s.machineService.EXPECT().GetMachineUUID(gomock.Any(), machine.Name("0")).Return("", machineerrors.MachineNotFound)
+
+	result, err := s.api.HostFirewallRules(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Assert(result.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *HostFirewallerSuite) TestHostFirewallRulesPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModel(c, nil)
+
+	result, err := s.api.HostFirewallRules(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-1"}, {Tag: "unit-mysql-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Assert(result.Results[0].Error, tc.DeepEquals, apiservertesting.ErrUnauthorized)
+	c.Assert(result.Results[1].Error, tc.DeepEquals, apiservertesting.ErrUnauthorized)
+}
+
+func (s *HostFirewallerSuite) TestWatchHostFirewallRules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	newStringsWatcher := func() *watchertest.MockStringsWatcher {
+		ch := make(chan []string, 1)
+		ch <- []string{}
+		return watchertest.NewMockStringsWatcher(ch)
+	}
+	newNotifyWatcher := func() *watchertest.MockNotifyWatcher {
+		ch := make(chan struct{}, 1)
+		ch <- struct{}{}
+		return watchertest.NewMockNotifyWatcher(ch)
+	}
+
+	s.modelConfigService.EXPECT().Watch(gomock.Any()).Return(newStringsWatcher(), nil)
+	s.portService.EXPECT().WatchOpenedPorts(gomock.Any()).Return(newStringsWatcher(), nil)
+	s.applicationService.EXPECT().WatchUnitAddRemoveOnMachine(gomock.Any(), machine.Name("0")).Return(newStringsWatcher(), nil)
+	s.networkService.EXPECT().WatchSubnets(gomock.Any(), set.NewStrings()).Return(newStringsWatcher(), nil)
+	s.applicationService.EXPECT().WatchAllApplicationsExposed(gomock.Any()).Return(newNotifyWatcher(), nil)
+	s.relationService.EXPECT().WatchAllRelations(gomock.Any()).Return(newNotifyWatcher(), nil)
+	s.applicationService.EXPECT().WatchAllUnitAddresses(gomock.Any()).Return(newNotifyWatcher(), nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), gomock.Any()).Return("42", nil)
+
+	result := s.api.WatchHostFirewallRules(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}, {Tag: "machine-1"}},
+	})
+	c.Assert(result, tc.DeepEquals, params.NotifyWatchResults{
+		Results: []params.NotifyWatchResult{
+			{NotifyWatcherId: "42"},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+}
+
+func (s *HostFirewallerSuite) TestWatchHostFirewallRulesStopsStartedWatchers(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	configWatcher := watchertest.NewMockStringsWatcher(make(chan []string))
+	portsWatcher := watchertest.NewMockStringsWatcher(make(chan []string))
+	s.modelConfigService.EXPECT().Watch(gomock.Any()).Return(configWatcher, nil)
+	s.portService.EXPECT().WatchOpenedPorts(gomock.Any()).Return(portsWatcher, nil)
+	s.applicationService.EXPECT().WatchUnitAddRemoveOnMachine(gomock.Any(), machine.Name("0")).
+		Return(nil, errors.New("boom"))
+
+	result := s.api.WatchHostFirewallRules(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}},
+	})
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.ErrorMatches, "boom")
+
+	// The watchers started before the failure are stopped.
+	workertest.CheckKilled(c, configWatcher)
+	workertest.CheckKilled(c, portsWatcher)
+}
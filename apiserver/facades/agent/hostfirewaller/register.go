@@ -0,0 +1,39 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hostfirewaller
+
+import (
+	"context"
+	"reflect"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("HostFirewaller", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newHostFirewallerAPI(ctx)
+	}, reflect.TypeFor[*HostFirewallerAPI]())
+}
+
+// newHostFirewallerAPI creates a new server-side HostFirewaller API facade.
+func newHostFirewallerAPI(ctx facade.ModelContext) (*HostFirewallerAPI, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthMachineAgent() {
+		return nil, apiservererrors.ErrPerm
+	}
+
+	domainServices := ctx.DomainServices()
+	return &HostFirewallerAPI{
+		machineService:     domainServices.Machine(),
+		portService:        domainServices.Port(),
+		applicationService: domainServices.Application(),
+		networkService:     domainServices.Network(),
+		relationService:    domainServices.Relation(),
+		modelConfigService: domainServices.Config(),
+		watcherRegistry:    ctx.WatcherRegistry(),
+		authorizer:         authorizer,
+	}, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hostfirewaller
+
+import (
+	"context"
+
+	"github.com/juju/collections/set"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/relation"
+	"github.com/juju/juju/environs/config"
+)
+
+// MachineService provides access to the machines in the model.
+type MachineService interface {
+	// GetMachineUUID returns the UUID of a machine identified by its name.
+	GetMachineUUID(ctx context.Context, name machine.Name) (machine.UUID, error)
+}
+
+// PortService provides access to the ports opened by units.
+type PortService interface {
+	// GetMachineOpenedPorts returns the opened ports for all the units on the
+	// given machine. Opened ports are grouped first by unit name and then by
+	// endpoint.
+	GetMachineOpenedPorts(ctx context.Context, machineUUID machine.UUID) (map[coreunit.Name]network.GroupedPortRanges, error)
+
+	// WatchOpenedPorts returns a strings watcher for opened ports. This
+	// watcher emits events for changes to the opened ports table.
+	WatchOpenedPorts(ctx context.Context) (watcher.StringsWatcher, error)
+}
+
+// ApplicationService provides access to the expose settings of applications
+// and the units on a machine.
+type ApplicationService interface {
+	// GetApplicationUUIDByName returns an application UUID by application
+	// name.
+	GetApplicationUUIDByName(ctx context.Context, name string) (coreapplication.UUID, error)
+
+	// GetAllExposedEndpoints returns all exposed endpoints in the model,
+	// grouped by application name and endpoint name.
+	GetAllExposedEndpoints(ctx context.Context) (map[string]map[string]application.ExposedEndpoint, error)
+
+	// WatchAllApplicationsExposed watches for changes to the exposed
+	// endpoints of every application in the model.
+	WatchAllApplicationsExposed(ctx context.Context) (watcher.NotifyWatcher, error)
+
+	// WatchUnitAddRemoveOnMachine returns a watcher that emits the names of
+	// the units added to or removed from the specified machine.
+	WatchUnitAddRemoveOnMachine(ctx context.Context, machineName machine.Name) (watcher.StringsWatcher, error)
+
+	// WatchAllUnitAddresses watches for changes to the addresses of any unit
+	// in the model.
+	WatchAllUnitAddresses(ctx context.Context) (watcher.NotifyWatcher, error)
+}
+
+// RelationService provides access to the relations between applications.
+type RelationService interface {
+	// GetAllRelationDetails returns the details of all relations in the
+	// model.
+	GetAllRelationDetails(ctx context.Context) ([]relation.RelationDetailsResult, error)
+
+	// GetInScopeUnits returns the units of an application that are in scope
+	// for the given relation.
+	GetInScopeUnits(ctx context.Context, applicationUUID coreapplication.UUID, relationUUID corerelation.UUID) ([]coreunit.Name, error)
+
+	// WatchAllRelations returns a watcher that notifies when any relation in
+	// the model changes, or units enter or leave the scope of a relation.
+	WatchAllRelations(ctx context.Context) (watcher.NotifyWatcher, error)
+}
+
+// NetworkService provides access to the spaces and subnets of the model.
+type NetworkService interface {
+	// GetAllSpaces returns all spaces for the model.
+	GetAllSpaces(ctx context.Context) (network.SpaceInfos, error)
+
+	// GetUnitPrivateAddress returns the private address for the specified
+	// unit.
+	GetUnitPrivateAddress(ctx context.Context, unitName coreunit.Name) (network.SpaceAddress, error)
+
+	// WatchSubnets returns a watcher that observes changes to subnets and
+	// their association, filtered based on the provided list of subnets to
+	// watch. An empty list watches all subnets.
+	WatchSubnets(ctx context.Context, subnetUUIDsToWatch set.Strings) (watcher.StringsWatcher, error)
+}
+
+// ModelConfigService provides access to the model's configuration.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(context.Context) (*config.Config, error)
+
+	// Watch returns a watcher that returns keys for any changes to model
+	// config.
+	Watch(context.Context) (watcher.StringsWatcher, error)
+}
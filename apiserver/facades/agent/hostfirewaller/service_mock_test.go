@@ -0,0 +1,789 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/agent/hostfirewaller (interfaces: MachineService,PortService,ApplicationService,RelationService,NetworkService,ModelConfigService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package hostfirewaller -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/hostfirewaller MachineService,PortService,ApplicationService,RelationService,NetworkService,ModelConfigService
+//
+
+// Package hostfirewaller is a generated GoMock package.
+package hostfirewaller
+
+import (
+	context "context"
+	reflect "reflect"
+
+	set "github.com/juju/collections/set"
+	application "github.com/juju/juju/core/application"
+	machine "github.com/juju/juju/core/machine"
+	network "github.com/juju/juju/core/network"
+	relation "github.com/juju/juju/core/relation"
+	unit "github.com/juju/juju/core/unit"
+	watcher "github.com/juju/juju/core/watcher"
+	application0 "github.com/juju/juju/domain/application"
+	relation0 "github.com/juju/juju/domain/relation"
+	config "github.com/juju/juju/environs/config"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMachineService is a mock of MachineService interface.
+type MockMachineService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMachineServiceMockRecorder
+}
+
+// MockMachineServiceMockRecorder is the mock recorder for MockMachineService.
+type MockMachineServiceMockRecorder struct {
+	mock *MockMachineService
+}
+
+// NewMockMachineService creates a new mock instance.
+func NewMockMachineService(ctrl *gomock.Controller) *MockMachineService {
+	mock := &MockMachineService{ctrl: ctrl}
+	mock.recorder = &MockMachineServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMachineService) EXPECT() *MockMachineServiceMockRecorder {
+	return m.recorder
+}
+
+// GetMachineUUID mocks base method.
+func (m *MockMachineService) GetMachineUUID(arg0 context.Context, arg1 machine.Name) (machine.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineUUID", arg0, arg1)
+	ret0, _ := ret[0].(machine.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineUUID indicates an expected call of GetMachineUUID.
+func (mr *MockMachineServiceMockRecorder) GetMachineUUID(arg0, arg1 any) *MockMachineServiceGetMachineUUIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineUUID", reflect.TypeOf((*MockMachineService)(nil).GetMachineUUID), arg0, arg1)
+	return &MockMachineServiceGetMachineUUIDCall{Call: call}
+}
+
+// MockMachineServiceGetMachineUUIDCall wrap *gomock.Call
+type MockMachineServiceGetMachineUUIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceGetMachineUUIDCall) Return(arg0 machine.UUID, arg1 error) *MockMachineServiceGetMachineUUIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceGetMachineUUIDCall) Do(f func(context.Context, machine.Name) (machine.UUID, error)) *MockMachineServiceGetMachineUUIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceGetMachineUUIDCall) DoAndReturn(f func(context.Context, machine.Name) (machine.UUID, error)) *MockMachineServiceGetMachineUUIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockPortService is a mock of PortService interface.
+type MockPortService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPortServiceMockRecorder
+}
+
+// MockPortServiceMockRecorder is the mock recorder for MockPortService.
+type MockPortServiceMockRecorder struct {
+	mock *MockPortService
+}
+
+// NewMockPortService creates a new mock instance.
+func NewMockPortService(ctrl *gomock.Controller) *MockPortService {
+	mock := &MockPortService{ctrl: ctrl}
+	mock.recorder = &MockPortServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPortService) EXPECT() *MockPortServiceMockRecorder {
+	return m.recorder
+}
+
+// GetMachineOpenedPorts mocks base method.
+func (m *MockPortService) GetMachineOpenedPorts(arg0 context.Context, arg1 machine.UUID) (map[unit.Name]network.GroupedPortRanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineOpenedPorts", arg0, arg1)
+	ret0, _ := ret[0].(map[unit.Name]network.GroupedPortRanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineOpenedPorts indicates an expected call of GetMachineOpenedPorts.
+func (mr *MockPortServiceMockRecorder) GetMachineOpenedPorts(arg0, arg1 any) *MockPortServiceGetMachineOpenedPortsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineOpenedPorts", reflect.TypeOf((*MockPortService)(nil).GetMachineOpenedPorts), arg0, arg1)
+	return &MockPortServiceGetMachineOpenedPortsCall{Call: call}
+}
+
+// MockPortServiceGetMachineOpenedPortsCall wrap *gomock.Call
+type MockPortServiceGetMachineOpenedPortsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPortServiceGetMachineOpenedPortsCall) Return(arg0 map[unit.Name]network.GroupedPortRanges, arg1 error) *MockPortServiceGetMachineOpenedPortsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPortServiceGetMachineOpenedPortsCall) Do(f func(context.Context, machine.UUID) (map[unit.Name]network.GroupedPortRanges, error)) *MockPortServiceGetMachineOpenedPortsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPortServiceGetMachineOpenedPortsCall) DoAndReturn(f func(context.Context, machine.UUID) (map[unit.Name]network.GroupedPortRanges, error)) *MockPortServiceGetMachineOpenedPortsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchOpenedPorts mocks base method.
+func (m *MockPortService) WatchOpenedPorts(arg0 context.Context) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchOpenedPorts", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchOpenedPorts indicates an expected call of WatchOpenedPorts.
+func (mr *MockPortServiceMockRecorder) WatchOpenedPorts(arg0 any) *MockPortServiceWatchOpenedPortsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchOpenedPorts", reflect.TypeOf((*MockPortService)(nil).WatchOpenedPorts), arg0)
+	return &MockPortServiceWatchOpenedPortsCall{Call: call}
+}
+
+// MockPortServiceWatchOpenedPortsCall wrap *gomock.Call
+type MockPortServiceWatchOpenedPortsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPortServiceWatchOpenedPortsCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockPortServiceWatchOpenedPortsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPortServiceWatchOpenedPortsCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockPortServiceWatchOpenedPortsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPortServiceWatchOpenedPortsCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockPortServiceWatchOpenedPortsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock *MockApplicationService
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllExposedEndpoints mocks base method.
+func (m *MockApplicationService) GetAllExposedEndpoints(arg0 context.Context) (map[string]map[string]application0.ExposedEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllExposedEndpoints", arg0)
+	ret0, _ := ret[0].(map[string]map[string]application0.ExposedEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllExposedEndpoints indicates an expected call of GetAllExposedEndpoints.
+func (mr *MockApplicationServiceMockRecorder) GetAllExposedEndpoints(arg0 any) *MockApplicationServiceGetAllExposedEndpointsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllExposedEndpoints", reflect.TypeOf((*MockApplicationService)(nil).GetAllExposedEndpoints), arg0)
+	return &MockApplicationServiceGetAllExposedEndpointsCall{Call: call}
+}
+
+// MockApplicationServiceGetAllExposedEndpointsCall wrap *gomock.Call
+type MockApplicationServiceGetAllExposedEndpointsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetAllExposedEndpointsCall) Return(arg0 map[string]map[string]application0.ExposedEndpoint, arg1 error) *MockApplicationServiceGetAllExposedEndpointsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetAllExposedEndpointsCall) Do(f func(context.Context) (map[string]map[string]application0.ExposedEndpoint, error)) *MockApplicationServiceGetAllExposedEndpointsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetAllExposedEndpointsCall) DoAndReturn(f func(context.Context) (map[string]map[string]application0.ExposedEndpoint, error)) *MockApplicationServiceGetAllExposedEndpointsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationUUIDByName mocks base method.
+func (m *MockApplicationService) GetApplicationUUIDByName(arg0 context.Context, arg1 string) (application.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationUUIDByName", arg0, arg1)
+	ret0, _ := ret[0].(application.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationUUIDByName indicates an expected call of GetApplicationUUIDByName.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationUUIDByName(arg0, arg1 any) *MockApplicationServiceGetApplicationUUIDByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationUUIDByName", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationUUIDByName), arg0, arg1)
+	return &MockApplicationServiceGetApplicationUUIDByNameCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationUUIDByNameCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationUUIDByNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationUUIDByNameCall) Return(arg0 application.UUID, arg1 error) *MockApplicationServiceGetApplicationUUIDByNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationUUIDByNameCall) Do(f func(context.Context, string) (application.UUID, error)) *MockApplicationServiceGetApplicationUUIDByNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationUUIDByNameCall) DoAndReturn(f func(context.Context, string) (application.UUID, error)) *MockApplicationServiceGetApplicationUUIDByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchAllApplicationsExposed mocks base method.
+func (m *MockApplicationService) WatchAllApplicationsExposed(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchAllApplicationsExposed", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchAllApplicationsExposed indicates an expected call of WatchAllApplicationsExposed.
+func (mr *MockApplicationServiceMockRecorder) WatchAllApplicationsExposed(arg0 any) *MockApplicationServiceWatchAllApplicationsExposedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchAllApplicationsExposed", reflect.TypeOf((*MockApplicationService)(nil).WatchAllApplicationsExposed), arg0)
+	return &MockApplicationServiceWatchAllApplicationsExposedCall{Call: call}
+}
+
+// MockApplicationServiceWatchAllApplicationsExposedCall wrap *gomock.Call
+type MockApplicationServiceWatchAllApplicationsExposedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceWatchAllApplicationsExposedCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockApplicationServiceWatchAllApplicationsExposedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceWatchAllApplicationsExposedCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchAllApplicationsExposedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceWatchAllApplicationsExposedCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchAllApplicationsExposedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchAllUnitAddresses mocks base method.
+func (m *MockApplicationService) WatchAllUnitAddresses(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchAllUnitAddresses", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchAllUnitAddresses indicates an expected call of WatchAllUnitAddresses.
+func (mr *MockApplicationServiceMockRecorder) WatchAllUnitAddresses(arg0 any) *MockApplicationServiceWatchAllUnitAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchAllUnitAddresses", reflect.TypeOf((*MockApplicationService)(nil).WatchAllUnitAddresses), arg0)
+	return &MockApplicationServiceWatchAllUnitAddressesCall{Call: call}
+}
+
+// MockApplicationServiceWatchAllUnitAddressesCall wrap *gomock.Call
+type MockApplicationServiceWatchAllUnitAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceWatchAllUnitAddressesCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockApplicationServiceWatchAllUnitAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceWatchAllUnitAddressesCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchAllUnitAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceWatchAllUnitAddressesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchAllUnitAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchUnitAddRemoveOnMachine mocks base method.
+func (m *MockApplicationService) WatchUnitAddRemoveOnMachine(arg0 context.Context, arg1 machine.Name) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchUnitAddRemoveOnMachine", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchUnitAddRemoveOnMachine indicates an expected call of WatchUnitAddRemoveOnMachine.
+func (mr *MockApplicationServiceMockRecorder) WatchUnitAddRemoveOnMachine(arg0, arg1 any) *MockApplicationServiceWatchUnitAddRemoveOnMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchUnitAddRemoveOnMachine", reflect.TypeOf((*MockApplicationService)(nil).WatchUnitAddRemoveOnMachine), arg0, arg1)
+	return &MockApplicationServiceWatchUnitAddRemoveOnMachineCall{Call: call}
+}
+
+// MockApplicationServiceWatchUnitAddRemoveOnMachineCall wrap *gomock.Call
+type MockApplicationServiceWatchUnitAddRemoveOnMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceWatchUnitAddRemoveOnMachineCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockApplicationServiceWatchUnitAddRemoveOnMachineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceWatchUnitAddRemoveOnMachineCall) Do(f func(context.Context, machine.Name) (watcher.Watcher[[]string], error)) *MockApplicationServiceWatchUnitAddRemoveOnMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceWatchUnitAddRemoveOnMachineCall) DoAndReturn(f func(context.Context, machine.Name) (watcher.Watcher[[]string], error)) *MockApplicationServiceWatchUnitAddRemoveOnMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockRelationService is a mock of RelationService interface.
+type MockRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelationServiceMockRecorder
+}
+
+// MockRelationServiceMockRecorder is the mock recorder for MockRelationService.
+type MockRelationServiceMockRecorder struct {
+	mock *MockRelationService
+}
+
+// NewMockRelationService creates a new mock instance.
+func NewMockRelationService(ctrl *gomock.Controller) *MockRelationService {
+	mock := &MockRelationService{ctrl: ctrl}
+	mock.recorder = &MockRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelationService) EXPECT() *MockRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllRelationDetails mocks base method.
+func (m *MockRelationService) GetAllRelationDetails(arg0 context.Context) ([]relation0.RelationDetailsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllRelationDetails", arg0)
+	ret0, _ := ret[0].([]relation0.RelationDetailsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllRelationDetails indicates an expected call of GetAllRelationDetails.
+func (mr *MockRelationServiceMockRecorder) GetAllRelationDetails(arg0 any) *MockRelationServiceGetAllRelationDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRelationDetails", reflect.TypeOf((*MockRelationService)(nil).GetAllRelationDetails), arg0)
+	return &MockRelationServiceGetAllRelationDetailsCall{Call: call}
+}
+
+// MockRelationServiceGetAllRelationDetailsCall wrap *gomock.Call
+type MockRelationServiceGetAllRelationDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRelationServiceGetAllRelationDetailsCall) Return(arg0 []relation0.RelationDetailsResult, arg1 error) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRelationServiceGetAllRelationDetailsCall) Do(f func(context.Context) ([]relation0.RelationDetailsResult, error)) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRelationServiceGetAllRelationDetailsCall) DoAndReturn(f func(context.Context) ([]relation0.RelationDetailsResult, error)) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetInScopeUnits mocks base method.
+func (m *MockRelationService) GetInScopeUnits(arg0 context.Context, arg1 application.UUID, arg2 relation.UUID) ([]unit.Name, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInScopeUnits", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]unit.Name)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInScopeUnits indicates an expected call of GetInScopeUnits.
+func (mr *MockRelationServiceMockRecorder) GetInScopeUnits(arg0, arg1, arg2 any) *MockRelationServiceGetInScopeUnitsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInScopeUnits", reflect.TypeOf((*MockRelationService)(nil).GetInScopeUnits), arg0, arg1, arg2)
+	return &MockRelationServiceGetInScopeUnitsCall{Call: call}
+}
+
+// MockRelationServiceGetInScopeUnitsCall wrap *gomock.Call
+type MockRelationServiceGetInScopeUnitsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRelationServiceGetInScopeUnitsCall) Return(arg0 []unit.Name, arg1 error) *MockRelationServiceGetInScopeUnitsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRelationServiceGetInScopeUnitsCall) Do(f func(context.Context, application.UUID, relation.UUID) ([]unit.Name, error)) *MockRelationServiceGetInScopeUnitsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRelationServiceGetInScopeUnitsCall) DoAndReturn(f func(context.Context, application.UUID, relation.UUID) ([]unit.Name, error)) *MockRelationServiceGetInScopeUnitsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchAllRelations mocks base method.
+func (m *MockRelationService) WatchAllRelations(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchAllRelations", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchAllRelations indicates an expected call of WatchAllRelations.
+func (mr *MockRelationServiceMockRecorder) WatchAllRelations(arg0 any) *MockRelationServiceWatchAllRelationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchAllRelations", reflect.TypeOf((*MockRelationService)(nil).WatchAllRelations), arg0)
+	return &MockRelationServiceWatchAllRelationsCall{Call: call}
+}
+
+// MockRelationServiceWatchAllRelationsCall wrap *gomock.Call
+type MockRelationServiceWatchAllRelationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRelationServiceWatchAllRelationsCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockRelationServiceWatchAllRelationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRelationServiceWatchAllRelationsCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockRelationServiceWatchAllRelationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRelationServiceWatchAllRelationsCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockRelationServiceWatchAllRelationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockNetworkService is a mock of NetworkService interface.
+type MockNetworkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkServiceMockRecorder
+}
+
+// MockNetworkServiceMockRecorder is the mock recorder for MockNetworkService.
+type MockNetworkServiceMockRecorder struct {
+	mock *MockNetworkService
+}
+
+// NewMockNetworkService creates a new mock instance.
+func NewMockNetworkService(ctrl *gomock.Controller) *MockNetworkService {
+	mock := &MockNetworkService{ctrl: ctrl}
+	mock.recorder = &MockNetworkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkService) EXPECT() *MockNetworkServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllSpaces mocks base method.
+func (m *MockNetworkService) GetAllSpaces(arg0 context.Context) (network.SpaceInfos, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSpaces", arg0)
+	ret0, _ := ret[0].(network.SpaceInfos)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSpaces indicates an expected call of GetAllSpaces.
+func (mr *MockNetworkServiceMockRecorder) GetAllSpaces(arg0 any) *MockNetworkServiceGetAllSpacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSpaces", reflect.TypeOf((*MockNetworkService)(nil).GetAllSpaces), arg0)
+	return &MockNetworkServiceGetAllSpacesCall{Call: call}
+}
+
+// MockNetworkServiceGetAllSpacesCall wrap *gomock.Call
+type MockNetworkServiceGetAllSpacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceGetAllSpacesCall) Return(arg0 network.SpaceInfos, arg1 error) *MockNetworkServiceGetAllSpacesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceGetAllSpacesCall) Do(f func(context.Context) (network.SpaceInfos, error)) *MockNetworkServiceGetAllSpacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceGetAllSpacesCall) DoAndReturn(f func(context.Context) (network.SpaceInfos, error)) *MockNetworkServiceGetAllSpacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetUnitPrivateAddress mocks base method.
+func (m *MockNetworkService) GetUnitPrivateAddress(arg0 context.Context, arg1 unit.Name) (network.SpaceAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitPrivateAddress", arg0, arg1)
+	ret0, _ := ret[0].(network.SpaceAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitPrivateAddress indicates an expected call of GetUnitPrivateAddress.
+func (mr *MockNetworkServiceMockRecorder) GetUnitPrivateAddress(arg0, arg1 any) *MockNetworkServiceGetUnitPrivateAddressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitPrivateAddress", reflect.TypeOf((*MockNetworkService)(nil).GetUnitPrivateAddress), arg0, arg1)
+	return &MockNetworkServiceGetUnitPrivateAddressCall{Call: call}
+}
+
+// MockNetworkServiceGetUnitPrivateAddressCall wrap *gomock.Call
+type MockNetworkServiceGetUnitPrivateAddressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceGetUnitPrivateAddressCall) Return(arg0 network.SpaceAddress, arg1 error) *MockNetworkServiceGetUnitPrivateAddressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceGetUnitPrivateAddressCall) Do(f func(context.Context, unit.Name) (network.SpaceAddress, error)) *MockNetworkServiceGetUnitPrivateAddressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceGetUnitPrivateAddressCall) DoAndReturn(f func(context.Context, unit.Name) (network.SpaceAddress, error)) *MockNetworkServiceGetUnitPrivateAddressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchSubnets mocks base method.
+func (m *MockNetworkService) WatchSubnets(arg0 context.Context, arg1 set.Strings) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSubnets", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchSubnets indicates an expected call of WatchSubnets.
+func (mr *MockNetworkServiceMockRecorder) WatchSubnets(arg0, arg1 any) *MockNetworkServiceWatchSubnetsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSubnets", reflect.TypeOf((*MockNetworkService)(nil).WatchSubnets), arg0, arg1)
+	return &MockNetworkServiceWatchSubnetsCall{Call: call}
+}
+
+// MockNetworkServiceWatchSubnetsCall wrap *gomock.Call
+type MockNetworkServiceWatchSubnetsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceWatchSubnetsCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockNetworkServiceWatchSubnetsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceWatchSubnetsCall) Do(f func(context.Context, set.Strings) (watcher.Watcher[[]string], error)) *MockNetworkServiceWatchSubnetsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceWatchSubnetsCall) DoAndReturn(f func(context.Context, set.Strings) (watcher.Watcher[[]string], error)) *MockNetworkServiceWatchSubnetsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock *MockModelConfigService
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(arg0 context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(arg0 any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockModelConfigService)(nil).ModelConfig), arg0)
+	return &MockModelConfigServiceModelConfigCall{Call: call}
+}
+
+// MockModelConfigServiceModelConfigCall wrap *gomock.Call
+type MockModelConfigServiceModelConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceModelConfigCall) Return(arg0 *config.Config, arg1 error) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceModelConfigCall) Do(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceModelConfigCall) DoAndReturn(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Watch mocks base method.
+func (m *MockModelConfigService) Watch(arg0 context.Context) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockModelConfigServiceMockRecorder) Watch(arg0 any) *MockModelConfigServiceWatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockModelConfigService)(nil).Watch), arg0)
+	return &MockModelConfigServiceWatchCall{Call: call}
+}
+
+// MockModelConfigServiceWatchCall wrap *gomock.Call
+type MockModelConfigServiceWatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceWatchCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceWatchCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceWatchCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
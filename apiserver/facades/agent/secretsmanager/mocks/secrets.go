@@ -737,6 +737,46 @@ func (c *MockSecretServiceGetSecretValueCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// GetSecretValueForDrain mocks base method.
+func (m *MockSecretService) GetSecretValueForDrain(arg0 context.Context, arg1 *secrets.URI, arg2 int, arg3 secret.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValueForDrain", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(secrets.SecretValue)
+	ret1, _ := ret[1].(*secrets.ValueRef)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSecretValueForDrain indicates an expected call of GetSecretValueForDrain.
+func (mr *MockSecretServiceMockRecorder) GetSecretValueForDrain(arg0, arg1, arg2, arg3 any) *MockSecretServiceGetSecretValueForDrainCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValueForDrain", reflect.TypeOf((*MockSecretService)(nil).GetSecretValueForDrain), arg0, arg1, arg2, arg3)
+	return &MockSecretServiceGetSecretValueForDrainCall{Call: call}
+}
+
+// MockSecretServiceGetSecretValueForDrainCall wrap *gomock.Call
+type MockSecretServiceGetSecretValueForDrainCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceGetSecretValueForDrainCall) Return(arg0 secrets.SecretValue, arg1 *secrets.ValueRef, arg2 error) *MockSecretServiceGetSecretValueForDrainCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceGetSecretValueForDrainCall) Do(f func(context.Context, *secrets.URI, int, secret.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error)) *MockSecretServiceGetSecretValueForDrainCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceGetSecretValueForDrainCall) DoAndReturn(f func(context.Context, *secrets.URI, int, secret.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error)) *MockSecretServiceGetSecretValueForDrainCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListCharmSecrets mocks base method.
 func (m *MockSecretService) ListCharmSecrets(arg0 context.Context, arg1 ...secret.CharmSecretOwner) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
@@ -512,7 +512,9 @@ func (s *SecretsManagerAPI) GetSecretRevisionContentInfo(ctx context.Context, ar
 	token := s.leadershipChecker.LeadershipCheck(appName, s.authTag.Id())
 	for i, rev := range arg.Revisions {
 		// TODO(wallworld) - if pendingDelete is true, mark the revision for deletion
-		val, valueRef, err := s.secretService.GetSecretValue(ctx, uri, rev, accessor)
+		// The content is read to drain or delete it rather than to consume it,
+		// so the read is not recorded in the secret access log.
+		val, valueRef, err := s.secretService.GetSecretValueForDrain(ctx, uri, rev, accessor)
 		if err != nil {
 			result.Results[i].Error = apiservererrors.ServerError(err)
 			continue
@@ -681,7 +681,7 @@ func (s *SecretsManagerSuite) TestGetSecretRevisionContentInfo(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secret.SecretAccessor{
+	s.secretService.EXPECT().GetSecretValueForDrain(gomock.Any(), uri, 666, secret.SecretAccessor{
 		Kind: secret.UnitAccessor,
 		ID:   "mariadb/0",
 	}).Return(
@@ -0,0 +1,227 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storageprovisioner
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	coreerrors "github.com/juju/juju/core/errors"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	secretservice "github.com/juju/juju/domain/secret/service"
+	storageprovisioningerrors "github.com/juju/juju/domain/storageprovisioning/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	// encryptionKeyLength is the number of random bytes in a generated
+	// filesystem encryption key.
+	encryptionKeyLength = 32
+
+	// encryptionKeySecretKey is the key of the secret content holding a
+	// filesystem encryption key.
+	encryptionKeySecretKey = "key"
+)
+
+// FilesystemEncryptionKeys returns the encryption keys for the filesystems of
+// the specified machine filesystem attachments. An empty key is returned for
+// filesystems that are not encrypted.
+//
+// The key for an encrypted filesystem is generated on first request and
+// stored in a model owned secret labelled after the filesystem's storage
+// instance. The secret is not owned by, or granted to, any charm, so the
+// units using the storage can neither read, change nor remove the key, and
+// the key outlives the units so that detached storage can still be unlocked
+// when it is attached again. The secret is recorded against the storage
+// instance, and it cannot be removed nor its content changed while the
+// storage instance exists. The secret is kept when the storage is removed.
+func (s *StorageProvisionerAPI) FilesystemEncryptionKeys(
+	ctx context.Context, args params.MachineStorageIds,
+) (params.StringResults, error) {
+	canAccess, err := s.getAttachmentAuthFunc(ctx)
+	if err != nil {
+		return params.StringResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	results := params.StringResults{
+		Results: make([]params.StringResult, len(args.Ids)),
+	}
+	for i, arg := range args.Ids {
+		key, err := s.filesystemEncryptionKey(ctx, canAccess, arg)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = key
+	}
+	return results, nil
+}
+
+func (s *StorageProvisionerAPI) filesystemEncryptionKey(
+	ctx context.Context,
+	canAccess func(names.Tag, names.Tag) bool,
+	arg params.MachineStorageId,
+) (string, error) {
+	// Keys are only handed out to the machine the filesystem is attached
+	// to, since it is the machine that needs to unlock the filesystem.
+	machineTag, err := names.ParseMachineTag(arg.MachineTag)
+	if err != nil {
+		return "", apiservererrors.ErrPerm
+	}
+	filesystemTag, err := names.ParseFilesystemTag(arg.AttachmentTag)
+	if err != nil || !canAccess(machineTag, filesystemTag) {
+		return "", apiservererrors.ErrPerm
+	}
+	_, err = s.getFilesystemAttachmentUUID(ctx, filesystemTag, machineTag)
+	if errors.Is(err, coreerrors.NotFound) {
+		return "", apiservererrors.ErrPerm
+	} else if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	uuid, err := s.storageProvisioningService.GetFilesystemUUIDForID(
+		ctx, filesystemTag.Id(),
+	)
+	if errors.Is(err, storageprovisioningerrors.FilesystemNotFound) {
+		return "", apiservererrors.ErrPerm
+	} else if err != nil {
+		return "", errors.Capture(err)
+	}
+	fsParams, err := s.storageProvisioningService.GetFilesystemParams(ctx, uuid)
+	if errors.Is(err, storageprovisioningerrors.FilesystemNotFound) {
+		return "", apiservererrors.ErrPerm
+	} else if err != nil {
+		return "", errors.Capture(err)
+	}
+	if encrypt, _ := strconv.ParseBool(fsParams.Attributes[storage.ConfigEncrypt]); !encrypt {
+		return "", nil
+	}
+
+	secretURI, err := s.storageProvisioningService.GetFilesystemEncryptionKeySecret(ctx, uuid)
+	if err == nil {
+		return s.getFilesystemEncryptionKey(ctx, secretURI, machineTag)
+	} else if !errors.Is(err, storageprovisioningerrors.FilesystemEncryptionKeyNotFound) {
+		return "", errors.Errorf(
+			"getting encryption key secret of filesystem %q: %w", filesystemTag.Id(), err,
+		)
+	}
+
+	storageID, err := s.storageProvisioningService.GetFilesystemStorageID(ctx, uuid)
+	if err != nil {
+		return "", errors.Errorf(
+			"getting storage instance of encrypted filesystem %q: %w", filesystemTag.Id(), err,
+		)
+	}
+	secretURI, err = s.ensureFilesystemEncryptionKeySecret(ctx, storageID)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	// Record the secret against the storage instance so that it cannot be
+	// removed while the storage exists. Should a concurrent request have
+	// recorded a secret first, that secret is the one to use.
+	err = s.storageProvisioningService.SetFilesystemEncryptionKeySecret(ctx, uuid, secretURI)
+	if errors.Is(err, storageprovisioningerrors.FilesystemEncryptionKeyAlreadySet) {
+		secretURI, err = s.storageProvisioningService.GetFilesystemEncryptionKeySecret(ctx, uuid)
+	}
+	if err != nil {
+		return "", errors.Errorf(
+			"recording encryption key secret of filesystem %q: %w", filesystemTag.Id(), err,
+		)
+	}
+	return s.getFilesystemEncryptionKey(ctx, secretURI, machineTag)
+}
+
+// ensureFilesystemEncryptionKeySecret returns the model owned secret holding
+// the encryption key for the filesystem of the storage instance, creating
+// the secret with a newly generated key if it does not exist yet.
+func (s *StorageProvisionerAPI) ensureFilesystemEncryptionKeySecret(
+	ctx context.Context, storageID string,
+) (*coresecrets.URI, error) {
+	label := filesystemEncryptionKeyLabel(storageID)
+	uri, err := s.secretService.GetUserSecretURIByLabel(ctx, label)
+	if err == nil {
+		return uri, nil
+	} else if !errors.Is(err, secreterrors.SecretNotFound) {
+		return nil, errors.Errorf("getting encryption key secret %q: %w", label, err)
+	}
+
+	keyBytes := make([]byte, encryptionKeyLength)
+	if _, err := rand.Read(keyBytes); err != nil {
+		return nil, errors.Errorf("generating encryption key: %w", err)
+	}
+	key := base64.StdEncoding.EncodeToString(keyBytes)
+
+	uris, err := s.secretService.CreateSecretURIs(ctx, 1)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	description := fmt.Sprintf("encryption key for the filesystem of storage %s", storageID)
+	err = s.secretService.CreateUserSecret(ctx, uris[0], secretservice.CreateUserSecretParams{
+		Version: secrets.Version,
+		UpdateUserSecretParams: secretservice.UpdateUserSecretParams{
+			Accessor: domainsecret.SecretAccessor{
+				Kind: domainsecret.ModelAccessor,
+				ID:   s.modelUUID.String(),
+			},
+			Label:       &label,
+			Description: &description,
+			Data:        coresecrets.SecretData{encryptionKeySecretKey: key},
+		},
+	})
+	if errors.Is(err, secreterrors.SecretLabelAlreadyExists) {
+		// A concurrent request created the key first, so use that one.
+		uri, err = s.secretService.GetUserSecretURIByLabel(ctx, label)
+		if err != nil {
+			return nil, errors.Errorf("getting encryption key secret %q: %w", label, err)
+		}
+		return uri, nil
+	} else if err != nil {
+		return nil, errors.Errorf(
+			"creating encryption key for storage %q: %w", storageID, err,
+		)
+	}
+	s.logger.Infof(ctx, "created encryption key %s for the filesystem of storage %q", uris[0], storageID)
+	return uris[0], nil
+}
+
+// getFilesystemEncryptionKey returns the encryption key held in the latest
+// revision of the specified secret. The read is recorded against the machine
+// which asked for the key.
+func (s *StorageProvisionerAPI) getFilesystemEncryptionKey(
+	ctx context.Context, uri *coresecrets.URI, machineTag names.MachineTag,
+) (string, error) {
+	md, err := s.secretService.GetSecret(ctx, uri)
+	if err != nil {
+		return "", errors.Errorf("getting encryption key secret %q: %w", uri, err)
+	}
+	value, err := s.secretService.GetSecretContentFromBackend(ctx, uri, md.LatestRevision, domainsecret.SecretAccessor{
+		Kind: domainsecret.MachineAccessor,
+		ID:   machineTag.Id(),
+	})
+	if err != nil {
+		return "", errors.Errorf("getting encryption key secret %q: %w", uri, err)
+	}
+	key := value.EncodedValues()[encryptionKeySecretKey]
+	if key == "" {
+		return "", errors.Errorf("encryption key secret %q has no key", uri)
+	}
+	return key, nil
+}
+
+// filesystemEncryptionKeyLabel returns the label of the secret holding the
+// encryption key of the filesystem of the storage instance.
+func filesystemEncryptionKeyLabel(storageID string) string {
+	return "storage-encryption-key-" + storageID
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storageprovisioner
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	machinetesting "github.com/juju/juju/core/machine/testing"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	secretservice "github.com/juju/juju/domain/secret/service"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/domain/storageprovisioning"
+	storageprovisioningerrors "github.com/juju/juju/domain/storageprovisioning/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// expectEncryptedFilesystem sets up the expectations for looking up the
+// filesystem 123 attached to the suite's machine, returning the supplied pool
+// attributes.
+func (s *provisionerSuite) expectEncryptedFilesystem(
+	c *tc.C, attrs map[string]string,
+) domainstorage.FilesystemUUID {
+	machineUUID := machinetesting.GenUUID(c)
+	fsUUID := tc.Must(c, domainstorage.NewFilesystemUUID)
+	s.machineService.EXPECT().
+		GetMachineUUID(gomock.Any(), s.machineName).
+		Return(machineUUID, nil)
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemAttachmentUUIDForFilesystemIDMachine(gomock.Any(), "123", machineUUID).
+		Return(tc.Must(c, domainstorage.NewFilesystemAttachmentUUID), nil)
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemUUIDForID(gomock.Any(), "123").
+		Return(fsUUID, nil)
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemParams(gomock.Any(), fsUUID).
+		Return(storageprovisioning.FilesystemParams{
+			Attributes: attrs,
+			ID:         "123",
+			Provider:   "loop",
+		}, nil)
+	return fsUUID
+}
+
+func (s *provisionerSuite) filesystemEncryptionKeys(c *tc.C) params.StringResults {
+	result, err := s.api.FilesystemEncryptionKeys(c.Context(), params.MachineStorageIds{
+		Ids: []params.MachineStorageId{{
+			MachineTag:    names.NewMachineTag(s.machineName.String()).String(),
+			AttachmentTag: names.NewFilesystemTag("123").String(),
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	return result
+}
+
+// machineAccessor returns the accessor recorded when the machine reads an
+// encryption key.
+func (s *provisionerSuite) machineAccessor() domainsecret.SecretAccessor {
+	return domainsecret.SecretAccessor{
+		Kind: domainsecret.MachineAccessor,
+		ID:   s.machineName.String(),
+	}
+}
+
+func (s *provisionerSuite) TestFilesystemEncryptionKeysNotEncrypted(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+	s.disableAuthz(c)
+
+	s.expectEncryptedFilesystem(c, map[string]string{"encrypt": "false"})
+
+	result := s.filesystemEncryptionKeys(c)
+	c.Check(result.Results[0], tc.DeepEquals, params.StringResult{})
+}
+
+func (s *provisionerSuite) TestFilesystemEncryptionKeysExisting(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+	s.disableAuthz(c)
+
+	fsUUID := s.expectEncryptedFilesystem(c, map[string]string{"encrypt": "true"})
+	uri := coresecrets.NewURI()
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID).
+		Return(uri, nil)
+	s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(
+		&coresecrets.SecretMetadata{URI: uri, LatestRevision: 2}, nil,
+	)
+	s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2, s.machineAccessor()).Return(
+		coresecrets.NewSecretValue(map[string]string{"key": "c2Vrcml0"}), nil,
+	)
+
+	result := s.filesystemEncryptionKeys(c)
+	c.Check(result.Results[0], tc.DeepEquals, params.StringResult{Result: "c2Vrcml0"})
+}
+
+func (s *provisionerSuite) TestFilesystemEncryptionKeysExistingByLabel(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+	s.disableAuthz(c)
+
+	fsUUID := s.expectEncryptedFilesystem(c, map[string]string{"encrypt": "true"})
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID).
+		Return(nil, storageprovisioningerrors.FilesystemEncryptionKeyNotFound)
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemStorageID(gomock.Any(), fsUUID).
+		Return("data/0", nil)
+
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().
+		GetUserSecretURIByLabel(gomock.Any(), "storage-encryption-key-data/0").
+		Return(uri, nil)
+	s.storageProvisioningService.EXPECT().
+		SetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID, uri).
+		Return(nil)
+	s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(
+		&coresecrets.SecretMetadata{URI: uri, LatestRevision: 2}, nil,
+	)
+	s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2, s.machineAccessor()).Return(
+		coresecrets.NewSecretValue(map[string]string{"key": "c2Vrcml0"}), nil,
+	)
+
+	result := s.filesystemEncryptionKeys(c)
+	c.Check(result.Results[0], tc.DeepEquals, params.StringResult{Result: "c2Vrcml0"})
+}
+
+func (s *provisionerSuite) TestFilesystemEncryptionKeysCreate(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+	s.disableAuthz(c)
+
+	fsUUID := s.expectEncryptedFilesystem(c, map[string]string{"encrypt": "true"})
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID).
+		Return(nil, storageprovisioningerrors.FilesystemEncryptionKeyNotFound)
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemStorageID(gomock.Any(), fsUUID).
+		Return("data/0", nil)
+
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().
+		GetUserSecretURIByLabel(gomock.Any(), "storage-encryption-key-data/0").
+		Return(nil, secreterrors.SecretNotFound)
+	s.secretService.EXPECT().CreateSecretURIs(gomock.Any(), 1).Return([]*coresecrets.URI{uri}, nil)
+
+	var created secretservice.CreateUserSecretParams
+	s.secretService.EXPECT().CreateUserSecret(gomock.Any(), uri, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *coresecrets.URI, p secretservice.CreateUserSecretParams) error {
+			created = p
+			return nil
+		},
+	)
+	s.storageProvisioningService.EXPECT().
+		SetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID, uri).
+		Return(nil)
+	s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(
+		&coresecrets.SecretMetadata{URI: uri, LatestRevision: 1}, nil,
+	)
+	s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 1, s.machineAccessor()).DoAndReturn(
+		func(context.Context, *coresecrets.URI, int, domainsecret.SecretAccessor) (coresecrets.SecretValue, error) {
+			return coresecrets.NewSecretValue(created.Data), nil
+		},
+	)
+
+	result := s.filesystemEncryptionKeys(c)
+	c.Assert(result.Results[0].Error, tc.IsNil)
+	key := result.Results[0].Result
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(decoded, tc.HasLen, 32)
+
+	// The key is held in a model owned secret, out of reach of the charm.
+	c.Check(created.Accessor, tc.DeepEquals, domainsecret.SecretAccessor{
+		Kind: domainsecret.ModelAccessor,
+		ID:   s.modelUUID.String(),
+	})
+	c.Assert(created.Label, tc.NotNil)
+	c.Check(*created.Label, tc.Equals, "storage-encryption-key-data/0")
+	c.Check(created.Data, tc.DeepEquals, coresecrets.SecretData{"key": key})
+}
+
+func (s *provisionerSuite) TestFilesystemEncryptionKeysCreateRace(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+	s.disableAuthz(c)
+
+	fsUUID := s.expectEncryptedFilesystem(c, map[string]string{"encrypt": "true"})
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemStorageID(gomock.Any(), fsUUID).
+		Return("data/0", nil)
+
+	labelURI := coresecrets.NewURI()
+	uri := coresecrets.NewURI()
+	gomock.InOrder(
+		s.storageProvisioningService.EXPECT().GetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID).
+			Return(nil, storageprovisioningerrors.FilesystemEncryptionKeyNotFound),
+		s.secretService.EXPECT().GetUserSecretURIByLabel(gomock.Any(), gomock.Any()).
+			Return(nil, secreterrors.SecretNotFound),
+		s.secretService.EXPECT().CreateSecretURIs(gomock.Any(), 1).Return([]*coresecrets.URI{coresecrets.NewURI()}, nil),
+		s.secretService.EXPECT().CreateUserSecret(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(secreterrors.SecretLabelAlreadyExists),
+		s.secretService.EXPECT().GetUserSecretURIByLabel(gomock.Any(), gomock.Any()).
+			Return(labelURI, nil),
+		s.storageProvisioningService.EXPECT().SetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID, labelURI).
+			Return(storageprovisioningerrors.FilesystemEncryptionKeyAlreadySet),
+		s.storageProvisioningService.EXPECT().GetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID).
+			Return(uri, nil),
+		s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(
+			&coresecrets.SecretMetadata{URI: uri, LatestRevision: 1}, nil,
+		),
+		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 1, s.machineAccessor()).Return(
+			coresecrets.NewSecretValue(map[string]string{"key": "c2Vrcml0"}), nil,
+		),
+	)
+
+	result := s.filesystemEncryptionKeys(c)
+	c.Check(result.Results[0], tc.DeepEquals, params.StringResult{Result: "c2Vrcml0"})
+}
+
+func (s *provisionerSuite) TestFilesystemEncryptionKeysNoStorageInstance(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+	s.disableAuthz(c)
+
+	fsUUID := s.expectEncryptedFilesystem(c, map[string]string{"encrypt": "true"})
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID).
+		Return(nil, storageprovisioningerrors.FilesystemEncryptionKeyNotFound)
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemStorageID(gomock.Any(), fsUUID).
+		Return("", storageerrors.StorageInstanceNotFound)
+
+	result := s.filesystemEncryptionKeys(c)
+	c.Check(result.Results[0].Error, tc.ErrorMatches, `getting storage instance of encrypted filesystem "123": .*`)
+}
+
+func (s *provisionerSuite) TestFilesystemEncryptionKeysNotAttached(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+	s.disableAuthz(c)
+
+	machineUUID := machinetesting.GenUUID(c)
+	s.machineService.EXPECT().
+		GetMachineUUID(gomock.Any(), s.machineName).
+		Return(machineUUID, nil)
+	s.storageProvisioningService.EXPECT().
+		GetFilesystemAttachmentUUIDForFilesystemIDMachine(gomock.Any(), "123", machineUUID).
+		Return("", storageprovisioningerrors.FilesystemAttachmentNotFound)
+
+	result := s.filesystemEncryptionKeys(c)
+	c.Assert(result.Results[0].Error, tc.NotNil)
+	c.Check(result.Results[0].Error.Code, tc.Equals, params.CodeUnauthorized)
+}
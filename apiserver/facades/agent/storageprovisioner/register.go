@@ -39,6 +39,13 @@ func Register(registry facade.FacadeRegistry) {
 		func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 			return newFacadeV7(stdCtx, ctx)
 		},
+		reflect.TypeFor[*StorageProvisionerAPIv7](),
+	)
+	registry.MustRegister(
+		"StorageProvisioner", 8,
+		func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+			return newFacadeV8(stdCtx, ctx) // add FilesystemEncryptionKeys.
+		},
 		reflect.TypeFor[*StorageProvisionerAPI](),
 	)
 
@@ -56,8 +63,8 @@ func Register(registry facade.FacadeRegistry) {
 	)
 }
 
-// newFacadeV7 uses
-func newFacadeV7(stdCtx context.Context, ctx facade.ModelContext) (*StorageProvisionerAPI, error) {
+// newFacadeV8 uses
+func newFacadeV8(stdCtx context.Context, ctx facade.ModelContext) (*StorageProvisionerAPI, error) {
 	domainServices := ctx.DomainServices()
 
 	return NewStorageProvisionerAPI(
@@ -71,12 +78,24 @@ func newFacadeV7(stdCtx context.Context, ctx facade.ModelContext) (*StorageProvi
 		ctx.Auth(),
 		domainServices.Status(),
 		domainServices.StorageProvisioning(),
+		domainServices.Secret(),
 		ctx.Logger().Child("storageprovisioner"),
 		ctx.ModelUUID(),
 		ctx.ControllerUUID(),
 	)
 }
 
+// newFacadeV7 provides the signature required for facade registration.
+func newFacadeV7(stdCtx context.Context, ctx facade.ModelContext) (*StorageProvisionerAPIv7, error) {
+	v8, err := newFacadeV8(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return &StorageProvisionerAPIv7{
+		StorageProvisionerAPI: v8,
+	}, nil
+}
+
 // newFacadeV6 provides the signature required for facade registration.
 func newFacadeV6(stdCtx context.Context, ctx facade.ModelContext) (*StorageProvisionerAPIv6, error) {
 	v7, err := newFacadeV7(stdCtx, ctx)
@@ -84,7 +103,7 @@ func newFacadeV6(stdCtx context.Context, ctx facade.ModelContext) (*StorageProvi
 		return nil, errors.Capture(err)
 	}
 	return &StorageProvisionerAPIv6{
-		StorageProvisionerAPI: v7,
+		StorageProvisionerAPIv7: v7,
 	}, nil
 }
 
@@ -25,6 +25,7 @@ func (s *registerSuite) TestRegister(c *tc.C) {
 	registry.EXPECT().MustRegister("StorageProvisioner", 5, gomock.Any(), gomock.Any()).AnyTimes()
 	registry.EXPECT().MustRegister("StorageProvisioner", 6, gomock.Any(), gomock.Any()).AnyTimes()
 	registry.EXPECT().MustRegister("StorageProvisioner", 7, gomock.Any(), gomock.Any()).AnyTimes()
+	registry.EXPECT().MustRegister("StorageProvisioner", 8, gomock.Any(), gomock.Any()).AnyTimes()
 	registry.EXPECT().MustRegister("VolumeAttachmentsWatcher", 2, gomock.Any(), gomock.Any()).AnyTimes()
 	registry.EXPECT().MustRegister("VolumeAttachmentPlansWatcher", 1, gomock.Any(), gomock.Any()).AnyTimes()
 	registry.EXPECT().MustRegister("FilesystemAttachmentsWatcher", 2, gomock.Any(), gomock.Any()).AnyTimes()
@@ -11,11 +11,14 @@ import (
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/secrets"
 	corestatus "github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
 	domainblockdevice "github.com/juju/juju/domain/blockdevice"
 	domainlife "github.com/juju/juju/domain/life"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	domainstorage "github.com/juju/juju/domain/storage"
 	"github.com/juju/juju/domain/storageprovisioning"
 	"github.com/juju/juju/environs/config"
@@ -166,6 +169,25 @@ type StorageProvisioningService interface {
 		ctx context.Context, uuid domainstorage.FilesystemUUID,
 	) (storageprovisioning.FilesystemParams, error)
 
+	// GetFilesystemStorageID returns the id of the storage instance of the
+	// filesystem with the supplied uuid.
+	GetFilesystemStorageID(
+		ctx context.Context, uuid domainstorage.FilesystemUUID,
+	) (string, error)
+
+	// GetFilesystemEncryptionKeySecret returns the uri of the secret holding
+	// the key that the filesystem with the supplied uuid is encrypted with.
+	GetFilesystemEncryptionKeySecret(
+		ctx context.Context, uuid domainstorage.FilesystemUUID,
+	) (*secrets.URI, error)
+
+	// SetFilesystemEncryptionKeySecret records the secret holding the key
+	// that the filesystem with the supplied uuid is encrypted with against
+	// the filesystem's storage instance.
+	SetFilesystemEncryptionKeySecret(
+		ctx context.Context, uuid domainstorage.FilesystemUUID, uri *secrets.URI,
+	) error
+
 	// GetFilesystemRemovalParams returns the filesystem removal params for the
 	// supplied uuid.
 	GetFilesystemRemovalParams(
@@ -446,3 +468,27 @@ type StorageProvisioningService interface {
 		blockDeviceUUID domainblockdevice.BlockDeviceUUID,
 	) error
 }
+
+// SecretService provides access to the secrets holding filesystem
+// encryption keys.
+type SecretService interface {
+	// CreateSecretURIs returns the specified number of new secret URIs.
+	CreateSecretURIs(ctx context.Context, count int) ([]*secrets.URI, error)
+
+	// CreateUserSecret creates a model owned secret with the specified
+	// parameters.
+	CreateUserSecret(ctx context.Context, uri *secrets.URI, params secretservice.CreateUserSecretParams) error
+
+	// GetUserSecretURIByLabel returns the URI of the model owned secret
+	// with the specified label.
+	GetUserSecretURIByLabel(ctx context.Context, label string) (*secrets.URI, error)
+
+	// GetSecret returns the secret with the specified URI.
+	GetSecret(ctx context.Context, uri *secrets.URI) (*secrets.SecretMetadata, error)
+
+	// GetSecretContentFromBackend retrieves the content for the specified
+	// secret revision, recording the read against the accessor.
+	GetSecretContentFromBackend(
+		ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor,
+	) (secrets.SecretValue, error)
+}
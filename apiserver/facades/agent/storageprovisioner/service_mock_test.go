@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/storageprovisioner (interfaces: ApplicationService,MachineService,StorageProvisioningService,BlockDeviceService,RemovalService)
+// Source: github.com/juju/juju/apiserver/facades/agent/storageprovisioner (interfaces: ApplicationService,MachineService,StorageProvisioningService,BlockDeviceService,RemovalService,SecretService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package storageprovisioner -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/storageprovisioner ApplicationService,MachineService,StorageProvisioningService,BlockDeviceService,RemovalService
+//	mockgen -typed -package storageprovisioner -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/storageprovisioner ApplicationService,MachineService,StorageProvisioningService,BlockDeviceService,RemovalService,SecretService
 //
 
 // Package storageprovisioner is a generated GoMock package.
@@ -17,10 +17,13 @@ import (
 	instance "github.com/juju/juju/core/instance"
 	life "github.com/juju/juju/core/life"
 	machine "github.com/juju/juju/core/machine"
+	secrets "github.com/juju/juju/core/secrets"
 	unit "github.com/juju/juju/core/unit"
 	watcher "github.com/juju/juju/core/watcher"
 	blockdevice0 "github.com/juju/juju/domain/blockdevice"
 	life0 "github.com/juju/juju/domain/life"
+	secret "github.com/juju/juju/domain/secret"
+	service "github.com/juju/juju/domain/secret/service"
 	storage "github.com/juju/juju/domain/storage"
 	storageprovisioning "github.com/juju/juju/domain/storageprovisioning"
 	gomock "go.uber.org/mock/gomock"
@@ -797,6 +800,45 @@ func (c *MockStorageProvisioningServiceGetFilesystemAttachmentUUIDForFilesystemI
 	return c
 }
 
+// GetFilesystemEncryptionKeySecret mocks base method.
+func (m *MockStorageProvisioningService) GetFilesystemEncryptionKeySecret(arg0 context.Context, arg1 storage.FilesystemUUID) (*secrets.URI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesystemEncryptionKeySecret", arg0, arg1)
+	ret0, _ := ret[0].(*secrets.URI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesystemEncryptionKeySecret indicates an expected call of GetFilesystemEncryptionKeySecret.
+func (mr *MockStorageProvisioningServiceMockRecorder) GetFilesystemEncryptionKeySecret(arg0, arg1 any) *MockStorageProvisioningServiceGetFilesystemEncryptionKeySecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesystemEncryptionKeySecret", reflect.TypeOf((*MockStorageProvisioningService)(nil).GetFilesystemEncryptionKeySecret), arg0, arg1)
+	return &MockStorageProvisioningServiceGetFilesystemEncryptionKeySecretCall{Call: call}
+}
+
+// MockStorageProvisioningServiceGetFilesystemEncryptionKeySecretCall wrap *gomock.Call
+type MockStorageProvisioningServiceGetFilesystemEncryptionKeySecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceGetFilesystemEncryptionKeySecretCall) Return(arg0 *secrets.URI, arg1 error) *MockStorageProvisioningServiceGetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceGetFilesystemEncryptionKeySecretCall) Do(f func(context.Context, storage.FilesystemUUID) (*secrets.URI, error)) *MockStorageProvisioningServiceGetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceGetFilesystemEncryptionKeySecretCall) DoAndReturn(f func(context.Context, storage.FilesystemUUID) (*secrets.URI, error)) *MockStorageProvisioningServiceGetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetFilesystemForID mocks base method.
 func (m *MockStorageProvisioningService) GetFilesystemForID(arg0 context.Context, arg1 string) (storageprovisioning.Filesystem, error) {
 	m.ctrl.T.Helper()
@@ -953,6 +995,45 @@ func (c *MockStorageProvisioningServiceGetFilesystemRemovalParamsCall) DoAndRetu
 	return c
 }
 
+// GetFilesystemStorageID mocks base method.
+func (m *MockStorageProvisioningService) GetFilesystemStorageID(arg0 context.Context, arg1 storage.FilesystemUUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesystemStorageID", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesystemStorageID indicates an expected call of GetFilesystemStorageID.
+func (mr *MockStorageProvisioningServiceMockRecorder) GetFilesystemStorageID(arg0, arg1 any) *MockStorageProvisioningServiceGetFilesystemStorageIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesystemStorageID", reflect.TypeOf((*MockStorageProvisioningService)(nil).GetFilesystemStorageID), arg0, arg1)
+	return &MockStorageProvisioningServiceGetFilesystemStorageIDCall{Call: call}
+}
+
+// MockStorageProvisioningServiceGetFilesystemStorageIDCall wrap *gomock.Call
+type MockStorageProvisioningServiceGetFilesystemStorageIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceGetFilesystemStorageIDCall) Return(arg0 string, arg1 error) *MockStorageProvisioningServiceGetFilesystemStorageIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceGetFilesystemStorageIDCall) Do(f func(context.Context, storage.FilesystemUUID) (string, error)) *MockStorageProvisioningServiceGetFilesystemStorageIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceGetFilesystemStorageIDCall) DoAndReturn(f func(context.Context, storage.FilesystemUUID) (string, error)) *MockStorageProvisioningServiceGetFilesystemStorageIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetFilesystemUUIDForID mocks base method.
 func (m *MockStorageProvisioningService) GetFilesystemUUIDForID(arg0 context.Context, arg1 string) (storage.FilesystemUUID, error) {
 	m.ctrl.T.Helper()
@@ -1614,6 +1695,44 @@ func (c *MockStorageProvisioningServiceSetFilesystemAttachmentProvisionedInfoFor
 	return c
 }
 
+// SetFilesystemEncryptionKeySecret mocks base method.
+func (m *MockStorageProvisioningService) SetFilesystemEncryptionKeySecret(arg0 context.Context, arg1 storage.FilesystemUUID, arg2 *secrets.URI) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFilesystemEncryptionKeySecret", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFilesystemEncryptionKeySecret indicates an expected call of SetFilesystemEncryptionKeySecret.
+func (mr *MockStorageProvisioningServiceMockRecorder) SetFilesystemEncryptionKeySecret(arg0, arg1, arg2 any) *MockStorageProvisioningServiceSetFilesystemEncryptionKeySecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFilesystemEncryptionKeySecret", reflect.TypeOf((*MockStorageProvisioningService)(nil).SetFilesystemEncryptionKeySecret), arg0, arg1, arg2)
+	return &MockStorageProvisioningServiceSetFilesystemEncryptionKeySecretCall{Call: call}
+}
+
+// MockStorageProvisioningServiceSetFilesystemEncryptionKeySecretCall wrap *gomock.Call
+type MockStorageProvisioningServiceSetFilesystemEncryptionKeySecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceSetFilesystemEncryptionKeySecretCall) Return(arg0 error) *MockStorageProvisioningServiceSetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceSetFilesystemEncryptionKeySecretCall) Do(f func(context.Context, storage.FilesystemUUID, *secrets.URI) error) *MockStorageProvisioningServiceSetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceSetFilesystemEncryptionKeySecretCall) DoAndReturn(f func(context.Context, storage.FilesystemUUID, *secrets.URI) error) *MockStorageProvisioningServiceSetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetFilesystemProvisionedInfo mocks base method.
 func (m *MockStorageProvisioningService) SetFilesystemProvisionedInfo(arg0 context.Context, arg1 string, arg2 storageprovisioning.FilesystemProvisionedInfo) error {
 	m.ctrl.T.Helper()
@@ -2546,3 +2665,220 @@ func (c *MockRemovalServiceRemoveDeadVolumeCall) DoAndReturn(f func(context.Cont
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock *MockSecretService
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSecretURIs mocks base method.
+func (m *MockSecretService) CreateSecretURIs(arg0 context.Context, arg1 int) ([]*secrets.URI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecretURIs", arg0, arg1)
+	ret0, _ := ret[0].([]*secrets.URI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecretURIs indicates an expected call of CreateSecretURIs.
+func (mr *MockSecretServiceMockRecorder) CreateSecretURIs(arg0, arg1 any) *MockSecretServiceCreateSecretURIsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecretURIs", reflect.TypeOf((*MockSecretService)(nil).CreateSecretURIs), arg0, arg1)
+	return &MockSecretServiceCreateSecretURIsCall{Call: call}
+}
+
+// MockSecretServiceCreateSecretURIsCall wrap *gomock.Call
+type MockSecretServiceCreateSecretURIsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceCreateSecretURIsCall) Return(arg0 []*secrets.URI, arg1 error) *MockSecretServiceCreateSecretURIsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceCreateSecretURIsCall) Do(f func(context.Context, int) ([]*secrets.URI, error)) *MockSecretServiceCreateSecretURIsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceCreateSecretURIsCall) DoAndReturn(f func(context.Context, int) ([]*secrets.URI, error)) *MockSecretServiceCreateSecretURIsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateUserSecret mocks base method.
+func (m *MockSecretService) CreateUserSecret(arg0 context.Context, arg1 *secrets.URI, arg2 service.CreateUserSecretParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserSecret", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserSecret indicates an expected call of CreateUserSecret.
+func (mr *MockSecretServiceMockRecorder) CreateUserSecret(arg0, arg1, arg2 any) *MockSecretServiceCreateUserSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserSecret", reflect.TypeOf((*MockSecretService)(nil).CreateUserSecret), arg0, arg1, arg2)
+	return &MockSecretServiceCreateUserSecretCall{Call: call}
+}
+
+// MockSecretServiceCreateUserSecretCall wrap *gomock.Call
+type MockSecretServiceCreateUserSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceCreateUserSecretCall) Return(arg0 error) *MockSecretServiceCreateUserSecretCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceCreateUserSecretCall) Do(f func(context.Context, *secrets.URI, service.CreateUserSecretParams) error) *MockSecretServiceCreateUserSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceCreateUserSecretCall) DoAndReturn(f func(context.Context, *secrets.URI, service.CreateUserSecretParams) error) *MockSecretServiceCreateUserSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSecret mocks base method.
+func (m *MockSecretService) GetSecret(arg0 context.Context, arg1 *secrets.URI) (*secrets.SecretMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", arg0, arg1)
+	ret0, _ := ret[0].(*secrets.SecretMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockSecretServiceMockRecorder) GetSecret(arg0, arg1 any) *MockSecretServiceGetSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockSecretService)(nil).GetSecret), arg0, arg1)
+	return &MockSecretServiceGetSecretCall{Call: call}
+}
+
+// MockSecretServiceGetSecretCall wrap *gomock.Call
+type MockSecretServiceGetSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceGetSecretCall) Return(arg0 *secrets.SecretMetadata, arg1 error) *MockSecretServiceGetSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceGetSecretCall) Do(f func(context.Context, *secrets.URI) (*secrets.SecretMetadata, error)) *MockSecretServiceGetSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceGetSecretCall) DoAndReturn(f func(context.Context, *secrets.URI) (*secrets.SecretMetadata, error)) *MockSecretServiceGetSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSecretContentFromBackend mocks base method.
+func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int, arg3 secret.SecretAccessor) (secrets.SecretValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretContentFromBackend", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(secrets.SecretValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretContentFromBackend indicates an expected call of GetSecretContentFromBackend.
+func (mr *MockSecretServiceMockRecorder) GetSecretContentFromBackend(arg0, arg1, arg2, arg3 any) *MockSecretServiceGetSecretContentFromBackendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentFromBackend", reflect.TypeOf((*MockSecretService)(nil).GetSecretContentFromBackend), arg0, arg1, arg2, arg3)
+	return &MockSecretServiceGetSecretContentFromBackendCall{Call: call}
+}
+
+// MockSecretServiceGetSecretContentFromBackendCall wrap *gomock.Call
+type MockSecretServiceGetSecretContentFromBackendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceGetSecretContentFromBackendCall) Return(arg0 secrets.SecretValue, arg1 error) *MockSecretServiceGetSecretContentFromBackendCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceGetSecretContentFromBackendCall) Do(f func(context.Context, *secrets.URI, int, secret.SecretAccessor) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceGetSecretContentFromBackendCall) DoAndReturn(f func(context.Context, *secrets.URI, int, secret.SecretAccessor) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetUserSecretURIByLabel mocks base method.
+func (m *MockSecretService) GetUserSecretURIByLabel(arg0 context.Context, arg1 string) (*secrets.URI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSecretURIByLabel", arg0, arg1)
+	ret0, _ := ret[0].(*secrets.URI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSecretURIByLabel indicates an expected call of GetUserSecretURIByLabel.
+func (mr *MockSecretServiceMockRecorder) GetUserSecretURIByLabel(arg0, arg1 any) *MockSecretServiceGetUserSecretURIByLabelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSecretURIByLabel", reflect.TypeOf((*MockSecretService)(nil).GetUserSecretURIByLabel), arg0, arg1)
+	return &MockSecretServiceGetUserSecretURIByLabelCall{Call: call}
+}
+
+// MockSecretServiceGetUserSecretURIByLabelCall wrap *gomock.Call
+type MockSecretServiceGetUserSecretURIByLabelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceGetUserSecretURIByLabelCall) Return(arg0 *secrets.URI, arg1 error) *MockSecretServiceGetUserSecretURIByLabelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceGetUserSecretURIByLabelCall) Do(f func(context.Context, string) (*secrets.URI, error)) *MockSecretServiceGetUserSecretURIByLabelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceGetUserSecretURIByLabelCall) DoAndReturn(f func(context.Context, string) (*secrets.URI, error)) *MockSecretServiceGetUserSecretURIByLabelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -48,6 +48,7 @@ type StorageProvisionerAPI struct {
 	authorizer                 facade.Authorizer
 	storageStatusService       StorageStatusService
 	storageProvisioningService StorageProvisioningService
+	secretService              SecretService
 	machineService             MachineService
 	applicationService         ApplicationService
 	removalService             RemovalService
@@ -64,9 +65,14 @@ type StorageProvisionerAPI struct {
 	modelUUID      model.UUID
 }
 
+// StorageProvisionerAPIv7 provides the StorageProvisioner API v7 facade.
+type StorageProvisionerAPIv7 struct {
+	*StorageProvisionerAPI
+}
+
 // StorageProvisionerAPIv6 provides the StorageProvisioner API v6 facade.
 type StorageProvisionerAPIv6 struct {
-	*StorageProvisionerAPI
+	*StorageProvisionerAPIv7
 }
 
 // StorageProvisionerAPIv5 provides the StorageProvisioner API v5 facade.
@@ -95,6 +101,7 @@ func NewStorageProvisionerAPI(
 	authorizer facade.Authorizer,
 	storageStatusService StorageStatusService,
 	storageProvisioningService StorageProvisioningService,
+	secretService SecretService,
 	logger logger.Logger,
 	modelUUID model.UUID,
 	controllerUUID string,
@@ -215,6 +222,7 @@ func NewStorageProvisionerAPI(
 		authorizer:                 authorizer,
 		storageStatusService:       storageStatusService,
 		storageProvisioningService: storageProvisioningService,
+		secretService:              secretService,
 		machineService:             machineService,
 		applicationService:         applicationService,
 		removalService:             removalService,
@@ -1417,6 +1425,9 @@ func (s *StorageProvisionerAPIv6) VolumeBlockDevices(
 	return res, err
 }
 
+// FilesystemEncryptionKeys is not available in v7.
+func (*StorageProvisionerAPIv7) FilesystemEncryptionKeys(_, _ struct{}) {}
+
 // FilesystemAttachments returns details of filesystem attachments with the specified IDs.
 func (s *StorageProvisionerAPI) FilesystemAttachments(ctx context.Context, args params.MachineStorageIds) (params.FilesystemAttachmentResults, error) {
 	canAccess, err := s.getAttachmentAuthFunc(ctx)
@@ -1570,6 +1581,7 @@ func (s *StorageProvisionerAPI) VolumeParams(ctx context.Context, args params.En
 			VolumeTag:  tag.String(),
 			Provider:   volParams.Provider,
 			SizeMiB:    volParams.SizeMiB,
+			SnapshotId: volParams.SnapshotID,
 			Tags:       volModelTags,
 		}
 		for k, v := range volParams.Attributes {
@@ -1789,6 +1801,7 @@ func (s *StorageProvisionerAPI) FilesystemParams(
 			Provider:      fsParams.Provider,
 			ProviderId:    fsParams.ProviderID,
 			SizeMiB:       fsParams.SizeMiB,
+			SnapshotId:    fsParams.SnapshotID,
 			Tags:          fsModelTags,
 		}
 		for k, v := range fsParams.Attributes {
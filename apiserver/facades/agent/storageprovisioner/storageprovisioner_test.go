@@ -47,6 +47,7 @@ type provisionerSuite struct {
 	applicationService         *MockApplicationService
 	blockDeviceService         *MockBlockDeviceService
 	removalService             *MockRemovalService
+	secretService              *MockSecretService
 
 	api *StorageProvisionerAPI
 
@@ -98,6 +99,7 @@ func (s *provisionerSuite) setupAPI(c *tc.C) *gomock.Controller {
 	s.applicationService = NewMockApplicationService(ctrl)
 	s.blockDeviceService = NewMockBlockDeviceService(ctrl)
 	s.removalService = NewMockRemovalService(ctrl)
+	s.secretService = NewMockSecretService(ctrl)
 
 	var err error
 	s.api, err = NewStorageProvisionerAPI(
@@ -111,6 +113,7 @@ func (s *provisionerSuite) setupAPI(c *tc.C) *gomock.Controller {
 		s.authorizer,
 		nil, // statusService
 		s.storageProvisioningService,
+		s.secretService,
 		loggertesting.WrapCheckLog(c),
 		s.modelUUID,
 		s.controllerUUID,
@@ -125,6 +128,7 @@ func (s *provisionerSuite) setupAPI(c *tc.C) *gomock.Controller {
 		s.applicationService = nil
 		s.blockDeviceService = nil
 		s.removalService = nil
+		s.secretService = nil
 		s.api = nil
 	})
 
@@ -162,6 +166,7 @@ func (s *provisionerV5Suite) setupAPI(c *tc.C) *gomock.Controller {
 		s.authorizer,
 		nil, // statusService
 		s.storageProvisioningService,
+		nil, // secretService
 		loggertesting.WrapCheckLog(c),
 		s.modelUUID,
 		s.controllerUUID,
@@ -170,7 +175,9 @@ func (s *provisionerV5Suite) setupAPI(c *tc.C) *gomock.Controller {
 
 	s.api = &StorageProvisionerAPIv5{
 		StorageProvisionerAPIv6: &StorageProvisionerAPIv6{
-			StorageProvisionerAPI: api,
+			StorageProvisionerAPIv7: &StorageProvisionerAPIv7{
+				StorageProvisionerAPI: api,
+			},
 		},
 	}
 
@@ -669,7 +676,9 @@ func (s *provisionerSuite) TestVolumeBlockDevicesV6(c *tc.C) {
 	}, nil)
 
 	api := &StorageProvisionerAPIv6{
-		StorageProvisionerAPI: s.api,
+		StorageProvisionerAPIv7: &StorageProvisionerAPIv7{
+			StorageProvisionerAPI: s.api,
+		},
 	}
 	result, err := api.VolumeBlockDevices(c.Context(), params.MachineStorageIds{
 		Ids: []params.MachineStorageId{
@@ -1492,6 +1501,7 @@ func (s *provisionerSuite) TestFilesystemParams(c *tc.C) {
 		Provider:   "myprovider",
 		ProviderID: new("fs-provider-id"),
 		SizeMiB:    10,
+		SnapshotID: "fs-snapshot-id",
 	}, nil)
 
 	results, err := s.api.FilesystemParams(c.Context(), params.Entities{
@@ -1509,6 +1519,7 @@ func (s *provisionerSuite) TestFilesystemParams(c *tc.C) {
 		SizeMiB:       10,
 		Provider:      "myprovider",
 		ProviderId:    new("fs-provider-id"),
+		SnapshotId:    "fs-snapshot-id",
 		Tags: map[string]string{
 			"tag1": "value1",
 		},
@@ -2101,9 +2112,10 @@ func (s *provisionerSuite) TestVolumeParams(c *tc.C) {
 		Attributes: map[string]string{
 			"foo": "bar",
 		},
-		ID:       "vol-id123",
-		Provider: "myprovider",
-		SizeMiB:  10,
+		ID:         "vol-id123",
+		Provider:   "myprovider",
+		SizeMiB:    10,
+		SnapshotID: "vol-snapshot-id",
 	}, nil)
 
 	results, err := s.api.VolumeParams(c.Context(), params.Entities{
@@ -2117,9 +2129,10 @@ func (s *provisionerSuite) TestVolumeParams(c *tc.C) {
 		Attributes: map[string]any{
 			"foo": "bar",
 		},
-		VolumeTag: tag.String(),
-		SizeMiB:   10,
-		Provider:  "myprovider",
+		VolumeTag:  tag.String(),
+		SizeMiB:    10,
+		Provider:   "myprovider",
+		SnapshotId: "vol-snapshot-id",
 		Tags: map[string]string{
 			"tag1": "value1",
 		},
@@ -65,9 +65,14 @@ import (
 	"github.com/juju/juju/rpc/params"
 )
 
+// APIv23 provides the Application API facade for version 23.
+type APIv23 struct {
+	*APIBase
+}
+
 // APIv22 provides the Application API facade for version 22.
 type APIv22 struct {
-	*APIBase
+	*APIv23
 }
 
 // APIv21 provides the Application API facade for version 21.
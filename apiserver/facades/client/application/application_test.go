@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/core/crossmodel"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/core/offer"
 	"github.com/juju/juju/core/os/ostype"
 	corerelation "github.com/juju/juju/core/relation"
@@ -2118,6 +2119,77 @@ func (s *applicationSuite) TestGetApplicationStorageDirectivesInfo(c *tc.C) {
 	})
 }
 
+func (s *applicationSuite) TestGetApplicationEgressRules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().GetApplicationEgressRules(gomock.Any(), "mysql").Return(firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "192.168.0.0/16", "10.0.0.0/8"),
+	}, nil)
+	s.applicationService.EXPECT().GetApplicationEgressRules(gomock.Any(), "foo").Return(nil, applicationerrors.ApplicationNotFound)
+
+	res, err := s.api.GetApplicationEgressRules(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "application-mysql"}, {Tag: "application-foo"}, {Tag: "unit-mysql-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 3)
+	c.Assert(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[0].Rules, tc.DeepEquals, []params.EgressRule{{
+		PortRange:        params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+		DestinationCIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"},
+	}})
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+	c.Check(res.Results[2].Error, tc.ErrorMatches, `"unit-mysql-0" is not a valid application tag`)
+}
+
+func (s *applicationSuite) TestSetApplicationEgressRules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().SetApplicationEgressRules(gomock.Any(), "mysql", firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	}).Return(nil)
+	s.applicationService.EXPECT().SetApplicationEgressRules(gomock.Any(), "foo", firewall.EgressRules{}).Return(applicationerrors.ApplicationNotFound)
+	s.applicationService.EXPECT().SetApplicationEgressRules(gomock.Any(), "bar", gomock.Any()).Return(
+		fmt.Errorf("bogus: %w", applicationerrors.InvalidEgressRule),
+	)
+	s.applicationService.EXPECT().SetApplicationEgressRules(gomock.Any(), "baz", gomock.Any()).Return(
+		fmt.Errorf("lxd: %w", applicationerrors.EgressRulesNotSupported),
+	)
+
+	res, err := s.api.SetApplicationEgressRules(c.Context(), params.SetApplicationEgressRulesArgs{
+		Args: []params.ApplicationEgressRules{{
+			ApplicationTag: "application-mysql",
+			Rules: []params.EgressRule{{
+				PortRange:        params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+				DestinationCIDRs: []string{"10.0.0.0/8"},
+			}},
+		}, {
+			ApplicationTag: "application-foo",
+		}, {
+			ApplicationTag: "application-bar",
+			Rules: []params.EgressRule{{
+				PortRange:        params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+				DestinationCIDRs: []string{"bogus"},
+			}},
+		}, {
+			ApplicationTag: "application-baz",
+			Rules: []params.EgressRule{{
+				PortRange:        params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+				DestinationCIDRs: []string{"10.0.0.0/8"},
+			}},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 4)
+	c.Check(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+	c.Check(res.Results[2].Error, tc.Satisfies, params.IsCodeNotValid)
+	c.Check(res.Results[3].Error, tc.Satisfies, params.IsCodeNotSupported)
+}
+
 func (s *applicationSuite) setupAPI(c *tc.C) {
 	s.expectAuthClient()
 	s.expectAnyPermissions()
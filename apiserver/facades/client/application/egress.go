@@ -0,0 +1,111 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/network/firewall"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// GetApplicationEgressRules returns the egress rules for the specified
+// applications in bulk.
+func (api *APIBase) GetApplicationEgressRules(ctx context.Context, args params.Entities) (params.ApplicationEgressRulesResults, error) {
+	resp := params.ApplicationEgressRulesResults{
+		Results: make([]params.ApplicationEgressRulesResult, len(args.Entities)),
+	}
+	if err := api.checkCanRead(ctx); err != nil {
+		return resp, errors.Trace(err)
+	}
+	for i, entity := range args.Entities {
+		rules, err := api.getOneApplicationEgressRules(ctx, entity.Tag)
+		if err != nil {
+			resp.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		resp.Results[i].Rules = rules
+	}
+	return resp, nil
+}
+
+func (api *APIBase) getOneApplicationEgressRules(ctx context.Context, tag string) ([]params.EgressRule, error) {
+	appTag, err := names.ParseApplicationTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	rules, err := api.applicationService.GetApplicationEgressRules(ctx, appTag.Id())
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return nil, errors.NotFoundf("application %q", appTag.Id())
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]params.EgressRule, len(rules))
+	for i, rule := range rules {
+		result[i] = params.EgressRule{
+			PortRange:        params.FromNetworkPortRange(rule.PortRange),
+			DestinationCIDRs: rule.DestinationCIDRs.SortedValues(),
+		}
+	}
+	return result, nil
+}
+
+// GetApplicationEgressRules isn't on the v22 API.
+func (api *APIv22) GetApplicationEgressRules(_ struct{}) {}
+
+// SetApplicationEgressRules replaces the egress rules for the specified
+// applications in bulk. An empty rule list removes all the egress rules
+// for an application.
+// The following apiserver codes can be returned in each ErrorResult:
+//   - [params.CodeNotFound]: If the application does not exist.
+//   - [params.CodeNotValid]: If any of the rules are not valid.
+//   - [params.CodeNotSupported]: If the model's provider cannot enforce
+//     egress rules.
+func (api *APIBase) SetApplicationEgressRules(ctx context.Context, args params.SetApplicationEgressRulesArgs) (params.ErrorResults, error) {
+	resp := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	if err := api.checkCanWrite(ctx); err != nil {
+		return resp, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return resp, errors.Trace(err)
+	}
+	for i, arg := range args.Args {
+		err := api.setOneApplicationEgressRules(ctx, arg)
+		resp.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return resp, nil
+}
+
+func (api *APIBase) setOneApplicationEgressRules(ctx context.Context, arg params.ApplicationEgressRules) error {
+	appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	rules := make(firewall.EgressRules, len(arg.Rules))
+	for i, rule := range arg.Rules {
+		rules[i] = firewall.NewEgressRule(rule.PortRange.NetworkPortRange(), rule.DestinationCIDRs...)
+	}
+
+	err = api.applicationService.SetApplicationEgressRules(ctx, appTag.Id(), rules)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return errors.NotFoundf("application %q", appTag.Id())
+	} else if errors.Is(err, applicationerrors.InvalidEgressRule) {
+		return errors.NewNotValid(err, "")
+	} else if errors.Is(err, applicationerrors.EgressRulesNotSupported) {
+		return errors.NewNotSupported(err, "")
+	}
+	return errors.Trace(err)
+}
+
+// SetApplicationEgressRules isn't on the v22 API.
+func (api *APIv22) SetApplicationEgressRules(_ struct{}) {}
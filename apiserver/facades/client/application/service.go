@@ -22,6 +22,7 @@ import (
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
 	corerelation "github.com/juju/juju/core/relation"
 	coreremoteapplication "github.com/juju/juju/core/remoteapplication"
 	coreresource "github.com/juju/juju/core/resource"
@@ -393,6 +394,22 @@ type ApplicationService interface {
 	// [applicationerrors.ApplicationNotFound] is returned.
 	MergeExposeSettings(ctx context.Context, appName string, exposedEndpoints map[string]application.ExposedEndpoint) error
 
+	// GetApplicationEgressRules returns the egress rules for the specified
+	// application. An empty list means that no rules have been set.
+	//
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	GetApplicationEgressRules(ctx context.Context, appName string) (firewall.EgressRules, error)
+
+	// SetApplicationEgressRules replaces the egress rules for the specified
+	// application. Passing an empty list removes all rules.
+	//
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned. If the model's
+	// provider cannot enforce egress rules, an error satisfying
+	// [applicationerrors.EgressRulesNotSupported] is returned.
+	SetApplicationEgressRules(ctx context.Context, appName string, rules firewall.EgressRules) error
+
 	// ResolveApplicationConstraints resolves given application constraints, taking
 	// into account the model constraints.
 	ResolveApplicationConstraints(ctx context.Context, appCons constraints.Value) (domainconstraints.Constraints, error)
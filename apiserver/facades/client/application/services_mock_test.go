@@ -25,6 +25,7 @@ import (
 	life "github.com/juju/juju/core/life"
 	machine "github.com/juju/juju/core/machine"
 	network "github.com/juju/juju/core/network"
+	firewall "github.com/juju/juju/core/network/firewall"
 	relation "github.com/juju/juju/core/relation"
 	remoteapplication "github.com/juju/juju/core/remoteapplication"
 	resource "github.com/juju/juju/core/resource"
@@ -905,6 +906,45 @@ func (c *MockApplicationServiceGetApplicationDetailsByNameCall) DoAndReturn(f fu
 	return c
 }
 
+// GetApplicationEgressRules mocks base method.
+func (m *MockApplicationService) GetApplicationEgressRules(arg0 context.Context, arg1 string) (firewall.EgressRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationEgressRules", arg0, arg1)
+	ret0, _ := ret[0].(firewall.EgressRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationEgressRules indicates an expected call of GetApplicationEgressRules.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationEgressRules(arg0, arg1 any) *MockApplicationServiceGetApplicationEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationEgressRules", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationEgressRules), arg0, arg1)
+	return &MockApplicationServiceGetApplicationEgressRulesCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationEgressRulesCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationEgressRulesCall) Return(arg0 firewall.EgressRules, arg1 error) *MockApplicationServiceGetApplicationEgressRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationEgressRulesCall) Do(f func(context.Context, string) (firewall.EgressRules, error)) *MockApplicationServiceGetApplicationEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationEgressRulesCall) DoAndReturn(f func(context.Context, string) (firewall.EgressRules, error)) *MockApplicationServiceGetApplicationEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationEndpointBindings mocks base method.
 func (m *MockApplicationService) GetApplicationEndpointBindings(arg0 context.Context, arg1 string) (map[string]network.SpaceUUID, error) {
 	m.ctrl.T.Helper()
@@ -1956,6 +1996,44 @@ func (c *MockApplicationServiceSetApplicationConstraintsCall) DoAndReturn(f func
 	return c
 }
 
+// SetApplicationEgressRules mocks base method.
+func (m *MockApplicationService) SetApplicationEgressRules(arg0 context.Context, arg1 string, arg2 firewall.EgressRules) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationEgressRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationEgressRules indicates an expected call of SetApplicationEgressRules.
+func (mr *MockApplicationServiceMockRecorder) SetApplicationEgressRules(arg0, arg1, arg2 any) *MockApplicationServiceSetApplicationEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationEgressRules", reflect.TypeOf((*MockApplicationService)(nil).SetApplicationEgressRules), arg0, arg1, arg2)
+	return &MockApplicationServiceSetApplicationEgressRulesCall{Call: call}
+}
+
+// MockApplicationServiceSetApplicationEgressRulesCall wrap *gomock.Call
+type MockApplicationServiceSetApplicationEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceSetApplicationEgressRulesCall) Return(arg0 error) *MockApplicationServiceSetApplicationEgressRulesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceSetApplicationEgressRulesCall) Do(f func(context.Context, string, firewall.EgressRules) error) *MockApplicationServiceSetApplicationEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceSetApplicationEgressRulesCall) DoAndReturn(f func(context.Context, string, firewall.EgressRules) error) *MockApplicationServiceSetApplicationEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetApplicationScale mocks base method.
 func (m *MockApplicationService) SetApplicationScale(arg0 context.Context, arg1 string, arg2 int) error {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/controller"
 	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/objectstore"
 )
 
 // ControllerConfigService is an interface that provides the controller config.
@@ -23,15 +24,22 @@ type ControllerConfigService interface {
 // API provides backup-specific API methods.
 type API struct {
 	controllerConfigService ControllerConfigService
+	objectStore             objectstore.ObjectStore
 	paths                   *corebackups.Paths
 
 	// machineID is the ID of the machine where the API server is running.
 	machineID string
 }
 
+// APIv3 provides the Backups API facade for version 3.
+type APIv3 struct {
+	*API
+}
+
 // NewAPI creates a new instance of the Backups API facade.
 func NewAPI(
 	controllerConfigService ControllerConfigService,
+	objectStore objectstore.ObjectStore,
 	authorizer facade.Authorizer,
 	machineTag names.Tag,
 	dataDir, logDir string,
@@ -51,6 +59,7 @@ func NewAPI(
 
 	b := API{
 		controllerConfigService: controllerConfigService,
+		objectStore:             objectStore,
 		paths:                   &paths,
 		machineID:               machineTag.Id(),
 	}
@@ -0,0 +1,41 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	internalbackups "github.com/juju/juju/internal/backups"
+	"github.com/juju/juju/rpc/params"
+)
+
+// List isn't implemented in the APIv3 facade.
+func (a *APIv3) List(_, _ struct{}) {}
+
+// List is the API method that returns the status of the scheduled backups
+// recorded in the controller object store, oldest first.
+func (a *API) List(ctx context.Context) (params.BackupsListResult, error) {
+	result := params.BackupsListResult{}
+
+	index, err := internalbackups.ReadIndex(ctx, a.objectStore)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.List = make([]params.BackupsIndexEntry, len(index.Entries))
+	for i, entry := range index.Entries {
+		result.List[i] = params.BackupsIndexEntry{
+			Filename: entry.Filename,
+			Started:  entry.Started,
+			Finished: entry.Finished,
+			Size:     entry.Size,
+			Checksum: entry.Checksum,
+			Status:   string(entry.Status),
+			Message:  entry.Message,
+		}
+	}
+	return result, nil
+}
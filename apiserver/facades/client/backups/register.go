@@ -7,20 +7,36 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/juju/errors"
+
 	"github.com/juju/juju/apiserver/facade"
 )
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Backups", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV3(ctx)
+	}, reflect.TypeFor[*APIv3]())
+	registry.MustRegister("Backups", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacade(ctx)
 	}, reflect.TypeFor[*API]())
 }
 
+// newFacadeV3 provides the required signature for version 3 facade
+// registration.
+func newFacadeV3(ctx facade.ModelContext) (*APIv3, error) {
+	api, err := newFacade(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv3{API: api}, nil
+}
+
 // newFacade provides the required signature for facade registration.
 func newFacade(ctx facade.ModelContext) (*API, error) {
 	return NewAPI(
 		ctx.DomainServices().ControllerConfig(),
+		ctx.ControllerObjectStore(),
 		ctx.Auth(),
 		ctx.MachineTag(),
 		ctx.DataDir(),
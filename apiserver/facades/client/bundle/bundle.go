@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/juju/errors"
+	"github.com/juju/names/v6"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
@@ -17,9 +18,12 @@ import (
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/storage"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/domain/desiredbundle"
+	desiredbundleerrors "github.com/juju/juju/domain/desiredbundle/errors"
 	bundlechanges "github.com/juju/juju/internal/bundle/changes"
 	internalerrors "github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/rpc/params"
@@ -43,6 +47,20 @@ type ApplicationService interface {
 	GetCharm(ctx context.Context, locator applicationcharm.CharmLocator) (charm.Charm, applicationcharm.CharmLocator, bool, error)
 }
 
+// DesiredBundleService is an interface for the desired bundle domain
+// service.
+type DesiredBundleService interface {
+	// SetDesiredBundle sets the bundle the model is expected to match.
+	SetDesiredBundle(ctx context.Context, bundle string) error
+
+	// GetDesiredBundle returns the desired bundle of the model, along with
+	// the result of the last comparison of the model with it.
+	GetDesiredBundle(ctx context.Context) (desiredbundle.DesiredBundle, error)
+
+	// RemoveDesiredBundle removes the desired bundle of the model.
+	RemoveDesiredBundle(ctx context.Context) error
+}
+
 // APIv8 provides the Bundle API facade for version 8. It drops IncludeSeries
 // from ExportBundle params, and drops series entirely from ExportBundle output
 type APIv8 struct {
@@ -50,13 +68,15 @@ type APIv8 struct {
 }
 
 // BundleAPI implements the Bundle interface and is the concrete implementation
-// of the API end point.
+// of the API end point. Version 9 adds the desired bundle of the model.
 type BundleAPI struct {
-	store              objectstore.ObjectStore
-	authorizer         facade.Authorizer
-	networkService     NetworkService
-	applicationService ApplicationService
-	logger             corelogger.Logger
+	store                objectstore.ObjectStore
+	authorizer           facade.Authorizer
+	modelTag             names.ModelTag
+	networkService       NetworkService
+	applicationService   ApplicationService
+	desiredBundleService DesiredBundleService
+	logger               corelogger.Logger
 }
 
 // NewFacade provides the required signature for facade registration.
@@ -66,8 +86,10 @@ func newFacade(ctx facade.ModelContext) (*BundleAPI, error) {
 	return NewBundleAPI(
 		ctx.ObjectStore(),
 		authorizer,
+		names.NewModelTag(ctx.ModelUUID().String()),
 		ctx.DomainServices().Network(),
 		ctx.DomainServices().Application(),
+		ctx.DomainServices().DesiredBundle(),
 		ctx.Logger().Child("bundlechanges"),
 	)
 }
@@ -76,8 +98,10 @@ func newFacade(ctx facade.ModelContext) (*BundleAPI, error) {
 func NewBundleAPI(
 	store objectstore.ObjectStore,
 	auth facade.Authorizer,
+	modelTag names.ModelTag,
 	networkService NetworkService,
 	applicationService ApplicationService,
+	desiredBundleService DesiredBundleService,
 	logger corelogger.Logger,
 ) (*BundleAPI, error) {
 	if !auth.AuthClient() {
@@ -85,11 +109,13 @@ func NewBundleAPI(
 	}
 
 	return &BundleAPI{
-		store:              store,
-		authorizer:         auth,
-		networkService:     networkService,
-		applicationService: applicationService,
-		logger:             logger,
+		store:                store,
+		authorizer:           auth,
+		modelTag:             modelTag,
+		networkService:       networkService,
+		applicationService:   applicationService,
+		desiredBundleService: desiredBundleService,
+		logger:               logger,
 	}, nil
 }
 
@@ -199,3 +225,61 @@ func (*BundleAPI) ExportBundle(context.Context, params.ExportBundleParams) (para
 	return params.StringResult{}, apiservererrors.ServerError(internalerrors.Errorf(
 		"Juju 4.0 doesn't support exporting bundles").Add(coreerrors.NotImplemented))
 }
+
+// SetDesiredBundle sets the bundle the model is expected to match. The
+// controller periodically compares the model with the bundle and reports
+// any differences.
+func (b *BundleAPI) SetDesiredBundle(ctx context.Context, args params.SetDesiredBundleParams) error {
+	if err := b.authorizer.HasPermission(ctx, permission.WriteAccess, b.modelTag); err != nil {
+		return errors.Trace(err)
+	}
+	err := b.desiredBundleService.SetDesiredBundle(ctx, args.BundleDataYAML)
+	if errors.Is(err, desiredbundleerrors.BundleNotValid) {
+		return errors.NewNotValid(err, "")
+	}
+	return errors.Trace(err)
+}
+
+// GetDesiredBundle returns the desired bundle of the model and the
+// differences between the model and the bundle found by the last check.
+func (b *BundleAPI) GetDesiredBundle(ctx context.Context) (params.DesiredBundleResult, error) {
+	if err := b.authorizer.HasPermission(ctx, permission.ReadAccess, b.modelTag); err != nil {
+		return params.DesiredBundleResult{}, errors.Trace(err)
+	}
+	desired, err := b.desiredBundleService.GetDesiredBundle(ctx)
+	if errors.Is(err, desiredbundleerrors.NotFound) {
+		return params.DesiredBundleResult{
+			Error: apiservererrors.ServerError(errors.NotFoundf("desired bundle")),
+		}, nil
+	} else if err != nil {
+		return params.DesiredBundleResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return params.DesiredBundleResult{
+		BundleDataYAML: desired.Bundle,
+		SetAt:          desired.SetAt,
+		Drift:          desired.Drift,
+		CheckedAt:      desired.CheckedAt,
+	}, nil
+}
+
+// RemoveDesiredBundle removes the desired bundle of the model, which stops
+// the model being compared with it.
+func (b *BundleAPI) RemoveDesiredBundle(ctx context.Context) error {
+	if err := b.authorizer.HasPermission(ctx, permission.WriteAccess, b.modelTag); err != nil {
+		return errors.Trace(err)
+	}
+	err := b.desiredBundleService.RemoveDesiredBundle(ctx)
+	if errors.Is(err, desiredbundleerrors.NotFound) {
+		return errors.NotFoundf("desired bundle")
+	}
+	return errors.Trace(err)
+}
+
+// SetDesiredBundle isn't on the v8 API.
+func (*APIv8) SetDesiredBundle(_, _ struct{}) {}
+
+// GetDesiredBundle isn't on the v8 API.
+func (*APIv8) GetDesiredBundle(_, _ struct{}) {}
+
+// RemoveDesiredBundle isn't on the v8 API.
+func (*APIv8) RemoveDesiredBundle(_, _ struct{}) {}
@@ -20,11 +20,12 @@ import (
 
 type bundleSuite struct {
 	coretesting.BaseSuite
-	auth               *apiservertesting.FakeAuthorizer
-	facade             *bundle.APIv8
-	store              *mockObjectStore
-	networkService     *MockNetworkService
-	applicationService *MockApplicationService
+	auth                 *apiservertesting.FakeAuthorizer
+	facade               *bundle.APIv8
+	store                *mockObjectStore
+	networkService       *MockNetworkService
+	applicationService   *MockApplicationService
+	desiredBundleService *MockDesiredBundleService
 }
 
 func TestBundleSuite(t *testing.T) {
@@ -35,6 +36,7 @@ func (s *bundleSuite) setUpMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.networkService = NewMockNetworkService(ctrl)
 	s.applicationService = NewMockApplicationService(ctrl)
+	s.desiredBundleService = NewMockDesiredBundleService(ctrl)
 	return ctrl
 }
 
@@ -48,15 +50,21 @@ func (s *bundleSuite) SetUpTest(c *tc.C) {
 }
 
 func (s *bundleSuite) makeAPI(c *tc.C) *bundle.APIv8 {
+	return &bundle.APIv8{s.makeAPIv9(c)}
+}
+
+func (s *bundleSuite) makeAPIv9(c *tc.C) *bundle.BundleAPI {
 	api, err := bundle.NewBundleAPI(
 		s.store,
 		s.auth,
+		coretesting.ModelTag,
 		s.networkService,
 		s.applicationService,
+		s.desiredBundleService,
 		loggertesting.WrapCheckLog(c),
 	)
 	c.Assert(err, tc.ErrorIsNil)
-	return &bundle.APIv8{api}
+	return api
 }
 
 func (s *bundleSuite) TestGetChangesMapArgsBundleContentError(c *tc.C) {
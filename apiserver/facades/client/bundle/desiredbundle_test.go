@@ -0,0 +1,96 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundle_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/domain/desiredbundle"
+	desiredbundleerrors "github.com/juju/juju/domain/desiredbundle/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+func (s *bundleSuite) TestSetDesiredBundle(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.auth.Tag = names.NewUserTag("write")
+
+	s.desiredBundleService.EXPECT().SetDesiredBundle(gomock.Any(), "applications: {}").Return(nil)
+
+	err := s.makeAPIv9(c).SetDesiredBundle(c.Context(), params.SetDesiredBundleParams{
+		BundleDataYAML: "applications: {}",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *bundleSuite) TestSetDesiredBundleNotValid(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.auth.Tag = names.NewUserTag("write")
+
+	s.desiredBundleService.EXPECT().SetDesiredBundle(gomock.Any(), ":").Return(desiredbundleerrors.BundleNotValid)
+
+	err := s.makeAPIv9(c).SetDesiredBundle(c.Context(), params.SetDesiredBundleParams{
+		BundleDataYAML: ":",
+	})
+	c.Assert(err, tc.ErrorIs, errors.NotValid)
+}
+
+func (s *bundleSuite) TestSetDesiredBundleNoWriteAccess(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	err := s.makeAPIv9(c).SetDesiredBundle(c.Context(), params.SetDesiredBundleParams{
+		BundleDataYAML: "applications: {}",
+	})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *bundleSuite) TestGetDesiredBundle(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	setAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	checkedAt := setAt.Add(time.Minute)
+	s.desiredBundleService.EXPECT().GetDesiredBundle(gomock.Any()).Return(desiredbundle.DesiredBundle{
+		UUID:      "bundle-uuid",
+		Bundle:    "applications: {}",
+		SetAt:     setAt,
+		Drift:     "applications: {}\n",
+		CheckedAt: &checkedAt,
+	}, nil)
+
+	result, err := s.makeAPIv9(c).GetDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.DesiredBundleResult{
+		BundleDataYAML: "applications: {}",
+		SetAt:          setAt,
+		Drift:          "applications: {}\n",
+		CheckedAt:      &checkedAt,
+	})
+}
+
+func (s *bundleSuite) TestGetDesiredBundleNotFound(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	s.desiredBundleService.EXPECT().GetDesiredBundle(gomock.Any()).Return(
+		desiredbundle.DesiredBundle{}, desiredbundleerrors.NotFound)
+
+	result, err := s.makeAPIv9(c).GetDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.NotNil)
+	c.Check(result.Error.Code, tc.Equals, params.CodeNotFound)
+}
+
+func (s *bundleSuite) TestRemoveDesiredBundleNotFound(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.auth.Tag = names.NewUserTag("write")
+
+	s.desiredBundleService.EXPECT().RemoveDesiredBundle(gomock.Any()).Return(desiredbundleerrors.NotFound)
+
+	err := s.makeAPIv9(c).RemoveDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+}
@@ -17,6 +17,9 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Bundle", 8, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV8(ctx)
 	}, reflect.TypeFor[*APIv8]())
+	registry.MustRegister("Bundle", 9, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacade(ctx)
+	}, reflect.TypeFor[*BundleAPI]())
 }
 
 // newFacadeV8 provides the signature required for facade registration
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/bundle (interfaces: NetworkService,ApplicationService)
+// Source: github.com/juju/juju/apiserver/facades/client/bundle (interfaces: NetworkService,ApplicationService,DesiredBundleService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package bundle_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/bundle NetworkService,ApplicationService
+//	mockgen -typed -package bundle_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/bundle NetworkService,ApplicationService,DesiredBundleService
 //
 
 // Package bundle_test is a generated GoMock package.
@@ -16,6 +16,7 @@ import (
 	network "github.com/juju/juju/core/network"
 	charm "github.com/juju/juju/domain/application/charm"
 	charm0 "github.com/juju/juju/domain/deployment/charm"
+	desiredbundle "github.com/juju/juju/domain/desiredbundle"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -144,3 +145,141 @@ func (c *MockApplicationServiceGetCharmCall) DoAndReturn(f func(context.Context,
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockDesiredBundleService is a mock of DesiredBundleService interface.
+type MockDesiredBundleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDesiredBundleServiceMockRecorder
+}
+
+// MockDesiredBundleServiceMockRecorder is the mock recorder for MockDesiredBundleService.
+type MockDesiredBundleServiceMockRecorder struct {
+	mock *MockDesiredBundleService
+}
+
+// NewMockDesiredBundleService creates a new mock instance.
+func NewMockDesiredBundleService(ctrl *gomock.Controller) *MockDesiredBundleService {
+	mock := &MockDesiredBundleService{ctrl: ctrl}
+	mock.recorder = &MockDesiredBundleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDesiredBundleService) EXPECT() *MockDesiredBundleServiceMockRecorder {
+	return m.recorder
+}
+
+// GetDesiredBundle mocks base method.
+func (m *MockDesiredBundleService) GetDesiredBundle(arg0 context.Context) (desiredbundle.DesiredBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDesiredBundle", arg0)
+	ret0, _ := ret[0].(desiredbundle.DesiredBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDesiredBundle indicates an expected call of GetDesiredBundle.
+func (mr *MockDesiredBundleServiceMockRecorder) GetDesiredBundle(arg0 any) *MockDesiredBundleServiceGetDesiredBundleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDesiredBundle", reflect.TypeOf((*MockDesiredBundleService)(nil).GetDesiredBundle), arg0)
+	return &MockDesiredBundleServiceGetDesiredBundleCall{Call: call}
+}
+
+// MockDesiredBundleServiceGetDesiredBundleCall wrap *gomock.Call
+type MockDesiredBundleServiceGetDesiredBundleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDesiredBundleServiceGetDesiredBundleCall) Return(arg0 desiredbundle.DesiredBundle, arg1 error) *MockDesiredBundleServiceGetDesiredBundleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDesiredBundleServiceGetDesiredBundleCall) Do(f func(context.Context) (desiredbundle.DesiredBundle, error)) *MockDesiredBundleServiceGetDesiredBundleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDesiredBundleServiceGetDesiredBundleCall) DoAndReturn(f func(context.Context) (desiredbundle.DesiredBundle, error)) *MockDesiredBundleServiceGetDesiredBundleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveDesiredBundle mocks base method.
+func (m *MockDesiredBundleService) RemoveDesiredBundle(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDesiredBundle", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDesiredBundle indicates an expected call of RemoveDesiredBundle.
+func (mr *MockDesiredBundleServiceMockRecorder) RemoveDesiredBundle(arg0 any) *MockDesiredBundleServiceRemoveDesiredBundleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDesiredBundle", reflect.TypeOf((*MockDesiredBundleService)(nil).RemoveDesiredBundle), arg0)
+	return &MockDesiredBundleServiceRemoveDesiredBundleCall{Call: call}
+}
+
+// MockDesiredBundleServiceRemoveDesiredBundleCall wrap *gomock.Call
+type MockDesiredBundleServiceRemoveDesiredBundleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDesiredBundleServiceRemoveDesiredBundleCall) Return(arg0 error) *MockDesiredBundleServiceRemoveDesiredBundleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDesiredBundleServiceRemoveDesiredBundleCall) Do(f func(context.Context) error) *MockDesiredBundleServiceRemoveDesiredBundleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDesiredBundleServiceRemoveDesiredBundleCall) DoAndReturn(f func(context.Context) error) *MockDesiredBundleServiceRemoveDesiredBundleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetDesiredBundle mocks base method.
+func (m *MockDesiredBundleService) SetDesiredBundle(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDesiredBundle", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDesiredBundle indicates an expected call of SetDesiredBundle.
+func (mr *MockDesiredBundleServiceMockRecorder) SetDesiredBundle(arg0, arg1 any) *MockDesiredBundleServiceSetDesiredBundleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDesiredBundle", reflect.TypeOf((*MockDesiredBundleService)(nil).SetDesiredBundle), arg0, arg1)
+	return &MockDesiredBundleServiceSetDesiredBundleCall{Call: call}
+}
+
+// MockDesiredBundleServiceSetDesiredBundleCall wrap *gomock.Call
+type MockDesiredBundleServiceSetDesiredBundleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDesiredBundleServiceSetDesiredBundleCall) Return(arg0 error) *MockDesiredBundleServiceSetDesiredBundleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDesiredBundleServiceSetDesiredBundleCall) Do(f func(context.Context, string) error) *MockDesiredBundleServiceSetDesiredBundleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDesiredBundleServiceSetDesiredBundleCall) DoAndReturn(f func(context.Context, string) error) *MockDesiredBundleServiceSetDesiredBundleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -20,6 +20,12 @@ import (
 
 var logger = internallogger.GetLogger("juju.apiserver.client")
 
+// ClientV8 serves client-specific API methods for version 8 of the facade,
+// which does not support watching the model status.
+type ClientV8 struct {
+	*Client
+}
+
 // Client serves client-specific API methods.
 type Client struct {
 	controllerTag names.ControllerTag
@@ -27,6 +33,7 @@ type Client struct {
 
 	auth             facade.Authorizer
 	leadershipReader leadership.Reader
+	watcherRegistry  facade.WatcherRegistry
 
 	logDir string
 	clock  clock.Clock
@@ -284,6 +284,47 @@ func (s *fullStatusSuite) TestFullStatusOffersNotIncluded(c *tc.C) {
 	c.Assert(output.Offers, tc.DeepEquals, map[string]params.ApplicationOfferStatus{})
 }
 
+func (s *fullStatusSuite) TestCheckStatusConditions(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	client := s.client(false)
+	s.expectCheckCanRead(client, true)
+	s.expectCheckIsAdmin(client, false)
+	s.modelInfoService.EXPECT().GetModelInfo(c.Context()).Return(model.ModelInfo{
+		Cloud:     "k8s",
+		CloudType: "k8s",
+		Type:      model.CAAS, // skip fetching machines
+	}, nil)
+	s.statusService.EXPECT().GetModelStatus(gomock.Any()).Return(status.StatusInfo{
+		Status: status.Available,
+	}, nil)
+	s.expectEmptyModelModuloOffers(c)
+	s.applicationService.EXPECT().GetUnitsK8sPodInfo(gomock.Any()).Return(nil, nil)
+
+	result, err := client.CheckStatusConditions(c.Context(), params.StatusConditionsArgs{
+		Kind:       "model",
+		Name:       "test",
+		Conditions: []string{"status=available", "applications>0"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.StatusConditionsResult{
+		Unmet: []string{"applications>0 (applications=0)"},
+	})
+}
+
+func (s *fullStatusSuite) TestCheckStatusConditionsNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	result, err := s.client(false).CheckStatusConditions(c.Context(), params.StatusConditionsArgs{
+		Kind:       "application",
+		Name:       "mysql",
+		Conditions: []string{"leader=true"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error, tc.ErrorMatches, `application field "leader" .* not valid`)
+	c.Check(result.Error.Code, tc.Equals, params.CodeNotValid)
+}
+
 func (s *fullStatusSuite) TestFullStatusUsesControllerFlagOnMachineStatus(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -624,6 +665,11 @@ func (s *fullStatusSuite) TestProcessStorageLinksFilesystemStorage(c *tc.C) {
 					Unit:     unitName,
 					Machine:  &machineName,
 					Location: "/srv/multi-fs/storage-instance",
+					Usage: &service.StorageUsage{
+						SizeMiB:   2048,
+						UsedMiB:   1024,
+						UpdatedAt: filesystemSince,
+					},
 				},
 			},
 		}}, nil)
@@ -669,6 +715,11 @@ func (s *fullStatusSuite) TestProcessStorageLinksFilesystemStorage(c *tc.C) {
 	c.Check(attachment.MachineTag, tc.Equals, "machine-0")
 	c.Check(attachment.Location, tc.Equals, "/srv/multi-fs/storage-instance")
 	c.Check(attachment.Life, tc.Equals, corelife.Alive)
+	c.Check(attachment.Usage, tc.DeepEquals, &params.StorageUsage{
+		SizeMiB: 2048,
+		UsedMiB: 1024,
+		Updated: filesystemSince,
+	})
 }
 
 func (s *fullStatusSuite) TestProcessStorageLinksVolumeStorage(c *tc.C) {
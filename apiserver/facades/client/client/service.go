@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/core/relation"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/domain/application"
 	"github.com/juju/juju/domain/application/architecture"
 	"github.com/juju/juju/domain/application/charm"
@@ -76,6 +77,10 @@ type StatusService interface {
 	// GetStatusHistory returns the status history based on the request.
 	GetStatusHistory(context.Context, statusservice.StatusHistoryRequest) ([]status.DetailedStatus, error)
 
+	// GetAllStatusHistory returns the status history of every entity in the
+	// model within the given range.
+	GetAllStatusHistory(context.Context, statusservice.StatusHistoryRange, statusservice.StatusHistoryPage) ([]statusservice.StatusHistoryEntry, error)
+
 	// GetModelStatus returns the current status of the model.
 	GetModelStatus(context.Context) (status.StatusInfo, error)
 
@@ -92,6 +97,10 @@ type StatusService interface {
 
 	// GetAllVolumeStatuses returns all the volume statuses for the model.
 	GetAllVolumeStatuses(ctx context.Context) ([]statusservice.Volume, error)
+
+	// WatchModelStatus returns a watcher that notifies when the status of
+	// any application, unit, machine or relation in the model changes.
+	WatchModelStatus(ctx context.Context) (watcher.NotifyWatcher, error)
 }
 
 // ControllerConfigService is used to retrieve API port and SSH port.
@@ -21,6 +21,7 @@ import (
 	relation "github.com/juju/juju/core/relation"
 	status "github.com/juju/juju/core/status"
 	unit "github.com/juju/juju/core/unit"
+	watcher "github.com/juju/juju/core/watcher"
 	application "github.com/juju/juju/domain/application"
 	architecture "github.com/juju/juju/domain/application/architecture"
 	charm "github.com/juju/juju/domain/application/charm"
@@ -1004,6 +1005,45 @@ func (c *MockStatusServiceGetAllRelationStatusesCall) DoAndReturn(f func(context
 	return c
 }
 
+// GetAllStatusHistory mocks base method.
+func (m *MockStatusService) GetAllStatusHistory(arg0 context.Context, arg1 service0.StatusHistoryRange, arg2 service0.StatusHistoryPage) ([]service0.StatusHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStatusHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]service0.StatusHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllStatusHistory indicates an expected call of GetAllStatusHistory.
+func (mr *MockStatusServiceMockRecorder) GetAllStatusHistory(arg0, arg1, arg2 any) *MockStatusServiceGetAllStatusHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStatusHistory", reflect.TypeOf((*MockStatusService)(nil).GetAllStatusHistory), arg0, arg1, arg2)
+	return &MockStatusServiceGetAllStatusHistoryCall{Call: call}
+}
+
+// MockStatusServiceGetAllStatusHistoryCall wrap *gomock.Call
+type MockStatusServiceGetAllStatusHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceGetAllStatusHistoryCall) Return(arg0 []service0.StatusHistoryEntry, arg1 error) *MockStatusServiceGetAllStatusHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceGetAllStatusHistoryCall) Do(f func(context.Context, service0.StatusHistoryRange, service0.StatusHistoryPage) ([]service0.StatusHistoryEntry, error)) *MockStatusServiceGetAllStatusHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceGetAllStatusHistoryCall) DoAndReturn(f func(context.Context, service0.StatusHistoryRange, service0.StatusHistoryPage) ([]service0.StatusHistoryEntry, error)) *MockStatusServiceGetAllStatusHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetAllStorageInstanceStatuses mocks base method.
 func (m *MockStatusService) GetAllStorageInstanceStatuses(arg0 context.Context) ([]service0.StorageInstance, error) {
 	m.ctrl.T.Helper()
@@ -1276,3 +1316,42 @@ func (c *MockStatusServiceGetStatusHistoryCall) DoAndReturn(f func(context.Conte
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// WatchModelStatus mocks base method.
+func (m *MockStatusService) WatchModelStatus(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchModelStatus", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchModelStatus indicates an expected call of WatchModelStatus.
+func (mr *MockStatusServiceMockRecorder) WatchModelStatus(arg0 any) *MockStatusServiceWatchModelStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchModelStatus", reflect.TypeOf((*MockStatusService)(nil).WatchModelStatus), arg0)
+	return &MockStatusServiceWatchModelStatusCall{Call: call}
+}
+
+// MockStatusServiceWatchModelStatusCall wrap *gomock.Call
+type MockStatusServiceWatchModelStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceWatchModelStatusCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockStatusServiceWatchModelStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceWatchModelStatusCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockStatusServiceWatchModelStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceWatchModelStatusCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockStatusServiceWatchModelStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
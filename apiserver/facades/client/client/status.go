@@ -109,6 +109,70 @@ func (c *Client) StatusHistory(ctx context.Context, requests params.StatusHistor
 	}
 }
 
+// ExportStatusHistory isn't on the v8 API.
+func (c *ClientV8) ExportStatusHistory(_, _ struct{}) {}
+
+// maxExportStatusHistoryPage is the largest number of entries returned in
+// a single page of exported status history.
+const maxExportStatusHistoryPage = 1000
+
+// ExportStatusHistory returns a page of the status history of every entity
+// in the model within the requested time range. Pages are requested in
+// turn, passing the NextCursor of each page as the Cursor of the next.
+func (c *Client) ExportStatusHistory(ctx context.Context, args params.ExportStatusHistoryArgs) (params.ExportStatusHistoryResult, error) {
+	if err := c.checkCanRead(ctx); err != nil {
+		return params.ExportStatusHistoryResult{}, err
+	}
+	if args.Cursor < 0 {
+		return params.ExportStatusHistoryResult{
+			Error: apiservererrors.ServerError(internalerrors.Errorf("cursor %d", args.Cursor).Add(errors.NotValid)),
+		}, nil
+	}
+	limit := args.Limit
+	if limit <= 0 || limit > maxExportStatusHistoryPage {
+		limit = maxExportStatusHistoryPage
+	}
+
+	// One more entry than the limit is read to find out whether there is
+	// another page.
+	entries, err := c.statusService.GetAllStatusHistory(ctx, statusservice.StatusHistoryRange{
+		From: args.From,
+		To:   args.To,
+	}, statusservice.StatusHistoryPage{
+		Offset: args.Cursor,
+		Limit:  limit + 1,
+	})
+	if err != nil {
+		return params.ExportStatusHistoryResult{
+			Error: apiservererrors.ServerError(err),
+		}, nil
+	}
+	var nextCursor int
+	if len(entries) > limit {
+		entries = entries[:limit]
+		nextCursor = args.Cursor + limit
+	}
+
+	results := make([]params.StatusHistoryEntry, len(entries))
+	for i, entry := range entries {
+		results[i] = params.StatusHistoryEntry{
+			Kind: entry.Kind.String(),
+			ID:   entry.ID,
+			Status: params.DetailedStatus{
+				Status: entry.Status.Status.String(),
+				Info:   entry.Status.Info,
+				Since:  entry.Status.Since,
+				Kind:   entry.Status.Kind.String(),
+				Data:   entry.Status.Data,
+			},
+		}
+	}
+	return params.ExportStatusHistoryResult{
+		Entries:    results,
+		NextCursor: nextCursor,
+	}, nil
+}
+
 func statusHistoryResultsError(err error, amount int) params.StatusHistoryResults {
 	results := make([]params.StatusHistoryResult, amount)
 	for i := range results {
@@ -1500,6 +1564,13 @@ func processStorage(
 			if sa.Machine != nil {
 				sad.MachineTag = names.NewMachineTag(sa.Machine.String()).String()
 			}
+			if sa.Usage != nil {
+				sad.Usage = &params.StorageUsage{
+					SizeMiB: sa.Usage.SizeMiB,
+					UsedMiB: sa.Usage.UsedMiB,
+					Updated: sa.Usage.UpdatedAt,
+				}
+			}
 			if details.Attachments == nil {
 				details.Attachments = map[string]params.StorageAttachmentDetails{}
 			}
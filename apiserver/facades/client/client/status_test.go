@@ -5,6 +5,7 @@ package client
 
 import (
 	"testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -193,6 +194,109 @@ func (s *statusSuite) TestStatusHistoryError(c *tc.C) {
 	}})
 }
 
+func (s *statusSuite) TestExportStatusHistory(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	from := now.Add(-time.Hour)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.statusService.EXPECT().GetAllStatusHistory(gomock.Any(), statusservice.StatusHistoryRange{
+		From: &from,
+	}, statusservice.StatusHistoryPage{
+		Limit: maxExportStatusHistoryPage + 1,
+	}).Return([]statusservice.StatusHistoryEntry{{
+		Kind: status.KindWorkload,
+		ID:   "foo/0",
+		Status: status.DetailedStatus{
+			Kind:   status.KindWorkload,
+			Status: status.Active,
+			Info:   "ready",
+			Data:   map[string]any{"foo": "bar"},
+			Since:  &now,
+		},
+	}}, nil)
+
+	client := &Client{
+		statusService: s.statusService,
+		auth:          s.authorizer,
+	}
+	result, err := client.ExportStatusHistory(c.Context(), params.ExportStatusHistoryArgs{
+		From: &from,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ExportStatusHistoryResult{
+		Entries: []params.StatusHistoryEntry{{
+			Kind: "workload",
+			ID:   "foo/0",
+			Status: params.DetailedStatus{
+				Kind:   "workload",
+				Status: "active",
+				Info:   "ready",
+				Data:   map[string]any{"foo": "bar"},
+				Since:  &now,
+			},
+		}},
+	})
+}
+
+func (s *statusSuite) TestExportStatusHistoryPaged(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	entry := statusservice.StatusHistoryEntry{
+		Kind: status.KindApplication,
+		ID:   "foo",
+		Status: status.DetailedStatus{
+			Kind:   status.KindApplication,
+			Status: status.Active,
+		},
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil).Times(2)
+	s.statusService.EXPECT().GetAllStatusHistory(gomock.Any(), statusservice.StatusHistoryRange{}, statusservice.StatusHistoryPage{
+		Offset: 4,
+		Limit:  3,
+	}).Return([]statusservice.StatusHistoryEntry{entry, entry, entry}, nil)
+	s.statusService.EXPECT().GetAllStatusHistory(gomock.Any(), statusservice.StatusHistoryRange{}, statusservice.StatusHistoryPage{
+		Offset: 6,
+		Limit:  3,
+	}).Return([]statusservice.StatusHistoryEntry{entry}, nil)
+
+	client := &Client{
+		statusService: s.statusService,
+		auth:          s.authorizer,
+	}
+	result, err := client.ExportStatusHistory(c.Context(), params.ExportStatusHistoryArgs{
+		Cursor: 4,
+		Limit:  2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Entries, tc.HasLen, 2)
+	c.Check(result.NextCursor, tc.Equals, 6)
+
+	result, err = client.ExportStatusHistory(c.Context(), params.ExportStatusHistoryArgs{
+		Cursor: 6,
+		Limit:  2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Entries, tc.HasLen, 1)
+	c.Check(result.NextCursor, tc.Equals, 0)
+}
+
+func (s *statusSuite) TestExportStatusHistoryError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.statusService.EXPECT().GetAllStatusHistory(gomock.Any(), statusservice.StatusHistoryRange{}, gomock.Any()).Return(nil, errors.Errorf("boom"))
+
+	client := &Client{
+		statusService: s.statusService,
+		auth:          s.authorizer,
+	}
+	result, err := client.ExportStatusHistory(c.Context(), params.ExportStatusHistoryArgs{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error, tc.ErrorMatches, "boom")
+}
+
 func (s *statusSuite) TestFetchOffers(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
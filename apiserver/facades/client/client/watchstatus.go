@@ -0,0 +1,64 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package client
+
+import (
+	"context"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/internal"
+	"github.com/juju/juju/internal/statusquery"
+	"github.com/juju/juju/rpc/params"
+)
+
+// WatchStatus isn't on the v8 API.
+func (c *ClientV8) WatchStatus(_, _ struct{}) {}
+
+// WatchStatus returns a notify watcher that fires whenever the status of an
+// application, unit, machine or relation in the model changes. Clients use
+// it to know when to fetch the full status again, rather than polling.
+func (c *Client) WatchStatus(ctx context.Context) (params.NotifyWatchResult, error) {
+	if err := c.checkCanRead(ctx); err != nil {
+		return params.NotifyWatchResult{}, err
+	}
+
+	w, err := c.statusService.WatchModelStatus(ctx)
+	if err != nil {
+		return params.NotifyWatchResult{
+			Error: apiservererrors.ServerError(err),
+		}, nil
+	}
+	id, _, err := internal.EnsureRegisterWatcher(ctx, c.watcherRegistry, w)
+	if err != nil {
+		return params.NotifyWatchResult{
+			Error: apiservererrors.ServerError(err),
+		}, nil
+	}
+	return params.NotifyWatchResult{NotifyWatcherId: id}, nil
+}
+
+// CheckStatusConditions isn't on the v8 API.
+func (c *ClientV8) CheckStatusConditions(_, _ struct{}) {}
+
+// CheckStatusConditions evaluates conditions on the status of an entity in
+// the model, returning those that are not met. Clients waiting for an
+// entity call it each time the WatchStatus watcher fires, rather than
+// fetching and evaluating the full status themselves.
+func (c *Client) CheckStatusConditions(ctx context.Context, args params.StatusConditionsArgs) (params.StatusConditionsResult, error) {
+	q, err := statusquery.ParseQuery(statusquery.Kind(args.Kind), args.Name, args.Conditions)
+	if err != nil {
+		return params.StatusConditionsResult{
+			Error: apiservererrors.ServerError(err),
+		}, nil
+	}
+
+	// FullStatus checks that the user can read the model.
+	fullStatus, err := c.FullStatus(ctx, params.StatusParams{Patterns: q.Patterns()})
+	if err != nil {
+		return params.StatusConditionsResult{}, err
+	}
+	return params.StatusConditionsResult{
+		Unmet: q.Unmet(&fullStatus),
+	}, nil
+}
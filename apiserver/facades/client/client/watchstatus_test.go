@@ -0,0 +1,90 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type watchStatusSuite struct {
+	testhelpers.IsolationSuite
+
+	authorizer      *MockAuthorizer
+	statusService   *MockStatusService
+	watcherRegistry *MockWatcherRegistry
+}
+
+func TestWatchStatusSuite(t *testing.T) {
+	tc.Run(t, &watchStatusSuite{})
+}
+
+func (s *watchStatusSuite) TestWatchStatus(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan struct{}, 1)
+	ch <- struct{}{}
+	w := watchertest.NewMockNotifyWatcher(ch)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.statusService.EXPECT().WatchModelStatus(gomock.Any()).Return(w, nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), w).Return("42", nil)
+
+	result, err := s.newClient().WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.NotifyWatchResult{NotifyWatcherId: "42"})
+}
+
+func (s *watchStatusSuite) TestWatchStatusError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.statusService.EXPECT().WatchModelStatus(gomock.Any()).Return(nil, errors.New("boom"))
+
+	result, err := s.newClient().WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error, tc.ErrorMatches, "boom")
+	c.Check(result.NotifyWatcherId, tc.Equals, "")
+}
+
+func (s *watchStatusSuite) TestWatchStatusPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(errors.Errorf("not superuser"))
+
+	_, err := s.newClient().WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorMatches, "not superuser")
+}
+
+func (s *watchStatusSuite) newClient() *Client {
+	return &Client{
+		auth:            s.authorizer,
+		statusService:   s.statusService,
+		watcherRegistry: s.watcherRegistry,
+	}
+}
+
+func (s *watchStatusSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.statusService = NewMockStatusService(ctrl)
+	s.watcherRegistry = NewMockWatcherRegistry(ctrl)
+
+	c.Cleanup(func() {
+		s.authorizer = nil
+		s.statusService = nil
+		s.watcherRegistry = nil
+	})
+
+	return ctrl
+}
@@ -161,6 +161,45 @@ func (c *MockSecretBackendServiceDeleteSecretBackendCall) DoAndReturn(f func(con
 	return c
 }
 
+// RotateSecretEncryptionKey mocks base method.
+func (m *MockSecretBackendService) RotateSecretEncryptionKey(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecretEncryptionKey", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSecretEncryptionKey indicates an expected call of RotateSecretEncryptionKey.
+func (mr *MockSecretBackendServiceMockRecorder) RotateSecretEncryptionKey(arg0 any) *MockSecretBackendServiceRotateSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecretEncryptionKey", reflect.TypeOf((*MockSecretBackendService)(nil).RotateSecretEncryptionKey), arg0)
+	return &MockSecretBackendServiceRotateSecretEncryptionKeyCall{Call: call}
+}
+
+// MockSecretBackendServiceRotateSecretEncryptionKeyCall wrap *gomock.Call
+type MockSecretBackendServiceRotateSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendServiceRotateSecretEncryptionKeyCall) Return(arg0 int, arg1 error) *MockSecretBackendServiceRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendServiceRotateSecretEncryptionKeyCall) Do(f func(context.Context) (int, error)) *MockSecretBackendServiceRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendServiceRotateSecretEncryptionKeyCall) DoAndReturn(f func(context.Context) (int, error)) *MockSecretBackendServiceRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateSecretBackend mocks base method.
 func (m *MockSecretBackendService) UpdateSecretBackend(arg0 context.Context, arg1 service.UpdateSecretBackendParams) error {
 	m.ctrl.T.Helper()
@@ -14,10 +14,21 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("SecretBackends", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretBackendsAPIV1(ctx)
+	}, reflect.TypeFor[*SecretBackendsAPIV1]())
+	registry.MustRegister("SecretBackends", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newSecretBackendsAPI(ctx)
 	}, reflect.TypeFor[*SecretBackendsAPI]())
 }
 
+func newSecretBackendsAPIV1(context facade.ModelContext) (*SecretBackendsAPIV1, error) {
+	api, err := newSecretBackendsAPI(context)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretBackendsAPIV1{SecretBackendsAPI: api}, nil
+}
+
 // newSecretBackendsAPI creates a SecretBackendsAPI.
 func newSecretBackendsAPI(context facade.ModelContext) (*SecretBackendsAPI, error) {
 	if !context.Auth().AuthClient() {
@@ -11,6 +11,7 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/domain/secretbackend"
@@ -28,6 +29,12 @@ type SecretBackendsAPI struct {
 	backendService SecretBackendService
 }
 
+// SecretBackendsAPIV1 is the server implementation for the SecretBackends
+// facade v1.
+type SecretBackendsAPIV1 struct {
+	*SecretBackendsAPI
+}
+
 func (s *SecretBackendsAPI) checkCanAdmin(ctx context.Context) error {
 	return s.authorizer.HasPermission(ctx, permission.SuperuserAccess, names.NewControllerTag(s.controllerUUID))
 }
@@ -146,3 +153,24 @@ func (s *SecretBackendsAPI) RemoveSecretBackends(ctx context.Context, args param
 	}
 	return result, nil
 }
+
+// RotateSecretEncryptionKey rewraps the secret data key of each model with
+// the active secret encryption master key.
+func (s *SecretBackendsAPI) RotateSecretEncryptionKey(ctx context.Context) (params.RotateSecretEncryptionKeyResult, error) {
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return params.RotateSecretEncryptionKeyResult{}, errors.Trace(err)
+	}
+	rewrapped, err := s.backendService.RotateSecretEncryptionKey(ctx)
+	if errors.Is(err, secretbackenderrors.EncryptionNotConfigured) {
+		return params.RotateSecretEncryptionKeyResult{}, apiservererrors.ParamsErrorf(
+			params.CodeNotSupported,
+			"secret encryption is not enabled: set %q in the controller config", controller.SecretEncryptionMasterKey,
+		)
+	} else if err != nil {
+		return params.RotateSecretEncryptionKeyResult{}, apiservererrors.ServerError(err)
+	}
+	return params.RotateSecretEncryptionKeyResult{Rewrapped: rewrapped}, nil
+}
+
+// RotateSecretEncryptionKey is not available on version 1 of the facade.
+func (*SecretBackendsAPIV1) RotateSecretEncryptionKey(_, _ struct{}) {}
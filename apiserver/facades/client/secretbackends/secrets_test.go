@@ -335,3 +335,38 @@ func (s *SecretsSuite) TestRemoveSecretBackendsInUse(c *tc.C) {
 			Message: `deleting in use secret backend not supported`}},
 	})
 }
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+	s.mockBackendService.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return(3, nil)
+
+	result, err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.Equals, params.RotateSecretEncryptionKeyResult{Rewrapped: 3})
+}
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKeyNotConfigured(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+	s.mockBackendService.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return(0, secretbackenderrors.EncryptionNotConfigured)
+
+	_, err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorMatches, `secret encryption is not enabled: set "secret-encryption-master-key" in the controller config`)
+	c.Assert(params.IsCodeNotSupported(err), tc.IsTrue)
+}
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKeyPermissionDenied(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	_, err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
@@ -16,4 +16,5 @@ type SecretBackendService interface {
 	UpdateSecretBackend(context.Context, secretbackendservice.UpdateSecretBackendParams) error
 	DeleteSecretBackend(context.Context, secretbackendservice.DeleteSecretBackendParams) error
 	BackendSummaryInfo(ctx context.Context, reveal bool, names ...string) ([]*secretbackendservice.SecretBackendInfo, error)
+	RotateSecretEncryptionKey(ctx context.Context) (int, error)
 }
@@ -120,19 +120,97 @@ func (c *MockSecretServiceDeleteSecretCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// DiffSecretRevisions mocks base method.
+func (m *MockSecretService) DiffSecretRevisions(arg0 context.Context, arg1 *secrets.URI, arg2 service.DiffSecretRevisionsParams) ([]secret.SecretKeyChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffSecretRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secret.SecretKeyChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffSecretRevisions indicates an expected call of DiffSecretRevisions.
+func (mr *MockSecretServiceMockRecorder) DiffSecretRevisions(arg0, arg1, arg2 any) *MockSecretServiceDiffSecretRevisionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffSecretRevisions", reflect.TypeOf((*MockSecretService)(nil).DiffSecretRevisions), arg0, arg1, arg2)
+	return &MockSecretServiceDiffSecretRevisionsCall{Call: call}
+}
+
+// MockSecretServiceDiffSecretRevisionsCall wrap *gomock.Call
+type MockSecretServiceDiffSecretRevisionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceDiffSecretRevisionsCall) Return(arg0 []secret.SecretKeyChange, arg1 error) *MockSecretServiceDiffSecretRevisionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceDiffSecretRevisionsCall) Do(f func(context.Context, *secrets.URI, service.DiffSecretRevisionsParams) ([]secret.SecretKeyChange, error)) *MockSecretServiceDiffSecretRevisionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceDiffSecretRevisionsCall) DoAndReturn(f func(context.Context, *secrets.URI, service.DiffSecretRevisionsParams) ([]secret.SecretKeyChange, error)) *MockSecretServiceDiffSecretRevisionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSecretAccessLog mocks base method.
+func (m *MockSecretService) GetSecretAccessLog(arg0 context.Context, arg1 secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretAccessLog", arg0, arg1)
+	ret0, _ := ret[0].([]secret.SecretAccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretAccessLog indicates an expected call of GetSecretAccessLog.
+func (mr *MockSecretServiceMockRecorder) GetSecretAccessLog(arg0, arg1 any) *MockSecretServiceGetSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretAccessLog", reflect.TypeOf((*MockSecretService)(nil).GetSecretAccessLog), arg0, arg1)
+	return &MockSecretServiceGetSecretAccessLogCall{Call: call}
+}
+
+// MockSecretServiceGetSecretAccessLogCall wrap *gomock.Call
+type MockSecretServiceGetSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceGetSecretAccessLogCall) Return(arg0 []secret.SecretAccessLogEntry, arg1 error) *MockSecretServiceGetSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceGetSecretAccessLogCall) Do(f func(context.Context, secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error)) *MockSecretServiceGetSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceGetSecretAccessLogCall) DoAndReturn(f func(context.Context, secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error)) *MockSecretServiceGetSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretContentFromBackend mocks base method.
-func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int) (secrets.SecretValue, error) {
+func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int, arg3 secret.SecretAccessor) (secrets.SecretValue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSecretContentFromBackend", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetSecretContentFromBackend", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(secrets.SecretValue)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSecretContentFromBackend indicates an expected call of GetSecretContentFromBackend.
-func (mr *MockSecretServiceMockRecorder) GetSecretContentFromBackend(arg0, arg1, arg2 any) *MockSecretServiceGetSecretContentFromBackendCall {
+func (mr *MockSecretServiceMockRecorder) GetSecretContentFromBackend(arg0, arg1, arg2, arg3 any) *MockSecretServiceGetSecretContentFromBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentFromBackend", reflect.TypeOf((*MockSecretService)(nil).GetSecretContentFromBackend), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentFromBackend", reflect.TypeOf((*MockSecretService)(nil).GetSecretContentFromBackend), arg0, arg1, arg2, arg3)
 	return &MockSecretServiceGetSecretContentFromBackendCall{Call: call}
 }
 
@@ -148,13 +226,13 @@ func (c *MockSecretServiceGetSecretContentFromBackendCall) Return(arg0 secrets.S
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretServiceGetSecretContentFromBackendCall) Do(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+func (c *MockSecretServiceGetSecretContentFromBackendCall) Do(f func(context.Context, *secrets.URI, int, secret.SecretAccessor) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretServiceGetSecretContentFromBackendCall) DoAndReturn(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+func (c *MockSecretServiceGetSecretContentFromBackendCall) DoAndReturn(f func(context.Context, *secrets.URI, int, secret.SecretAccessor) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -360,6 +438,45 @@ func (c *MockSecretServiceListSecretsCall) DoAndReturn(f func(context.Context, *
 	return c
 }
 
+// MigrateSecrets mocks base method.
+func (m *MockSecretService) MigrateSecrets(arg0 context.Context, arg1 secret.MigrateSecretsParams) ([]secret.SecretRevisionMigration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSecrets", arg0, arg1)
+	ret0, _ := ret[0].([]secret.SecretRevisionMigration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSecrets indicates an expected call of MigrateSecrets.
+func (mr *MockSecretServiceMockRecorder) MigrateSecrets(arg0, arg1 any) *MockSecretServiceMigrateSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSecrets", reflect.TypeOf((*MockSecretService)(nil).MigrateSecrets), arg0, arg1)
+	return &MockSecretServiceMigrateSecretsCall{Call: call}
+}
+
+// MockSecretServiceMigrateSecretsCall wrap *gomock.Call
+type MockSecretServiceMigrateSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceMigrateSecretsCall) Return(arg0 []secret.SecretRevisionMigration, arg1 error) *MockSecretServiceMigrateSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceMigrateSecretsCall) Do(f func(context.Context, secret.MigrateSecretsParams) ([]secret.SecretRevisionMigration, error)) *MockSecretServiceMigrateSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceMigrateSecretsCall) DoAndReturn(f func(context.Context, secret.MigrateSecretsParams) ([]secret.SecretRevisionMigration, error)) *MockSecretServiceMigrateSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RevokeSecretAccess mocks base method.
 func (m *MockSecretService) RevokeSecretAccess(arg0 context.Context, arg1 *secrets.URI, arg2 secret.SecretAccessParams) error {
 	m.ctrl.T.Helper()
@@ -398,6 +515,45 @@ func (c *MockSecretServiceRevokeSecretAccessCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// RollbackUserSecret mocks base method.
+func (m *MockSecretService) RollbackUserSecret(arg0 context.Context, arg1 *secrets.URI, arg2 service.RollbackUserSecretParams) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackUserSecret", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackUserSecret indicates an expected call of RollbackUserSecret.
+func (mr *MockSecretServiceMockRecorder) RollbackUserSecret(arg0, arg1, arg2 any) *MockSecretServiceRollbackUserSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackUserSecret", reflect.TypeOf((*MockSecretService)(nil).RollbackUserSecret), arg0, arg1, arg2)
+	return &MockSecretServiceRollbackUserSecretCall{Call: call}
+}
+
+// MockSecretServiceRollbackUserSecretCall wrap *gomock.Call
+type MockSecretServiceRollbackUserSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceRollbackUserSecretCall) Return(arg0 int, arg1 error) *MockSecretServiceRollbackUserSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceRollbackUserSecretCall) Do(f func(context.Context, *secrets.URI, service.RollbackUserSecretParams) (int, error)) *MockSecretServiceRollbackUserSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceRollbackUserSecretCall) DoAndReturn(f func(context.Context, *secrets.URI, service.RollbackUserSecretParams) (int, error)) *MockSecretServiceRollbackUserSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateUserSecret mocks base method.
 func (m *MockSecretService) UpdateUserSecret(arg0 context.Context, arg1 *secrets.URI, arg2 service.UpdateUserSecretParams) error {
 	m.ctrl.T.Helper()
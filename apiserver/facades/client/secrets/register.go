@@ -19,16 +19,27 @@ func Register(registry facade.FacadeRegistry) {
 		return newSecretsAPIV1(stdCtx, ctx)
 	}, reflect.TypeFor[*SecretsAPI]())
 	registry.MustRegister("Secrets", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretsAPIV2(stdCtx, ctx)
+	}, reflect.TypeFor[*SecretsAPIV2]())
+	registry.MustRegister("Secrets", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newSecretsAPI(stdCtx, ctx)
 	}, reflect.TypeFor[*SecretsAPI]())
 }
 
 func newSecretsAPIV1(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV1, error) {
+	api, err := newSecretsAPIV2(stdCtx, context)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &SecretsAPIV1{SecretsAPIV2: api}, nil
+}
+
+func newSecretsAPIV2(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV2, error) {
 	api, err := newSecretsAPI(stdCtx, context)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &SecretsAPIV1{SecretsAPI: api}, nil
+	return &SecretsAPIV2{SecretsAPI: api}, nil
 }
 
 // newSecretsAPI creates a SecretsAPI.
@@ -35,9 +35,14 @@ type SecretsAPI struct {
 	secretService        SecretService
 }
 
+// SecretsAPIV2 is the backend for the Secrets facade v2.
+type SecretsAPIV2 struct {
+	*SecretsAPI
+}
+
 // SecretsAPIV1 is the backend for the Secrets facade v1.
 type SecretsAPIV1 struct {
-	*SecretsAPI
+	*SecretsAPIV2
 }
 
 func (s *SecretsAPI) checkCanRead(ctx context.Context) error {
@@ -59,6 +64,12 @@ func (s *SecretsAPI) checkCanAdmin(ctx context.Context) error {
 	return apiservererrors.ErrPerm
 }
 
+// userAccessor returns the accessor recorded in the secret access log when
+// the authenticated user reads secret content.
+func (s *SecretsAPI) userAccessor() domainsecret.SecretAccessor {
+	return domainsecret.SecretAccessor{Kind: domainsecret.UserAccessor, ID: s.authTag.Id()}
+}
+
 // ListSecrets lists available secrets.
 // If args specifies secret owners, then only charm secrets are queried because user secret don't have owners as such.
 // If no owners are specified, we use the more generic list method when returns all types of secret.
@@ -184,7 +195,7 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 			if arg.Filter.Revision != nil {
 				rev = *arg.Filter.Revision
 			}
-			val, err := s.secretService.GetSecretContentFromBackend(ctx, m.URI, rev)
+			val, err := s.secretService.GetSecretContentFromBackend(ctx, m.URI, rev, s.userAccessor())
 			valueResult := &params.SecretValueResult{
 				Error: apiservererrors.ServerError(err),
 			}
@@ -198,6 +209,127 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 	return result, nil
 }
 
+// SecretAccessLog isn't on the v2 API.
+func (s *SecretsAPIV2) SecretAccessLog(_ context.Context, _ struct{}) {}
+
+// SecretAccessLog returns the recorded reads of secret revisions matching
+// the args, most recent first. Only model admins may query the log.
+func (s *SecretsAPI) SecretAccessLog(ctx context.Context, arg params.SecretAccessLogArgs) (params.SecretAccessLogResults, error) {
+	result := params.SecretAccessLogResults{}
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	filter := domainsecret.SecretAccessLogFilter{
+		Limit: arg.Limit,
+	}
+	if arg.URI != nil {
+		uri, err := coresecrets.ParseURI(*arg.URI)
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		filter.SecretID = uri.ID
+	}
+	if arg.AccessorTag != nil {
+		tag, err := names.ParseTag(*arg.AccessorTag)
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		accessor, err := subjectFromTag(tag)
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		filter.Accessor = &accessor
+	}
+	if arg.Since != nil {
+		filter.Since = *arg.Since
+	}
+	if arg.Until != nil {
+		filter.Until = *arg.Until
+	}
+
+	entries, err := s.secretService.GetSecretAccessLog(ctx, filter)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Results = make([]params.SecretAccessLogEntry, len(entries))
+	for i, entry := range entries {
+		accessorTag, err := tagFromSubject(entry.Accessor)
+		if err != nil {
+			return params.SecretAccessLogResults{}, errors.Trace(err)
+		}
+		result.Results[i] = params.SecretAccessLogEntry{
+			URI:         (&coresecrets.URI{ID: entry.SecretID}).String(),
+			Revision:    entry.Revision,
+			AccessorTag: accessorTag.String(),
+			AccessedAt:  entry.AccessedAt,
+		}
+	}
+	return result, nil
+}
+
+// MigrateSecrets isn't on the v2 API.
+func (s *SecretsAPIV2) MigrateSecrets(_ context.Context, _ struct{}) {}
+
+// MigrateSecrets moves the content of secret revisions from one secret
+// backend to another, reporting the outcome for each revision. A revision
+// only refers to the target backend once its copied content is verified.
+// Only model admins may migrate secrets.
+func (s *SecretsAPI) MigrateSecrets(ctx context.Context, arg params.MigrateSecretsArgs) (params.SecretMigrationResults, error) {
+	result := params.SecretMigrationResults{}
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	migrateParams := domainsecret.MigrateSecretsParams{
+		FromBackend: arg.FromBackend,
+		ToBackend:   arg.ToBackend,
+		DryRun:      arg.DryRun,
+	}
+	if arg.URI != nil {
+		uri, err := coresecrets.ParseURI(*arg.URI)
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		migrateParams.URI = uri
+	}
+
+	migrations, err := s.secretService.MigrateSecrets(ctx, migrateParams)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Results = make([]params.SecretRevisionMigrationResult, len(migrations))
+	for i, m := range migrations {
+		result.Results[i] = params.SecretRevisionMigrationResult{
+			URI:      m.URI.String(),
+			Revision: m.Revision,
+			Status:   string(m.Status),
+			Checksum: m.Checksum,
+		}
+		if m.Error != nil {
+			result.Results[i].Error = apiservererrors.ServerError(m.Error)
+		}
+	}
+	return result, nil
+}
+
+func subjectFromTag(tag names.Tag) (domainsecret.SecretAccessor, error) {
+	switch kind := tag.Kind(); kind {
+	case names.UnitTagKind:
+		return domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: tag.Id()}, nil
+	case names.ApplicationTagKind:
+		return domainsecret.SecretAccessor{Kind: domainsecret.ApplicationAccessor, ID: tag.Id()}, nil
+	case names.ModelTagKind:
+		return domainsecret.SecretAccessor{Kind: domainsecret.ModelAccessor, ID: tag.Id()}, nil
+	case names.UserTagKind:
+		return domainsecret.SecretAccessor{Kind: domainsecret.UserAccessor, ID: tag.Id()}, nil
+	case names.MachineTagKind:
+		return domainsecret.SecretAccessor{Kind: domainsecret.MachineAccessor, ID: tag.Id()}, nil
+	default:
+		return domainsecret.SecretAccessor{}, errors.NotValidf("accessor tag kind %q", kind)
+	}
+}
+
 func tagFromSubject(access domainsecret.SecretAccessor) (names.Tag, error) {
 	switch kind := access.Kind; kind {
 	case domainsecret.UnitAccessor:
@@ -206,6 +338,10 @@ func tagFromSubject(access domainsecret.SecretAccessor) (names.Tag, error) {
 		return names.NewApplicationTag(access.ID), nil
 	case domainsecret.ModelAccessor:
 		return names.NewModelTag(access.ID), nil
+	case domainsecret.UserAccessor:
+		return names.NewUserTag(access.ID), nil
+	case domainsecret.MachineAccessor:
+		return names.NewMachineTag(access.ID), nil
 	default:
 		return nil, errors.NotValidf("subject kind %q", kind)
 	}
@@ -252,7 +388,17 @@ func (s *SecretsAPI) createSecret(ctx context.Context, arg params.CreateSecretAr
 	if arg.OwnerTag != "" && arg.OwnerTag != s.modelUUID {
 		return "", errors.NotValidf("owner tag %q", arg.OwnerTag)
 	}
-	if len(arg.Content.Data) == 0 {
+	var source *coresecrets.ExternalSource
+	if arg.Source != nil {
+		if len(arg.Content.Data) > 0 {
+			return "", errors.NotValidf("secret with both content and an external source")
+		}
+		src, err := coresecrets.ParseExternalSource(*arg.Source)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		source = &src
+	} else if len(arg.Content.Data) == 0 {
 		return "", errors.NotValidf("empty secret value")
 	}
 
@@ -267,18 +413,18 @@ func (s *SecretsAPI) createSecret(ctx context.Context, arg params.CreateSecretAr
 		uri = coresecrets.NewURI()
 	}
 
-	if len(arg.Content.Data) == 0 {
-		return "", errors.NotValidf("empty secret value")
-	}
-	v := coresecrets.NewSecretValue(arg.Content.Data)
-	checksum, err := v.Checksum()
-	if err != nil {
-		return "", errors.Annotate(err, "calculating secret checksum")
+	if source == nil {
+		v := coresecrets.NewSecretValue(arg.Content.Data)
+		checksum, err := v.Checksum()
+		if err != nil {
+			return "", errors.Annotate(err, "calculating secret checksum")
+		}
+		arg.UpsertSecretArg.Content.Checksum = checksum
 	}
-	arg.UpsertSecretArg.Content.Checksum = checksum
 	err = s.secretService.CreateUserSecret(ctx, uri, secretservice.CreateUserSecretParams{
 		Version:                secrets.Version,
 		UpdateUserSecretParams: fromUpsertParams(s.modelUUID, nil, arg.UpsertSecretArg),
+		Source:                 source,
 	})
 	if err != nil {
 		return "", errors.Trace(err)
@@ -339,6 +485,83 @@ func (s *SecretsAPI) updateSecret(ctx context.Context, arg params.UpdateUserSecr
 	return errors.Trace(err)
 }
 
+// RollbackSecret isn't on the v2 API.
+func (s *SecretsAPIV2) RollbackSecret(_ context.Context, _ struct{}) {}
+
+// RollbackSecret creates a new revision of a user secret with the content
+// of an earlier revision, returning the new revision.
+func (s *SecretsAPI) RollbackSecret(ctx context.Context, arg params.RollbackSecretArg) (params.IntResult, error) {
+	if err := s.checkCanWrite(ctx); err != nil {
+		return params.IntResult{}, errors.Trace(err)
+	}
+	if arg.Revision < 1 {
+		return params.IntResult{Error: apiservererrors.ServerError(errors.NotValidf("revision %d", arg.Revision))}, nil
+	}
+	uri, err := s.secretURI(ctx, arg.URI, arg.Label)
+	if err != nil {
+		return params.IntResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	revision, err := s.secretService.RollbackUserSecret(ctx, uri, secretservice.RollbackUserSecretParams{
+		Accessor: domainsecret.SecretAccessor{Kind: domainsecret.ModelAccessor, ID: s.modelUUID},
+		Revision: arg.Revision,
+	})
+	if err != nil {
+		return params.IntResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return params.IntResult{Result: revision}, nil
+}
+
+// SecretRevisionDiff isn't on the v2 API.
+func (s *SecretsAPIV2) SecretRevisionDiff(_ context.Context, _ struct{}) {}
+
+// SecretRevisionDiff returns the keys of the content of a secret which were
+// added, removed or changed between two revisions. The comparison is made on
+// the controller so that secret values are only sent to the client when they
+// are to be revealed, which requires model admin access.
+func (s *SecretsAPI) SecretRevisionDiff(ctx context.Context, arg params.SecretRevisionDiffArg) (params.SecretRevisionDiffResult, error) {
+	if arg.Reveal {
+		if err := s.checkCanAdmin(ctx); err != nil {
+			return params.SecretRevisionDiffResult{}, errors.Trace(err)
+		}
+	} else {
+		if err := s.checkCanRead(ctx); err != nil {
+			return params.SecretRevisionDiffResult{}, errors.Trace(err)
+		}
+	}
+	if arg.FromRevision < 1 {
+		return params.SecretRevisionDiffResult{Error: apiservererrors.ServerError(errors.NotValidf("revision %d", arg.FromRevision))}, nil
+	}
+	if arg.ToRevision < 1 {
+		return params.SecretRevisionDiffResult{Error: apiservererrors.ServerError(errors.NotValidf("revision %d", arg.ToRevision))}, nil
+	}
+	uri, err := s.secretURI(ctx, arg.URI, arg.Label)
+	if err != nil {
+		return params.SecretRevisionDiffResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	changes, err := s.secretService.DiffSecretRevisions(ctx, uri, secretservice.DiffSecretRevisionsParams{
+		Accessor:     s.userAccessor(),
+		FromRevision: arg.FromRevision,
+		ToRevision:   arg.ToRevision,
+		RevealValues: arg.Reveal,
+	})
+	if err != nil {
+		return params.SecretRevisionDiffResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	result := params.SecretRevisionDiffResult{
+		URI:     uri.String(),
+		Changes: make([]params.SecretKeyChange, len(changes)),
+	}
+	for i, change := range changes {
+		result.Changes[i] = params.SecretKeyChange{
+			Key:    change.Key,
+			Change: string(change.Change),
+			From:   change.From,
+			To:     change.To,
+		}
+	}
+	return result, nil
+}
+
 func (s *SecretsAPI) secretURI(ctx context.Context, uriStr, label string) (*coresecrets.URI, error) {
 	if uriStr == "" && label == "" {
 		return nil, errors.New("must specify either URI or label")
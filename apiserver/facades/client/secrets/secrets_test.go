@@ -249,7 +249,10 @@ func (s *SecretsSuite) assertListSecrets(c *tc.C, reveal bool) {
 		valueResult = &params.SecretValueResult{
 			Data: map[string]string{"foo": "bar"},
 		}
-		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2).Return(
+		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2, secret.SecretAccessor{
+			Kind: secret.UserAccessor,
+			ID:   "foo",
+		}).Return(
 			coresecrets.NewSecretValue(valueResult.Data), nil,
 		)
 	}
@@ -416,6 +419,50 @@ func (s *SecretsSuite) TestCreateSecrets(c *tc.C) {
 	c.Assert(result.Results[0], tc.DeepEquals, params.StringResult{Result: uri.String()})
 }
 
+func (s *SecretsSuite) TestCreateSecretsWithSource(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
+
+	uri := coresecrets.NewURI()
+	uriStrPtr := new(uri.String())
+	s.secretService.EXPECT().CreateUserSecret(gomock.Any(), uri, gomock.Any()).DoAndReturn(func(_ context.Context, _ *coresecrets.URI, params secretservice.CreateUserSecretParams) error {
+		c.Assert(params.Source, tc.DeepEquals, &coresecrets.ExternalSource{
+			Backend: "myvault",
+			Path:    "kv/prod/db",
+			Key:     "password",
+		})
+		c.Assert(params.UpdateUserSecretParams.Label, tc.DeepEquals, new("label"))
+		c.Assert(params.UpdateUserSecretParams.Data, tc.HasLen, 0)
+		return nil
+	})
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := facade.CreateSecrets(c.Context(), params.CreateSecretArgs{
+		Args: []params.CreateSecretArg{{
+			OwnerTag: coretesting.ModelTag.Id(),
+			URI:      uriStrPtr,
+			Source:   new("myvault:kv/prod/db#password"),
+			UpsertSecretArg: params.UpsertSecretArg{
+				Label: new("label"),
+			},
+		}, {
+			OwnerTag: coretesting.ModelTag.Id(),
+			Source:   new("myvault:kv/prod/db#password"),
+			UpsertSecretArg: params.UpsertSecretArg{
+				Content: params.SecretContentParams{
+					Data: map[string]string{"foo": "bar"},
+				},
+			},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results[0], tc.DeepEquals, params.StringResult{Result: uri.String()})
+	c.Assert(result.Results[1].Error.Message, tc.Equals, "secret with both content and an external source not valid")
+}
+
 func (s *SecretsSuite) assertUpdateSecrets(c *tc.C, uri *coresecrets.URI) {
 	defer s.setup(c).Finish()
 
@@ -726,3 +773,286 @@ func (s *SecretsSuite) TestRevokeSecretPermissionDenied(c *tc.C) {
 	_, err = facade.RevokeSecret(c.Context(), params.GrantRevokeUserSecretArg{Label: "my-secret"})
 	c.Assert(err, tc.ErrorMatches, "permission denied")
 }
+
+func (s *SecretsSuite) TestSecretAccessLog(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	uri := coresecrets.NewURI()
+	now := time.Now()
+	since := now.Add(-time.Hour)
+	s.secretService.EXPECT().GetSecretAccessLog(gomock.Any(), secret.SecretAccessLogFilter{
+		SecretID: uri.ID,
+		Accessor: &secret.SecretAccessor{Kind: secret.UnitAccessor, ID: "mysql/0"},
+		Since:    since,
+		Limit:    10,
+	}).Return([]secret.SecretAccessLogEntry{{
+		SecretID:   uri.ID,
+		Revision:   2,
+		Accessor:   secret.SecretAccessor{Kind: secret.UnitAccessor, ID: "mysql/0"},
+		AccessedAt: now,
+	}}, nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	uriStr := uri.String()
+	accessorTag := "unit-mysql-0"
+	result, err := facade.SecretAccessLog(c.Context(), params.SecretAccessLogArgs{
+		URI:         &uriStr,
+		AccessorTag: &accessorTag,
+		Since:       &since,
+		Limit:       10,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.SecretAccessLogResults{
+		Results: []params.SecretAccessLogEntry{{
+			URI:         uri.String(),
+			Revision:    2,
+			AccessorTag: "unit-mysql-0",
+			AccessedAt:  now,
+		}},
+	})
+}
+
+func (s *SecretsSuite) TestSecretAccessLogUserAccessor(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	uri := coresecrets.NewURI()
+	now := time.Now()
+	s.secretService.EXPECT().GetSecretAccessLog(gomock.Any(), secret.SecretAccessLogFilter{
+		Accessor: &secret.SecretAccessor{Kind: secret.UserAccessor, ID: "fred"},
+	}).Return([]secret.SecretAccessLogEntry{{
+		SecretID:   uri.ID,
+		Revision:   1,
+		Accessor:   secret.SecretAccessor{Kind: secret.UserAccessor, ID: "fred"},
+		AccessedAt: now,
+	}}, nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	accessorTag := "user-fred"
+	result, err := facade.SecretAccessLog(c.Context(), params.SecretAccessLogArgs{
+		AccessorTag: &accessorTag,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.SecretAccessLogResults{
+		Results: []params.SecretAccessLogEntry{{
+			URI:         uri.String(),
+			Revision:    1,
+			AccessorTag: "user-fred",
+			AccessedAt:  now,
+		}},
+	})
+}
+
+func (s *SecretsSuite) TestSecretAccessLogPermissionDenied(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = facade.SecretAccessLog(c.Context(), params.SecretAccessLogArgs{})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *SecretsSuite) TestMigrateSecrets(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().MigrateSecrets(gomock.Any(), secret.MigrateSecretsParams{
+		FromBackend: "internal",
+		ToBackend:   "myvault",
+		URI:         uri,
+	}).Return([]secret.SecretRevisionMigration{{
+		URI:      uri,
+		Revision: 1,
+		Status:   secret.SecretMigrationMigrated,
+		Checksum: "checksum-1",
+	}, {
+		URI:      uri,
+		Revision: 2,
+		Status:   secret.SecretMigrationFailed,
+		Checksum: "checksum-2",
+		Error:    errors.New("boom"),
+	}}, nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	uriStr := uri.String()
+	result, err := facade.MigrateSecrets(c.Context(), params.MigrateSecretsArgs{
+		FromBackend: "internal",
+		ToBackend:   "myvault",
+		URI:         &uriStr,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.SecretMigrationResults{
+		Results: []params.SecretRevisionMigrationResult{{
+			URI:      uri.String(),
+			Revision: 1,
+			Status:   "migrated",
+			Checksum: "checksum-1",
+		}, {
+			URI:      uri.String(),
+			Revision: 2,
+			Status:   "failed",
+			Checksum: "checksum-2",
+			Error:    &params.Error{Message: "boom"},
+		}},
+	})
+}
+
+func (s *SecretsSuite) TestMigrateSecretsPermissionDenied(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = facade.MigrateSecrets(c.Context(), params.MigrateSecretsArgs{FromBackend: "internal", ToBackend: "myvault"})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *SecretsSuite) TestRollbackSecret(c *tc.C) {
+	defer s.setup(c).Finish()
+	s.expectAuthClient()
+
+	uri := coresecrets.NewURI()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
+	s.secretService.EXPECT().GetUserSecretURIByLabel(gomock.Any(), "my-secret").Return(uri, nil)
+	s.secretService.EXPECT().RollbackUserSecret(gomock.Any(), uri, secretservice.RollbackUserSecretParams{
+		Accessor: secret.SecretAccessor{Kind: secret.ModelAccessor, ID: coretesting.ModelTag.Id()},
+		Revision: 2,
+	}).Return(4, nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+	result, err := facade.RollbackSecret(c.Context(), params.RollbackSecretArg{
+		Label:    "my-secret",
+		Revision: 2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.IntResult{Result: 4})
+}
+
+func (s *SecretsSuite) TestRollbackSecretInvalidRevision(c *tc.C) {
+	defer s.setup(c).Finish()
+	s.expectAuthClient()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+	result, err := facade.RollbackSecret(c.Context(), params.RollbackSecretArg{
+		URI:      coresecrets.NewURI().String(),
+		Revision: 0,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.ErrorMatches, "revision 0 not valid")
+}
+
+func (s *SecretsSuite) TestSecretRevisionDiff(c *tc.C) {
+	defer s.setup(c).Finish()
+	s.expectAuthClient()
+
+	uri := coresecrets.NewURI()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, coretesting.ModelTag).Return(nil)
+	s.secretService.EXPECT().DiffSecretRevisions(gomock.Any(), uri, secretservice.DiffSecretRevisionsParams{
+		Accessor:     secret.SecretAccessor{Kind: secret.UserAccessor, ID: "foo"},
+		FromRevision: 1,
+		ToRevision:   3,
+	}).Return([]secret.SecretKeyChange{
+		{Key: "foo", Change: secret.SecretKeyChanged},
+		{Key: "new", Change: secret.SecretKeyAdded},
+	}, nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+	result, err := facade.SecretRevisionDiff(c.Context(), params.SecretRevisionDiffArg{
+		URI:          uri.String(),
+		FromRevision: 1,
+		ToRevision:   3,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.SecretRevisionDiffResult{
+		URI: uri.String(),
+		Changes: []params.SecretKeyChange{
+			{Key: "foo", Change: "changed"},
+			{Key: "new", Change: "added"},
+		},
+	})
+}
+
+func (s *SecretsSuite) TestSecretRevisionDiffReveal(c *tc.C) {
+	defer s.setup(c).Finish()
+	s.expectAuthClient()
+
+	uri := coresecrets.NewURI()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+	s.secretService.EXPECT().GetUserSecretURIByLabel(gomock.Any(), "my-secret").Return(uri, nil)
+	s.secretService.EXPECT().DiffSecretRevisions(gomock.Any(), uri, secretservice.DiffSecretRevisionsParams{
+		Accessor:     secret.SecretAccessor{Kind: secret.UserAccessor, ID: "foo"},
+		FromRevision: 1,
+		ToRevision:   3,
+		RevealValues: true,
+	}).Return([]secret.SecretKeyChange{
+		{Key: "foo", Change: secret.SecretKeyChanged, From: "YmFy", To: "YmF6"},
+	}, nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+	result, err := facade.SecretRevisionDiff(c.Context(), params.SecretRevisionDiffArg{
+		Label:        "my-secret",
+		FromRevision: 1,
+		ToRevision:   3,
+		Reveal:       true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.SecretRevisionDiffResult{
+		URI: uri.String(),
+		Changes: []params.SecretKeyChange{
+			{Key: "foo", Change: "changed", From: "YmFy", To: "YmF6"},
+		},
+	})
+}
+
+func (s *SecretsSuite) TestSecretRevisionDiffRevealPermissionDenied(c *tc.C) {
+	defer s.setup(c).Finish()
+	s.expectAuthClient()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = facade.SecretRevisionDiff(c.Context(), params.SecretRevisionDiffArg{
+		URI:          coresecrets.NewURI().String(),
+		FromRevision: 1,
+		ToRevision:   3,
+		Reveal:       true,
+	})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
@@ -19,17 +19,27 @@ type SecretService interface {
 
 	CreateUserSecret(context.Context, *secrets.URI, secretservice.CreateUserSecretParams) error
 	UpdateUserSecret(context.Context, *secrets.URI, secretservice.UpdateUserSecretParams) error
+	RollbackUserSecret(context.Context, *secrets.URI, secretservice.RollbackUserSecretParams) (int, error)
 
 	// View and fetch secrets.
 
 	GetUserSecretURIByLabel(ctx context.Context, label string) (*secrets.URI, error)
-	GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error)
+	GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor) (secrets.SecretValue, error)
+	DiffSecretRevisions(ctx context.Context, uri *secrets.URI, params secretservice.DiffSecretRevisionsParams) ([]domainsecret.SecretKeyChange, error)
 	ListSecrets(ctx context.Context, uri *secrets.URI,
 		revision *int,
 		labels domainsecret.Labels,
 	) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
 	ListCharmSecrets(ctx context.Context, owners ...domainsecret.CharmSecretOwner) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
 
+	// Audit secret reads.
+
+	GetSecretAccessLog(ctx context.Context, filter domainsecret.SecretAccessLogFilter) ([]domainsecret.SecretAccessLogEntry, error)
+
+	// Move secret content between backends.
+
+	MigrateSecrets(ctx context.Context, params domainsecret.MigrateSecretsParams) ([]domainsecret.SecretRevisionMigration, error)
+
 	// Delete secrets.
 
 	DeleteSecret(ctx context.Context, uri *secrets.URI, params domainsecret.DeleteSecretParams) error
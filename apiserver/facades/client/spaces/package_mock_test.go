@@ -397,6 +397,44 @@ func (c *MockNetworkServiceRemoveSpaceCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// SetSpacePreferredAddressType mocks base method.
+func (m *MockNetworkService) SetSpacePreferredAddressType(arg0 context.Context, arg1 network.SpaceName, arg2 network.AddressType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSpacePreferredAddressType", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSpacePreferredAddressType indicates an expected call of SetSpacePreferredAddressType.
+func (mr *MockNetworkServiceMockRecorder) SetSpacePreferredAddressType(arg0, arg1, arg2 any) *MockNetworkServiceSetSpacePreferredAddressTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSpacePreferredAddressType", reflect.TypeOf((*MockNetworkService)(nil).SetSpacePreferredAddressType), arg0, arg1, arg2)
+	return &MockNetworkServiceSetSpacePreferredAddressTypeCall{Call: call}
+}
+
+// MockNetworkServiceSetSpacePreferredAddressTypeCall wrap *gomock.Call
+type MockNetworkServiceSetSpacePreferredAddressTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceSetSpacePreferredAddressTypeCall) Return(arg0 error) *MockNetworkServiceSetSpacePreferredAddressTypeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceSetSpacePreferredAddressTypeCall) Do(f func(context.Context, network.SpaceName, network.AddressType) error) *MockNetworkServiceSetSpacePreferredAddressTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceSetSpacePreferredAddressTypeCall) DoAndReturn(f func(context.Context, network.SpaceName, network.AddressType) error) *MockNetworkServiceSetSpacePreferredAddressTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SpaceByName mocks base method.
 func (m *MockNetworkService) SpaceByName(arg0 context.Context, arg1 network.SpaceName) (*network.SpaceInfo, error) {
 	m.ctrl.T.Helper()
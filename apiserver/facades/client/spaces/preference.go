@@ -0,0 +1,61 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package spaces
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/permission"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// SetSpaceAddressPreferences sets the type of IP address, ipv4 or ipv6,
+// preferred on dual-stack machines in each of the given spaces.
+// Unlike the space topology, the preference may be set for spaces sourced
+// from the provider.
+func (api *API) SetSpaceAddressPreferences(
+	ctx context.Context, args params.SetSpaceAddressPreferencesParams,
+) (params.ErrorResults, error) {
+	result := params.ErrorResults{}
+
+	if err := api.auth.HasPermission(ctx, permission.AdminAccess, api.modelTag); err != nil {
+		return result, err
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.Results = make([]params.ErrorResult, len(args.Preferences))
+	for i, pref := range args.Preferences {
+		spaceTag, err := names.ParseSpaceTag(pref.SpaceTag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(errors.Trace(err))
+			continue
+		}
+		spaceName := network.NewSpaceName(spaceTag.Id())
+
+		err = api.networkService.SetSpacePreferredAddressType(
+			ctx, spaceName, network.AddressType(pref.AddressType),
+		)
+		if errors.Is(err, networkerrors.SpaceNotFound) {
+			err = errors.NotFoundf("space %q", spaceName)
+		} else if errors.Is(err, coreerrors.NotValid) {
+			err = errors.NewNotValid(err, "")
+		} else if err != nil {
+			err = errors.Annotatef(err, "setting space %q address preference", spaceName)
+		}
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+// SetSpaceAddressPreferences isn't on the v6 API.
+func (api *APIv6) SetSpaceAddressPreferences(_ struct{}) {}
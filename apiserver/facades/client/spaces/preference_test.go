@@ -0,0 +1,54 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package spaces_test
+
+import (
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+func (s *APISuite) TestSetSpaceAddressPreferences(c *tc.C) {
+	// Provider sourced spaces can have an address preference.
+	ctrl := s.SetupMocks(c, true, true)
+	defer ctrl.Finish()
+
+	s.NetworkService.EXPECT().SetSpacePreferredAddressType(
+		gomock.Any(), network.SpaceName("rack1"), network.IPv6Address,
+	).Return(nil)
+	s.NetworkService.EXPECT().SetSpacePreferredAddressType(
+		gomock.Any(), network.SpaceName("missing"), network.IPv6Address,
+	).Return(errors.Errorf("space %q: %w", "missing", networkerrors.SpaceNotFound))
+	s.NetworkService.EXPECT().SetSpacePreferredAddressType(
+		gomock.Any(), network.SpaceName("rack2"), network.AddressType("ipv5"),
+	).Return(errors.Errorf("preferred address type %q %w", "ipv5", coreerrors.NotValid))
+
+	res, err := s.API.SetSpaceAddressPreferences(c.Context(), params.SetSpaceAddressPreferencesParams{
+		Preferences: []params.SpaceAddressPreference{{
+			SpaceTag:    names.NewSpaceTag("rack1").String(),
+			AddressType: "ipv6",
+		}, {
+			SpaceTag:    names.NewSpaceTag("missing").String(),
+			AddressType: "ipv6",
+		}, {
+			SpaceTag:    names.NewSpaceTag("rack2").String(),
+			AddressType: "ipv5",
+		}, {
+			SpaceTag:    "rack3",
+			AddressType: "ipv6",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 4)
+	c.Check(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+	c.Check(res.Results[2].Error, tc.Satisfies, params.IsCodeNotValid)
+	c.Check(res.Results[3].Error, tc.ErrorMatches, `"rack3" is not a valid tag`)
+}
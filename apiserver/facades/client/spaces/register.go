@@ -7,6 +7,7 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/apiserver/common"
@@ -17,10 +18,21 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Spaces", 6, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newAPI(ctx)
+		return newAPIv6(ctx)
+	}, reflect.TypeFor[*APIv6]())
+	registry.MustRegister("Spaces", 7, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newAPI(ctx) // Added SetSpaceAddressPreferences
 	}, reflect.TypeFor[*API]())
 }
 
+func newAPIv6(ctx facade.ModelContext) (*APIv6, error) {
+	api, err := newAPI(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv6{api}, nil
+}
+
 // newAPI creates a new Space API server-side facade with a
 // state.State backing.
 func newAPI(ctx facade.ModelContext) (*API, error) {
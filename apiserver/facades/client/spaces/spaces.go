@@ -46,6 +46,12 @@ type NetworkService interface {
 	// [github.com/juju/juju/domain/network/errors.SpaceNotFound].
 	UpdateSpace(context.Context, network.SpaceUUID, network.SpaceName) error
 
+	// SetSpacePreferredAddressType sets the type of IP address preferred on
+	// dual-stack machines in the space with the input name. If the space is
+	// not found, an error is returned satisfying
+	// [github.com/juju/juju/domain/network/errors.SpaceNotFound].
+	SetSpacePreferredAddressType(context.Context, network.SpaceName, network.AddressType) error
+
 	// RemoveSpace removes a space identified by the given name.
 	// It can handle forced removal and supports dry-run mode.
 	// [github.com/juju/juju/domain/network/errors.SpaceNotFound].
@@ -96,7 +102,12 @@ type MachineService interface {
 	CountMachinesInSpace(context.Context, network.SpaceUUID) (int64, error)
 }
 
-// API provides the spaces API facade for version 6.
+// APIv6 provides the spaces API facade for version 6.
+type APIv6 struct {
+	*API
+}
+
+// API provides the spaces API facade for version 7.
 type API struct {
 	controllerConfigService ControllerConfigService
 	networkService          NetworkService
@@ -193,6 +204,7 @@ func (api *API) ListSpaces(ctx context.Context) (results params.ListSpacesResult
 		result := params.Space{}
 		result.Id = space.ID.String()
 		result.Name = space.Name.String()
+		result.PreferredAddressType = string(space.PreferredAddressType)
 
 		if err != nil {
 			err = errors.Annotatef(err, "fetching spaces")
@@ -241,6 +253,7 @@ func (api *API) ShowSpace(ctx context.Context, entities params.Entities) (params
 		}
 		result.Space.Name = space.Name.String()
 		result.Space.Id = space.ID.String()
+		result.Space.PreferredAddressType = string(space.PreferredAddressType)
 		subnets := space.Subnets
 
 		result.Space.Subnets = make([]params.Subnet, len(subnets))
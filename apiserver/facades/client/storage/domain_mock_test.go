@@ -744,6 +744,45 @@ func (c *MockStorageServiceAdoptFilesystemCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// CreateStorageInstanceSnapshot mocks base method.
+func (m *MockStorageService) CreateStorageInstanceSnapshot(arg0 context.Context, arg1 storage0.StorageInstanceUUID, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageInstanceSnapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStorageInstanceSnapshot indicates an expected call of CreateStorageInstanceSnapshot.
+func (mr *MockStorageServiceMockRecorder) CreateStorageInstanceSnapshot(arg0, arg1, arg2 any) *MockStorageServiceCreateStorageInstanceSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageInstanceSnapshot", reflect.TypeOf((*MockStorageService)(nil).CreateStorageInstanceSnapshot), arg0, arg1, arg2)
+	return &MockStorageServiceCreateStorageInstanceSnapshotCall{Call: call}
+}
+
+// MockStorageServiceCreateStorageInstanceSnapshotCall wrap *gomock.Call
+type MockStorageServiceCreateStorageInstanceSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceCreateStorageInstanceSnapshotCall) Return(arg0 string, arg1 error) *MockStorageServiceCreateStorageInstanceSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceCreateStorageInstanceSnapshotCall) Do(f func(context.Context, storage0.StorageInstanceUUID, string) (string, error)) *MockStorageServiceCreateStorageInstanceSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceCreateStorageInstanceSnapshotCall) DoAndReturn(f func(context.Context, storage0.StorageInstanceUUID, string) (string, error)) *MockStorageServiceCreateStorageInstanceSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateStoragePool mocks base method.
 func (m *MockStorageService) CreateStoragePool(arg0 context.Context, arg1 string, arg2 storage0.ProviderType, arg3 map[string]any) (storage0.StoragePoolUUID, error) {
 	m.ctrl.T.Helper()
@@ -1211,3 +1250,41 @@ func (c *MockStorageServiceListStoragePoolsByProvidersCall) DoAndReturn(f func(c
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ResizeStorageInstance mocks base method.
+func (m *MockStorageService) ResizeStorageInstance(arg0 context.Context, arg1 storage0.StorageInstanceUUID, arg2 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeStorageInstance", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeStorageInstance indicates an expected call of ResizeStorageInstance.
+func (mr *MockStorageServiceMockRecorder) ResizeStorageInstance(arg0, arg1, arg2 any) *MockStorageServiceResizeStorageInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeStorageInstance", reflect.TypeOf((*MockStorageService)(nil).ResizeStorageInstance), arg0, arg1, arg2)
+	return &MockStorageServiceResizeStorageInstanceCall{Call: call}
+}
+
+// MockStorageServiceResizeStorageInstanceCall wrap *gomock.Call
+type MockStorageServiceResizeStorageInstanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceResizeStorageInstanceCall) Return(arg0 error) *MockStorageServiceResizeStorageInstanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceResizeStorageInstanceCall) Do(f func(context.Context, storage0.StorageInstanceUUID, uint64) error) *MockStorageServiceResizeStorageInstanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceResizeStorageInstanceCall) DoAndReturn(f func(context.Context, storage0.StorageInstanceUUID, uint64) error) *MockStorageServiceResizeStorageInstanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	coreerrors "github.com/juju/juju/core/errors"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ResizeStorage grows storage instances to at least the requested size.
+// The volume or filesystem backing each storage instance is resized by the
+// storage provisioner. Storage whose provider does not support resizing is
+// rejected with a not supported error.
+func (a *StorageAPI) ResizeStorage(ctx context.Context, args params.ResizeStorageArgs) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Capture(err)
+	}
+
+	// Check if changes are allowed and the operation may proceed.
+	if err := a.blockChecker.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Capture(err)
+	}
+
+	results := make([]params.ErrorResult, len(args.Storage))
+	for i, arg := range args.Storage {
+		err := a.resizeOneStorage(ctx, arg)
+		results[i].Error = apiservererrors.ServerError(err)
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+func (a *StorageAPI) resizeOneStorage(ctx context.Context, arg params.ResizeStorageArg) error {
+	tag, err := names.ParseStorageTag(arg.StorageTag)
+	if err != nil {
+		return apiservererrors.ParamsErrorf(params.CodeNotValid, "invalid storage tag")
+	}
+
+	uuid, err := a.storageService.GetStorageInstanceUUIDForID(ctx, tag.Id())
+	if errors.Is(err, storageerrors.StorageInstanceNotFound) {
+		return apiservererrors.ParamsErrorf(params.CodeNotFound, "storage %q does not exist", tag.Id())
+	} else if err != nil {
+		return errors.Errorf(
+			"getting storage instance uuid for storage id %q: %w",
+			tag.Id(), err,
+		)
+	}
+
+	err = a.storageService.ResizeStorageInstance(ctx, uuid, arg.SizeMiB)
+	switch {
+	case errors.Is(err, coreerrors.NotValid):
+		return apiservererrors.ParamsErrorf(params.CodeNotValid,
+			"invalid size for storage %q", tag.Id())
+	case errors.Is(err, storageerrors.StorageInstanceNotFound):
+		return apiservererrors.ParamsErrorf(params.CodeNotFound,
+			"storage %q does not exist", tag.Id())
+	case errors.Is(err, storageerrors.StorageInstanceNotAlive):
+		return apiservererrors.ParamsErrorf(params.CodeNotValid,
+			"storage %q is not alive", tag.Id())
+	case errors.Is(err, storageerrors.StorageInstanceResizeNotSupported):
+		return apiservererrors.ParamsErrorf(params.CodeNotSupported,
+			"storage %q cannot be resized by its storage provider", tag.Id())
+	case errors.Is(err, storageerrors.StorageInstanceShrinkNotSupported):
+		return apiservererrors.ParamsErrorf(params.CodeNotSupported,
+			"storage %q cannot be shrunk", tag.Id())
+	case err != nil:
+		return errors.Errorf("resizing storage %q: %w", tag.Id(), err)
+	}
+	return nil
+}
+
+// ResizeStorage is not available on version 7 and earlier of the facade.
+func (*StorageAPIv7) ResizeStorage(_, _ struct{}) {}
+
+// ResizeStorage is not available on version 6 and earlier of the facade.
+func (*StorageAPIv6) ResizeStorage(_, _ struct{}) {}
@@ -0,0 +1,161 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// resizeSuite provides a suite of tests for asserting the functionality
+// behind resizing storage instances.
+type resizeSuite struct {
+	baseStorageSuite
+}
+
+// TestResizeSuite registers and runs all the tests from [resizeSuite].
+func TestResizeSuite(t *testing.T) {
+	tc.Run(t, &resizeSuite{})
+}
+
+// resizeOneStorageArgs returns a [params.ResizeStorageArgs] resizing
+// "storage-data/0" to the supplied size.
+func resizeOneStorageArgs(sizeMiB uint64) params.ResizeStorageArgs {
+	return params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: "storage-data/0",
+			SizeMiB:    sizeMiB,
+		}},
+	}
+}
+
+// TestResizeStorage is a happy path test for resizing a storage instance.
+func (s *resizeSuite) TestResizeStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.storageService.EXPECT().GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/0",
+	).Return(storageUUID, nil)
+	s.storageService.EXPECT().ResizeStorageInstance(
+		gomock.Any(), storageUUID, uint64(204800),
+	).Return(nil)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.ResizeStorage(c.Context(), resizeOneStorageArgs(204800))
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(result.Results, tc.DeepEquals, []params.ErrorResult{
+		{Error: nil},
+	})
+}
+
+// TestResizeStorageNotFound tests that resizing a storage instance that does
+// not exist results in an error with [params.CodeNotFound].
+func (s *resizeSuite) TestResizeStorageNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.storageService.EXPECT().GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/0",
+	).Return("", storageerrors.StorageInstanceNotFound)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.ResizeStorage(c.Context(), resizeOneStorageArgs(204800))
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+// TestResizeStorageShrink tests that attempting to shrink a storage instance
+// results in an error with [params.CodeNotSupported].
+func (s *resizeSuite) TestResizeStorageShrink(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.storageService.EXPECT().GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/0",
+	).Return(storageUUID, nil)
+	s.storageService.EXPECT().ResizeStorageInstance(
+		gomock.Any(), storageUUID, uint64(1024),
+	).Return(storageerrors.StorageInstanceShrinkNotSupported)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.ResizeStorage(c.Context(), resizeOneStorageArgs(1024))
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotSupported)
+	c.Check(result.Results[0].Error, tc.ErrorMatches, `storage "data/0" cannot be shrunk`)
+}
+
+// TestResizeStorageNotSupported tests that resizing a storage instance
+// whose storage provider cannot resize it results in an error with
+// [params.CodeNotSupported].
+func (s *resizeSuite) TestResizeStorageNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.storageService.EXPECT().GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/0",
+	).Return(storageUUID, nil)
+	s.storageService.EXPECT().ResizeStorageInstance(
+		gomock.Any(), storageUUID, uint64(1024),
+	).Return(storageerrors.StorageInstanceResizeNotSupported)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.ResizeStorage(c.Context(), resizeOneStorageArgs(1024))
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotSupported)
+	c.Check(result.Results[0].Error, tc.ErrorMatches,
+		`storage "data/0" cannot be resized by its storage provider`)
+}
+
+// TestResizeStorageInvalidTag tests that an invalid storage tag results in an
+// error with [params.CodeNotValid].
+func (s *resizeSuite) TestResizeStorageInvalidTag(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.ResizeStorage(c.Context(), params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: "unit-myapp-0",
+			SizeMiB:    1024,
+		}},
+	})
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotValid)
+}
+
+// TestResizeStorageWithReadPermissionFails tests that a user with only read
+// permission on the model is not allowed to resize storage.
+func (s *resizeSuite) TestResizeStorageWithReadPermissionFails(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	userTag := tc.Must1(c, names.ParseUserTag, "user-tlm")
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		HasReadTag: userTag,
+		Tag:        userTag,
+	}
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.ResizeStorage(c.Context(), resizeOneStorageArgs(1024))
+	paramsErr, is := errors.AsType[*params.Error](err)
+	c.Assert(is, tc.IsTrue)
+	c.Check(paramsErr.Code, tc.Equals, params.CodeUnauthorized)
+	c.Check(result.Results, tc.HasLen, 0)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// CreateStorageSnapshots takes snapshots of storage instances, returning the
+// provider id of each snapshot. The snapshot id can be passed to AddToUnit
+// to create new storage from the snapshot. Storage whose provider cannot
+// snapshot it from the controller is rejected with a not supported error.
+func (a *StorageAPI) CreateStorageSnapshots(
+	ctx context.Context, args params.CreateStorageSnapshotArgs,
+) (params.StringResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.StringResults{}, errors.Capture(err)
+	}
+
+	// Check if changes are allowed and the operation may proceed.
+	if err := a.blockChecker.ChangeAllowed(ctx); err != nil {
+		return params.StringResults{}, errors.Capture(err)
+	}
+
+	results := make([]params.StringResult, len(args.Storage))
+	for i, arg := range args.Storage {
+		id, err := a.createOneStorageSnapshot(ctx, arg)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Result = id
+	}
+	return params.StringResults{Results: results}, nil
+}
+
+func (a *StorageAPI) createOneStorageSnapshot(
+	ctx context.Context, arg params.CreateStorageSnapshotArg,
+) (string, error) {
+	tag, err := names.ParseStorageTag(arg.StorageTag)
+	if err != nil {
+		return "", apiservererrors.ParamsErrorf(params.CodeNotValid, "invalid storage tag")
+	}
+
+	uuid, err := a.storageService.GetStorageInstanceUUIDForID(ctx, tag.Id())
+	if errors.Is(err, storageerrors.StorageInstanceNotFound) {
+		return "", apiservererrors.ParamsErrorf(params.CodeNotFound, "storage %q does not exist", tag.Id())
+	} else if err != nil {
+		return "", errors.Errorf(
+			"getting storage instance uuid for storage id %q: %w",
+			tag.Id(), err,
+		)
+	}
+
+	id, err := a.storageService.CreateStorageInstanceSnapshot(ctx, uuid, arg.Name)
+	switch {
+	case errors.Is(err, storageerrors.StorageInstanceNotFound):
+		return "", apiservererrors.ParamsErrorf(params.CodeNotFound,
+			"storage %q does not exist", tag.Id())
+	case errors.Is(err, storageerrors.StorageInstanceSnapshotNotSupported):
+		return "", apiservererrors.ParamsErrorf(params.CodeNotSupported,
+			"storage %q cannot be snapshotted by its storage provider", tag.Id())
+	case errors.Is(err, storageerrors.StorageInstanceNotProvisioned):
+		return "", apiservererrors.ParamsErrorf(params.CodeNotProvisioned,
+			"storage %q has not been provisioned", tag.Id())
+	case err != nil:
+		return "", errors.Errorf("creating snapshot of storage %q: %w", tag.Id(), err)
+	}
+	return id, nil
+}
+
+// CreateStorageSnapshots is not available on version 8 and earlier of the
+// facade.
+func (*StorageAPIv8) CreateStorageSnapshots(_, _ struct{}) {}
+
+// CreateStorageSnapshots is not available on version 7 and earlier of the
+// facade.
+func (*StorageAPIv7) CreateStorageSnapshots(_, _ struct{}) {}
+
+// CreateStorageSnapshots is not available on version 6 and earlier of the
+// facade.
+func (*StorageAPIv6) CreateStorageSnapshots(_, _ struct{}) {}
@@ -0,0 +1,188 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	corestorage "github.com/juju/juju/core/storage"
+	coreunit "github.com/juju/juju/core/unit"
+	domainapplication "github.com/juju/juju/domain/application"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// snapshotSuite provides a suite of tests for asserting the functionality
+// behind snapshotting storage instances and creating storage from them.
+type snapshotSuite struct {
+	baseStorageSuite
+}
+
+// TestSnapshotSuite registers and runs all the tests from [snapshotSuite].
+func TestSnapshotSuite(t *testing.T) {
+	tc.Run(t, &snapshotSuite{})
+}
+
+// snapshotOneStorageArgs returns a [params.CreateStorageSnapshotArgs]
+// snapshotting "storage-data/0" with the supplied name.
+func snapshotOneStorageArgs(name string) params.CreateStorageSnapshotArgs {
+	return params.CreateStorageSnapshotArgs{
+		Storage: []params.CreateStorageSnapshotArg{{
+			StorageTag: "storage-data/0",
+			Name:       name,
+		}},
+	}
+}
+
+// TestCreateStorageSnapshots is a happy path test for snapshotting a
+// storage instance.
+func (s *snapshotSuite) TestCreateStorageSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.storageService.EXPECT().GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/0",
+	).Return(storageUUID, nil)
+	s.storageService.EXPECT().CreateStorageInstanceSnapshot(
+		gomock.Any(), storageUUID, "nightly",
+	).Return("default:data-0/nightly", nil)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.CreateStorageSnapshots(c.Context(), snapshotOneStorageArgs("nightly"))
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(result.Results, tc.DeepEquals, []params.StringResult{
+		{Result: "default:data-0/nightly"},
+	})
+}
+
+// TestCreateStorageSnapshotsNotFound tests that snapshotting a storage
+// instance that does not exist results in an error with
+// [params.CodeNotFound].
+func (s *snapshotSuite) TestCreateStorageSnapshotsNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.storageService.EXPECT().GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/0",
+	).Return("", storageerrors.StorageInstanceNotFound)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.CreateStorageSnapshots(c.Context(), snapshotOneStorageArgs(""))
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+// TestCreateStorageSnapshotsNotSupported tests that snapshotting a storage
+// instance whose storage provider cannot snapshot it results in an error
+// with [params.CodeNotSupported].
+func (s *snapshotSuite) TestCreateStorageSnapshotsNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.storageService.EXPECT().GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/0",
+	).Return(storageUUID, nil)
+	s.storageService.EXPECT().CreateStorageInstanceSnapshot(
+		gomock.Any(), storageUUID, "",
+	).Return("", storageerrors.StorageInstanceSnapshotNotSupported)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.CreateStorageSnapshots(c.Context(), snapshotOneStorageArgs(""))
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotSupported)
+	c.Check(result.Results[0].Error, tc.ErrorMatches,
+		`storage "data/0" cannot be snapshotted by its storage provider`)
+}
+
+// TestCreateStorageSnapshotsNotProvisioned tests that snapshotting a
+// storage instance which has not been provisioned results in an error with
+// [params.CodeNotProvisioned].
+func (s *snapshotSuite) TestCreateStorageSnapshotsNotProvisioned(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.storageService.EXPECT().GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/0",
+	).Return(storageUUID, nil)
+	s.storageService.EXPECT().CreateStorageInstanceSnapshot(
+		gomock.Any(), storageUUID, "",
+	).Return("", storageerrors.StorageInstanceNotProvisioned)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.CreateStorageSnapshots(c.Context(), snapshotOneStorageArgs(""))
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotProvisioned)
+}
+
+// TestAddToUnitFromSnapshot tests that the snapshot id supplied when adding
+// storage to a unit is passed on to the application service.
+func (s *snapshotSuite) TestAddToUnitFromSnapshot(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+	storageID := corestorage.ID("data/1")
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.applicationService.EXPECT().GetUnitUUID(
+		gomock.Any(), coreunit.Name("mysql/0"),
+	).Return(unitUUID, nil)
+	s.applicationService.EXPECT().AddStorageForIAASUnit(
+		gomock.Any(), corestorage.Name("data"), unitUUID, uint32(1),
+		domainapplication.AddUnitStorageOverride{
+			SnapshotID: new("default:data-0/nightly"),
+		},
+	).Return([]corestorage.ID{storageID}, nil)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.AddToUnit(c.Context(), params.StoragesAddParams{
+		Storages: []params.StorageAddParams{{
+			UnitTag:     "unit-mysql-0",
+			StorageName: "data",
+			SnapshotId:  "default:data-0/nightly",
+		}},
+	})
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(result.Results, tc.DeepEquals, []params.AddStorageResult{{
+		Result: &params.AddStorageDetails{
+			StorageTags: []string{"storage-data-1"},
+		},
+	}})
+}
+
+// TestAddToUnitFromSnapshotNotSupported tests that adding storage from a
+// snapshot with a storage provider that cannot create storage from
+// snapshots results in an error with [params.CodeNotSupported].
+func (s *snapshotSuite) TestAddToUnitFromSnapshotNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.applicationService.EXPECT().GetUnitUUID(
+		gomock.Any(), coreunit.Name("mysql/0"),
+	).Return(unitUUID, nil)
+	s.applicationService.EXPECT().AddStorageForIAASUnit(
+		gomock.Any(), corestorage.Name("data"), unitUUID, uint32(1), gomock.Any(),
+	).Return(nil, storageerrors.StorageInstanceSnapshotNotSupported)
+
+	api := s.makeTestAPIForIAASModel(c)
+	result, err := api.AddToUnit(c.Context(), params.StoragesAddParams{
+		Storages: []params.StorageAddParams{{
+			UnitTag:     "unit-mysql-0",
+			StorageName: "data",
+			SnapshotId:  "snap-1",
+		}},
+	})
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotSupported)
+}
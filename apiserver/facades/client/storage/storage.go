@@ -119,6 +119,40 @@ type StorageService interface {
 		storageID string,
 	) (domainstorage.StorageInstanceUUID, error)
 
+	// ResizeStorageInstance grows the storage instance to at least the
+	// supplied size in MiB.
+	//
+	// The following errors may be returned:
+	// - [coreerrors.NotValid] when the supplied size is zero.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound]
+	// when the storage instance does not exist.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotAlive]
+	// when the storage instance is not alive.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceShrinkNotSupported]
+	// when the supplied size is smaller than the currently requested size.
+	ResizeStorageInstance(
+		ctx context.Context,
+		uuid domainstorage.StorageInstanceUUID,
+		sizeMiB uint64,
+	) error
+
+	// CreateStorageInstanceSnapshot takes a snapshot of the volume or
+	// filesystem provisioned for the storage instance and returns the
+	// provider id of the snapshot.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound]
+	// when the storage instance does not exist.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceSnapshotNotSupported]
+	// when the storage provider cannot snapshot the storage instance.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotProvisioned]
+	// when the storage instance has not been provisioned yet.
+	CreateStorageInstanceSnapshot(
+		ctx context.Context,
+		uuid domainstorage.StorageInstanceUUID,
+		name string,
+	) (string, error)
+
 	// GetStoragePoolUUID returns the UUID of the storage pool for the specified name.
 	GetStoragePoolUUID(context.Context, string) (domainstorage.StoragePoolUUID, error)
 
@@ -274,7 +308,17 @@ type StorageAPIv6 struct {
 	*StorageAPI
 }
 
-// StorageAPI implements the latest version (v7) of the Storage API.
+// StorageAPIv7 provides the Storage API facade for version 7.
+type StorageAPIv7 struct {
+	*StorageAPI
+}
+
+// StorageAPIv8 provides the Storage API facade for version 8.
+type StorageAPIv8 struct {
+	*StorageAPI
+}
+
+// StorageAPI implements the latest version (v9) of the Storage API.
 type StorageAPI struct {
 	blockChecker       BlockChecker
 	applicationService ApplicationService
@@ -540,6 +584,7 @@ func (a *StorageAPI) ListStorageDetails(
 				MachineTag: machineTagStr,
 				StorageTag: storageInstTag.String(),
 				UnitTag:    unitTag.String(),
+				Usage:      storageUsageToParams(attachment.Usage),
 			}
 
 			retVal.Attachments[unitTag.String()] = sad
@@ -579,6 +624,19 @@ func (a *StorageAPI) ListStorageDetails(
 	return retVal, nil
 }
 
+// storageUsageToParams converts the last reported usage of an attached
+// filesystem into its params form, or nil if none has been reported.
+func storageUsageToParams(usage *statusservice.StorageUsage) *params.StorageUsage {
+	if usage == nil {
+		return nil
+	}
+	return &params.StorageUsage{
+		SizeMiB: usage.SizeMiB,
+		UsedMiB: usage.UsedMiB,
+		Updated: usage.UpdatedAt,
+	}
+}
+
 // ListVolumes lists volumes with the given filters. Each filter produces
 // an independent list of volumes, or an error if the filter is invalid
 // or the volumes could not be listed.
@@ -738,6 +796,7 @@ func (a *StorageAPI) volumeDetails(
 			sad := params.StorageAttachmentDetails{
 				StorageTag: details.StorageTag,
 				UnitTag:    names.NewUnitTag(sa.Unit.String()).String(),
+				Usage:      storageUsageToParams(sa.Usage),
 			}
 			if sa.Machine != nil {
 				sad.MachineTag = names.NewMachineTag(sa.Machine.String()).String()
@@ -1005,6 +1064,7 @@ func (a *StorageAPI) filesystemDetails(
 			sad := params.StorageAttachmentDetails{
 				StorageTag: details.StorageTag,
 				UnitTag:    names.NewUnitTag(sa.Unit.String()).String(),
+				Usage:      storageUsageToParams(sa.Usage),
 			}
 			if sa.Machine != nil {
 				sad.MachineTag = names.NewMachineTag(sa.Machine.String()).String()
@@ -1188,6 +1248,9 @@ func (a *StorageAPI) addOneStorage(ctx context.Context, one params.StorageAddPar
 		StoragePoolUUID: storagePoolUUID,
 		SizeMiB:         one.Directives.SizeMiB,
 	}
+	if one.SnapshotId != "" {
+		args.SnapshotID = &one.SnapshotId
+	}
 	var result []corestorage.ID
 	if a.modelType == coremodel.CAAS {
 		result, err = a.applicationService.AddStorageForCAASUnit(
@@ -1222,6 +1285,10 @@ func handleUnitAddStorageError(err error, unitName coreunit.Name, one params.Sto
 	case errors.Is(err, applicationerrors.StorageNameNotSupported):
 		return apiservererrors.ParamsErrorf(params.CodeNotSupported,
 			"storage name %q not supported by charm", one.StorageName)
+	case errors.Is(err, storageerrors.StorageInstanceSnapshotNotSupported):
+		return apiservererrors.ParamsErrorf(params.CodeNotSupported,
+			"storage %q cannot be created from a snapshot by its storage provider",
+			one.StorageName)
 		// When the supplied storage directive overrides violates the charm's
 		// storage.
 	case errors.HasType[applicationerrors.StorageCountLimitExceeded](err):
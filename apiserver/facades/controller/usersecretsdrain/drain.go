@@ -130,7 +130,7 @@ func (s *SecretsDrainAPI) getSecretContent(ctx context.Context, arg params.GetSe
 		return nil, nil, false, errors.Trace(err)
 	}
 
-	val, valueRef, err := s.secretService.GetSecretValue(ctx, md.URI, md.LatestRevision, secret.SecretAccessor{
+	val, valueRef, err := s.secretService.GetSecretValueForDrain(ctx, md.URI, md.LatestRevision, secret.SecretAccessor{
 		Kind: secret.ModelAccessor,
 		ID:   s.modelUUID.String(),
 	})
@@ -187,7 +187,7 @@ func (s *SecretsDrainAPI) GetSecretRevisionContentInfo(ctx context.Context, arg
 	}
 
 	for i, rev := range arg.Revisions {
-		val, valueRef, err := s.secretService.GetSecretValue(ctx, uri, rev, secret.SecretAccessor{
+		val, valueRef, err := s.secretService.GetSecretValueForDrain(ctx, uri, rev, secret.SecretAccessor{
 			Kind: secret.ModelAccessor,
 			ID:   s.modelUUID.String(),
 		})
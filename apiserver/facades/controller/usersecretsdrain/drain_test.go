@@ -140,7 +140,7 @@ func (s *drainSuite) TestGetSecretContentInternal(c *tc.C) {
 	val := coresecrets.NewSecretValue(data)
 	uri := coresecrets.NewURI()
 	s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(&coresecrets.SecretMetadata{URI: uri, LatestRevision: 668}, nil)
-	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 668, secret.SecretAccessor{
+	s.secretService.EXPECT().GetSecretValueForDrain(gomock.Any(), uri, 668, secret.SecretAccessor{
 		Kind: secret.ModelAccessor,
 		ID:   coretesting.ModelTag.Id(),
 	}).Return(
@@ -165,7 +165,7 @@ func (s *drainSuite) TestGetSecretContentExternal(c *tc.C) {
 
 	uri := coresecrets.NewURI()
 	s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(&coresecrets.SecretMetadata{URI: uri, LatestRevision: 668}, nil)
-	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 668, secret.SecretAccessor{
+	s.secretService.EXPECT().GetSecretValueForDrain(gomock.Any(), uri, 668, secret.SecretAccessor{
 		Kind: secret.ModelAccessor,
 		ID:   coretesting.ModelTag.Id(),
 	}).Return(
@@ -232,7 +232,7 @@ func (s *drainSuite) TestGetSecretRevisionContentInfoInternal(c *tc.C) {
 	uri := coresecrets.NewURI()
 	data := map[string]string{"foo": "bar"}
 	val := coresecrets.NewSecretValue(data)
-	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secret.SecretAccessor{
+	s.secretService.EXPECT().GetSecretValueForDrain(gomock.Any(), uri, 666, secret.SecretAccessor{
 		Kind: secret.ModelAccessor,
 		ID:   coretesting.ModelTag.Id(),
 	}).Return(
@@ -255,7 +255,7 @@ func (s *drainSuite) TestGetSecretRevisionContentInfoExternal(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secret.SecretAccessor{
+	s.secretService.EXPECT().GetSecretValueForDrain(gomock.Any(), uri, 666, secret.SecretAccessor{
 		Kind: secret.ModelAccessor,
 		ID:   coretesting.ModelTag.Id(),
 	}).Return(
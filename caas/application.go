@@ -59,6 +59,7 @@ type Application interface {
 	) error
 
 	ServiceInterface
+	NetworkPolicyInterface
 }
 
 // ServicePort represents service ports mapping from service to units.
@@ -83,6 +84,39 @@ type ServiceInterface interface {
 	UpdatePorts(ports []ServicePort, updateContainerPorts bool) error
 }
 
+// NetworkPolicy describes the inbound traffic accepted by the units of an
+// application. Any traffic not matching one of its ingress rules is denied.
+type NetworkPolicy struct {
+	Ingress []NetworkPolicyIngressRule `json:"ingress"`
+}
+
+// NetworkPolicyIngressRule allows the units of the applications and the
+// CIDRs it lists to connect to the ports of an application's units.
+type NetworkPolicyIngressRule struct {
+	// Ports are the ports the traffic is accepted on. Traffic is accepted
+	// on all ports when empty.
+	Ports []ServicePort `json:"ports,omitempty"`
+
+	// Applications are the names of the applications whose units may
+	// connect.
+	Applications []string `json:"applications,omitempty"`
+
+	// CIDRs are the networks which may connect.
+	CIDRs []string `json:"cidrs,omitempty"`
+}
+
+// NetworkPolicyInterface provides the API to restrict the inbound traffic of
+// an application's units.
+type NetworkPolicyInterface interface {
+	// EnsureNetworkPolicy ensures that the inbound traffic of the
+	// application's units is restricted to the given policy.
+	EnsureNetworkPolicy(policy NetworkPolicy) error
+
+	// DeleteNetworkPolicy removes any restriction on the inbound traffic
+	// of the application's units. It is not an error if there is none.
+	DeleteNetworkPolicy() error
+}
+
 // ApplicationState represents the application state.
 type ApplicationState struct {
 	DesiredReplicas int
@@ -0,0 +1,537 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/client/modelconfig"
+	"github.com/juju/juju/api/client/spaces"
+	"github.com/juju/juju/api/client/storage"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ApplyModelConfigAPI defines the methods used to read and update the
+// model config and constraints when applying a model file.
+type ApplyModelConfigAPI interface {
+	ModelGet(ctx context.Context) (map[string]any, error)
+	ModelSet(ctx context.Context, config map[string]any) error
+	GetModelConstraints(ctx context.Context) (constraints.Value, error)
+	SetModelConstraints(ctx context.Context, constraints constraints.Value) error
+}
+
+// ApplyStorageAPI defines the methods used to read and update storage
+// pools when applying a model file.
+type ApplyStorageAPI interface {
+	ListPools(ctx context.Context, providers, names []string) ([]params.StoragePool, error)
+	CreatePool(ctx context.Context, name, provider string, attrs map[string]any) error
+	UpdatePool(ctx context.Context, name, provider string, attrs map[string]any) error
+}
+
+// ApplySpacesAPI defines the methods used to read and create spaces when
+// applying a model file.
+type ApplySpacesAPI interface {
+	ListSpaces(ctx context.Context) ([]params.Space, error)
+	CreateSpace(ctx context.Context, name string, cidrs []string, public bool) error
+}
+
+const applyDoc = `
+Apply a model file to the current model, making only the changes needed for
+the model to match it.
+
+A model file is a bundle that can also hold model level settings under a
+top level "model" key:
+
+    model:
+      config:
+        update-status-hook-interval: 10m
+      constraints: mem=4G
+      storage-pools:
+        fast:
+          type: ebs
+          attributes:
+            volume-type: gp3
+      spaces:
+        internal: [10.0.0.0/24]
+    applications:
+      ...
+
+The model config, model constraints, storage pools and spaces are compared
+with the model, and then the applications, their config, constraints and
+scale, relations, offers and SAAS consumers in the bundle are compared with
+the model in the same way as when deploying a bundle to an existing model.
+
+Use --dry-run to show the plan without changing the model.
+
+Applying a model file never removes anything from the model: applications,
+relations, pools and spaces that are not in the file are left in place.
+Spaces that already exist are not changed; use move-to-space to move subnets
+between spaces.
+`
+
+const applyExamples = `
+Show the changes needed for the model to match a model file:
+
+    juju apply -f model.yaml --dry-run
+
+Apply a model file to another model:
+
+    juju apply -m production -f model.yaml
+`
+
+// NewApplyCommand returns a command to apply a model file to a model.
+func NewApplyCommand() cmd.Command {
+	command := &applyCommand{}
+	command.newAPIRootFn = func(ctx context.Context) (base.APICallCloser, error) {
+		return command.NewAPIRoot(ctx)
+	}
+	command.newModelConfigAPI = func(api base.APICallCloser) ApplyModelConfigAPI {
+		return modelconfig.NewClient(api)
+	}
+	command.newStorageAPI = func(api base.APICallCloser) ApplyStorageAPI {
+		return storage.NewClient(api)
+	}
+	command.newSpacesAPI = func(api base.APICallCloser) ApplySpacesAPI {
+		return spaces.NewAPI(api)
+	}
+	command.newDeployCommand = func(ds charm.BundleDataSource) modelcmd.ModelCommand {
+		deployCmd := newDeployCommand()
+		deployCmd.bundleDataSource = ds
+		return modelcmd.Wrap(deployCmd)
+	}
+	return modelcmd.Wrap(command)
+}
+
+// applyCommand applies a model file to a model.
+type applyCommand struct {
+	modelcmd.ModelCommandBase
+
+	file   string
+	dryRun bool
+
+	newAPIRootFn      func(context.Context) (base.APICallCloser, error)
+	newModelConfigAPI func(base.APICallCloser) ApplyModelConfigAPI
+	newStorageAPI     func(base.APICallCloser) ApplyStorageAPI
+	newSpacesAPI      func(base.APICallCloser) ApplySpacesAPI
+	newDeployCommand  func(charm.BundleDataSource) modelcmd.ModelCommand
+}
+
+// Info is part of cmd.Command.
+func (c *applyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "apply",
+		Purpose:  "Apply a model file to a model.",
+		Doc:      applyDoc,
+		Examples: applyExamples,
+		SeeAlso: []string{
+			"deploy",
+			"diff-bundle",
+			"model-config",
+			"set-model-constraints",
+		},
+	})
+}
+
+// SetFlags is part of cmd.Command.
+func (c *applyCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.file, "f", "", "The model file to apply")
+	f.StringVar(&c.file, "file", "", "")
+	f.BoolVar(&c.dryRun, "dry-run", false, "Show the changes without applying them")
+}
+
+// Init is part of cmd.Command.
+func (c *applyCommand) Init(args []string) error {
+	if c.file == "" {
+		return errors.New("no model file specified")
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run is part of cmd.Command.
+func (c *applyCommand) Run(ctx *cmd.Context) error {
+	path, err := filepath.Abs(ctx.AbsPath(c.file))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Annotate(err, "reading model file")
+	}
+	spec, bundleBytes, err := splitModelFile(data)
+	if err != nil {
+		return errors.Annotatef(err, "parsing model file %q", c.file)
+	}
+
+	apiRoot, err := c.newAPIRootFn(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = apiRoot.Close() }()
+
+	changes, err := c.planModelChanges(ctx, apiRoot, spec)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := c.applyModelChanges(ctx, changes); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+
+	if bundleBytes == nil {
+		return nil
+	}
+	ds, err := charm.StreamBundleDataSource(bytes.NewReader(bundleBytes), filepath.Dir(path))
+	if err != nil {
+		return errors.Annotatef(err, "parsing model file %q", c.file)
+	}
+	return errors.Trace(c.deployBundle(ctx, path, ds))
+}
+
+// deployBundle deploys the bundle part of the model file with the deploy
+// command, which only makes the changes needed for the model to match it.
+func (c *applyCommand) deployBundle(ctx *cmd.Context, path string, ds charm.BundleDataSource) error {
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	modelName, err := c.ModelIdentifier()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	args := []string{"-m", controllerName + ":" + modelName, path}
+	if c.dryRun {
+		args = append(args, "--dry-run")
+	}
+
+	deployCmd := c.newDeployCommand(ds)
+	deployCmd.SetClientStore(c.ClientStore())
+	f := gnuflag.NewFlagSet(deployCmd.Info().Name, gnuflag.ContinueOnError)
+	f.SetOutput(io.Discard)
+	deployCmd.SetFlags(f)
+	if err := f.Parse(deployCmd.AllowInterspersedFlags(), args); err != nil {
+		return errors.Trace(err)
+	}
+	if err := deployCmd.Init(f.Args()); err != nil {
+		return errors.Trace(err)
+	}
+	return deployCmd.Run(ctx)
+}
+
+// modelSpec holds the model level settings of a model file.
+type modelSpec struct {
+	Config       map[string]any             `yaml:"config,omitempty"`
+	Constraints  string                     `yaml:"constraints,omitempty"`
+	StoragePools map[string]storagePoolSpec `yaml:"storage-pools,omitempty"`
+	Spaces       map[string][]string        `yaml:"spaces,omitempty"`
+}
+
+// storagePoolSpec holds the settings of a storage pool in a model file.
+type storagePoolSpec struct {
+	Type       string         `yaml:"type"`
+	Attributes map[string]any `yaml:"attributes,omitempty"`
+}
+
+// modelSectionKey is the top level key that holds the model settings in a
+// model file.
+const modelSectionKey = "model"
+
+// splitModelFile separates the model settings from the bundle in a model
+// file. The bundle is returned with the model settings removed, or nil if
+// the file holds nothing but model settings.
+func splitModelFile(data []byte) (modelSpec, []byte, error) {
+	var (
+		spec     modelSpec
+		found    bool
+		docs     [][]byte
+		document = yaml.NewDecoder(bytes.NewReader(data))
+	)
+	for {
+		var doc yaml.MapSlice
+		err := document.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return modelSpec{}, nil, errors.Trace(err)
+		}
+
+		bundleDoc := make(yaml.MapSlice, 0, len(doc))
+		for _, item := range doc {
+			if item.Key != modelSectionKey {
+				bundleDoc = append(bundleDoc, item)
+				continue
+			}
+			if found {
+				return modelSpec{}, nil, errors.Errorf("more than one %q section", modelSectionKey)
+			}
+			found = true
+			section, err := yaml.Marshal(item.Value)
+			if err != nil {
+				return modelSpec{}, nil, errors.Trace(err)
+			}
+			if err := yaml.UnmarshalStrict(section, &spec); err != nil {
+				return modelSpec{}, nil, errors.Annotatef(err, "%q section", modelSectionKey)
+			}
+		}
+		if len(bundleDoc) == 0 {
+			continue
+		}
+		out, err := yaml.Marshal(bundleDoc)
+		if err != nil {
+			return modelSpec{}, nil, errors.Trace(err)
+		}
+		docs = append(docs, out)
+	}
+	if len(docs) == 0 {
+		return spec, nil, nil
+	}
+	return spec, bytes.Join(docs, []byte("---\n")), nil
+}
+
+// modelChange is a change to the model level settings.
+type modelChange struct {
+	descriptions []string
+	apply        func(context.Context) error
+}
+
+// planModelChanges compares the model settings in the model file with the
+// model, and returns the changes needed for the model to match them. Spaces
+// are created before pools, constraints and config, as the applications in
+// the bundle may be bound to them.
+func (c *applyCommand) planModelChanges(ctx *cmd.Context, apiRoot base.APICallCloser, spec modelSpec) ([]modelChange, error) {
+	var changes []modelChange
+
+	if len(spec.Spaces) > 0 {
+		spaceChanges, err := planSpaceChanges(ctx, c.newSpacesAPI(apiRoot), spec.Spaces)
+		if err != nil {
+			return nil, errors.Annotate(err, "planning space changes")
+		}
+		changes = append(changes, spaceChanges...)
+	}
+
+	if len(spec.StoragePools) > 0 {
+		poolChanges, err := planStoragePoolChanges(ctx, c.newStorageAPI(apiRoot), spec.StoragePools)
+		if err != nil {
+			return nil, errors.Annotate(err, "planning storage pool changes")
+		}
+		changes = append(changes, poolChanges...)
+	}
+
+	if spec.Constraints != "" || len(spec.Config) > 0 {
+		configAPI := c.newModelConfigAPI(apiRoot)
+		if spec.Constraints != "" {
+			change, err := planConstraintsChange(ctx, configAPI, spec.Constraints)
+			if err != nil {
+				return nil, errors.Annotate(err, "planning model constraints changes")
+			}
+			if change != nil {
+				changes = append(changes, *change)
+			}
+		}
+		if len(spec.Config) > 0 {
+			change, err := planConfigChange(ctx, configAPI, spec.Config)
+			if err != nil {
+				return nil, errors.Annotate(err, "planning model config changes")
+			}
+			if change != nil {
+				changes = append(changes, *change)
+			}
+		}
+	}
+	return changes, nil
+}
+
+func planSpaceChanges(ctx *cmd.Context, api ApplySpacesAPI, desired map[string][]string) ([]modelChange, error) {
+	existing, err := api.ListSpaces(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	current := make(map[string]set.Strings)
+	for _, space := range existing {
+		cidrs := set.NewStrings()
+		for _, subnet := range space.Subnets {
+			cidrs.Add(subnet.CIDR)
+		}
+		current[space.Name] = cidrs
+	}
+
+	var changes []modelChange
+	for _, name := range sortedKeys(desired) {
+		cidrs := desired[name]
+		if currentCIDRs, ok := current[name]; ok {
+			if missing := set.NewStrings(cidrs...).Difference(currentCIDRs); !missing.IsEmpty() {
+				ctx.Warningf("space %q does not contain subnets %s; use move-to-space to move them",
+					name, strings.Join(missing.SortedValues(), ", "))
+			}
+			continue
+		}
+		changes = append(changes, modelChange{
+			descriptions: []string{fmt.Sprintf("create space %s with subnets %s", name, strings.Join(cidrs, ", "))},
+			apply: func(ctx context.Context) error {
+				return errors.Annotatef(api.CreateSpace(ctx, name, cidrs, true), "creating space %q", name)
+			},
+		})
+	}
+	return changes, nil
+}
+
+func planStoragePoolChanges(ctx context.Context, api ApplyStorageAPI, desired map[string]storagePoolSpec) ([]modelChange, error) {
+	existing, err := api.ListPools(ctx, nil, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	current := make(map[string]params.StoragePool)
+	for _, pool := range existing {
+		current[pool.Name] = pool
+	}
+
+	var changes []modelChange
+	for _, name := range sortedKeys(desired) {
+		pool := desired[name]
+		if pool.Type == "" {
+			return nil, errors.NotValidf("storage pool %q without a type", name)
+		}
+		attrs := make(map[string]any)
+		for key, value := range pool.Attributes {
+			attrs[key] = value
+		}
+
+		currentPool, ok := current[name]
+		if !ok {
+			changes = append(changes, modelChange{
+				descriptions: []string{fmt.Sprintf("create storage pool %s of type %s", name, pool.Type)},
+				apply: func(ctx context.Context) error {
+					return errors.Annotatef(api.CreatePool(ctx, name, pool.Type, attrs), "creating storage pool %q", name)
+				},
+			})
+			continue
+		}
+
+		var descriptions []string
+		if currentPool.Provider != pool.Type {
+			descriptions = append(descriptions, fmt.Sprintf("set storage pool %s type to %s (currently %s)", name, pool.Type, currentPool.Provider))
+		}
+		for _, key := range sortedKeys(attrs) {
+			value, ok := currentPool.Attrs[key]
+			if !ok {
+				descriptions = append(descriptions, fmt.Sprintf("set storage pool %s attribute %s to %v", name, key, attrs[key]))
+			} else if fmt.Sprint(value) != fmt.Sprint(attrs[key]) {
+				descriptions = append(descriptions, fmt.Sprintf("set storage pool %s attribute %s to %v (currently %v)", name, key, attrs[key], value))
+			}
+		}
+		if len(descriptions) == 0 {
+			continue
+		}
+		changes = append(changes, modelChange{
+			descriptions: descriptions,
+			apply: func(ctx context.Context) error {
+				return errors.Annotatef(api.UpdatePool(ctx, name, pool.Type, attrs), "updating storage pool %q", name)
+			},
+		})
+	}
+	return changes, nil
+}
+
+func planConstraintsChange(ctx context.Context, api ApplyModelConfigAPI, desired string) (*modelChange, error) {
+	cons, err := constraints.Parse(desired)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	current, err := api.GetModelConstraints(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if current.String() == cons.String() {
+		return nil, nil
+	}
+	return &modelChange{
+		descriptions: []string{fmt.Sprintf("set model constraints to %q (currently %q)", cons.String(), current.String())},
+		apply: func(ctx context.Context) error {
+			return errors.Annotate(api.SetModelConstraints(ctx, cons), "setting model constraints")
+		},
+	}, nil
+}
+
+func planConfigChange(ctx context.Context, api ApplyModelConfigAPI, desired map[string]any) (*modelChange, error) {
+	current, err := api.ModelGet(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var descriptions []string
+	attrs := make(map[string]any)
+	for _, key := range sortedKeys(desired) {
+		value, ok := current[key]
+		switch {
+		case !ok:
+			descriptions = append(descriptions, fmt.Sprintf("set model config %s to %v", key, desired[key]))
+		case fmt.Sprint(value) != fmt.Sprint(desired[key]):
+			descriptions = append(descriptions, fmt.Sprintf("set model config %s to %v (currently %v)", key, desired[key], value))
+		default:
+			continue
+		}
+		attrs[key] = desired[key]
+	}
+	if len(attrs) == 0 {
+		return nil, nil
+	}
+	return &modelChange{
+		descriptions: descriptions,
+		apply: func(ctx context.Context) error {
+			return errors.Annotate(api.ModelSet(ctx, attrs), "setting model config")
+		},
+	}, nil
+}
+
+// applyModelChanges writes out the model changes and, unless this is a dry
+// run, applies them.
+func (c *applyCommand) applyModelChanges(ctx *cmd.Context, changes []modelChange) error {
+	if len(changes) == 0 {
+		ctx.Infof("No changes to model settings.")
+		return nil
+	}
+	if c.dryRun {
+		fmt.Fprintf(ctx.Stdout, "Changes to model settings:\n")
+	} else {
+		fmt.Fprintf(ctx.Stdout, "Applying model settings:\n")
+	}
+	for _, change := range changes {
+		for _, desc := range change.descriptions {
+			fmt.Fprintf(ctx.Stdout, "- %s\n", desc)
+		}
+		if c.dryRun {
+			continue
+		}
+		if err := change.apply(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
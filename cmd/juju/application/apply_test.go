@@ -0,0 +1,345 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/juju/gnuflag"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type ApplySuite struct {
+	testhelpers.IsolationSuite
+
+	api    *mockApplyAPI
+	deploy *fakeDeployCommand
+	dir    string
+}
+
+func TestApplySuite(t *testing.T) {
+	tc.Run(t, &ApplySuite{})
+}
+
+func (s *ApplySuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.api = &mockApplyAPI{
+		Stub: &testhelpers.Stub{},
+		config: map[string]any{
+			"update-status-hook-interval": "5m",
+			"logging-config":              "<root>=INFO",
+		},
+		constraints: constraints.MustParse("mem=2G"),
+		pools: []params.StoragePool{{
+			Name:     "fast",
+			Provider: "ebs",
+			Attrs:    map[string]any{"volume-type": "gp2"},
+		}},
+		spaces: []params.Space{{
+			Name:    "alpha",
+			Subnets: []params.Subnet{{CIDR: "10.0.0.0/24"}},
+		}},
+	}
+	s.deploy = &fakeDeployCommand{}
+	s.dir = c.MkDir()
+}
+
+const applyModelFile = `
+model:
+  config:
+    update-status-hook-interval: 10m
+    logging-config: <root>=INFO
+  constraints: mem=4G
+  storage-pools:
+    fast:
+      type: ebs
+      attributes:
+        volume-type: gp3
+    slow:
+      type: ebs
+  spaces:
+    alpha: [10.0.0.0/24]
+    internal: [10.0.1.0/24]
+applications:
+  mysql:
+    charm: mysql
+    num_units: 3
+`
+
+func (s *ApplySuite) writeModelFile(c *tc.C, content string) string {
+	path := filepath.Join(s.dir, "model.yaml")
+	err := os.WriteFile(path, []byte(content), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	return path
+}
+
+func (s *ApplySuite) runApply(c *tc.C, args ...string) (*cmd.Context, error) {
+	command := NewApplyCommandForTest(
+		jujuclienttesting.MinimalStore(), fakeAPIRoot{}, s.api, s.api, s.api,
+		func(ds charm.BundleDataSource) modelcmd.ModelCommand {
+			s.deploy.ds = ds
+			return modelcmd.Wrap(s.deploy)
+		},
+	)
+	return cmdtesting.RunCommand(c, command, args...)
+}
+
+func (s *ApplySuite) TestInitNoFile(c *tc.C) {
+	_, err := s.runApply(c)
+	c.Assert(err, tc.ErrorMatches, "no model file specified")
+}
+
+func (s *ApplySuite) TestDryRun(c *tc.C) {
+	path := s.writeModelFile(c, applyModelFile)
+
+	ctx, err := s.runApply(c, "-f", path, "--dry-run")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Changes to model settings:
+- create space internal with subnets 10.0.1.0/24
+- set storage pool fast attribute volume-type to gp3 (currently gp2)
+- create storage pool slow of type ebs
+- set model constraints to "mem=4096M" (currently "mem=2048M")
+- set model config update-status-hook-interval to 10m (currently 5m)
+Changes to deploy bundle:
+`[1:])
+	s.api.CheckCallNames(c, "ListSpaces", "ListPools", "GetModelConstraints", "ModelGet")
+
+	c.Check(s.deploy.args, tc.DeepEquals, []string{path})
+	c.Check(s.deploy.dryRun, tc.IsTrue)
+	c.Check(s.deploy.model, tc.Equals, "arthur:king/sword")
+	c.Assert(s.deploy.ds, tc.NotNil)
+	c.Check(string(s.deploy.ds.BundleBytes()), tc.Not(tc.Contains), "model:")
+	c.Check(s.deploy.ds.BasePath(), tc.Equals, s.dir)
+	parts := s.deploy.ds.Parts()
+	c.Assert(parts, tc.HasLen, 1)
+	c.Check(parts[0].UnmarshallError, tc.ErrorIsNil)
+	c.Check(parts[0].Data.Applications["mysql"].NumUnits, tc.Equals, 3)
+}
+
+func (s *ApplySuite) TestApply(c *tc.C) {
+	path := s.writeModelFile(c, applyModelFile)
+
+	ctx, err := s.runApply(c, "-f", path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Matches, "Applying model settings:\n(?s).*")
+	s.api.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "ListSpaces"},
+		{FuncName: "ListPools"},
+		{FuncName: "GetModelConstraints"},
+		{FuncName: "ModelGet"},
+		{FuncName: "CreateSpace", Args: []any{"internal", []string{"10.0.1.0/24"}, true}},
+		{FuncName: "UpdatePool", Args: []any{"fast", "ebs", map[string]any{"volume-type": "gp3"}}},
+		{FuncName: "CreatePool", Args: []any{"slow", "ebs", map[string]any{}}},
+		{FuncName: "SetModelConstraints", Args: []any{constraints.MustParse("mem=4G")}},
+		{FuncName: "ModelSet", Args: []any{map[string]any{"update-status-hook-interval": "10m"}}},
+	})
+	c.Check(s.deploy.dryRun, tc.IsFalse)
+	c.Check(s.deploy.ran, tc.IsTrue)
+}
+
+func (s *ApplySuite) TestNoModelChanges(c *tc.C) {
+	path := s.writeModelFile(c, `
+model:
+  config:
+    update-status-hook-interval: 5m
+applications:
+  mysql:
+    charm: mysql
+`)
+
+	ctx, err := s.runApply(c, "-f", path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No changes to model settings.\n")
+	s.api.CheckCallNames(c, "ModelGet")
+	c.Check(s.deploy.ran, tc.IsTrue)
+}
+
+func (s *ApplySuite) TestModelSettingsOnly(c *tc.C) {
+	path := s.writeModelFile(c, `
+model:
+  constraints: mem=4G
+`)
+
+	_, err := s.runApply(c, "-f", path)
+	c.Assert(err, tc.ErrorIsNil)
+	s.api.CheckCallNames(c, "GetModelConstraints", "SetModelConstraints")
+	c.Check(s.deploy.ran, tc.IsFalse)
+}
+
+func (s *ApplySuite) TestExistingSpaceWithOtherSubnets(c *tc.C) {
+	path := s.writeModelFile(c, `
+model:
+  spaces:
+    alpha: [10.0.0.0/24, 10.0.2.0/24]
+`)
+
+	// Existing spaces are not changed, only warned about.
+	ctx, err := s.runApply(c, "-f", path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No changes to model settings.\n")
+	s.api.CheckCallNames(c, "ListSpaces")
+}
+
+func (s *ApplySuite) TestInvalidModelSection(c *tc.C) {
+	path := s.writeModelFile(c, `
+model:
+  settings:
+    foo: bar
+`)
+
+	_, err := s.runApply(c, "-f", path)
+	c.Assert(err, tc.ErrorMatches, `(?s)parsing model file .*: "model" section: .*field settings not found.*`)
+	s.api.CheckNoCalls(c)
+}
+
+func (s *ApplySuite) TestStoragePoolWithoutType(c *tc.C) {
+	path := s.writeModelFile(c, `
+model:
+  storage-pools:
+    fast: {}
+`)
+
+	_, err := s.runApply(c, "-f", path)
+	c.Assert(err, tc.ErrorMatches, `planning storage pool changes: storage pool "fast" without a type not valid`)
+}
+
+func (s *ApplySuite) TestSplitModelFileMultipleDocuments(c *tc.C) {
+	spec, bundle, err := splitModelFile([]byte(`
+applications:
+  mysql:
+    charm: mysql
+---
+model:
+  constraints: mem=4G
+applications:
+  mysql:
+    num_units: 2
+`))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(spec.Constraints, tc.Equals, "mem=4G")
+	c.Check(strings.Count(string(bundle), "applications:"), tc.Equals, 2)
+	c.Check(string(bundle), tc.Not(tc.Contains), "model:")
+
+	_, _, err = splitModelFile([]byte("model: {}\n---\nmodel: {}\n"))
+	c.Check(err, tc.ErrorMatches, `more than one "model" section`)
+}
+
+type fakeAPIRoot struct {
+	base.APICallCloser
+}
+
+func (fakeAPIRoot) Close() error {
+	return nil
+}
+
+type mockApplyAPI struct {
+	*testhelpers.Stub
+
+	config      map[string]any
+	constraints constraints.Value
+	pools       []params.StoragePool
+	spaces      []params.Space
+}
+
+func (m *mockApplyAPI) ModelGet(ctx context.Context) (map[string]any, error) {
+	m.MethodCall(m, "ModelGet")
+	return m.config, m.NextErr()
+}
+
+func (m *mockApplyAPI) ModelSet(ctx context.Context, config map[string]any) error {
+	m.MethodCall(m, "ModelSet", config)
+	return m.NextErr()
+}
+
+func (m *mockApplyAPI) GetModelConstraints(ctx context.Context) (constraints.Value, error) {
+	m.MethodCall(m, "GetModelConstraints")
+	return m.constraints, m.NextErr()
+}
+
+func (m *mockApplyAPI) SetModelConstraints(ctx context.Context, cons constraints.Value) error {
+	m.MethodCall(m, "SetModelConstraints", cons)
+	return m.NextErr()
+}
+
+func (m *mockApplyAPI) ListPools(ctx context.Context, providers, names []string) ([]params.StoragePool, error) {
+	m.MethodCall(m, "ListPools")
+	return m.pools, m.NextErr()
+}
+
+func (m *mockApplyAPI) CreatePool(ctx context.Context, name, provider string, attrs map[string]any) error {
+	m.MethodCall(m, "CreatePool", name, provider, attrs)
+	return m.NextErr()
+}
+
+func (m *mockApplyAPI) UpdatePool(ctx context.Context, name, provider string, attrs map[string]any) error {
+	m.MethodCall(m, "UpdatePool", name, provider, attrs)
+	return m.NextErr()
+}
+
+func (m *mockApplyAPI) ListSpaces(ctx context.Context) ([]params.Space, error) {
+	m.MethodCall(m, "ListSpaces")
+	return m.spaces, m.NextErr()
+}
+
+func (m *mockApplyAPI) CreateSpace(ctx context.Context, name string, cidrs []string, public bool) error {
+	m.MethodCall(m, "CreateSpace", name, cidrs, public)
+	return m.NextErr()
+}
+
+// fakeDeployCommand stands in for the deploy command run by apply.
+type fakeDeployCommand struct {
+	modelcmd.ModelCommandBase
+
+	ds     charm.BundleDataSource
+	args   []string
+	model  string
+	dryRun bool
+	ran    bool
+}
+
+func (f *fakeDeployCommand) Info() *cmd.Info {
+	return &cmd.Info{Name: "deploy"}
+}
+
+func (f *fakeDeployCommand) SetFlags(fs *gnuflag.FlagSet) {
+	f.ModelCommandBase.SetFlags(fs)
+	fs.BoolVar(&f.dryRun, "dry-run", false, "")
+}
+
+func (f *fakeDeployCommand) Init(args []string) error {
+	f.args = args
+	return nil
+}
+
+func (f *fakeDeployCommand) Run(ctx *cmd.Context) error {
+	f.ran = true
+	controllerName, err := f.ControllerName()
+	if err != nil {
+		return err
+	}
+	modelName, err := f.ModelIdentifier()
+	if err != nil {
+		return err
+	}
+	f.model = controllerName + ":" + modelName
+	if f.dryRun {
+		_, _ = ctx.Stdout.Write([]byte("Changes to deploy bundle:\n"))
+	}
+	return nil
+}
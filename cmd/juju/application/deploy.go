@@ -339,6 +339,10 @@ type DeployCommand struct {
 	machineMap string
 	flagSet    *gnuflag.FlagSet
 
+	// bundleDataSource, if set, holds the bundle at CharmOrBundle, already
+	// read by the apply command.
+	bundleDataSource charm.BundleDataSource
+
 	unknownModel bool
 
 	controllerAPIRoot api.Connection
@@ -853,6 +857,7 @@ func (c *DeployCommand) getDeployerFactory(base corebase.Base, defaultCharmSchem
 		BundleStorage:      c.BundleStorage,
 		Channel:            c.Channel,
 		CharmOrBundle:      c.CharmOrBundle,
+		BundleDataSource:   c.bundleDataSource,
 		DefaultCharmSchema: defaultCharmSchema,
 		ConfigOptions:      c.ConfigOptions,
 		Constraints:        c.Constraints,
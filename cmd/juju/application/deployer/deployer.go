@@ -217,6 +217,9 @@ func (d *factory) repoBundleDeployer(ctx context.Context, userCharmURL *charm.UR
 }
 
 func (d *factory) localBundleDeployer() (DeployerKind, error) {
+	if d.bundleDataSource != nil {
+		return &localBundleDeployerKind{DataSource: d.bundleDataSource}, nil
+	}
 	if ds, localBundleDataErr := charm.LocalBundleDataSource(d.charmOrBundle); localBundleDataErr == nil {
 		// Set the deployer kind to localBundleDeployerKind
 		return &localBundleDeployerKind{DataSource: ds}, nil
@@ -359,6 +362,7 @@ func (d *factory) setConfig(cfg DeployerConfig) {
 	d.numUnits = cfg.NumUnits
 	d.attachStorage = cfg.AttachStorage
 	d.charmOrBundle = cfg.CharmOrBundle
+	d.bundleDataSource = cfg.BundleDataSource
 	d.defaultCharmSchema = cfg.DefaultCharmSchema
 	d.bundleOverlayFile = cfg.BundleOverlayFile
 	d.channel = cfg.Channel
@@ -408,6 +412,7 @@ type DeployerConfig struct {
 	BundleStorage        map[string]map[string]storage.Directive
 	Channel              charm.Channel
 	CharmOrBundle        string
+	BundleDataSource     charm.BundleDataSource
 	DefaultCharmSchema   charm.Schema
 	ConfigOptions        common.ConfigFlag
 	ConstraintsStr       string
@@ -445,6 +450,7 @@ type factory struct {
 	numUnits           int
 	attachStorage      []string
 	charmOrBundle      string
+	bundleDataSource   charm.BundleDataSource
 	bundleOverlayFile  []string
 	channel            charm.Channel
 	revision           int
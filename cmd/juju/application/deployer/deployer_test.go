@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/juju/errors"
@@ -245,6 +246,38 @@ func (s *deployerSuite) TestGetDeployerLocalBundle(c *tc.C) {
 	c.Assert(deployer.String(), tc.Equals, fmt.Sprintf("deploy local bundle from: %s", bundlePath))
 }
 
+func (s *deployerSuite) TestGetDeployerLocalBundleDataSource(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.basicDeployerConfig(corebase.MustParseBaseFromString("ubuntu@18.04"))
+	cfg.FlagSet = &gnuflag.FlagSet{}
+	s.expectModelType()
+
+	content := `
+      applications:
+          mysql:
+              charm: mysql
+              num_units: 2
+`
+	// The file at the path is not a bundle; the data source is used in its
+	// place.
+	modelPath := filepath.Join(c.MkDir(), "model.yaml")
+	err := os.WriteFile(modelPath, []byte("model: {}"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	ds, err := charm.StreamBundleDataSource(strings.NewReader(content), filepath.Dir(modelPath))
+	c.Assert(err, tc.ErrorIsNil)
+	s.expectStat(modelPath, nil)
+	cfg.CharmOrBundle = modelPath
+	cfg.BundleDataSource = ds
+
+	factory := s.newDeployerFactory()
+	deployer, err := factory.GetDeployer(c.Context(), cfg, s.charmDeployAPI, s.resolver)
+	c.Assert(err, tc.ErrorIsNil)
+	bundleDeployer, ok := deployer.(*localBundle)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(bundleDeployer.bundleDataSource, tc.Equals, ds)
+}
+
 func (s *deployerSuite) TestGetDeployerCharmHubBundleWithChannel(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -0,0 +1,254 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/yaml.v3"
+
+	"github.com/juju/juju/api/client/bundle"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// DesiredBundleAPI provides access to the desired bundle of a model.
+type DesiredBundleAPI interface {
+	Close() error
+	SetDesiredBundle(ctx context.Context, bundleDataYAML string) error
+	GetDesiredBundle(ctx context.Context) (params.DesiredBundleResult, error)
+	RemoveDesiredBundle(ctx context.Context) error
+}
+
+func newDesiredBundleAPIFunc(c *modelcmd.ModelCommandBase) func(context.Context) (DesiredBundleAPI, error) {
+	return func(ctx context.Context) (DesiredBundleAPI, error) {
+		root, err := c.NewAPIRoot(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return bundle.NewClient(root), nil
+	}
+}
+
+// NewSetDesiredBundleCommand returns a command which sets the bundle a model
+// is expected to match.
+func NewSetDesiredBundleCommand() modelcmd.ModelCommand {
+	c := &setDesiredBundleCommand{}
+	c.newAPIFunc = newDesiredBundleAPIFunc(&c.ModelCommandBase)
+	return modelcmd.Wrap(c)
+}
+
+type setDesiredBundleCommand struct {
+	modelcmd.ModelCommandBase
+
+	newAPIFunc func(context.Context) (DesiredBundleAPI, error)
+
+	bundleFile string
+	reset      bool
+}
+
+const setDesiredBundleDoc = `
+Set the bundle that the model is expected to match.
+
+The controller periodically compares the model with its desired bundle, in
+the same way as diff-bundle, and reports any differences: the model status
+shows that the model has drifted, the controller logs a warning, and
+show-desired-bundle shows the differences.
+
+The bundle is stored in the model, so it must be a local bundle file; the
+file may contain overlays as further YAML documents. Machines and placement
+directives are not compared, nor is the revision, channel or base of an
+application that does not specify them.
+
+Use --reset to remove the desired bundle and stop comparing the model with
+it.
+`
+
+const setDesiredBundleExamples = `
+    juju set-desired-bundle ./bundle.yaml
+    juju set-desired-bundle --reset
+`
+
+// Info implements cmd.Command.
+func (c *setDesiredBundleCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "set-desired-bundle",
+		Args:     "[<bundle file>]",
+		Purpose:  "Set the bundle that the model is expected to match.",
+		Doc:      setDesiredBundleDoc,
+		Examples: setDesiredBundleExamples,
+		SeeAlso: []string{
+			"show-desired-bundle",
+			"diff-bundle",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *setDesiredBundleCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.reset, "reset", false, "Remove the desired bundle of the model")
+}
+
+// Init implements cmd.Command.
+func (c *setDesiredBundleCommand) Init(args []string) error {
+	if c.reset {
+		if len(args) > 0 {
+			return errors.New("cannot specify a bundle file with --reset")
+		}
+		return nil
+	}
+	if len(args) == 0 {
+		return errors.New("no bundle file specified")
+	}
+	c.bundleFile = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *setDesiredBundleCommand) Run(ctx *cmd.Context) error {
+	var bundleYAML []byte
+	if !c.reset {
+		var err error
+		bundleYAML, err = os.ReadFile(ctx.AbsPath(c.bundleFile))
+		if err != nil {
+			return errors.Annotate(err, "reading bundle file")
+		}
+	}
+
+	client, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	if c.reset {
+		err := client.RemoveDesiredBundle(ctx)
+		if errors.Is(err, errors.NotFound) || params.IsCodeNotFound(err) {
+			ctx.Infof("Model has no desired bundle.")
+			return nil
+		} else if err != nil {
+			return block.ProcessBlockedError(errors.Annotate(err, "removing desired bundle"), block.BlockChange)
+		}
+		ctx.Infof("Removed desired bundle.")
+		return nil
+	}
+
+	if err := client.SetDesiredBundle(ctx, string(bundleYAML)); err != nil {
+		return block.ProcessBlockedError(errors.Annotate(err, "setting desired bundle"), block.BlockChange)
+	}
+	ctx.Infof("Set desired bundle from %s.", c.bundleFile)
+	return nil
+}
+
+// NewShowDesiredBundleCommand returns a command which shows the desired
+// bundle of a model and how the model differs from it.
+func NewShowDesiredBundleCommand() modelcmd.ModelCommand {
+	c := &showDesiredBundleCommand{}
+	c.newAPIFunc = newDesiredBundleAPIFunc(&c.ModelCommandBase)
+	return modelcmd.Wrap(c)
+}
+
+type showDesiredBundleCommand struct {
+	modelcmd.ModelCommandBase
+
+	newAPIFunc func(context.Context) (DesiredBundleAPI, error)
+	out        cmd.Output
+
+	showBundle bool
+}
+
+const showDesiredBundleDoc = `
+Show whether the model matches its desired bundle.
+
+The differences found by the last comparison of the model with its desired
+bundle are shown in the same form as diff-bundle. Use --bundle to show the
+desired bundle itself.
+`
+
+const showDesiredBundleExamples = `
+    juju show-desired-bundle
+    juju show-desired-bundle --bundle > bundle.yaml
+`
+
+// Info implements cmd.Command.
+func (c *showDesiredBundleCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "show-desired-bundle",
+		Purpose:  "Show how the model differs from its desired bundle.",
+		Doc:      showDesiredBundleDoc,
+		Examples: showDesiredBundleExamples,
+		SeeAlso: []string{
+			"set-desired-bundle",
+			"diff-bundle",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *showDesiredBundleCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.showBundle, "bundle", false, "Show the desired bundle instead of the differences")
+	c.out.AddFlags(f, "yaml", cmd.DefaultFormatters.Formatters())
+}
+
+// Init implements cmd.Command.
+func (c *showDesiredBundleCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// desiredBundleDrift is the output of show-desired-bundle.
+type desiredBundleDrift struct {
+	SetAt     time.Time  `yaml:"set-at" json:"set-at"`
+	CheckedAt *time.Time `yaml:"checked-at,omitempty" json:"checked-at,omitempty"`
+	Status    string     `yaml:"status" json:"status"`
+	Drift     any        `yaml:"drift,omitempty" json:"drift,omitempty"`
+}
+
+// Run implements cmd.Command.
+func (c *showDesiredBundleCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	result, err := client.GetDesiredBundle(ctx)
+	if errors.Is(err, errors.NotFound) || params.IsCodeNotFound(err) {
+		return errors.New("model has no desired bundle")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	if c.showBundle {
+		_, err := ctx.Stdout.Write([]byte(result.BundleDataYAML))
+		return errors.Trace(err)
+	}
+
+	out := desiredBundleDrift{
+		SetAt:     result.SetAt,
+		CheckedAt: result.CheckedAt,
+	}
+	switch {
+	case result.CheckedAt == nil:
+		out.Status = "not checked"
+	case result.Drift == "":
+		out.Status = "matches"
+	default:
+		out.Status = "drifted"
+		var drift map[string]any
+		if err := yaml.Unmarshal([]byte(result.Drift), &drift); err != nil {
+			return errors.Annotate(err, "reading bundle drift")
+		}
+		out.Drift = drift
+	}
+	return c.out.Write(ctx, out)
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type DesiredBundleSuite struct {
+	testhelpers.IsolationSuite
+
+	api *mockDesiredBundleAPI
+}
+
+func TestDesiredBundleSuite(t *testing.T) {
+	tc.Run(t, &DesiredBundleSuite{})
+}
+
+func (s *DesiredBundleSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.api = &mockDesiredBundleAPI{Stub: &testhelpers.Stub{}}
+}
+
+func (s *DesiredBundleSuite) runSet(c *tc.C, args ...string) (*cmd.Context, error) {
+	command := NewSetDesiredBundleCommandForTest(s.api, jujuclienttesting.MinimalStore())
+	return cmdtesting.RunCommand(c, command, args...)
+}
+
+func (s *DesiredBundleSuite) runShow(c *tc.C, args ...string) (*cmd.Context, error) {
+	command := NewShowDesiredBundleCommandForTest(s.api, jujuclienttesting.MinimalStore())
+	return cmdtesting.RunCommand(c, command, args...)
+}
+
+func (s *DesiredBundleSuite) TestSetInit(c *tc.C) {
+	_, err := s.runSet(c)
+	c.Check(err, tc.ErrorMatches, "no bundle file specified")
+
+	_, err = s.runSet(c, "--reset", "bundle.yaml")
+	c.Check(err, tc.ErrorMatches, "cannot specify a bundle file with --reset")
+
+	_, err = s.runSet(c, "a.yaml", "b.yaml")
+	c.Check(err, tc.ErrorMatches, `unrecognized args: \["b.yaml"\]`)
+}
+
+func (s *DesiredBundleSuite) TestSet(c *tc.C) {
+	path := filepath.Join(c.MkDir(), "bundle.yaml")
+	err := os.WriteFile(path, []byte("applications: {}\n"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	ctx, err := s.runSet(c, path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Set desired bundle from "+path+".\n")
+	s.api.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "SetDesiredBundle", Args: []any{"applications: {}\n"}},
+		{FuncName: "Close"},
+	})
+}
+
+func (s *DesiredBundleSuite) TestSetMissingFile(c *tc.C) {
+	_, err := s.runSet(c, filepath.Join(c.MkDir(), "missing.yaml"))
+	c.Assert(err, tc.ErrorMatches, "reading bundle file: .*no such file or directory")
+	s.api.CheckNoCalls(c)
+}
+
+func (s *DesiredBundleSuite) TestReset(c *tc.C) {
+	ctx, err := s.runSet(c, "--reset")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Removed desired bundle.\n")
+	s.api.CheckCallNames(c, "RemoveDesiredBundle", "Close")
+}
+
+func (s *DesiredBundleSuite) TestResetNoBundle(c *tc.C) {
+	s.api.SetErrors(&params.Error{Code: params.CodeNotFound, Message: "desired bundle not found"})
+
+	ctx, err := s.runSet(c, "--reset")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Model has no desired bundle.\n")
+}
+
+func (s *DesiredBundleSuite) TestShowDrifted(c *tc.C) {
+	setAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	checkedAt := setAt.Add(10 * time.Minute)
+	s.api.result = params.DesiredBundleResult{
+		BundleDataYAML: "applications: {}\n",
+		SetAt:          setAt,
+		CheckedAt:      &checkedAt,
+		Drift: `
+applications:
+  mysql:
+    options:
+      flavour:
+        bundle: percona
+        model: mariadb
+`[1:],
+	}
+
+	ctx, err := s.runShow(c)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+set-at: 2026-10-18T09:00:00Z
+checked-at: 2026-10-18T09:10:00Z
+status: drifted
+drift:
+  applications:
+    mysql:
+      options:
+        flavour:
+          bundle: percona
+          model: mariadb
+`[1:])
+}
+
+func (s *DesiredBundleSuite) TestShowMatchesJSON(c *tc.C) {
+	setAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	s.api.result = params.DesiredBundleResult{
+		BundleDataYAML: "applications: {}\n",
+		SetAt:          setAt,
+		CheckedAt:      &setAt,
+	}
+
+	ctx, err := s.runShow(c, "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals,
+		`{"set-at":"2026-10-18T09:00:00Z","checked-at":"2026-10-18T09:00:00Z","status":"matches"}`+"\n")
+}
+
+func (s *DesiredBundleSuite) TestShowBundle(c *tc.C) {
+	s.api.result = params.DesiredBundleResult{
+		BundleDataYAML: "applications: {}\n",
+	}
+
+	ctx, err := s.runShow(c, "--bundle")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "applications: {}\n")
+}
+
+func (s *DesiredBundleSuite) TestShowNoBundle(c *tc.C) {
+	s.api.SetErrors(errors.NotFoundf("desired bundle"))
+
+	_, err := s.runShow(c)
+	c.Assert(err, tc.ErrorMatches, "model has no desired bundle")
+}
+
+type mockDesiredBundleAPI struct {
+	*testhelpers.Stub
+
+	result params.DesiredBundleResult
+}
+
+func (m *mockDesiredBundleAPI) Close() error {
+	m.MethodCall(m, "Close")
+	return nil
+}
+
+func (m *mockDesiredBundleAPI) SetDesiredBundle(ctx context.Context, bundleDataYAML string) error {
+	m.MethodCall(m, "SetDesiredBundle", bundleDataYAML)
+	return m.NextErr()
+}
+
+func (m *mockDesiredBundleAPI) GetDesiredBundle(ctx context.Context) (params.DesiredBundleResult, error) {
+	m.MethodCall(m, "GetDesiredBundle")
+	return m.result, m.NextErr()
+}
+
+func (m *mockDesiredBundleAPI) RemoveDesiredBundle(ctx context.Context) error {
+	m.MethodCall(m, "RemoveDesiredBundle")
+	return m.NextErr()
+}
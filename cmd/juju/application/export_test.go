@@ -206,3 +206,50 @@ func NewConfigCommandForTest(api ApplicationAPI, store jujuclient.ClientStore) m
 	c.SetClientStore(store)
 	return c
 }
+
+func NewApplyCommandForTest(
+	store jujuclient.ClientStore,
+	apiRoot base.APICallCloser,
+	modelConfigAPI ApplyModelConfigAPI,
+	storageAPI ApplyStorageAPI,
+	spacesAPI ApplySpacesAPI,
+	newDeployCommand func(charm.BundleDataSource) modelcmd.ModelCommand,
+) cmd.Command {
+	cmd := &applyCommand{
+		newAPIRootFn: func(context.Context) (base.APICallCloser, error) {
+			return apiRoot, nil
+		},
+		newModelConfigAPI: func(base.APICallCloser) ApplyModelConfigAPI {
+			return modelConfigAPI
+		},
+		newStorageAPI: func(base.APICallCloser) ApplyStorageAPI {
+			return storageAPI
+		},
+		newSpacesAPI: func(base.APICallCloser) ApplySpacesAPI {
+			return spacesAPI
+		},
+		newDeployCommand: newDeployCommand,
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+// NewSetDesiredBundleCommandForTest returns a set-desired-bundle command
+// using the api provided.
+func NewSetDesiredBundleCommandForTest(api DesiredBundleAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &setDesiredBundleCommand{newAPIFunc: func(ctx context.Context) (DesiredBundleAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+// NewShowDesiredBundleCommandForTest returns a show-desired-bundle command
+// using the api provided.
+func NewShowDesiredBundleCommandForTest(api DesiredBundleAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &showDesiredBundleCommand{newAPIFunc: func(ctx context.Context) (DesiredBundleAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/cmd/juju/application (interfaces: ApplicationEgressAPI)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package mocks -destination mocks/egress_mock.go github.com/juju/juju/cmd/juju/application ApplicationEgressAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	firewall "github.com/juju/juju/core/network/firewall"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApplicationEgressAPI is a mock of ApplicationEgressAPI interface.
+type MockApplicationEgressAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationEgressAPIMockRecorder
+}
+
+// MockApplicationEgressAPIMockRecorder is the mock recorder for MockApplicationEgressAPI.
+type MockApplicationEgressAPIMockRecorder struct {
+	mock *MockApplicationEgressAPI
+}
+
+// NewMockApplicationEgressAPI creates a new mock instance.
+func NewMockApplicationEgressAPI(ctrl *gomock.Controller) *MockApplicationEgressAPI {
+	mock := &MockApplicationEgressAPI{ctrl: ctrl}
+	mock.recorder = &MockApplicationEgressAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationEgressAPI) EXPECT() *MockApplicationEgressAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockApplicationEgressAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockApplicationEgressAPIMockRecorder) Close() *MockApplicationEgressAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockApplicationEgressAPI)(nil).Close))
+	return &MockApplicationEgressAPICloseCall{Call: call}
+}
+
+// MockApplicationEgressAPICloseCall wrap *gomock.Call
+type MockApplicationEgressAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationEgressAPICloseCall) Return(arg0 error) *MockApplicationEgressAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationEgressAPICloseCall) Do(f func() error) *MockApplicationEgressAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationEgressAPICloseCall) DoAndReturn(f func() error) *MockApplicationEgressAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetEgressRules mocks base method.
+func (m *MockApplicationEgressAPI) GetEgressRules(arg0 context.Context, arg1 string) (firewall.EgressRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEgressRules", arg0, arg1)
+	ret0, _ := ret[0].(firewall.EgressRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEgressRules indicates an expected call of GetEgressRules.
+func (mr *MockApplicationEgressAPIMockRecorder) GetEgressRules(arg0, arg1 any) *MockApplicationEgressAPIGetEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressRules", reflect.TypeOf((*MockApplicationEgressAPI)(nil).GetEgressRules), arg0, arg1)
+	return &MockApplicationEgressAPIGetEgressRulesCall{Call: call}
+}
+
+// MockApplicationEgressAPIGetEgressRulesCall wrap *gomock.Call
+type MockApplicationEgressAPIGetEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationEgressAPIGetEgressRulesCall) Return(arg0 firewall.EgressRules, arg1 error) *MockApplicationEgressAPIGetEgressRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationEgressAPIGetEgressRulesCall) Do(f func(context.Context, string) (firewall.EgressRules, error)) *MockApplicationEgressAPIGetEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationEgressAPIGetEgressRulesCall) DoAndReturn(f func(context.Context, string) (firewall.EgressRules, error)) *MockApplicationEgressAPIGetEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetEgressRules mocks base method.
+func (m *MockApplicationEgressAPI) SetEgressRules(arg0 context.Context, arg1 string, arg2 firewall.EgressRules) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEgressRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEgressRules indicates an expected call of SetEgressRules.
+func (mr *MockApplicationEgressAPIMockRecorder) SetEgressRules(arg0, arg1, arg2 any) *MockApplicationEgressAPISetEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEgressRules", reflect.TypeOf((*MockApplicationEgressAPI)(nil).SetEgressRules), arg0, arg1, arg2)
+	return &MockApplicationEgressAPISetEgressRulesCall{Call: call}
+}
+
+// MockApplicationEgressAPISetEgressRulesCall wrap *gomock.Call
+type MockApplicationEgressAPISetEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationEgressAPISetEgressRulesCall) Return(arg0 error) *MockApplicationEgressAPISetEgressRulesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationEgressAPISetEgressRulesCall) Do(f func(context.Context, string, firewall.EgressRules) error) *MockApplicationEgressAPISetEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationEgressAPISetEgressRulesCall) DoAndReturn(f func(context.Context, string, firewall.EgressRules) error) *MockApplicationEgressAPISetEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -7,3 +7,4 @@ package application_test
 //go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/modelconfigapi_mock.go github.com/juju/juju/cmd/juju/application ModelConfigClient
 //go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/deployer_mock.go github.com/juju/juju/cmd/juju/application/deployer Deployer,DeployerFactory
 //go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/expose_mock.go github.com/juju/juju/cmd/juju/application ApplicationExposeAPI
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/egress_mock.go github.com/juju/juju/cmd/juju/application ApplicationEgressAPI
@@ -0,0 +1,214 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/application"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/core/output"
+)
+
+var usageSetEgressSummary = `
+Sets or shows the outbound traffic allowed for an application.`[1:]
+
+var usageSetEgressDetails = `
+Restricts the destinations that the machines hosting an application's units
+are allowed to connect to. Each ` + "`--allow`" + ` option adds a rule of the form
+` + "`<cidr>:<port>[-<port>][/<protocol>]`" + `; if no protocol is given, ` + "`tcp`" + ` is
+assumed. The set of rules supplied replaces any rules previously set for the
+application.
+
+Once an application has egress rules, all other outbound traffic from the
+machines hosting its units is denied. Connections to the controller are
+always allowed. To deny outbound traffic for every application that has no
+egress rules, set the ` + "`egress-default-deny`" + ` model configuration key.
+
+The ` + "`--reset`" + ` option removes all the egress rules for the application.
+
+If no options are specified, the current egress rules for the application
+are displayed.
+
+Egress rules are enforced by the cloud's firewall, using security groups on
+AWS with ` + "`firewall-mode=instance`" + `. On clouds that cannot restrict
+outbound traffic, setting egress rules is refused.
+`[1:]
+
+const setEgressExamples = `
+To allow mysql to connect to PostgreSQL servers on the 10.0.0.0/8 network:
+
+    juju set-egress mysql --allow 10.0.0.0/8:5432
+
+To allow DNS lookups and HTTPS connections to a proxy:
+
+    juju set-egress mysql --allow 10.0.0.2/32:53/udp --allow 10.1.1.1/32:443
+
+To remove all egress rules for mysql:
+
+    juju set-egress mysql --reset
+
+To show the egress rules for mysql:
+
+    juju set-egress mysql
+`
+
+// NewSetEgressCommand returns a command to set the egress rules of an
+// application.
+func NewSetEgressCommand() modelcmd.ModelCommand {
+	return modelcmd.Wrap(&setEgressCommand{})
+}
+
+// setEgressCommand is responsible for setting and showing the egress rules
+// of an application.
+type setEgressCommand struct {
+	modelcmd.ModelCommandBase
+	modelcmd.IAASOnlyCommand
+	out cmd.Output
+
+	api ApplicationEgressAPI
+
+	applicationName string
+	allow           []string
+	reset           bool
+
+	rules firewall.EgressRules
+}
+
+// Info implements cmd.Command.
+func (c *setEgressCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "set-egress",
+		Args:     "<application name>",
+		Purpose:  usageSetEgressSummary,
+		Doc:      usageSetEgressDetails,
+		Examples: setEgressExamples,
+		SeeAlso: []string{
+			"expose",
+			"model-config",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *setEgressCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.Var(cmd.NewAppendStringsValue(&c.allow), "allow", "Allow outbound traffic matching <cidr>:<ports>; may be repeated")
+	f.BoolVar(&c.reset, "reset", false, "Remove all egress rules for the application")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatEgressRulesTabular,
+	})
+}
+
+// Init implements cmd.Command.
+func (c *setEgressCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no application name specified")
+	}
+	// Allow the application to be given as "application=<name>".
+	appName := strings.TrimPrefix(args[0], "application=")
+	if !names.IsValidApplication(appName) {
+		return errors.Errorf("invalid application name %q", appName)
+	}
+	c.applicationName = appName
+
+	if c.reset && len(c.allow) > 0 {
+		return errors.New("cannot specify both --allow and --reset")
+	}
+	for _, in := range c.allow {
+		rule, err := firewall.ParseEgressRule(in)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.rules = append(c.rules, rule)
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// ApplicationEgressAPI is used to set and get the egress rules of an
+// application.
+type ApplicationEgressAPI interface {
+	Close() error
+	GetEgressRules(ctx context.Context, applicationName string) (firewall.EgressRules, error)
+	SetEgressRules(ctx context.Context, applicationName string, rules firewall.EgressRules) error
+}
+
+func (c *setEgressCommand) getAPI(ctx context.Context) (ApplicationEgressAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return application.NewClient(root), nil
+}
+
+// Run implements cmd.Command.
+func (c *setEgressCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	switch {
+	case c.reset:
+		err := client.SetEgressRules(ctx, c.applicationName, firewall.EgressRules{})
+		return block.ProcessBlockedError(err, block.BlockChange)
+	case len(c.rules) > 0:
+		err := client.SetEgressRules(ctx, c.applicationName, c.rules)
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+
+	rules, err := client.GetEgressRules(ctx, c.applicationName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(rules) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No egress rules set for application %q.", c.applicationName)
+		return nil
+	}
+
+	result := make([]egressRule, len(rules))
+	for i, rule := range rules {
+		result[i] = egressRule{
+			Ports:        rule.PortRange.String(),
+			Destinations: rule.DestinationCIDRs.SortedValues(),
+		}
+	}
+	return c.out.Write(ctx, result)
+}
+
+type egressRule struct {
+	Ports        string   `yaml:"ports" json:"ports"`
+	Destinations []string `yaml:"destinations" json:"destinations"`
+}
+
+func formatEgressRulesTabular(writer io.Writer, value any) error {
+	rules, ok := value.([]egressRule)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", rules, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Ports", "Destinations")
+	for _, rule := range rules {
+		w.Println(rule.Ports, strings.Join(rule.Destinations, ","))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/application/mocks"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/internal/testing"
+)
+
+type SetEgressSuite struct {
+	testing.BaseSuite
+}
+
+func TestSetEgressSuite(t *stdtesting.T) {
+	tc.Run(t, &SetEgressSuite{})
+}
+
+func runSetEgress(c *tc.C, api ApplicationEgressAPI, args ...string) (*cmd.Context, error) {
+	setEgressCmd := &setEgressCommand{api: api}
+	setEgressCmd.SetClientStore(jujuclienttesting.MinimalStore())
+
+	return cmdtesting.RunCommand(c, modelcmd.WrapBase(setEgressCmd), args...)
+}
+
+func (s *SetEgressSuite) TestInit(c *tc.C) {
+	specs := []struct {
+		args []string
+		err  string
+	}{
+		{args: nil, err: "no application name specified"},
+		{args: []string{"mysql", "extra"}, err: `unrecognized args: \["extra"\]`},
+		{args: []string{"application=!!"}, err: `invalid application name "!!"`},
+		{args: []string{"mysql", "--allow", "10.0.0.0/8"}, err: `invalid egress rule "10.0.0.0/8": expected <cidr>:<ports>`},
+		{args: []string{"mysql", "--allow", "10.0.0.0/8:5432", "--reset"}, err: "cannot specify both --allow and --reset"},
+	}
+	for i, spec := range specs {
+		c.Logf("test %d: %v", i, spec.args)
+		_, err := runSetEgress(c, nil, spec.args...)
+		c.Check(err, tc.ErrorMatches, spec.err)
+	}
+}
+
+func (s *SetEgressSuite) TestSetEgress(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	api := mocks.NewMockApplicationEgressAPI(ctrl)
+	api.EXPECT().SetEgressRules(gomock.Any(), "mysql", firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+		firewall.NewEgressRule(network.MustParsePortRange("53/udp"), "10.0.0.2/32"),
+	}).Return(nil)
+	api.EXPECT().Close().Return(nil)
+
+	_, err := runSetEgress(c, api, "application=mysql", "--allow", "10.0.0.0/8:5432", "--allow", "10.0.0.2/32:53/udp")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *SetEgressSuite) TestResetEgress(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	api := mocks.NewMockApplicationEgressAPI(ctrl)
+	api.EXPECT().SetEgressRules(gomock.Any(), "mysql", firewall.EgressRules{}).Return(nil)
+	api.EXPECT().Close().Return(nil)
+
+	_, err := runSetEgress(c, api, "mysql", "--reset")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *SetEgressSuite) TestShowEgress(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	api := mocks.NewMockApplicationEgressAPI(ctrl)
+	api.EXPECT().GetEgressRules(gomock.Any(), "mysql").Return(firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8", "192.168.0.0/16"),
+	}, nil)
+	api.EXPECT().Close().Return(nil)
+
+	ctx, err := runSetEgress(c, api, "mysql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Ports     Destinations
+5432/tcp  10.0.0.0/8,192.168.0.0/16
+`[1:])
+}
+
+func (s *SetEgressSuite) TestShowEgressNoRules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	api := mocks.NewMockApplicationEgressAPI(ctrl)
+	api.EXPECT().GetEgressRules(gomock.Any(), "mysql").Return(firewall.EgressRules{}, nil)
+	api.EXPECT().Close().Return(nil)
+
+	ctx, err := runSetEgress(c, api, "mysql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No egress rules set for application \"mysql\".\n")
+}
@@ -28,6 +28,9 @@ type APIClient interface {
 	Create(nctx context.Context, otes string, noDownload bool) (*params.BackupsMetadataResult, error)
 	// Download pulls the backup archive file.
 	Download(ctx context.Context, filename string) (io.ReadCloser, error)
+	// List returns the status of the scheduled backups kept by the
+	// controller.
+	List(ctx context.Context) ([]params.BackupsIndexEntry, error)
 }
 
 // CommandBase is the base type for backups sub-commands.
@@ -29,4 +29,8 @@
 // In terms of the restore process, please see "[juju-restore tool]".
 // [juju-restore tool]: https://github.com/juju/juju-restore
 
+// Controllers with the backup-schedule controller config key set take
+// backups on a schedule and keep them in the controller object store. The
+// backups command lists their status.
+
 package backups
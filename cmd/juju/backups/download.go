@@ -19,12 +19,16 @@ import (
 const downloadDoc = `
 Retrieves a backup archive file.
 
+Scheduled backups kept by the controller are downloaded using the filename
+shown by the backups command.
+
 If ` + "`--filename`" + ` is not used, the archive is downloaded to a temporary
 location and the filename is printed to stdout.
 `
 
 const examples = `
     juju download-backup /full/path/to/backup/on/controller
+    juju download-backup juju-backup-20260102-030405.tar.gz
 `
 
 // NewDownloadCommand returns a commant used to download backups.
@@ -26,6 +26,10 @@ type DownloadCommand struct {
 	*downloadCommand
 }
 
+type ListCommand struct {
+	*listCommand
+}
+
 func NewCreateCommandForTest(store jujuclient.ClientStore) (cmd.Command, *CreateCommand) {
 	c := &createCommand{}
 	c.SetClientStore(store)
@@ -37,3 +41,9 @@ func NewDownloadCommandForTest(store jujuclient.ClientStore) (cmd.Command, *Down
 	c.SetClientStore(store)
 	return modelcmd.Wrap(c), &DownloadCommand{c}
 }
+
+func NewListCommandForTest(store jujuclient.ClientStore) (cmd.Command, *ListCommand) {
+	c := &listCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c), &ListCommand{c}
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/rpc/params"
+)
+
+const listDoc = `
+Lists the scheduled backups kept by the controller, oldest first.
+
+Scheduled backups are taken by the controller when the backup-schedule
+controller config key is set, and the number kept is limited by the
+backup-retention key. Each attempt is listed with its status; failed
+attempts include the reason they failed.
+`
+
+const listExamples = `
+    juju backups
+    juju backups --format yaml
+`
+
+// NewListCommand returns a command used to list scheduled backups.
+func NewListCommand() cmd.Command {
+	return modelcmd.Wrap(&listCommand{})
+}
+
+// listCommand is the sub-command for listing scheduled backups.
+type listCommand struct {
+	CommandBase
+	out cmd.Output
+}
+
+// Info implements Command.Info.
+func (c *listCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "backups",
+		Purpose:  "Lists the scheduled backups kept by the controller.",
+		Doc:      listDoc,
+		Examples: listExamples,
+		Aliases:  []string{"list-backups"},
+		SeeAlso: []string{
+			"create-backup",
+			"download-backup",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.CommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatBackupsTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *listCommand) Init(args []string) error {
+	if err := c.CommandBase.Init(args); err != nil {
+		return err
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements Command.Run.
+func (c *listCommand) Run(ctx *cmd.Context) error {
+	if err := c.validateIaasController(ctx, c.Info().Name); err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.NewAPIClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	result, err := client.List(ctx)
+	if errors.Is(err, errors.NotSupported) {
+		return errors.New("listing backups is not supported by this controller")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	if len(result) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No scheduled backups to display.")
+		return nil
+	}
+	return c.out.Write(ctx, formatBackups(result))
+}
+
+// BackupInfo defines the serialization behaviour of a scheduled backup.
+type BackupInfo struct {
+	Filename string    `yaml:"filename" json:"filename"`
+	Status   string    `yaml:"status" json:"status"`
+	Started  time.Time `yaml:"started" json:"started"`
+	Finished time.Time `yaml:"finished" json:"finished"`
+	Size     int64     `yaml:"size,omitempty" json:"size,omitempty"`
+	Checksum string    `yaml:"checksum,omitempty" json:"checksum,omitempty"`
+	Message  string    `yaml:"message,omitempty" json:"message,omitempty"`
+}
+
+func formatBackups(all []params.BackupsIndexEntry) []BackupInfo {
+	out := make([]BackupInfo, len(all))
+	for i, entry := range all {
+		out[i] = BackupInfo{
+			Filename: entry.Filename,
+			Status:   entry.Status,
+			Started:  entry.Started,
+			Finished: entry.Finished,
+			Size:     entry.Size,
+			Checksum: entry.Checksum,
+			Message:  entry.Message,
+		}
+	}
+	return out
+}
+
+func formatBackupsTabular(writer io.Writer, value any) error {
+	backups, ok := value.([]BackupInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", backups, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Filename", "Status", "Started", "Size (B)", "Message")
+	for _, info := range backups {
+		w.Println(
+			info.Filename,
+			info.Status,
+			info.Started.UTC().Format(time.RFC3339),
+			info.Size,
+			info.Message,
+		)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/backups"
+	"github.com/juju/juju/rpc/params"
+)
+
+type listSuite struct {
+	BaseBackupsSuite
+	wrappedCommand cmd.Command
+	command        *backups.ListCommand
+}
+
+func TestListSuite(t *testing.T) {
+	tc.Run(t, &listSuite{})
+}
+
+func (s *listSuite) SetUpTest(c *tc.C) {
+	s.BaseBackupsSuite.SetUpTest(c)
+	s.wrappedCommand, s.command = backups.NewListCommandForTest(s.store)
+}
+
+func (s *listSuite) setList() *fakeAPIClient {
+	started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := s.setSuccess()
+	client.list = []params.BackupsIndexEntry{{
+		Filename: "juju-backup-20260101-000000.tar.gz",
+		Started:  started,
+		Finished: started.Add(time.Minute),
+		Size:     42,
+		Checksum: "checksum",
+		Status:   "succeeded",
+	}, {
+		Filename: "juju-backup-20260102-000000.tar.gz",
+		Started:  started.Add(24 * time.Hour),
+		Finished: started.Add(24*time.Hour + time.Minute),
+		Status:   "failed",
+		Message:  "creating backup: boom",
+	}}
+	return client
+}
+
+func (s *listSuite) TestTabular(c *tc.C) {
+	client := s.setList()
+	ctx, err := cmdtesting.RunCommand(c, s.wrappedCommand)
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.CheckCalls(c, "List")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Filename                            Status     Started               Size (B)  Message
+juju-backup-20260101-000000.tar.gz  succeeded  2026-01-01T00:00:00Z  42        
+juju-backup-20260102-000000.tar.gz  failed     2026-01-02T00:00:00Z  0         creating backup: boom
+`[1:])
+}
+
+func (s *listSuite) TestYAML(c *tc.C) {
+	s.setList()
+	ctx, err := cmdtesting.RunCommand(c, s.wrappedCommand, "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+- filename: juju-backup-20260101-000000.tar.gz
+  status: succeeded
+  started: 2026-01-01T00:00:00Z
+  finished: 2026-01-01T00:01:00Z
+  size: 42
+  checksum: checksum
+- filename: juju-backup-20260102-000000.tar.gz
+  status: failed
+  started: 2026-01-02T00:00:00Z
+  finished: 2026-01-02T00:01:00Z
+  message: 'creating backup: boom'
+`[1:])
+}
+
+func (s *listSuite) TestEmpty(c *tc.C) {
+	s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, s.wrappedCommand)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No scheduled backups to display.\n")
+}
+
+func (s *listSuite) TestTooManyArgs(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.wrappedCommand, "extra")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+func (s *listSuite) TestNotSupported(c *tc.C) {
+	client := s.setFailure("boom")
+	client.err = errors.NotSupportedf("listing backups")
+	_, err := cmdtesting.RunCommand(c, s.wrappedCommand)
+	c.Assert(err, tc.ErrorMatches, "listing backups is not supported by this controller")
+}
+
+func (s *listSuite) TestError(c *tc.C) {
+	s.setFailure("failed!")
+	_, err := cmdtesting.RunCommand(c, s.wrappedCommand)
+	c.Assert(err, tc.ErrorMatches, "failed!")
+}
@@ -139,6 +139,7 @@ func (s *BaseBackupsSuite) checkArchive(c *tc.C) {
 // Replace this fakeAPIClient with MockAPIClient for all tests.
 type fakeAPIClient struct {
 	metaresult *params.BackupsMetadataResult
+	list       []params.BackupsIndexEntry
 	archive    io.ReadCloser
 	err        error
 
@@ -183,6 +184,14 @@ func (c *fakeAPIClient) Download(_ context.Context, id string) (io.ReadCloser, e
 	return c.archive, nil
 }
 
+func (c *fakeAPIClient) List(_ context.Context) ([]params.BackupsIndexEntry, error) {
+	c.calls = append(c.calls, "List")
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.list, nil
+}
+
 func (c *fakeAPIClient) Close() error {
 	return nil
 }
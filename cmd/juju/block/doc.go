@@ -46,6 +46,7 @@ Commands that can be disabled are grouped based on logical operations as follows
     change-user-password
     config
     consume
+    create-storage-snapshot
     deploy
     destroy-controller
     destroy-model
@@ -63,6 +64,7 @@ Commands that can be disabled are grouped based on logical operations as follows
     remove-ssh-key
     remove-unit
     remove-user
+    resize-storage
     resolved
     retry-provisioning
     run
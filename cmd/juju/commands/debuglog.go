@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -65,6 +66,10 @@ with the prefix will match.
 
 The ` + "`--include-labels`" + ` and ` + "`--exclude-labels`" + ` options filter by logging labels.
 
+The ` + "`--grep`" + ` and ` + "`--exclude-grep`" + ` options filter by matching a regular
+expression against the log message. This filtering is done by the controller,
+so only matching messages are sent to the client.
+
 The filtering options combine as follows:
 * All ` + "`--include`" + ` options are logically ORed together.
 * All ` + "`--exclude`" + ` options are logically ORed together.
@@ -72,9 +77,11 @@ The filtering options combine as follows:
 * All ` + "`--exclude-module`" + ` options are logically ORed together.
 * All ` + "`--include-labels`" + ` options are logically ORed together.
 * All ` + "`--exclude-labels`" + ` options are logically ORed together.
+* All ` + "`--grep`" + ` options are logically ORed together.
+* All ` + "`--exclude-grep`" + ` options are logically ORed together.
 * The combined ` + "`--include`" + `, ` + "`--exclude`" + `, ` + "`--include-module`" + `, ` + "`--exclude-module`" + `,
-  ` + "`--include-labels`" + ` and ` + "`--exclude-labels`" + ` selections are logically ANDed to form
-  the complete filter.
+  ` + "`--include-labels`" + `, ` + "`--exclude-labels`" + `, ` + "`--grep`" + ` and ` + "`--exclude-grep`" + `
+  selections are logically ANDed to form the complete filter.
 
 The ` + "`--tail`" + ` option waits for and continuously prints new log lines after displaying the most recent log lines.
 
@@ -109,6 +116,14 @@ Begin with the last 500 lines, using grep as a text filter:
 
     juju debug-log -n 500 | grep amd64
 
+Begin with the last 500 lines, filtering the messages on the controller:
+
+    juju debug-log -n 500 --grep amd64
+
+Follow a single request through the whole log, ignoring ping messages:
+
+    juju debug-log --replay --grep 'request-id=[0-9a-f]+' --exclude-grep ping
+
 Begin with the last 30 log messages:
 
     juju debug-log -n 30
@@ -246,6 +261,8 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeModule), "exclude-module", "Do not show log messages for these logging modules")
 	f.Var(cmd.NewAppendStringsValue(&c.includeLabels), "include-labels", "Only show log messages for these logging label key values")
 	f.Var(cmd.NewAppendStringsValue(&c.excludeLabels), "exclude-labels", "Do not show log messages for these logging label key values")
+	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeMessage), "grep", "Only show log messages matching these regular expressions")
+	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeMessage), "exclude-grep", "Do not show log messages matching these regular expressions")
 
 	f.StringVar(&c.level, "l", "", "Log level to show, one of [TRACE, DEBUG, INFO, WARNING, ERROR]")
 	f.StringVar(&c.level, "level", "", "")
@@ -352,6 +369,16 @@ func (c *debugLogCommand) Init(args []string) error {
 		}
 		c.params.ExcludeLabels[parts[0]] = parts[1]
 	}
+	for _, expr := range c.params.IncludeMessage {
+		if _, err := regexp.Compile(expr); err != nil {
+			return errors.NewNotValid(err, fmt.Sprintf("--grep pattern %q", expr))
+		}
+	}
+	for _, expr := range c.params.ExcludeMessage {
+		if _, err := regexp.Compile(expr); err != nil {
+			return errors.NewNotValid(err, fmt.Sprintf("--exclude-grep pattern %q", expr))
+		}
+	}
 
 	return cmd.CheckEmpty(args)
 }
@@ -106,6 +106,24 @@ func (s *DebugLogSuite) TestArgParsing(c *tc.C) {
 				ExcludeLabels: map[string]string{"logger-tags": "http,apiserver"},
 				Backlog:       10,
 			},
+		}, {
+			args: []string{"--grep", "request-id=[0-9a-f]+", "--grep", "abc"},
+			expected: common.DebugLogParams{
+				IncludeMessage: []string{"request-id=[0-9a-f]+", "abc"},
+				Backlog:        10,
+			},
+		}, {
+			args: []string{"--exclude-grep", "ping", "--exclude-grep", "pong"},
+			expected: common.DebugLogParams{
+				ExcludeMessage: []string{"ping", "pong"},
+				Backlog:        10,
+			},
+		}, {
+			args:     []string{"--grep", "("},
+			errMatch: `--grep pattern "\(": error parsing regexp: .*`,
+		}, {
+			args:     []string{"--exclude-grep", "[a-"},
+			errMatch: `--exclude-grep pattern "\[a-": error parsing regexp: .*`,
 		}, {
 			args: []string{"--replay"},
 			expected: common.DebugLogParams{
@@ -209,14 +227,18 @@ func (s *DebugLogSuite) TestParamsPassed(c *tc.C) {
 		"--include-module=juju.provisioner",
 		"--lines=500",
 		"--level=WARNING",
+		"--grep=request-id",
+		"--exclude-grep=ping",
 	)
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(fake.params, tc.DeepEquals, common.DebugLogParams{
-		IncludeEntity: []string{"machine-1*"},
-		IncludeModule: []string{"juju.provisioner"},
-		ExcludeEntity: []string{"machine-1-lxd-1"},
-		Backlog:       500,
-		Level:         loggo.WARNING,
+		IncludeEntity:  []string{"machine-1*"},
+		IncludeModule:  []string{"juju.provisioner"},
+		ExcludeEntity:  []string{"machine-1-lxd-1"},
+		IncludeMessage: []string{"request-id"},
+		ExcludeMessage: []string{"ping"},
+		Backlog:        500,
+		Level:          loggo.WARNING,
 	})
 }
 
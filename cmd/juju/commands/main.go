@@ -42,6 +42,7 @@ import (
 	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/juju/subnet"
 	"github.com/juju/juju/cmd/juju/user"
+	"github.com/juju/juju/cmd/juju/waitfor"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/internal/featureflag"
 	internallogger "github.com/juju/juju/internal/logger"
@@ -364,6 +365,8 @@ func registerCommands(r commandRegistry) {
 	r.Register(status.NewStatusCommand())
 	r.Register(newSwitchCommand())
 	r.Register(status.NewStatusHistoryCommand())
+	r.Register(status.NewExportStatusHistoryCommand())
+	r.Register(waitfor.NewWaitForCommand())
 
 	// Error resolution and debugging commands.
 	r.Register(action.NewExecCommand(nil))
@@ -390,6 +393,7 @@ func registerCommands(r commandRegistry) {
 	// Manage backups.
 	r.Register(backups.NewCreateCommand())
 	r.Register(backups.NewDownloadCommand())
+	r.Register(backups.NewListCommand())
 
 	// Manage authorized ssh keys.
 	r.Register(sshkeys.NewAddKeysCommand())
@@ -448,9 +452,13 @@ func registerCommands(r commandRegistry) {
 	r.Register(application.NewDeployCommand())
 	r.Register(application.NewExposeCommand())
 	r.Register(application.NewUnexposeCommand())
+	r.Register(application.NewSetEgressCommand())
 	r.Register(application.NewApplicationGetConstraintsCommand())
 	r.Register(application.NewApplicationSetConstraintsCommand())
 	r.Register(application.NewDiffBundleCommand())
+	r.Register(application.NewApplyCommand())
+	r.Register(application.NewSetDesiredBundleCommand())
+	r.Register(application.NewShowDesiredBundleCommand())
 	r.Register(application.NewShowApplicationCommand())
 	r.Register(application.NewShowUnitCommand())
 
@@ -470,6 +478,8 @@ func registerCommands(r commandRegistry) {
 	r.Register(storage.NewRemoveStorageCommandWithAPI())
 	r.Register(storage.NewDetachStorageCommandWithAPI())
 	r.Register(storage.NewAttachStorageCommandWithAPI())
+	r.Register(storage.NewResizeStorageCommandWithAPI())
+	r.Register(storage.NewCreateStorageSnapshotCommandWithAPI())
 	r.Register(storage.NewImportFilesystemCommand(storage.NewStorageImporter, nil))
 
 	// Manage spaces
@@ -480,6 +490,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(space.NewShowSpaceCommand())
 	r.Register(space.NewRemoveCommand())
 	r.Register(space.NewRenameCommand())
+	r.Register(space.NewSetAddressPreferenceCommand())
 
 	// Manage subnets
 	r.Register(subnet.NewListCommand())
@@ -545,6 +556,9 @@ func registerCommands(r commandRegistry) {
 	r.Register(secrets.NewRemoveSecretCommand())
 	r.Register(secrets.NewGrantSecretCommand())
 	r.Register(secrets.NewRevokeSecretCommand())
+	r.Register(secrets.NewSecretAccessLogCommand())
+	r.Register(secrets.NewMigrateSecretsCommand())
+	r.Register(secrets.NewRollbackSecretCommand())
 
 	// Secret backends.
 	r.Register(secretbackends.NewListSecretBackendsCommand())
@@ -553,6 +567,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(secretbackends.NewRemoveSecretBackendCommand())
 	r.Register(secretbackends.NewShowSecretBackendCommand())
 	r.Register(secretbackends.NewModelSecretBackendCommand())
+	r.Register(secretbackends.NewRotateSecretEncryptionKeyCommand())
 }
 
 type cloudToCommandAdaptor struct{}
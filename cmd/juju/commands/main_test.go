@@ -212,9 +212,11 @@ var commandNames = []string{
 	"add-storage",
 	"add-unit",
 	"add-user",
+	"apply",
 	"attach-resource",
 	"attach-storage",
 	"autoload-credentials",
+	"backups",
 	"bind",
 	"bootstrap",
 	"cancel-task",
@@ -228,6 +230,7 @@ var commandNames = []string{
 	"controllers",
 	"create-backup",
 	"create-storage-pool",
+	"create-storage-snapshot",
 	"credentials",
 	"dashboard",
 	"debug-code",
@@ -252,6 +255,7 @@ var commandNames = []string{
 	"enable-user",
 	"exec",
 	"export-bundle",
+	"export-status-history",
 	"expose",
 	"find-offers",
 	"find",
@@ -268,6 +272,7 @@ var commandNames = []string{
 	"integrate",
 	"kill-controller",
 	"list-actions",
+	"list-backups",
 	"list-charm-resources",
 	"list-clouds",
 	"list-controllers",
@@ -292,6 +297,7 @@ var commandNames = []string{
 	"logout",
 	"machines",
 	"migrate",
+	"migrate-secrets",
 	"model-config",
 	"model-constraints",
 	"model-default",
@@ -324,6 +330,7 @@ var commandNames = []string{
 	"remove-unit",
 	"remove-user",
 	"rename-space",
+	"resize-storage",
 	"resolve",
 	"resolved",
 	"resources",
@@ -332,23 +339,30 @@ var commandNames = []string{
 	"revoke-cloud",
 	"revoke-secret",
 	"revoke",
+	"rollback-secret",
+	"rotate-secret-encryption-key",
 	"run",
 	"scale-application",
 	"scp",
+	"secret-access-log",
 	"secret-backends",
 	"secrets",
 	"set-constraints",
 	"set-credential",
 	"set-default-credentials",
 	"set-default-region",
+	"set-desired-bundle",
+	"set-egress",
 	"set-firewall-rule",
 	"set-model-constraints",
+	"set-space-address-preference",
 	"show-action",
 	"show-application",
 	"show-cloud",
 	"show-controller",
 	"show-credential",
 	"show-credentials",
+	"show-desired-bundle",
 	"show-machine",
 	"show-model",
 	"show-offer",
@@ -386,6 +400,7 @@ var commandNames = []string{
 	"upgrade-model",
 	"users",
 	"version",
+	"wait-for",
 	"whoami",
 }
 
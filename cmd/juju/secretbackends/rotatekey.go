@@ -0,0 +1,99 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretbackends
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/client/secretbackends"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+type rotateSecretEncryptionKeyCommand struct {
+	modelcmd.ControllerCommandBase
+
+	RotateSecretEncryptionKeyAPIFunc func(ctx context.Context) (RotateSecretEncryptionKeyAPI, error)
+}
+
+var rotateSecretEncryptionKeyDoc = `
+Rewraps the data key encrypting the secret content of each model with the
+active master key from the ` + "`secret-encryption-master-key`" + ` controller
+config source. Secret content is not re-encrypted, so the rotation does not
+require downtime.
+
+To rotate the master key:
+
+1. Add the new key on every controller machine. For a ` + "`file:`" + ` source,
+   add the new key as the first line of the key file and keep the old key
+   below it. For a ` + "`keystore:`" + ` source, add the new key and write its
+   label to the ` + "`active`" + ` file, keeping the old key.
+2. Run this command.
+3. Remove the old key from every controller machine.
+`
+
+const rotateSecretEncryptionKeyExamples = `
+    juju rotate-secret-encryption-key
+`
+
+// RotateSecretEncryptionKeyAPI is the secret backends client API.
+type RotateSecretEncryptionKeyAPI interface {
+	RotateSecretEncryptionKey(context.Context) (int, error)
+	Close() error
+}
+
+// NewRotateSecretEncryptionKeyCommand returns a command to rotate the
+// master key encrypting secret content.
+func NewRotateSecretEncryptionKeyCommand() cmd.Command {
+	c := &rotateSecretEncryptionKeyCommand{}
+	c.RotateSecretEncryptionKeyAPIFunc = c.secretBackendsAPI
+
+	return modelcmd.WrapController(c)
+}
+
+func (c *rotateSecretEncryptionKeyCommand) secretBackendsAPI(ctx context.Context) (RotateSecretEncryptionKeyAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return secretbackends.NewClient(root), nil
+}
+
+// Info implements cmd.Info.
+func (c *rotateSecretEncryptionKeyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "rotate-secret-encryption-key",
+		Purpose:  "Rewraps secret data keys with the active secret encryption master key.",
+		Doc:      rotateSecretEncryptionKeyDoc,
+		Examples: rotateSecretEncryptionKeyExamples,
+		SeeAlso: []string{
+			"controller-config",
+			"secret-backends",
+		},
+	})
+}
+
+// Init implements cmd.Init.
+func (c *rotateSecretEncryptionKeyCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Run.
+func (c *rotateSecretEncryptionKeyCommand) Run(ctxt *cmd.Context) error {
+	api, err := c.RotateSecretEncryptionKeyAPIFunc(ctxt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	rewrapped, err := api.RotateSecretEncryptionKey(ctxt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ctxt.Infof("Rewrapped %d secret data key(s) with the active master key.", rewrapped)
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretbackends_test
+
+import (
+	"testing"
+
+	jujuerrors "github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/secretbackends"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type RotateSecretEncryptionKeySuite struct {
+	testhelpers.IsolationSuite
+	store *jujuclient.MemStore
+	api   *secretbackends.MockRotateSecretEncryptionKeyAPI
+}
+
+func TestRotateSecretEncryptionKeySuite(t *testing.T) {
+	tc.Run(t, &RotateSecretEncryptionKeySuite{})
+}
+
+func (s *RotateSecretEncryptionKeySuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *RotateSecretEncryptionKeySuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.api = secretbackends.NewMockRotateSecretEncryptionKeyAPI(ctrl)
+
+	return ctrl
+}
+
+func (s *RotateSecretEncryptionKeySuite) TestInitError(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, secretbackends.NewRotateSecretEncryptionKeyCommandForTest(s.store, s.api), "extra")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+func (s *RotateSecretEncryptionKeySuite) TestRotate(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.api.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return(2, nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secretbackends.NewRotateSecretEncryptionKeyCommandForTest(s.store, s.api))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Rewrapped 2 secret data key(s) with the active master key.\n")
+}
+
+func (s *RotateSecretEncryptionKeySuite) TestRotateError(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.api.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return(0, jujuerrors.NotSupportedf("secret encryption"))
+	s.api.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secretbackends.NewRotateSecretEncryptionKeyCommandForTest(s.store, s.api))
+	c.Assert(err, tc.ErrorMatches, "secret encryption not supported")
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	coresecrets "github.com/juju/juju/core/secrets"
+)
+
+type secretAccessLogCommand struct {
+	modelcmd.ModelCommandBase
+	out cmd.Output
+
+	secretsAPIFunc func(ctx context.Context) (SecretAccessLogAPI, error)
+
+	uri   *coresecrets.URI
+	unit  string
+	since string
+	until string
+	limit int
+
+	sinceTime *time.Time
+	untilTime *time.Time
+}
+
+var secretAccessLogDoc = `
+Displays the recorded reads of secret revisions in the model, most recent
+first. A read is recorded each time a unit or the model gets the content of
+a secret revision, when a user reveals the content with show-secret, and
+when a machine reads the encryption key of its storage. The log is bounded,
+so the oldest reads are eventually discarded.
+
+Reads by units are shown by unit name, and reads by users and machines by
+their tag, such as "user-admin" or "machine-0".
+
+The log can be narrowed to a secret, to the unit which read the secret, and
+to a time window. Times are given either as a duration before now, such as
+"24h", or as an RFC3339 timestamp or a date and optional time in the local
+time zone, such as "2026-10-01" or "2026-10-01 09:30".
+
+Only model admins can view the secret access log.
+`
+
+const secretAccessLogExamples = `
+    juju secret-access-log
+    juju secret-access-log 9m4e2mr0ui3e8a215n4g
+    juju secret-access-log --unit mysql/0 --since 168h
+    juju secret-access-log secret:9m4e2mr0ui3e8a215n4g --since 2026-10-01 --until 2026-10-08
+    juju secret-access-log --limit 20 --format yaml
+`
+
+// SecretAccessLogAPI is the secrets client API.
+type SecretAccessLogAPI interface {
+	SecretAccessLog(ctx context.Context, filter apisecrets.SecretAccessLogFilter) ([]apisecrets.SecretAccessLogEntry, error)
+	Close() error
+}
+
+// NewSecretAccessLogCommand returns a command to show the secret access log.
+func NewSecretAccessLogCommand() cmd.Command {
+	c := &secretAccessLogCommand{}
+	c.secretsAPIFunc = c.secretsAPI
+
+	return modelcmd.Wrap(c)
+}
+
+func (c *secretAccessLogCommand) secretsAPI(ctx context.Context) (SecretAccessLogAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apisecrets.NewClient(root), nil
+}
+
+// Info implements cmd.Info.
+func (c *secretAccessLogCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "secret-access-log",
+		Args:     "[<ID>]",
+		Purpose:  "Shows who read which secret revisions and when.",
+		Doc:      secretAccessLogDoc,
+		Examples: secretAccessLogExamples,
+		SeeAlso: []string{
+			"secrets",
+			"show-secret",
+		},
+	})
+}
+
+// SetFlags implements cmd.SetFlags.
+func (c *secretAccessLogCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.unit, "unit", "", "Only show reads by the specified unit")
+	f.StringVar(&c.since, "since", "", "Only show reads at or after this time")
+	f.StringVar(&c.until, "until", "", "Only show reads before this time")
+	f.IntVar(&c.limit, "limit", 0, "Show at most this many reads (defaults to all)")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSecretAccessLogTabular,
+	})
+}
+
+// Init implements cmd.Init.
+func (c *secretAccessLogCommand) Init(args []string) error {
+	if len(args) > 0 {
+		uri, err := coresecrets.ParseURI(args[0])
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.uri = uri
+		args = args[1:]
+	}
+	if c.unit != "" && !names.IsValidUnit(c.unit) {
+		return errors.NotValidf("unit name %q", c.unit)
+	}
+	if c.limit < 0 {
+		return errors.New("limit must be a positive integer")
+	}
+	now := time.Now()
+	if c.since != "" {
+		t, err := parseAccessTime(c.since, now)
+		if err != nil {
+			return errors.Annotate(err, "invalid --since")
+		}
+		c.sinceTime = &t
+	}
+	if c.until != "" {
+		t, err := parseAccessTime(c.until, now)
+		if err != nil {
+			return errors.Annotate(err, "invalid --until")
+		}
+		c.untilTime = &t
+	}
+	if c.sinceTime != nil && c.untilTime != nil && !c.sinceTime.Before(*c.untilTime) {
+		return errors.New("--since must be before --until")
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// accessTimeLayouts are the layouts accepted by parseAccessTime, in addition
+// to RFC3339 and durations. They are interpreted in the local time zone.
+var accessTimeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseAccessTime parses a duration before now, an RFC3339 timestamp, or a
+// date and optional time in the local time zone.
+func parseAccessTime(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range accessTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.NotValidf("time %q", value)
+}
+
+type secretAccessDetails struct {
+	ID         string    `json:"id" yaml:"id"`
+	Revision   int       `json:"revision" yaml:"revision"`
+	Accessor   string    `json:"accessor" yaml:"accessor"`
+	AccessedAt time.Time `json:"accessed" yaml:"accessed"`
+}
+
+// Run implements cmd.Run.
+func (c *secretAccessLogCommand) Run(ctxt *cmd.Context) error {
+	api, err := c.secretsAPIFunc(ctxt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	filter := apisecrets.SecretAccessLogFilter{
+		URI:   c.uri,
+		Since: c.sinceTime,
+		Until: c.untilTime,
+		Limit: c.limit,
+	}
+	if c.unit != "" {
+		filter.Accessor = names.NewUnitTag(c.unit)
+	}
+	entries, err := api.SecretAccessLog(ctxt, filter)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	details := make([]secretAccessDetails, len(entries))
+	for i, e := range entries {
+		accessor := e.Accessor.Id()
+		switch e.Accessor.Kind() {
+		case names.ModelTagKind:
+			accessor = "<" + e.Accessor.Kind() + ">"
+		case names.UserTagKind, names.MachineTagKind:
+			accessor = e.Accessor.String()
+		}
+		details[i] = secretAccessDetails{
+			ID:         e.URI.ID,
+			Revision:   e.Revision,
+			Accessor:   accessor,
+			AccessedAt: e.AccessedAt,
+		}
+	}
+	return c.out.Write(ctxt, details)
+}
+
+// formatSecretAccessLogTabular writes a tabular summary of secret reads.
+func formatSecretAccessLogTabular(writer io.Writer, value any) error {
+	entries, ok := value.([]secretAccessDetails)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", entries, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{tw}
+	w.SetColumnAlignRight(2)
+
+	w.Println("Time", "ID", "Revision", "Accessor")
+	for _, e := range entries {
+		w.Println(e.AccessedAt.Local().Format(time.RFC3339), e.ID, e.Revision, e.Accessor)
+	}
+	return tw.Flush()
+}
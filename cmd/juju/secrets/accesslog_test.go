@@ -0,0 +1,128 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/secrets"
+	"github.com/juju/juju/cmd/juju/secrets/mocks"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type accessLogSuite struct {
+	testhelpers.IsolationSuite
+	store      *jujuclient.MemStore
+	secretsAPI *mocks.MockSecretAccessLogAPI
+}
+
+func TestAccessLogSuite(t *testing.T) {
+	tc.Run(t, &accessLogSuite{})
+}
+
+func (s *accessLogSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *accessLogSuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.secretsAPI = mocks.NewMockSecretAccessLogAPI(ctrl)
+	return ctrl
+}
+
+func (s *accessLogSuite) TestInit(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI), "not a secret")
+	c.Assert(err, tc.ErrorMatches, `secret URI "not a secret" not valid`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI), "--unit", "mysql")
+	c.Assert(err, tc.ErrorMatches, `unit name "mysql" not valid`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI), "--limit", "-1")
+	c.Assert(err, tc.ErrorMatches, "limit must be a positive integer")
+	_, err = cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI), "--since", "yesterday")
+	c.Assert(err, tc.ErrorMatches, `invalid --since: time "yesterday" not valid`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI),
+		"--since", "2026-10-08", "--until", "2026-10-01")
+	c.Assert(err, tc.ErrorMatches, "--since must be before --until")
+}
+
+func (s *accessLogSuite) TestAccessLogTabular(c *tc.C) {
+	defer s.setup(c).Finish()
+	s.PatchValue(&time.Local, time.UTC)
+
+	uri := coresecrets.NewURI()
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	accessedAt := time.Date(2026, 10, 2, 9, 30, 0, 0, time.UTC)
+	s.secretsAPI.EXPECT().SecretAccessLog(gomock.Any(), apisecrets.SecretAccessLogFilter{
+		URI:      uri,
+		Accessor: names.NewUnitTag("mysql/0"),
+		Since:    &since,
+		Limit:    10,
+	}).Return([]apisecrets.SecretAccessLogEntry{{
+		URI:        uri,
+		Revision:   2,
+		Accessor:   names.NewUnitTag("mysql/0"),
+		AccessedAt: accessedAt,
+	}, {
+		URI:        uri,
+		Revision:   1,
+		Accessor:   coretesting.ModelTag,
+		AccessedAt: accessedAt.Add(-time.Hour),
+	}, {
+		URI:        uri,
+		Revision:   1,
+		Accessor:   names.NewUserTag("admin"),
+		AccessedAt: accessedAt.Add(-2 * time.Hour),
+	}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI),
+		uri.String(), "--unit", "mysql/0", "--since", "2026-10-01", "--limit", "10")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, fmt.Sprintf(`
+Time                  ID                    Revision  Accessor
+2026-10-02T09:30:00Z  %s         2  mysql/0
+2026-10-02T08:30:00Z  %s         1  <model>
+2026-10-02T07:30:00Z  %s         1  user-admin
+`[1:], uri.ID, uri.ID, uri.ID))
+}
+
+func (s *accessLogSuite) TestAccessLogYAML(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	accessedAt := time.Date(2026, 10, 2, 9, 30, 0, 0, time.UTC)
+	s.secretsAPI.EXPECT().SecretAccessLog(gomock.Any(), apisecrets.SecretAccessLogFilter{}).Return(
+		[]apisecrets.SecretAccessLogEntry{{
+			URI:        uri,
+			Revision:   2,
+			Accessor:   names.NewUnitTag("mysql/0"),
+			AccessedAt: accessedAt,
+		}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI), "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, fmt.Sprintf(`
+- id: %s
+  revision: 2
+  accessor: mysql/0
+  accessed: 2026-10-02T09:30:00Z
+`[1:], uri.ID))
+}
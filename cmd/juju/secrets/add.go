@@ -8,11 +8,13 @@ import (
 	"fmt"
 
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 
 	apisecrets "github.com/juju/juju/api/client/secrets"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
+	coresecrets "github.com/juju/juju/core/secrets"
 )
 
 type addSecretCommand struct {
@@ -20,12 +22,15 @@ type addSecretCommand struct {
 
 	SecretUpsertContentCommand
 	name           string
+	sourceStr      string
+	source         *coresecrets.ExternalSource
 	secretsAPIFunc func(context.Context) (AddSecretsAPI, error)
 }
 
 // AddSecretsAPI is the secrets client API.
 type AddSecretsAPI interface {
 	CreateSecret(ctx context.Context, name, description string, data map[string]string) (string, error)
+	CreateExternalSecret(ctx context.Context, name, description string, source coresecrets.ExternalSource) (string, error)
 	Close() error
 }
 
@@ -54,6 +59,15 @@ prior to being stored.
 
 If a key has the ` + "`#file` " + `suffix, the value is read from the corresponding file.
 
+Instead of key values, the secret content can be synced from a path in the
+secret backend the model uses, using --source with a reference of the form
+<backend>:<path>[#<key>]. The path must be under one of the paths listed in
+the backend's external-paths config. With a key, only that key is synced;
+otherwise every key at the path is synced. The controller checks the path
+periodically and adds a new secret revision whenever the content there
+changes, notifying units which track the secret. The content of such a
+secret cannot be updated with juju update-secret.
+
 A secret is owned by the model, meaning only the model admin
 can manage it, ie grant/revoke access, update, remove etc.
 `
@@ -67,6 +81,7 @@ can manage it, ie grant/revoke access, update, remove etc.
     juju add-secret db-password \
         --info "my database password" \
         --file=/path/to/file
+    juju add-secret db-password --source myvault:kv/prod/db#password
 `
 )
 
@@ -74,13 +89,19 @@ can manage it, ie grant/revoke access, update, remove etc.
 func (c *addSecretCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "add-secret",
-		Args:     "<name> [key[#base64|#file]=value...]",
+		Args:     "<name> [key[#base64|#file]=value...|--source <backend>:<path>[#<key>]]",
 		Purpose:  "Add a new secret.",
 		Doc:      addSecretDoc,
 		Examples: addSecretExamples,
 	})
 }
 
+// SetFlags implements cmd.Command.
+func (c *addSecretCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.SecretUpsertContentCommand.SetFlags(f)
+	f.StringVar(&c.sourceStr, "source", "", "A secret backend path to sync the secret content from")
+}
+
 // Init implements cmd.Command.
 func (c *addSecretCommand) Init(args []string) error {
 	if len(args) < 1 {
@@ -88,6 +109,17 @@ func (c *addSecretCommand) Init(args []string) error {
 	}
 	c.name = args[0]
 	args = args[1:]
+	if c.sourceStr != "" {
+		if len(args) > 0 || c.FileName != "" {
+			return errors.New("secret content cannot be specified with --source")
+		}
+		source, err := coresecrets.ParseExternalSource(c.sourceStr)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.source = &source
+		return nil
+	}
 	if err := c.SecretUpsertContentCommand.Init(args); err != nil {
 		return err
 	}
@@ -105,7 +137,12 @@ func (c *addSecretCommand) Run(ctx *cmd.Context) error {
 	}
 	defer secretsAPI.Close()
 
-	uri, err := secretsAPI.CreateSecret(ctx, c.name, c.Description, c.Data)
+	var uri string
+	if c.source != nil {
+		uri, err = secretsAPI.CreateExternalSecret(ctx, c.name, c.Description, *c.source)
+	} else {
+		uri, err = secretsAPI.CreateSecret(ctx, c.name, c.Description, c.Data)
+	}
 	if err != nil {
 		return err
 	}
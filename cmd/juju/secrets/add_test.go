@@ -83,3 +83,34 @@ func (s *addSuite) TestAddEmptyData(c *tc.C) {
 	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "--info", "this is a secret.")
 	c.Assert(err, tc.ErrorMatches, `missing secret value or filename`)
 }
+
+func (s *addSuite) TestAddFromSource(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().CreateExternalSecret(gomock.Any(), "db-password", "", coresecrets.ExternalSource{
+		Backend: "myvault",
+		Path:    "kv/prod/db",
+		Key:     "password",
+	}).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "db-password", "--source", "myvault:kv/prod/db#password")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, uri.String()+"\n")
+}
+
+func (s *addSuite) TestAddFromSourceWithData(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "db-password", "foo=bar", "--source", "myvault:kv/prod/db")
+	c.Assert(err, tc.ErrorMatches, `secret content cannot be specified with --source`)
+}
+
+func (s *addSuite) TestAddFromInvalidSource(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "db-password", "--source", "kv/prod/db")
+	c.Assert(err, tc.ErrorMatches, `external source "kv/prod/db" must be of the form .*`)
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"context"
+	"io"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	coresecrets "github.com/juju/juju/core/secrets"
+)
+
+type migrateSecretsCommand struct {
+	modelcmd.ModelCommandBase
+	out cmd.Output
+
+	secretsAPIFunc func(ctx context.Context) (MigrateSecretsAPI, error)
+
+	fromBackend string
+	toBackend   string
+	secretID    string
+	dryRun      bool
+
+	uri *coresecrets.URI
+}
+
+var migrateSecretsDoc = `
+Moves the content of secret revisions in the model from one secret backend
+to another. Use the name "internal" for the controller database.
+
+The content of each revision held in the source backend is copied to the
+target backend and read back. Only once the checksum of the copy matches the
+checksum of the original does the revision refer to the target backend, and
+the content is then removed from the source backend. A revision which cannot
+be migrated continues to refer to the source backend, and the outcome of
+each revision is reported.
+
+With --dry-run, the content of each revision is read from the source backend
+to report what would be migrated, but nothing is changed.
+
+Only model admins can migrate secrets.
+`
+
+const migrateSecretsExamples = `
+    juju migrate-secrets --from internal --to myvault --dry-run
+    juju migrate-secrets --from internal --to myvault
+    juju migrate-secrets --from myvault --to internal --secret 9m4e2mr0ui3e8a215n4g
+`
+
+// MigrateSecretsAPI is the secrets client API.
+type MigrateSecretsAPI interface {
+	MigrateSecrets(ctx context.Context, args apisecrets.MigrateSecretsArgs) ([]apisecrets.SecretRevisionMigration, error)
+	Close() error
+}
+
+// NewMigrateSecretsCommand returns a command to move secrets between
+// secret backends.
+func NewMigrateSecretsCommand() cmd.Command {
+	c := &migrateSecretsCommand{}
+	c.secretsAPIFunc = c.secretsAPI
+
+	return modelcmd.Wrap(c)
+}
+
+func (c *migrateSecretsCommand) secretsAPI(ctx context.Context) (MigrateSecretsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apisecrets.NewClient(root), nil
+}
+
+// Info implements cmd.Info.
+func (c *migrateSecretsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "migrate-secrets",
+		Purpose:  "Moves secret content from one secret backend to another.",
+		Doc:      migrateSecretsDoc,
+		Examples: migrateSecretsExamples,
+		SeeAlso: []string{
+			"secrets",
+			"secret-backends",
+			"model-secret-backend",
+		},
+	})
+}
+
+// SetFlags implements cmd.SetFlags.
+func (c *migrateSecretsCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.fromBackend, "from", "", "The secret backend to move content from")
+	f.StringVar(&c.toBackend, "to", "", "The secret backend to move content to")
+	f.StringVar(&c.secretID, "secret", "", "Only migrate the revisions of the specified secret")
+	f.BoolVar(&c.dryRun, "dry-run", false, "Report what would be migrated without changing anything")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSecretMigrationTabular,
+	})
+}
+
+// Init implements cmd.Init.
+func (c *migrateSecretsCommand) Init(args []string) error {
+	if c.fromBackend == "" {
+		return errors.New("--from is required")
+	}
+	if c.toBackend == "" {
+		return errors.New("--to is required")
+	}
+	if c.fromBackend == c.toBackend {
+		return errors.New("--from and --to must be different secret backends")
+	}
+	if c.secretID != "" {
+		uri, err := coresecrets.ParseURI(c.secretID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.uri = uri
+	}
+	return cmd.CheckEmpty(args)
+}
+
+type secretMigrationDetails struct {
+	ID       string `json:"id" yaml:"id"`
+	Revision int    `json:"revision" yaml:"revision"`
+	Status   string `json:"status" yaml:"status"`
+	Checksum string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Error    string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Run implements cmd.Run.
+func (c *migrateSecretsCommand) Run(ctxt *cmd.Context) error {
+	api, err := c.secretsAPIFunc(ctxt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	migrations, err := api.MigrateSecrets(ctxt, apisecrets.MigrateSecretsArgs{
+		FromBackend: c.fromBackend,
+		ToBackend:   c.toBackend,
+		URI:         c.uri,
+		DryRun:      c.dryRun,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(migrations) == 0 {
+		ctxt.Infof("No secret revisions in %q to migrate.", c.fromBackend)
+		return nil
+	}
+
+	failed := 0
+	details := make([]secretMigrationDetails, len(migrations))
+	for i, m := range migrations {
+		details[i] = secretMigrationDetails{
+			ID:       m.URI.ID,
+			Revision: m.Revision,
+			Status:   m.Status,
+			Checksum: m.Checksum,
+		}
+		if m.Error != nil {
+			details[i].Error = m.Error.Error()
+			failed++
+		}
+	}
+	if err := c.out.Write(ctxt, details); err != nil {
+		return errors.Trace(err)
+	}
+	if failed > 0 {
+		return errors.Errorf("%d of %d secret revisions could not be migrated", failed, len(migrations))
+	}
+	return nil
+}
+
+// formatSecretMigrationTabular writes a tabular summary of secret revision
+// migrations.
+func formatSecretMigrationTabular(writer io.Writer, value any) error {
+	migrations, ok := value.([]secretMigrationDetails)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", migrations, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{tw}
+	w.SetColumnAlignRight(1)
+
+	w.Println("ID", "Revision", "Status", "Checksum", "Message")
+	for _, m := range migrations {
+		w.Println(m.ID, m.Revision, m.Status, m.Checksum, m.Error)
+	}
+	return tw.Flush()
+}
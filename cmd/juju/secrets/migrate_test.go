@@ -0,0 +1,141 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/secrets"
+	"github.com/juju/juju/cmd/juju/secrets/mocks"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type migrateSuite struct {
+	testhelpers.IsolationSuite
+	store      *jujuclient.MemStore
+	secretsAPI *mocks.MockMigrateSecretsAPI
+}
+
+func TestMigrateSuite(t *testing.T) {
+	tc.Run(t, &migrateSuite{})
+}
+
+func (s *migrateSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *migrateSuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.secretsAPI = mocks.NewMockMigrateSecretsAPI(ctrl)
+	return ctrl
+}
+
+func (s *migrateSuite) TestInit(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, secrets.NewMigrateSecretsCommandForTest(s.store, s.secretsAPI), "--to", "myvault")
+	c.Assert(err, tc.ErrorMatches, "--from is required")
+	_, err = cmdtesting.RunCommand(c, secrets.NewMigrateSecretsCommandForTest(s.store, s.secretsAPI), "--from", "internal")
+	c.Assert(err, tc.ErrorMatches, "--to is required")
+	_, err = cmdtesting.RunCommand(c, secrets.NewMigrateSecretsCommandForTest(s.store, s.secretsAPI),
+		"--from", "myvault", "--to", "myvault")
+	c.Assert(err, tc.ErrorMatches, "--from and --to must be different secret backends")
+	_, err = cmdtesting.RunCommand(c, secrets.NewMigrateSecretsCommandForTest(s.store, s.secretsAPI),
+		"--from", "internal", "--to", "myvault", "--secret", "not a secret")
+	c.Assert(err, tc.ErrorMatches, `secret URI "not a secret" not valid`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewMigrateSecretsCommandForTest(s.store, s.secretsAPI),
+		"--from", "internal", "--to", "myvault", "extra")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+func (s *migrateSuite) TestMigrateDryRun(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().MigrateSecrets(gomock.Any(), apisecrets.MigrateSecretsArgs{
+		FromBackend: "internal",
+		ToBackend:   "myvault",
+		URI:         uri,
+		DryRun:      true,
+	}).Return([]apisecrets.SecretRevisionMigration{{
+		URI:      uri,
+		Revision: 1,
+		Status:   "planned",
+		Checksum: "checksum-1",
+	}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewMigrateSecretsCommandForTest(s.store, s.secretsAPI),
+		"--from", "internal", "--to", "myvault", "--secret", uri.String(), "--dry-run")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, fmt.Sprintf(`
+ID                    Revision  Status   Checksum    Message
+%s         1  planned  checksum-1  
+`[1:], uri.ID))
+}
+
+func (s *migrateSuite) TestMigrateNothing(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.secretsAPI.EXPECT().MigrateSecrets(gomock.Any(), apisecrets.MigrateSecretsArgs{
+		FromBackend: "internal",
+		ToBackend:   "myvault",
+	}).Return(nil, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewMigrateSecretsCommandForTest(s.store, s.secretsAPI),
+		"--from", "internal", "--to", "myvault")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, "No secret revisions in \"internal\" to migrate.\n")
+}
+
+func (s *migrateSuite) TestMigrateFailed(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().MigrateSecrets(gomock.Any(), apisecrets.MigrateSecretsArgs{
+		FromBackend: "internal",
+		ToBackend:   "myvault",
+	}).Return([]apisecrets.SecretRevisionMigration{{
+		URI:      uri,
+		Revision: 1,
+		Status:   "migrated",
+		Checksum: "checksum-1",
+	}, {
+		URI:      uri,
+		Revision: 2,
+		Status:   "failed",
+		Checksum: "checksum-2",
+		Error:    errors.New("boom"),
+	}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewMigrateSecretsCommandForTest(s.store, s.secretsAPI),
+		"--from", "internal", "--to", "myvault", "--format", "yaml")
+	c.Assert(err, tc.ErrorMatches, "1 of 2 secret revisions could not be migrated")
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, fmt.Sprintf(`
+- id: %s
+  revision: 1
+  status: migrated
+  checksum: checksum-1
+- id: %s
+  revision: 2
+  status: failed
+  checksum: checksum-2
+  error: boom
+`[1:], uri.ID, uri.ID))
+}
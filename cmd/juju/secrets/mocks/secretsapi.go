@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI)
+// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,ShowSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,SecretAccessLogAPI,MigrateSecretsAPI,RollbackSecretsAPI)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,ShowSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,SecretAccessLogAPI,MigrateSecretsAPI,RollbackSecretsAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -118,6 +118,145 @@ func (c *MockListSecretsAPIListSecretsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// MockShowSecretsAPI is a mock of ShowSecretsAPI interface.
+type MockShowSecretsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockShowSecretsAPIMockRecorder
+}
+
+// MockShowSecretsAPIMockRecorder is the mock recorder for MockShowSecretsAPI.
+type MockShowSecretsAPIMockRecorder struct {
+	mock *MockShowSecretsAPI
+}
+
+// NewMockShowSecretsAPI creates a new mock instance.
+func NewMockShowSecretsAPI(ctrl *gomock.Controller) *MockShowSecretsAPI {
+	mock := &MockShowSecretsAPI{ctrl: ctrl}
+	mock.recorder = &MockShowSecretsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShowSecretsAPI) EXPECT() *MockShowSecretsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockShowSecretsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockShowSecretsAPIMockRecorder) Close() *MockShowSecretsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockShowSecretsAPI)(nil).Close))
+	return &MockShowSecretsAPICloseCall{Call: call}
+}
+
+// MockShowSecretsAPICloseCall wrap *gomock.Call
+type MockShowSecretsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShowSecretsAPICloseCall) Return(arg0 error) *MockShowSecretsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShowSecretsAPICloseCall) Do(f func() error) *MockShowSecretsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShowSecretsAPICloseCall) DoAndReturn(f func() error) *MockShowSecretsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSecrets mocks base method.
+func (m *MockShowSecretsAPI) ListSecrets(arg0 context.Context, arg1 bool, arg2 secrets0.Filter) ([]secrets.SecretDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secrets.SecretDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockShowSecretsAPIMockRecorder) ListSecrets(arg0, arg1, arg2 any) *MockShowSecretsAPIListSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockShowSecretsAPI)(nil).ListSecrets), arg0, arg1, arg2)
+	return &MockShowSecretsAPIListSecretsCall{Call: call}
+}
+
+// MockShowSecretsAPIListSecretsCall wrap *gomock.Call
+type MockShowSecretsAPIListSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShowSecretsAPIListSecretsCall) Return(arg0 []secrets.SecretDetails, arg1 error) *MockShowSecretsAPIListSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShowSecretsAPIListSecretsCall) Do(f func(context.Context, bool, secrets0.Filter) ([]secrets.SecretDetails, error)) *MockShowSecretsAPIListSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShowSecretsAPIListSecretsCall) DoAndReturn(f func(context.Context, bool, secrets0.Filter) ([]secrets.SecretDetails, error)) *MockShowSecretsAPIListSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SecretRevisionDiff mocks base method.
+func (m *MockShowSecretsAPI) SecretRevisionDiff(arg0 context.Context, arg1 *secrets0.URI, arg2 string, arg3, arg4 int, arg5 bool) (secrets.SecretRevisionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretRevisionDiff", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(secrets.SecretRevisionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretRevisionDiff indicates an expected call of SecretRevisionDiff.
+func (mr *MockShowSecretsAPIMockRecorder) SecretRevisionDiff(arg0, arg1, arg2, arg3, arg4, arg5 any) *MockShowSecretsAPISecretRevisionDiffCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretRevisionDiff", reflect.TypeOf((*MockShowSecretsAPI)(nil).SecretRevisionDiff), arg0, arg1, arg2, arg3, arg4, arg5)
+	return &MockShowSecretsAPISecretRevisionDiffCall{Call: call}
+}
+
+// MockShowSecretsAPISecretRevisionDiffCall wrap *gomock.Call
+type MockShowSecretsAPISecretRevisionDiffCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShowSecretsAPISecretRevisionDiffCall) Return(arg0 secrets.SecretRevisionDiff, arg1 error) *MockShowSecretsAPISecretRevisionDiffCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShowSecretsAPISecretRevisionDiffCall) Do(f func(context.Context, *secrets0.URI, string, int, int, bool) (secrets.SecretRevisionDiff, error)) *MockShowSecretsAPISecretRevisionDiffCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShowSecretsAPISecretRevisionDiffCall) DoAndReturn(f func(context.Context, *secrets0.URI, string, int, int, bool) (secrets.SecretRevisionDiff, error)) *MockShowSecretsAPISecretRevisionDiffCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockAddSecretsAPI is a mock of AddSecretsAPI interface.
 type MockAddSecretsAPI struct {
 	ctrl     *gomock.Controller
@@ -179,6 +318,45 @@ func (c *MockAddSecretsAPICloseCall) DoAndReturn(f func() error) *MockAddSecrets
 	return c
 }
 
+// CreateExternalSecret mocks base method.
+func (m *MockAddSecretsAPI) CreateExternalSecret(arg0 context.Context, arg1, arg2 string, arg3 secrets0.ExternalSource) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalSecret", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalSecret indicates an expected call of CreateExternalSecret.
+func (mr *MockAddSecretsAPIMockRecorder) CreateExternalSecret(arg0, arg1, arg2, arg3 any) *MockAddSecretsAPICreateExternalSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalSecret", reflect.TypeOf((*MockAddSecretsAPI)(nil).CreateExternalSecret), arg0, arg1, arg2, arg3)
+	return &MockAddSecretsAPICreateExternalSecretCall{Call: call}
+}
+
+// MockAddSecretsAPICreateExternalSecretCall wrap *gomock.Call
+type MockAddSecretsAPICreateExternalSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAddSecretsAPICreateExternalSecretCall) Return(arg0 string, arg1 error) *MockAddSecretsAPICreateExternalSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAddSecretsAPICreateExternalSecretCall) Do(f func(context.Context, string, string, secrets0.ExternalSource) (string, error)) *MockAddSecretsAPICreateExternalSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAddSecretsAPICreateExternalSecretCall) DoAndReturn(f func(context.Context, string, string, secrets0.ExternalSource) (string, error)) *MockAddSecretsAPICreateExternalSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateSecret mocks base method.
 func (m *MockAddSecretsAPI) CreateSecret(arg0 context.Context, arg1, arg2 string, arg3 map[string]string) (string, error) {
 	m.ctrl.T.Helper()
@@ -554,3 +732,303 @@ func (c *MockRemoveSecretsAPIRemoveSecretCall) DoAndReturn(f func(context.Contex
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSecretAccessLogAPI is a mock of SecretAccessLogAPI interface.
+type MockSecretAccessLogAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretAccessLogAPIMockRecorder
+}
+
+// MockSecretAccessLogAPIMockRecorder is the mock recorder for MockSecretAccessLogAPI.
+type MockSecretAccessLogAPIMockRecorder struct {
+	mock *MockSecretAccessLogAPI
+}
+
+// NewMockSecretAccessLogAPI creates a new mock instance.
+func NewMockSecretAccessLogAPI(ctrl *gomock.Controller) *MockSecretAccessLogAPI {
+	mock := &MockSecretAccessLogAPI{ctrl: ctrl}
+	mock.recorder = &MockSecretAccessLogAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretAccessLogAPI) EXPECT() *MockSecretAccessLogAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSecretAccessLogAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSecretAccessLogAPIMockRecorder) Close() *MockSecretAccessLogAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSecretAccessLogAPI)(nil).Close))
+	return &MockSecretAccessLogAPICloseCall{Call: call}
+}
+
+// MockSecretAccessLogAPICloseCall wrap *gomock.Call
+type MockSecretAccessLogAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretAccessLogAPICloseCall) Return(arg0 error) *MockSecretAccessLogAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAccessLogAPICloseCall) Do(f func() error) *MockSecretAccessLogAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAccessLogAPICloseCall) DoAndReturn(f func() error) *MockSecretAccessLogAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SecretAccessLog mocks base method.
+func (m *MockSecretAccessLogAPI) SecretAccessLog(arg0 context.Context, arg1 secrets.SecretAccessLogFilter) ([]secrets.SecretAccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretAccessLog", arg0, arg1)
+	ret0, _ := ret[0].([]secrets.SecretAccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretAccessLog indicates an expected call of SecretAccessLog.
+func (mr *MockSecretAccessLogAPIMockRecorder) SecretAccessLog(arg0, arg1 any) *MockSecretAccessLogAPISecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretAccessLog", reflect.TypeOf((*MockSecretAccessLogAPI)(nil).SecretAccessLog), arg0, arg1)
+	return &MockSecretAccessLogAPISecretAccessLogCall{Call: call}
+}
+
+// MockSecretAccessLogAPISecretAccessLogCall wrap *gomock.Call
+type MockSecretAccessLogAPISecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretAccessLogAPISecretAccessLogCall) Return(arg0 []secrets.SecretAccessLogEntry, arg1 error) *MockSecretAccessLogAPISecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAccessLogAPISecretAccessLogCall) Do(f func(context.Context, secrets.SecretAccessLogFilter) ([]secrets.SecretAccessLogEntry, error)) *MockSecretAccessLogAPISecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAccessLogAPISecretAccessLogCall) DoAndReturn(f func(context.Context, secrets.SecretAccessLogFilter) ([]secrets.SecretAccessLogEntry, error)) *MockSecretAccessLogAPISecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockMigrateSecretsAPI is a mock of MigrateSecretsAPI interface.
+type MockMigrateSecretsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMigrateSecretsAPIMockRecorder
+}
+
+// MockMigrateSecretsAPIMockRecorder is the mock recorder for MockMigrateSecretsAPI.
+type MockMigrateSecretsAPIMockRecorder struct {
+	mock *MockMigrateSecretsAPI
+}
+
+// NewMockMigrateSecretsAPI creates a new mock instance.
+func NewMockMigrateSecretsAPI(ctrl *gomock.Controller) *MockMigrateSecretsAPI {
+	mock := &MockMigrateSecretsAPI{ctrl: ctrl}
+	mock.recorder = &MockMigrateSecretsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMigrateSecretsAPI) EXPECT() *MockMigrateSecretsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockMigrateSecretsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockMigrateSecretsAPIMockRecorder) Close() *MockMigrateSecretsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMigrateSecretsAPI)(nil).Close))
+	return &MockMigrateSecretsAPICloseCall{Call: call}
+}
+
+// MockMigrateSecretsAPICloseCall wrap *gomock.Call
+type MockMigrateSecretsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMigrateSecretsAPICloseCall) Return(arg0 error) *MockMigrateSecretsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMigrateSecretsAPICloseCall) Do(f func() error) *MockMigrateSecretsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMigrateSecretsAPICloseCall) DoAndReturn(f func() error) *MockMigrateSecretsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MigrateSecrets mocks base method.
+func (m *MockMigrateSecretsAPI) MigrateSecrets(arg0 context.Context, arg1 secrets.MigrateSecretsArgs) ([]secrets.SecretRevisionMigration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSecrets", arg0, arg1)
+	ret0, _ := ret[0].([]secrets.SecretRevisionMigration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSecrets indicates an expected call of MigrateSecrets.
+func (mr *MockMigrateSecretsAPIMockRecorder) MigrateSecrets(arg0, arg1 any) *MockMigrateSecretsAPIMigrateSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSecrets", reflect.TypeOf((*MockMigrateSecretsAPI)(nil).MigrateSecrets), arg0, arg1)
+	return &MockMigrateSecretsAPIMigrateSecretsCall{Call: call}
+}
+
+// MockMigrateSecretsAPIMigrateSecretsCall wrap *gomock.Call
+type MockMigrateSecretsAPIMigrateSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMigrateSecretsAPIMigrateSecretsCall) Return(arg0 []secrets.SecretRevisionMigration, arg1 error) *MockMigrateSecretsAPIMigrateSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMigrateSecretsAPIMigrateSecretsCall) Do(f func(context.Context, secrets.MigrateSecretsArgs) ([]secrets.SecretRevisionMigration, error)) *MockMigrateSecretsAPIMigrateSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMigrateSecretsAPIMigrateSecretsCall) DoAndReturn(f func(context.Context, secrets.MigrateSecretsArgs) ([]secrets.SecretRevisionMigration, error)) *MockMigrateSecretsAPIMigrateSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockRollbackSecretsAPI is a mock of RollbackSecretsAPI interface.
+type MockRollbackSecretsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRollbackSecretsAPIMockRecorder
+}
+
+// MockRollbackSecretsAPIMockRecorder is the mock recorder for MockRollbackSecretsAPI.
+type MockRollbackSecretsAPIMockRecorder struct {
+	mock *MockRollbackSecretsAPI
+}
+
+// NewMockRollbackSecretsAPI creates a new mock instance.
+func NewMockRollbackSecretsAPI(ctrl *gomock.Controller) *MockRollbackSecretsAPI {
+	mock := &MockRollbackSecretsAPI{ctrl: ctrl}
+	mock.recorder = &MockRollbackSecretsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRollbackSecretsAPI) EXPECT() *MockRollbackSecretsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockRollbackSecretsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockRollbackSecretsAPIMockRecorder) Close() *MockRollbackSecretsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockRollbackSecretsAPI)(nil).Close))
+	return &MockRollbackSecretsAPICloseCall{Call: call}
+}
+
+// MockRollbackSecretsAPICloseCall wrap *gomock.Call
+type MockRollbackSecretsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRollbackSecretsAPICloseCall) Return(arg0 error) *MockRollbackSecretsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRollbackSecretsAPICloseCall) Do(f func() error) *MockRollbackSecretsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRollbackSecretsAPICloseCall) DoAndReturn(f func() error) *MockRollbackSecretsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackSecret mocks base method.
+func (m *MockRollbackSecretsAPI) RollbackSecret(arg0 context.Context, arg1 *secrets0.URI, arg2 string, arg3 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackSecret", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackSecret indicates an expected call of RollbackSecret.
+func (mr *MockRollbackSecretsAPIMockRecorder) RollbackSecret(arg0, arg1, arg2, arg3 any) *MockRollbackSecretsAPIRollbackSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSecret", reflect.TypeOf((*MockRollbackSecretsAPI)(nil).RollbackSecret), arg0, arg1, arg2, arg3)
+	return &MockRollbackSecretsAPIRollbackSecretCall{Call: call}
+}
+
+// MockRollbackSecretsAPIRollbackSecretCall wrap *gomock.Call
+type MockRollbackSecretsAPIRollbackSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRollbackSecretsAPIRollbackSecretCall) Return(arg0 int, arg1 error) *MockRollbackSecretsAPIRollbackSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRollbackSecretsAPIRollbackSecretCall) Do(f func(context.Context, *secrets0.URI, string, int) (int, error)) *MockRollbackSecretsAPIRollbackSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRollbackSecretsAPIRollbackSecretCall) DoAndReturn(f func(context.Context, *secrets0.URI, string, int) (int, error)) *MockRollbackSecretsAPIRollbackSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/juju/juju/api/jujuclient"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,ShowSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,SecretAccessLogAPI,MigrateSecretsAPI,RollbackSecretsAPI
 
 // NewAddCommandForTest returns a secrets command for testing.
 func NewAddCommandForTest(store jujuclient.ClientStore, api AddSecretsAPI) *addSecretCommand {
@@ -38,6 +38,15 @@ func NewRemoveCommandForTest(store jujuclient.ClientStore, api RemoveSecretsAPI)
 	return c
 }
 
+// NewRollbackCommandForTest returns a secrets command for testing.
+func NewRollbackCommandForTest(store jujuclient.ClientStore, api RollbackSecretsAPI) *rollbackSecretCommand {
+	c := &rollbackSecretCommand{
+		secretsAPIFunc: func(ctx context.Context) (RollbackSecretsAPI, error) { return api, nil },
+	}
+	c.SetClientStore(store)
+	return c
+}
+
 // NewGrantCommandForTest returns a secrets command for testing.
 func NewGrantCommandForTest(store jujuclient.ClientStore, api GrantRevokeSecretsAPI) *grantSecretCommand {
 	c := &grantSecretCommand{
@@ -66,9 +75,27 @@ func NewListCommandForTest(store jujuclient.ClientStore, listSecretsAPI ListSecr
 }
 
 // NewShowCommandForTest returns a list-secrets command for testing.
-func NewShowCommandForTest(store jujuclient.ClientStore, listSecretsAPI ListSecretsAPI) *showSecretsCommand {
+func NewShowCommandForTest(store jujuclient.ClientStore, showSecretsAPI ShowSecretsAPI) *showSecretsCommand {
 	c := &showSecretsCommand{
-		listSecretsAPIFunc: func(ctx context.Context) (ListSecretsAPI, error) { return listSecretsAPI, nil },
+		listSecretsAPIFunc: func(ctx context.Context) (ShowSecretsAPI, error) { return showSecretsAPI, nil },
+	}
+	c.SetClientStore(store)
+	return c
+}
+
+// NewSecretAccessLogCommandForTest returns a secret-access-log command for testing.
+func NewSecretAccessLogCommandForTest(store jujuclient.ClientStore, api SecretAccessLogAPI) *secretAccessLogCommand {
+	c := &secretAccessLogCommand{
+		secretsAPIFunc: func(ctx context.Context) (SecretAccessLogAPI, error) { return api, nil },
+	}
+	c.SetClientStore(store)
+	return c
+}
+
+// NewMigrateSecretsCommandForTest returns a migrate-secrets command for testing.
+func NewMigrateSecretsCommandForTest(store jujuclient.ClientStore, api MigrateSecretsAPI) *migrateSecretsCommand {
+	c := &migrateSecretsCommand{
+		secretsAPIFunc: func(ctx context.Context) (MigrateSecretsAPI, error) { return api, nil },
 	}
 	c.SetClientStore(store)
 	return c
@@ -0,0 +1,115 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/secrets"
+)
+
+type rollbackSecretCommand struct {
+	modelcmd.ModelCommandBase
+
+	secretsAPIFunc func(ctx context.Context) (RollbackSecretsAPI, error)
+
+	secretURI *secrets.URI
+	name      string
+	revision  int
+}
+
+// RollbackSecretsAPI is the secrets client API.
+type RollbackSecretsAPI interface {
+	RollbackSecret(ctx context.Context, uri *secrets.URI, name string, revision int) (int, error)
+	Close() error
+}
+
+// NewRollbackSecretCommand returns a command to roll back a secret to the
+// content of an earlier revision.
+func NewRollbackSecretCommand() cmd.Command {
+	c := &rollbackSecretCommand{}
+	c.secretsAPIFunc = c.secretsAPI
+	return modelcmd.Wrap(c)
+}
+
+func (c *rollbackSecretCommand) secretsAPI(ctx context.Context) (RollbackSecretsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apisecrets.NewClient(root), nil
+}
+
+const (
+	rollbackSecretDoc = `
+Roll back a secret to the content of an earlier revision.
+
+Existing revisions are not changed. Instead, a new revision is created with
+the content of the specified revision, so consumers tracking the latest
+revision are notified of the change as with any other update. Use
+"juju show-secret --diff" to compare revisions before rolling back.
+`
+	rollbackSecretExamples = `
+    juju rollback-secret my-secret --to-revision 2
+    juju rollback-secret secret:9m4e2mr0ui3e8a215n4g --to-revision 2
+`
+)
+
+// Info implements cmd.Command.
+func (c *rollbackSecretCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "rollback-secret",
+		Args:     "<ID>|<name>",
+		Purpose:  "Roll back a secret to the content of an earlier revision.",
+		Doc:      rollbackSecretDoc,
+		Examples: rollbackSecretExamples,
+		SeeAlso: []string{
+			"show-secret",
+			"update-secret",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *rollbackSecretCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.IntVar(&c.revision, "to-revision", 0, "the revision whose content to restore")
+}
+
+// Init implements cmd.Command.
+func (c *rollbackSecretCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("missing secret URI")
+	}
+	if c.revision < 1 {
+		return errors.New("--to-revision is required and must be a positive revision")
+	}
+	var err error
+	if c.secretURI, err = secrets.ParseURI(args[0]); err != nil {
+		c.name = args[0]
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *rollbackSecretCommand) Run(ctx *cmd.Context) error {
+	secretsAPI, err := c.secretsAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer secretsAPI.Close()
+
+	revision, err := secretsAPI.RollbackSecret(ctx, c.secretURI, c.name, c.revision)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Secret rolled back to the content of revision %d as revision %d.", c.revision, revision)
+	return nil
+}
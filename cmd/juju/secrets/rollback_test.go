@@ -0,0 +1,89 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/secrets"
+	"github.com/juju/juju/cmd/juju/secrets/mocks"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type rollbackSuite struct {
+	testhelpers.IsolationSuite
+	store      *jujuclient.MemStore
+	secretsAPI *mocks.MockRollbackSecretsAPI
+}
+
+func TestRollbackSuite(t *testing.T) {
+	tc.Run(t, &rollbackSuite{})
+}
+
+func (s *rollbackSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *rollbackSuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.secretsAPI = mocks.NewMockRollbackSecretsAPI(ctrl)
+	return ctrl
+}
+
+func (s *rollbackSuite) TestRollbackMissingArg(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewRollbackCommandForTest(s.store, s.secretsAPI), "--to-revision", "2")
+	c.Assert(err, tc.ErrorMatches, `missing secret URI`)
+}
+
+func (s *rollbackSuite) TestRollbackMissingRevision(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewRollbackCommandForTest(s.store, s.secretsAPI), "my-secret")
+	c.Assert(err, tc.ErrorMatches, `--to-revision is required and must be a positive revision`)
+}
+
+func (s *rollbackSuite) TestRollback(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().RollbackSecret(gomock.Any(), uri, "", 2).Return(5, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewRollbackCommandForTest(s.store, s.secretsAPI), uri.String(), "--to-revision", "2")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, "Secret rolled back to the content of revision 2 as revision 5.\n")
+}
+
+func (s *rollbackSuite) TestRollbackByName(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.secretsAPI.EXPECT().RollbackSecret(gomock.Any(), nil, "my-secret", 2).Return(5, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewRollbackCommandForTest(s.store, s.secretsAPI), "my-secret", "--to-revision", "2")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *rollbackSuite) TestRollbackError(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.secretsAPI.EXPECT().RollbackSecret(gomock.Any(), nil, "my-secret", 3).Return(0, errors.NotValidf("revision 3 is already the latest revision"))
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewRollbackCommandForTest(s.store, s.secretsAPI), "my-secret", "--to-revision", "3")
+	c.Assert(err, tc.ErrorMatches, `revision 3 is already the latest revision not valid`)
+}
@@ -5,6 +5,8 @@ package secrets
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
@@ -20,12 +22,15 @@ type showSecretsCommand struct {
 	modelcmd.ModelCommandBase
 	out cmd.Output
 
-	listSecretsAPIFunc func(ctx context.Context) (ListSecretsAPI, error)
+	listSecretsAPIFunc func(ctx context.Context) (ShowSecretsAPI, error)
 	uri                *coresecrets.URI
 	name               string
 	revealSecrets      bool
 	revisions          bool
 	revision           int
+	diff               string
+
+	fromRevision, toRevision int
 }
 
 var showSecretsDoc = `
@@ -36,6 +41,10 @@ with the ` + "`--reveal`" + ` option in the ` + "`json`" + ` or ` + "`yaml`" + `
 
 Use ` + "`--revision`" + ` to inspect a particular revision, else latest is used.
 Use ` + "`--revisions`" + ` to see the metadata for each revision.
+
+Use ` + "`--diff <rev1>..<rev2>`" + ` to see which keys were added, removed or
+changed between two revisions. Values are redacted unless ` + "`--reveal`" + `
+is also specified.
 `
 
 const showSecretsExamples = `
@@ -45,6 +54,8 @@ const showSecretsExamples = `
     juju show-secret 9m4e2mr0ui3e8a215n4g --revision 2 --reveal
     juju show-secret 9m4e2mr0ui3e8a215n4g --revisions
     juju show-secret 9m4e2mr0ui3e8a215n4g --reveal
+    juju show-secret 9m4e2mr0ui3e8a215n4g --diff 1..3
+    juju show-secret 9m4e2mr0ui3e8a215n4g --diff 1..3 --reveal
 `
 
 // NewShowSecretsCommand returns a command to list secrets metadata.
@@ -55,7 +66,15 @@ func NewShowSecretsCommand() cmd.Command {
 	return modelcmd.Wrap(c)
 }
 
-func (c *showSecretsCommand) secretsAPI(ctx context.Context) (ListSecretsAPI, error) {
+// ShowSecretsAPI is the secrets client API used by show-secret.
+type ShowSecretsAPI interface {
+	ListSecretsAPI
+	SecretRevisionDiff(
+		ctx context.Context, uri *coresecrets.URI, name string, fromRevision, toRevision int, reveal bool,
+	) (apisecrets.SecretRevisionDiff, error)
+}
+
+func (c *showSecretsCommand) secretsAPI(ctx context.Context) (ShowSecretsAPI, error) {
 	root, err := c.NewAPIRoot(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -76,6 +95,7 @@ func (c *showSecretsCommand) Info() *cmd.Info {
 			"add-secret",
 			"update-secret",
 			"remove-secret",
+			"rollback-secret",
 		},
 	})
 }
@@ -86,6 +106,7 @@ func (c *showSecretsCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.revisions, "revisions", false, "Show the secret revisions metadata")
 	f.IntVar(&c.revision, "revision", 0, "Show a specific revision (defaults to latest)")
 	f.IntVar(&c.revision, "r", 0, "")
+	f.StringVar(&c.diff, "diff", "", "Show the changes between two revisions, as <rev1>..<rev2>")
 	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
 		"yaml": cmd.FormatYaml,
 		"json": cmd.FormatJson,
@@ -113,9 +134,37 @@ func (c *showSecretsCommand) Init(args []string) error {
 	if c.revision < 0 {
 		return errors.New("revision must be a positive integer")
 	}
+	if c.diff != "" {
+		if c.revisions || c.revision > 0 {
+			return errors.New("specify either --diff or --revisions/--revision but not both")
+		}
+		if c.fromRevision, c.toRevision, err = parseRevisionRange(c.diff); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	return cmd.CheckEmpty(args[1:])
 }
 
+// parseRevisionRange parses a revision range of the form <rev1>..<rev2>.
+func parseRevisionRange(str string) (int, int, error) {
+	fromStr, toStr, ok := strings.Cut(str, "..")
+	if !ok {
+		return 0, 0, errors.NotValidf("revision range %q, expected <rev1>..<rev2>,", str)
+	}
+	from, err := strconv.Atoi(fromStr)
+	if err != nil || from < 1 {
+		return 0, 0, errors.NotValidf("revision %q in range %q", fromStr, str)
+	}
+	to, err := strconv.Atoi(toStr)
+	if err != nil || to < 1 {
+		return 0, 0, errors.NotValidf("revision %q in range %q", toStr, str)
+	}
+	if from == to {
+		return 0, 0, errors.NotValidf("revision range %q with the same revisions", str)
+	}
+	return from, to, nil
+}
+
 // Run implements cmd.Run.
 func (c *showSecretsCommand) Run(ctxt *cmd.Context) error {
 	if c.revealSecrets && c.out.Name() == "tabular" {
@@ -129,6 +178,10 @@ func (c *showSecretsCommand) Run(ctxt *cmd.Context) error {
 	}
 	defer api.Close()
 
+	if c.diff != "" {
+		return c.showDiff(ctxt, api)
+	}
+
 	filter := coresecrets.Filter{
 		URI: c.uri,
 	}
@@ -152,3 +205,46 @@ func (c *showSecretsCommand) Run(ctxt *cmd.Context) error {
 
 	return c.out.Write(ctxt, details)
 }
+
+type secretKeyChange struct {
+	Key    string `json:"key" yaml:"key"`
+	Change string `json:"change" yaml:"change"`
+	From   string `json:"from,omitempty" yaml:"from,omitempty"`
+	To     string `json:"to,omitempty" yaml:"to,omitempty"`
+}
+
+type secretDiffDetails struct {
+	FromRevision int               `json:"from-revision" yaml:"from-revision"`
+	ToRevision   int               `json:"to-revision" yaml:"to-revision"`
+	Changes      []secretKeyChange `json:"changes" yaml:"changes"`
+}
+
+// showDiff writes the keys which differ between two revisions of the secret.
+// The revisions are compared by the controller, so the content of the secret
+// is only sent to the client if --reveal is specified.
+func (c *showSecretsCommand) showDiff(ctxt *cmd.Context, api ShowSecretsAPI) error {
+	diff, err := api.SecretRevisionDiff(ctxt, c.uri, c.name, c.fromRevision, c.toRevision, c.revealSecrets)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	changes := make([]secretKeyChange, len(diff.Changes))
+	for i, change := range diff.Changes {
+		changes[i] = secretKeyChange{
+			Key:    change.Key,
+			Change: change.Change,
+			From:   change.From,
+			To:     change.To,
+		}
+	}
+	if len(changes) == 0 {
+		ctxt.Infof("No changes between revision %d and revision %d.", c.fromRevision, c.toRevision)
+	}
+	return c.out.Write(ctxt, map[string]secretDiffDetails{
+		diff.URI.ID: {
+			FromRevision: c.fromRevision,
+			ToRevision:   c.toRevision,
+			Changes:      changes,
+		},
+	})
+}
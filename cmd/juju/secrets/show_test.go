@@ -23,7 +23,7 @@ import (
 type ShowSuite struct {
 	testhelpers.IsolationSuite
 	store      *jujuclient.MemStore
-	secretsAPI *mocks.MockListSecretsAPI
+	secretsAPI *mocks.MockShowSecretsAPI
 }
 
 func TestShowSuite(t *stdtesting.T) {
@@ -41,7 +41,7 @@ func (s *ShowSuite) SetUpTest(c *tc.C) {
 func (s *ShowSuite) setup(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
-	s.secretsAPI = mocks.NewMockListSecretsAPI(ctrl)
+	s.secretsAPI = mocks.NewMockShowSecretsAPI(ctrl)
 
 	return ctrl
 }
@@ -224,3 +224,88 @@ func (s *ShowSuite) TestShowRevisions(c *tc.C) {
     updated: 0001-01-01T00:00:00Z
 `[1:], uri.ID))
 }
+
+func (s *ShowSuite) TestInitDiff(c *tc.C) {
+	uri := coresecrets.NewURI()
+	_, err := cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.ID, "--diff", "1..2", "--revisions")
+	c.Assert(err, tc.ErrorMatches, "specify either --diff or --revisions/--revision but not both")
+	_, err = cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.ID, "--diff", "1..2", "--revision", "2")
+	c.Assert(err, tc.ErrorMatches, "specify either --diff or --revisions/--revision but not both")
+	_, err = cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.ID, "--diff", "1")
+	c.Assert(err, tc.ErrorMatches, `revision range "1", expected <rev1>..<rev2>, not valid`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.ID, "--diff", "1..x")
+	c.Assert(err, tc.ErrorMatches, `revision "x" in range "1..x" not valid`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.ID, "--diff", "2..2")
+	c.Assert(err, tc.ErrorMatches, `revision range "2..2" with the same revisions not valid`)
+}
+
+func (s *ShowSuite) expectDiffRevisions(uri *coresecrets.URI, reveal bool) {
+	diff := apisecrets.SecretRevisionDiff{
+		URI: uri,
+		Changes: []apisecrets.SecretKeyChange{
+			{Key: "foo", Change: "changed"},
+			{Key: "gone", Change: "removed"},
+			{Key: "new", Change: "added"},
+		},
+	}
+	if reveal {
+		diff.Changes = []apisecrets.SecretKeyChange{
+			{Key: "foo", Change: "changed", From: "bar", To: "baz"},
+			{Key: "gone", Change: "removed", From: "old"},
+			{Key: "new", Change: "added", To: "new"},
+		}
+	}
+	// The content is only asked for if it is to be revealed.
+	s.secretsAPI.EXPECT().SecretRevisionDiff(gomock.Any(), uri, "", 1, 3, reveal).Return(diff, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+}
+
+func (s *ShowSuite) TestShowDiff(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.expectDiffRevisions(uri, false)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.String(), "--diff", "1..3")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, fmt.Sprintf(`
+%s:
+  from-revision: 1
+  to-revision: 3
+  changes:
+  - key: foo
+    change: changed
+  - key: gone
+    change: removed
+  - key: new
+    change: added
+`[1:], uri.ID))
+}
+
+func (s *ShowSuite) TestShowDiffReveal(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.expectDiffRevisions(uri, true)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.String(), "--diff", "1..3", "--reveal")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, fmt.Sprintf(`
+%s:
+  from-revision: 1
+  to-revision: 3
+  changes:
+  - key: foo
+    change: changed
+    from: bar
+    to: baz
+  - key: gone
+    change: removed
+    from: old
+  - key: new
+    change: added
+    to: new
+`[1:], uri.ID))
+}
@@ -122,8 +122,9 @@ func (c *ListCommand) Run(ctx *cmd.Context) error {
 
 		for i, space := range spaces {
 			fsp := formattedSpace{
-				Id:   space.Id,
-				Name: space.Name,
+				Id:                   space.Id,
+				Name:                 space.Name,
+				PreferredAddressType: space.PreferredAddressType,
 			}
 
 			result.Spaces[i].Id = space.Id
@@ -252,9 +253,10 @@ type formattedSubnet struct {
 }
 
 type formattedSpace struct {
-	Id      string                     `json:"id" yaml:"id"`
-	Name    string                     `json:"name" yaml:"name"`
-	Subnets map[string]formattedSubnet `json:"subnets" yaml:"subnets"`
+	Id                   string                     `json:"id" yaml:"id"`
+	Name                 string                     `json:"name" yaml:"name"`
+	Subnets              map[string]formattedSubnet `json:"subnets" yaml:"subnets"`
+	PreferredAddressType string                     `json:"preferred-address-type,omitempty" yaml:"preferred-address-type,omitempty"`
 }
 
 type formattedList struct {
@@ -272,6 +272,44 @@ func (c *MockSpaceAPIRenameSpaceCall) DoAndReturn(f func(context.Context, string
 	return c
 }
 
+// SetSpaceAddressPreference mocks base method.
+func (m *MockSpaceAPI) SetSpaceAddressPreference(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSpaceAddressPreference", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSpaceAddressPreference indicates an expected call of SetSpaceAddressPreference.
+func (mr *MockSpaceAPIMockRecorder) SetSpaceAddressPreference(arg0, arg1, arg2 any) *MockSpaceAPISetSpaceAddressPreferenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSpaceAddressPreference", reflect.TypeOf((*MockSpaceAPI)(nil).SetSpaceAddressPreference), arg0, arg1, arg2)
+	return &MockSpaceAPISetSpaceAddressPreferenceCall{Call: call}
+}
+
+// MockSpaceAPISetSpaceAddressPreferenceCall wrap *gomock.Call
+type MockSpaceAPISetSpaceAddressPreferenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSpaceAPISetSpaceAddressPreferenceCall) Return(arg0 error) *MockSpaceAPISetSpaceAddressPreferenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSpaceAPISetSpaceAddressPreferenceCall) Do(f func(context.Context, string, string) error) *MockSpaceAPISetSpaceAddressPreferenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSpaceAPISetSpaceAddressPreferenceCall) DoAndReturn(f func(context.Context, string, string) error) *MockSpaceAPISetSpaceAddressPreferenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ShowSpace mocks base method.
 func (m *MockSpaceAPI) ShowSpace(arg0 context.Context, arg1 string) (params.ShowSpaceResult, error) {
 	m.ctrl.T.Helper()
@@ -665,6 +703,44 @@ func (c *MockAPIRenameSpaceCall) DoAndReturn(f func(context.Context, string, str
 	return c
 }
 
+// SetSpaceAddressPreference mocks base method.
+func (m *MockAPI) SetSpaceAddressPreference(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSpaceAddressPreference", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSpaceAddressPreference indicates an expected call of SetSpaceAddressPreference.
+func (mr *MockAPIMockRecorder) SetSpaceAddressPreference(arg0, arg1, arg2 any) *MockAPISetSpaceAddressPreferenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSpaceAddressPreference", reflect.TypeOf((*MockAPI)(nil).SetSpaceAddressPreference), arg0, arg1, arg2)
+	return &MockAPISetSpaceAddressPreferenceCall{Call: call}
+}
+
+// MockAPISetSpaceAddressPreferenceCall wrap *gomock.Call
+type MockAPISetSpaceAddressPreferenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAPISetSpaceAddressPreferenceCall) Return(arg0 error) *MockAPISetSpaceAddressPreferenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAPISetSpaceAddressPreferenceCall) Do(f func(context.Context, string, string) error) *MockAPISetSpaceAddressPreferenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAPISetSpaceAddressPreferenceCall) DoAndReturn(f func(context.Context, string, string) error) *MockAPISetSpaceAddressPreferenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ShowSpace mocks base method.
 func (m *MockAPI) ShowSpace(arg0 context.Context, arg1 string) (params.ShowSpaceResult, error) {
 	m.ctrl.T.Helper()
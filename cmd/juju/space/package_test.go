@@ -265,6 +265,11 @@ func (sa *StubAPI) RenameSpace(ctx context.Context, name, newName string) error
 	return sa.NextErr()
 }
 
+func (sa *StubAPI) SetSpaceAddressPreference(ctx context.Context, name, addressType string) error {
+	sa.MethodCall(sa, "SetSpaceAddressPreference", name, addressType)
+	return sa.NextErr()
+}
+
 func (sa *StubAPI) ReloadSpaces(ctx context.Context) error {
 	sa.MethodCall(sa, "ReloadSpaces")
 	return sa.NextErr()
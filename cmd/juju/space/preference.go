@@ -0,0 +1,100 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package space
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/network"
+)
+
+// NewSetAddressPreferenceCommand returns a command used to set the type of
+// IP address preferred in a space.
+func NewSetAddressPreferenceCommand() modelcmd.ModelCommand {
+	return modelcmd.Wrap(&SetAddressPreferenceCommand{})
+}
+
+// SetAddressPreferenceCommand calls the API to set the type of IP address
+// preferred on dual-stack machines in a network space.
+type SetAddressPreferenceCommand struct {
+	SpaceCommandBase
+	Name        string
+	AddressType string
+}
+
+const setAddressPreferenceCommandDoc = `
+Sets the type of IP address, ` + "`ipv4`" + ` or ` + "`ipv6`" + `, preferred on dual-stack
+machines in a space. The preference governs the ingress addresses that
+` + "`network-get`" + ` reports to units bound to the space and, for the controller's
+management space, the order of the API addresses handed to agents.
+
+IPv4 addresses are preferred in spaces without a preference. Spaces containing
+only IPv6 subnets need no preference.
+`
+
+const setAddressPreferenceCommandExamples = `
+Prefer IPv6 addresses in the ` + "`rack1`" + ` space:
+
+	juju set-space-address-preference rack1 ipv6
+`
+
+// Info is defined on the cmd.Command interface.
+func (c *SetAddressPreferenceCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "set-space-address-preference",
+		Args:     "<name> ipv4|ipv6",
+		Purpose:  "Set the type of IP address preferred in a network space.",
+		Doc:      strings.TrimSpace(setAddressPreferenceCommandDoc),
+		Examples: setAddressPreferenceCommandExamples,
+		SeeAlso: []string{
+			"spaces",
+			"show-space",
+		},
+	})
+}
+
+// Init is defined on the cmd.Command interface. It checks the
+// arguments for sanity and sets up the command to run.
+func (c *SetAddressPreferenceCommand) Init(args []string) (err error) {
+	defer errors.DeferredAnnotatef(&err, "invalid arguments specified")
+
+	switch len(args) {
+	case 0:
+		return errors.New("space name is required")
+	case 1:
+		return errors.New("address type is required")
+	}
+	if !names.IsValidSpace(args[0]) {
+		return errors.Errorf("%q is not a valid space name", args[0])
+	}
+	c.Name = args[0]
+
+	switch addrType := network.AddressType(strings.ToLower(args[1])); addrType {
+	case network.IPv4Address, network.IPv6Address:
+		c.AddressType = string(addrType)
+	default:
+		return errors.Errorf("address type %q must be %q or %q", args[1], network.IPv4Address, network.IPv6Address)
+	}
+
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Run implements Command.Run.
+func (c *SetAddressPreferenceCommand) Run(ctx *cmd.Context) error {
+	return c.RunWithSpaceAPI(ctx, func(api SpaceAPI, ctx *cmd.Context) error {
+		err := api.SetSpaceAddressPreference(ctx, c.Name, c.AddressType)
+		if err != nil {
+			return errors.Annotatef(err, "cannot set space %q address preference", c.Name)
+		}
+
+		ctx.Infof("space %q now prefers %s addresses", c.Name, c.AddressType)
+		return nil
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package space_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/space"
+)
+
+type SetAddressPreferenceSuite struct {
+	BaseSpaceSuite
+}
+
+func TestSetAddressPreferenceSuite(t *testing.T) {
+	tc.Run(t, &SetAddressPreferenceSuite{})
+}
+
+func (s *SetAddressPreferenceSuite) SetUpTest(c *tc.C) {
+	s.BaseSpaceSuite.SetUpTest(c)
+	s.newCommand = space.NewSetAddressPreferenceCommand
+}
+
+func (s *SetAddressPreferenceSuite) TestInit(c *tc.C) {
+	for i, test := range []struct {
+		about             string
+		args              []string
+		expectName        string
+		expectAddressType string
+		expectErr         string
+	}{{
+		about:     "no arguments",
+		expectErr: "space name is required",
+	}, {
+		about:     "no address type",
+		args:      s.Strings("a-space"),
+		expectErr: "address type is required",
+	}, {
+		about:     "invalid space name",
+		args:      s.Strings("%inv$alid", "ipv6"),
+		expectErr: `"%inv\$alid" is not a valid space name`,
+	}, {
+		about:     "invalid address type",
+		args:      s.Strings("a-space", "hostname"),
+		expectErr: `address type "hostname" must be "ipv4" or "ipv6"`,
+	}, {
+		about:             "more than two arguments",
+		args:              s.Strings("a-space", "ipv6", "rubbish"),
+		expectErr:         `unrecognized args: \["rubbish"\]`,
+		expectName:        "a-space",
+		expectAddressType: "ipv6",
+	}, {
+		about:             "all ok",
+		args:              s.Strings("a-space", "IPv6"),
+		expectName:        "a-space",
+		expectAddressType: "ipv6",
+	}} {
+		c.Logf("test #%d: %s", i, test.about)
+		command, err := s.InitCommand(c, test.args...)
+		if test.expectErr != "" {
+			prefixedErr := "invalid arguments specified: " + test.expectErr
+			c.Check(err, tc.ErrorMatches, prefixedErr)
+		} else {
+			c.Check(err, tc.ErrorIsNil)
+			command := command.(*space.SetAddressPreferenceCommand)
+			c.Check(command.Name, tc.Equals, test.expectName)
+			c.Check(command.AddressType, tc.Equals, test.expectAddressType)
+		}
+		// No API calls should be recorded at this stage.
+		s.api.CheckCallNames(c)
+	}
+}
+
+func (s *SetAddressPreferenceSuite) TestRunSucceeds(c *tc.C) {
+	s.AssertRunSucceeds(c,
+		`space "a-space" now prefers ipv6 addresses\n`,
+		"", // no stdout, just stderr
+		"a-space", "ipv6",
+	)
+
+	s.api.CheckCallNames(c, "SetSpaceAddressPreference", "Close")
+	s.api.CheckCall(c, 0, "SetSpaceAddressPreference", "a-space", "ipv6")
+}
+
+func (s *SetAddressPreferenceSuite) TestRunWhenSpacesAPIFails(c *tc.C) {
+	s.api.SetErrors(errors.New("boom"))
+
+	_ = s.AssertRunFails(c,
+		`cannot set space "foo" address preference: boom`,
+		"foo", "ipv6",
+	)
+
+	s.api.CheckCallNames(c, "SetSpaceAddressPreference", "Close")
+	s.api.CheckCall(c, 0, "SetSpaceAddressPreference", "foo", "ipv6")
+}
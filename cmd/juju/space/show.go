@@ -111,9 +111,10 @@ func showSpaceFromResult(result params.ShowSpaceResult) ShowSpace {
 	}
 	return ShowSpace{
 		Space: SpaceInfo{
-			ID:      s.Id,
-			Name:    s.Name,
-			Subnets: subnets,
+			ID:                   s.Id,
+			Name:                 s.Name,
+			Subnets:              subnets,
+			PreferredAddressType: s.PreferredAddressType,
 		},
 		Applications: result.Applications,
 		MachineCount: result.MachineCount,
@@ -44,6 +44,10 @@ type SpaceAPI interface {
 	// RenameSpace changes the name of the space.
 	RenameSpace(ctx context.Context, name, newName string) error
 
+	// SetSpaceAddressPreference sets the type of IP address preferred on
+	// dual-stack machines in the space.
+	SetSpaceAddressPreference(ctx context.Context, name, addressType string) error
+
 	// ReloadSpaces fetches spaces and subnets from substrate
 	ReloadSpaces(ctx context.Context) error
 
@@ -178,6 +182,12 @@ func (m *APIShim) RenameSpace(ctx context.Context, oldName, newName string) erro
 	return m.spaceAPI.RenameSpace(ctx, oldName, newName)
 }
 
+// SetSpaceAddressPreference sets the type of IP address preferred on
+// dual-stack machines in the space.
+func (m *APIShim) SetSpaceAddressPreference(ctx context.Context, name, addressType string) error {
+	return m.spaceAPI.SetSpaceAddressPreference(ctx, name, addressType)
+}
+
 // ShowSpace fetches space information.
 func (m *APIShim) ShowSpace(ctx context.Context, name string) (params.ShowSpaceResult, error) {
 	return m.spaceAPI.ShowSpace(ctx, name)
@@ -291,6 +301,10 @@ type SpaceInfo struct {
 
 	// Subnets are the subnets that have been grouped into this network space.
 	Subnets []SubnetInfo `json:"subnets" yaml:"subnets"`
+
+	// PreferredAddressType is the type of IP address preferred on
+	// dual-stack machines in the space.
+	PreferredAddressType string `json:"preferred-address-type,omitempty" yaml:"preferred-address-type,omitempty"`
 }
 
 // FanCIDRs describes the subnets relevant to a fan network.
@@ -18,3 +18,13 @@ func NewStatusCommandForTest(store jujuclient.ClientStore, statusapi statusAPI,
 	cmd.SetClientStore(store)
 	return modelcmd.Wrap(cmd)
 }
+
+func NewStatusCommandWithHistoryForTest(store jujuclient.ClientStore, statusapi statusAPI, clients []HistoryAPI, clock Clock) cmd.Command {
+	cmd := &statusCommand{statusAPI: statusapi, historyClients: clients, clock: clock}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewExportStatusHistoryCommandForTest(clients []HistoryAPI) cmd.Command {
+	return &exportStatusHistoryCommand{clients: clients}
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/client"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/status"
+)
+
+// NewExportStatusHistoryCommand returns a command that exports the status
+// history of every entity in the model.
+func NewExportStatusHistoryCommand() cmd.Command {
+	return modelcmd.Wrap(&exportStatusHistoryCommand{})
+}
+
+type exportStatusHistoryCommand struct {
+	modelcmd.ModelCommandBase
+	clients []HistoryAPI
+	out     cmd.Output
+
+	fromArg string
+	toArg   string
+	from    *time.Time
+	to      *time.Time
+}
+
+const exportStatusHistoryDoc = `
+Export the status history of every machine, application, unit and other
+entity in the model as JSON lines, ordered by the time each status was set.
+
+Each controller records the status changes it handles, so the history is
+gathered from all of the controllers and merged.
+
+The --from and --to options limit the export to a time range. They accept
+an RFC3339 timestamp, or a date and time in the local time zone in the form
+YYYY-MM-DD, YYYY-MM-DD HH:MM or YYYY-MM-DD HH:MM:SS.
+`
+
+const exportStatusHistoryExamples = `
+Export the complete status history of the model:
+
+    juju export-status-history
+
+Export the status history for the hour before an incident to a file:
+
+    juju export-status-history --from "2026-04-01 02:15" --to "2026-04-01 03:15" -o history.jsonl
+`
+
+func (c *exportStatusHistoryCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "export-status-history",
+		Purpose:  "Export the status history of all entities in the model.",
+		Doc:      exportStatusHistoryDoc,
+		Examples: exportStatusHistoryExamples,
+		SeeAlso: []string{
+			"show-status-log",
+			"status",
+		},
+	})
+}
+
+func (c *exportStatusHistoryCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.fromArg, "from", "", "Only export statuses set after this time")
+	f.StringVar(&c.toArg, "to", "", "Only export statuses set at or before this time")
+
+	c.out.AddFlags(f, "json-lines", map[string]cmd.Formatter{
+		"json-lines": formatHistoryJSONLines,
+	})
+}
+
+func (c *exportStatusHistoryCommand) Init(args []string) error {
+	if len(args) > 0 {
+		return errors.Errorf("unexpected arguments: %v", args)
+	}
+	if c.fromArg != "" {
+		t, err := parseTimestamp(c.fromArg)
+		if err != nil {
+			return errors.Annotate(err, "parsing --from")
+		}
+		c.from = &t
+	}
+	if c.toArg != "" {
+		t, err := parseTimestamp(c.toArg)
+		if err != nil {
+			return errors.Annotate(err, "parsing --to")
+		}
+		c.to = &t
+	}
+	if c.from != nil && c.to != nil && !c.to.After(*c.from) {
+		return errors.Errorf("--to must be after --from")
+	}
+	return nil
+}
+
+func (c *exportStatusHistoryCommand) Run(ctx *cmd.Context) error {
+	clients := c.clients
+	if clients == nil {
+		var err error
+		if clients, _, err = getStatusHistoryClients(ctx, c, ctx); err != nil {
+			return errors.Trace(err)
+		} else if len(clients) == 0 {
+			return errors.New("no controller status-history clients available; is bootstrap still in progress?")
+		}
+	}
+	defer func() {
+		for _, client := range clients {
+			_ = client.Close()
+		}
+	}()
+
+	entries, err := exportStatusHistory(ctx, clients, c.from, c.to)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return c.out.Write(ctx, entries)
+}
+
+// historyExportRecord is a single line of the exported status history.
+type historyExportRecord struct {
+	Kind    status.HistoryKind `json:"kind"`
+	ID      string             `json:"id"`
+	Status  status.Status      `json:"status"`
+	Message string             `json:"message,omitempty"`
+	Data    map[string]any     `json:"data,omitempty"`
+	Since   *time.Time         `json:"since,omitempty"`
+}
+
+func formatHistoryJSONLines(writer io.Writer, value any) error {
+	entries, ok := value.([]client.StatusHistoryEntry)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", entries, value)
+	}
+	encoder := json.NewEncoder(writer)
+	for _, entry := range entries {
+		if err := encoder.Encode(historyExportRecord{
+			Kind:    entry.Kind,
+			ID:      entry.ID,
+			Status:  entry.Status.Status,
+			Message: entry.Status.Info,
+			Data:    entry.Status.Data,
+			Since:   entry.Status.Since,
+		}); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// exportStatusHistory gathers the status history from each controller and
+// merges it into a single history, ordered by the time each status was set.
+// A controller that cannot be read is reported as a warning, unless none of
+// them can be read.
+func exportStatusHistory(ctx *cmd.Context, clients []HistoryAPI, from, to *time.Time) ([]client.StatusHistoryEntry, error) {
+	var (
+		entries []client.StatusHistoryEntry
+		lastErr error
+		failed  int
+	)
+	for _, api := range clients {
+		e, err := api.ExportStatusHistory(ctx, from, to)
+		if errors.Is(err, errors.NotSupported) {
+			return nil, errors.New("exporting status history is not supported by this controller")
+		} else if err != nil {
+			fmt.Fprintf(ctx.Stderr, "%v\n", err)
+			lastErr = err
+			failed++
+			continue
+		}
+		entries = append(entries, e...)
+	}
+	if failed == len(clients) && lastErr != nil {
+		return nil, errors.Trace(lastErr)
+	}
+
+	slices.SortStableFunc(entries, func(a, b client.StatusHistoryEntry) int {
+		return compareSince(a.Status.Since, b.Status.Since)
+	})
+	return entries, nil
+}
+
+// compareSince orders times, with unknown times last.
+func compareSince(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return a.Compare(*b)
+}
+
+// timestampLayouts are the layouts accepted by parseTimestamp, in addition
+// to RFC3339. They are interpreted in the local time zone.
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseTimestamp parses an RFC3339 timestamp, or a date and optional time
+// in the local time zone.
+func parseTimestamp(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.NotValidf("timestamp %q", value)
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type ExportStatusHistorySuite struct {
+	testhelpers.IsolationSuite
+	now time.Time
+}
+
+func TestExportStatusHistorySuite(t *testing.T) {
+	tc.Run(t, &ExportStatusHistorySuite{})
+}
+
+func (s *ExportStatusHistorySuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.now = time.Date(2026, 4, 1, 3, 12, 0, 0, time.UTC)
+}
+
+func (s *ExportStatusHistorySuite) at(minutes int) *time.Time {
+	t := s.now.Add(time.Duration(minutes) * time.Minute)
+	return &t
+}
+
+func (s *ExportStatusHistorySuite) TestExportMergesControllers(c *tc.C) {
+	clients := []HistoryAPI{
+		&fakeHistoryAPI{entries: []client.StatusHistoryEntry{{
+			Kind:   status.KindWorkload,
+			ID:     "foo/0",
+			Status: status.DetailedStatus{Kind: status.KindWorkload, Status: status.Maintenance, Info: "installing", Since: s.at(0)},
+		}, {
+			Kind:   status.KindWorkload,
+			ID:     "foo/0",
+			Status: status.DetailedStatus{Kind: status.KindWorkload, Status: status.Active, Since: s.at(2)},
+		}}},
+		&fakeHistoryAPI{entries: []client.StatusHistoryEntry{{
+			Kind:   status.KindMachine,
+			ID:     "0",
+			Status: status.DetailedStatus{Kind: status.KindMachine, Status: status.Started, Data: map[string]any{"foo": "bar"}, Since: s.at(1)},
+		}}},
+	}
+
+	ctx, err := cmdtesting.RunCommand(c, NewExportStatusHistoryCommandForTest(clients))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+{"kind":"workload","id":"foo/0","status":"maintenance","message":"installing","since":"2026-04-01T03:12:00Z"}
+{"kind":"juju-machine","id":"0","status":"started","data":{"foo":"bar"},"since":"2026-04-01T03:13:00Z"}
+{"kind":"workload","id":"foo/0","status":"active","since":"2026-04-01T03:14:00Z"}
+`[1:])
+}
+
+func (s *ExportStatusHistorySuite) TestExportRange(c *tc.C) {
+	clients := []HistoryAPI{
+		&fakeHistoryAPI{entries: []client.StatusHistoryEntry{{
+			Kind:   status.KindApplication,
+			ID:     "foo",
+			Status: status.DetailedStatus{Status: status.Waiting, Since: s.at(0)},
+		}, {
+			Kind:   status.KindApplication,
+			ID:     "foo",
+			Status: status.DetailedStatus{Status: status.Active, Since: s.at(5)},
+		}}},
+	}
+
+	ctx, err := cmdtesting.RunCommand(c, NewExportStatusHistoryCommandForTest(clients),
+		"--from", "2026-04-01T03:13:00Z", "--to", "2026-04-01T03:20:00Z")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+{"kind":"application","id":"foo","status":"active","since":"2026-04-01T03:17:00Z"}
+`[1:])
+}
+
+func (s *ExportStatusHistorySuite) TestExportControllerError(c *tc.C) {
+	clients := []HistoryAPI{
+		&fakeHistoryAPI{err: errors.New("boom")},
+		&fakeHistoryAPI{entries: []client.StatusHistoryEntry{{
+			Kind:   status.KindApplication,
+			ID:     "foo",
+			Status: status.DetailedStatus{Status: status.Active, Since: s.at(0)},
+		}}},
+	}
+
+	ctx, err := cmdtesting.RunCommand(c, NewExportStatusHistoryCommandForTest(clients))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "boom\n")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+{"kind":"application","id":"foo","status":"active","since":"2026-04-01T03:12:00Z"}
+`[1:])
+}
+
+func (s *ExportStatusHistorySuite) TestExportAllControllersFail(c *tc.C) {
+	clients := []HistoryAPI{
+		&fakeHistoryAPI{err: errors.New("boom")},
+	}
+	_, err := cmdtesting.RunCommand(c, NewExportStatusHistoryCommandForTest(clients))
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *ExportStatusHistorySuite) TestExportNotSupported(c *tc.C) {
+	clients := []HistoryAPI{
+		&fakeHistoryAPI{err: errors.NotSupportedf("exporting status history")},
+	}
+	_, err := cmdtesting.RunCommand(c, NewExportStatusHistoryCommandForTest(clients))
+	c.Assert(err, tc.ErrorMatches, "exporting status history is not supported by this controller")
+}
+
+func (s *ExportStatusHistorySuite) TestInitErrors(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, NewExportStatusHistoryCommandForTest(nil), "foo")
+	c.Check(err, tc.ErrorMatches, `unexpected arguments: \[foo\]`)
+
+	_, err = cmdtesting.RunCommand(c, NewExportStatusHistoryCommandForTest(nil), "--from", "yesterday")
+	c.Check(err, tc.ErrorMatches, `parsing --from: timestamp "yesterday" not valid`)
+
+	_, err = cmdtesting.RunCommand(c, NewExportStatusHistoryCommandForTest(nil), "--from", "2026-04-02", "--to", "2026-04-01")
+	c.Check(err, tc.ErrorMatches, `--to must be after --from`)
+}
+
+func (s *ExportStatusHistorySuite) TestParseTimestamp(c *tc.C) {
+	t, err := parseTimestamp("2026-04-01T03:12:00Z")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(t, tc.Equals, time.Date(2026, 4, 1, 3, 12, 0, 0, time.UTC))
+
+	for _, value := range []string{
+		"2026-04-01 03:12:00",
+		"2026-04-01T03:12:00",
+		"2026-04-01 03:12",
+		"2026-04-01T03:12",
+	} {
+		t, err := parseTimestamp(value)
+		c.Assert(err, tc.ErrorIsNil, tc.Commentf("%q", value))
+		c.Check(t.Equal(time.Date(2026, 4, 1, 3, 12, 0, 0, time.Local)), tc.IsTrue, tc.Commentf("%q", value))
+	}
+
+	t, err = parseTimestamp("2026-04-01")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(t.Equal(time.Date(2026, 4, 1, 0, 0, 0, 0, time.Local)), tc.IsTrue)
+}
@@ -325,10 +325,10 @@ func (s unitStatus) MarshalYAML() (any, error) {
 }
 
 type relationStatus struct {
-	Provider  string
-	Requirer  string
-	Interface string
-	Type      string
-	Status    string
-	Message   string
+	Provider  string `json:"provider"`
+	Requirer  string `json:"requirer"`
+	Interface string `json:"interface"`
+	Type      string `json:"type"`
+	Status    string `json:"status,omitempty"`
+	Message   string `json:"message,omitempty"`
 }
@@ -38,12 +38,16 @@ func NewStatusHistoryCommand() cmd.Command {
 	return modelcmd.Wrap(&statusHistoryCommand{})
 }
 
-// HistoryAPI is the API surface for the show-status-log command.
+// HistoryAPI is the API surface for reading status history.
 type HistoryAPI interface {
 	// StatusHistory returns the status history for the given entity tag
 	// and kind, filtered according to the provided filter.
 	StatusHistory(ctx context.Context, kind status.HistoryKind, tag names.Tag, filter status.StatusHistoryFilter) (status.History, error)
 
+	// ExportStatusHistory returns the status history of every entity in
+	// the model, optionally bounded by the given times.
+	ExportStatusHistory(ctx context.Context, from, to *time.Time) ([]client.StatusHistoryEntry, error)
+
 	// Close closes the API client.
 	Close() error
 }
@@ -341,7 +345,21 @@ func (c *statusHistoryCommand) getStatusHistoryClients(ctx context.Context, warn
 	if c.clients != nil {
 		return c.clients, true, nil
 	}
+	return getStatusHistoryClients(ctx, c, warningLogger)
+}
+
+// apiRootOpener opens API connections for a model command.
+type apiRootOpener interface {
+	NewAPIRoot(ctx context.Context) (api.Connection, error)
+	NewAPIRootWithDialOpts(ctx context.Context, dialOpts *api.DialOpts, overrideAddresses ...string) (api.Connection, error)
+}
 
+// getStatusHistoryClients returns a status history client for each
+// controller, as each controller only holds the status history it recorded.
+// The returned bool is true if only the connected controller could be used,
+// because it does not support reporting the details of the other
+// controllers.
+func getStatusHistoryClients(ctx context.Context, c apiRootOpener, warningLogger warningLogger) ([]HistoryAPI, bool, error) {
 	controllerClient, err := getControllerDetailsClient(ctx, c)
 	if err != nil {
 		return nil, false, errors.Annotatef(err, "getting controller addresses")
@@ -352,7 +370,7 @@ func (c *statusHistoryCommand) getStatusHistoryClients(ctx context.Context, warn
 	// that the controller details API is not supported, so we fall back to
 	// using the address of the connected controller only.
 	if controllerClient.BestAPIVersion() < 3 {
-		clients, err := c.getStatusHistoryClient(ctx)
+		clients, err := getSingleStatusHistoryClient(ctx, c)
 		return clients, true, err
 	}
 
@@ -360,7 +378,7 @@ func (c *statusHistoryCommand) getStatusHistoryClients(ctx context.Context, warn
 	// API, so we can get the addresses of all controllers.
 	controllers, err := controllerClient.ControllerDetails(ctx)
 	if errors.Is(err, errors.NotSupported) {
-		clients, err := c.getStatusHistoryClient(ctx)
+		clients, err := getSingleStatusHistoryClient(ctx, c)
 		return clients, true, err
 	} else if err != nil {
 		return nil, false, errors.Annotatef(err, "getting controller details")
@@ -380,7 +398,7 @@ func (c *statusHistoryCommand) getStatusHistoryClients(ctx context.Context, warn
 	return clients, false, nil
 }
 
-func (c *statusHistoryCommand) getStatusHistoryClient(ctx context.Context) ([]HistoryAPI, error) {
+func getSingleStatusHistoryClient(ctx context.Context, c apiRootOpener) ([]HistoryAPI, error) {
 	client, err := getStatusHistoryClient(ctx, c)
 	if err != nil {
 		return nil, err
@@ -401,7 +419,7 @@ type ControllerDetailsAPI interface {
 	Close() error
 }
 
-var getControllerDetailsClient = func(ctx context.Context, c *statusHistoryCommand) (ControllerDetailsAPI, error) {
+var getControllerDetailsClient = func(ctx context.Context, c apiRootOpener) (ControllerDetailsAPI, error) {
 	root, err := c.NewAPIRoot(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -409,7 +427,7 @@ var getControllerDetailsClient = func(ctx context.Context, c *statusHistoryComma
 	return highavailability.NewClient(root), nil
 }
 
-var getStatusHistoryClient = func(ctx context.Context, c *statusHistoryCommand) (HistoryAPI, error) {
+var getStatusHistoryClient = func(ctx context.Context, c apiRootOpener) (HistoryAPI, error) {
 	root, err := c.NewAPIRoot(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -417,7 +435,7 @@ var getStatusHistoryClient = func(ctx context.Context, c *statusHistoryCommand)
 	return client.NewClient(root, logger), nil
 }
 
-var getStatusHistoryClientForAddresses = func(ctx context.Context, c *statusHistoryCommand, addresses []string) (HistoryAPI, error) {
+var getStatusHistoryClientForAddresses = func(ctx context.Context, c apiRootOpener, addresses []string) (HistoryAPI, error) {
 	root, err := c.NewAPIRootWithDialOpts(ctx, &api.DialOpts{
 		DialTimeout: 5 * time.Second,
 		Timeout:     30 * time.Second,
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
+	"github.com/juju/juju/api/client/client"
 	"github.com/juju/juju/api/client/highavailability"
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/cmd/cmdtesting"
@@ -221,13 +222,13 @@ func (s *StatusHistorySuite) TestClientCompatibility(c *tc.C) {
   type: model
 `[1:]
 
-	s.PatchValue(&getControllerDetailsClient, func(_ context.Context, _ *statusHistoryCommand) (ControllerDetailsAPI, error) {
+	s.PatchValue(&getControllerDetailsClient, func(_ context.Context, _ apiRootOpener) (ControllerDetailsAPI, error) {
 		return &fakeControllerDetailsAPI{
 			apiVersion: 2,
 		}, nil
 	})
 	fake := s.singularHistoryAPI()
-	s.PatchValue(&getStatusHistoryClient, func(ctx context.Context, _ *statusHistoryCommand) (HistoryAPI, error) {
+	s.PatchValue(&getStatusHistoryClient, func(ctx context.Context, _ apiRootOpener) (HistoryAPI, error) {
 		return fake, nil
 	})
 
@@ -355,6 +356,7 @@ func (s *StatusHistorySuite) multipleClients() {
 type fakeHistoryAPI struct {
 	err     error
 	history status.History
+	entries []client.StatusHistoryEntry
 }
 
 func (*fakeHistoryAPI) Close() error {
@@ -365,6 +367,21 @@ func (f *fakeHistoryAPI) StatusHistory(ctx context.Context, kind status.HistoryK
 	return f.history, f.err
 }
 
+func (f *fakeHistoryAPI) ExportStatusHistory(ctx context.Context, from, to *time.Time) ([]client.StatusHistoryEntry, error) {
+	var entries []client.StatusHistoryEntry
+	for _, entry := range f.entries {
+		since := entry.Status.Since
+		if from != nil && (since == nil || !since.After(*from)) {
+			continue
+		}
+		if to != nil && (since == nil || since.After(*to)) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, f.err
+}
+
 type fakeControllerDetailsAPI struct {
 	details    map[string]highavailability.ControllerDetails
 	apiVersion int
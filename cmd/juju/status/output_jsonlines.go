@@ -0,0 +1,166 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"slices"
+
+	"github.com/juju/errors"
+)
+
+const (
+	deltaAdded   = "added"
+	deltaChanged = "changed"
+	deltaRemoved = "removed"
+)
+
+// Kinds of entity reported in the json-lines output, in the order they are
+// written.
+const (
+	entityMachine     = "machine"
+	entityApplication = "application"
+	entityUnit        = "unit"
+	entityRelation    = "relation"
+)
+
+var entityOrder = map[string]int{
+	entityMachine:     0,
+	entityApplication: 1,
+	entityUnit:        2,
+	entityRelation:    3,
+}
+
+// statusEntityKey identifies an entity in the json-lines output.
+type statusEntityKey struct {
+	Kind string
+	ID   string
+}
+
+// statusDelta is a single line of the json-lines output.
+type statusDelta struct {
+	Kind   string          `json:"kind"`
+	ID     string          `json:"id"`
+	Change string          `json:"change"`
+	Status json.RawMessage `json:"status,omitempty"`
+}
+
+// formatJSONLines writes a line for each machine, application, unit and
+// relation that has been added, changed or removed since the previous call.
+// The first call reports every entity as added.
+func (c *statusCommand) formatJSONLines(writer io.Writer, value any) error {
+	fs, ok := value.(formattedStatus)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", fs, value)
+	}
+	entities, err := statusEntities(fs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	encoder := json.NewEncoder(writer)
+	for _, delta := range statusDeltas(c.reported, entities) {
+		if err := encoder.Encode(delta); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	c.reported = entities
+	return nil
+}
+
+// statusDeltas returns the differences between two sets of entities,
+// ordered by kind and then id.
+func statusDeltas(previous, current map[statusEntityKey]json.RawMessage) []statusDelta {
+	var deltas []statusDelta
+	for key, status := range current {
+		old, ok := previous[key]
+		switch {
+		case !ok:
+			deltas = append(deltas, statusDelta{Kind: key.Kind, ID: key.ID, Change: deltaAdded, Status: status})
+		case string(old) != string(status):
+			deltas = append(deltas, statusDelta{Kind: key.Kind, ID: key.ID, Change: deltaChanged, Status: status})
+		}
+	}
+	for key := range previous {
+		if _, ok := current[key]; !ok {
+			deltas = append(deltas, statusDelta{Kind: key.Kind, ID: key.ID, Change: deltaRemoved})
+		}
+	}
+	slices.SortFunc(deltas, func(a, b statusDelta) int {
+		return cmp.Or(
+			cmp.Compare(entityOrder[a.Kind], entityOrder[b.Kind]),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+	return deltas
+}
+
+// statusEntities flattens the formatted status into the individual
+// entities reported in the json-lines output. Containers, units and
+// subordinate units are reported separately from the machines and
+// applications they belong to, so that a change to one of them is not
+// also reported as a change to its parent.
+func statusEntities(fs formattedStatus) (map[statusEntityKey]json.RawMessage, error) {
+	entities := make(map[statusEntityKey]json.RawMessage)
+	add := func(kind, id string, value any) error {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return errors.Annotatef(err, "marshalling %s %q", kind, id)
+		}
+		entities[statusEntityKey{Kind: kind, ID: id}] = data
+		return nil
+	}
+
+	var addMachines func(map[string]machineStatus) error
+	addMachines = func(machines map[string]machineStatus) error {
+		for id, machine := range machines {
+			containers := machine.Containers
+			machine.Containers = nil
+			if err := add(entityMachine, id, machine); err != nil {
+				return err
+			}
+			if err := addMachines(containers); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := addMachines(fs.Machines); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var addUnits func(map[string]unitStatus) error
+	addUnits = func(units map[string]unitStatus) error {
+		for id, unit := range units {
+			subordinates := unit.Subordinates
+			unit.Subordinates = nil
+			if err := add(entityUnit, id, unit); err != nil {
+				return err
+			}
+			if err := addUnits(subordinates); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for name, app := range fs.Applications {
+		units := app.Units
+		app.Units = nil
+		if err := add(entityApplication, name, app); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if err := addUnits(units); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	for _, rel := range fs.Relations {
+		if err := add(entityRelation, rel.Provider+" "+rel.Requirer, rel); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return entities, nil
+}
@@ -5,6 +5,7 @@ package status
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
+	"github.com/juju/worker/v5"
 
 	"github.com/juju/juju/api/client/client"
 	jujucmd "github.com/juju/juju/cmd"
@@ -23,6 +25,7 @@ import (
 	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/core/watcher"
 	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/juju/osenv"
 	"github.com/juju/juju/rpc/params"
@@ -30,8 +33,13 @@ import (
 
 var logger = internallogger.GetLogger("juju.cmd.juju.status")
 
+// clearScreen is the ANSI sequence that moves the cursor to the top left
+// of the terminal and clears it, used to redraw the tabular output.
+const clearScreen = "\x1b[H\x1b[2J"
+
 type statusAPI interface {
 	Status(context.Context, *client.StatusArgs) (*params.FullStatus, error)
+	WatchStatus(context.Context) (watcher.NotifyWatcher, error)
 	Close() error
 }
 
@@ -71,6 +79,22 @@ type statusCommand struct {
 
 	// storage indicates if 'storage' section is displayed
 	storage bool
+
+	// watch indicates if the status is reported again whenever it changes.
+	watch bool
+
+	// atArg and at hold the time to report the status of the model at,
+	// rebuilt from the status history.
+	atArg string
+	at    *time.Time
+
+	// historyClients are used instead of connecting to each controller
+	// to read the status history. They are only set by tests.
+	historyClients []HistoryAPI
+
+	// reported holds the entities written by the last json-lines report, so
+	// that subsequent reports only contain the deltas.
+	reported map[statusEntityKey]json.RawMessage
 }
 
 var usageSummary = `
@@ -101,6 +125,26 @@ Reports aggregated information about the model. Includes a description of subnet
 the counts of applications, units, and machines by status code.
 - ` + "`--format=json`" + `, ` + "`--format=yaml`" + `:
 Provides information in a ` + "`JSON`" + ` or ` + "`YAML`" + ` format for programmatic use.
+- ` + "`--format=json-lines`" + `:
+Reports each machine, application, unit and relation as a separate ` + "`JSON`" + ` object
+on its own line. With ` + "`--watch`" + `, only the entities that were added, changed or
+removed since the previous report are written.
+
+### Viewing past status
+
+The ` + "`--at`" + ` option reports the status of the model as it was at the given time,
+rebuilt from the status history recorded by the controllers. It accepts an
+RFC3339 timestamp, or a date and time in the local time zone in the form
+YYYY-MM-DD HH:MM or YYYY-MM-DD HH:MM:SS. Entities that have been removed from
+the model since are not shown, and details other than statuses, such as
+addresses, are as they are now.
+
+### Watching for changes
+
+The ` + "`--watch`" + ` option keeps the command running and reports the status again
+whenever a machine, application, unit or relation in the model changes, rather
+than polling the controller. In tabular format the screen is redrawn on each
+change. Press Ctrl-C to stop watching.
 
 `
 
@@ -124,6 +168,18 @@ Include information about storage and relations in output:
 Provide output as valid ` + "`JSON`" + `:
 
     juju status --format=json
+
+Redraw the status whenever the model changes:
+
+    juju status --watch
+
+Stream the changes to the model as ` + "`JSON`" + ` lines for a script:
+
+    juju status --watch --format=json-lines
+
+Report the status of the model as it was at 03:12 on 1 April 2026:
+
+    juju status --at "2026-04-01 03:12"
 `
 
 func (c *statusCommand) Info() *cmd.Info {
@@ -137,6 +193,7 @@ func (c *statusCommand) Info() *cmd.Info {
 			"machines",
 			"show-model",
 			"show-status-log",
+			"export-status-history",
 			"storage",
 		},
 	})
@@ -151,6 +208,8 @@ func (c *statusCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.integrations, "integrations", false, "Same as `--relations`")
 	f.BoolVar(&c.relations, "relations", false, "Show relations section in tabular output")
 	f.BoolVar(&c.storage, "storage", false, "Show storage section in tabular output")
+	f.BoolVar(&c.watch, "watch", false, "Report the status again whenever the model changes")
+	f.StringVar(&c.atArg, "at", "", "Report the status of the model at a past time")
 
 	f.IntVar(&c.retryCount, "retry-count", 3, "Number of times to retry API failures")
 	f.DurationVar(&c.retryDelay, "retry-delay", 100*time.Millisecond, "Time to wait between retry attempts")
@@ -181,6 +240,8 @@ func (c *statusCommand) SetFlags(f *gnuflag.FlagSet) {
 		"line":    c.formatOneline,
 		"tabular": c.FormatTabular,
 		"summary": c.formatSummary,
+
+		"json-lines": c.formatJSONLines,
 	})
 }
 
@@ -205,6 +266,17 @@ func (c *statusCommand) Init(args []string) error {
 		return errors.Errorf("cannot mix --no-color and --color")
 	}
 
+	if c.atArg != "" {
+		if c.watch {
+			return errors.Errorf("cannot mix --at and --watch")
+		}
+		at, err := parseTimestamp(c.atArg)
+		if err != nil {
+			return errors.Annotate(err, "parsing --at")
+		}
+		c.at = &at
+	}
+
 	return nil
 }
 
@@ -257,6 +329,50 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 		}
 	}
 
+	if c.watch {
+		return c.watchStatus(ctx, showIntegrations, showStorage)
+	}
+	return c.reportStatus(ctx, showIntegrations, showStorage)
+}
+
+// watchStatus reports the status each time the model status watcher
+// fires, until the command is interrupted. The watcher fires once when it
+// starts, which produces the initial report.
+func (c *statusCommand) watchStatus(ctx *cmd.Context, showIntegrations, showStorage bool) error {
+	apiclient, err := c.getStatusAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	w, err := apiclient.WatchStatus(ctx)
+	if errors.Is(err, errors.NotSupported) {
+		return errors.New("--watch is not supported by this controller")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = worker.Stop(w) }()
+
+	redraw := c.out.Name() == "tabular" && isTerminal(ctx.Stdout)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-w.Changes():
+			if !ok {
+				return errors.Annotate(w.Wait(), "watching status")
+			}
+		}
+		if redraw {
+			fmt.Fprint(ctx.Stdout, clearScreen)
+		}
+		if err := c.reportStatus(ctx, showIntegrations, showStorage); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+// reportStatus gets the status of the model and writes it out in the
+// requested format.
+func (c *statusCommand) reportStatus(ctx *cmd.Context, showIntegrations, showStorage bool) error {
 	// Always attempt to get the status at least once, and retry if it fails.
 	status, err := c.getStatus(ctx, showStorage)
 	if err != nil && !modelcmd.IsModelMigratedError(err) {
@@ -283,6 +399,12 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 		return errors.Errorf("unable to obtain the current status")
 	}
 
+	if c.at != nil {
+		if err := c.rewindStatusTo(ctx, status, *c.at); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	controllerName, err := c.ControllerName()
 	if err != nil {
 		return errors.Trace(err)
@@ -319,7 +441,7 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 		return err
 	}
 
-	if !status.IsEmpty() {
+	if !status.IsEmpty() || c.out.Name() == "json-lines" {
 		return nil
 	}
 	if len(c.patterns) == 0 {
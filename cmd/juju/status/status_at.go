@@ -0,0 +1,217 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"time"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/rpc/params"
+)
+
+// rewindStatusTo replaces the statuses in the full status with those the
+// model had at the given time, read from the status history of each
+// controller.
+func (c *statusCommand) rewindStatusTo(ctx *cmd.Context, fullStatus *params.FullStatus, at time.Time) error {
+	clients := c.historyClients
+	if clients == nil {
+		var err error
+		if clients, _, err = getStatusHistoryClients(ctx, c, ctx); err != nil {
+			return errors.Trace(err)
+		} else if len(clients) == 0 {
+			return errors.New("no controller status-history clients available; is bootstrap still in progress?")
+		}
+		defer func() {
+			for _, client := range clients {
+				_ = client.Close()
+			}
+		}()
+	}
+
+	// The whole history is needed, not just the history up to the
+	// requested time, so that entities created since can be identified.
+	entries, err := exportStatusHistory(ctx, clients, nil, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	rewindStatus(fullStatus, entries, at)
+	return nil
+}
+
+// historyKey identifies the status history of a single entity.
+type historyKey struct {
+	kind status.HistoryKind
+	id   string
+}
+
+// rewindStatus replaces the status of each entity in the full status with
+// the latest status recorded for it at or before the given time. Entities
+// known to have been created after that time, because the status they were
+// created with is their earliest history and is from after the time, did
+// not exist yet, so they are removed. Older history may have been rotated
+// away, so entities whose retained history merely starts after the time
+// are kept. Entities without any history are left as they are, as are
+// entities that have since been removed from the model, which cannot be
+// shown.
+func rewindStatus(fullStatus *params.FullStatus, entries []client.StatusHistoryEntry, at time.Time) {
+	latest := make(map[historyKey]status.DetailedStatus)
+	earliest := make(map[string]time.Time)
+	created := make(map[string]time.Time)
+	for _, entry := range entries {
+		since := entry.Status.Since
+		if since == nil {
+			continue
+		}
+		entity := historyEntity(entry.Kind, entry.ID)
+		if first, ok := earliest[entity]; !ok || since.Before(first) {
+			earliest[entity] = *since
+		}
+		if isCreationStatus(entry.Kind, entry.Status.Status) {
+			if first, ok := created[entity]; !ok || since.Before(first) {
+				created[entity] = *since
+			}
+		}
+		if since.After(at) {
+			continue
+		}
+
+		key := historyKey{kind: historyEntryKind(entry.Kind), id: entry.ID}
+		if prev, ok := latest[key]; !ok || !prev.Since.After(*since) {
+			latest[key] = entry.Status
+		}
+	}
+	// notYetCreated returns true if the entity's earliest history is the
+	// status it was created with, and that is from after the requested
+	// time.
+	notYetCreated := func(kind status.HistoryKind, id string) bool {
+		entity := historyEntity(kind, id)
+		createdAt, ok := created[entity]
+		return ok && !createdAt.After(earliest[entity]) && createdAt.After(at)
+	}
+	rewind := func(current *params.DetailedStatus, kind status.HistoryKind, id string) {
+		s, ok := latest[historyKey{kind: kind, id: id}]
+		if !ok {
+			return
+		}
+		current.Status = s.Status.String()
+		current.Info = s.Info
+		current.Data = s.Data
+		current.Since = s.Since
+	}
+
+	for key := range latest {
+		if key.kind == status.KindModel {
+			rewind(&fullStatus.Model.ModelStatus, status.KindModel, key.id)
+		}
+	}
+
+	var rewindMachines func(map[string]params.MachineStatus)
+	rewindMachines = func(machines map[string]params.MachineStatus) {
+		for id, machine := range machines {
+			if notYetCreated(status.KindMachine, id) {
+				delete(machines, id)
+				continue
+			}
+			rewind(&machine.AgentStatus, status.KindMachine, id)
+			rewind(&machine.InstanceStatus, status.KindMachineInstance, id)
+			rewindMachines(machine.Containers)
+			machines[id] = machine
+		}
+	}
+	rewindMachines(fullStatus.Machines)
+
+	var rewindUnits func(map[string]params.UnitStatus)
+	rewindUnits = func(units map[string]params.UnitStatus) {
+		for name, unit := range units {
+			if notYetCreated(status.KindUnit, name) {
+				delete(units, name)
+				continue
+			}
+			rewind(&unit.WorkloadStatus, status.KindWorkload, name)
+			rewind(&unit.AgentStatus, status.KindUnitAgent, name)
+			rewindUnits(unit.Subordinates)
+			units[name] = unit
+		}
+	}
+	removedApps := set.NewStrings()
+	for name, app := range fullStatus.Applications {
+		if notYetCreated(status.KindApplication, name) {
+			delete(fullStatus.Applications, name)
+			removedApps.Add(name)
+			continue
+		}
+		rewind(&app.Status, status.KindApplication, name)
+		rewindUnits(app.Units)
+		fullStatus.Applications[name] = app
+	}
+
+	for name, app := range fullStatus.RemoteApplicationOfferers {
+		if notYetCreated(status.KindSAAS, name) {
+			delete(fullStatus.RemoteApplicationOfferers, name)
+			removedApps.Add(name)
+			continue
+		}
+		rewind(&app.Status, status.KindSAAS, name)
+		fullStatus.RemoteApplicationOfferers[name] = app
+	}
+
+	relations := fullStatus.Relations[:0]
+	for _, rel := range fullStatus.Relations {
+		removed := false
+		for _, ep := range rel.Endpoints {
+			removed = removed || removedApps.Contains(ep.ApplicationName)
+		}
+		if !removed {
+			relations = append(relations, rel)
+		}
+	}
+	fullStatus.Relations = relations
+
+	fullStatus.ControllerTimestamp = &at
+}
+
+// isCreationStatus returns true if the status is the one an entity's
+// history starts with when the entity is created: pending for machines and
+// their instances, allocating for unit agents and unset for applications.
+func isCreationStatus(kind status.HistoryKind, s status.Status) bool {
+	switch kind {
+	case status.KindMachine, status.KindMachineInstance, status.KindContainer, status.KindContainerInstance:
+		return s == status.Pending
+	case status.KindUnitAgent:
+		return s == status.Allocating
+	case status.KindApplication:
+		return s == status.Unset
+	}
+	return false
+}
+
+// historyEntryKind returns the kind under which a history entry is
+// applied. Container statuses are recorded as machine statuses.
+func historyEntryKind(kind status.HistoryKind) status.HistoryKind {
+	switch kind {
+	case status.KindContainer:
+		return status.KindMachine
+	case status.KindContainerInstance:
+		return status.KindMachineInstance
+	}
+	return kind
+}
+
+// historyEntity returns the name of the entity a history entry belongs to,
+// so that the agent and workload histories of a unit, or the agent and
+// instance histories of a machine, are treated as one entity.
+func historyEntity(kind status.HistoryKind, id string) string {
+	switch kind {
+	case status.KindUnit, status.KindUnitAgent, status.KindWorkload:
+		return "unit-" + id
+	case status.KindMachine, status.KindMachineInstance, status.KindContainer, status.KindContainerInstance:
+		return "machine-" + id
+	}
+	return string(kind) + "-" + id
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/rpc/params"
+)
+
+func (s *MinimalStatusSuite) TestRewindStatus(c *tc.C) {
+	at := time.Date(2026, 4, 1, 3, 12, 0, 0, time.UTC)
+	before := at.Add(-time.Minute)
+	after := at.Add(time.Minute)
+	now := at.Add(time.Hour)
+
+	fullStatus := &params.FullStatus{
+		Model: params.ModelStatusInfo{
+			ModelStatus: params.DetailedStatus{Status: "available", Since: &now},
+		},
+		Machines: map[string]params.MachineStatus{
+			"0": {
+				AgentStatus:    params.DetailedStatus{Status: "started", Since: &now},
+				InstanceStatus: params.DetailedStatus{Status: "running", Since: &now},
+				Containers: map[string]params.MachineStatus{
+					"0/lxd/0": {AgentStatus: params.DetailedStatus{Status: "started", Since: &now}},
+				},
+			},
+			"1": {AgentStatus: params.DetailedStatus{Status: "started", Since: &now}},
+			"2": {AgentStatus: params.DetailedStatus{Status: "started", Since: &now}},
+		},
+		Applications: map[string]params.ApplicationStatus{
+			"foo": {
+				Status: params.DetailedStatus{Status: "active", Since: &now},
+				Units: map[string]params.UnitStatus{
+					"foo/0": {
+						WorkloadStatus: params.DetailedStatus{Status: "active", Info: "ready", Since: &now},
+						AgentStatus:    params.DetailedStatus{Status: "idle", Since: &now},
+					},
+					"foo/1": {
+						WorkloadStatus: params.DetailedStatus{Status: "active", Since: &now},
+					},
+					"foo/2": {
+						WorkloadStatus: params.DetailedStatus{Status: "active", Since: &now},
+						AgentStatus:    params.DetailedStatus{Status: "idle", Since: &now},
+					},
+				},
+			},
+			"bar": {Status: params.DetailedStatus{Status: "active", Since: &now}},
+		},
+		Relations: []params.RelationStatus{{
+			Key:       "bar:db foo:db",
+			Endpoints: []params.EndpointStatus{{ApplicationName: "bar"}, {ApplicationName: "foo"}},
+		}},
+	}
+	entries := []client.StatusHistoryEntry{
+		{Kind: status.KindModel, ID: "model-uuid", Status: status.DetailedStatus{Status: status.Busy, Since: &before}},
+		{Kind: status.KindMachine, ID: "0", Status: status.DetailedStatus{Status: status.Pending, Since: &before}},
+		{Kind: status.KindMachineInstance, ID: "0", Status: status.DetailedStatus{Status: status.Provisioning, Info: "starting", Since: &before}},
+		{Kind: status.KindMachine, ID: "0", Status: status.DetailedStatus{Status: status.Started, Since: &after}},
+		{Kind: status.KindMachine, ID: "0/lxd/0", Status: status.DetailedStatus{Status: status.Pending, Since: &after}},
+		{Kind: status.KindMachine, ID: "1", Status: status.DetailedStatus{Status: status.Pending, Since: &after}},
+		{Kind: status.KindMachine, ID: "2", Status: status.DetailedStatus{Status: status.Started, Since: &after}},
+		{Kind: status.KindApplication, ID: "foo", Status: status.DetailedStatus{Status: status.Waiting, Since: &before}},
+		{Kind: status.KindWorkload, ID: "foo/0", Status: status.DetailedStatus{Status: status.Maintenance, Info: "installing", Since: &before}},
+		{Kind: status.KindUnitAgent, ID: "foo/0", Status: status.DetailedStatus{Status: status.Executing, Since: &before}},
+		{Kind: status.KindWorkload, ID: "foo/1", Status: status.DetailedStatus{Status: status.Waiting, Since: &after}},
+		{Kind: status.KindUnitAgent, ID: "foo/1", Status: status.DetailedStatus{Status: status.Allocating, Since: &after}},
+		{Kind: status.KindUnitAgent, ID: "foo/2", Status: status.DetailedStatus{Status: status.Idle, Since: &after}},
+		{Kind: status.KindApplication, ID: "bar", Status: status.DetailedStatus{Status: status.Unset, Since: &after}},
+	}
+
+	rewindStatus(fullStatus, entries, at)
+
+	c.Check(fullStatus.ControllerTimestamp, tc.DeepEquals, &at)
+	c.Check(fullStatus.Model.ModelStatus, tc.DeepEquals, params.DetailedStatus{Status: "busy", Since: &before})
+
+	// Machine 1 and container 0/lxd/0 were created after the time. The
+	// retained history of machine 2 starts after the time, but not with its
+	// creation, so it existed and its older history has been rotated away.
+	c.Assert(fullStatus.Machines, tc.HasLen, 2)
+	c.Check(fullStatus.Machines["2"].AgentStatus, tc.DeepEquals, params.DetailedStatus{Status: "started", Since: &now})
+	machine := fullStatus.Machines["0"]
+	c.Check(machine.AgentStatus, tc.DeepEquals, params.DetailedStatus{Status: "pending", Since: &before})
+	c.Check(machine.InstanceStatus, tc.DeepEquals, params.DetailedStatus{Status: "allocating", Info: "starting", Since: &before})
+	c.Check(machine.Containers, tc.HasLen, 0)
+
+	// Application bar and unit foo/1 were created after the time, so the
+	// relation between foo and bar did not exist either.
+	c.Assert(fullStatus.Applications, tc.HasLen, 1)
+	app := fullStatus.Applications["foo"]
+	c.Check(app.Status, tc.DeepEquals, params.DetailedStatus{Status: "waiting", Since: &before})
+	c.Assert(app.Units, tc.HasLen, 2)
+	c.Check(app.Units["foo/2"].AgentStatus, tc.DeepEquals, params.DetailedStatus{Status: "idle", Since: &now})
+	c.Check(app.Units["foo/0"].WorkloadStatus, tc.DeepEquals, params.DetailedStatus{Status: "maintenance", Info: "installing", Since: &before})
+	c.Check(app.Units["foo/0"].AgentStatus, tc.DeepEquals, params.DetailedStatus{Status: "executing", Since: &before})
+	c.Check(fullStatus.Relations, tc.HasLen, 0)
+}
+
+func (s *MinimalStatusSuite) TestStatusAt(c *tc.C) {
+	at := time.Date(2026, 4, 1, 3, 12, 0, 0, time.UTC)
+	before := at.Add(-time.Minute)
+	clients := []HistoryAPI{
+		&fakeHistoryAPI{entries: []client.StatusHistoryEntry{{
+			Kind:   status.KindModel,
+			ID:     "model-uuid",
+			Status: status.DetailedStatus{Status: status.Busy, Since: &before},
+		}}},
+	}
+
+	s.statusapi.expectIncludeStorage = true
+	statusCmd := NewStatusCommandWithHistoryForTest(s.store, s.statusapi, clients, s.clock)
+	ctx, err := cmdtesting.RunCommand(c, statusCmd, "--no-color", "--utc", "--at", "2026-04-01T03:12:00Z", "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+model:
+  name: test
+  type: ""
+  controller: kontroll
+  cloud: foo
+  version: ""
+  model-status:
+    current: busy
+    since: 2026-04-01 03:11:00Z
+machines: {}
+applications: {}
+storage: {}
+controller:
+  timestamp: "03:12:00"
+`[1:])
+}
+
+func (s *MinimalStatusSuite) TestStatusAtWithWatch(c *tc.C) {
+	_, err := s.runStatus(c, "--at", "2026-04-01", "--watch")
+	c.Assert(err, tc.ErrorMatches, "cannot mix --at and --watch")
+}
+
+func (s *MinimalStatusSuite) TestStatusAtInvalid(c *tc.C) {
+	_, err := s.runStatus(c, "--at", "yesterday")
+	c.Assert(err, tc.ErrorMatches, `parsing --at: timestamp "yesterday" not valid`)
+}
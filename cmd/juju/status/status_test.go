@@ -6,9 +6,11 @@ package status
 import (
 	"context"
 	"errors"
+	"fmt"
 	stdtesting "testing"
 	"time"
 
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/client/client"
@@ -17,6 +19,8 @@ import (
 	"github.com/juju/juju/cmd/cmd/cmdtesting"
 	coremodel "github.com/juju/juju/core/model"
 	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
@@ -95,6 +99,121 @@ transcode/1   shared-fs/0   filesystem  radiance  /mnt/huang  1.0 GiB  attached
 `[1:])
 }
 
+func (s *MinimalStatusSuite) TestWatch(c *tc.C) {
+	changes := make(chan struct{}, 2)
+	changes <- struct{}{}
+	changes <- struct{}{}
+	s.statusapi.watcher = watchertest.NewMockNotifyWatcher(changes)
+
+	ctx, cancel := context.WithCancel(c.Context())
+	defer cancel()
+	s.statusapi.onStatus = func(call int) {
+		s.statusapi.result.Model.Version = fmt.Sprintf("4.0.%d", call)
+		if call == 2 {
+			cancel()
+		}
+	}
+
+	cmdCtx := cmdtesting.Context(c)
+	cmdCtx.Context = ctx
+	statusCmd := NewStatusCommandForTest(s.store, s.statusapi, s.clock)
+	err := cmdtesting.InitCommand(statusCmd, []string{"--no-color", "--watch"})
+	c.Assert(err, tc.ErrorIsNil)
+	err = statusCmd.Run(cmdCtx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.statusapi.calls, tc.Equals, 2)
+
+	c.Check(cmdtesting.Stdout(cmdCtx), tc.Equals, `
+Model  Controller  Cloud/Region  Version
+test   kontroll    foo           4.0.1  
+Model  Controller  Cloud/Region  Version
+test   kontroll    foo           4.0.2  
+`[1:])
+}
+
+func (s *MinimalStatusSuite) TestWatchJSONLines(c *tc.C) {
+	changes := make(chan struct{}, 2)
+	changes <- struct{}{}
+	changes <- struct{}{}
+	s.statusapi.watcher = watchertest.NewMockNotifyWatcher(changes)
+	s.statusapi.expectIncludeStorage = true
+	s.statusapi.result.Machines = map[string]params.MachineStatus{
+		"0": {Id: "0", InstanceId: "inst-0"},
+		"1": {Id: "1", InstanceId: "inst-1"},
+	}
+
+	ctx, cancel := context.WithCancel(c.Context())
+	defer cancel()
+	s.statusapi.onStatus = func(call int) {
+		if call == 2 {
+			cancel()
+			s.statusapi.result.Machines = map[string]params.MachineStatus{
+				"0": {Id: "0", InstanceId: "inst-0", Hostname: "juju-0"},
+				"2": {Id: "2", InstanceId: "inst-2"},
+			}
+		}
+	}
+
+	cmdCtx := cmdtesting.Context(c)
+	cmdCtx.Context = ctx
+	statusCmd := NewStatusCommandForTest(s.store, s.statusapi, s.clock)
+	err := cmdtesting.InitCommand(statusCmd, []string{"--no-color", "--watch", "--format", "json-lines"})
+	c.Assert(err, tc.ErrorIsNil)
+	err = statusCmd.Run(cmdCtx)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(cmdtesting.Stdout(cmdCtx), tc.Equals, `
+{"kind":"machine","id":"0","change":"added","status":{"juju-status":{},"instance-id":"inst-0","machine-status":{},"modification-status":{}}}
+{"kind":"machine","id":"1","change":"added","status":{"juju-status":{},"instance-id":"inst-1","machine-status":{},"modification-status":{}}}
+{"kind":"machine","id":"0","change":"changed","status":{"juju-status":{},"hostname":"juju-0","instance-id":"inst-0","machine-status":{},"modification-status":{}}}
+{"kind":"machine","id":"1","change":"removed"}
+{"kind":"machine","id":"2","change":"added","status":{"juju-status":{},"instance-id":"inst-2","machine-status":{},"modification-status":{}}}
+`[1:])
+}
+
+func (s *MinimalStatusSuite) TestStatusEntitiesFlattensChildren(c *tc.C) {
+	entities, err := statusEntities(formattedStatus{
+		Machines: map[string]machineStatus{
+			"0": {InstanceId: "inst-0", Containers: map[string]machineStatus{
+				"0/lxd/0": {InstanceId: "inst-1"},
+			}},
+		},
+		Applications: map[string]applicationStatus{
+			"foo": {Charm: "foo", Units: map[string]unitStatus{
+				"foo/0": {Machine: "0", Subordinates: map[string]unitStatus{
+					"bar/0": {},
+				}},
+			}},
+		},
+		Relations: []relationStatus{{
+			Provider: "foo:db", Requirer: "baz:db", Interface: "mysql", Type: "regular",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	keys := make([]statusEntityKey, 0, len(entities))
+	for key := range entities {
+		keys = append(keys, key)
+	}
+	c.Check(keys, tc.SameContents, []statusEntityKey{
+		{Kind: entityMachine, ID: "0"},
+		{Kind: entityMachine, ID: "0/lxd/0"},
+		{Kind: entityApplication, ID: "foo"},
+		{Kind: entityUnit, ID: "foo/0"},
+		{Kind: entityUnit, ID: "bar/0"},
+		{Kind: entityRelation, ID: "foo:db baz:db"},
+	})
+	c.Check(string(entities[statusEntityKey{Kind: entityUnit, ID: "foo/0"}]), tc.Equals,
+		`{"workload-status":{},"juju-status":{},"machine":"0"}`)
+	c.Check(string(entities[statusEntityKey{Kind: entityRelation, ID: "foo:db baz:db"}]), tc.Equals,
+		`{"provider":"foo:db","requirer":"baz:db","interface":"mysql","type":"regular"}`)
+}
+
+func (s *MinimalStatusSuite) TestWatchNotSupported(c *tc.C) {
+	_, err := s.runStatus(c, "--watch")
+	c.Assert(err, tc.ErrorMatches, "--watch is not supported by this controller")
+}
+
 func (s *MinimalStatusSuite) TestRetryOnError(c *tc.C) {
 	s.statusapi.errors = []error{
 		errors.New("boom"),
@@ -156,6 +275,9 @@ type fakeStatusAPI struct {
 	result               *params.FullStatus
 	patterns             []string
 	errors               []error
+	watcher              watcher.NotifyWatcher
+	calls                int
+	onStatus             func(call int)
 }
 
 func (f *fakeStatusAPI) Status(ctx context.Context, args *client.StatusArgs) (*params.FullStatus, error) {
@@ -163,6 +285,10 @@ func (f *fakeStatusAPI) Status(ctx context.Context, args *client.StatusArgs) (*p
 		return nil, errors.New("IncludeStorage arg mismatch")
 	}
 	f.patterns = args.Patterns
+	f.calls++
+	if f.onStatus != nil {
+		f.onStatus(f.calls)
+	}
 	if len(f.errors) > 0 {
 		err, rest := f.errors[0], f.errors[1:]
 		f.errors = rest
@@ -174,6 +300,13 @@ func (f *fakeStatusAPI) Status(ctx context.Context, args *client.StatusArgs) (*p
 	return f.result, nil
 }
 
+func (f *fakeStatusAPI) WatchStatus(context.Context) (watcher.NotifyWatcher, error) {
+	if f.watcher == nil {
+		return nil, jujuerrors.NotSupportedf("watching status")
+	}
+	return f.watcher, nil
+}
+
 func (*fakeStatusAPI) Close() error {
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"github.com/juju/names/v6"
 
 	jujucmd "github.com/juju/juju/cmd"
@@ -76,6 +77,12 @@ by the charm, which can specify a maximum number of storage instances per unit.
 positive number, followed by a size suffix.  Valid suffixes include M, G, T,
 and P.  Defaults to "1024M", or the which can specify a minimum size required
 by the charm.
+
+Storage can be created from a snapshot of other storage, taken with
+` + "`juju create-storage-snapshot`" + `, by passing the snapshot ID with
+` + "`--from-snapshot`" + `. Only one storage directive may be given with
+` + "`--from-snapshot`" + `, and its pool must support creating storage from
+snapshots.
 `
 
 	addCommandExamples = `
@@ -97,6 +104,11 @@ using the default count (` + "`1`" + `) and storage pool
 (e.g., on AWS, the ` + "`ebs`" + ` pool; equivalent to spelling out ` + "`pgdata=ebs,100G,1`)" + `:
 
     juju deploy postgresql --storage pgdata=100G
+
+Add storage for ` + "`pgdata`" + ` to unit ` + "`postgresql/1`" + ` from a snapshot of the
+storage of another unit:
+
+    juju add-storage postgresql/1 pgdata --from-snapshot default:juju-pgdata-0/nightly
 `
 
 	addCommandAgs = `<unit> <storage-directive>`
@@ -112,6 +124,15 @@ type addCommand struct {
 	// defined in charm storage metadata.
 	storageDirectives map[string]storage.Directive
 	newAPIFunc        func(ctx context.Context) (StorageAddAPI, error)
+
+	// snapshotId is the ID of a snapshot to create the storage from.
+	snapshotId string
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *addCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	f.StringVar(&c.snapshotId, "from-snapshot", "", "The ID of a snapshot to create the storage from")
 }
 
 // Init implements Command.Init.
@@ -127,7 +148,13 @@ func (c *addCommand) Init(args []string) (err error) {
 	c.unitTag = names.NewUnitTag(u)
 
 	c.storageDirectives, err = storage.ParseDirectivesMap(args[1:], false)
-	return
+	if err != nil {
+		return err
+	}
+	if c.snapshotId != "" && len(c.storageDirectives) != 1 {
+		return errors.New("--from-snapshot requires exactly one storage directive")
+	}
+	return nil
 }
 
 // Info implements Command.Info.
@@ -139,6 +166,7 @@ func (c *addCommand) Info() *cmd.Info {
 		Args:     addCommandAgs,
 		Examples: addCommandExamples,
 		SeeAlso: []string{
+			"create-storage-snapshot",
 			"import-filesystem",
 			"storage",
 			"storage-pools",
@@ -223,6 +251,7 @@ func (c *addCommand) createStorageAddParams() []params.StorageAddParams {
 				SizeMiB: &d.Size,
 				Count:   &d.Count,
 			},
+			SnapshotId: c.snapshotId,
 		})
 	}
 
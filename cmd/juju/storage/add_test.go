@@ -245,6 +245,31 @@ func (s *addSuite) TestUnauthorizedMentionsJujuGrant(c *tc.C) {
 	c.Assert(errString, tc.Matches, `.*juju grant.*`)
 }
 
+func (s *addSuite) TestAddFromSnapshot(c *tc.C) {
+	var added []params.StorageAddParams
+	s.mockAPI.addToUnitFunc = func(storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
+		added = storages
+		return []params.AddStorageResult{{
+			Result: &params.AddStorageDetails{
+				StorageTags: []string{"storage-data-1"},
+			},
+		}}, nil
+	}
+
+	context, err := s.runAdd(c, "tst/123", "data", "--from-snapshot", "default:data-0/nightly")
+	c.Assert(err, tc.ErrorIsNil)
+	s.assertExpectedOutput(c, context, "added storage data/1 to tst/123\n")
+	c.Assert(added, tc.HasLen, 1)
+	c.Check(added[0].StorageName, tc.Equals, "data")
+	c.Check(added[0].SnapshotId, tc.Equals, "default:data-0/nightly")
+}
+
+func (s *addSuite) TestAddFromSnapshotMultipleDirectives(c *tc.C) {
+	s.args = []string{"tst/123", "data", "logs", "--from-snapshot", "snap-1"}
+	expectedErr := "--from-snapshot requires exactly one storage directive"
+	s.assertAddErrorOutput(c, expectedErr, visibleErrorMessage(expectedErr))
+}
+
 func (s *addSuite) assertAddErrorOutput(c *tc.C, expected string, expectedErr string) {
 	context, err := s.runAdd(c, s.args...)
 	c.Assert(errors.Cause(err), tc.ErrorMatches, expected)
@@ -92,3 +92,17 @@ func NewDetachStorageCommandForTest(new NewEntityDetacherCloserFunc, store jujuc
 	cmd.newEntityDetacherCloser = new
 	return modelcmd.Wrap(cmd)
 }
+
+func NewResizeStorageCommandForTest(new NewStorageResizerCloserFunc, store jujuclient.ClientStore) cmd.Command {
+	cmd := &resizeStorageCommand{}
+	cmd.SetClientStore(store)
+	cmd.newStorageResizerCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
+func NewCreateStorageSnapshotCommandForTest(new NewStorageSnapshotterCloserFunc, store jujuclient.ClientStore) cmd.Command {
+	cmd := &createStorageSnapshotCommand{}
+	cmd.SetClientStore(store)
+	cmd.newStorageSnapshotterCloser = new
+	return modelcmd.Wrap(cmd)
+}
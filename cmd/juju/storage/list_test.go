@@ -119,6 +119,11 @@ storage:
       units:
         transcode/0:
           location: there
+          usage:
+            size: 2048
+            used: 1536
+            used-percent: 75
+            updated: %s
         transcode/1:
           location: here
 filesystems:
@@ -287,7 +292,7 @@ volumes:
     status:
       current: attached
       since: %s
-`[1:], repeat(since, 15)...))
+`[1:], repeat(since, 16)...))
 }
 
 func (s *ListSuite) TestListInitErrors(c *tc.C) {
@@ -386,6 +391,11 @@ func (s *mockListAPI) ListStorageDetails(ctx context.Context) ([]params.StorageD
 		Attachments: map[string]params.StorageAttachmentDetails{
 			"unit-transcode-0": {
 				Location: "there",
+				Usage: &params.StorageUsage{
+					SizeMiB: 2048,
+					UsedMiB: 1536,
+					Updated: s.time,
+				},
 			},
 			"unit-transcode-1": {
 				Location: "here",
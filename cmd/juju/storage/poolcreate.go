@@ -29,6 +29,15 @@ type PoolCreateAPI interface {
 var disallowedAttrKeys = []string{"name", "type"}
 
 const poolCreateCommandDoc = `
+Setting the encrypt=true attribute on a pool of volume-backed filesystems has
+the machine agent set up LUKS encryption on each volume before creating the
+filesystem. The key is generated by the controller and stored in a model
+secret labelled storage-encryption-key-<storage id>. Charms cannot read,
+change or remove the secret, and it is kept when the units are removed, so
+that detached storage can be unlocked again. While the storage exists the
+secret cannot be removed nor its content changed. The secret is kept when the
+storage is removed.
+
 Further reading:
 
 - https://documentation.ubuntu.com/juju/3.6/reference/storage/#storage-pool
@@ -38,6 +47,7 @@ Further reading:
 
 const poolCreateCommandExamples = `
     juju create-storage-pool ebsrotary ebs volume-type=standard
+    juju create-storage-pool secure loop encrypt=true
     juju create-storage-pool gcepd storage-provisioner=kubernetes.io/gce-pd [storage-mode=RWX|RWO|ROX] parameters.type=pd-standard
 
 `
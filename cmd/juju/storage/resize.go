@@ -0,0 +1,139 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/utils/v4"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewResizeStorageCommandWithAPI returns a command
+// used to grow existing storage.
+func NewResizeStorageCommandWithAPI() cmd.Command {
+	command := &resizeStorageCommand{}
+	command.newStorageResizerCloser = func(ctx context.Context) (StorageResizerCloser, error) {
+		return command.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(command)
+}
+
+const (
+	resizeStorageCommandDoc = `
+Grows an existing storage instance to the specified size. Specify the
+storage ID (storage_name/id), as output by ` + "`juju storage`" + `, and
+the new size with --size, using the usual M, G, T and P suffixes.
+
+Storage can only be grown; requests to shrink storage are rejected, as
+are requests to resize storage whose provider does not support resizing.
+The resize is carried out online by the storage provisioner. Filesystems
+on resized volumes are grown to fill the volume on the machine.
+
+Charms are not notified when their storage grows. Charms that need to
+act on the new size have to check it themselves, for example from the
+update-status hook.
+`
+
+	resizeStorageCommandExamples = `
+    juju resize-storage pgdata/0 --size 200G
+
+`
+
+	resizeStorageCommandArgs = `<storage> --size <size>`
+)
+
+// resizeStorageCommand grows a storage instance.
+type resizeStorageCommand struct {
+	StorageCommandBase
+	modelcmd.IAASOnlyCommand
+	newStorageResizerCloser NewStorageResizerCloserFunc
+	storageId               string
+
+	size    string
+	sizeMiB uint64
+}
+
+// Init implements Command.Init.
+func (c *resizeStorageCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("resize-storage requires a storage ID")
+	}
+	c.storageId, args = args[0], args[1:]
+	if c.size == "" {
+		return errors.New("--size must be specified")
+	}
+	size, err := utils.ParseSize(c.size)
+	if err != nil {
+		return errors.Annotate(err, "parsing --size")
+	}
+	if size == 0 {
+		return errors.New("--size must be greater than zero")
+	}
+	c.sizeMiB = size
+	return cmd.CheckEmpty(args)
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *resizeStorageCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	f.StringVar(&c.size, "size", "", "The new size of the storage, e.g. 200G")
+}
+
+// Info implements Command.Info.
+func (c *resizeStorageCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "resize-storage",
+		Purpose:  "Grows existing storage to a new size.",
+		Doc:      resizeStorageCommandDoc,
+		Examples: resizeStorageCommandExamples,
+		Args:     resizeStorageCommandArgs,
+		SeeAlso: []string{
+			"storage",
+			"show-storage",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *resizeStorageCommand) Run(ctx *cmd.Context) error {
+	resizer, err := c.newStorageResizerCloser(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer resizer.Close()
+
+	if err := resizer.Resize(ctx, c.storageId, c.sizeMiB); err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "resize storage")
+		}
+		return block.ProcessBlockedError(errors.Annotatef(err, "could not resize storage %s", c.storageId), block.BlockChange)
+	}
+	ctx.Infof("resizing %s to %dMiB", c.storageId, c.sizeMiB)
+	return nil
+}
+
+// NewStorageResizerCloserFunc is the type of a function that returns a
+// StorageResizerCloser.
+type NewStorageResizerCloserFunc func(ctx context.Context) (StorageResizerCloser, error)
+
+// StorageResizerCloser extends StorageResizer with a Closer method.
+type StorageResizerCloser interface {
+	StorageResizer
+	Close() error
+}
+
+// StorageResizer defines an interface for growing storage with the
+// specified ID.
+type StorageResizer interface {
+	Resize(context.Context, string, uint64) error
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type ResizeStorageSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestResizeStorageSuite(t *testing.T) {
+	tc.Run(t, &ResizeStorageSuite{})
+}
+
+func (s *ResizeStorageSuite) TestResize(c *tc.C) {
+	var fake fakeStorageResizer
+	command := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, command, "pgdata/0", "--size", "200G")
+	c.Assert(err, tc.ErrorIsNil)
+	fake.CheckCallNames(c, "NewStorageResizerCloser", "Resize", "Close")
+	fake.CheckCall(c, 1, "Resize", "pgdata/0", uint64(204800))
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, "resizing pgdata/0 to 204800MiB\n")
+}
+
+func (s *ResizeStorageSuite) TestResizeError(c *tc.C) {
+	var fake fakeStorageResizer
+	fake.SetErrors(nil, &params.Error{Code: params.CodeNotSupported, Message: `storage "pgdata/0" cannot be shrunk`})
+	command := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, command, "pgdata/0", "--size", "1G")
+	c.Assert(err, tc.ErrorMatches, `could not resize storage pgdata/0: storage "pgdata/0" cannot be shrunk`)
+	fake.CheckCallNames(c, "NewStorageResizerCloser", "Resize", "Close")
+}
+
+func (s *ResizeStorageSuite) TestResizeUnauthorizedError(c *tc.C) {
+	var fake fakeStorageResizer
+	fake.SetErrors(nil, &params.Error{Code: params.CodeUnauthorized, Message: "nope"})
+	command := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, command, "pgdata/0", "--size", "1G")
+	c.Assert(err, tc.ErrorMatches, "could not resize storage pgdata/0: nope")
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, `
+You do not have permission to resize storage.
+You may ask an administrator to grant you access with "juju grant".
+
+`)
+}
+
+func (s *ResizeStorageSuite) TestResizeInitErrors(c *tc.C) {
+	s.testResizeInitError(c, []string{}, "resize-storage requires a storage ID")
+	s.testResizeInitError(c, []string{"pgdata/0"}, "--size must be specified")
+	s.testResizeInitError(c, []string{"pgdata/0", "--size", "lots"}, `parsing --size: .*`)
+	s.testResizeInitError(c, []string{"pgdata/0", "--size", "0"}, "--size must be greater than zero")
+	s.testResizeInitError(c, []string{"pgdata/0", "pgdata/1", "--size", "1G"}, `unrecognized args: \["pgdata/1"\]`)
+}
+
+func (s *ResizeStorageSuite) testResizeInitError(c *tc.C, args []string, expect string) {
+	command := storage.NewResizeStorageCommandForTest(nil, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, command, args...)
+	c.Assert(err, tc.ErrorMatches, expect)
+}
+
+type fakeStorageResizer struct {
+	testhelpers.Stub
+}
+
+func (f *fakeStorageResizer) new(ctx context.Context) (storage.StorageResizerCloser, error) {
+	f.MethodCall(f, "NewStorageResizerCloser")
+	err := f.NextErr()
+	return f, err
+}
+
+func (f *fakeStorageResizer) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeStorageResizer) Resize(ctx context.Context, id string, sizeMiB uint64) error {
+	f.MethodCall(f, "Resize", id, sizeMiB)
+	return f.NextErr()
+}
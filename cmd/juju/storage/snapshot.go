@@ -0,0 +1,128 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewCreateStorageSnapshotCommandWithAPI returns a command
+// used to snapshot existing storage.
+func NewCreateStorageSnapshotCommandWithAPI() cmd.Command {
+	command := &createStorageSnapshotCommand{}
+	command.newStorageSnapshotterCloser = func(ctx context.Context) (StorageSnapshotterCloser, error) {
+		return command.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(command)
+}
+
+const (
+	createStorageSnapshotCommandDoc = `
+Takes a snapshot of an existing storage instance and prints the ID of the
+snapshot. Specify the storage ID (storage_name/id), as output by
+` + "`juju storage`" + `. The snapshot is named after the current time unless
+a name is given with --name.
+
+The snapshot ID can be passed to ` + "`juju add-storage --from-snapshot`" + ` to
+create new storage with the contents of the snapshot.
+
+Snapshots are taken by the controller, so only storage provisioned by
+storage providers that manage storage from the controller, such as the
+LXD provider, can be snapshotted.
+`
+
+	createStorageSnapshotCommandExamples = `
+    juju create-storage-snapshot pgdata/0
+    juju create-storage-snapshot pgdata/0 --name before-upgrade
+
+`
+
+	createStorageSnapshotCommandArgs = `<storage>`
+)
+
+// createStorageSnapshotCommand snapshots a storage instance.
+type createStorageSnapshotCommand struct {
+	StorageCommandBase
+	modelcmd.IAASOnlyCommand
+	newStorageSnapshotterCloser NewStorageSnapshotterCloserFunc
+	storageId                   string
+	name                        string
+}
+
+// Init implements Command.Init.
+func (c *createStorageSnapshotCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("create-storage-snapshot requires a storage ID")
+	}
+	c.storageId, args = args[0], args[1:]
+	return cmd.CheckEmpty(args)
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *createStorageSnapshotCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	f.StringVar(&c.name, "name", "", "The name of the snapshot")
+}
+
+// Info implements Command.Info.
+func (c *createStorageSnapshotCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "create-storage-snapshot",
+		Purpose:  "Takes a snapshot of existing storage.",
+		Doc:      createStorageSnapshotCommandDoc,
+		Examples: createStorageSnapshotCommandExamples,
+		Args:     createStorageSnapshotCommandArgs,
+		SeeAlso: []string{
+			"add-storage",
+			"storage",
+			"show-storage",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *createStorageSnapshotCommand) Run(ctx *cmd.Context) error {
+	snapshotter, err := c.newStorageSnapshotterCloser(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer snapshotter.Close()
+
+	id, err := snapshotter.CreateSnapshot(ctx, c.storageId, c.name)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "snapshot storage")
+		}
+		return block.ProcessBlockedError(errors.Annotatef(err, "could not snapshot storage %s", c.storageId), block.BlockChange)
+	}
+	fmt.Fprintln(ctx.Stdout, id)
+	return nil
+}
+
+// NewStorageSnapshotterCloserFunc is the type of a function that returns a
+// StorageSnapshotterCloser.
+type NewStorageSnapshotterCloserFunc func(ctx context.Context) (StorageSnapshotterCloser, error)
+
+// StorageSnapshotterCloser extends StorageSnapshotter with a Closer method.
+type StorageSnapshotterCloser interface {
+	StorageSnapshotter
+	Close() error
+}
+
+// StorageSnapshotter defines an interface for snapshotting storage with the
+// specified ID.
+type StorageSnapshotter interface {
+	CreateSnapshot(ctx context.Context, storageId, name string) (string, error)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type CreateStorageSnapshotSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestCreateStorageSnapshotSuite(t *testing.T) {
+	tc.Run(t, &CreateStorageSnapshotSuite{})
+}
+
+func (s *CreateStorageSnapshotSuite) TestCreateSnapshot(c *tc.C) {
+	fake := fakeStorageSnapshotter{id: "default:juju-pgdata-0/before-upgrade"}
+	command := storage.NewCreateStorageSnapshotCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, command, "pgdata/0", "--name", "before-upgrade")
+	c.Assert(err, tc.ErrorIsNil)
+	fake.CheckCallNames(c, "NewStorageSnapshotterCloser", "CreateSnapshot", "Close")
+	fake.CheckCall(c, 1, "CreateSnapshot", "pgdata/0", "before-upgrade")
+	c.Assert(cmdtesting.Stdout(ctx), tc.Equals, "default:juju-pgdata-0/before-upgrade\n")
+}
+
+func (s *CreateStorageSnapshotSuite) TestCreateSnapshotError(c *tc.C) {
+	var fake fakeStorageSnapshotter
+	fake.SetErrors(nil, &params.Error{Code: params.CodeNotSupported, Message: `storage "pgdata/0" cannot be snapshotted by its storage provider`})
+	command := storage.NewCreateStorageSnapshotCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, command, "pgdata/0")
+	c.Assert(err, tc.ErrorMatches, `could not snapshot storage pgdata/0: storage "pgdata/0" cannot be snapshotted by its storage provider`)
+	fake.CheckCallNames(c, "NewStorageSnapshotterCloser", "CreateSnapshot", "Close")
+	fake.CheckCall(c, 1, "CreateSnapshot", "pgdata/0", "")
+}
+
+func (s *CreateStorageSnapshotSuite) TestCreateSnapshotUnauthorizedError(c *tc.C) {
+	var fake fakeStorageSnapshotter
+	fake.SetErrors(nil, &params.Error{Code: params.CodeUnauthorized, Message: "nope"})
+	command := storage.NewCreateStorageSnapshotCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, command, "pgdata/0")
+	c.Assert(err, tc.ErrorMatches, "could not snapshot storage pgdata/0: nope")
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, `
+You do not have permission to snapshot storage.
+You may ask an administrator to grant you access with "juju grant".
+
+`)
+}
+
+func (s *CreateStorageSnapshotSuite) TestCreateSnapshotInitErrors(c *tc.C) {
+	s.testCreateSnapshotInitError(c, []string{}, "create-storage-snapshot requires a storage ID")
+	s.testCreateSnapshotInitError(c, []string{"pgdata/0", "pgdata/1"}, `unrecognized args: \["pgdata/1"\]`)
+}
+
+func (s *CreateStorageSnapshotSuite) testCreateSnapshotInitError(c *tc.C, args []string, expect string) {
+	command := storage.NewCreateStorageSnapshotCommandForTest(nil, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, command, args...)
+	c.Assert(err, tc.ErrorMatches, expect)
+}
+
+type fakeStorageSnapshotter struct {
+	testhelpers.Stub
+	id string
+}
+
+func (f *fakeStorageSnapshotter) new(ctx context.Context) (storage.StorageSnapshotterCloser, error) {
+	f.MethodCall(f, "NewStorageSnapshotterCloser")
+	err := f.NextErr()
+	return f, err
+}
+
+func (f *fakeStorageSnapshotter) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeStorageSnapshotter) CreateSnapshot(ctx context.Context, id, name string) (string, error) {
+	f.MethodCall(f, "CreateSnapshot", id, name)
+	if err := f.NextErr(); err != nil {
+		return "", err
+	}
+	return f.id, nil
+}
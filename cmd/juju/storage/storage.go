@@ -61,9 +61,27 @@ type UnitStorageAttachment struct {
 	// Life is the lifecycle state of the storage attachment.
 	Life string `yaml:"life,omitempty" json:"life,omitempty"`
 
+	// Usage is the last reported usage of the attached filesystem.
+	Usage *StorageUsage `yaml:"usage,omitempty" json:"usage,omitempty"`
+
 	// TODO(axw) per-unit status when we have it in state.
 }
 
+// StorageUsage contains the last reported usage of an attached filesystem.
+type StorageUsage struct {
+	// Size is the size of the filesystem in MiB.
+	Size uint64 `yaml:"size" json:"size"`
+
+	// Used is the space used on the filesystem in MiB.
+	Used uint64 `yaml:"used" json:"used"`
+
+	// UsedPercent is the percentage of the filesystem that is used.
+	UsedPercent uint64 `yaml:"used-percent" json:"used-percent"`
+
+	// Updated is when the usage was reported.
+	Updated string `yaml:"updated,omitempty" json:"updated,omitempty"`
+}
+
 // formatStorageDetails takes a set of StorageDetail and
 // creates a mapping from storage ID to storage details.
 func formatStorageDetails(storages []params.StorageDetails) (map[string]StorageInfo, error) {
@@ -115,9 +133,10 @@ func createStorageInfo(details params.StorageDetails) (names.StorageTag, Storage
 				machineId = machineTag.Id()
 			}
 			unitStorageAttachments[unitTag.Id()] = UnitStorageAttachment{
-				machineId,
-				attachmentDetails.Location,
-				string(attachmentDetails.Life),
+				MachineId: machineId,
+				Location:  attachmentDetails.Location,
+				Life:      string(attachmentDetails.Life),
+				Usage:     formatStorageUsage(attachmentDetails.Usage),
 			}
 		}
 		info.Attachments = &StorageAttachments{unitStorageAttachments}
@@ -125,3 +144,20 @@ func createStorageInfo(details params.StorageDetails) (names.StorageTag, Storage
 
 	return storageTag, info, nil
 }
+
+// formatStorageUsage returns the display form of a filesystem's reported
+// usage, or nil if none has been reported.
+func formatStorageUsage(usage *params.StorageUsage) *StorageUsage {
+	if usage == nil {
+		return nil
+	}
+	out := &StorageUsage{
+		Size:    usage.SizeMiB,
+		Used:    usage.UsedMiB,
+		Updated: common.FormatTime(&usage.Updated, false),
+	}
+	if usage.SizeMiB > 0 {
+		out.UsedPercent = usage.UsedMiB * 100 / usage.SizeMiB
+	}
+	return out
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+func NewWaitForCommandForTest(store jujuclient.ClientStore, api StatusAPI, clock clock.Clock) cmd.Command {
+	c := &waitForCommand{
+		newAPIFunc: func(context.Context) (StatusAPI, error) {
+			return api, nil
+		},
+		clock: clock,
+	}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/worker/v5"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/watcher"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/statusquery"
+)
+
+var logger = internallogger.GetLogger("juju.cmd.juju.waitfor")
+
+// defaultTimeout is how long to wait when no timeout is given.
+const defaultTimeout = 10 * time.Minute
+
+// StatusAPI is used to watch the status of the model and to check
+// conditions on it.
+type StatusAPI interface {
+	CheckStatusConditions(ctx context.Context, kind, name string, conditions []string) ([]string, error)
+	WatchStatus(context.Context) (watcher.NotifyWatcher, error)
+	Close() error
+}
+
+// NewWaitForCommand returns a command that waits for an entity in the
+// model to meet a set of conditions.
+func NewWaitForCommand() cmd.Command {
+	c := &waitForCommand{clock: clock.WallClock}
+	c.newAPIFunc = func(ctx context.Context) (StatusAPI, error) {
+		return c.NewAPIClient(ctx)
+	}
+	return modelcmd.Wrap(c)
+}
+
+type waitForCommand struct {
+	modelcmd.ModelCommandBase
+
+	newAPIFunc func(context.Context) (StatusAPI, error)
+	clock      clock.Clock
+
+	timeout time.Duration
+	query   statusquery.Query
+}
+
+const waitForDoc = `
+Wait for the model, an application, a unit or a machine to meet all of the
+given conditions.
+
+The command returns as soon as the conditions are met, and fails if they are
+not met before the timeout. The controller evaluates the conditions each
+time the status of the model changes, so the command neither polls the
+controller nor fetches the status of the model.
+
+Each condition takes the form <field><operator><value>. The operators are
+=, !=, <, <=, > and >=; the ordering operators can only be used with
+numeric fields. Quote conditions using < or > so that the shell does not
+treat them as redirections.
+
+The fields that can be used for each kind of entity are:
+
+model:
+    status          the status of the model
+    applications    the number of applications
+    machines        the number of machines
+
+application:
+    status          the status of the application
+    workload-status the workload status of every unit
+    agent-status    the agent status of every unit
+    life            alive, dying or dead
+    exposed         true or false
+    units           the number of units
+    scale           the requested number of units (Kubernetes only)
+    charm-rev       the charm revision
+
+unit:
+    workload-status the workload status of the unit
+    agent-status    the agent status of the unit
+    life            alive, dying or dead
+    machine         the machine the unit is deployed to
+    leader          true or false
+
+machine:
+    status          the agent status of the machine
+    instance-status the status of the machine instance
+    life            alive, dying or dead
+    containers      the number of containers
+
+A condition on the unit statuses of an application is met when every unit
+meets it, so it is never met by an application without units.
+
+When no conditions are given for an application, unit or machine, the
+command waits for it to appear in the model.
+`
+
+const waitForExamples = `
+Wait for the model to become available:
+
+    juju wait-for model status=available
+
+Wait for at least three active units of mysql:
+
+    juju wait-for application mysql workload-status=active "units>=3"
+
+Wait for a unit to become idle, for up to five minutes:
+
+    juju wait-for unit mysql/0 agent-status=idle --timeout 5m
+
+Wait for machine 0 to be provisioned:
+
+    juju wait-for machine 0 instance-status=running
+`
+
+func (c *waitForCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "wait-for",
+		Args:     "<model|application|unit|machine> [<name>] [<condition>...]",
+		Purpose:  "Wait for an entity in the model to meet a set of conditions.",
+		Doc:      waitForDoc,
+		Examples: waitForExamples,
+		SeeAlso: []string{
+			"status",
+		},
+	})
+}
+
+func (c *waitForCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.DurationVar(&c.timeout, "timeout", defaultTimeout, "How long to wait for the conditions to be met, or 0 to wait indefinitely")
+}
+
+func (c *waitForCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no entity kind specified")
+	}
+	c.query.Kind = statusquery.Kind(args[0])
+	args = args[1:]
+	if err := c.query.Kind.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	if c.query.Kind == statusquery.KindModel {
+		if len(args) == 0 {
+			return errors.New("no conditions specified")
+		}
+	} else {
+		if len(args) == 0 {
+			return errors.Errorf("no %s name specified", c.query.Kind)
+		}
+		c.query.Name, args = args[0], args[1:]
+	}
+
+	// The conditions are parsed here only to report errors early; the
+	// controller evaluates them.
+	for _, arg := range args {
+		cond, err := statusquery.ParseCondition(c.query.Kind, arg)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.query.Conditions = append(c.query.Conditions, cond)
+	}
+
+	if c.timeout < 0 {
+		return errors.NotValidf("negative timeout %v", c.timeout)
+	}
+	return nil
+}
+
+func (c *waitForCommand) Run(ctx *cmd.Context) error {
+	if c.query.Kind == statusquery.KindModel {
+		modelName, err := c.ModelIdentifier()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.query.Name = modelName
+	}
+	conditions := make([]string, len(c.query.Conditions))
+	for i, cond := range c.query.Conditions {
+		conditions[i] = cond.String()
+	}
+
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = api.Close() }()
+
+	w, err := api.WatchStatus(ctx)
+	if errors.Is(err, errors.NotSupported) {
+		return errors.New("wait-for is not supported by this controller")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = worker.Stop(w) }()
+
+	var timeout <-chan time.Time
+	if c.timeout > 0 {
+		timeout = c.clock.After(c.timeout)
+	}
+
+	// The watcher fires once when it starts, so the conditions are
+	// checked against the current status straight away.
+	unmet := []string{"status not yet read"}
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Annotatef(ctx.Err(), "waiting for %s", c.query)
+		case <-timeout:
+			return errors.Errorf("timed out after %v waiting for %s: %s", c.timeout, c.query, strings.Join(unmet, ", "))
+		case _, ok := <-w.Changes():
+			if !ok {
+				return errors.Annotate(w.Wait(), "watching status")
+			}
+		}
+
+		var err error
+		unmet, err = api.CheckStatusConditions(ctx, string(c.query.Kind), c.query.Name, conditions)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(unmet) == 0 {
+			ctx.Infof("%s ready", c.query)
+			return nil
+		}
+		logger.Debugf(ctx, "waiting for %s: %s", c.query, strings.Join(unmet, ", "))
+	}
+}
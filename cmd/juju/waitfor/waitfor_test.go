@@ -0,0 +1,258 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor_test
+
+import (
+	"context"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/waitfor"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/internal/statusquery"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type waitForSuite struct {
+	testing.BaseSuite
+
+	store   jujuclient.ClientStore
+	api     *fakeStatusAPI
+	clock   *testclock.Clock
+	changes chan struct{}
+}
+
+func TestWaitForSuite(t *stdtesting.T) {
+	tc.Run(t, &waitForSuite{})
+}
+
+func (s *waitForSuite) SetUpTest(c *tc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.changes = make(chan struct{}, 3)
+	s.api = &fakeStatusAPI{
+		watcher: watchertest.NewMockNotifyWatcher(s.changes),
+	}
+	s.clock = testclock.NewClock(time.Now())
+
+	store := jujuclient.NewMemStore()
+	store.CurrentControllerName = "kontroll"
+	store.Controllers["kontroll"] = jujuclient.ControllerDetails{}
+	store.Models["kontroll"] = &jujuclient.ControllerModels{
+		CurrentModel: "admin/test",
+		Models: map[string]jujuclient.ModelDetails{"admin/test": {
+			ModelType: coremodel.IAAS,
+		}},
+	}
+	store.Accounts["kontroll"] = jujuclient.AccountDetails{
+		User: "admin",
+	}
+	s.store = store
+}
+
+func (s *waitForSuite) newCommand() cmd.Command {
+	return waitfor.NewWaitForCommandForTest(s.store, s.api, s.clock)
+}
+
+func (s *waitForSuite) run(c *tc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, s.newCommand(), args...)
+}
+
+func application(unitStatuses ...string) params.ApplicationStatus {
+	app := params.ApplicationStatus{
+		Status: params.DetailedStatus{Status: "active"},
+		Units:  make(map[string]params.UnitStatus),
+	}
+	for i, status := range unitStatuses {
+		app.Units["mysql/"+string(rune('0'+i))] = params.UnitStatus{
+			WorkloadStatus: params.DetailedStatus{Status: status},
+			AgentStatus:    params.DetailedStatus{Status: "idle"},
+		}
+	}
+	return app
+}
+
+func (s *waitForSuite) TestInitErrors(c *tc.C) {
+	for i, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: nil,
+		err:  "no entity kind specified",
+	}, {
+		args: []string{"relation"},
+		err:  `entity kind "relation" \(expected model, application, unit or machine\) not valid`,
+	}, {
+		args: []string{"model"},
+		err:  "no conditions specified",
+	}, {
+		args: []string{"application"},
+		err:  "no application name specified",
+	}, {
+		args: []string{"application", "mysql", "workload-status"},
+		err:  `condition "workload-status" not valid`,
+	}, {
+		args: []string{"application", "mysql", "leader=true"},
+		err:  `application field "leader" in condition "leader=true" \(expected one of .*\) not valid`,
+	}, {
+		args: []string{"application", "mysql", "units>=three"},
+		err:  `number "three" in condition "units>=three" not valid`,
+	}, {
+		args: []string{"application", "mysql", "status>active"},
+		err:  `operator ">" for field "status" not valid`,
+	}, {
+		args: []string{"application", "mysql", "--timeout", "-1s"},
+		err:  `negative timeout -1s not valid`,
+	}} {
+		c.Logf("test %d: %v", i, test.args)
+		err := cmdtesting.InitCommand(s.newCommand(), test.args)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+func (s *waitForSuite) TestApplicationReady(c *tc.C) {
+	s.changes <- struct{}{}
+	s.changes <- struct{}{}
+	s.api.results = []*params.FullStatus{{
+		Applications: map[string]params.ApplicationStatus{
+			"mysql": application("active", "waiting"),
+		},
+	}, {
+		Applications: map[string]params.ApplicationStatus{
+			"mysql": application("active", "active", "active"),
+		},
+	}}
+
+	ctx, err := s.run(c, "application", "mysql", "workload-status=active", "units>=3")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "application \"mysql\" ready\n")
+	c.Check(s.api.calls, tc.Equals, 2)
+	c.Check(s.api.name, tc.Equals, "mysql")
+	c.Check(s.api.conditions, tc.DeepEquals, []string{"workload-status=active", "units>=3"})
+}
+
+func (s *waitForSuite) TestWaitsForEntityToAppear(c *tc.C) {
+	s.changes <- struct{}{}
+	s.changes <- struct{}{}
+	s.api.results = []*params.FullStatus{{}, {
+		Machines: map[string]params.MachineStatus{
+			"0": {Containers: map[string]params.MachineStatus{
+				"0/lxd/0": {InstanceStatus: params.DetailedStatus{Status: "running"}},
+			}},
+		},
+	}}
+
+	_, err := s.run(c, "machine", "0/lxd/0", "instance-status=running")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.calls, tc.Equals, 2)
+}
+
+func (s *waitForSuite) TestModel(c *tc.C) {
+	s.changes <- struct{}{}
+	s.api.results = []*params.FullStatus{{
+		Model: params.ModelStatusInfo{
+			ModelStatus: params.DetailedStatus{Status: "available"},
+		},
+	}}
+
+	ctx, err := s.run(c, "model", "status=available")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "model \"admin/test\" ready\n")
+	c.Check(s.api.name, tc.Equals, "admin/test")
+}
+
+func (s *waitForSuite) TestTimeout(c *tc.C) {
+	s.changes <- struct{}{}
+	s.api.results = []*params.FullStatus{{
+		Applications: map[string]params.ApplicationStatus{
+			"mysql": application("active", "waiting"),
+		},
+	}}
+
+	command := s.newCommand()
+	err := cmdtesting.InitCommand(command, []string{"application", "mysql", "workload-status=active", "units>=3", "--timeout", "1m"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- command.Run(cmdtesting.Context(c))
+	}()
+	c.Assert(s.clock.WaitAdvance(time.Minute, testing.LongWait, 1), tc.ErrorIsNil)
+
+	select {
+	case err := <-errc:
+		c.Check(err, tc.ErrorMatches, `timed out after 1m0s waiting for application "mysql": `+
+			`workload-status=active \(workload-status=active,waiting\), units>=3 \(units=2\)`)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for command to finish")
+	}
+}
+
+func (s *waitForSuite) TestInterrupted(c *tc.C) {
+	ctx, cancel := context.WithCancel(c.Context())
+	cancel()
+
+	cmdCtx := cmdtesting.Context(c)
+	cmdCtx.Context = ctx
+	command := s.newCommand()
+	err := cmdtesting.InitCommand(command, []string{"unit", "mysql/0"})
+	c.Assert(err, tc.ErrorIsNil)
+	err = command.Run(cmdCtx)
+	c.Check(err, tc.ErrorIs, context.Canceled)
+	c.Check(s.api.calls, tc.Equals, 0)
+}
+
+func (s *waitForSuite) TestNotSupported(c *tc.C) {
+	s.api.watcher = nil
+	_, err := s.run(c, "unit", "mysql/0")
+	c.Check(err, tc.ErrorMatches, "wait-for is not supported by this controller")
+}
+
+// fakeStatusAPI evaluates the conditions against each of its results in
+// turn, as the controller would against the model status.
+type fakeStatusAPI struct {
+	watcher    watcher.NotifyWatcher
+	results    []*params.FullStatus
+	name       string
+	conditions []string
+	calls      int
+}
+
+func (f *fakeStatusAPI) CheckStatusConditions(_ context.Context, kind, name string, conditions []string) ([]string, error) {
+	f.name = name
+	f.conditions = conditions
+	f.calls++
+	if len(f.results) == 0 {
+		return nil, errors.New("no status")
+	}
+	result := f.results[0]
+	if len(f.results) > 1 {
+		f.results = f.results[1:]
+	}
+	q, err := statusquery.ParseQuery(statusquery.Kind(kind), name, conditions)
+	if err != nil {
+		return nil, err
+	}
+	return q.Unmet(result), nil
+}
+
+func (f *fakeStatusAPI) WatchStatus(context.Context) (watcher.NotifyWatcher, error) {
+	if f.watcher == nil {
+		return nil, errors.NotSupportedf("watching status")
+	}
+	return f.watcher, nil
+}
+
+func (*fakeStatusAPI) Close() error {
+	return nil
+}
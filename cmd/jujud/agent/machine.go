@@ -56,6 +56,7 @@ import (
 	"github.com/juju/juju/internal/container/broker"
 	internaldependency "github.com/juju/juju/internal/dependency"
 	"github.com/juju/juju/internal/flightrecorder"
+	"github.com/juju/juju/internal/logexport"
 	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/internal/pki"
 	k8sconstants "github.com/juju/juju/internal/provider/kubernetes/constants"
@@ -444,9 +445,13 @@ func (a *MachineAgent) Run(ctx *cmd.Context) (err error) {
 	}
 	defer logSink.Close()
 
+	// Wrap the log sink so that records can also be forwarded to the log
+	// exporter worker, when it is running.
+	logForwarder := logexport.NewForwarder(logSink)
+
 	// Add the log sink to the default logger context.
 	if err := loggo.DefaultContext().AddWriter("logsink", corelogger.NewTaggedRedirectWriter(
-		logSink,
+		logForwarder,
 		a.Tag().String(),
 		a.CurrentConfig().Model().Id(),
 	)); err != nil {
@@ -491,7 +496,7 @@ func (a *MachineAgent) Run(ctx *cmd.Context) (err error) {
 	a.upgradeDBLock = internalupgrade.NewLock(agentConfig, jujuversion.Current)
 	a.upgradeStepsLock = internalupgrade.NewLock(agentConfig, jujuversion.Current)
 
-	createEngine := a.makeEngineCreator(agentName, agentConfig.UpgradedToVersion(), logSink)
+	createEngine := a.makeEngineCreator(agentName, agentConfig.UpgradedToVersion(), logForwarder)
 	if err := a.createJujudSymlinks(agentConfig.DataDir()); err != nil {
 		return err
 	}
@@ -513,7 +518,7 @@ func (a *MachineAgent) Run(ctx *cmd.Context) (err error) {
 
 func (a *MachineAgent) makeEngineCreator(
 	agentName string, previousAgentVersion semversion.Number,
-	logSink corelogger.LogSink,
+	logSink *logexport.Forwarder,
 ) func(context.Context) (worker.Worker, error) {
 	return func(ctx context.Context) (worker.Worker, error) {
 		agentConfig := a.CurrentConfig()
@@ -555,6 +560,7 @@ func (a *MachineAgent) makeEngineCreator(
 			PreUpgradeSteps:                   a.preUpgradeSteps,
 			UpgradeSteps:                      a.upgradeSteps,
 			LogSink:                           logSink,
+			LogForwarder:                      logSink,
 			NewDeployContext:                  deployer.NewNestedContext,
 			Clock:                             clock,
 			FlightRecorder:                    flightRecorder,
@@ -722,6 +728,9 @@ func (a *MachineAgent) startModelWorkers(cfg modelworkermanager.NewModelConfig)
 		NewContainerBrokerFunc:        newCAASBroker,
 		NewMigrationMaster:            migrationmaster.NewWorker,
 		OperationPrunerInterval:       24 * time.Hour,
+		BundleDriftInterval:           10 * time.Minute,
+		SecretExternalSyncInterval:    time.Minute,
+		DNSUpdateInterval:             time.Minute,
 		DomainServices:                cfg.DomainServices,
 		ProviderServicesGetter:        cfg.ProviderServicesGetter,
 		LeaseManager:                  cfg.LeaseManager,
@@ -44,6 +44,7 @@ import (
 	"github.com/juju/juju/internal/container/lxd"
 	internalhttp "github.com/juju/juju/internal/http"
 	internallease "github.com/juju/juju/internal/lease"
+	"github.com/juju/juju/internal/logexport"
 	internallogger "github.com/juju/juju/internal/logger"
 	internalobjectstore "github.com/juju/juju/internal/objectstore"
 	"github.com/juju/juju/internal/s3client"
@@ -64,6 +65,7 @@ import (
 	"github.com/juju/juju/internal/worker/apiservercertwatcher"
 	"github.com/juju/juju/internal/worker/auditconfigupdater"
 	"github.com/juju/juju/internal/worker/authenticationworker"
+	"github.com/juju/juju/internal/worker/backupscheduler"
 	"github.com/juju/juju/internal/worker/bootstrap"
 	"github.com/juju/juju/internal/worker/caasupgrader"
 	"github.com/juju/juju/internal/worker/certupdater"
@@ -78,12 +80,14 @@ import (
 	"github.com/juju/juju/internal/worker/dbaccessor"
 	"github.com/juju/juju/internal/worker/deployer"
 	"github.com/juju/juju/internal/worker/diskmanager"
+	"github.com/juju/juju/internal/worker/dnsresponder"
 	workerdomainservices "github.com/juju/juju/internal/worker/domainservices"
 	"github.com/juju/juju/internal/worker/externalcontrollerupdater"
 	"github.com/juju/juju/internal/worker/filenotifywatcher"
 	workerflightrecorder "github.com/juju/juju/internal/worker/flightrecorder"
 	"github.com/juju/juju/internal/worker/fortress"
 	"github.com/juju/juju/internal/worker/gate"
+	"github.com/juju/juju/internal/worker/hostfirewaller"
 	"github.com/juju/juju/internal/worker/hostkeyreporter"
 	"github.com/juju/juju/internal/worker/httpclient"
 	"github.com/juju/juju/internal/worker/httpserver"
@@ -92,6 +96,7 @@ import (
 	"github.com/juju/juju/internal/worker/jwtparser"
 	leasemanager "github.com/juju/juju/internal/worker/lease"
 	"github.com/juju/juju/internal/worker/leaseexpiry"
+	"github.com/juju/juju/internal/worker/logexporter"
 	"github.com/juju/juju/internal/worker/logger"
 	"github.com/juju/juju/internal/worker/logsink"
 	"github.com/juju/juju/internal/worker/machineactions"
@@ -189,6 +194,10 @@ type ManifoldsConfig struct {
 	// LogSink defines an interface for writing log records to a log sink.
 	LogSink corelogger.LogSink
 
+	// LogForwarder forwards the records written to LogSink to the log
+	// exporter worker.
+	LogForwarder logexporter.LogForwarder
+
 	// NewDeployContext gives the tests the opportunity to create a
 	// deployer.Context that can be used for testing.
 	NewDeployContext func(deployer.ContextConfig) (deployer.Context, error)
@@ -664,6 +673,45 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			GetChangeStreamService: changestreampruner.GetControllerChangeStreamService,
 		})),
 
+		// The backup scheduler takes backups of the controller according to
+		// the backup-schedule controller config and prunes old ones.
+		backupSchedulerName: ifPrimaryController(backupscheduler.Manifold(backupscheduler.ManifoldConfig{
+			AgentName:                  agentName,
+			DBAccessorName:             dbAccessorName,
+			DomainServicesName:         domainServicesName,
+			ObjectStoreName:            objectStoreFacadeName,
+			GetControllerConfigService: backupscheduler.GetControllerConfigService,
+			NewBackupCreator:           backupscheduler.NewBackupCreator,
+			NewWorker:                  backupscheduler.NewWorker,
+			Clock:                      config.Clock,
+			Logger:                     internallogger.GetLogger("juju.worker.backupscheduler"),
+		})),
+
+		// The DNS responder answers queries for the names of the
+		// applications and units of the controller's models, if the
+		// dns-listen-address controller config is set.
+		dnsResponderName: ifDatabaseUpgradeComplete(dnsresponder.Manifold(dnsresponder.ManifoldConfig{
+			DomainServicesName:         domainServicesName,
+			GetControllerConfigService: dnsresponder.GetControllerConfigService,
+			NewResolver:                dnsresponder.NewModelResolver,
+			NewWorker:                  dnsresponder.NewWorker,
+			Clock:                      config.Clock,
+			Logger:                     internallogger.GetLogger("juju.worker.dnsresponder"),
+		})),
+
+		// The log exporter forwards agent log records to an external
+		// collector, according to the log-export-* controller config.
+		logExporterName: ifDatabaseUpgradeComplete(logexporter.Manifold(logexporter.ManifoldConfig{
+			AgentName:                  agentName,
+			DomainServicesName:         domainServicesName,
+			LogForwarder:               config.LogForwarder,
+			GetControllerConfigService: logexporter.GetControllerConfigService,
+			NewExporter:                logexport.NewExporter,
+			NewWorker:                  logexporter.NewWorker,
+			Clock:                      config.Clock,
+			Logger:                     internallogger.GetLogger("juju.worker.logexporter"),
+		})),
+
 		auditConfigUpdaterName: ifDatabaseUpgradeComplete(auditconfigupdater.Manifold(auditconfigupdater.ManifoldConfig{
 			AgentName:                  agentName,
 			DomainServicesName:         domainServicesName,
@@ -1155,7 +1203,8 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			MachineLock:   config.MachineLock,
 			ContainerType: instance.LXD,
 		})),
-		// isNotControllerFlagName is only used for the machineconverter,
+		// isNotControllerFlagName is only used for the machineconverter
+		// and the hostfirewaller.
 		isNotControllerFlagName: util.IsControllerFlagManifold(stateConfigWatcherName, false),
 		machineConverterName: ifNotController(ifNotMigrating(machineconverter.Manifold(machineconverter.ManifoldConfig{
 			AgentName:        agentName,
@@ -1166,6 +1215,18 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewConverter:     machineconverter.NewConverter,
 		}))),
 
+		// The hostfirewaller worker enforces the ports opened by the
+		// units on the machine with a host firewall, when enabled by the
+		// host-firewall model config. Controllers are left alone.
+		hostFirewallerName: ifNotController(ifNotMigrating(hostfirewaller.Manifold(hostfirewaller.ManifoldConfig{
+			AgentName:     agentName,
+			APICallerName: apiCallerName,
+			Logger:        internallogger.GetLogger("juju.worker.hostfirewaller"),
+			NewFacade:     hostfirewaller.NewFacade,
+			NewWorker:     hostfirewaller.NewWorker,
+			ApplyNftables: hostfirewaller.ApplyNftables,
+		}))),
+
 		// The machineSetupName manifold runs small tasks required
 		// to setup a machine, but requires the machine agent's API
 		// connection. Once its work is complete, it stops.
@@ -1384,6 +1445,8 @@ const (
 	apiRemoteCallerName           = "api-remote-caller"
 	apiRemoteRelationCallerName   = "api-remote-relation-caller"
 	auditConfigUpdaterName        = "audit-config-updater"
+	backupSchedulerName           = "backup-scheduler"
+	logExporterName               = "log-exporter"
 	authenticationWorkerName      = "ssh-authkeys-updater"
 	brokerTrackerName             = "broker-tracker"
 	certificateUpdaterName        = "certificate-updater"
@@ -1396,9 +1459,11 @@ const (
 	dbAccessorName                = "db-accessor"
 	deployerName                  = "deployer"
 	diskManagerName               = "disk-manager"
+	dnsResponderName              = "dns-responder"
 	domainServicesName            = "domain-services"
 	externalControllerUpdaterName = "external-controller-updater"
 	fileNotifyWatcherName         = "file-notify-watcher"
+	hostFirewallerName            = "host-firewaller"
 	hostKeyReporterName           = "host-key-reporter"
 	httpClientName                = "http-client"
 	httpServerArgsName            = "http-server-args"
@@ -76,6 +76,7 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *tc.C) {
 			"api-remote-relation-caller",
 			"api-server",
 			"audit-config-updater",
+			"backup-scheduler",
 			"bootstrap",
 			"broker-tracker",
 			"certificate-updater",
@@ -89,10 +90,12 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *tc.C) {
 			"db-accessor",
 			"deployer",
 			"disk-manager",
+			"dns-responder",
 			"domain-services",
 			"external-controller-updater",
 			"file-notify-watcher",
 			"flight-recorder",
+			"host-firewaller",
 			"host-key-reporter",
 			"http-client",
 			"http-server-args",
@@ -105,6 +108,7 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *tc.C) {
 			"jwt-parser",
 			"lease-expiry",
 			"lease-manager",
+			"log-exporter",
 			"log-sink",
 			"logging-config-updater",
 			"lxd-container-provisioner",
@@ -171,6 +175,7 @@ func (s *ManifoldsSuite) TestManifoldNamesCAAS(c *tc.C) {
 			"api-remote-relation-caller",
 			"api-server",
 			"audit-config-updater",
+			"backup-scheduler",
 			"bootstrap",
 			"certificate-watcher",
 			"change-stream-pruner",
@@ -180,6 +185,7 @@ func (s *ManifoldsSuite) TestManifoldNamesCAAS(c *tc.C) {
 			"controller-agent-config",
 			"controller-presence",
 			"db-accessor",
+			"dns-responder",
 			"domain-services",
 			"external-controller-updater",
 			"file-notify-watcher",
@@ -194,6 +200,7 @@ func (s *ManifoldsSuite) TestManifoldNamesCAAS(c *tc.C) {
 			"jwt-parser",
 			"lease-expiry",
 			"lease-manager",
+			"log-exporter",
 			"log-sink",
 			"logging-config-updater",
 			"migration-fortress",
@@ -266,6 +273,7 @@ func (s *ManifoldsSuite) TestMigrationGuardsUsed(c *tc.C) {
 		"api-remote-relation-caller",
 		"api-server",
 		"audit-config-updater",
+		"backup-scheduler",
 		"bootstrap",
 		"certificate-updater",
 		"certificate-watcher",
@@ -277,6 +285,7 @@ func (s *ManifoldsSuite) TestMigrationGuardsUsed(c *tc.C) {
 		"controller-presence",
 		"db-accessor",
 		"deployer",
+		"dns-responder",
 		"domain-services",
 		"file-notify-watcher",
 		"flight-recorder",
@@ -292,6 +301,7 @@ func (s *ManifoldsSuite) TestMigrationGuardsUsed(c *tc.C) {
 		"jwt-parser",
 		"lease-expiry",
 		"lease-manager",
+		"log-exporter",
 		"log-sink",
 		"migration-fortress",
 		"migration-inactive-flag",
@@ -367,6 +377,7 @@ func (*ManifoldsSuite) TestSingularGuardsUsed(c *tc.C) {
 	// Explicitly guarded by ifPrimaryController.
 	primaryControllerWorkers := set.NewStrings(
 		"api-address-setter",
+		"backup-scheduler",
 		"change-stream-pruner",
 		"external-controller-updater",
 		"lease-expiry",
@@ -378,8 +389,11 @@ func (*ManifoldsSuite) TestSingularGuardsUsed(c *tc.C) {
 	// the database before it has been upgraded.
 	dbUpgradedWorkers := set.NewStrings(
 		"audit-config-updater",
+		"backup-scheduler",
 		"bootstrap",
 		"control-socket",
+		"dns-responder",
+		"log-exporter",
 		"object-store",
 		"object-store-s3-caller",
 	)
@@ -738,6 +752,35 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"upgrade-database-gate",
 	},
 
+	"backup-scheduler": {
+		"agent",
+		"api-remote-caller",
+		"change-stream",
+		"clock",
+		"controller-agent-config",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"is-primary-controller-flag",
+		"lease-manager",
+		"log-sink",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"object-store",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
 	"bootstrap": {
 		"agent",
 		"api-remote-caller",
@@ -980,6 +1023,20 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"flight-recorder": {},
 
+	"host-firewaller": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"is-not-controller-flag",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"state-config-watcher",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
+
 	"host-key-reporter": {
 		"agent",
 		"api-caller",
@@ -1173,6 +1230,62 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"upgrade-steps-gate",
 	},
 
+	"log-exporter": {
+		"agent",
+		"api-remote-caller",
+		"change-stream",
+		"clock",
+		"controller-agent-config",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"lease-manager",
+		"log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
+	"dns-responder": {
+		"agent",
+		"api-remote-caller",
+		"change-stream",
+		"clock",
+		"controller-agent-config",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"lease-manager",
+		"log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
 	"log-sink": {},
 
 	"machine-action-runner": {
@@ -1898,6 +2011,35 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 		"upgrade-database-gate",
 	},
 
+	"backup-scheduler": {
+		"agent",
+		"api-remote-caller",
+		"change-stream",
+		"clock",
+		"controller-agent-config",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"is-primary-controller-flag",
+		"lease-manager",
+		"log-sink",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"object-store",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
 	"bootstrap": {
 		"agent",
 		"api-remote-caller",
@@ -2232,6 +2374,62 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 		"trace",
 	},
 
+	"log-exporter": {
+		"agent",
+		"api-remote-caller",
+		"change-stream",
+		"clock",
+		"controller-agent-config",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"lease-manager",
+		"log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
+	"dns-responder": {
+		"agent",
+		"api-remote-caller",
+		"change-stream",
+		"clock",
+		"controller-agent-config",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"lease-manager",
+		"log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
 	"log-sink": {},
 
 	"logging-config-updater": {
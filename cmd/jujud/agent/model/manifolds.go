@@ -32,6 +32,7 @@ import (
 	"github.com/juju/juju/internal/worker/apiconfigwatcher"
 	"github.com/juju/juju/internal/worker/apiremoterelationcaller"
 	"github.com/juju/juju/internal/worker/asynccharmdownloader"
+	"github.com/juju/juju/internal/worker/bundledrift"
 	"github.com/juju/juju/internal/worker/caasapplicationprovisioner"
 	"github.com/juju/juju/internal/worker/caasfirewaller"
 	"github.com/juju/juju/internal/worker/caasmodelconfigmanager"
@@ -40,6 +41,7 @@ import (
 	"github.com/juju/juju/internal/worker/charmrevisioner"
 	provisioner "github.com/juju/juju/internal/worker/computeprovisioner"
 	"github.com/juju/juju/internal/worker/credentialvalidator"
+	"github.com/juju/juju/internal/worker/dnsupdater"
 	"github.com/juju/juju/internal/worker/firewaller"
 	"github.com/juju/juju/internal/worker/fortress"
 	"github.com/juju/juju/internal/worker/instancepoller"
@@ -55,6 +57,7 @@ import (
 	"github.com/juju/juju/internal/worker/remoterelationconsumer/offererrelations"
 	"github.com/juju/juju/internal/worker/remoterelationconsumer/offererunitrelations"
 	"github.com/juju/juju/internal/worker/removal"
+	"github.com/juju/juju/internal/worker/secretexternalsync"
 	"github.com/juju/juju/internal/worker/secretsdrainworker"
 	"github.com/juju/juju/internal/worker/secretspruner"
 	"github.com/juju/juju/internal/worker/singular"
@@ -113,6 +116,18 @@ type ManifoldsConfig struct {
 	// OperationPrunerInterval determines how often the operations are pruned
 	OperationPrunerInterval time.Duration
 
+	// BundleDriftInterval determines how often the model is compared with
+	// its desired bundle.
+	BundleDriftInterval time.Duration
+
+	// SecretExternalSyncInterval determines how often user secrets are
+	// synced from their external sources.
+	SecretExternalSyncInterval time.Duration
+
+	// DNSUpdateInterval determines how often the model's names are
+	// published to the external DNS server, if one is configured.
+	DNSUpdateInterval time.Duration
+
 	// ProviderServicesGetter is used to access the provider service.
 	ProviderServicesGetter modelworkermanager.ProviderServicesGetter
 
@@ -395,6 +410,33 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Clock:              config.Clock,
 		}))),
 
+		// The bundleDrift worker compares the model with its desired
+		// bundle, if it has one, and records any differences.
+		bundleDriftName: ifResponsible(ifNotMigrating(bundledrift.Manifold(bundledrift.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			CheckInterval:      config.BundleDriftInterval,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.bundledrift"),
+			Clock:              config.Clock,
+		}))),
+
+		// The secretExternalSync worker syncs the content of user secrets
+		// from the secret backend paths they reference.
+		secretExternalSyncName: ifResponsible(ifNotMigrating(secretexternalsync.Manifold(secretexternalsync.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			SyncInterval:       config.SecretExternalSyncInterval,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.secretexternalsync"),
+			Clock:              config.Clock,
+		}))),
+
+		// The dnsUpdater worker publishes the names of the model's
+		// applications and units to an external DNS server.
+		dnsUpdaterName: ifResponsible(ifNotMigrating(dnsupdater.Manifold(dnsupdater.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			UpdateInterval:     config.DNSUpdateInterval,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.dnsupdater"),
+			Clock:              config.Clock,
+		}))),
+
 		changeStreamPrunerName: ifResponsible(ifNotMigrating(changestreampruner.Manifold(changestreampruner.ManifoldConfig{
 			DomainServiceName:      domainServicesName,
 			Clock:                  config.Clock,
@@ -613,9 +655,11 @@ const (
 
 	apiRemoteRelationCallerName  = "api-remote-relation-caller"
 	asyncCharmDownloader         = "async-charm-downloader"
+	bundleDriftName              = "bundle-drift"
 	changeStreamPrunerName       = "change-stream-pruner"
 	charmRevisionerName          = "charm-revisioner"
 	computeProvisionerName       = "compute-provisioner"
+	dnsUpdaterName               = "dns-updater"
 	domainServicesName           = "domain-services"
 	firewallerName               = "firewaller"
 	httpClientName               = "http-client"
@@ -638,6 +682,7 @@ const (
 	caasmodelconfigmanagerName     = "caas-model-config-manager"
 	caasApplicationProvisionerName = "caas-application-provisioner"
 
+	secretExternalSyncName = "secret-external-sync"
 	secretsPrunerName      = "secrets-pruner"
 	userSecretsDrainWorker = "user-secrets-drain-worker"
 
@@ -43,10 +43,12 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"api-config-watcher",
 		"api-remote-relation-caller",
 		"async-charm-downloader",
+		"bundle-drift",
 		"change-stream-pruner",
 		"charm-revisioner",
 		"clock",
 		"compute-provisioner",
+		"dns-updater",
 		"domain-services",
 		"firewaller",
 		"http-client",
@@ -64,6 +66,7 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"provider-tracker",
 		"remote-relation-consumer",
 		"removal",
+		"secret-external-sync",
 		"secrets-pruner",
 		"storage-provisioner",
 		"user-secrets-drain-worker",
@@ -88,6 +91,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"api-config-watcher",
 		"api-remote-relation-caller",
 		"async-charm-downloader",
+		"bundle-drift",
 		"caas-application-provisioner",
 		"caas-firewaller",
 		"caas-model-config-manager",
@@ -95,6 +99,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"change-stream-pruner",
 		"charm-revisioner",
 		"clock",
+		"dns-updater",
 		"domain-services",
 		"http-client",
 		"is-responsible-flag",
@@ -110,6 +115,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"provider-tracker",
 		"remote-relation-consumer",
 		"removal",
+		"secret-external-sync",
 		"secrets-pruner",
 		"storage-provisioner",
 		"user-secrets-drain-worker",
@@ -297,6 +303,39 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"lease-manager",
 	},
 
+	"bundle-drift": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
+	"dns-updater": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
+	"secret-external-sync": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"charm-revisioner": {
 		"agent",
 		"domain-services",
@@ -475,6 +514,39 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 		"is-responsible-flag",
 	},
 
+	"bundle-drift": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
+	"dns-updater": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
+	"secret-external-sync": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"charm-revisioner": {
 		"agent",
 		"lease-manager",
@@ -5,6 +5,7 @@ package controller
 
 import (
 	"fmt"
+	"net"
 	"net/url"
 	"regexp"
 	"slices"
@@ -23,6 +24,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/internal/configschema"
 	"github.com/juju/juju/internal/pki"
+	"github.com/juju/juju/internal/secrets/encryption"
 )
 
 // docs:controller-config-keys
@@ -283,6 +285,43 @@ const (
 	// HTTPServerWriteTimeout is the maximum duration before timing out writes of the HTTP response.
 	// A zero value means no timeout.
 	HTTPServerWriteTimeout = "http-server-write-timeout"
+
+	// BackupSchedule is the interval between backups taken by the controller.
+	// A zero value disables scheduled backups.
+	BackupSchedule = "backup-schedule"
+
+	// BackupRetention is the number of scheduled backups kept in the
+	// controller object store. Older backups are pruned.
+	BackupRetention = "backup-retention"
+
+	// LogExportProtocol is the protocol used to export agent logs to an
+	// external collector. One of otlp-http, syslog-tcp or syslog-tls. An
+	// empty value disables log export.
+	LogExportProtocol = "log-export-protocol"
+
+	// LogExportEndpoint is the collector endpoint logs are exported to. It
+	// is a URL for otlp-http and a host:port for the syslog protocols.
+	LogExportEndpoint = "log-export-endpoint"
+
+	// LogExportCACert is an optional PEM encoded CA certificate used to
+	// verify the collector when exporting logs over TLS.
+	LogExportCACert = "log-export-ca-cert"
+
+	// LogExportBufferSize is the maximum size of the on-disk buffer of logs
+	// waiting to be exported, eg "100M". When the buffer is full the oldest
+	// logs are dropped.
+	LogExportBufferSize = "log-export-buffer-size"
+
+	// SecretEncryptionMasterKey is the source of the master key wrapping
+	// the data keys that encrypt secret content stored by the internal
+	// secret backend, eg "file:/etc/juju/secret.keys". An empty value
+	// disables encryption of secret content.
+	SecretEncryptionMasterKey = "secret-encryption-master-key"
+
+	// DNSListenAddress is the address, eg ":53", on which each controller
+	// answers DNS queries for the names published for the applications and
+	// units of its models. An empty value disables the DNS responder.
+	DNSListenAddress = "dns-listen-address"
 )
 
 // Attribute Defaults
@@ -456,6 +495,29 @@ const (
 
 	// DefaultHTTPServerWriteTimeout is set to 0 (no timeout).
 	DefaultHTTPServerWriteTimeout = 0 * time.Second
+
+	// DefaultBackupSchedule is set to 0 (scheduled backups disabled).
+	DefaultBackupSchedule = 0 * time.Second
+
+	// DefaultBackupRetention is the default number of scheduled backups
+	// to keep.
+	DefaultBackupRetention = 7
+
+	// DefaultLogExportBufferSize is the default size in MB of the on-disk
+	// buffer of logs waiting to be exported.
+	DefaultLogExportBufferSize = 100
+)
+
+// Log export protocols supported by the LogExportProtocol key.
+const (
+	// LogExportOTLPHTTP exports logs using OTLP over HTTP(S).
+	LogExportOTLPHTTP = "otlp-http"
+
+	// LogExportSyslogTCP exports logs as RFC 5424 syslog messages over TCP.
+	LogExportSyslogTCP = "syslog-tcp"
+
+	// LogExportSyslogTLS exports logs as RFC 5424 syslog messages over TLS.
+	LogExportSyslogTLS = "syslog-tls"
 )
 
 var (
@@ -516,6 +578,14 @@ var (
 		JujudControllerSnapSource,
 		SSHMaxConcurrentConnections,
 		SSHServerPort,
+		BackupSchedule,
+		BackupRetention,
+		LogExportProtocol,
+		LogExportEndpoint,
+		LogExportCACert,
+		LogExportBufferSize,
+		SecretEncryptionMasterKey,
+		DNSListenAddress,
 	}
 
 	// For backwards compatibility, we must include "anything" and
@@ -570,6 +640,14 @@ var (
 		QueryTracingThreshold,
 		DqliteBusyTimeout,
 		SSHMaxConcurrentConnections,
+		BackupSchedule,
+		BackupRetention,
+		LogExportProtocol,
+		LogExportEndpoint,
+		LogExportCACert,
+		LogExportBufferSize,
+		SecretEncryptionMasterKey,
+		DNSListenAddress,
 	)
 
 	methodNameRE = regexp.MustCompile(`[[:alpha:]][[:alnum:]]*\.[[:alpha:]][[:alnum:]]*`)
@@ -1009,6 +1087,52 @@ func (c Config) MigrationMinionWaitMax() time.Duration {
 	return c.durationOrDefault(MigrationMinionWaitMax, DefaultMigrationMinionWaitMax)
 }
 
+// BackupSchedule returns the interval between scheduled controller backups.
+// A zero value means scheduled backups are disabled.
+func (c Config) BackupSchedule() time.Duration {
+	return c.durationOrDefault(BackupSchedule, DefaultBackupSchedule)
+}
+
+// BackupRetention returns the number of scheduled backups to keep.
+func (c Config) BackupRetention() int {
+	return c.intOrDefault(BackupRetention, DefaultBackupRetention)
+}
+
+// LogExportProtocol returns the protocol used to export logs, or an empty
+// string if log export is disabled.
+func (c Config) LogExportProtocol() string {
+	return c.asString(LogExportProtocol)
+}
+
+// LogExportEndpoint returns the collector endpoint logs are exported to.
+func (c Config) LogExportEndpoint() string {
+	return c.asString(LogExportEndpoint)
+}
+
+// LogExportCACert returns the CA certificate used to verify the log export
+// collector, if any.
+func (c Config) LogExportCACert() string {
+	return c.asString(LogExportCACert)
+}
+
+// LogExportBufferSizeMB returns the maximum size in MB of the on-disk buffer
+// of logs waiting to be exported.
+func (c Config) LogExportBufferSizeMB() int {
+	return c.sizeMBOrDefault(LogExportBufferSize, DefaultLogExportBufferSize)
+}
+
+// SecretEncryptionMasterKey returns the source of the master key used to
+// encrypt secret content, or an empty string if encryption is disabled.
+func (c Config) SecretEncryptionMasterKey() string {
+	return c.asString(SecretEncryptionMasterKey)
+}
+
+// DNSListenAddress returns the address on which controllers answer DNS
+// queries, or an empty string if the DNS responder is disabled.
+func (c Config) DNSListenAddress() string {
+	return c.asString(DNSListenAddress)
+}
+
 // QueryTracingEnabled returns whether query tracing is enabled.
 func (c Config) QueryTracingEnabled() bool {
 	return c.boolOrDefault(QueryTracingEnabled, DefaultQueryTracingEnabled)
@@ -1345,6 +1469,78 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, err := parseDuration(c, BackupSchedule); err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Annotatef(err, "parsing %s in configuration", BackupSchedule)
+	} else if err == nil {
+		if v < 0 {
+			return errors.Errorf("%s value %q must be a positive duration", BackupSchedule, v)
+		}
+	}
+
+	if v, ok := c[BackupRetention].(int); ok {
+		if v <= 0 {
+			return errors.NotValidf("non-positive integer for backup-retention")
+		}
+	}
+
+	if err := c.validateLogExport(); err != nil {
+		return errors.Trace(err)
+	}
+
+	if v, ok := c[SecretEncryptionMasterKey].(string); ok {
+		if _, err := encryption.ParseSource(v); err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", SecretEncryptionMasterKey)
+		}
+	}
+
+	if v, ok := c[DNSListenAddress].(string); ok && v != "" {
+		if _, _, err := net.SplitHostPort(v); err != nil {
+			return errors.Errorf("%s value %q must be a host:port, eg :53", DNSListenAddress, v)
+		}
+	}
+
+	return nil
+}
+
+func (c Config) validateLogExport() error {
+	if v, ok := c[LogExportBufferSize].(string); ok {
+		mb, err := utils.ParseSize(v)
+		if err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", LogExportBufferSize)
+		}
+		if mb < 1 {
+			return errors.NotValidf("%s less than 1 MB", LogExportBufferSize)
+		}
+	}
+
+	if v, ok := c[LogExportCACert].(string); ok && v != "" {
+		if ok, err := pki.IsPemCA([]byte(v)); err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", LogExportCACert)
+		} else if !ok {
+			return errors.NotValidf("%s is not a CA certificate", LogExportCACert)
+		}
+	}
+
+	protocol := c.LogExportProtocol()
+	endpoint := c.LogExportEndpoint()
+	switch protocol {
+	case "":
+		return nil
+	case LogExportOTLPHTTP:
+		u, err := url.Parse(endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("%s value %q must be an http or https URL when %s is %q",
+				LogExportEndpoint, endpoint, LogExportProtocol, protocol)
+		}
+	case LogExportSyslogTCP, LogExportSyslogTLS:
+		if _, _, err := net.SplitHostPort(endpoint); err != nil || endpoint == "" {
+			return errors.Errorf("%s value %q must be a host:port when %s is %q",
+				LogExportEndpoint, endpoint, LogExportProtocol, protocol)
+		}
+	default:
+		return errors.Errorf("%s value %q must be one of %s, %s or %s",
+			LogExportProtocol, protocol, LogExportOTLPHTTP, LogExportSyslogTCP, LogExportSyslogTLS)
+	}
 	return nil
 }
 
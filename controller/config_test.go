@@ -353,6 +353,68 @@ var newConfigTests = []struct {
 		controller.QueryTracingThreshold: "-1s",
 	},
 	expectError: `query-tracing-threshold value "-1s" must be a positive duration`,
+}, {
+	about: "invalid backup schedule value",
+	config: controller.Config{
+		controller.BackupSchedule: "invalid",
+	},
+	expectError: `backup-schedule: conversion to duration: time: invalid duration "invalid"`,
+}, {
+	about: "negative backup schedule duration",
+	config: controller.Config{
+		controller.BackupSchedule: "-1h",
+	},
+	expectError: `backup-schedule value "-1h0m0s" must be a positive duration`,
+}, {
+	about: "non-positive backup retention",
+	config: controller.Config{
+		controller.BackupRetention: 0,
+	},
+	expectError: `non-positive integer for backup-retention not valid`,
+}, {
+	about: "invalid log export protocol",
+	config: controller.Config{
+		controller.LogExportProtocol: "carrier-pigeon",
+	},
+	expectError: `log-export-protocol value "carrier-pigeon" must be one of otlp-http, syslog-tcp or syslog-tls`,
+}, {
+	about: "otlp log export without URL endpoint",
+	config: controller.Config{
+		controller.LogExportProtocol: controller.LogExportOTLPHTTP,
+		controller.LogExportEndpoint: "collector:4318",
+	},
+	expectError: `log-export-endpoint value "collector:4318" must be an http or https URL when log-export-protocol is "otlp-http"`,
+}, {
+	about: "syslog log export without host:port endpoint",
+	config: controller.Config{
+		controller.LogExportProtocol: controller.LogExportSyslogTLS,
+		controller.LogExportEndpoint: "collector",
+	},
+	expectError: `log-export-endpoint value "collector" must be a host:port when log-export-protocol is "syslog-tls"`,
+}, {
+	about: "invalid log export CA certificate",
+	config: controller.Config{
+		controller.LogExportCACert: "invalid",
+	},
+	expectError: `invalid log-export-ca-cert in configuration: .*`,
+}, {
+	about: "invalid log export buffer size",
+	config: controller.Config{
+		controller.LogExportBufferSize: "0",
+	},
+	expectError: `log-export-buffer-size less than 1 MB not valid`,
+}, {
+	about: "invalid secret encryption master key source",
+	config: controller.Config{
+		controller.SecretEncryptionMasterKey: "/etc/juju/secret.keys",
+	},
+	expectError: `invalid secret-encryption-master-key in configuration: expected <type>:<path>, got "/etc/juju/secret.keys"`,
+}, {
+	about: "invalid dns listen address",
+	config: controller.Config{
+		controller.DNSListenAddress: "53",
+	},
+	expectError: `dns-listen-address value "53" must be a host:port, eg :53`,
 }, {
 	about: "invalid dqlite busy timeout value",
 	config: controller.Config{
@@ -964,3 +1026,93 @@ func (s *ConfigSuite) TestSSHServerConcurrentConnections(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(cfg.SSHMaxConcurrentConnections(), tc.Equals, 10)
 }
+
+func (s *ConfigSuite) TestBackupScheduleDefault(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(cfg.BackupSchedule(), tc.Equals, controller.DefaultBackupSchedule)
+	c.Assert(cfg.BackupRetention(), tc.Equals, controller.DefaultBackupRetention)
+}
+
+func (s *ConfigSuite) TestBackupScheduleValues(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			controller.BackupSchedule:  "24h",
+			controller.BackupRetention: 3,
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.BackupSchedule(), tc.Equals, 24*time.Hour)
+	c.Assert(cfg.BackupRetention(), tc.Equals, 3)
+}
+
+func (s *ConfigSuite) TestLogExportDefault(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(cfg.LogExportProtocol(), tc.Equals, "")
+	c.Assert(cfg.LogExportEndpoint(), tc.Equals, "")
+	c.Assert(cfg.LogExportCACert(), tc.Equals, "")
+	c.Assert(cfg.LogExportBufferSizeMB(), tc.Equals, controller.DefaultLogExportBufferSize)
+}
+
+func (s *ConfigSuite) TestLogExportValues(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			controller.LogExportProtocol:   controller.LogExportSyslogTLS,
+			controller.LogExportEndpoint:   "collector:6514",
+			controller.LogExportCACert:     testing.CACert,
+			controller.LogExportBufferSize: "1G",
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.LogExportProtocol(), tc.Equals, controller.LogExportSyslogTLS)
+	c.Assert(cfg.LogExportEndpoint(), tc.Equals, "collector:6514")
+	c.Assert(cfg.LogExportCACert(), tc.Equals, testing.CACert)
+	c.Assert(cfg.LogExportBufferSizeMB(), tc.Equals, 1024)
+}
+
+func (s *ConfigSuite) TestSecretEncryptionMasterKey(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.SecretEncryptionMasterKey(), tc.Equals, "")
+
+	cfg, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			controller.SecretEncryptionMasterKey: "keystore:/var/lib/juju/keystore",
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.SecretEncryptionMasterKey(), tc.Equals, "keystore:/var/lib/juju/keystore")
+}
+
+func (s *ConfigSuite) TestDNSListenAddress(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.DNSListenAddress(), tc.Equals, "")
+
+	cfg, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			controller.DNSListenAddress: "[::]:53",
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.DNSListenAddress(), tc.Equals, "[::]:53")
+}
@@ -64,6 +64,14 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujudControllerSnapSource:          schema.String(),
 	SSHServerPort:                      schema.ForceInt(),
 	SSHMaxConcurrentConnections:        schema.ForceInt(),
+	BackupSchedule:                     schema.TimeDurationString(),
+	BackupRetention:                    schema.ForceInt(),
+	LogExportProtocol:                  schema.String(),
+	LogExportEndpoint:                  schema.String(),
+	LogExportCACert:                    schema.String(),
+	LogExportBufferSize:                schema.String(),
+	SecretEncryptionMasterKey:          schema.String(),
+	DNSListenAddress:                   schema.String(),
 }, schema.Defaults{
 	AgentRateLimitMax:                  schema.Omit,
 	AgentRateLimitRate:                 schema.Omit,
@@ -117,6 +125,14 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujudControllerSnapSource:          DefaultJujudControllerSnapSource,
 	SSHServerPort:                      DefaultSSHServerPort,
 	SSHMaxConcurrentConnections:        DefaultSSHMaxConcurrentConnections,
+	BackupSchedule:                     schema.Omit,
+	BackupRetention:                    schema.Omit,
+	LogExportProtocol:                  schema.Omit,
+	LogExportEndpoint:                  schema.Omit,
+	LogExportCACert:                    schema.Omit,
+	LogExportBufferSize:                schema.Omit,
+	SecretEncryptionMasterKey:          schema.Omit,
+	DNSListenAddress:                   schema.Omit,
 })
 
 // ConfigSchema holds information on all the fields defined by
@@ -347,4 +363,52 @@ same database.`[1:],
 		Type:        configschema.Tint,
 		Description: `The maximum number of concurrent ssh connections to the controller`,
 	},
+	BackupSchedule: {
+		Type: configschema.Tstring,
+		Description: `
+The interval between backups taken by the controller and stored in the
+controller object store. A value of 0 disables scheduled backups.`[1:],
+	},
+	BackupRetention: {
+		Type:        configschema.Tint,
+		Description: `The number of scheduled backups to keep in the controller object store`,
+	},
+	LogExportProtocol: {
+		Type: configschema.Tstring,
+		Description: `
+The protocol used to export agent logs to an external collector, one of
+otlp-http, syslog-tcp or syslog-tls. An empty value disables log export.`[1:],
+	},
+	LogExportEndpoint: {
+		Type: configschema.Tstring,
+		Description: `
+The collector endpoint agent logs are exported to. This is a URL for
+otlp-http, eg https://collector:4318/v1/logs, and a host:port for the
+syslog protocols.`[1:],
+	},
+	LogExportCACert: {
+		Type:        configschema.Tstring,
+		Description: `The PEM encoded CA certificate used to verify the log export collector`,
+	},
+	LogExportBufferSize: {
+		Type: configschema.Tstring,
+		Description: `
+The maximum size of the on-disk buffer of logs waiting to be exported,
+eg "100M". When the buffer is full the oldest logs are dropped.`[1:],
+	},
+	SecretEncryptionMasterKey: {
+		Type: configschema.Tstring,
+		Description: `
+The source of the master key used to encrypt the secret content stored by
+the internal secret backend: file:<path> for a file of base64 encoded keys,
+or keystore:<dir> for a local keystore directory. The source must be present
+on every controller machine. An empty value disables encryption.`[1:],
+	},
+	DNSListenAddress: {
+		Type: configschema.Tstring,
+		Description: `
+The address, eg :53, on which each controller answers DNS queries for
+<unit-number>.<application>.<model>.juju and <application>.<model>.juju
+names. An empty value disables the DNS responder.`[1:],
+	},
 }
@@ -0,0 +1,115 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"encoding/json"
+	"io"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// ObjectStoreDir is the directory in the controller object store in
+	// which scheduled backups are kept.
+	ObjectStoreDir = "backups"
+
+	// IndexPath is the path in the controller object store of the index
+	// of scheduled backups.
+	IndexPath = ObjectStoreDir + "/index.json"
+
+	// IndexNextPath is the path in the controller object store to which a
+	// new index is written before it replaces the one at IndexPath. When
+	// present, it holds the most recent index.
+	IndexNextPath = ObjectStoreDir + "/index.next.json"
+)
+
+// ObjectStorePath returns the path in the controller object store of the
+// scheduled backup archive with the given filename.
+func ObjectStorePath(filename string) string {
+	return path.Join(ObjectStoreDir, filename)
+}
+
+// Status describes the outcome of a scheduled backup.
+type Status string
+
+const (
+	// StatusSucceeded indicates that the backup archive was created and
+	// stored.
+	StatusSucceeded Status = "succeeded"
+
+	// StatusFailed indicates that the backup could not be created or
+	// stored.
+	StatusFailed Status = "failed"
+)
+
+// IndexEntry records a single scheduled backup attempt.
+type IndexEntry struct {
+	// Filename is the name of the backup archive. The archive is stored
+	// at ObjectStorePath(Filename) when Status is StatusSucceeded.
+	Filename string `json:"filename"`
+
+	// Started records when the backup was started.
+	Started time.Time `json:"started"`
+
+	// Finished records when the backup was complete or failed.
+	Finished time.Time `json:"finished"`
+
+	// Size is the size in bytes of the stored archive.
+	Size int64 `json:"size,omitempty"`
+
+	// Checksum is the checksum of the stored archive.
+	Checksum string `json:"checksum,omitempty"`
+
+	// Status is the outcome of the backup.
+	Status Status `json:"status"`
+
+	// Message holds the reason a backup failed.
+	Message string `json:"message,omitempty"`
+}
+
+// Index is the record of scheduled backups kept in the controller object
+// store, ordered from oldest to newest.
+type Index struct {
+	Entries []IndexEntry `json:"entries"`
+}
+
+// ReadIndex decodes an index from the reader.
+func ReadIndex(r io.Reader) (Index, error) {
+	var index Index
+	if err := json.NewDecoder(r).Decode(&index); err != nil {
+		return Index{}, errors.Errorf("decoding backups index: %w", err)
+	}
+	sort.SliceStable(index.Entries, func(i, j int) bool {
+		return index.Entries[i].Started.Before(index.Entries[j].Started)
+	})
+	return index, nil
+}
+
+// Bytes returns the JSON encoding of the index.
+func (i Index) Bytes() ([]byte, error) {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return nil, errors.Errorf("encoding backups index: %w", err)
+	}
+	return data, nil
+}
+
+// Add appends the entry to the index and drops the oldest entries so that
+// at most retain entries are kept. The dropped entries are returned so that
+// their archives can be removed.
+func (i *Index) Add(entry IndexEntry, retain int) []IndexEntry {
+	i.Entries = append(i.Entries, entry)
+	if retain <= 0 || len(i.Entries) <= retain {
+		return nil
+	}
+	n := len(i.Entries) - retain
+	dropped := make([]IndexEntry, n)
+	copy(dropped, i.Entries[:n])
+	i.Entries = append([]IndexEntry(nil), i.Entries[n:]...)
+	return dropped
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"bytes"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/backups"
+	"github.com/juju/juju/internal/testing"
+)
+
+type indexSuite struct {
+	testing.BaseSuite
+}
+
+func TestIndexSuite(t *stdtesting.T) {
+	tc.Run(t, &indexSuite{})
+}
+
+func (s *indexSuite) TestObjectStorePath(c *tc.C) {
+	c.Check(backups.ObjectStorePath("juju-backup-1.tar.gz"), tc.Equals, "backups/juju-backup-1.tar.gz")
+}
+
+func (s *indexSuite) TestAddWithinRetention(c *tc.C) {
+	var index backups.Index
+	dropped := index.Add(backups.IndexEntry{Filename: "a"}, 2)
+	c.Check(dropped, tc.HasLen, 0)
+	dropped = index.Add(backups.IndexEntry{Filename: "b"}, 2)
+	c.Check(dropped, tc.HasLen, 0)
+	c.Check(index.Entries, tc.HasLen, 2)
+}
+
+func (s *indexSuite) TestAddDropsOldest(c *tc.C) {
+	var index backups.Index
+	index.Add(backups.IndexEntry{Filename: "a"}, 3)
+	index.Add(backups.IndexEntry{Filename: "b"}, 3)
+	index.Add(backups.IndexEntry{Filename: "c"}, 3)
+
+	dropped := index.Add(backups.IndexEntry{Filename: "d"}, 2)
+	c.Assert(dropped, tc.HasLen, 2)
+	c.Check(dropped[0].Filename, tc.Equals, "a")
+	c.Check(dropped[1].Filename, tc.Equals, "b")
+	c.Assert(index.Entries, tc.HasLen, 2)
+	c.Check(index.Entries[0].Filename, tc.Equals, "c")
+	c.Check(index.Entries[1].Filename, tc.Equals, "d")
+}
+
+func (s *indexSuite) TestRoundTrip(c *tc.C) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	index := backups.Index{
+		Entries: []backups.IndexEntry{{
+			Filename: "b",
+			Started:  now,
+			Finished: now.Add(time.Minute),
+			Status:   backups.StatusFailed,
+			Message:  "boom",
+		}, {
+			Filename: "a",
+			Started:  now.Add(-time.Hour),
+			Finished: now.Add(-time.Hour + time.Minute),
+			Size:     42,
+			Checksum: "abc",
+			Status:   backups.StatusSucceeded,
+		}},
+	}
+
+	data, err := index.Bytes()
+	c.Assert(err, tc.ErrorIsNil)
+
+	read, err := backups.ReadIndex(bytes.NewReader(data))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(read.Entries, tc.HasLen, 2)
+
+	// Entries are ordered oldest first.
+	c.Check(read.Entries[0], tc.DeepEquals, index.Entries[1])
+	c.Check(read.Entries[1], tc.DeepEquals, index.Entries[0])
+}
+
+func (s *indexSuite) TestReadIndexInvalid(c *tc.C) {
+	_, err := backups.ReadIndex(bytes.NewBufferString("not json"))
+	c.Assert(err, tc.ErrorMatches, "decoding backups index: .*")
+}
@@ -0,0 +1,218 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/juju/collections/set"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/internal/errors"
+)
+
+// EgressRule represents a rule for allowing traffic to a set of destination
+// CIDRs on a particular port range.
+type EgressRule struct {
+	// The destination port range for the outgoing traffic.
+	PortRange network.PortRange
+
+	// A set of CIDRs that describe the destination of outgoing traffic. An
+	// implicit 0.0.0.0/0 CIDR is assumed if no CIDRs are specified.
+	DestinationCIDRs set.Strings
+}
+
+// NewEgressRule creates a new EgressRule for allowing access to portRange
+// on the list of destinationCIDRs. If no destinationCIDRs are specified,
+// the rule will implicitly apply to all networks.
+func NewEgressRule(portRange network.PortRange, destinationCIDRs ...string) EgressRule {
+	return EgressRule{
+		PortRange:        portRange,
+		DestinationCIDRs: set.NewStrings(destinationCIDRs...),
+	}
+}
+
+// ParseEgressRule parses an egress rule of the form
+// <cidr>:<port>[-<port>][/<protocol>], for example "10.0.0.0/8:5432" or
+// "2001:db8::/32:8000-8080/udp". If no protocol is specified then "tcp" is
+// used.
+func ParseEgressRule(in string) (EgressRule, error) {
+	// The port range follows the first colon after the CIDR prefix length,
+	// since IPv6 CIDRs contain colons themselves.
+	slash := strings.Index(in, "/")
+	if slash == -1 {
+		return EgressRule{}, errors.Errorf("invalid egress rule %q: expected <cidr>:<ports>", in)
+	}
+	colon := strings.Index(in[slash:], ":")
+	if colon == -1 {
+		return EgressRule{}, errors.Errorf("invalid egress rule %q: expected <cidr>:<ports>", in)
+	}
+	cidr, ports := in[:slash+colon], in[slash+colon+1:]
+
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		return EgressRule{}, errors.Errorf("invalid egress rule %q: %w", in, err)
+	}
+	portRange, err := network.ParsePortRange(ports)
+	if err != nil {
+		return EgressRule{}, errors.Errorf("invalid egress rule %q: %w", in, err)
+	}
+	return NewEgressRule(portRange, cidr), nil
+}
+
+// Validate ensures that the egress rule contains valid destination
+// parameters.
+func (r EgressRule) Validate() error {
+	if err := r.PortRange.Validate(); err != nil {
+		return errors.Errorf("invalid destination for egress rule: %w", err)
+	}
+
+	for dstCIDR := range r.DestinationCIDRs {
+		if _, _, err := net.ParseCIDR(dstCIDR); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	return nil
+}
+
+// String is the string representation of EgressRule.
+func (r EgressRule) String() string {
+	var buf bytes.Buffer
+	_, _ = fmt.Fprint(&buf, r.PortRange.String())
+
+	dst := strings.Join(r.DestinationCIDRs.SortedValues(), ",")
+	if dst != "" && dst != AllNetworksIPV4CIDR && dst != AllNetworksIPV6CIDR {
+		_, _ = fmt.Fprintf(&buf, " to %s", dst)
+	}
+	return buf.String()
+}
+
+// LessThan compares two EgressRule instances for equality.
+func (r EgressRule) LessThan(other EgressRule) bool {
+	if r.PortRange != other.PortRange {
+		return r.PortRange.LessThan(other.PortRange)
+	}
+
+	thisDst := strings.Join(r.DestinationCIDRs.SortedValues(), ",")
+	otherDst := strings.Join(other.DestinationCIDRs.SortedValues(), ",")
+	return thisDst < otherDst
+}
+
+// EqualTo returns true if this rule is equal to the provided rule.
+func (r EgressRule) EqualTo(other EgressRule) bool {
+	if r.PortRange != other.PortRange {
+		return false
+	}
+	thisDst := r.DestinationCIDRs.SortedValues()
+	otherDst := other.DestinationCIDRs.SortedValues()
+	if len(thisDst) != len(otherDst) {
+		return false
+	}
+	for i, thisCIDR := range thisDst {
+		if thisCIDR != otherDst[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// EgressRules represents a collection of EgressRule instances.
+type EgressRules []EgressRule
+
+// Sort the rule list by port range and then by destination CIDRs.
+func (rules EgressRules) Sort() {
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].LessThan(rules[j])
+	})
+}
+
+// Validate the list of egress rules.
+func (rules EgressRules) Validate() error {
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EqualTo returns true if this rule list is equal to the provided rule list.
+// Both lists are compared in their compacted form.
+func (rules EgressRules) EqualTo(other EgressRules) bool {
+	a, b := rules.Compact(), other.Compact()
+	if len(a) != len(b) {
+		return false
+	}
+	for i, thisRule := range a {
+		if !thisRule.EqualTo(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compact returns a sorted copy of the rule list where all rules sharing a
+// port range are merged into a single rule. Rules without destination CIDRs
+// are expanded to the IPV4 and IPV6 quad-zero CIDRs.
+func (rules EgressRules) Compact() EgressRules {
+	byPortRange := make(map[network.PortRange]set.Strings, len(rules))
+	for _, rule := range rules {
+		cidrs, ok := byPortRange[rule.PortRange]
+		if !ok {
+			cidrs = set.NewStrings()
+			byPortRange[rule.PortRange] = cidrs
+		}
+		if rule.DestinationCIDRs.IsEmpty() {
+			cidrs.Add(AllNetworksIPV4CIDR)
+			cidrs.Add(AllNetworksIPV6CIDR)
+			continue
+		}
+		for cidr := range rule.DestinationCIDRs {
+			cidrs.Add(cidr)
+		}
+	}
+
+	out := make(EgressRules, 0, len(byPortRange))
+	for portRange, cidrs := range byPortRange {
+		out = append(out, EgressRule{
+			PortRange:        portRange,
+			DestinationCIDRs: cidrs,
+		})
+	}
+	out.Sort()
+	return out
+}
+
+// RemoveCIDRsMatchingAddressType returns a new list of rules where any CIDR
+// whose address type corresponds to the specified AddressType argument has
+// been removed. A nil list is returned unchanged.
+func (rules EgressRules) RemoveCIDRsMatchingAddressType(removeAddrType network.AddressType) EgressRules {
+	if rules == nil {
+		return nil
+	}
+	out := make(EgressRules, 0, len(rules))
+
+	for _, rule := range rules.Compact() {
+		filteredCIDRS := set.NewStrings(rule.DestinationCIDRs.Values()...)
+		for dstCIDR := range rule.DestinationCIDRs {
+			if addrType, _ := network.CIDRAddressType(dstCIDR); addrType == removeAddrType {
+				filteredCIDRS.Remove(dstCIDR)
+			}
+		}
+
+		if filteredCIDRS.IsEmpty() {
+			continue
+		}
+
+		out = append(out, EgressRule{
+			PortRange:        rule.PortRange,
+			DestinationCIDRs: filteredCIDRS,
+		})
+	}
+	return out
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+func TestEgressRuleSuite(t *testing.T) {
+	tc.Run(t, &EgressRuleSuite{})
+}
+
+type EgressRuleSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func (s *EgressRuleSuite) TestRuleFormatting(c *tc.C) {
+	pr := network.MustParsePortRange("5432/tcp")
+	r1 := NewEgressRule(pr)
+	c.Assert(r1.DestinationCIDRs, tc.HasLen, 0)
+	c.Assert(r1.String(), tc.Equals, "5432/tcp")
+
+	r2 := NewEgressRule(pr, "10.0.0.0/8", "192.168.0.0/16", "10.0.0.0/8")
+	c.Assert(r2.DestinationCIDRs, tc.HasLen, 2)
+	c.Assert(r2.String(), tc.Equals, "5432/tcp to 10.0.0.0/8,192.168.0.0/16")
+}
+
+func (s *EgressRuleSuite) TestParseEgressRule(c *tc.C) {
+	specs := []struct {
+		in       string
+		expected EgressRule
+	}{{
+		in:       "10.0.0.0/8:5432",
+		expected: NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	}, {
+		in:       "10.0.0.0/8:8000-8080/udp",
+		expected: NewEgressRule(network.MustParsePortRange("8000-8080/udp"), "10.0.0.0/8"),
+	}, {
+		in:       "2001:db8::/32:443",
+		expected: NewEgressRule(network.MustParsePortRange("443/tcp"), "2001:db8::/32"),
+	}, {
+		in:       "0.0.0.0/0:icmp",
+		expected: NewEgressRule(network.MustParsePortRange("icmp"), "0.0.0.0/0"),
+	}}
+	for i, spec := range specs {
+		c.Logf("test %d: %q", i, spec.in)
+		rule, err := ParseEgressRule(spec.in)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(rule.EqualTo(spec.expected), tc.IsTrue, tc.Commentf("got %v", rule))
+	}
+}
+
+func (s *EgressRuleSuite) TestParseEgressRuleInvalid(c *tc.C) {
+	specs := []struct {
+		in  string
+		err string
+	}{
+		{in: "10.0.0.1:5432", err: `invalid egress rule "10.0.0.1:5432": expected <cidr>:<ports>`},
+		{in: "10.0.0.0/8", err: `invalid egress rule "10.0.0.0/8": expected <cidr>:<ports>`},
+		{in: "10.0.0.0/88:5432", err: `invalid egress rule .*: invalid CIDR address: 10.0.0.0/88`},
+		{in: "10.0.0.0/8:gopher", err: `invalid egress rule .*`},
+		{in: "10.0.0.0/8:80/gopher", err: `invalid egress rule .*invalid protocol "gopher".*`},
+	}
+	for i, spec := range specs {
+		c.Logf("test %d: %q", i, spec.in)
+		_, err := ParseEgressRule(spec.in)
+		c.Check(err, tc.ErrorMatches, spec.err)
+	}
+}
+
+func (s *EgressRuleSuite) TestRuleValidation(c *tc.C) {
+	pr := network.MustParsePortRange("5432/tcp")
+	c.Assert(NewEgressRule(pr, "bogus").Validate(), tc.ErrorMatches, ".*invalid CIDR address: bogus")
+	c.Assert(NewEgressRule(pr, "10.0.0.0/8").Validate(), tc.ErrorIsNil)
+}
+
+func (s *EgressRuleSuite) TestCompact(c *tc.C) {
+	rules := EgressRules{
+		NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+		NewEgressRule(network.MustParsePortRange("53/udp")),
+		NewEgressRule(network.MustParsePortRange("5432/tcp"), "192.168.0.0/16", "10.0.0.0/8"),
+	}
+	c.Assert(rules.Compact(), tc.DeepEquals, EgressRules{
+		NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8", "192.168.0.0/16"),
+		NewEgressRule(network.MustParsePortRange("53/udp"), AllNetworksIPV4CIDR, AllNetworksIPV6CIDR),
+	})
+}
+
+func (s *EgressRuleSuite) TestRulesEquality(c *tc.C) {
+	a := EgressRules{
+		NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+		NewEgressRule(network.MustParsePortRange("5432/tcp"), "192.168.0.0/16"),
+	}
+	b := EgressRules{
+		NewEgressRule(network.MustParsePortRange("5432/tcp"), "192.168.0.0/16", "10.0.0.0/8"),
+	}
+	c.Assert(a.EqualTo(b), tc.IsTrue)
+
+	b = append(b, NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"))
+	c.Assert(a.EqualTo(b), tc.IsFalse)
+}
+
+func (s *EgressRuleSuite) TestRemoveCIDRsMatchingAddressType(c *tc.C) {
+	rules := EgressRules{
+		NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8", "2001:db8::/32"),
+		NewEgressRule(network.MustParsePortRange("443/tcp"), "2001:db8::/32"),
+	}
+	c.Assert(rules.RemoveCIDRsMatchingAddressType(network.IPv6Address), tc.DeepEquals, EgressRules{
+		NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	})
+
+	var unrestricted EgressRules
+	c.Assert(unrestricted.RemoveCIDRsMatchingAddressType(network.IPv6Address), tc.IsNil)
+}
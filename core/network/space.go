@@ -104,6 +104,11 @@ type SpaceInfo struct {
 
 	// Subnets are the subnets that have been grouped into this network space.
 	Subnets SubnetInfos
+
+	// PreferredAddressType is the type of IP address preferred when
+	// selecting among the addresses of dual-stack machines in the space.
+	// IPv4 addresses are preferred if it is empty.
+	PreferredAddressType AddressType
 }
 
 // SpaceInfos is a collection of spaces.
@@ -0,0 +1,160 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package operation
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/names/v6"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// RetentionRule describes how long the operations it matches are kept
+// before they are pruned.
+type RetentionRule struct {
+	// Application, if set, restricts the rule to operations with a task on a
+	// unit of the application.
+	Application string
+
+	// Action, if set, restricts the rule to operations running the action.
+	// Operations run with juju exec have the action name "juju-exec".
+	Action string
+
+	// MaxAge is how long completed operations are kept. If zero, the
+	// max-action-results-age of the model applies.
+	MaxAge time.Duration
+
+	// FailedMaxAge is how long completed operations with a failed task are
+	// kept. If zero, failed operations are kept for MaxAge.
+	FailedMaxAge time.Duration
+
+	// KeepLast, if positive, is how many of the most recently completed
+	// operations are kept for each action; older operations are pruned
+	// whatever their age.
+	KeepLast int
+
+	// Audit indicates that the operations are never pruned.
+	Audit bool
+}
+
+// Matches returns true if the rule applies to an operation running the
+// action with tasks on units of the applications.
+func (r RetentionRule) Matches(action string, applications []string) bool {
+	if r.Action != "" && r.Action != action {
+		return false
+	}
+	if r.Application != "" && !slices.Contains(applications, r.Application) {
+		return false
+	}
+	return true
+}
+
+// RetentionPolicy is an ordered list of retention rules. The first rule
+// matching an operation applies to it; operations matched by no rule are
+// kept for the max-action-results-age of the model.
+type RetentionPolicy []RetentionRule
+
+// Match returns the first rule matching an operation running the action
+// with tasks on units of the applications.
+func (p RetentionPolicy) Match(action string, applications []string) (RetentionRule, bool) {
+	for _, rule := range p {
+		if rule.Matches(action, applications) {
+			return rule, true
+		}
+	}
+	return RetentionRule{}, false
+}
+
+// HasAudit returns true if any rule of the policy marks operations for
+// audit.
+func (p RetentionPolicy) HasAudit() bool {
+	return slices.ContainsFunc(p, func(r RetentionRule) bool { return r.Audit })
+}
+
+// ParseRetentionPolicy parses a retention policy from its model config
+// form: rules separated by semicolons, each a space-separated list of
+// key=value fields. For example:
+//
+//	action=backup max-age=8760h; action=health-check keep-last=10; application=vault audit=true
+func ParseRetentionPolicy(s string) (RetentionPolicy, error) {
+	var policy RetentionPolicy
+	for i, raw := range strings.Split(s, ";") {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		rule, err := parseRetentionRule(raw)
+		if err != nil {
+			return nil, errors.Errorf("rule %d: %w", i+1, err)
+		}
+		policy = append(policy, rule)
+	}
+	return policy, nil
+}
+
+func parseRetentionRule(s string) (RetentionRule, error) {
+	var rule RetentionRule
+	for _, field := range strings.Fields(s) {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok || value == "" {
+			return RetentionRule{}, errors.Errorf("expected key=value, got %q", field).Add(coreerrors.NotValid)
+		}
+		var err error
+		switch key {
+		case "application":
+			if !names.IsValidApplication(value) {
+				return RetentionRule{}, errors.Errorf("application name %q", value).Add(coreerrors.NotValid)
+			}
+			rule.Application = value
+		case "action":
+			rule.Action = value
+		case "max-age":
+			rule.MaxAge, err = parsePositiveDuration(key, value)
+		case "failed-max-age":
+			rule.FailedMaxAge, err = parsePositiveDuration(key, value)
+		case "keep-last":
+			rule.KeepLast, err = strconv.Atoi(value)
+			if err == nil && rule.KeepLast <= 0 {
+				err = errors.Errorf("keep-last must be positive, got %d", rule.KeepLast).Add(coreerrors.NotValid)
+			} else if err != nil {
+				err = errors.Errorf("keep-last %q: %w", value, err).Add(coreerrors.NotValid)
+			}
+		case "audit":
+			rule.Audit, err = strconv.ParseBool(value)
+			if err != nil {
+				err = errors.Errorf("audit %q: %w", value, err).Add(coreerrors.NotValid)
+			}
+		default:
+			return RetentionRule{}, errors.Errorf("unknown key %q", key).Add(coreerrors.NotValid)
+		}
+		if err != nil {
+			return RetentionRule{}, err
+		}
+	}
+
+	if rule.Audit && (rule.MaxAge > 0 || rule.FailedMaxAge > 0 || rule.KeepLast > 0) {
+		return RetentionRule{}, errors.New(
+			"audit cannot be combined with max-age, failed-max-age or keep-last").Add(coreerrors.NotValid)
+	}
+	if !rule.Audit && rule.MaxAge == 0 && rule.FailedMaxAge == 0 && rule.KeepLast == 0 {
+		return RetentionRule{}, errors.New(
+			"expected one of max-age, failed-max-age, keep-last or audit").Add(coreerrors.NotValid)
+	}
+	return rule, nil
+}
+
+func parsePositiveDuration(key, value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.Errorf("%s %q: %w", key, value, err).Add(coreerrors.NotValid)
+	}
+	if d <= 0 {
+		return 0, errors.Errorf("%s must be positive, got %s", key, value).Add(coreerrors.NotValid)
+	}
+	return d, nil
+}
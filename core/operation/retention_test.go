@@ -0,0 +1,88 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package operation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+)
+
+type retentionSuite struct{}
+
+func TestRetentionSuite(t *testing.T) {
+	tc.Run(t, &retentionSuite{})
+}
+
+func (s *retentionSuite) TestParseRetentionPolicy(c *tc.C) {
+	policy, err := ParseRetentionPolicy(
+		"action=backup max-age=8760h; action=health-check keep-last=10;; application=vault audit=true; failed-max-age=720h")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(policy, tc.DeepEquals, RetentionPolicy{
+		{Action: "backup", MaxAge: 8760 * time.Hour},
+		{Action: "health-check", KeepLast: 10},
+		{Application: "vault", Audit: true},
+		{FailedMaxAge: 720 * time.Hour},
+	})
+	c.Check(policy.HasAudit(), tc.IsTrue)
+}
+
+func (s *retentionSuite) TestParseRetentionPolicyEmpty(c *tc.C) {
+	policy, err := ParseRetentionPolicy("")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(policy, tc.HasLen, 0)
+}
+
+func (s *retentionSuite) TestParseRetentionPolicyInvalid(c *tc.C) {
+	for _, t := range []struct {
+		policy string
+		err    string
+	}{{
+		policy: "action=backup",
+		err:    "rule 1: expected one of max-age, failed-max-age, keep-last or audit",
+	}, {
+		policy: "action=backup max-age=1y",
+		err:    `rule 1: max-age "1y": .*`,
+	}, {
+		policy: "max-age=1h; keep-last=0",
+		err:    "rule 2: keep-last must be positive, got 0",
+	}, {
+		policy: "audit=true max-age=1h",
+		err:    "rule 1: audit cannot be combined with max-age, failed-max-age or keep-last",
+	}, {
+		policy: "application=Vault audit=true",
+		err:    `rule 1: application name "Vault"`,
+	}, {
+		policy: "colour=blue",
+		err:    `rule 1: unknown key "colour"`,
+	}, {
+		policy: "backup",
+		err:    `rule 1: expected key=value, got "backup"`,
+	}} {
+		_, err := ParseRetentionPolicy(t.policy)
+		c.Check(err, tc.ErrorMatches, t.err, tc.Commentf("policy %q", t.policy))
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid, tc.Commentf("policy %q", t.policy))
+	}
+}
+
+func (s *retentionSuite) TestMatchFirstRule(c *tc.C) {
+	policy := RetentionPolicy{
+		{Application: "vault", Action: "backup", Audit: true},
+		{Action: "backup", MaxAge: time.Hour},
+	}
+
+	rule, ok := policy.Match("backup", []string{"vault"})
+	c.Assert(ok, tc.IsTrue)
+	c.Check(rule.Audit, tc.IsTrue)
+
+	rule, ok = policy.Match("backup", []string{"mysql"})
+	c.Assert(ok, tc.IsTrue)
+	c.Check(rule.MaxAge, tc.Equals, time.Hour)
+
+	_, ok = policy.Match("juju-exec", []string{"vault"})
+	c.Check(ok, tc.IsFalse)
+}
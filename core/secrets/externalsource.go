@@ -0,0 +1,78 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ExternalSource identifies content held at a path in a secret backend,
+// outside of the paths managed by Juju, from which the content of a user
+// secret is synced. It is written as <backend>:<path>[#<key>], where
+// backend is the name of a secret backend known to the controller, such as
+// "vault:kv/prod/db#password". Without a key, every key at the path is
+// synced.
+type ExternalSource struct {
+	Backend string
+	Path    string
+	Key     string
+}
+
+// ParseExternalSource parses a reference to content in a secret backend.
+func ParseExternalSource(str string) (ExternalSource, error) {
+	backend, rest, ok := strings.Cut(str, ":")
+	if !ok || backend == "" {
+		return ExternalSource{}, errors.Errorf(
+			"external source %q must be of the form <backend>:<path>[#<key>] %w", str, coreerrors.NotValid)
+	}
+	path, key, hasKey := strings.Cut(rest, "#")
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return ExternalSource{}, errors.Errorf("external source %q has no path %w", str, coreerrors.NotValid)
+	}
+	if hasKey && !keyRegExp.MatchString(key) {
+		return ExternalSource{}, errors.Errorf("external source key %q %w", key, coreerrors.NotValid)
+	}
+	return ExternalSource{
+		Backend: backend,
+		Path:    path,
+		Key:     key,
+	}, nil
+}
+
+// String returns the reference in the form accepted by
+// [ParseExternalSource].
+func (s ExternalSource) String() string {
+	str := s.Backend + ":" + s.Path
+	if s.Key != "" {
+		str += "#" + s.Key
+	}
+	return str
+}
+
+// Select returns the content of a secret synced from the content read from
+// the source: the value of the source key if it has one, or otherwise every
+// value read.
+func (s ExternalSource) Select(value SecretValue) (SecretData, error) {
+	data := SecretData(value.EncodedValues())
+	if s.Key != "" {
+		v, ok := data[s.Key]
+		if !ok {
+			return nil, errors.Errorf("key %q in external source %q %w", s.Key, s, coreerrors.NotFound)
+		}
+		return SecretData{s.Key: v}, nil
+	}
+	if len(data) == 0 {
+		return nil, errors.Errorf("external source %q has no content %w", s, coreerrors.NotFound)
+	}
+	for key := range data {
+		if !keyRegExp.MatchString(key) {
+			return nil, errors.Errorf("key %q in external source %q %w", key, s, coreerrors.NotValid)
+		}
+	}
+	return data, nil
+}
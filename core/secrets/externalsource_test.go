@@ -0,0 +1,95 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+)
+
+type ExternalSourceSuite struct{}
+
+func TestExternalSourceSuite(t *testing.T) {
+	tc.Run(t, &ExternalSourceSuite{})
+}
+
+func (s *ExternalSourceSuite) TestParse(c *tc.C) {
+	for _, t := range []struct {
+		in       string
+		expected secrets.ExternalSource
+	}{{
+		in:       "vault:kv/prod/db#password",
+		expected: secrets.ExternalSource{Backend: "vault", Path: "kv/prod/db", Key: "password"},
+	}, {
+		in:       "myvault:/kv/prod/db/",
+		expected: secrets.ExternalSource{Backend: "myvault", Path: "kv/prod/db"},
+	}} {
+		c.Logf("%s", t.in)
+		source, err := secrets.ParseExternalSource(t.in)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(source, tc.DeepEquals, t.expected)
+	}
+}
+
+func (s *ExternalSourceSuite) TestParseInvalid(c *tc.C) {
+	for _, t := range []struct {
+		in  string
+		err string
+	}{{
+		in:  "kv/prod/db",
+		err: `external source "kv/prod/db" must be of the form <backend>:<path>\[#<key>\] not valid`,
+	}, {
+		in:  ":kv/prod/db",
+		err: `external source ":kv/prod/db" must be of the form <backend>:<path>\[#<key>\] not valid`,
+	}, {
+		in:  "vault:#password",
+		err: `external source "vault:#password" has no path not valid`,
+	}, {
+		in:  "vault:kv/prod/db#DB_PASS",
+		err: `external source key "DB_PASS" not valid`,
+	}} {
+		c.Logf("%s", t.in)
+		_, err := secrets.ParseExternalSource(t.in)
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+		c.Check(err, tc.ErrorMatches, t.err)
+	}
+}
+
+func (s *ExternalSourceSuite) TestString(c *tc.C) {
+	source := secrets.ExternalSource{Backend: "vault", Path: "kv/prod/db", Key: "password"}
+	c.Check(source.String(), tc.Equals, "vault:kv/prod/db#password")
+	source.Key = ""
+	c.Check(source.String(), tc.Equals, "vault:kv/prod/db")
+}
+
+func (s *ExternalSourceSuite) TestSelect(c *tc.C) {
+	value := secrets.NewSecretValue(map[string]string{
+		"username": "YWRtaW4=",
+		"password": "czNjcmV0",
+	})
+
+	source := secrets.ExternalSource{Backend: "vault", Path: "kv/prod/db", Key: "password"}
+	data, err := source.Select(value)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data, tc.DeepEquals, secrets.SecretData{"password": "czNjcmV0"})
+
+	source.Key = ""
+	data, err = source.Select(value)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data, tc.DeepEquals, secrets.SecretData{"username": "YWRtaW4=", "password": "czNjcmV0"})
+
+	source.Key = "token"
+	_, err = source.Select(value)
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *ExternalSourceSuite) TestSelectInvalidKey(c *tc.C) {
+	source := secrets.ExternalSource{Backend: "vault", Path: "kv/prod/db"}
+	_, err := source.Select(secrets.NewSecretValue(map[string]string{"DB_PASS": "czNjcmV0"}))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
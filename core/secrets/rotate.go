@@ -6,7 +6,9 @@ package secrets
 import "time"
 
 // RotatePolicy defines a policy for how often
-// to rotate a secret.
+// to rotate a secret. As well as the fixed policies below, a policy may
+// be a custom interval or cron schedule, such as "36h", "0 3 * * SUN" or
+// "45d@0 3 * * SUN~1h"; see [rotateSchedule].
 type RotatePolicy string
 
 const (
@@ -43,17 +45,37 @@ func (p *RotatePolicy) WillRotate() bool {
 
 // IsValid returns true if p is a valid rotate policy.
 func (p RotatePolicy) IsValid() bool {
+	return p.Validate() == nil
+}
+
+// Validate returns an error satisfying [coreerrors.NotValid] describing why
+// p is not a valid rotate policy.
+func (p RotatePolicy) Validate() error {
+	if p.IsCustom() {
+		_, err := parseRotateSchedule(string(p))
+		return err
+	}
+	return nil
+}
+
+// IsCustom returns true if p is not one of the fixed rotate policies.
+func (p RotatePolicy) IsCustom() bool {
 	switch p {
-	case RotateNever, RotateHourly, RotateDaily, RotateWeekly,
+	case "", RotateNever, RotateHourly, RotateDaily, RotateWeekly,
 		RotateMonthly, RotateQuarterly, RotateYearly:
-		return true
+		return false
 	}
-	return false
+	return true
 }
 
 // NextRotateTime returns when the policy dictates a secret should be next
-// rotated given the last rotation time.
+// rotated given the last rotation time. Any jitter of a custom policy is
+// included.
 func (p RotatePolicy) NextRotateTime(lastRotated time.Time) *time.Time {
+	return p.nextRotateTime(lastRotated, true)
+}
+
+func (p RotatePolicy) nextRotateTime(lastRotated time.Time, withJitter bool) *time.Time {
 	var result time.Time
 	switch p {
 	case RotateNever:
@@ -70,6 +92,10 @@ func (p RotatePolicy) NextRotateTime(lastRotated time.Time) *time.Time {
 		result = lastRotated.AddDate(0, 3, 0)
 	case RotateYearly:
 		result = lastRotated.AddDate(1, 0, 0)
+	default:
+		if schedule, err := parseRotateSchedule(string(p)); err == nil {
+			result = schedule.next(lastRotated, withJitter)
+		}
 	}
 	return &result
 }
@@ -84,5 +110,5 @@ func (p *RotatePolicy) LessThan(other RotatePolicy) bool {
 		return true // if the other doesn't rotate, current will be more frequent
 	}
 	now := time.Now()
-	return p.NextRotateTime(now).Before(*other.NextRotateTime(now))
+	return p.nextRotateTime(now, false).Before(*other.nextRotateTime(now, false))
 }
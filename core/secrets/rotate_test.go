@@ -0,0 +1,144 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+)
+
+type RotateSuite struct{}
+
+func TestRotateSuite(t *testing.T) {
+	tc.Run(t, &RotateSuite{})
+}
+
+// lastRotated is a Wednesday.
+var lastRotated = time.Date(2026, 10, 14, 10, 30, 0, 0, time.UTC)
+
+func (s *RotateSuite) TestNextRotateTimeFixed(c *tc.C) {
+	for policy, expected := range map[secrets.RotatePolicy]time.Time{
+		secrets.RotateHourly:    lastRotated.Add(time.Hour),
+		secrets.RotateDaily:     lastRotated.AddDate(0, 0, 1),
+		secrets.RotateWeekly:    lastRotated.AddDate(0, 0, 7),
+		secrets.RotateMonthly:   lastRotated.AddDate(0, 1, 0),
+		secrets.RotateQuarterly: lastRotated.AddDate(0, 3, 0),
+		secrets.RotateYearly:    lastRotated.AddDate(1, 0, 0),
+	} {
+		c.Logf("%s", policy)
+		c.Check(policy.IsCustom(), tc.IsFalse)
+		c.Check(*policy.NextRotateTime(lastRotated), tc.Equals, expected)
+	}
+	c.Check(secrets.RotateNever.NextRotateTime(lastRotated), tc.IsNil)
+}
+
+func (s *RotateSuite) TestNextRotateTimeCustom(c *tc.C) {
+	for _, t := range []struct {
+		policy   secrets.RotatePolicy
+		expected time.Time
+	}{{
+		policy:   "36h",
+		expected: lastRotated.Add(36 * time.Hour),
+	}, {
+		policy:   "45d",
+		expected: lastRotated.AddDate(0, 0, 45),
+	}, {
+		policy:   "1d12h",
+		expected: lastRotated.Add(36 * time.Hour),
+	}, {
+		policy:   "0 3 * * SUN",
+		expected: time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC),
+	}, {
+		policy:   "*/15 * * * *",
+		expected: time.Date(2026, 10, 14, 10, 45, 0, 0, time.UTC),
+	}, {
+		policy:   "0 0 1 JAN-MAR,JUL *",
+		expected: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, {
+		// Day of month and day of week match either.
+		policy:   "0 9 20 * 5",
+		expected: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC),
+	}, {
+		// 45 days is Saturday 28 November, so the next window is the Sunday.
+		policy:   "45d@0 3 * * SUN",
+		expected: time.Date(2026, 11, 29, 3, 0, 0, 0, time.UTC),
+	}, {
+		// The window matches exactly once the interval has passed.
+		policy:   "5d@30 10 * * MON",
+		expected: time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC),
+	}} {
+		c.Logf("%s", t.policy)
+		c.Check(t.policy.IsCustom(), tc.IsTrue)
+		c.Check(t.policy.Validate(), tc.ErrorIsNil)
+		c.Check(*t.policy.NextRotateTime(lastRotated), tc.Equals, t.expected)
+	}
+}
+
+func (s *RotateSuite) TestNextRotateTimeJitter(c *tc.C) {
+	policy := secrets.RotatePolicy("0 3 * * SUN ~ 1h")
+	c.Assert(policy.IsValid(), tc.IsTrue)
+	window := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+	for range 20 {
+		next := *policy.NextRotateTime(lastRotated)
+		c.Check(next.Before(window), tc.IsFalse)
+		c.Check(next.Before(window.Add(time.Hour)), tc.IsTrue)
+	}
+}
+
+func (s *RotateSuite) TestValidateInvalid(c *tc.C) {
+	for _, t := range []struct {
+		policy secrets.RotatePolicy
+		err    string
+	}{{
+		policy: "fortnightly",
+		err:    `cron expression "fortnightly" must have 5 fields: .*`,
+	}, {
+		policy: "30s",
+		err:    `rotate interval "30s" is less than 1m0s not valid`,
+	}, {
+		policy: "36h~soon",
+		err:    `rotate jitter "soon" not valid`,
+	}, {
+		policy: "36h~-1h",
+		err:    `rotate jitter "-1h" not valid`,
+	}, {
+		policy: "forever@0 3 * * SUN",
+		err:    `rotate interval "forever" not valid`,
+	}, {
+		policy: "0 24 * * *",
+		err:    `cron expression "0 24 \* \* \*": hour "24" not valid`,
+	}, {
+		policy: "0 3 * * FUNDAY",
+		err:    `cron expression "0 3 \* \* FUNDAY": day of week "FUNDAY" not valid`,
+	}, {
+		policy: "*/0 * * * *",
+		err:    `cron expression "\*/0 \* \* \* \*": minute step "0" not valid`,
+	}, {
+		policy: "0 0 10-5 * *",
+		err:    `cron expression "0 0 10-5 \* \*": day of month range "10-5" not valid`,
+	}, {
+		policy: "0 0 30 FEB *",
+		err:    `cron expression "0 0 30 FEB \*" never matches not valid`,
+	}} {
+		c.Logf("%s", t.policy)
+		c.Check(t.policy.IsValid(), tc.IsFalse)
+		err := t.policy.Validate()
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+		c.Check(err, tc.ErrorMatches, t.err)
+	}
+}
+
+func (s *RotateSuite) TestLessThan(c *tc.C) {
+	daily := secrets.RotateDaily
+	c.Check(daily.LessThan(secrets.RotateWeekly), tc.IsTrue)
+	c.Check(daily.LessThan("36h"), tc.IsTrue)
+	hours := secrets.RotatePolicy("12h~1h")
+	c.Check(hours.LessThan(secrets.RotateDaily), tc.IsTrue)
+	c.Check(hours.LessThan(secrets.RotateNever), tc.IsTrue)
+}
@@ -0,0 +1,291 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// MinRotateInterval is the shortest interval a custom rotate policy may
+// specify.
+const MinRotateInterval = time.Minute
+
+// rotateSchedule is a custom rotate policy, written as
+//
+//	[<interval>][@<cron>][~<jitter>]
+//
+// where at least one of interval and cron is given. The interval is a
+// duration such as "36h" or "45d". The cron expression has the five fields
+// minute, hour, day of month, month and day of week, evaluated in UTC, such
+// as "0 3 * * SUN". A cron expression on its own rotates the secret at each
+// time it matches. Together with an interval, such as "45d@0 3 * * SUN",
+// the secret is rotated at the first time the expression matches once the
+// interval has passed. The jitter is a duration such as "30m"; a random
+// delay of up to the jitter is added to each rotation time so that secrets
+// sharing a policy do not all rotate at once.
+type rotateSchedule struct {
+	interval time.Duration
+	window   *cronSchedule
+	jitter   time.Duration
+}
+
+var daysRegExp = regexp.MustCompile(`^(\d+)d(.*)$`)
+
+// parseRotateDuration parses a duration which, in addition to the units
+// accepted by [time.ParseDuration], may start with a number of days.
+func parseRotateDuration(str string) (time.Duration, error) {
+	var days time.Duration
+	if m := daysRegExp.FindStringSubmatch(str); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return 0, errors.Capture(err)
+		}
+		days = time.Duration(n) * 24 * time.Hour
+		if str = m[2]; str == "" {
+			return days, nil
+		}
+	}
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	return days + d, nil
+}
+
+// parseRotateSchedule parses a custom rotate policy.
+func parseRotateSchedule(policy string) (*rotateSchedule, error) {
+	var (
+		result rotateSchedule
+		err    error
+	)
+	str, jitter, hasJitter := strings.Cut(policy, "~")
+	if hasJitter {
+		result.jitter, err = parseRotateDuration(strings.TrimSpace(jitter))
+		if err != nil || result.jitter <= 0 {
+			return nil, errors.Errorf("rotate jitter %q %w", jitter, coreerrors.NotValid)
+		}
+	}
+	str = strings.TrimSpace(str)
+
+	interval, cron, hasCron := strings.Cut(str, "@")
+	if !hasCron {
+		// A lone interval or a lone cron expression.
+		if _, err := parseRotateDuration(str); err == nil {
+			interval, cron = str, ""
+		} else {
+			interval, cron, hasCron = "", str, true
+		}
+	}
+	if interval = strings.TrimSpace(interval); interval != "" {
+		result.interval, err = parseRotateDuration(interval)
+		if err != nil {
+			return nil, errors.Errorf("rotate interval %q %w", interval, coreerrors.NotValid)
+		}
+		if result.interval < MinRotateInterval {
+			return nil, errors.Errorf("rotate interval %q is less than %v %w",
+				interval, MinRotateInterval, coreerrors.NotValid)
+		}
+	}
+	if hasCron {
+		if result.window, err = parseCronSchedule(cron); err != nil {
+			return nil, errors.Capture(err)
+		}
+	}
+	if result.interval == 0 && result.window == nil {
+		return nil, errors.Errorf("rotate policy %q %w", policy, coreerrors.NotValid)
+	}
+	return &result, nil
+}
+
+// next returns when a secret should be next rotated given the last rotation
+// time, adding a random jitter if withJitter is true.
+func (s *rotateSchedule) next(lastRotated time.Time, withJitter bool) time.Time {
+	result := lastRotated
+	switch {
+	case s.interval > 0 && s.window != nil:
+		// The first time in the window at or after the interval.
+		result = s.window.next(result.Add(s.interval - time.Nanosecond))
+	case s.interval > 0:
+		result = result.Add(s.interval)
+	default:
+		result = s.window.next(result)
+	}
+	if withJitter && s.jitter > 0 {
+		result = result.Add(rand.N(s.jitter))
+	}
+	return result
+}
+
+// cronSchedule is a five field cron expression. Each field is held as a
+// set of bits, one for each value matched.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// domAny and dowAny record whether the day of month and day of week
+	// fields are "*"; if neither is, a day matches if either field does.
+	domAny, dowAny bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// Sunday is both 0 and 7.
+	cronDow = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+// cronSearchLimit bounds the search for the next time a cron expression
+// matches.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// parseCronSchedule parses a five field cron expression.
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.Errorf(
+			"cron expression %q must have 5 fields: minute hour day-of-month month day-of-week %w",
+			expr, coreerrors.NotValid)
+	}
+	var (
+		s   cronSchedule
+		err error
+	)
+	for i, f := range []struct {
+		field cronField
+		bits  *uint64
+	}{
+		{cronMinute, &s.minute},
+		{cronHour, &s.hour},
+		{cronDom, &s.dom},
+		{cronMonth, &s.month},
+		{cronDow, &s.dow},
+	} {
+		if *f.bits, err = f.field.parse(fields[i]); err != nil {
+			return nil, errors.Errorf("cron expression %q: %w", expr, err)
+		}
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domAny = fields[2] == "*"
+	s.dowAny = fields[4] == "*"
+
+	if s.next(time.Unix(0, 0)).IsZero() {
+		return nil, errors.Errorf("cron expression %q never matches %w", expr, coreerrors.NotValid)
+	}
+	return &s, nil
+}
+
+// parse parses a comma separated list of values, ranges and steps.
+func (f cronField) parse(str string) (uint64, error) {
+	var result uint64
+	for item := range strings.SplitSeq(str, ",") {
+		rng, stepStr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step < 1 {
+				return 0, errors.Errorf("%s step %q %w", f.name, stepStr, coreerrors.NotValid)
+			}
+		}
+		var low, high int
+		switch lowStr, highStr, isRange := strings.Cut(rng, "-"); {
+		case rng == "*":
+			low, high = f.min, f.max
+		case isRange:
+			var err error
+			if low, err = f.value(lowStr); err != nil {
+				return 0, errors.Capture(err)
+			}
+			if high, err = f.value(highStr); err != nil {
+				return 0, errors.Capture(err)
+			}
+		default:
+			var err error
+			if low, err = f.value(rng); err != nil {
+				return 0, errors.Capture(err)
+			}
+			high = low
+			if hasStep {
+				high = f.max
+			}
+		}
+		if low > high {
+			return 0, errors.Errorf("%s range %q %w", f.name, rng, coreerrors.NotValid)
+		}
+		for v := low; v <= high; v += step {
+			result |= 1 << v
+		}
+	}
+	return result, nil
+}
+
+// value parses a single value of the field, either a number or a name.
+func (f cronField) value(str string) (int, error) {
+	if v, ok := f.names[strings.ToUpper(str)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(str)
+	if err != nil || v < f.min || v > f.max {
+		return 0, errors.Errorf("%s %q %w", f.name, str, coreerrors.NotValid)
+	}
+	return v, nil
+}
+
+// next returns the first time after t, in UTC, which the expression
+// matches, or the zero time if there is none within the search limit.
+func (s *cronSchedule) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<int(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case s.hour&(1<<t.Hour()) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.minute&(1<<t.Minute()) == 0:
+			// Skip straight to the next matching minute in the hour.
+			rest := s.minute >> t.Minute()
+			if rest == 0 {
+				t = t.Truncate(time.Hour).Add(time.Hour)
+			} else {
+				t = t.Add(time.Duration(bits.TrailingZeros64(rest)) * time.Minute)
+			}
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) matchDay(t time.Time) bool {
+	dom := s.dom&(1<<t.Day()) != 0
+	dow := s.dow&(1<<int(t.Weekday())) != 0
+	if s.domAny || s.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
@@ -0,0 +1,17 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+// FilesystemUsage describes how much of a filesystem mounted on a machine
+// is in use.
+type FilesystemUsage struct {
+	// MountPoint is the path at which the filesystem is mounted.
+	MountPoint string
+
+	// SizeMiB is the total size of the filesystem, in MiB.
+	SizeMiB uint64
+
+	// UsedMiB is the amount of the filesystem in use, in MiB.
+	UsedMiB uint64
+}
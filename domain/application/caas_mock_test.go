@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -package application -destination caas_mock_test.go github.com/juju/juju/caas Application
+//	mockgen -typed -package application -destination domain/application/caas_mock_test.go github.com/juju/juju/caas Application
 //
 
 // Package application is a generated GoMock package.
@@ -120,6 +120,44 @@ func (c *MockApplicationDeleteCall) DoAndReturn(f func() error) *MockApplication
 	return c
 }
 
+// DeleteNetworkPolicy mocks base method.
+func (m *MockApplication) DeleteNetworkPolicy() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkPolicy")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetworkPolicy indicates an expected call of DeleteNetworkPolicy.
+func (mr *MockApplicationMockRecorder) DeleteNetworkPolicy() *MockApplicationDeleteNetworkPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkPolicy", reflect.TypeOf((*MockApplication)(nil).DeleteNetworkPolicy))
+	return &MockApplicationDeleteNetworkPolicyCall{Call: call}
+}
+
+// MockApplicationDeleteNetworkPolicyCall wrap *gomock.Call
+type MockApplicationDeleteNetworkPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationDeleteNetworkPolicyCall) Return(arg0 error) *MockApplicationDeleteNetworkPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationDeleteNetworkPolicyCall) Do(f func() error) *MockApplicationDeleteNetworkPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationDeleteNetworkPolicyCall) DoAndReturn(f func() error) *MockApplicationDeleteNetworkPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Ensure mocks base method.
 func (m *MockApplication) Ensure(arg0 caas.ApplicationConfig) error {
 	m.ctrl.T.Helper()
@@ -158,6 +196,44 @@ func (c *MockApplicationEnsureCall) DoAndReturn(f func(caas.ApplicationConfig) e
 	return c
 }
 
+// EnsureNetworkPolicy mocks base method.
+func (m *MockApplication) EnsureNetworkPolicy(arg0 caas.NetworkPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureNetworkPolicy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureNetworkPolicy indicates an expected call of EnsureNetworkPolicy.
+func (mr *MockApplicationMockRecorder) EnsureNetworkPolicy(arg0 any) *MockApplicationEnsureNetworkPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureNetworkPolicy", reflect.TypeOf((*MockApplication)(nil).EnsureNetworkPolicy), arg0)
+	return &MockApplicationEnsureNetworkPolicyCall{Call: call}
+}
+
+// MockApplicationEnsureNetworkPolicyCall wrap *gomock.Call
+type MockApplicationEnsureNetworkPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationEnsureNetworkPolicyCall) Return(arg0 error) *MockApplicationEnsureNetworkPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationEnsureNetworkPolicyCall) Do(f func(caas.NetworkPolicy) error) *MockApplicationEnsureNetworkPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationEnsureNetworkPolicyCall) DoAndReturn(f func(caas.NetworkPolicy) error) *MockApplicationEnsureNetworkPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // EnsurePVCs mocks base method.
 func (m *MockApplication) EnsurePVCs(arg0 []storage.KubernetesFilesystemParams, arg1 map[string][]storage.KubernetesFilesystemUnitAttachmentParams, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -143,6 +143,15 @@ const (
 	// invalid. There are missing required fields.
 	InvalidApplicationState = errors.ConstError("invalid application state")
 
+	// InvalidEgressRule describes an error that occurs when an application
+	// egress rule is not valid.
+	InvalidEgressRule = errors.ConstError("invalid egress rule")
+
+	// EgressRulesNotSupported describes an error that occurs when egress
+	// rules are set on an application in a model whose provider cannot
+	// restrict the outbound traffic of its machines.
+	EgressRulesNotSupported = errors.ConstError("egress rules not supported")
+
 	// CharmNotValid describes an error that occurs when the charm is not valid.
 	CharmNotValid = errors.ConstError("charm not valid")
 
@@ -22,6 +22,7 @@ import (
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/core/os/ostype"
 	"github.com/juju/juju/core/resource"
 	"github.com/juju/juju/core/secrets"
@@ -435,6 +436,20 @@ type ApplicationState interface {
 	// [applicationerrors.ApplicationNotFound] is returned.
 	MergeExposeSettings(ctx context.Context, appUUID coreapplication.UUID, exposedEndpoints map[string]application.ExposedEndpoint) error
 
+	// GetApplicationEgressRules returns the egress rules of the application.
+	GetApplicationEgressRules(ctx context.Context, appUUID coreapplication.UUID) (firewall.EgressRules, error)
+
+	// SetApplicationEgressRules replaces the egress rules of the application
+	// with the provided rules.
+	//
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	SetApplicationEgressRules(ctx context.Context, appUUID coreapplication.UUID, rules firewall.EgressRules) error
+
+	// NamespaceForWatchApplicationEgressRules returns the namespace identifier
+	// for application egress rule changes.
+	NamespaceForWatchApplicationEgressRules() string
+
 	// EndpointsExist returns an error satisfying
 	// [applicationerrors.EndpointNotFound] if any of the provided endpoints do not
 	// exist.
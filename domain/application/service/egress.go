@@ -0,0 +1,133 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/juju/core/changestream"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetApplicationEgressRules returns the egress rules of the application,
+// which are the destinations its units are allowed to connect to. An empty
+// list is returned if the application has no egress rules.
+//
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *Service) GetApplicationEgressRules(ctx context.Context, appName string) (firewall.EgressRules, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	appID, err := s.st.GetApplicationUUIDByName(ctx, appName)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rules, err := s.st.GetApplicationEgressRules(ctx, appID)
+	return rules, errors.Capture(err)
+}
+
+// SetApplicationEgressRules replaces the egress rules of the application with
+// the provided rules. Passing an empty list removes the egress rules, so that
+// the application no longer restricts the outbound traffic of its machines.
+// Rules can only be set if the model's provider is able to enforce them.
+//
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNotFound] if the application does not
+//     exist.
+//   - [applicationerrors.ApplicationIsDead] if the application is dead.
+//   - [applicationerrors.InvalidEgressRule] if any of the rules is not valid.
+//   - [applicationerrors.EgressRulesNotSupported] if the model's provider
+//     cannot apply egress rules to its machines.
+func (s *ProviderService) SetApplicationEgressRules(ctx context.Context, appName string, rules firewall.EgressRules) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	for _, rule := range rules {
+		if rule.DestinationCIDRs.IsEmpty() {
+			return errors.Errorf("egress rule %v has no destination", rule).
+				Add(applicationerrors.InvalidEgressRule)
+		}
+		if err := rule.Validate(); err != nil {
+			return errors.Errorf("egress rule %v: %w", rule, err).
+				Add(applicationerrors.InvalidEgressRule)
+		}
+	}
+
+	// Removing the rules is always allowed, since there is nothing for the
+	// provider to enforce.
+	if len(rules) > 0 {
+		if err := s.ensureEgressRulesSupported(ctx); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	appID, err := s.st.GetApplicationUUIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return errors.Capture(s.st.SetApplicationEgressRules(ctx, appID, rules))
+}
+
+// ensureEgressRulesSupported returns an error satisfying
+// [applicationerrors.EgressRulesNotSupported] if the model's provider cannot
+// apply egress rules to its machines.
+func (s *ProviderService) ensureEgressRulesSupported(ctx context.Context) error {
+	provider, err := s.provider(ctx)
+	if errors.Is(err, coreerrors.NotSupported) {
+		return errors.Errorf("provider cannot restrict outbound traffic").
+			Add(applicationerrors.EgressRulesNotSupported)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+
+	querier, ok := provider.(environs.EgressFirewallFeatureQuerier)
+	if !ok {
+		return errors.Errorf("provider cannot restrict outbound traffic").
+			Add(applicationerrors.EgressRulesNotSupported)
+	}
+	supported, err := querier.SupportsEgressRules(ctx)
+	if err != nil {
+		return errors.Errorf("querying provider egress support: %w", err)
+	} else if !supported {
+		return errors.Errorf("provider cannot restrict outbound traffic").
+			Add(applicationerrors.EgressRulesNotSupported)
+	}
+	return nil
+}
+
+// WatchApplicationEgressRules watches for changes to the egress rules of the
+// specified application.
+//
+// If the application does not exist an error satisfying
+// [applicationerrors.ApplicationNotFound] will be returned.
+func (s *WatchableService) WatchApplicationEgressRules(ctx context.Context, name string) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	uuid, err := s.GetApplicationUUIDByName(ctx, name)
+	if err != nil {
+		return nil, errors.Errorf("getting ID of application %s: %w", name, err)
+	}
+
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		fmt.Sprintf("application egress rules watcher for %q", name),
+		eventsource.PredicateFilter(
+			s.st.NamespaceForWatchApplicationEgressRules(),
+			changestream.All,
+			eventsource.EqualsPredicate(uuid.String()),
+		),
+	)
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+)
+
+type egressServiceSuite struct {
+	baseSuite
+}
+
+func TestEgressServiceSuite(t *testing.T) {
+	tc.Run(t, &egressServiceSuite{})
+}
+
+func (s *egressServiceSuite) TestGetApplicationEgressRules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	applicationUUID := tc.Must(c, coreapplication.NewUUID)
+	expected := firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	}
+	s.state.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return(applicationUUID, nil)
+	s.state.EXPECT().GetApplicationEgressRules(gomock.Any(), applicationUUID).Return(expected, nil)
+
+	rules, err := s.service.GetApplicationEgressRules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, expected)
+}
+
+func (s *egressServiceSuite) TestGetApplicationEgressRulesNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return(coreapplication.UUID(""), applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.GetApplicationEgressRules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+// egressProvider is a provider which reports whether it can apply egress
+// rules.
+type egressProvider struct {
+	*MockProvider
+	supported bool
+}
+
+func (p egressProvider) SupportsEgressRules(context.Context) (bool, error) {
+	return p.supported, nil
+}
+
+func (s *egressServiceSuite) setEgressSupport(supported bool) {
+	s.service.provider = func(context.Context) (Provider, error) {
+		return egressProvider{MockProvider: s.provider, supported: supported}, nil
+	}
+}
+
+func (s *egressServiceSuite) TestSetApplicationEgressRules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.setEgressSupport(true)
+
+	applicationUUID := tc.Must(c, coreapplication.NewUUID)
+	rules := firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	}
+	s.state.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return(applicationUUID, nil)
+	s.state.EXPECT().SetApplicationEgressRules(gomock.Any(), applicationUUID, rules).Return(nil)
+
+	err := s.service.SetApplicationEgressRules(c.Context(), "foo", rules)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *egressServiceSuite) TestSetApplicationEgressRulesNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.setEgressSupport(false)
+
+	err := s.service.SetApplicationEgressRules(c.Context(), "foo", firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.EgressRulesNotSupported)
+}
+
+func (s *egressServiceSuite) TestSetApplicationEgressRulesProviderNotQuerier(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetApplicationEgressRules(c.Context(), "foo", firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.EgressRulesNotSupported)
+}
+
+func (s *egressServiceSuite) TestSetApplicationEgressRulesRemoveNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.setEgressSupport(false)
+
+	applicationUUID := tc.Must(c, coreapplication.NewUUID)
+	s.state.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return(applicationUUID, nil)
+	s.state.EXPECT().SetApplicationEgressRules(gomock.Any(), applicationUUID, firewall.EgressRules{}).Return(nil)
+
+	err := s.service.SetApplicationEgressRules(c.Context(), "foo", firewall.EgressRules{})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *egressServiceSuite) TestSetApplicationEgressRulesInvalid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetApplicationEgressRules(c.Context(), "foo", firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "bogus"),
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidEgressRule)
+
+	err = s.service.SetApplicationEgressRules(c.Context(), "foo", firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp")),
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidEgressRule)
+}
@@ -25,6 +25,7 @@ import (
 	machine "github.com/juju/juju/core/machine"
 	model "github.com/juju/juju/core/model"
 	network "github.com/juju/juju/core/network"
+	firewall "github.com/juju/juju/core/network/firewall"
 	objectstore "github.com/juju/juju/core/objectstore"
 	semversion "github.com/juju/juju/core/semversion"
 	status "github.com/juju/juju/core/status"
@@ -1520,6 +1521,45 @@ func (c *MockStateGetApplicationDetailsByNameCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// GetApplicationEgressRules mocks base method.
+func (m *MockState) GetApplicationEgressRules(arg0 context.Context, arg1 application.UUID) (firewall.EgressRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationEgressRules", arg0, arg1)
+	ret0, _ := ret[0].(firewall.EgressRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationEgressRules indicates an expected call of GetApplicationEgressRules.
+func (mr *MockStateMockRecorder) GetApplicationEgressRules(arg0, arg1 any) *MockStateGetApplicationEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationEgressRules", reflect.TypeOf((*MockState)(nil).GetApplicationEgressRules), arg0, arg1)
+	return &MockStateGetApplicationEgressRulesCall{Call: call}
+}
+
+// MockStateGetApplicationEgressRulesCall wrap *gomock.Call
+type MockStateGetApplicationEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationEgressRulesCall) Return(arg0 firewall.EgressRules, arg1 error) *MockStateGetApplicationEgressRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationEgressRulesCall) Do(f func(context.Context, application.UUID) (firewall.EgressRules, error)) *MockStateGetApplicationEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationEgressRulesCall) DoAndReturn(f func(context.Context, application.UUID) (firewall.EgressRules, error)) *MockStateGetApplicationEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationEndpointBindings mocks base method.
 func (m *MockState) GetApplicationEndpointBindings(arg0 context.Context, arg1 application.UUID) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -5089,6 +5129,44 @@ func (c *MockStateNamespaceForWatchApplicationConfigCall) DoAndReturn(f func() s
 	return c
 }
 
+// NamespaceForWatchApplicationEgressRules mocks base method.
+func (m *MockState) NamespaceForWatchApplicationEgressRules() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceForWatchApplicationEgressRules")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NamespaceForWatchApplicationEgressRules indicates an expected call of NamespaceForWatchApplicationEgressRules.
+func (mr *MockStateMockRecorder) NamespaceForWatchApplicationEgressRules() *MockStateNamespaceForWatchApplicationEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceForWatchApplicationEgressRules", reflect.TypeOf((*MockState)(nil).NamespaceForWatchApplicationEgressRules))
+	return &MockStateNamespaceForWatchApplicationEgressRulesCall{Call: call}
+}
+
+// MockStateNamespaceForWatchApplicationEgressRulesCall wrap *gomock.Call
+type MockStateNamespaceForWatchApplicationEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateNamespaceForWatchApplicationEgressRulesCall) Return(arg0 string) *MockStateNamespaceForWatchApplicationEgressRulesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateNamespaceForWatchApplicationEgressRulesCall) Do(f func() string) *MockStateNamespaceForWatchApplicationEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateNamespaceForWatchApplicationEgressRulesCall) DoAndReturn(f func() string) *MockStateNamespaceForWatchApplicationEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NamespaceForWatchApplicationExposed mocks base method.
 func (m *MockState) NamespaceForWatchApplicationExposed() (string, string) {
 	m.ctrl.T.Helper()
@@ -5511,6 +5589,44 @@ func (c *MockStateSetApplicationConstraintsCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// SetApplicationEgressRules mocks base method.
+func (m *MockState) SetApplicationEgressRules(arg0 context.Context, arg1 application.UUID, arg2 firewall.EgressRules) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationEgressRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationEgressRules indicates an expected call of SetApplicationEgressRules.
+func (mr *MockStateMockRecorder) SetApplicationEgressRules(arg0, arg1, arg2 any) *MockStateSetApplicationEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationEgressRules", reflect.TypeOf((*MockState)(nil).SetApplicationEgressRules), arg0, arg1, arg2)
+	return &MockStateSetApplicationEgressRulesCall{Call: call}
+}
+
+// MockStateSetApplicationEgressRulesCall wrap *gomock.Call
+type MockStateSetApplicationEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetApplicationEgressRulesCall) Return(arg0 error) *MockStateSetApplicationEgressRulesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetApplicationEgressRulesCall) Do(f func(context.Context, application.UUID, firewall.EgressRules) error) *MockStateSetApplicationEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetApplicationEgressRulesCall) DoAndReturn(f func(context.Context, application.UUID, firewall.EgressRules) error) *MockStateSetApplicationEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetApplicationHasK8sResources mocks base method.
 func (m *MockState) SetApplicationHasK8sResources(arg0 context.Context, arg1 application.UUID) error {
 	m.ctrl.T.Helper()
@@ -1144,6 +1144,25 @@ func (s *ProviderService) populateAddStorageArgs(
 		return domainstorage.UnitAddStorageArg{}, errors.Capture(err)
 	}
 
+	if arg.SnapshotID != nil {
+		supported, err := s.storageService.CheckPoolSupportsSnapshots(
+			ctx,
+			storageDirective.PoolUUID,
+			storageAddInfo.CharmStorageDefinitionForValidation.Type,
+		)
+		if err != nil {
+			return domainstorage.UnitAddStorageArg{}, errors.Errorf(
+				"checking storage pool supports snapshots: %w", err,
+			)
+		}
+		if !supported {
+			return domainstorage.UnitAddStorageArg{}, errors.Errorf(
+				"storage pool for storage %q cannot create storage from a snapshot",
+				storageName,
+			).Add(storageerrors.StorageInstanceSnapshotNotSupported)
+		}
+	}
+
 	args, err := s.storageService.MakeUnitAddStorageArgs(
 		ctx,
 		unitUUID,
@@ -1153,6 +1172,11 @@ func (s *ProviderService) populateAddStorageArgs(
 	if err != nil {
 		return domainstorage.UnitAddStorageArg{}, errors.Capture(err)
 	}
+	if arg.SnapshotID != nil {
+		for i := range args.StorageInstances {
+			args.StorageInstances[i].SnapshotID = *arg.SnapshotID
+		}
+	}
 	// Record the max allowed count precondition.
 	// This will be checked inside the transaction.
 	args.CountLessThanEqual = uint32(math.MaxUint32) - addCount
@@ -1174,6 +1198,9 @@ func (s *ProviderService) populateAddStorageArgs(
 // when storage name is not defined in charm metadata.
 // - [github.com/juju/juju/domain/application/errors.StorageCountLimitExceeded]
 // when the requested storage falls outside of the bounds defined by the charm.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceSnapshotNotSupported]
+// when a snapshot is supplied and the storage pool cannot create storage from
+// it.
 func (s *ProviderService) AddStorageForIAASUnit(
 	ctx context.Context, storageName corestorage.Name, unitUUID coreunit.UUID,
 	count uint32, arg application.AddUnitStorageOverride,
@@ -1221,6 +1248,9 @@ func (s *ProviderService) AddStorageForIAASUnit(
 // when storage name is not defined in charm metadata.
 // - [github.com/juju/juju/domain/application/errors.StorageCountLimitExceeded]
 // when the requested storage falls outside of the bounds defined by the charm.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceSnapshotNotSupported]
+// when a snapshot is supplied and the storage pool cannot create storage from
+// it.
 func (s *ProviderService) AddStorageForCAASUnit(
 	ctx context.Context, storageName corestorage.Name, unitUUID coreunit.UUID,
 	count uint32, arg application.AddUnitStorageOverride,
@@ -44,6 +44,7 @@ import (
 	domainnetwork "github.com/juju/juju/domain/network"
 	"github.com/juju/juju/domain/status"
 	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
@@ -3279,6 +3280,102 @@ func (s *providerServiceSuite) TestAddStorageForIAASUnit(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, nil)
 }
 
+// TestAddStorageForIAASUnitFromSnapshot tests that the snapshot supplied when
+// adding storage is set on every new storage instance.
+func (s *providerServiceSuite) TestAddStorageForIAASUnitFromSnapshot(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	charmStorageDef := internal.CharmStorageDefinitionForValidation{
+		Name:     "pgdata",
+		Type:     applicationcharm.StorageFilesystem,
+		CountMax: 666,
+	}
+	directive := internal.StorageDirective{
+		Name:             "pgdata",
+		CharmStorageType: applicationcharm.StorageFilesystem,
+		Count:            1,
+		MaxCount:         666,
+		PoolUUID:         poolUUID,
+		Size:             1024,
+	}
+
+	s.storageService.EXPECT().GetUnitStorageDirectiveByName(gomock.Any(), unitUUID, corestorage.Name("pgdata")).
+		Return(directive, nil)
+	s.state.EXPECT().GetStorageAddInfoByUnitUUID(gomock.Any(), unitUUID, corestorage.Name("pgdata")).
+		Return(internal.StorageInfoForAdd{
+			CharmStorageDefinitionForValidation: charmStorageDef,
+		}, nil)
+	s.storageService.EXPECT().ValidateApplicationStorageDirectiveOverrides(
+		gomock.Any(), gomock.Any(), gomock.Any(),
+	)
+	s.storageService.EXPECT().CheckPoolSupportsSnapshots(
+		gomock.Any(), poolUUID, applicationcharm.StorageFilesystem,
+	).Return(true, nil)
+	s.storageService.EXPECT().MakeUnitAddStorageArgs(gomock.Any(), unitUUID, uint32(1), directive).
+		Return(domainstorage.UnitAddStorageArg{
+			StorageInstances: []domainstorage.CreateUnitStorageInstanceArg{{
+				Name: "pgdata",
+			}},
+		}, nil)
+	unitStorageArgs := domainstorage.UnitAddStorageArg{
+		StorageInstances: []domainstorage.CreateUnitStorageInstanceArg{{
+			Name:       "pgdata",
+			SnapshotID: "default:juju-abc/snap1",
+		}},
+		CountLessThanEqual: 665,
+	}
+	s.storageService.EXPECT().MakeIAASUnitStorageArgs(gomock.Any(), unitStorageArgs.StorageInstances).
+		Return(domainstorage.CreateIAASUnitStorageArg{}, nil)
+	s.state.EXPECT().AddStorageForIAASUnit(gomock.Any(), unitUUID, corestorage.Name("pgdata"), domainstorage.IAASUnitAddStorageArg{
+		UnitAddStorageArg: unitStorageArgs,
+	})
+
+	_, err := s.service.AddStorageForIAASUnit(c.Context(), "pgdata", unitUUID, 1, application.AddUnitStorageOverride{
+		SnapshotID: new("default:juju-abc/snap1"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// TestAddStorageForIAASUnitFromSnapshotNotSupported tests that adding storage
+// from a snapshot to a storage pool that cannot create storage from snapshots
+// returns an error satisfying
+// [storageerrors.StorageInstanceSnapshotNotSupported].
+func (s *providerServiceSuite) TestAddStorageForIAASUnitFromSnapshotNotSupported(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+
+	s.storageService.EXPECT().GetUnitStorageDirectiveByName(gomock.Any(), unitUUID, corestorage.Name("pgdata")).
+		Return(internal.StorageDirective{
+			Name:             "pgdata",
+			CharmStorageType: applicationcharm.StorageFilesystem,
+			PoolUUID:         poolUUID,
+		}, nil)
+	s.state.EXPECT().GetStorageAddInfoByUnitUUID(gomock.Any(), unitUUID, corestorage.Name("pgdata")).
+		Return(internal.StorageInfoForAdd{
+			CharmStorageDefinitionForValidation: internal.CharmStorageDefinitionForValidation{
+				Name: "pgdata",
+				Type: applicationcharm.StorageFilesystem,
+			},
+		}, nil)
+	s.storageService.EXPECT().ValidateApplicationStorageDirectiveOverrides(
+		gomock.Any(), gomock.Any(), gomock.Any(),
+	)
+	s.storageService.EXPECT().CheckPoolSupportsSnapshots(
+		gomock.Any(), poolUUID, applicationcharm.StorageFilesystem,
+	).Return(false, nil)
+
+	_, err := s.service.AddStorageForIAASUnit(c.Context(), "pgdata", unitUUID, 1, application.AddUnitStorageOverride{
+		SnapshotID: new("default:juju-abc/snap1"),
+	})
+	c.Assert(err, tc.ErrorIs, storageerrors.StorageInstanceSnapshotNotSupported)
+}
+
 func (s *providerServiceSuite) TestAddStorageForCAASUnitNotFound(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
@@ -774,6 +774,24 @@ func (s *WatchableService) WatchApplicationExposed(ctx context.Context, name str
 	)
 }
 
+// WatchAllApplicationsExposed watches for changes to the exposed endpoints of
+// every application in the model.
+// This notifies on any changes to the exposed endpoints of any application and
+// it is up to the caller to determine if the applications they're interested
+// in have changed.
+func (s *WatchableService) WatchAllApplicationsExposed(ctx context.Context) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	exposedToSpaces, exposedToCIDRs := s.st.NamespaceForWatchApplicationExposed()
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"all applications exposed watcher",
+		eventsource.NamespaceFilter(exposedToSpaces, changestream.All),
+		eventsource.NamespaceFilter(exposedToCIDRs, changestream.All),
+	)
+}
+
 // WatchUnitAddresses watches for changes to the addresses of the specified
 // unit.
 // This notifies on any changes to the unit addresses and it is up to the
@@ -795,6 +813,21 @@ func (s *WatchableService) WatchUnitAddresses(ctx context.Context, unitName core
 	)
 }
 
+// WatchAllUnitAddresses watches for changes to the addresses of any unit in
+// the model.
+// This notifies on any changes to the addresses and it is up to the caller to
+// determine if the addresses they're interested in have changed.
+func (s *WatchableService) WatchAllUnitAddresses(ctx context.Context) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"all unit addresses watcher",
+		eventsource.NamespaceFilter(s.st.NamespaceForWatchNetNodeAddress(), changestream.All),
+	)
+}
+
 // WatchUnitForLegacyUniter watches for some specific changes to the unit with
 // the given name. The watcher will emit a notification when there is a change
 // to the unit's inherent properties, it's subordinates or it's resolved mode.
@@ -11,6 +11,7 @@ import (
 	corestorage "github.com/juju/juju/core/storage"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/application/internal"
 	"github.com/juju/juju/domain/application/service/storage"
 	internalcharm "github.com/juju/juju/domain/deployment/charm"
@@ -119,6 +120,19 @@ type StorageService interface {
 		storageInst []domainstorage.CreateUnitStorageInstanceArg,
 	) (domainstorage.CreateIAASUnitStorageArg, error)
 
+	// CheckPoolSupportsSnapshots checks that the provided storage pool can
+	// create a certain type of charm storage from a snapshot.
+	//
+	// The following errors may be expected:
+	// - [coreerrors.NotValid] if the provided pool uuid is not valid.
+	// - [storageerrors.StoragePoolNotFound] when no storage pool exists for
+	// the provided pool uuid.
+	CheckPoolSupportsSnapshots(
+		ctx context.Context,
+		poolUUID domainstorage.StoragePoolUUID,
+		storageType charm.StorageType,
+	) (bool, error)
+
 	// MakeUnitAddStorageArgs creates the storage arguments required to
 	// add storage to a unit. This is similar to [MakeUnitStorageArgs]
 	// but without processing existing storage.
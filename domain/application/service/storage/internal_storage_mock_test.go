@@ -1,15 +1,16 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/storage (interfaces: Provider,ProviderRegistry)
+// Source: github.com/juju/juju/internal/storage (interfaces: Provider,ProviderRegistry,FilesystemSource,VolumeSnapshotter)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package storage -mock_names=Provider=MockStorageProvider -destination internal_storage_mock_test.go github.com/juju/juju/internal/storage Provider,ProviderRegistry
+//	mockgen -typed -package storage -mock_names=Provider=MockStorageProvider -destination internal_storage_mock_test.go github.com/juju/juju/internal/storage Provider,ProviderRegistry,FilesystemSource,VolumeSnapshotter
 //
 
 // Package storage is a generated GoMock package.
 package storage
 
 import (
+	context "context"
 	reflect "reflect"
 
 	storage "github.com/juju/juju/internal/storage"
@@ -521,3 +522,399 @@ func (c *MockProviderRegistryStorageProviderTypesCall) DoAndReturn(f func() ([]s
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockFilesystemSource is a mock of FilesystemSource interface.
+type MockFilesystemSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockFilesystemSourceMockRecorder
+}
+
+// MockFilesystemSourceMockRecorder is the mock recorder for MockFilesystemSource.
+type MockFilesystemSourceMockRecorder struct {
+	mock *MockFilesystemSource
+}
+
+// NewMockFilesystemSource creates a new mock instance.
+func NewMockFilesystemSource(ctrl *gomock.Controller) *MockFilesystemSource {
+	mock := &MockFilesystemSource{ctrl: ctrl}
+	mock.recorder = &MockFilesystemSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFilesystemSource) EXPECT() *MockFilesystemSourceMockRecorder {
+	return m.recorder
+}
+
+// AttachFilesystems mocks base method.
+func (m *MockFilesystemSource) AttachFilesystems(arg0 context.Context, arg1 []storage.FilesystemAttachmentParams) ([]storage.AttachFilesystemsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachFilesystems", arg0, arg1)
+	ret0, _ := ret[0].([]storage.AttachFilesystemsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachFilesystems indicates an expected call of AttachFilesystems.
+func (mr *MockFilesystemSourceMockRecorder) AttachFilesystems(arg0, arg1 any) *MockFilesystemSourceAttachFilesystemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachFilesystems", reflect.TypeOf((*MockFilesystemSource)(nil).AttachFilesystems), arg0, arg1)
+	return &MockFilesystemSourceAttachFilesystemsCall{Call: call}
+}
+
+// MockFilesystemSourceAttachFilesystemsCall wrap *gomock.Call
+type MockFilesystemSourceAttachFilesystemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFilesystemSourceAttachFilesystemsCall) Return(arg0 []storage.AttachFilesystemsResult, arg1 error) *MockFilesystemSourceAttachFilesystemsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFilesystemSourceAttachFilesystemsCall) Do(f func(context.Context, []storage.FilesystemAttachmentParams) ([]storage.AttachFilesystemsResult, error)) *MockFilesystemSourceAttachFilesystemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFilesystemSourceAttachFilesystemsCall) DoAndReturn(f func(context.Context, []storage.FilesystemAttachmentParams) ([]storage.AttachFilesystemsResult, error)) *MockFilesystemSourceAttachFilesystemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateFilesystems mocks base method.
+func (m *MockFilesystemSource) CreateFilesystems(arg0 context.Context, arg1 []storage.FilesystemParams) ([]storage.CreateFilesystemsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFilesystems", arg0, arg1)
+	ret0, _ := ret[0].([]storage.CreateFilesystemsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFilesystems indicates an expected call of CreateFilesystems.
+func (mr *MockFilesystemSourceMockRecorder) CreateFilesystems(arg0, arg1 any) *MockFilesystemSourceCreateFilesystemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFilesystems", reflect.TypeOf((*MockFilesystemSource)(nil).CreateFilesystems), arg0, arg1)
+	return &MockFilesystemSourceCreateFilesystemsCall{Call: call}
+}
+
+// MockFilesystemSourceCreateFilesystemsCall wrap *gomock.Call
+type MockFilesystemSourceCreateFilesystemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFilesystemSourceCreateFilesystemsCall) Return(arg0 []storage.CreateFilesystemsResult, arg1 error) *MockFilesystemSourceCreateFilesystemsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFilesystemSourceCreateFilesystemsCall) Do(f func(context.Context, []storage.FilesystemParams) ([]storage.CreateFilesystemsResult, error)) *MockFilesystemSourceCreateFilesystemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFilesystemSourceCreateFilesystemsCall) DoAndReturn(f func(context.Context, []storage.FilesystemParams) ([]storage.CreateFilesystemsResult, error)) *MockFilesystemSourceCreateFilesystemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DestroyFilesystems mocks base method.
+func (m *MockFilesystemSource) DestroyFilesystems(arg0 context.Context, arg1 []string) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyFilesystems", arg0, arg1)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyFilesystems indicates an expected call of DestroyFilesystems.
+func (mr *MockFilesystemSourceMockRecorder) DestroyFilesystems(arg0, arg1 any) *MockFilesystemSourceDestroyFilesystemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyFilesystems", reflect.TypeOf((*MockFilesystemSource)(nil).DestroyFilesystems), arg0, arg1)
+	return &MockFilesystemSourceDestroyFilesystemsCall{Call: call}
+}
+
+// MockFilesystemSourceDestroyFilesystemsCall wrap *gomock.Call
+type MockFilesystemSourceDestroyFilesystemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFilesystemSourceDestroyFilesystemsCall) Return(arg0 []error, arg1 error) *MockFilesystemSourceDestroyFilesystemsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFilesystemSourceDestroyFilesystemsCall) Do(f func(context.Context, []string) ([]error, error)) *MockFilesystemSourceDestroyFilesystemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFilesystemSourceDestroyFilesystemsCall) DoAndReturn(f func(context.Context, []string) ([]error, error)) *MockFilesystemSourceDestroyFilesystemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DetachFilesystems mocks base method.
+func (m *MockFilesystemSource) DetachFilesystems(arg0 context.Context, arg1 []storage.FilesystemAttachmentParams) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFilesystems", arg0, arg1)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachFilesystems indicates an expected call of DetachFilesystems.
+func (mr *MockFilesystemSourceMockRecorder) DetachFilesystems(arg0, arg1 any) *MockFilesystemSourceDetachFilesystemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFilesystems", reflect.TypeOf((*MockFilesystemSource)(nil).DetachFilesystems), arg0, arg1)
+	return &MockFilesystemSourceDetachFilesystemsCall{Call: call}
+}
+
+// MockFilesystemSourceDetachFilesystemsCall wrap *gomock.Call
+type MockFilesystemSourceDetachFilesystemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFilesystemSourceDetachFilesystemsCall) Return(arg0 []error, arg1 error) *MockFilesystemSourceDetachFilesystemsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFilesystemSourceDetachFilesystemsCall) Do(f func(context.Context, []storage.FilesystemAttachmentParams) ([]error, error)) *MockFilesystemSourceDetachFilesystemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFilesystemSourceDetachFilesystemsCall) DoAndReturn(f func(context.Context, []storage.FilesystemAttachmentParams) ([]error, error)) *MockFilesystemSourceDetachFilesystemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReleaseFilesystems mocks base method.
+func (m *MockFilesystemSource) ReleaseFilesystems(arg0 context.Context, arg1 []string) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseFilesystems", arg0, arg1)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseFilesystems indicates an expected call of ReleaseFilesystems.
+func (mr *MockFilesystemSourceMockRecorder) ReleaseFilesystems(arg0, arg1 any) *MockFilesystemSourceReleaseFilesystemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseFilesystems", reflect.TypeOf((*MockFilesystemSource)(nil).ReleaseFilesystems), arg0, arg1)
+	return &MockFilesystemSourceReleaseFilesystemsCall{Call: call}
+}
+
+// MockFilesystemSourceReleaseFilesystemsCall wrap *gomock.Call
+type MockFilesystemSourceReleaseFilesystemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFilesystemSourceReleaseFilesystemsCall) Return(arg0 []error, arg1 error) *MockFilesystemSourceReleaseFilesystemsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFilesystemSourceReleaseFilesystemsCall) Do(f func(context.Context, []string) ([]error, error)) *MockFilesystemSourceReleaseFilesystemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFilesystemSourceReleaseFilesystemsCall) DoAndReturn(f func(context.Context, []string) ([]error, error)) *MockFilesystemSourceReleaseFilesystemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ValidateFilesystemParams mocks base method.
+func (m *MockFilesystemSource) ValidateFilesystemParams(arg0 storage.FilesystemParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateFilesystemParams", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateFilesystemParams indicates an expected call of ValidateFilesystemParams.
+func (mr *MockFilesystemSourceMockRecorder) ValidateFilesystemParams(arg0 any) *MockFilesystemSourceValidateFilesystemParamsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateFilesystemParams", reflect.TypeOf((*MockFilesystemSource)(nil).ValidateFilesystemParams), arg0)
+	return &MockFilesystemSourceValidateFilesystemParamsCall{Call: call}
+}
+
+// MockFilesystemSourceValidateFilesystemParamsCall wrap *gomock.Call
+type MockFilesystemSourceValidateFilesystemParamsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFilesystemSourceValidateFilesystemParamsCall) Return(arg0 error) *MockFilesystemSourceValidateFilesystemParamsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFilesystemSourceValidateFilesystemParamsCall) Do(f func(storage.FilesystemParams) error) *MockFilesystemSourceValidateFilesystemParamsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFilesystemSourceValidateFilesystemParamsCall) DoAndReturn(f func(storage.FilesystemParams) error) *MockFilesystemSourceValidateFilesystemParamsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockVolumeSnapshotter is a mock of VolumeSnapshotter interface.
+type MockVolumeSnapshotter struct {
+	ctrl     *gomock.Controller
+	recorder *MockVolumeSnapshotterMockRecorder
+}
+
+// MockVolumeSnapshotterMockRecorder is the mock recorder for MockVolumeSnapshotter.
+type MockVolumeSnapshotterMockRecorder struct {
+	mock *MockVolumeSnapshotter
+}
+
+// NewMockVolumeSnapshotter creates a new mock instance.
+func NewMockVolumeSnapshotter(ctrl *gomock.Controller) *MockVolumeSnapshotter {
+	mock := &MockVolumeSnapshotter{ctrl: ctrl}
+	mock.recorder = &MockVolumeSnapshotterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVolumeSnapshotter) EXPECT() *MockVolumeSnapshotterMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshots mocks base method.
+func (m *MockVolumeSnapshotter) CreateSnapshots(arg0 context.Context, arg1 []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]storage.CreateSnapshotsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshots indicates an expected call of CreateSnapshots.
+func (mr *MockVolumeSnapshotterMockRecorder) CreateSnapshots(arg0, arg1 any) *MockVolumeSnapshotterCreateSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshots", reflect.TypeOf((*MockVolumeSnapshotter)(nil).CreateSnapshots), arg0, arg1)
+	return &MockVolumeSnapshotterCreateSnapshotsCall{Call: call}
+}
+
+// MockVolumeSnapshotterCreateSnapshotsCall wrap *gomock.Call
+type MockVolumeSnapshotterCreateSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeSnapshotterCreateSnapshotsCall) Return(arg0 []storage.CreateSnapshotsResult, arg1 error) *MockVolumeSnapshotterCreateSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeSnapshotterCreateSnapshotsCall) Do(f func(context.Context, []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error)) *MockVolumeSnapshotterCreateSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeSnapshotterCreateSnapshotsCall) DoAndReturn(f func(context.Context, []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error)) *MockVolumeSnapshotterCreateSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DestroySnapshots mocks base method.
+func (m *MockVolumeSnapshotter) DestroySnapshots(arg0 context.Context, arg1 []string) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroySnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroySnapshots indicates an expected call of DestroySnapshots.
+func (mr *MockVolumeSnapshotterMockRecorder) DestroySnapshots(arg0, arg1 any) *MockVolumeSnapshotterDestroySnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySnapshots", reflect.TypeOf((*MockVolumeSnapshotter)(nil).DestroySnapshots), arg0, arg1)
+	return &MockVolumeSnapshotterDestroySnapshotsCall{Call: call}
+}
+
+// MockVolumeSnapshotterDestroySnapshotsCall wrap *gomock.Call
+type MockVolumeSnapshotterDestroySnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeSnapshotterDestroySnapshotsCall) Return(arg0 []error, arg1 error) *MockVolumeSnapshotterDestroySnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeSnapshotterDestroySnapshotsCall) Do(f func(context.Context, []string) ([]error, error)) *MockVolumeSnapshotterDestroySnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeSnapshotterDestroySnapshotsCall) DoAndReturn(f func(context.Context, []string) ([]error, error)) *MockVolumeSnapshotterDestroySnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSnapshots mocks base method.
+func (m *MockVolumeSnapshotter) ListSnapshots(arg0 context.Context, arg1 string) ([]storage.SnapshotInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]storage.SnapshotInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockVolumeSnapshotterMockRecorder) ListSnapshots(arg0, arg1 any) *MockVolumeSnapshotterListSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockVolumeSnapshotter)(nil).ListSnapshots), arg0, arg1)
+	return &MockVolumeSnapshotterListSnapshotsCall{Call: call}
+}
+
+// MockVolumeSnapshotterListSnapshotsCall wrap *gomock.Call
+type MockVolumeSnapshotterListSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeSnapshotterListSnapshotsCall) Return(arg0 []storage.SnapshotInfo, arg1 error) *MockVolumeSnapshotterListSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeSnapshotterListSnapshotsCall) Do(f func(context.Context, string) ([]storage.SnapshotInfo, error)) *MockVolumeSnapshotterListSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeSnapshotterListSnapshotsCall) DoAndReturn(f func(context.Context, string) ([]storage.SnapshotInfo, error)) *MockVolumeSnapshotterListSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
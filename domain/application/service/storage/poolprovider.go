@@ -6,6 +6,8 @@ package storage
 import (
 	"context"
 
+	"github.com/juju/collections/transform"
+
 	coreerrors "github.com/juju/juju/core/errors"
 	corestorage "github.com/juju/juju/core/storage"
 	"github.com/juju/juju/core/trace"
@@ -66,6 +68,19 @@ type StoragePoolProvider interface {
 		charm.StorageType,
 	) (bool, error)
 
+	// CheckPoolSupportsSnapshots checks that the provided storage pool can
+	// create a certain type of charm storage from a snapshot.
+	//
+	// The following errors may be expected:
+	// - [coreerrors.NotValid] if the provided pool uuid is not valid.
+	// - [storageerrors.StoragePoolNotFound] when no storage pool exists for
+	// the provided pool uuid.
+	CheckPoolSupportsSnapshots(
+		context.Context,
+		domainstorage.StoragePoolUUID,
+		charm.StorageType,
+	) (bool, error)
+
 	// GetProviderForPool returns the storage provider that is backing a given
 	// storage pool. This is a utility func for this domain to enable asking
 	// questions of a provider when you are starting with a storage pool.
@@ -123,6 +138,78 @@ func (v *DefaultStoragePoolProvider) CheckPoolSupportsCharmStorage(
 	), nil
 }
 
+// CheckPoolSupportsSnapshots checks that the provided storage pool can create
+// a certain type of charm storage from a snapshot. This is the case when the
+// source that provisions the storage, the volume source if the storage needs
+// a volume and otherwise the filesystem source, implements
+// [storage.VolumeSnapshotter].
+//
+// The following errors may be expected:
+// - [coreerrors.NotValid] if the provided pool uuid is not valid.
+// - [storageerrors.StoragePoolNotFound] when no storage pool exists for the
+// provided pool uuid.
+func (v *DefaultStoragePoolProvider) CheckPoolSupportsSnapshots(
+	ctx context.Context,
+	poolUUID domainstorage.StoragePoolUUID,
+	storageType charm.StorageType,
+) (bool, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	provider, err := v.GetProviderForPool(ctx, poolUUID)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	storageKind, err := StorageKindFromCharmStorageType(storageType)
+	if err != nil {
+		return false, err
+	}
+
+	ic, err := domainstorageprovisioning.CalculateStorageInstanceComposition(
+		storageKind, provider,
+	)
+	if err != nil {
+		return false, errors.Errorf(
+			"calculating storage instance composition: %w", err,
+		)
+	}
+
+	pool, err := v.st.GetStoragePool(ctx, poolUUID)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	poolConfig, err := storage.NewConfig(
+		pool.Name,
+		storage.ProviderType(pool.Provider),
+		transform.Map(pool.Attrs, func(k string, v string) (string, any) {
+			return k, v
+		}),
+	)
+	if err != nil {
+		return false, errors.Errorf(
+			"storage pool %q is misconfigured: %w", pool.Name, err,
+		)
+	}
+
+	var source any
+	if ic.VolumeRequired {
+		source, err = provider.VolumeSource(poolConfig)
+	} else {
+		source, err = provider.FilesystemSource(poolConfig)
+	}
+	if errors.Is(err, coreerrors.NotSupported) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Errorf(
+			"getting storage source for pool %q: %w", pool.Name, err,
+		)
+	}
+
+	_, supported := source.(storage.VolumeSnapshotter)
+	return supported, nil
+}
+
 // GetProviderForPool returns the storage provider associated with the given
 // storage pool. This func will first consult the cache to see if the provider
 // is available there and then if not proxy the call through to the underlying
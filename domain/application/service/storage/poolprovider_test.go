@@ -114,3 +114,85 @@ func (s *storagePoolProviderSuite) TestPoolSupportsCharmStorageBlockdevice(c *tc
 	c.Check(err, tc.ErrorIsNil)
 	c.Check(supports, tc.IsTrue)
 }
+
+// snapshottingFilesystemSource is a filesystem source that also supports
+// snapshots.
+type snapshottingFilesystemSource struct {
+	*MockFilesystemSource
+	*MockVolumeSnapshotter
+}
+
+// TestPoolSupportsSnapshots tests that a storage pool whose filesystem source
+// implements [internalstorage.VolumeSnapshotter] supports creating charm
+// filesystem storage from a snapshot.
+func (s *storagePoolProviderSuite) TestPoolSupportsSnapshots(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+
+	provider := NewMockStorageProvider(ctrl)
+	s.state.EXPECT().GetProviderTypeForPool(gomock.Any(), poolUUID).Return(
+		"testprovider", nil,
+	)
+	s.state.EXPECT().GetStoragePool(gomock.Any(), poolUUID).Return(
+		domainstorage.StoragePool{
+			Name:     "testpool",
+			Provider: "testprovider",
+		}, nil,
+	)
+	s.registry.EXPECT().StorageProvider(internalstorage.ProviderType("testprovider")).Return(
+		provider, nil,
+	)
+	provider.EXPECT().Scope().Return(internalstorage.ScopeEnviron)
+	provider.EXPECT().Supports(internalstorage.StorageKindFilesystem).Return(true)
+	provider.EXPECT().FilesystemSource(gomock.Any()).Return(
+		snapshottingFilesystemSource{
+			MockFilesystemSource:  NewMockFilesystemSource(ctrl),
+			MockVolumeSnapshotter: NewMockVolumeSnapshotter(ctrl),
+		}, nil,
+	)
+
+	validator := NewStoragePoolProvider(s, s.state)
+	supports, err := validator.CheckPoolSupportsSnapshots(
+		c.Context(), poolUUID, charm.StorageFilesystem,
+	)
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(supports, tc.IsTrue)
+}
+
+// TestPoolSupportsSnapshotsNotSupported tests that a storage pool whose
+// filesystem source does not implement [internalstorage.VolumeSnapshotter]
+// does not support creating charm filesystem storage from a snapshot.
+func (s *storagePoolProviderSuite) TestPoolSupportsSnapshotsNotSupported(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+
+	provider := NewMockStorageProvider(ctrl)
+	s.state.EXPECT().GetProviderTypeForPool(gomock.Any(), poolUUID).Return(
+		"testprovider", nil,
+	)
+	s.state.EXPECT().GetStoragePool(gomock.Any(), poolUUID).Return(
+		domainstorage.StoragePool{
+			Name:     "testpool",
+			Provider: "testprovider",
+		}, nil,
+	)
+	s.registry.EXPECT().StorageProvider(internalstorage.ProviderType("testprovider")).Return(
+		provider, nil,
+	)
+	provider.EXPECT().Scope().Return(internalstorage.ScopeEnviron)
+	provider.EXPECT().Supports(internalstorage.StorageKindFilesystem).Return(true)
+	provider.EXPECT().FilesystemSource(gomock.Any()).Return(
+		NewMockFilesystemSource(ctrl), nil,
+	)
+
+	validator := NewStoragePoolProvider(s, s.state)
+	supports, err := validator.CheckPoolSupportsSnapshots(
+		c.Context(), poolUUID, charm.StorageFilesystem,
+	)
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(supports, tc.IsFalse)
+}
@@ -33,6 +33,14 @@ type ProviderState interface {
 	// - [storageerrors.PoolNotFoundError] when no storage pool exists for the
 	// provided pool uuid.
 	GetProviderTypeForPool(context.Context, domainstorage.StoragePoolUUID) (string, error)
+
+	// GetStoragePool returns the name, provider type and attributes of the
+	// given storage pool.
+	//
+	// The following error types can be expected:
+	// - [storageerrors.StoragePoolNotFound] when no storage pool exists for
+	// the provided pool uuid.
+	GetStoragePool(context.Context, domainstorage.StoragePoolUUID) (domainstorage.StoragePool, error)
 }
 
 // Service defines an internal service to this package that groups and
@@ -884,6 +892,26 @@ func (s Service) MakeIAASUnitStorageArgs(
 	return arg, nil
 }
 
+// CheckPoolSupportsSnapshots checks that the provided storage pool can create
+// a certain type of charm storage from a snapshot.
+//
+// The following errors may be expected:
+// - [coreerrors.NotValid] if the provided pool uuid is not valid.
+// - [storageerrors.StoragePoolNotFound] when no storage pool exists for the
+// provided pool uuid.
+func (s *Service) CheckPoolSupportsSnapshots(
+	ctx context.Context,
+	poolUUID domainstorage.StoragePoolUUID,
+	storageType charm.StorageType,
+) (bool, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.storagePoolProvider.CheckPoolSupportsSnapshots(
+		ctx, poolUUID, storageType,
+	)
+}
+
 // MakeUnitAddStorageArgs creates the storage arguments required to
 // add storage to a unit. This is similar to [MakeUnitStorageArgs]
 // but without processing existing storage.
@@ -86,6 +86,45 @@ func (c *MockProviderStateGetProviderTypeForPoolCall) DoAndReturn(f func(context
 	return c
 }
 
+// GetStoragePool mocks base method.
+func (m *MockProviderState) GetStoragePool(arg0 context.Context, arg1 storage0.StoragePoolUUID) (storage0.StoragePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoragePool", arg0, arg1)
+	ret0, _ := ret[0].(storage0.StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoragePool indicates an expected call of GetStoragePool.
+func (mr *MockProviderStateMockRecorder) GetStoragePool(arg0, arg1 any) *MockProviderStateGetStoragePoolCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePool", reflect.TypeOf((*MockProviderState)(nil).GetStoragePool), arg0, arg1)
+	return &MockProviderStateGetStoragePoolCall{Call: call}
+}
+
+// MockProviderStateGetStoragePoolCall wrap *gomock.Call
+type MockProviderStateGetStoragePoolCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProviderStateGetStoragePoolCall) Return(arg0 storage0.StoragePool, arg1 error) *MockProviderStateGetStoragePoolCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProviderStateGetStoragePoolCall) Do(f func(context.Context, storage0.StoragePoolUUID) (storage0.StoragePool, error)) *MockProviderStateGetStoragePoolCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProviderStateGetStoragePoolCall) DoAndReturn(f func(context.Context, storage0.StoragePoolUUID) (storage0.StoragePool, error)) *MockProviderStateGetStoragePoolCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockState is a mock of State interface.
 type MockState struct {
 	ctrl     *gomock.Controller
@@ -638,6 +677,45 @@ func (c *MockStoragePoolProviderCheckPoolSupportsCharmStorageCall) DoAndReturn(f
 	return c
 }
 
+// CheckPoolSupportsSnapshots mocks base method.
+func (m *MockStoragePoolProvider) CheckPoolSupportsSnapshots(arg0 context.Context, arg1 storage0.StoragePoolUUID, arg2 charm.StorageType) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPoolSupportsSnapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckPoolSupportsSnapshots indicates an expected call of CheckPoolSupportsSnapshots.
+func (mr *MockStoragePoolProviderMockRecorder) CheckPoolSupportsSnapshots(arg0, arg1, arg2 any) *MockStoragePoolProviderCheckPoolSupportsSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPoolSupportsSnapshots", reflect.TypeOf((*MockStoragePoolProvider)(nil).CheckPoolSupportsSnapshots), arg0, arg1, arg2)
+	return &MockStoragePoolProviderCheckPoolSupportsSnapshotsCall{Call: call}
+}
+
+// MockStoragePoolProviderCheckPoolSupportsSnapshotsCall wrap *gomock.Call
+type MockStoragePoolProviderCheckPoolSupportsSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoragePoolProviderCheckPoolSupportsSnapshotsCall) Return(arg0 bool, arg1 error) *MockStoragePoolProviderCheckPoolSupportsSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoragePoolProviderCheckPoolSupportsSnapshotsCall) Do(f func(context.Context, storage0.StoragePoolUUID, charm.StorageType) (bool, error)) *MockStoragePoolProviderCheckPoolSupportsSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoragePoolProviderCheckPoolSupportsSnapshotsCall) DoAndReturn(f func(context.Context, storage0.StoragePoolUUID, charm.StorageType) (bool, error)) *MockStoragePoolProviderCheckPoolSupportsSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetProviderForPool mocks base method.
 func (m *MockStoragePoolProvider) GetProviderForPool(arg0 context.Context, arg1 storage0.StoragePoolUUID) (storage1.Provider, error) {
 	m.ctrl.T.Helper()
@@ -18,8 +18,9 @@ import (
 	storage "github.com/juju/juju/core/storage"
 	unit "github.com/juju/juju/core/unit"
 	application0 "github.com/juju/juju/domain/application"
+	charm "github.com/juju/juju/domain/application/charm"
 	internal "github.com/juju/juju/domain/application/internal"
-	charm "github.com/juju/juju/domain/deployment/charm"
+	charm0 "github.com/juju/juju/domain/deployment/charm"
 	network "github.com/juju/juju/domain/network"
 	storage0 "github.com/juju/juju/domain/storage"
 	gomock "go.uber.org/mock/gomock"
@@ -48,6 +49,45 @@ func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
 	return m.recorder
 }
 
+// CheckPoolSupportsSnapshots mocks base method.
+func (m *MockStorageService) CheckPoolSupportsSnapshots(arg0 context.Context, arg1 storage0.StoragePoolUUID, arg2 charm.StorageType) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPoolSupportsSnapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckPoolSupportsSnapshots indicates an expected call of CheckPoolSupportsSnapshots.
+func (mr *MockStorageServiceMockRecorder) CheckPoolSupportsSnapshots(arg0, arg1, arg2 any) *MockStorageServiceCheckPoolSupportsSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPoolSupportsSnapshots", reflect.TypeOf((*MockStorageService)(nil).CheckPoolSupportsSnapshots), arg0, arg1, arg2)
+	return &MockStorageServiceCheckPoolSupportsSnapshotsCall{Call: call}
+}
+
+// MockStorageServiceCheckPoolSupportsSnapshotsCall wrap *gomock.Call
+type MockStorageServiceCheckPoolSupportsSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceCheckPoolSupportsSnapshotsCall) Return(arg0 bool, arg1 error) *MockStorageServiceCheckPoolSupportsSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceCheckPoolSupportsSnapshotsCall) Do(f func(context.Context, storage0.StoragePoolUUID, charm.StorageType) (bool, error)) *MockStorageServiceCheckPoolSupportsSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceCheckPoolSupportsSnapshotsCall) DoAndReturn(f func(context.Context, storage0.StoragePoolUUID, charm.StorageType) (bool, error)) *MockStorageServiceCheckPoolSupportsSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationStorageDirectives mocks base method.
 func (m *MockStorageService) GetApplicationStorageDirectives(arg0 context.Context, arg1 application.UUID) ([]internal.StorageDirective, error) {
 	m.ctrl.T.Helper()
@@ -166,7 +206,7 @@ func (c *MockStorageServiceGetUnitStorageDirectiveByNameCall) DoAndReturn(f func
 }
 
 // MakeApplicationStorageDirectiveArgs mocks base method.
-func (m *MockStorageService) MakeApplicationStorageDirectiveArgs(arg0 context.Context, arg1 map[string]application0.ApplicationStorageDirectiveOverride, arg2 map[string]charm.Storage) ([]storage0.DirectiveArg, error) {
+func (m *MockStorageService) MakeApplicationStorageDirectiveArgs(arg0 context.Context, arg1 map[string]application0.ApplicationStorageDirectiveOverride, arg2 map[string]charm0.Storage) ([]storage0.DirectiveArg, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MakeApplicationStorageDirectiveArgs", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]storage0.DirectiveArg)
@@ -193,13 +233,13 @@ func (c *MockStorageServiceMakeApplicationStorageDirectiveArgsCall) Return(arg0
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceMakeApplicationStorageDirectiveArgsCall) Do(f func(context.Context, map[string]application0.ApplicationStorageDirectiveOverride, map[string]charm.Storage) ([]storage0.DirectiveArg, error)) *MockStorageServiceMakeApplicationStorageDirectiveArgsCall {
+func (c *MockStorageServiceMakeApplicationStorageDirectiveArgsCall) Do(f func(context.Context, map[string]application0.ApplicationStorageDirectiveOverride, map[string]charm0.Storage) ([]storage0.DirectiveArg, error)) *MockStorageServiceMakeApplicationStorageDirectiveArgsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceMakeApplicationStorageDirectiveArgsCall) DoAndReturn(f func(context.Context, map[string]application0.ApplicationStorageDirectiveOverride, map[string]charm.Storage) ([]storage0.DirectiveArg, error)) *MockStorageServiceMakeApplicationStorageDirectiveArgsCall {
+func (c *MockStorageServiceMakeApplicationStorageDirectiveArgsCall) DoAndReturn(f func(context.Context, map[string]application0.ApplicationStorageDirectiveOverride, map[string]charm0.Storage) ([]storage0.DirectiveArg, error)) *MockStorageServiceMakeApplicationStorageDirectiveArgsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -439,7 +479,7 @@ func (c *MockStorageServiceMakeUnitStorageArgsCall) DoAndReturn(f func(context.C
 }
 
 // ReconcileStorageDirectivesAgainstCharmStorage mocks base method.
-func (m *MockStorageService) ReconcileStorageDirectivesAgainstCharmStorage(arg0 context.Context, arg1 []internal.StorageDirective, arg2 map[string]charm.Storage) ([]storage0.DirectiveArg, []storage0.DirectiveArg, error) {
+func (m *MockStorageService) ReconcileStorageDirectivesAgainstCharmStorage(arg0 context.Context, arg1 []internal.StorageDirective, arg2 map[string]charm0.Storage) ([]storage0.DirectiveArg, []storage0.DirectiveArg, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileStorageDirectivesAgainstCharmStorage", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]storage0.DirectiveArg)
@@ -467,13 +507,13 @@ func (c *MockStorageServiceReconcileStorageDirectivesAgainstCharmStorageCall) Re
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceReconcileStorageDirectivesAgainstCharmStorageCall) Do(f func(context.Context, []internal.StorageDirective, map[string]charm.Storage) ([]storage0.DirectiveArg, []storage0.DirectiveArg, error)) *MockStorageServiceReconcileStorageDirectivesAgainstCharmStorageCall {
+func (c *MockStorageServiceReconcileStorageDirectivesAgainstCharmStorageCall) Do(f func(context.Context, []internal.StorageDirective, map[string]charm0.Storage) ([]storage0.DirectiveArg, []storage0.DirectiveArg, error)) *MockStorageServiceReconcileStorageDirectivesAgainstCharmStorageCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceReconcileStorageDirectivesAgainstCharmStorageCall) DoAndReturn(f func(context.Context, []internal.StorageDirective, map[string]charm.Storage) ([]storage0.DirectiveArg, []storage0.DirectiveArg, error)) *MockStorageServiceReconcileStorageDirectivesAgainstCharmStorageCall {
+func (c *MockStorageServiceReconcileStorageDirectivesAgainstCharmStorageCall) DoAndReturn(f func(context.Context, []internal.StorageDirective, map[string]charm0.Storage) ([]storage0.DirectiveArg, []storage0.DirectiveArg, error)) *MockStorageServiceReconcileStorageDirectivesAgainstCharmStorageCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -517,7 +557,7 @@ func (c *MockStorageServiceValidateApplicationStorageDirectiveOverridesCall) DoA
 }
 
 // ValidateCharmStorage mocks base method.
-func (m *MockStorageService) ValidateCharmStorage(arg0 context.Context, arg1 map[string]charm.Storage) error {
+func (m *MockStorageService) ValidateCharmStorage(arg0 context.Context, arg1 map[string]charm0.Storage) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidateCharmStorage", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -543,13 +583,13 @@ func (c *MockStorageServiceValidateCharmStorageCall) Return(arg0 error) *MockSto
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceValidateCharmStorageCall) Do(f func(context.Context, map[string]charm.Storage) error) *MockStorageServiceValidateCharmStorageCall {
+func (c *MockStorageServiceValidateCharmStorageCall) Do(f func(context.Context, map[string]charm0.Storage) error) *MockStorageServiceValidateCharmStorageCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceValidateCharmStorageCall) DoAndReturn(f func(context.Context, map[string]charm.Storage) error) *MockStorageServiceValidateCharmStorageCall {
+func (c *MockStorageServiceValidateCharmStorageCall) DoAndReturn(f func(context.Context, map[string]charm0.Storage) error) *MockStorageServiceValidateCharmStorageCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
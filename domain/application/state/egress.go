@@ -0,0 +1,129 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetApplicationEgressRules returns the egress rules of the application,
+// with the destination CIDRs of rules sharing a port range merged into a
+// single rule. An empty list is returned if the application has no egress
+// rules.
+func (st *State) GetApplicationEgressRules(ctx context.Context, appID coreapplication.UUID) (firewall.EgressRules, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := entityUUID{UUID: appID.String()}
+	query := `
+SELECT
+    r.cidr AS &egressRule.cidr,
+    p.protocol AS &egressRule.protocol,
+    r.from_port AS &egressRule.from_port,
+    r.to_port AS &egressRule.to_port
+FROM application_egress_rule r
+JOIN protocol p ON r.protocol_id = p.id
+WHERE r.application_uuid = $entityUUID.uuid;
+`
+	stmt, err := st.Prepare(query, egressRule{}, ident)
+	if err != nil {
+		return nil, errors.Errorf("preparing egress rules query: %w", err)
+	}
+
+	var rows []egressRule
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, ident).GetAll(&rows); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("retrieving egress rules for application %q: %w", appID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rules := make(firewall.EgressRules, len(rows))
+	for i, row := range rows {
+		rules[i] = firewall.NewEgressRule(network.PortRange{
+			Protocol: row.Protocol,
+			FromPort: row.FromPort,
+			ToPort:   row.ToPort,
+		}, row.CIDR)
+	}
+	return rules.Compact(), nil
+}
+
+// SetApplicationEgressRules replaces the egress rules of the application
+// with the provided rules. Passing an empty list removes all the egress
+// rules of the application.
+func (st *State) SetApplicationEgressRules(ctx context.Context, appID coreapplication.UUID, rules firewall.EgressRules) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ident := entityUUID{UUID: appID.String()}
+	deleteStmt, err := st.Prepare(`
+DELETE FROM application_egress_rule
+WHERE application_uuid = $entityUUID.uuid;
+`, ident)
+	if err != nil {
+		return errors.Errorf("preparing delete egress rules query: %w", err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO application_egress_rule (application_uuid, cidr, protocol_id, from_port, to_port)
+SELECT $egressRule.application_uuid, $egressRule.cidr, id, $egressRule.from_port, $egressRule.to_port
+FROM protocol
+WHERE protocol = $egressRule.protocol;
+`, egressRule{})
+	if err != nil {
+		return errors.Errorf("preparing insert egress rule query: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, deleteStmt, ident).Run(); err != nil {
+			return errors.Errorf("removing egress rules for application %q: %w", appID, err)
+		}
+		for _, rule := range rules.Compact() {
+			for _, cidr := range rule.DestinationCIDRs.SortedValues() {
+				row := egressRule{
+					ApplicationUUID: appID.String(),
+					CIDR:            cidr,
+					Protocol:        rule.PortRange.Protocol,
+					FromPort:        rule.PortRange.FromPort,
+					ToPort:          rule.PortRange.ToPort,
+				}
+				var outcome sqlair.Outcome
+				if err := tx.Query(ctx, insertStmt, row).Get(&outcome); err != nil {
+					return errors.Errorf("inserting egress rule %v for application %q: %w", rule, appID, err)
+				}
+				if n, err := outcome.Result().RowsAffected(); err != nil {
+					return errors.Capture(err)
+				} else if n != 1 {
+					return errors.Errorf("inserting egress rule %v for application %q: unknown protocol %q",
+						rule, appID, rule.PortRange.Protocol)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// NamespaceForWatchApplicationEgressRules returns the namespace identifier
+// for application egress rule changes.
+func (*State) NamespaceForWatchApplicationEgressRules() string {
+	return "application_egress_rule"
+}
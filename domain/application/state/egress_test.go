@@ -0,0 +1,96 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/life"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type egressStateSuite struct {
+	baseSuite
+
+	state *State
+}
+
+func TestEgressStateSuite(t *testing.T) {
+	tc.Run(t, &egressStateSuite{})
+}
+
+func (s *egressStateSuite) SetUpTest(c *tc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	s.state = NewState(s.TxnRunnerFactory(), s.modelUUID, clock.WallClock, loggertesting.WrapCheckLog(c))
+}
+
+func (s *egressStateSuite) TestGetApplicationEgressRulesEmpty(c *tc.C) {
+	appID := s.createIAASApplication(c, "foo", life.Alive)
+
+	rules, err := s.state.GetApplicationEgressRules(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.HasLen, 0)
+}
+
+func (s *egressStateSuite) TestSetApplicationEgressRules(c *tc.C) {
+	appID := s.createIAASApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationEgressRules(c.Context(), appID, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+		firewall.NewEgressRule(network.MustParsePortRange("53/udp"), "10.0.0.2/32"),
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "192.168.0.0/16"),
+		firewall.NewEgressRule(network.MustParsePortRange("icmp"), "10.0.0.0/8"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	rules, err := s.state.GetApplicationEgressRules(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("icmp"), "10.0.0.0/8"),
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8", "192.168.0.0/16"),
+		firewall.NewEgressRule(network.MustParsePortRange("53/udp"), "10.0.0.2/32"),
+	})
+}
+
+func (s *egressStateSuite) TestSetApplicationEgressRulesReplaces(c *tc.C) {
+	appID := s.createIAASApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationEgressRules(c.Context(), appID, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.SetApplicationEgressRules(c.Context(), appID, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("443/tcp"), "0.0.0.0/0"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	rules, err := s.state.GetApplicationEgressRules(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("443/tcp"), "0.0.0.0/0"),
+	})
+
+	err = s.state.SetApplicationEgressRules(c.Context(), appID, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	rules, err = s.state.GetApplicationEgressRules(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.HasLen, 0)
+}
+
+func (s *egressStateSuite) TestSetApplicationEgressRulesApplicationDead(c *tc.C) {
+	appID := s.createIAASApplication(c, "foo", life.Dead)
+
+	err := s.state.SetApplicationEgressRules(c.Context(), appID, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationIsDead)
+}
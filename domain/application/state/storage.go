@@ -1399,6 +1399,82 @@ WHERE  uuid = $storagePoolUUID.uuid
 	return typeVal.Type, nil
 }
 
+// GetStoragePool returns the name, provider type and attributes of the given
+// storage pool.
+//
+// The following error types can be expected:
+// - [storageerrors.StoragePoolNotFound] when no storage pool exists for the
+// provided pool uuid.
+func (st *State) GetStoragePool(
+	ctx context.Context, poolUUID domainstorage.StoragePoolUUID,
+) (domainstorage.StoragePool, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return domainstorage.StoragePool{}, errors.Capture(err)
+	}
+
+	var (
+		poolUUIDInput = storagePoolUUID{UUID: poolUUID.String()}
+		poolVal       storagePoolNameAndType
+		attrVals      []storagePoolAttribute
+	)
+
+	poolStmt, err := st.Prepare(`
+SELECT &storagePoolNameAndType.*
+FROM   storage_pool
+WHERE  uuid = $storagePoolUUID.uuid
+`,
+		poolUUIDInput, poolVal,
+	)
+	if err != nil {
+		return domainstorage.StoragePool{}, errors.Capture(err)
+	}
+
+	attrStmt, err := st.Prepare(`
+SELECT &storagePoolAttribute.*
+FROM   storage_pool_attribute
+WHERE  storage_pool_uuid = $storagePoolUUID.uuid
+`,
+		poolUUIDInput, storagePoolAttribute{},
+	)
+	if err != nil {
+		return domainstorage.StoragePool{}, errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, poolStmt, poolUUIDInput).Get(&poolVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage pool %q does not exist", poolUUID,
+			).Add(storageerrors.StoragePoolNotFound)
+		} else if err != nil {
+			return err
+		}
+
+		err = tx.Query(ctx, attrStmt, poolUUIDInput).GetAll(&attrVals)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return domainstorage.StoragePool{}, errors.Capture(err)
+	}
+
+	pool := domainstorage.StoragePool{
+		UUID:     poolUUID.String(),
+		Name:     poolVal.Name,
+		Provider: poolVal.Type,
+	}
+	if len(attrVals) != 0 {
+		pool.Attrs = make(domainstorage.Attrs, len(attrVals))
+		for _, attr := range attrVals {
+			pool.Attrs[attr.Key] = attr.Value
+		}
+	}
+	return pool, nil
+}
+
 // makeInsertUnitStorageAttachmentArgs is responsible for making the set of
 // storage instance attachment arguments that correspond to the storage uuids.
 func makeInsertUnitStorageAttachmentArgs(
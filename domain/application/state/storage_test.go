@@ -505,6 +505,44 @@ func (s *storageSuite) TestGetProviderTypeForPool(c *tc.C) {
 	c.Check(pType, tc.Equals, "ptype")
 }
 
+func (s *storageSuite) TestGetStoragePoolNotFound(c *tc.C) {
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	st := NewState(
+		s.ModelSuite.TxnRunnerFactory(),
+		s.modelUUID,
+		clock.WallClock,
+		loggertesting.WrapCheckLog(c),
+	)
+
+	_, err := st.GetStoragePool(c.Context(), poolUUID)
+	c.Check(err, tc.ErrorIs, storageerrors.StoragePoolNotFound)
+}
+
+// TestGetStoragePool checks that the name, provider type and attributes of a
+// storage pool are correctly returned.
+func (s *storageSuite) TestGetStoragePool(c *tc.C) {
+	poolUUID := s.newStoragePool(c, "test-pool", "ptype")
+	_, err := s.ModelSuite.DB().Exec(`
+INSERT INTO storage_pool_attribute (storage_pool_uuid, key, value)
+VALUES (?, 'foo', 'bar')`, poolUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	st := NewState(
+		s.ModelSuite.TxnRunnerFactory(),
+		s.modelUUID,
+		clock.WallClock,
+		loggertesting.WrapCheckLog(c),
+	)
+
+	pool, err := st.GetStoragePool(c.Context(), poolUUID)
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(pool, tc.DeepEquals, domainstorage.StoragePool{
+		UUID:     poolUUID.String(),
+		Name:     "test-pool",
+		Provider: "ptype",
+		Attrs:    domainstorage.Attrs{"foo": "bar"},
+	})
+}
+
 // TestGetModelStoragePoolsWithModelDefaults tests getting model default storage
 // pools when only the model defaults have been set via model config.
 func (s *storageSuite) TestGetModelStoragePoolsWithModelConfig(c *tc.C) {
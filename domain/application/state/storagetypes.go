@@ -134,6 +134,13 @@ type insertStorageVolumeInstance struct {
 	StorageVolumeUUID   string `db:"storage_volume_uuid"`
 }
 
+// insertStorageInstanceSnapshotSource represents the set of values required
+// for recording the snapshot a new storage instance is created from.
+type insertStorageInstanceSnapshotSource struct {
+	StorageInstanceUUID string `db:"storage_instance_uuid"`
+	SnapshotID          string `db:"snapshot_id"`
+}
+
 // insertStorageVolumeStatus represents the set of values required for
 // creating a new status value on a volume.
 type insertStorageVolumeStatus struct {
@@ -736,6 +736,20 @@ type storagePoolType struct {
 	Type string `db:"type"`
 }
 
+// storagePoolNameAndType is used to represent the name and provider type of
+// a storage pool record.
+type storagePoolNameAndType struct {
+	Name string `db:"name"`
+	Type string `db:"type"`
+}
+
+// storagePoolAttribute is used to represent a configuration attribute of a
+// storage pool record.
+type storagePoolAttribute struct {
+	Key   string `db:"key"`
+	Value string `db:"value"`
+}
+
 // storagePoolUUID is used to represent the UUID of a storage pool record.
 type storagePoolUUID struct {
 	UUID string `db:"uuid"`
@@ -1395,3 +1409,13 @@ type unitLifeWithCharm struct {
 	LifeID    int    `db:"life_id"`
 	CharmUUID string `db:"charm_uuid"`
 }
+
+// egressRule represents a single destination CIDR and port range that the
+// units of an application are allowed to connect to.
+type egressRule struct {
+	ApplicationUUID string `db:"application_uuid"`
+	CIDR            string `db:"cidr"`
+	Protocol        string `db:"protocol"`
+	FromPort        int    `db:"from_port"`
+	ToPort          int    `db:"to_port"`
+}
@@ -1057,6 +1057,14 @@ INSERT INTO storage_volume_status (*) VALUES ($insertStorageVolumeStatus.*)
 		return nil, errors.Capture(err)
 	}
 
+	insertSnapshotSourceStmt, err := st.Prepare(`
+INSERT INTO storage_instance_snapshot_source (*) VALUES ($insertStorageInstanceSnapshotSource.*)
+`,
+		insertStorageInstanceSnapshotSource{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
 	// We guard against zero length insert args below. This is because there is
 	// no correlation between input args and the number of inserts that happen.
 	// Empty inserts will result in an error that we don't need to consider.
@@ -1130,6 +1138,16 @@ INSERT INTO storage_volume_status (*) VALUES ($insertStorageVolumeStatus.*)
 		}
 	}
 
+	if snapshotArgs := makeInsertStorageInstanceSnapshotSourceArgs(stArgs); len(snapshotArgs) != 0 {
+		err := tx.Query(ctx, insertSnapshotSourceStmt, snapshotArgs).Run()
+		if err != nil {
+			return nil, errors.Errorf(
+				"setting snapshot source of new storage instance(s): %w",
+				err,
+			)
+		}
+	}
+
 	var result []string
 	for _, inst := range storageInstArgs {
 		result = append(result, inst.StorageID)
@@ -1365,6 +1383,24 @@ func (st *State) makeInsertUnitStorageInstanceArgs(
 	return storageInstancesRval, nil
 }
 
+// makeInsertStorageInstanceSnapshotSourceArgs returns the snapshot source
+// records for the storage instances being created from a snapshot.
+func makeInsertStorageInstanceSnapshotSourceArgs(
+	args []domainstorage.CreateUnitStorageInstanceArg,
+) []insertStorageInstanceSnapshotSource {
+	var rval []insertStorageInstanceSnapshotSource
+	for _, arg := range args {
+		if arg.SnapshotID == "" {
+			continue
+		}
+		rval = append(rval, insertStorageInstanceSnapshotSource{
+			StorageInstanceUUID: arg.UUID.String(),
+			SnapshotID:          arg.SnapshotID,
+		})
+	}
+	return rval
+}
+
 // makeInsertUnitVolumeArgs is responsible for making the insert args to
 // establish new volumes linked to a storage instance in the model.
 func (st *State) makeInsertUnitVolumeArgs(
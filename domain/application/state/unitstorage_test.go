@@ -685,6 +685,41 @@ func (u *unitStorageSuite) TestAddStorageForIAASUnit(c *tc.C) {
 	})
 }
 
+// TestAddStorageForIAASUnitFromSnapshot verifies that the snapshot a new
+// storage instance is created from is recorded against the storage instance.
+func (u *unitStorageSuite) TestAddStorageForIAASUnitFromSnapshot(c *tc.C) {
+	unitUUID, poolUUID := u.newUnitWithStorageDirectives(c)
+
+	fsUUID := tc.Must(c, domainstorage.NewFilesystemUUID)
+	siUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	_, err := u.state.AddStorageForIAASUnit(c.Context(), unitUUID, "st1", domainstorage.IAASUnitAddStorageArg{
+		UnitAddStorageArg: domainstorage.UnitAddStorageArg{
+			StorageInstances: []domainstorage.CreateUnitStorageInstanceArg{{
+				Filesystem: &domainstorage.CreateUnitStorageFilesystemArg{
+					UUID: fsUUID,
+				},
+				Name:            "st1",
+				UUID:            siUUID,
+				Kind:            domainstorage.StorageKindFilesystem,
+				SnapshotID:      "default:juju-abc/snap1",
+				StoragePoolUUID: poolUUID,
+				RequestSizeMiB:  1024,
+			}},
+			StorageToOwn: []domainstorage.StorageInstanceUUID{siUUID},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var snapshotID string
+	err = u.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `
+SELECT snapshot_id FROM storage_instance_snapshot_source
+WHERE  storage_instance_uuid = ?`, siUUID.String()).Scan(&snapshotID)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(snapshotID, tc.Equals, "default:juju-abc/snap1")
+}
+
 // TestAttachStorageInstanceToUnitStorageInstanceNotFound verifies that attaching
 // storage to a unitfor a missing Storage Instance returns an error satisfying
 // [domainstorageerrors.StorageInstanceNotFound].
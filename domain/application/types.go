@@ -547,4 +547,8 @@ type AddUnitStorageOverride struct {
 
 	// SizeMiB is the size of the storage instance, in MiB.
 	SizeMiB *uint64
+
+	// SnapshotID is the provider ID of a snapshot to create the new storage
+	// instances from.
+	SnapshotID *string
 }
@@ -22,6 +22,7 @@ import (
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
 	corestorage "github.com/juju/juju/core/storage"
 	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher/watchertest"
@@ -1353,6 +1354,107 @@ func (s *watcherSuite) TestWatchApplicationExposedBadName(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
 }
 
+func (s *watcherSuite) TestWatchAllApplicationsExposed(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "v_application_exposed_endpoint")
+
+	svc := s.setupService(c, factory)
+
+	s.createIAASApplication(c, svc, "foo")
+	s.createIAASApplication(c, svc, "bar")
+
+	ctx := c.Context()
+	s.AssertChangeStreamIdle(c)
+	watcher, err := svc.WatchAllApplicationsExposed(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness[struct{}](s, watchertest.NewWatcherC[struct{}](c, watcher))
+
+	// Assert that exposing either application triggers the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.MergeExposeSettings(ctx, "foo", map[string]application.ExposedEndpoint{
+			"": {
+				ExposeToCIDRs: set.NewStrings("10.0.0.0/24"),
+			},
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.MergeExposeSettings(ctx, "bar", map[string]application.ExposedEndpoint{
+			"": {
+				ExposeToCIDRs: set.NewStrings("10.0.1.0/24"),
+			},
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that unexposing an application triggers the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.UnsetExposeSettings(ctx, "foo", set.NewStrings(""))
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that nothing changes if nothing happens.
+	harness.AddTest(c, func(c *tc.C) {}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertNoChange()
+	})
+
+	harness.Run(c, struct{}{})
+}
+
+func (s *watcherSuite) TestWatchApplicationEgressRules(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "application_egress_rule")
+
+	svc := s.setupService(c, factory)
+
+	appName := "foo"
+	s.createIAASApplication(c, svc, appName)
+	s.createIAASApplication(c, svc, "bar")
+
+	ctx := c.Context()
+	s.AssertChangeStreamIdle(c)
+	watcher, err := svc.WatchApplicationEgressRules(ctx, appName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness[struct{}](s, watchertest.NewWatcherC[struct{}](c, watcher))
+
+	// Assert that setting egress rules triggers the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetApplicationEgressRules(ctx, appName, firewall.EgressRules{
+			firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that removing the egress rules triggers the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetApplicationEgressRules(ctx, appName, nil)
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that changes to another application are ignored.
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetApplicationEgressRules(ctx, "bar", firewall.EgressRules{
+			firewall.NewEgressRule(network.MustParsePortRange("443/tcp"), "0.0.0.0/0"),
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertNoChange()
+	})
+
+	harness.Run(c, struct{}{})
+}
+
 func (s *watcherSuite) TestWatchUnitForLegacyUniter(c *tc.C) {
 	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, s.ModelUUID())
 
@@ -1588,6 +1690,73 @@ func (s *watcherSuite) TestWatchUnitAddresses(c *tc.C) {
 	harness.Run(c, struct{}{})
 }
 
+func (s *watcherSuite) TestWatchAllUnitAddresses(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "ip_address")
+
+	svc := s.setupService(c, factory)
+
+	netNodeUUID, err := domainnetwork.NewNetNodeUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.createIAASApplication(c, svc, "foo", service.AddIAASUnitArg{})
+
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		insertNetNode := `INSERT INTO net_node (uuid) VALUES (?)`
+		_, err := tx.ExecContext(ctx, insertNetNode, netNodeUUID)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE unit SET net_node_uuid = ? WHERE name = ?", netNodeUUID, "foo/0")
+		if err != nil {
+			return err
+		}
+		insertLLD := `INSERT INTO link_layer_device (uuid, net_node_uuid, name, mtu, mac_address, device_type_id, virtual_port_type_id) VALUES (?, ?, ?, ?, ?, ?, ?)`
+		_, err = tx.ExecContext(ctx, insertLLD, "lld0-uuid", netNodeUUID, "lld0-name", 1500, "00:11:22:33:44:55", 0, 0)
+		if err != nil {
+			return err
+		}
+		insertSpace := `INSERT INTO space (uuid, name) VALUES (?, ?)`
+		_, err = tx.ExecContext(ctx, insertSpace, "space0-uuid", "space0")
+		if err != nil {
+			return err
+		}
+		insertSubnet := `INSERT INTO subnet (uuid, cidr, space_uuid) VALUES (?, ?, ?)`
+		_, err = tx.ExecContext(ctx, insertSubnet, "subnet-uuid", "10.0.0.0/24", "space0-uuid")
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.AssertChangeStreamIdle(c)
+	watcher, err := svc.WatchAllUnitAddresses(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	// Assert that adding an address to any net node triggers the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+			insertIPAddress := `INSERT INTO ip_address (uuid, device_uuid, address_value, net_node_uuid, type_id, scope_id, origin_id, config_type_id, subnet_uuid) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+			_, err = tx.ExecContext(ctx, insertIPAddress, "ip-address0-uuid", "lld0-uuid", "10.0.0.1", netNodeUUID, 0, 3, 1, 1, "subnet-uuid")
+			return err
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that removing the address triggers the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+			_, err = tx.ExecContext(ctx, `DELETE FROM ip_address WHERE uuid = ?`, "ip-address0-uuid")
+			return err
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	harness.Run(c, struct{}{})
+}
+
 func (s *watcherSuite) getApplicationConfigHash(c *tc.C, db changestream.WatchableDB, appUUID coreapplication.UUID) string {
 	var hash string
 	err := db.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
@@ -1600,13 +1769,22 @@ func (s *watcherSuite) getApplicationConfigHash(c *tc.C, db changestream.Watchab
 	return hash
 }
 
+// egressProvider is a provider which can apply egress rules.
+type egressProvider struct {
+	service.Provider
+}
+
+func (egressProvider) SupportsEgressRules(context.Context) (bool, error) {
+	return true, nil
+}
+
 func (s *watcherSuite) setupService(c *tc.C, factory domain.WatchableDBFactory) *service.WatchableService {
 	modelDB := func(ctx context.Context) (database.TxnRunner, error) {
 		return s.ModelTxnRunner(), nil
 	}
 
 	providerGetter := func(ctx context.Context) (service.Provider, error) {
-		return machineservice.NewNoopProvider(), nil
+		return egressProvider{Provider: machineservice.NewNoopProvider()}, nil
 	}
 	caasProviderGetter := func(ctx context.Context) (service.CAASProvider, error) {
 		return nil, coreerrors.NotSupported
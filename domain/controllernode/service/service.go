@@ -185,11 +185,26 @@ func (s *Service) encodeAPIAddresses(ctx context.Context, mgmtSpace *network.Spa
 	// space ID of the address matches the management space ID), and also by
 	// joining the address host and port to a string "host:port".
 	addresses := make(controllernode.APIAddresses, 0, len(addrs))
+	// If the management space has an address preference, agents are only
+	// handed the addresses of the preferred type, provided there are any.
+	var preferredType network.AddressType
+	if mgmtSpace != nil && mgmtSpace.PreferredAddressType != "" {
+		for _, spHostPort := range addrs {
+			if spHostPort.SpaceID == mgmtSpace.ID &&
+				network.DeriveAddressType(spHostPort.Host()) == mgmtSpace.PreferredAddressType {
+				preferredType = mgmtSpace.PreferredAddressType
+				break
+			}
+		}
+	}
 	emptyAgentAddresses := true
 	for _, spHostPort := range addrs {
 		// Check if the address is available for agents. If no management space
 		// is set, all addresses are available for agents.
 		isAvailableForAgents := mgmtSpace == nil || spHostPort.SpaceID == mgmtSpace.ID
+		if preferredType != "" && network.DeriveAddressType(spHostPort.Host()) != preferredType {
+			isAvailableForAgents = false
+		}
 		// Join the address host and port to a string "host:port".
 		address := net.JoinHostPort(spHostPort.Host(), strconv.Itoa(spHostPort.Port()))
 		addresses = append(addresses, controllernode.APIAddress{
@@ -384,6 +384,63 @@ func (s *serviceSuite) TestSetAPIAddressesNotAllAddrsFilteredAgents(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestSetAPIAddressesMgmtSpacePreferredAddressType(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := NewService(s.state, loggertesting.WrapCheckLog(c))
+
+	controllerApiAddrs := map[string]controllernode.APIAddresses{
+		"1": {
+			{
+				Address: "10.0.0.1:17070",
+				IsAgent: false,
+				Scope:   network.ScopeCloudLocal,
+			}, {
+				Address: "[2001:db8::1]:17070",
+				IsAgent: true,
+				Scope:   network.ScopePublic,
+			},
+		},
+		// Without an address of the preferred type, the addresses of the
+		// other type remain available for agents.
+		"2": {
+			{
+				Address: "10.0.0.2:17070",
+				IsAgent: true,
+				Scope:   network.ScopeCloudLocal,
+			},
+		},
+	}
+	s.state.EXPECT().SetAPIAddresses(gomock.Any(), controllerApiAddrs).Return(nil)
+
+	hostPort := func(value string, scope network.Scope) network.SpaceHostPort {
+		return network.SpaceHostPort{
+			SpaceAddress: network.SpaceAddress{
+				MachineAddress: network.NewMachineAddress(value, network.WithScope(scope)),
+				SpaceID:        "space0-uuid",
+			},
+			NetPort: network.NetPort(17070),
+		}
+	}
+	args := controllernode.SetAPIAddressArgs{
+		MgmtSpace: &network.SpaceInfo{
+			ID:                   "space0-uuid",
+			Name:                 "space0",
+			PreferredAddressType: network.IPv6Address,
+		},
+		APIAddresses: map[string]network.SpaceHostPorts{
+			"1": {
+				hostPort("10.0.0.1", network.ScopeCloudLocal),
+				hostPort("2001:db8::1", network.ScopePublic),
+			},
+			"2": {
+				hostPort("10.0.0.2", network.ScopeCloudLocal),
+			},
+		},
+	}
+	err := svc.SetAPIAddresses(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *serviceSuite) TestGetControllerIDs(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	svc := NewService(s.state, loggertesting.WrapCheckLog(c))
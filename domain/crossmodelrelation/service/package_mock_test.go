@@ -23,6 +23,7 @@ import (
 	crossmodelrelation "github.com/juju/juju/domain/crossmodelrelation"
 	internal "github.com/juju/juju/domain/crossmodelrelation/internal"
 	secret "github.com/juju/juju/domain/secret"
+	secretbackend "github.com/juju/juju/domain/secretbackend"
 	uuid "github.com/juju/juju/internal/uuid"
 	gomock "go.uber.org/mock/gomock"
 	macaroon "gopkg.in/macaroon.v2"
@@ -89,6 +90,45 @@ func (c *MockControllerStateCreateOfferAccessCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// GetModelSecretDataKey mocks base method.
+func (m *MockControllerState) GetModelSecretDataKey(arg0 context.Context, arg1 model.UUID) (secretbackend.WrappedDataKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelSecretDataKey", arg0, arg1)
+	ret0, _ := ret[0].(secretbackend.WrappedDataKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelSecretDataKey indicates an expected call of GetModelSecretDataKey.
+func (mr *MockControllerStateMockRecorder) GetModelSecretDataKey(arg0, arg1 any) *MockControllerStateGetModelSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelSecretDataKey", reflect.TypeOf((*MockControllerState)(nil).GetModelSecretDataKey), arg0, arg1)
+	return &MockControllerStateGetModelSecretDataKeyCall{Call: call}
+}
+
+// MockControllerStateGetModelSecretDataKeyCall wrap *gomock.Call
+type MockControllerStateGetModelSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerStateGetModelSecretDataKeyCall) Return(arg0 secretbackend.WrappedDataKey, arg1 error) *MockControllerStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerStateGetModelSecretDataKeyCall) Do(f func(context.Context, model.UUID) (secretbackend.WrappedDataKey, error)) *MockControllerStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerStateGetModelSecretDataKeyCall) DoAndReturn(f func(context.Context, model.UUID) (secretbackend.WrappedDataKey, error)) *MockControllerStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetOfferUUIDsForUsersWithConsume mocks base method.
 func (m *MockControllerState) GetOfferUUIDsForUsersWithConsume(arg0 context.Context, arg1 []string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +168,45 @@ func (c *MockControllerStateGetOfferUUIDsForUsersWithConsumeCall) DoAndReturn(f
 	return c
 }
 
+// GetSecretEncryptionMasterKeySource mocks base method.
+func (m *MockControllerState) GetSecretEncryptionMasterKeySource(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretEncryptionMasterKeySource", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretEncryptionMasterKeySource indicates an expected call of GetSecretEncryptionMasterKeySource.
+func (mr *MockControllerStateMockRecorder) GetSecretEncryptionMasterKeySource(arg0 any) *MockControllerStateGetSecretEncryptionMasterKeySourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretEncryptionMasterKeySource", reflect.TypeOf((*MockControllerState)(nil).GetSecretEncryptionMasterKeySource), arg0)
+	return &MockControllerStateGetSecretEncryptionMasterKeySourceCall{Call: call}
+}
+
+// MockControllerStateGetSecretEncryptionMasterKeySourceCall wrap *gomock.Call
+type MockControllerStateGetSecretEncryptionMasterKeySourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerStateGetSecretEncryptionMasterKeySourceCall) Return(arg0 string, arg1 error) *MockControllerStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerStateGetSecretEncryptionMasterKeySourceCall) Do(f func(context.Context) (string, error)) *MockControllerStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerStateGetSecretEncryptionMasterKeySourceCall) DoAndReturn(f func(context.Context) (string, error)) *MockControllerStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUserUUIDByName mocks base method.
 func (m *MockControllerState) GetUserUUIDByName(arg0 context.Context, arg1 user.Name) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -697,6 +776,45 @@ func (c *MockModelStateGetModelEgressSubnetsCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// GetModelUUID mocks base method.
+func (m *MockModelState) GetModelUUID(arg0 context.Context) (model.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelUUID", arg0)
+	ret0, _ := ret[0].(model.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelUUID indicates an expected call of GetModelUUID.
+func (mr *MockModelStateMockRecorder) GetModelUUID(arg0 any) *MockModelStateGetModelUUIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelUUID", reflect.TypeOf((*MockModelState)(nil).GetModelUUID), arg0)
+	return &MockModelStateGetModelUUIDCall{Call: call}
+}
+
+// MockModelStateGetModelUUIDCall wrap *gomock.Call
+type MockModelStateGetModelUUIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateGetModelUUIDCall) Return(arg0 model.UUID, arg1 error) *MockModelStateGetModelUUIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateGetModelUUIDCall) Do(f func(context.Context) (model.UUID, error)) *MockModelStateGetModelUUIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateGetModelUUIDCall) DoAndReturn(f func(context.Context) (model.UUID, error)) *MockModelStateGetModelUUIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetOfferConnections mocks base method.
 func (m *MockModelState) GetOfferConnections(arg0 context.Context, arg1 []string) ([]crossmodelrelation.OfferConnectionDetail, error) {
 	m.ctrl.T.Helper()
@@ -1371,6 +1489,45 @@ func (c *MockModelStateGetSecretRemoteConsumerCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// GetSecretRevisionID mocks base method.
+func (m *MockModelState) GetSecretRevisionID(arg0 context.Context, arg1 *secrets.URI, arg2 int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretRevisionID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretRevisionID indicates an expected call of GetSecretRevisionID.
+func (mr *MockModelStateMockRecorder) GetSecretRevisionID(arg0, arg1, arg2 any) *MockModelStateGetSecretRevisionIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretRevisionID", reflect.TypeOf((*MockModelState)(nil).GetSecretRevisionID), arg0, arg1, arg2)
+	return &MockModelStateGetSecretRevisionIDCall{Call: call}
+}
+
+// MockModelStateGetSecretRevisionIDCall wrap *gomock.Call
+type MockModelStateGetSecretRevisionIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateGetSecretRevisionIDCall) Return(arg0 string, arg1 error) *MockModelStateGetSecretRevisionIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateGetSecretRevisionIDCall) Do(f func(context.Context, *secrets.URI, int) (string, error)) *MockModelStateGetSecretRevisionIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateGetSecretRevisionIDCall) DoAndReturn(f func(context.Context, *secrets.URI, int) (string, error)) *MockModelStateGetSecretRevisionIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretValue mocks base method.
 func (m *MockModelState) GetSecretValue(arg0 context.Context, arg1 *secrets.URI, arg2 int) (secrets.SecretData, *secrets.ValueRef, error) {
 	m.ctrl.T.Helper()
@@ -1992,6 +2149,44 @@ func (c *MockModelStateNamespacesForRelationEgressNetworksWatcherCall) DoAndRetu
 	return c
 }
 
+// RecordSecretAccess mocks base method.
+func (m *MockModelState) RecordSecretAccess(arg0 context.Context, arg1 secret.SecretAccessLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSecretAccess", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSecretAccess indicates an expected call of RecordSecretAccess.
+func (mr *MockModelStateMockRecorder) RecordSecretAccess(arg0, arg1 any) *MockModelStateRecordSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSecretAccess", reflect.TypeOf((*MockModelState)(nil).RecordSecretAccess), arg0, arg1)
+	return &MockModelStateRecordSecretAccessCall{Call: call}
+}
+
+// MockModelStateRecordSecretAccessCall wrap *gomock.Call
+type MockModelStateRecordSecretAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateRecordSecretAccessCall) Return(arg0 error) *MockModelStateRecordSecretAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateRecordSecretAccessCall) Do(f func(context.Context, secret.SecretAccessLogEntry) error) *MockModelStateRecordSecretAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateRecordSecretAccessCall) DoAndReturn(f func(context.Context, secret.SecretAccessLogEntry) error) *MockModelStateRecordSecretAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveMacaroonForRelation mocks base method.
 func (m *MockModelState) SaveMacaroonForRelation(arg0 context.Context, arg1 string, arg2 []byte) error {
 	m.ctrl.T.Helper()
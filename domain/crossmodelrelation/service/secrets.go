@@ -7,6 +7,7 @@ import (
 	"context"
 
 	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/relation"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/trace"
@@ -15,7 +16,9 @@ import (
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	domainsecret "github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
 )
 
 // ModelSecretsState describes retrieval and persistence methods for
@@ -44,6 +47,14 @@ type ModelSecretsState interface {
 	// GetSecretValue returns the contents - either data or value reference - of a
 	// given secret revision.
 	GetSecretValue(ctx context.Context, uri *secrets.URI, revision int) (secrets.SecretData, *secrets.ValueRef, error)
+	// RecordSecretAccess adds an entry to the secret access log.
+	RecordSecretAccess(ctx context.Context, entry domainsecret.SecretAccessLogEntry) error
+	// GetSecretRevisionID returns the revision UUID for the specified secret
+	// URI and revision.
+	GetSecretRevisionID(ctx context.Context, uri *secrets.URI, revision int) (string, error)
+
+	// GetModelUUID returns the UUID of the model.
+	GetModelUUID(ctx context.Context) (model.UUID, error)
 	// GetSecretAccess returns the access to the secret for the specified accessor.
 	GetSecretAccess(ctx context.Context, uri *secrets.URI, params domainsecret.AccessParams) (string, error)
 }
@@ -137,6 +148,8 @@ func (s *Service) canRead(ctx context.Context, uri *secrets.URI, consumer domain
 // - [secreterrors.PermissionDenied] if the consumer does not have permission to read the secret
 // - [secreterrors.SecretNotFound] if the secret does not exist
 // - [secreterrors.SecretRevisionNotFound] if the secret revision does not exist
+//
+// Each successful read is recorded in the secret access log.
 func (s *Service) ProcessRemoteConsumerGetSecret(
 	ctx context.Context, uri *secrets.URI, consumer unit.Name, revision *int, peek, refresh bool,
 ) (secrets.SecretValue, *secrets.ValueRef, int, error) {
@@ -171,7 +184,39 @@ func (s *Service) ProcessRemoteConsumerGetSecret(
 	}
 
 	data, valueRef, err := s.modelState.GetSecretValue(ctx, uri, wantRevision)
-	return secrets.NewSecretValue(data), valueRef, latestRevision, err
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if encryption.HasSealed(data) {
+		revisionID, err := s.modelState.GetSecretRevisionID(ctx, uri, wantRevision)
+		if err != nil {
+			return nil, nil, 0, errors.Capture(err)
+		}
+		modelUUID, err := s.modelState.GetModelUUID(ctx)
+		if err != nil {
+			return nil, nil, 0, errors.Errorf("getting model uuid: %w", err)
+		}
+		if data, err = secretbackend.OpenSecretContent(ctx, s.controllerState, modelUUID, encryption.SecretRevision{
+			SecretID:   uri.ID,
+			RevisionID: revisionID,
+		}, data); err != nil {
+			return nil, nil, 0, errors.Capture(err)
+		}
+	}
+	// The read is recorded against the consuming unit in the other model,
+	// as it would be for a consumer in this model.
+	if err := s.modelState.RecordSecretAccess(ctx, domainsecret.SecretAccessLogEntry{
+		SecretID: uri.ID,
+		Revision: wantRevision,
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.UnitAccessor,
+			ID:   consumer.String(),
+		},
+		AccessedAt: s.clock.Now(),
+	}); err != nil {
+		return nil, nil, 0, errors.Errorf("recording access to secret %q: %w", uri.ID, err)
+	}
+	return secrets.NewSecretValue(data), valueRef, latestRevision, nil
 }
 
 func (s *Service) updateConsumedRevision(ctx context.Context, consumer unit.Name, uri *secrets.URI, refresh bool) (int, error) {
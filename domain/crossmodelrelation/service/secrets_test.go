@@ -3,6 +3,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"github.com/juju/tc"
@@ -15,6 +16,7 @@ import (
 	unittesting "github.com/juju/juju/core/unit/testing"
 	"github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/errors"
 )
 
 type secretsServiceSuite struct {
@@ -120,6 +122,7 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretNoPeekOrRefresh(
 		SubjectID:     consumer.Application(),
 	}).Return(secret.RoleView.String(), nil)
 	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(data, nil, nil)
+	s.expectRecordSecretAccess(c, uri, consumer, 666)
 
 	service := s.service(c)
 
@@ -148,6 +151,7 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretPeek(c *tc.C) {
 			CurrentRevision: 665,
 		}, 666, nil)
 	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(data, nil, nil)
+	s.expectRecordSecretAccess(c, uri, consumer, 666)
 
 	service := s.service(c)
 
@@ -177,6 +181,7 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretRefresh(c *tc.C)
 			Label:           "foo",
 		}, 666, nil)
 	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(data, nil, nil)
+	s.expectRecordSecretAccess(c, uri, consumer, 666)
 	s.modelState.EXPECT().SaveSecretRemoteConsumer(gomock.Any(), uri, consumer.String(), coresecrets.SecretConsumerMetadata{
 		CurrentRevision: 666,
 		Label:           "foo",
@@ -210,6 +215,7 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretNoConsumerExists
 	s.modelState.EXPECT().GetSecretRemoteConsumer(gomock.Any(), uri, consumer.String()).
 		Return(nil, 666, secreterrors.SecretConsumerNotFound)
 	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(nil, ref, nil)
+	s.expectRecordSecretAccess(c, uri, consumer, 666)
 	s.modelState.EXPECT().SaveSecretRemoteConsumer(gomock.Any(), uri, consumer.String(), coresecrets.SecretConsumerMetadata{
 		CurrentRevision: 666,
 	})
@@ -242,3 +248,39 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretPermissionError(
 		c.Context(), uri, consumer, nil, false, false)
 	c.Assert(err, tc.ErrorIs, secreterrors.PermissionDenied)
 }
+
+func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretRecordAccessError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	consumer := unittesting.GenNewName(c, "consumer/0")
+
+	s.modelState.EXPECT().GetSecretAccess(gomock.Any(), uri, secret.AccessParams{
+		SubjectTypeID: secret.SubjectApplication,
+		SubjectID:     consumer.Application(),
+	}).Return(secret.RoleView.String(), nil)
+	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(map[string]string{"foo": "bar"}, nil, nil)
+	s.modelState.EXPECT().RecordSecretAccess(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	service := s.service(c)
+
+	_, _, _, err := service.ProcessRemoteConsumerGetSecret(
+		c.Context(), uri, consumer, new(666), false, false)
+	c.Assert(err, tc.ErrorMatches, `recording access to secret .*: boom`)
+}
+
+// expectRecordSecretAccess expects the read of the secret revision by the
+// consumer to be recorded in the secret access log.
+func (s *secretsServiceSuite) expectRecordSecretAccess(c *tc.C, uri *coresecrets.URI, consumer coreunit.Name, rev int) {
+	s.modelState.EXPECT().RecordSecretAccess(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, entry secret.SecretAccessLogEntry) error {
+			c.Check(entry.SecretID, tc.Equals, uri.ID)
+			c.Check(entry.Revision, tc.Equals, rev)
+			c.Check(entry.Accessor, tc.DeepEquals, secret.SecretAccessor{
+				Kind: secret.UnitAccessor,
+				ID:   consumer.String(),
+			})
+			c.Check(entry.AccessedAt.IsZero(), tc.IsFalse)
+			return nil
+		})
+}
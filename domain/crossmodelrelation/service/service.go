@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/domain/crossmodelrelation"
 	"github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/domain/secretbackend"
 	environsconfig "github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/statushistory"
@@ -50,6 +51,8 @@ type ModelState interface {
 // ControllerState describes retrieval and persistence methods for cross
 // model relation access in the controller database.
 type ControllerState interface {
+	secretbackend.DataKeyReader
+
 	// CreateOfferAccess give the offer owner AdminAccess and EveryoneUserName
 	// ReadAccess for the provided offer.
 	CreateOfferAccess(
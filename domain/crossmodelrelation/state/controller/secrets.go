@@ -0,0 +1,82 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/controller"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/domain/secretbackend"
+	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetSecretEncryptionMasterKeySource returns the source of the master key
+// encrypting secret content from the controller config, or an empty string
+// if secret content is not encrypted.
+func (st *State) GetSecretEncryptionMasterKeySource(ctx context.Context) (string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	value := controllerConfigValue{Key: controller.SecretEncryptionMasterKey}
+	stmt, err := st.Prepare(`
+SELECT value AS &controllerConfigValue.value
+FROM   v_controller_config
+WHERE  key = $controllerConfigValue.key`, value)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, value).Get(&value)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return value.Value, nil
+}
+
+// GetModelSecretDataKey returns the wrapped data key encrypting the secret
+// content of the model, returning an error satisfying
+// [secretbackenderrors.DataKeyNotFound] if the model has none.
+func (st *State) GetModelSecretDataKey(ctx context.Context, modelUUID coremodel.UUID) (secretbackend.WrappedDataKey, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return secretbackend.WrappedDataKey{}, errors.Capture(err)
+	}
+
+	key := modelDataKey{ModelUUID: modelUUID.String()}
+	stmt, err := st.Prepare(`
+SELECT &modelDataKey.*
+FROM   model_secret_data_key
+WHERE  model_uuid = $modelDataKey.model_uuid`, key)
+	if err != nil {
+		return secretbackend.WrappedDataKey{}, errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, key).Get(&key)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("model %q", modelUUID).Add(secretbackenderrors.DataKeyNotFound)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return secretbackend.WrappedDataKey{}, errors.Capture(err)
+	}
+	return secretbackend.WrappedDataKey{
+		MasterKeyID: key.MasterKeyID,
+		Key:         key.WrappedKey,
+	}, nil
+}
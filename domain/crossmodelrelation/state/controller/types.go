@@ -46,3 +46,17 @@ type offerUser struct {
 	DisplayName string `db:"display_name"`
 	Access      string `db:"access_type"`
 }
+
+// controllerConfigValue represents a single controller config key and value.
+type controllerConfigValue struct {
+	Key   string `db:"key"`
+	Value string `db:"value"`
+}
+
+// modelDataKey represents the wrapped data key encrypting the secret
+// content of a model.
+type modelDataKey struct {
+	ModelUUID   string `db:"model_uuid"`
+	WrappedKey  []byte `db:"wrapped_key"`
+	MasterKeyID string `db:"master_key_id"`
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/canonical/sqlair"
 
 	coredatabase "github.com/juju/juju/core/database"
+	coremodel "github.com/juju/juju/core/model"
 	coresecrets "github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/watcher/eventsource"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
@@ -564,6 +565,11 @@ WHERE  name=$unit.name`, u)
 	return u.UUID, errors.Capture(err)
 }
 
+// GetModelUUID returns the UUID of the model.
+func (st *State) GetModelUUID(context.Context) (coremodel.UUID, error) {
+	return coremodel.UUID(st.modelUUID), nil
+}
+
 // GetSecretValue returns the contents - either data or value reference - of a
 // given secret revision, returning an error satisfying
 // [secreterrors.SecretRevisionNotFound] if the secret revision does not exist.
@@ -650,6 +656,84 @@ AND    rev.revision = $secretRevision.revision`
 	}, nil
 }
 
+// maxSecretAccessLogEntries is the number of entries the secret access log
+// of a model is bounded to. It matches the bound applied by the secret domain.
+const maxSecretAccessLogEntries = 100000
+
+// RecordSecretAccess adds an entry for a read by a consumer in another model
+// to the secret access log, removing the oldest entries once the log is full.
+func (st *State) RecordSecretAccess(ctx context.Context, entry domainsecret.SecretAccessLogEntry) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := secretAccessLogEntry{
+		SecretID:     entry.SecretID,
+		Revision:     entry.Revision,
+		AccessorKind: string(entry.Accessor.Kind),
+		AccessorID:   entry.Accessor.ID,
+		AccessedAt:   entry.AccessedAt.UTC(),
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO secret_access_log (secret_id, revision, accessor_kind, accessor_id, accessed_at)
+VALUES ($secretAccessLogEntry.*)`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	bound := secretAccessLogBound{Entries: maxSecretAccessLogEntries}
+	pruneStmt, err := st.Prepare(`
+DELETE FROM secret_access_log
+WHERE  id <= (SELECT MAX(id) FROM secret_access_log) - $secretAccessLogBound.entries`, bound)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, insertStmt, row).Run(); err != nil {
+			return errors.Errorf("recording access to secret %q: %w", entry.SecretID, err)
+		}
+		if err := tx.Query(ctx, pruneStmt, bound).Run(); err != nil {
+			return errors.Errorf("pruning secret access log: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetSecretRevisionID returns the revision UUID for the specified secret URI
+// and revision, or an error satisfying [secreterrors.SecretRevisionNotFound]
+// if the revision is not found.
+func (st *State) GetSecretRevisionID(ctx context.Context, uri *coresecrets.URI, revision int) (string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	want := secretRevision{SecretID: uri.ID, Revision: revision}
+	stmt, err := st.Prepare(`
+SELECT uuid AS &uuid.uuid
+FROM   secret_revision
+WHERE  secret_id = $secretRevision.secret_id
+AND    revision = $secretRevision.revision`, uuid{}, want)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var result uuid
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, want).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("secret %q revision %d not found", uri, revision).Add(secreterrors.SecretRevisionNotFound)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return result.UUID, nil
+}
+
 // GetSecretAccess returns the access to the secret for the specified accessor.
 // It returns an error satisfying [secreterrors.SecretNotFound]
 // if the secret is not found.
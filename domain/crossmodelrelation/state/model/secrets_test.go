@@ -644,3 +644,53 @@ func (s *modelSecretsSuite) TestGetSecretValueRevisionNotFound(c *tc.C) {
 	_, _, err := s.state.GetSecretValue(c.Context(), uri, 666)
 	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
 }
+
+func (s *modelSecretsSuite) TestGetSecretRevisionID(c *tc.C) {
+	uri := coresecrets.NewURI()
+	s.createSecret(c, uri, map[string]string{"foo": "bar"}, nil)
+
+	got, err := s.state.GetSecretRevisionID(c.Context(), uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.Equals, getRevUUID(c, s.DB(), uri, 1))
+}
+
+func (s *modelSecretsSuite) TestGetSecretRevisionIDNotFound(c *tc.C) {
+	uri := coresecrets.NewURI()
+	s.createSecret(c, uri, map[string]string{"foo": "bar"}, nil)
+
+	_, err := s.state.GetSecretRevisionID(c.Context(), uri, 666)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *modelSecretsSuite) TestRecordSecretAccess(c *tc.C) {
+	uri := coresecrets.NewURI()
+	s.createSecret(c, uri, map[string]string{"foo": "bar"}, nil)
+
+	accessedAt := time.Now().UTC().Truncate(time.Second)
+	err := s.state.RecordSecretAccess(c.Context(), domainsecret.SecretAccessLogEntry{
+		SecretID: uri.ID,
+		Revision: 1,
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.UnitAccessor,
+			ID:   "remote-app/0",
+		},
+		AccessedAt: accessedAt,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var (
+		revision                 int
+		accessorKind, accessorID string
+		gotAccessedAt            time.Time
+	)
+	row := s.DB().QueryRowContext(c.Context(), `
+SELECT revision, accessor_kind, accessor_id, accessed_at
+FROM   secret_access_log
+WHERE  secret_id = ?`, uri.ID)
+	err = row.Scan(&revision, &accessorKind, &accessorID, &gotAccessedAt)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(revision, tc.Equals, 1)
+	c.Check(accessorKind, tc.Equals, "unit")
+	c.Check(accessorID, tc.Equals, "remote-app/0")
+	c.Check(gotAccessedAt.Equal(accessedAt), tc.IsTrue)
+}
@@ -423,6 +423,21 @@ type secretRevision struct {
 	Revision int    `db:"revision"`
 }
 
+// secretAccessLogEntry represents a row in the secret_access_log table.
+type secretAccessLogEntry struct {
+	SecretID     string    `db:"secret_id"`
+	Revision     int       `db:"revision"`
+	AccessorKind string    `db:"accessor_kind"`
+	AccessorID   string    `db:"accessor_id"`
+	AccessedAt   time.Time `db:"accessed_at"`
+}
+
+// secretAccessLogBound holds the number of entries the secret access log is
+// bounded to.
+type secretAccessLogBound struct {
+	Entries int `db:"entries"`
+}
+
 type secretValues []secretContent
 
 func (rows secretValues) toSecretData() coresecrets.SecretData {
@@ -0,0 +1,12 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package desiredbundle stores the bundle that a model is expected to match.
+//
+// Operators attach a desired bundle to a model to describe how it should be
+// deployed. The bundle drift worker periodically compares the model with the
+// desired bundle, using the same comparison as diff-bundle, and records the
+// differences it finds. A model that has drifted from its desired bundle
+// reports so in its status, so that changes made by hand, such as config set
+// during an incident, are noticed and reverted.
+package desiredbundle
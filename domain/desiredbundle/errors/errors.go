@@ -0,0 +1,21 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// NotFound describes an error that occurs when the model does not have
+	// a desired bundle.
+	NotFound = errors.ConstError("desired bundle not found")
+
+	// BundleChanged describes an error that occurs when recording the result
+	// of a comparison against a desired bundle that has since been replaced
+	// or removed.
+	BundleChanged = errors.ConstError("desired bundle changed")
+
+	// BundleNotValid describes an error that occurs when the desired bundle
+	// cannot be parsed or verified.
+	BundleNotValid = errors.ConstError("desired bundle not valid")
+)
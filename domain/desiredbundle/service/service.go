@@ -0,0 +1,137 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/domain/desiredbundle"
+	desiredbundleerrors "github.com/juju/juju/domain/desiredbundle/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// State describes retrieval and persistence methods for the desired bundle
+// of a model.
+type State interface {
+	// SetDesiredBundle sets the desired bundle of the model, replacing any
+	// existing desired bundle along with the result of its last comparison
+	// with the model.
+	SetDesiredBundle(ctx context.Context, uuid, bundle string, setAt time.Time) error
+
+	// GetDesiredBundle returns the desired bundle of the model.
+	GetDesiredBundle(ctx context.Context) (desiredbundle.DesiredBundle, error)
+
+	// RemoveDesiredBundle removes the desired bundle of the model.
+	RemoveDesiredBundle(ctx context.Context) error
+
+	// SetBundleDrift records the result of comparing the model with the
+	// desired bundle identified by uuid.
+	SetBundleDrift(ctx context.Context, uuid, drift string, checkedAt time.Time) error
+}
+
+// Service provides the API for working with the desired bundle of a model.
+type Service struct {
+	st    State
+	clock clock.Clock
+}
+
+// NewService returns a new service reference wrapping the given state.
+func NewService(st State, clock clock.Clock) *Service {
+	return &Service{
+		st:    st,
+		clock: clock,
+	}
+}
+
+// SetDesiredBundle sets the bundle that the model is expected to match,
+// replacing any existing desired bundle. The model is compared with the new
+// bundle the next time the bundle drift worker runs.
+// The following errors may be returned:
+//   - [desiredbundleerrors.BundleNotValid] if the bundle cannot be parsed or
+//     verified.
+func (s *Service) SetDesiredBundle(ctx context.Context, bundle string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if _, err := ParseBundle(bundle); err != nil {
+		return errors.Capture(err)
+	}
+
+	bundleUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Errorf("generating desired bundle uuid: %w", err)
+	}
+	if err := s.st.SetDesiredBundle(ctx, bundleUUID.String(), bundle, s.clock.Now()); err != nil {
+		return errors.Errorf("setting desired bundle: %w", err)
+	}
+	return nil
+}
+
+// GetDesiredBundle returns the desired bundle of the model, along with the
+// result of its last comparison with the model.
+// The following errors may be returned:
+//   - [desiredbundleerrors.NotFound] if the model does not have a desired
+//     bundle.
+func (s *Service) GetDesiredBundle(ctx context.Context) (desiredbundle.DesiredBundle, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	bundle, err := s.st.GetDesiredBundle(ctx)
+	return bundle, errors.Capture(err)
+}
+
+// RemoveDesiredBundle removes the desired bundle of the model, so that the
+// model is no longer compared with it.
+// The following errors may be returned:
+//   - [desiredbundleerrors.NotFound] if the model does not have a desired
+//     bundle.
+func (s *Service) RemoveDesiredBundle(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return errors.Capture(s.st.RemoveDesiredBundle(ctx))
+}
+
+// SetBundleDrift records the result of comparing the model with the desired
+// bundle identified by bundleUUID. An empty drift records that the model
+// matches the bundle.
+// The following errors may be returned:
+//   - [desiredbundleerrors.BundleChanged] if the desired bundle has been
+//     replaced or removed since it was compared with the model.
+func (s *Service) SetBundleDrift(ctx context.Context, bundleUUID, drift string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if bundleUUID == "" {
+		return errors.Errorf("empty desired bundle uuid").Add(desiredbundleerrors.BundleChanged)
+	}
+	return errors.Capture(s.st.SetBundleDrift(ctx, bundleUUID, drift, s.clock.Now()))
+}
+
+// ParseBundle parses and verifies the YAML encoded bundle. Bundles with
+// several documents, such as those with overlays, are merged.
+// The following errors may be returned:
+//   - [desiredbundleerrors.BundleNotValid] if the bundle cannot be parsed or
+//     verified.
+func ParseBundle(bundle string) (*charm.BundleData, error) {
+	dataSource, err := charm.StreamBundleDataSource(strings.NewReader(bundle), "")
+	if err != nil {
+		return nil, errors.Errorf("reading bundle: %w", err).Add(desiredbundleerrors.BundleNotValid)
+	}
+	data, err := charm.ReadAndMergeBundleData(dataSource)
+	if err != nil {
+		return nil, errors.Errorf("reading bundle: %w", err).Add(desiredbundleerrors.BundleNotValid)
+	}
+	if err := data.Verify(nil, nil, nil); err != nil {
+		return nil, errors.Errorf("verifying bundle: %w", err).Add(desiredbundleerrors.BundleNotValid)
+	}
+	return data, nil
+}
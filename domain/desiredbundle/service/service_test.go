@@ -0,0 +1,102 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	desiredbundleerrors "github.com/juju/juju/domain/desiredbundle/errors"
+)
+
+type serviceSuite struct {
+	state *MockState
+	clock *testclock.Clock
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+	return ctrl
+}
+
+func (s *serviceSuite) service() *Service {
+	return NewService(s.state, s.clock)
+}
+
+const validBundle = `
+applications:
+  mysql:
+    charm: mysql
+    num_units: 1
+  wordpress:
+    charm: wordpress
+    num_units: 2
+relations:
+- [wordpress:db, mysql:db]
+`
+
+func (s *serviceSuite) TestSetDesiredBundle(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().SetDesiredBundle(gomock.Any(), gomock.Any(), validBundle, s.clock.Now()).Return(nil)
+
+	err := s.service().SetDesiredBundle(c.Context(), validBundle)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetDesiredBundleNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service().SetDesiredBundle(c.Context(), "applications: [")
+	c.Check(err, tc.ErrorIs, desiredbundleerrors.BundleNotValid)
+
+	// The relation refers to an application that is not in the bundle.
+	err = s.service().SetDesiredBundle(c.Context(), `
+applications:
+  mysql:
+    charm: mysql
+relations:
+- [wordpress:db, mysql:db]
+`)
+	c.Check(err, tc.ErrorIs, desiredbundleerrors.BundleNotValid)
+}
+
+func (s *serviceSuite) TestSetBundleDrift(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().SetBundleDrift(gomock.Any(), "bundle-1", "drift", s.clock.Now()).Return(nil)
+
+	err := s.service().SetBundleDrift(c.Context(), "bundle-1", "drift")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetBundleDriftEmptyUUID(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service().SetBundleDrift(c.Context(), "", "drift")
+	c.Assert(err, tc.ErrorIs, desiredbundleerrors.BundleChanged)
+}
+
+func (s *serviceSuite) TestParseBundleMergesOverlays(c *tc.C) {
+	data, err := ParseBundle(validBundle + `
+---
+applications:
+  mysql:
+    options:
+      max-connections: 500
+`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data.Applications["mysql"].Options, tc.DeepEquals, map[string]any{"max-connections": 500})
+	c.Check(data.Applications["wordpress"].NumUnits, tc.Equals, 2)
+}
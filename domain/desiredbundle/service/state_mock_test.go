@@ -0,0 +1,195 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/desiredbundle/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/desiredbundle/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	desiredbundle "github.com/juju/juju/domain/desiredbundle"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// GetDesiredBundle mocks base method.
+func (m *MockState) GetDesiredBundle(arg0 context.Context) (desiredbundle.DesiredBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDesiredBundle", arg0)
+	ret0, _ := ret[0].(desiredbundle.DesiredBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDesiredBundle indicates an expected call of GetDesiredBundle.
+func (mr *MockStateMockRecorder) GetDesiredBundle(arg0 any) *MockStateGetDesiredBundleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDesiredBundle", reflect.TypeOf((*MockState)(nil).GetDesiredBundle), arg0)
+	return &MockStateGetDesiredBundleCall{Call: call}
+}
+
+// MockStateGetDesiredBundleCall wrap *gomock.Call
+type MockStateGetDesiredBundleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetDesiredBundleCall) Return(arg0 desiredbundle.DesiredBundle, arg1 error) *MockStateGetDesiredBundleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetDesiredBundleCall) Do(f func(context.Context) (desiredbundle.DesiredBundle, error)) *MockStateGetDesiredBundleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetDesiredBundleCall) DoAndReturn(f func(context.Context) (desiredbundle.DesiredBundle, error)) *MockStateGetDesiredBundleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveDesiredBundle mocks base method.
+func (m *MockState) RemoveDesiredBundle(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDesiredBundle", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDesiredBundle indicates an expected call of RemoveDesiredBundle.
+func (mr *MockStateMockRecorder) RemoveDesiredBundle(arg0 any) *MockStateRemoveDesiredBundleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDesiredBundle", reflect.TypeOf((*MockState)(nil).RemoveDesiredBundle), arg0)
+	return &MockStateRemoveDesiredBundleCall{Call: call}
+}
+
+// MockStateRemoveDesiredBundleCall wrap *gomock.Call
+type MockStateRemoveDesiredBundleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRemoveDesiredBundleCall) Return(arg0 error) *MockStateRemoveDesiredBundleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRemoveDesiredBundleCall) Do(f func(context.Context) error) *MockStateRemoveDesiredBundleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRemoveDesiredBundleCall) DoAndReturn(f func(context.Context) error) *MockStateRemoveDesiredBundleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetBundleDrift mocks base method.
+func (m *MockState) SetBundleDrift(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBundleDrift", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBundleDrift indicates an expected call of SetBundleDrift.
+func (mr *MockStateMockRecorder) SetBundleDrift(arg0, arg1, arg2, arg3 any) *MockStateSetBundleDriftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBundleDrift", reflect.TypeOf((*MockState)(nil).SetBundleDrift), arg0, arg1, arg2, arg3)
+	return &MockStateSetBundleDriftCall{Call: call}
+}
+
+// MockStateSetBundleDriftCall wrap *gomock.Call
+type MockStateSetBundleDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetBundleDriftCall) Return(arg0 error) *MockStateSetBundleDriftCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetBundleDriftCall) Do(f func(context.Context, string, string, time.Time) error) *MockStateSetBundleDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetBundleDriftCall) DoAndReturn(f func(context.Context, string, string, time.Time) error) *MockStateSetBundleDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetDesiredBundle mocks base method.
+func (m *MockState) SetDesiredBundle(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDesiredBundle", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDesiredBundle indicates an expected call of SetDesiredBundle.
+func (mr *MockStateMockRecorder) SetDesiredBundle(arg0, arg1, arg2, arg3 any) *MockStateSetDesiredBundleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDesiredBundle", reflect.TypeOf((*MockState)(nil).SetDesiredBundle), arg0, arg1, arg2, arg3)
+	return &MockStateSetDesiredBundleCall{Call: call}
+}
+
+// MockStateSetDesiredBundleCall wrap *gomock.Call
+type MockStateSetDesiredBundleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetDesiredBundleCall) Return(arg0 error) *MockStateSetDesiredBundleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetDesiredBundleCall) Do(f func(context.Context, string, string, time.Time) error) *MockStateSetDesiredBundleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetDesiredBundleCall) DoAndReturn(f func(context.Context, string, string, time.Time) error) *MockStateSetDesiredBundleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/desiredbundle"
+	desiredbundleerrors "github.com/juju/juju/domain/desiredbundle/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State represents a type for interacting with the underlying state.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new State for interacting with the underlying state.
+func NewState(factory database.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// SetDesiredBundle sets the desired bundle of the model, replacing any
+// existing desired bundle along with the result of its last comparison with
+// the model.
+func (st *State) SetDesiredBundle(ctx context.Context, uuid, bundle string, setAt time.Time) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	modelStmt, err := st.Prepare(`SELECT &modelUUID.uuid FROM model`, modelUUID{})
+	if err != nil {
+		return errors.Errorf("preparing select model uuid statement: %w", err)
+	}
+
+	upsertStmt, err := st.Prepare(`
+INSERT INTO desired_bundle (*) VALUES ($desiredBundle.*)
+ON CONFLICT (model_uuid) DO UPDATE SET
+    uuid = excluded.uuid,
+    bundle = excluded.bundle,
+    set_at = excluded.set_at,
+    drift = NULL,
+    checked_at = NULL
+`, desiredBundle{})
+	if err != nil {
+		return errors.Errorf("preparing upsert desired bundle statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var model modelUUID
+		if err := tx.Query(ctx, modelStmt).Get(&model); err != nil {
+			return errors.Errorf("getting model uuid: %w", err)
+		}
+
+		row := desiredBundle{
+			ModelUUID: model.UUID,
+			UUID:      uuid,
+			Bundle:    bundle,
+			SetAt:     setAt.UTC(),
+		}
+		if err := tx.Query(ctx, upsertStmt, row).Run(); err != nil {
+			return errors.Errorf("setting desired bundle: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetDesiredBundle returns the desired bundle of the model.
+// The following errors may be returned:
+//   - [desiredbundleerrors.NotFound] if the model does not have a desired
+//     bundle.
+func (st *State) GetDesiredBundle(ctx context.Context) (desiredbundle.DesiredBundle, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return desiredbundle.DesiredBundle{}, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`SELECT &desiredBundle.* FROM desired_bundle`, desiredBundle{})
+	if err != nil {
+		return desiredbundle.DesiredBundle{}, errors.Errorf("preparing select desired bundle statement: %w", err)
+	}
+
+	var row desiredBundle
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).Get(&row)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return desiredbundleerrors.NotFound
+		}
+		return err
+	})
+	if err != nil {
+		return desiredbundle.DesiredBundle{}, errors.Capture(err)
+	}
+
+	result := desiredbundle.DesiredBundle{
+		UUID:   row.UUID,
+		Bundle: row.Bundle,
+		SetAt:  row.SetAt,
+		Drift:  row.Drift.String,
+	}
+	if row.CheckedAt.Valid {
+		result.CheckedAt = &row.CheckedAt.Time
+	}
+	return result, nil
+}
+
+// RemoveDesiredBundle removes the desired bundle of the model.
+// The following errors may be returned:
+//   - [desiredbundleerrors.NotFound] if the model does not have a desired
+//     bundle.
+func (st *State) RemoveDesiredBundle(ctx context.Context) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`DELETE FROM desired_bundle`)
+	if err != nil {
+		return errors.Errorf("preparing delete desired bundle statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt).Get(&outcome); err != nil {
+			return errors.Errorf("removing desired bundle: %w", err)
+		}
+		if n, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Capture(err)
+		} else if n == 0 {
+			return desiredbundleerrors.NotFound
+		}
+		return nil
+	})
+}
+
+// SetBundleDrift records the result of comparing the model with the desired
+// bundle identified by uuid. An empty drift records that the model matches
+// the bundle.
+// The following errors may be returned:
+//   - [desiredbundleerrors.BundleChanged] if the desired bundle has been
+//     replaced or removed since it was read.
+func (st *State) SetBundleDrift(ctx context.Context, uuid, drift string, checkedAt time.Time) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+UPDATE desired_bundle
+SET    drift = $bundleDrift.drift,
+       checked_at = $bundleDrift.checked_at
+WHERE  uuid = $bundleDrift.uuid
+`, bundleDrift{})
+	if err != nil {
+		return errors.Errorf("preparing update bundle drift statement: %w", err)
+	}
+
+	arg := bundleDrift{
+		UUID:      uuid,
+		Drift:     drift,
+		CheckedAt: checkedAt.UTC(),
+	}
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, arg).Get(&outcome); err != nil {
+			return errors.Errorf("setting bundle drift: %w", err)
+		}
+		if n, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Capture(err)
+		} else if n == 0 {
+			return desiredbundleerrors.BundleChanged
+		}
+		return nil
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	desiredbundleerrors "github.com/juju/juju/domain/desiredbundle/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type stateSuite struct {
+	schematesting.ModelSuite
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) SetUpTest(c *tc.C) {
+	s.ModelSuite.SetUpTest(c)
+
+	_, err := s.DB().ExecContext(c.Context(), `
+INSERT INTO model (uuid, controller_uuid, name, qualifier, type, cloud, cloud_type)
+VALUES (?, ?, "test-model", "test-qualifier", "iaas", "test-cloud", "test-cloud-type")
+`, s.ModelUUID(), "controller-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestGetDesiredBundleNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.GetDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIs, desiredbundleerrors.NotFound)
+}
+
+func (s *stateSuite) TestSetDesiredBundle(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	setAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	err := st.SetDesiredBundle(c.Context(), "bundle-1", "applications: {}", setAt)
+	c.Assert(err, tc.ErrorIsNil)
+
+	bundle, err := st.GetDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(bundle.UUID, tc.Equals, "bundle-1")
+	c.Check(bundle.Bundle, tc.Equals, "applications: {}")
+	c.Check(bundle.SetAt.Equal(setAt), tc.IsTrue)
+	c.Check(bundle.Drift, tc.Equals, "")
+	c.Check(bundle.CheckedAt, tc.IsNil)
+}
+
+func (s *stateSuite) TestSetDesiredBundleReplacesDrift(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	now := time.Now()
+
+	err := st.SetDesiredBundle(c.Context(), "bundle-1", "applications: {}", now)
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.SetBundleDrift(c.Context(), "bundle-1", "applications: {}", now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.SetDesiredBundle(c.Context(), "bundle-2", "applications: {mysql: {}}", now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	bundle, err := st.GetDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(bundle.UUID, tc.Equals, "bundle-2")
+	c.Check(bundle.Bundle, tc.Equals, "applications: {mysql: {}}")
+	c.Check(bundle.Drifted(), tc.IsFalse)
+	c.Check(bundle.CheckedAt, tc.IsNil)
+}
+
+func (s *stateSuite) TestSetBundleDrift(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	checkedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	err := st.SetDesiredBundle(c.Context(), "bundle-1", "applications: {}", checkedAt)
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.SetBundleDrift(c.Context(), "bundle-1", "applications:\n  mysql:\n    missing: bundle\n", checkedAt)
+	c.Assert(err, tc.ErrorIsNil)
+
+	bundle, err := st.GetDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(bundle.Drifted(), tc.IsTrue)
+	c.Check(bundle.Drift, tc.Equals, "applications:\n  mysql:\n    missing: bundle\n")
+	c.Assert(bundle.CheckedAt, tc.NotNil)
+	c.Check(bundle.CheckedAt.Equal(checkedAt), tc.IsTrue)
+
+	// An empty drift records that the model matches the bundle.
+	err = st.SetBundleDrift(c.Context(), "bundle-1", "", checkedAt)
+	c.Assert(err, tc.ErrorIsNil)
+	bundle, err = st.GetDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(bundle.Drifted(), tc.IsFalse)
+	c.Check(bundle.CheckedAt, tc.NotNil)
+}
+
+func (s *stateSuite) TestSetBundleDriftBundleChanged(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.SetBundleDrift(c.Context(), "bundle-1", "", time.Now())
+	c.Assert(err, tc.ErrorIs, desiredbundleerrors.BundleChanged)
+
+	err = st.SetDesiredBundle(c.Context(), "bundle-2", "applications: {}", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.SetBundleDrift(c.Context(), "bundle-1", "", time.Now())
+	c.Assert(err, tc.ErrorIs, desiredbundleerrors.BundleChanged)
+}
+
+func (s *stateSuite) TestRemoveDesiredBundle(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.RemoveDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIs, desiredbundleerrors.NotFound)
+
+	err = st.SetDesiredBundle(c.Context(), "bundle-1", "applications: {}", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.RemoveDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = st.GetDesiredBundle(c.Context())
+	c.Assert(err, tc.ErrorIs, desiredbundleerrors.NotFound)
+}
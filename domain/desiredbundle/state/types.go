@@ -0,0 +1,32 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+)
+
+// modelUUID represents the uuid of the model.
+type modelUUID struct {
+	UUID string `db:"uuid"`
+}
+
+// desiredBundle represents a row of the desired_bundle table.
+type desiredBundle struct {
+	ModelUUID string         `db:"model_uuid"`
+	UUID      string         `db:"uuid"`
+	Bundle    string         `db:"bundle"`
+	SetAt     time.Time      `db:"set_at"`
+	Drift     sql.NullString `db:"drift"`
+	CheckedAt sql.NullTime   `db:"checked_at"`
+}
+
+// bundleDrift represents the result of comparing a model with its desired
+// bundle.
+type bundleDrift struct {
+	UUID      string    `db:"uuid"`
+	Drift     string    `db:"drift"`
+	CheckedAt time.Time `db:"checked_at"`
+}
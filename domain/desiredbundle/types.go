@@ -0,0 +1,35 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package desiredbundle
+
+import "time"
+
+// DesiredBundle is the bundle that a model is expected to match, along with
+// the result of the last comparison of the model with the bundle.
+type DesiredBundle struct {
+	// UUID identifies this version of the desired bundle. It changes every
+	// time the bundle is set.
+	UUID string
+
+	// Bundle is the YAML encoded bundle.
+	Bundle string
+
+	// SetAt is when the bundle was set.
+	SetAt time.Time
+
+	// Drift holds the differences between the bundle and the model, in the
+	// YAML format used by diff-bundle. It is empty if the model matches the
+	// bundle.
+	Drift string
+
+	// CheckedAt is when the model was last compared with the bundle. It is
+	// nil if the model has not been compared with the bundle yet.
+	CheckedAt *time.Time
+}
+
+// Drifted returns true if the model was found to differ from the bundle
+// when they were last compared.
+func (b DesiredBundle) Drifted() bool {
+	return b.Drift != ""
+}
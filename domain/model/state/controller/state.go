@@ -868,6 +868,7 @@ WHERE model_type.type = $dbInitialModel.model_type
 // - Authorized keys for a model.
 // - Secret backends
 // - Secret backend ref counting
+// - Secret data keys
 // - Model agent information
 // - Model permissions
 // - Model login information
@@ -884,6 +885,7 @@ func (s *State) Delete(
 
 	queries := []string{
 		`DELETE FROM model_secret_backend WHERE model_uuid = $dbUUID.uuid`,
+		`DELETE FROM model_secret_data_key WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM secret_backend_reference WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM model_authorized_keys WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM permission WHERE grant_on = $dbUUID.uuid`,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/loggo/v3"
 
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/errors"
 )
@@ -157,6 +158,50 @@ func SpaceChecker(provider SpaceProvider) config.ValidatorFunc {
 	}
 }
 
+// EgressRulesProvider is responsible for reporting whether the model's
+// provider can restrict the outbound traffic of its machines.
+type EgressRulesProvider interface {
+	// SupportsEgressRules returns true if the provider can apply egress
+	// rules to the machines of the model.
+	SupportsEgressRules(context.Context) (bool, error)
+}
+
+// EgressDefaultDenySupport returns a config validator that refuses to enable
+// egress-default-deny when the model's provider cannot enforce it. A getter
+// returning an error satisfying [coreerrors.NotSupported] means that the
+// provider cannot restrict outbound traffic at all.
+//
+// Only changes to an existing model config are checked, since the provider
+// is not available while a model is being created.
+func EgressDefaultDenySupport(
+	getter func(context.Context) (EgressRulesProvider, error),
+) config.ValidatorFunc {
+	return func(ctx context.Context, cfg, old *config.Config) (*config.Config, error) {
+		if old == nil || !cfg.EgressDefaultDeny() || old.EgressDefaultDeny() {
+			return cfg, nil
+		}
+
+		notSupported := &config.ValidationError{
+			InvalidAttrs: []string{config.EgressDefaultDenyKey},
+			Reason:       "the model's provider cannot restrict outbound traffic",
+		}
+		provider, err := getter(ctx)
+		if errors.Is(err, coreerrors.NotSupported) {
+			return cfg, notSupported
+		} else if err != nil {
+			return cfg, errors.Errorf("getting provider to validate %s: %w", config.EgressDefaultDenyKey, err)
+		}
+
+		supported, err := provider.SupportsEgressRules(ctx)
+		if err != nil {
+			return cfg, errors.Errorf("checking provider support for egress rules: %w", err)
+		} else if !supported {
+			return cfg, notSupported
+		}
+		return cfg, nil
+	}
+}
+
 const (
 	// ErrorLogTracingPermission is a specific error to indicate that trace
 	// level logging cannot be enabled within model config because the user
@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/tc"
 
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/testing"
@@ -416,3 +417,61 @@ func (*validatorsSuite) TestContainerNetworkingMethodNoChange(c *tc.C) {
 	_, err = ContainerNetworkingMethodChange()(c.Context(), newCfg, oldCfg)
 	c.Check(err, tc.ErrorIsNil)
 }
+
+type egressRulesProvider bool
+
+func (p egressRulesProvider) SupportsEgressRules(context.Context) (bool, error) {
+	return bool(p), nil
+}
+
+func egressConfig(c *tc.C, deny bool) *config.Config {
+	cfg, err := config.New(config.NoDefaults, map[string]any{
+		"name":                "wallyworld",
+		"uuid":                testing.ModelTag.Id(),
+		"type":                "sometype",
+		"egress-default-deny": deny,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return cfg
+}
+
+func (*validatorsSuite) TestEgressDefaultDenySupported(c *tc.C) {
+	validator := EgressDefaultDenySupport(func(context.Context) (EgressRulesProvider, error) {
+		return egressRulesProvider(true), nil
+	})
+
+	_, err := validator(c.Context(), egressConfig(c, true), egressConfig(c, false))
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (*validatorsSuite) TestEgressDefaultDenyNotSupported(c *tc.C) {
+	for _, getter := range []func(context.Context) (EgressRulesProvider, error){
+		func(context.Context) (EgressRulesProvider, error) {
+			return egressRulesProvider(false), nil
+		},
+		func(context.Context) (EgressRulesProvider, error) {
+			return nil, coreerrors.NotSupported
+		},
+	} {
+		var validationError *config.ValidationError
+		_, err := EgressDefaultDenySupport(getter)(c.Context(), egressConfig(c, true), egressConfig(c, false))
+		c.Assert(errors.As(err, &validationError), tc.IsTrue)
+		c.Check(validationError.InvalidAttrs, tc.DeepEquals, []string{"egress-default-deny"})
+	}
+}
+
+func (*validatorsSuite) TestEgressDefaultDenyUnchanged(c *tc.C) {
+	validator := EgressDefaultDenySupport(func(context.Context) (EgressRulesProvider, error) {
+		c.Fatal("provider should not be queried")
+		return nil, nil
+	})
+
+	// Disabling, keeping and initially setting the value don't need the
+	// provider.
+	_, err := validator(c.Context(), egressConfig(c, false), egressConfig(c, true))
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = validator(c.Context(), egressConfig(c, true), egressConfig(c, true))
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = validator(c.Context(), egressConfig(c, true), nil)
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/trace"
+	domainnetwork "github.com/juju/juju/domain/network"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetApplicationAddresses returns, for each application in the model, the
+// addresses of its units in the space that the application's default
+// endpoint binding is bound to. These are the addresses that names published
+// for applications and units resolve to. Machine-local and link-local
+// addresses are not included.
+func (s *Service) GetApplicationAddresses(ctx context.Context) ([]domainnetwork.ApplicationAddresses, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	addresses, err := s.st.GetApplicationAddresses(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting application addresses: %w", err)
+	}
+	return addresses, nil
+}
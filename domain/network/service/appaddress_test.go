@@ -0,0 +1,64 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/unit"
+	domainnetwork "github.com/juju/juju/domain/network"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+func TestAppAddressSuite(t *testing.T) {
+	tc.Run(t, &appAddressSuite{})
+}
+
+type appAddressSuite struct {
+	testhelpers.IsolationSuite
+
+	st *MockState
+}
+
+func (s *appAddressSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.st = NewMockState(ctrl)
+	c.Cleanup(func() { s.st = nil })
+	return ctrl
+}
+
+func (s *appAddressSuite) service(c *tc.C) *Service {
+	return NewService(s.st, loggertesting.WrapCheckLog(c))
+}
+
+func (s *appAddressSuite) TestGetApplicationAddresses(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expected := []domainnetwork.ApplicationAddresses{{
+		ApplicationName: "mysql",
+		Units: []domainnetwork.UnitAddresses{{
+			UnitName:  unit.Name("mysql/0"),
+			Addresses: []string{"10.0.0.10", "2001:db8::10"},
+		}},
+	}}
+	s.st.EXPECT().GetApplicationAddresses(gomock.Any()).Return(expected, nil)
+
+	addresses, err := s.service(c).GetApplicationAddresses(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(addresses, tc.DeepEquals, expected)
+}
+
+func (s *appAddressSuite) TestGetApplicationAddressesError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetApplicationAddresses(gomock.Any()).Return(nil, errors.New("boom"))
+
+	_, err := s.service(c).GetApplicationAddresses(c.Context())
+	c.Assert(err, tc.ErrorMatches, "getting application addresses: boom")
+}
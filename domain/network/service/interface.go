@@ -61,6 +61,16 @@ type SpaceState interface {
 	// space is not found, an error is returned matching
 	// [github.com/juju/juju/domain/network/errors.SpaceNotFound].
 	UpdateSpace(ctx context.Context, uuid network.SpaceUUID, name network.SpaceName) error
+	// SetSpacePreferredAddressType sets the type of IP address preferred
+	// when selecting among the addresses of dual-stack machines in the space
+	// identified by the passed uuid. If the space is not found, an error is
+	// returned matching
+	// [github.com/juju/juju/domain/network/errors.SpaceNotFound].
+	SetSpacePreferredAddressType(ctx context.Context, uuid network.SpaceUUID, addrType network.AddressType) error
+	// NamespaceForWatchSpaceAddressPreference returns the namespace
+	// identifier used for observing changes to the address preferences of
+	// spaces.
+	NamespaceForWatchSpaceAddressPreference() string
 	// RemoveSpace removes a space from the system, optionally forcing removal,
 	// or simulating it via dry run.
 	RemoveSpace(
@@ -207,4 +217,9 @@ type NetworkInfoState interface {
 	GetUnitNetworkInfo(
 		ctx context.Context, unitUUID string,
 	) (networkinternal.UnitNetworkInfo, error)
+
+	// GetApplicationAddresses returns, for each application in the model,
+	// the addresses of its units in the space that the application's default
+	// endpoint binding is bound to.
+	GetApplicationAddresses(ctx context.Context) ([]domainnetwork.ApplicationAddresses, error)
 }
@@ -393,6 +393,45 @@ func (c *MockStateGetAllSubnetsCall) DoAndReturn(f func(context.Context) (networ
 	return c
 }
 
+// GetApplicationAddresses mocks base method.
+func (m *MockState) GetApplicationAddresses(arg0 context.Context) ([]network0.ApplicationAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAddresses", arg0)
+	ret0, _ := ret[0].([]network0.ApplicationAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAddresses indicates an expected call of GetApplicationAddresses.
+func (mr *MockStateMockRecorder) GetApplicationAddresses(arg0 any) *MockStateGetApplicationAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAddresses", reflect.TypeOf((*MockState)(nil).GetApplicationAddresses), arg0)
+	return &MockStateGetApplicationAddressesCall{Call: call}
+}
+
+// MockStateGetApplicationAddressesCall wrap *gomock.Call
+type MockStateGetApplicationAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationAddressesCall) Return(arg0 []network0.ApplicationAddresses, arg1 error) *MockStateGetApplicationAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationAddressesCall) Do(f func(context.Context) ([]network0.ApplicationAddresses, error)) *MockStateGetApplicationAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationAddressesCall) DoAndReturn(f func(context.Context) ([]network0.ApplicationAddresses, error)) *MockStateGetApplicationAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetContainerNetworkingMethod mocks base method.
 func (m *MockState) GetContainerNetworkingMethod(arg0 context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -1489,6 +1528,44 @@ func (c *MockStateNICsInSpacesCall) DoAndReturn(f func(context.Context, string)
 	return c
 }
 
+// NamespaceForWatchSpaceAddressPreference mocks base method.
+func (m *MockState) NamespaceForWatchSpaceAddressPreference() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceForWatchSpaceAddressPreference")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NamespaceForWatchSpaceAddressPreference indicates an expected call of NamespaceForWatchSpaceAddressPreference.
+func (mr *MockStateMockRecorder) NamespaceForWatchSpaceAddressPreference() *MockStateNamespaceForWatchSpaceAddressPreferenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceForWatchSpaceAddressPreference", reflect.TypeOf((*MockState)(nil).NamespaceForWatchSpaceAddressPreference))
+	return &MockStateNamespaceForWatchSpaceAddressPreferenceCall{Call: call}
+}
+
+// MockStateNamespaceForWatchSpaceAddressPreferenceCall wrap *gomock.Call
+type MockStateNamespaceForWatchSpaceAddressPreferenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateNamespaceForWatchSpaceAddressPreferenceCall) Return(arg0 string) *MockStateNamespaceForWatchSpaceAddressPreferenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateNamespaceForWatchSpaceAddressPreferenceCall) Do(f func() string) *MockStateNamespaceForWatchSpaceAddressPreferenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateNamespaceForWatchSpaceAddressPreferenceCall) DoAndReturn(f func() string) *MockStateNamespaceForWatchSpaceAddressPreferenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NamespaceForWatchSubnet mocks base method.
 func (m *MockState) NamespaceForWatchSubnet() string {
 	m.ctrl.T.Helper()
@@ -1604,6 +1681,44 @@ func (c *MockStateSetMachineNetConfigCall) DoAndReturn(f func(context.Context, s
 	return c
 }
 
+// SetSpacePreferredAddressType mocks base method.
+func (m *MockState) SetSpacePreferredAddressType(arg0 context.Context, arg1 network.SpaceUUID, arg2 network.AddressType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSpacePreferredAddressType", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSpacePreferredAddressType indicates an expected call of SetSpacePreferredAddressType.
+func (mr *MockStateMockRecorder) SetSpacePreferredAddressType(arg0, arg1, arg2 any) *MockStateSetSpacePreferredAddressTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSpacePreferredAddressType", reflect.TypeOf((*MockState)(nil).SetSpacePreferredAddressType), arg0, arg1, arg2)
+	return &MockStateSetSpacePreferredAddressTypeCall{Call: call}
+}
+
+// MockStateSetSpacePreferredAddressTypeCall wrap *gomock.Call
+type MockStateSetSpacePreferredAddressTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetSpacePreferredAddressTypeCall) Return(arg0 error) *MockStateSetSpacePreferredAddressTypeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetSpacePreferredAddressTypeCall) Do(f func(context.Context, network.SpaceUUID, network.AddressType) error) *MockStateSetSpacePreferredAddressTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetSpacePreferredAddressTypeCall) DoAndReturn(f func(context.Context, network.SpaceUUID, network.AddressType) error) *MockStateSetSpacePreferredAddressTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateSpace mocks base method.
 func (m *MockState) UpdateSpace(arg0 context.Context, arg1 network.SpaceUUID, arg2 network.SpaceName) error {
 	m.ctrl.T.Helper()
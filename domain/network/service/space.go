@@ -58,6 +58,33 @@ func (s *Service) UpdateSpace(ctx context.Context, uuid network.SpaceUUID, name
 	return errors.Capture(s.st.UpdateSpace(ctx, uuid, name))
 }
 
+// SetSpacePreferredAddressType sets the type of IP address, IPv4 or IPv6,
+// preferred when selecting among the addresses of dual-stack machines in the
+// space with the input name. This governs the ingress addresses reported to
+// units bound to the space. The following errors may be returned:
+//   - [coreerrors.NotValid] if the address type is neither IPv4 nor IPv6.
+//   - [github.com/juju/juju/domain/network/errors.SpaceNotFound] if the
+//     space does not exist.
+func (s *Service) SetSpacePreferredAddressType(
+	ctx context.Context, name network.SpaceName, addrType network.AddressType,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if addrType != network.IPv4Address && addrType != network.IPv6Address {
+		return errors.Errorf(
+			"preferred address type %q must be %q or %q",
+			addrType, network.IPv4Address, network.IPv6Address,
+		).Add(coreerrors.NotValid)
+	}
+
+	sp, err := s.st.GetSpaceByName(ctx, name)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(s.st.SetSpacePreferredAddressType(ctx, sp.ID, addrType))
+}
+
 // Space returns a space from state that matches the input ID. If the space is
 // not found, an error is returned matching
 // [github.com/juju/juju/domain/network/errors.SpaceNotFound].
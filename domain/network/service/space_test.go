@@ -159,6 +159,41 @@ func (s *spaceSuite) TestUpdateSpaceNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, networkerrors.SpaceNotFound)
 }
 
+func (s *spaceSuite) TestSetSpacePreferredAddressType(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	spaceID := networktesting.GenSpaceUUID(c)
+	s.st.EXPECT().GetSpaceByName(gomock.Any(), network.SpaceName("space0")).
+		Return(&network.SpaceInfo{ID: spaceID, Name: "space0"}, nil)
+	s.st.EXPECT().SetSpacePreferredAddressType(gomock.Any(), spaceID, network.IPv6Address).Return(nil)
+
+	svc := NewService(s.st, loggertesting.WrapCheckLog(c))
+	err := svc.SetSpacePreferredAddressType(c.Context(), "space0", network.IPv6Address)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *spaceSuite) TestSetSpacePreferredAddressTypeNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := NewService(s.st, loggertesting.WrapCheckLog(c))
+	err := svc.SetSpacePreferredAddressType(c.Context(), "space0", network.HostName)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+// TestSetSpacePreferredAddressTypeNotFound checks that if we try to call
+// Service.SetSpacePreferredAddressType on a space that doesn't exist, an error
+// is returned matching networkerrors.SpaceNotFound.
+func (s *spaceSuite) TestSetSpacePreferredAddressTypeNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetSpaceByName(gomock.Any(), network.SpaceName("space0")).
+		Return(nil, errors.Errorf("space %q: %w", "space0", networkerrors.SpaceNotFound))
+
+	svc := NewService(s.st, loggertesting.WrapCheckLog(c))
+	err := svc.SetSpacePreferredAddressType(c.Context(), "space0", network.IPv6Address)
+	c.Assert(err, tc.ErrorIs, networkerrors.SpaceNotFound)
+}
+
 func (s *spaceSuite) TestRetrieveSpaceByID(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
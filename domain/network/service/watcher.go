@@ -33,6 +33,16 @@ type WatcherFactory interface {
 		mapper eventsource.Mapper,
 		filterOption eventsource.FilterOption, filterOptions ...eventsource.FilterOption,
 	) (watcher.StringsWatcher, error)
+
+	// NewNotifyWatcher returns a new watcher that filters changes from the
+	// input base watcher's db/queue. A single filter option is required,
+	// though additional filter options can be provided.
+	NewNotifyWatcher(
+		ctx context.Context,
+		summary string,
+		filterOption eventsource.FilterOption,
+		filterOptions ...eventsource.FilterOption,
+	) (watcher.NotifyWatcher, error)
 }
 
 // WatchableService provides the API for working with external controllers
@@ -79,6 +89,19 @@ func (s *WatchableService) WatchSubnets(ctx context.Context, subnetUUIDsToWatch
 	)
 }
 
+// WatchSpaceAddressPreferences returns a watcher that notifies when the
+// address preference of any space changes.
+func (s *WatchableService) WatchSpaceAddressPreferences(ctx context.Context) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"space address preference watcher",
+		eventsource.NamespaceFilter(s.st.NamespaceForWatchSpaceAddressPreference(), changestream.All),
+	)
+}
+
 // subnetUUIDsFilter filters the returned subnet UUIDs from the changelog
 // according to the user-provided list of subnet UUIDs.
 // To keep the compatibility with legacy watchers, if the input set of subnets
@@ -0,0 +1,94 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"net"
+
+	"github.com/canonical/sqlair"
+
+	coreunit "github.com/juju/juju/core/unit"
+	domainnetwork "github.com/juju/juju/domain/network"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetApplicationAddresses returns, for each application in the model, the
+// addresses of its units in the space that the application's default
+// endpoint binding is bound to. Machine-local and link-local addresses, and
+// the addresses of dead units, are not included. Addresses of each unit are
+// ordered in the same way as the ingress addresses reported for it.
+func (st *State) GetApplicationAddresses(ctx context.Context) ([]domainnetwork.ApplicationAddresses, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT a.name AS &applicationUnitAddress.application_name,
+       u.name AS &applicationUnitAddress.unit_name,
+       urn.address_value AS &applicationUnitAddress.address_value
+FROM   unit AS u
+JOIN   application AS a ON u.application_uuid = a.uuid
+JOIN   v_unit_relation_network AS urn ON u.uuid = urn.unit_uuid
+WHERE  u.life_id != 2 /* dead */
+AND    urn.scope_rank IS NOT NULL
+AND    urn.space_uuid = a.space_uuid
+ORDER BY a.name, u.name, urn.scope_rank, urn.is_secondary, urn.address_value
+`, applicationUnitAddress{})
+	if err != nil {
+		return nil, errors.Errorf("preparing application addresses query: %w", err)
+	}
+
+	var rows []applicationUnitAddress
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying application addresses: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return groupApplicationAddresses(rows), nil
+}
+
+// groupApplicationAddresses groups the input rows, which must be ordered by
+// application and unit name, by application and then by unit. Subnet prefixes
+// are removed from the address values and duplicate addresses dropped.
+func groupApplicationAddresses(rows []applicationUnitAddress) []domainnetwork.ApplicationAddresses {
+	var (
+		result []domainnetwork.ApplicationAddresses
+		seen   map[string]bool
+	)
+	for _, row := range rows {
+		if len(result) == 0 || result[len(result)-1].ApplicationName != row.ApplicationName {
+			result = append(result, domainnetwork.ApplicationAddresses{
+				ApplicationName: row.ApplicationName,
+			})
+		}
+		app := &result[len(result)-1]
+		if len(app.Units) == 0 || app.Units[len(app.Units)-1].UnitName.String() != row.UnitName {
+			app.Units = append(app.Units, domainnetwork.UnitAddresses{
+				UnitName: coreunit.Name(row.UnitName),
+			})
+			seen = make(map[string]bool)
+		}
+		unit := &app.Units[len(app.Units)-1]
+
+		// The saved address value is in the form 192.0.2.1/24, but
+		// addresses from Kubernetes do not have the subnet mask suffix.
+		ip, _, err := net.ParseCIDR(row.AddressValue)
+		if err != nil {
+			ip = net.ParseIP(row.AddressValue)
+		}
+		if ip == nil || seen[ip.String()] {
+			continue
+		}
+		seen[ip.String()] = true
+		unit.Addresses = append(unit.Addresses, ip.String())
+	}
+	return result
+}
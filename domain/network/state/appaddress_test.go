@@ -0,0 +1,87 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	corenetwork "github.com/juju/juju/core/network"
+	coreunit "github.com/juju/juju/core/unit"
+	domainnetwork "github.com/juju/juju/domain/network"
+)
+
+type appAddressSuite struct {
+	linkLayerBaseSuite
+}
+
+func TestAppAddressSuite(t *testing.T) {
+	tc.Run(t, &appAddressSuite{})
+}
+
+func (s *appAddressSuite) TestGetApplicationAddresses(c *tc.C) {
+	boundSpaceUUID := s.addSpaceWithName(c, "bound")
+	otherSpaceUUID := s.addSpaceWithName(c, "other")
+	boundSubnetUUID := s.addSubnet(c, "10.0.0.0/24", boundSpaceUUID)
+	boundV6SubnetUUID := s.addSubnet(c, "2001:db8::/64", boundSpaceUUID)
+	otherSubnetUUID := s.addSubnet(c, "192.168.0.0/24", otherSpaceUUID)
+
+	charmUUID := s.addCharm(c)
+	appUUID := s.addApplicationWithName(c, charmUUID, boundSpaceUUID, "mysql")
+
+	node0 := s.addNetNode(c)
+	device0 := s.addLinkLayerDevice(c, node0, "eth0", "00:11:22:33:44:55", corenetwork.EthernetDevice)
+	s.addIPAddressWithSubnetAndScope(c, device0, node0, boundSubnetUUID, "10.0.0.10/24", corenetwork.ScopeCloudLocal)
+	v6UUID := s.addIPAddressWithSubnetAndScope(c, device0, node0, boundV6SubnetUUID, "2001:db8::10/64", corenetwork.ScopePublic)
+	s.query(c, `UPDATE ip_address SET type_id = 1 WHERE uuid = ?`, v6UUID)
+	s.addIPAddressWithSubnetAndScope(c, device0, node0, otherSubnetUUID, "192.168.0.10/24", corenetwork.ScopeCloudLocal)
+	unit0UUID := s.addUnit(c, appUUID, charmUUID, node0)
+	s.query(c, `UPDATE unit SET name = 'mysql/0' WHERE uuid = ?`, unit0UUID)
+
+	node1 := s.addNetNode(c)
+	device1 := s.addLinkLayerDevice(c, node1, "eth1", "00:11:22:33:44:66", corenetwork.EthernetDevice)
+	s.addIPAddressWithSubnetAndScope(c, device1, node1, boundSubnetUUID, "10.0.0.11/24", corenetwork.ScopeCloudLocal)
+	s.addIPAddressWithSubnetAndScope(c, device1, node1, boundSubnetUUID, "127.0.0.1/8", corenetwork.ScopeMachineLocal)
+	unit1UUID := s.addUnit(c, appUUID, charmUUID, node1)
+	s.query(c, `UPDATE unit SET name = 'mysql/1' WHERE uuid = ?`, unit1UUID)
+
+	// A unit with addresses in other spaces only is not reported.
+	node2 := s.addNetNode(c)
+	device2 := s.addLinkLayerDevice(c, node2, "eth2", "00:11:22:33:44:77", corenetwork.EthernetDevice)
+	s.addIPAddressWithSubnetAndScope(c, device2, node2, otherSubnetUUID, "192.168.0.12/24", corenetwork.ScopeCloudLocal)
+	unit2UUID := s.addUnit(c, appUUID, charmUUID, node2)
+	s.query(c, `UPDATE unit SET name = 'mysql/2' WHERE uuid = ?`, unit2UUID)
+
+	addresses, err := s.state.GetApplicationAddresses(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(addresses, tc.DeepEquals, []domainnetwork.ApplicationAddresses{{
+		ApplicationName: "mysql",
+		Units: []domainnetwork.UnitAddresses{{
+			UnitName:  coreunit.Name("mysql/0"),
+			Addresses: []string{"10.0.0.10", "2001:db8::10"},
+		}, {
+			UnitName:  coreunit.Name("mysql/1"),
+			Addresses: []string{"10.0.0.11"},
+		}},
+	}})
+}
+
+func (s *appAddressSuite) TestGetApplicationAddressesDeadUnit(c *tc.C) {
+	spaceUUID := corenetwork.AlphaSpaceId.String()
+	subnetUUID := s.addSubnet(c, "10.0.0.0/24", spaceUUID)
+
+	charmUUID := s.addCharm(c)
+	appUUID := s.addApplicationWithName(c, charmUUID, spaceUUID, "mysql")
+
+	node := s.addNetNode(c)
+	device := s.addLinkLayerDevice(c, node, "eth0", "00:11:22:33:44:55", corenetwork.EthernetDevice)
+	s.addIPAddressWithSubnetAndScope(c, device, node, subnetUUID, "10.0.0.10/24", corenetwork.ScopeCloudLocal)
+	unitUUID := s.addUnit(c, appUUID, charmUUID, node)
+	s.query(c, `UPDATE unit SET life_id = 2 WHERE uuid = ?`, unitUUID)
+
+	addresses, err := s.state.GetApplicationAddresses(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(addresses, tc.HasLen, 0)
+}
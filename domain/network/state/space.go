@@ -12,6 +12,7 @@ import (
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/ipaddress"
 	networkerrors "github.com/juju/juju/domain/network/errors"
 	"github.com/juju/juju/internal/database"
 	"github.com/juju/juju/internal/errors"
@@ -243,3 +244,59 @@ WHERE  uuid = $space.uuid;`, sp)
 	})
 	return err
 }
+
+// SetSpacePreferredAddressType sets the type of IP address preferred when
+// selecting among the addresses of dual-stack machines in the space
+// identified by the passed uuid. If the space is not found, an error is
+// returned matching [networkerrors.SpaceNotFound].
+func (st *State) SetSpacePreferredAddressType(
+	ctx context.Context,
+	uuid network.SpaceUUID,
+	addrType network.AddressType,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	sp := space{UUID: uuid}
+	spaceStmt, err := st.Prepare(`
+SELECT &space.uuid
+FROM   space
+WHERE  uuid = $space.uuid;`, sp)
+	if err != nil {
+		return errors.Errorf("preparing select space statement: %w", err)
+	}
+
+	pref := spaceAddressPreference{
+		SpaceUUID:     uuid,
+		AddressTypeID: int(ipaddress.MarshallAddressType(addrType)),
+	}
+	upsertStmt, err := st.Prepare(`
+INSERT INTO space_address_preference (*) VALUES ($spaceAddressPreference.*)
+ON CONFLICT (space_uuid) DO UPDATE SET
+    address_type_id = excluded.address_type_id;`, pref)
+	if err != nil {
+		return errors.Errorf("preparing upsert space address preference statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, spaceStmt, sp).Get(&sp)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("space not found with %s: %w", uuid, networkerrors.SpaceNotFound)
+		} else if err != nil {
+			return errors.Errorf("retrieving space %q: %w", uuid, err)
+		}
+
+		if err := tx.Query(ctx, upsertStmt, pref).Run(); err != nil {
+			return errors.Errorf("setting space %q address preference: %w", uuid, err)
+		}
+		return nil
+	})
+}
+
+// NamespaceForWatchSpaceAddressPreference returns the namespace identifier
+// used for observing changes to the address preferences of spaces.
+func (*State) NamespaceForWatchSpaceAddressPreference() string {
+	return "space_address_preference"
+}
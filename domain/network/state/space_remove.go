@@ -126,6 +126,11 @@ func (st *State) deleteSpace(ctx context.Context,
 		return errors.Errorf("deleting provider space: %w", err)
 	}
 
+	// Remove the address preference of the space
+	if err := st.removeSpaceAddressPreference(ctx, tx, toDelete); err != nil {
+		return errors.Errorf("deleting space address preference: %w", err)
+	}
+
 	// Remove the space itself
 	if err := st.removeSpaceRecord(ctx, tx, toDelete); err != nil {
 		return errors.Errorf("deleting space: %w", err)
@@ -225,6 +230,23 @@ WHERE space_uuid = $space.uuid;`, spaceToDelete)
 	return nil
 }
 
+// removeSpaceAddressPreference removes the address preference of the given
+// space.
+func (st *State) removeSpaceAddressPreference(ctx context.Context, tx *sqlair.TX, spaceToDelete space) error {
+	stmt, err := st.Prepare(`
+DELETE FROM space_address_preference
+WHERE space_uuid = $space.uuid;`, spaceToDelete)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := tx.Query(ctx, stmt, spaceToDelete).Run(); err != nil {
+		return errors.Capture(err)
+	}
+
+	return nil
+}
+
 // removeSpaceRecord removes the space record itself.
 func (st *State) removeSpaceRecord(ctx context.Context, tx *sqlair.TX, spaceToDelete space) error {
 	stmt, err := st.Prepare(`
@@ -231,6 +231,26 @@ func (s *spaceDeleteSuite) TestDeleteSpace(c *tc.C) {
 	c.Assert(spaces, tc.SameContents, []string{"other", network.AlphaSpaceName.String()})
 }
 
+// TestDeleteSpaceRemoveAddressPreference verifies that the address preference
+// of a deleted space is removed.
+func (s *spaceDeleteSuite) TestDeleteSpaceRemoveAddressPreference(c *tc.C) {
+	// Arrange
+	toDeleteUUID := s.addSpaceWithName(c, "toDelete")
+	otherUUID := s.addSpaceWithName(c, "other")
+	s.query(c, `INSERT INTO space_address_preference (space_uuid, address_type_id) VALUES (?, 1)`, toDeleteUUID)
+	s.query(c, `INSERT INTO space_address_preference (space_uuid, address_type_id) VALUES (?, 1)`, otherUUID)
+
+	// Act
+	err := s.txn(c, func(ctx context.Context, tx *sqlair.TX) error {
+		return s.state.deleteSpace(ctx, tx, "toDelete")
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	obtained := s.selectDistinctValues(c, "space_uuid", "space_address_preference")
+	c.Assert(obtained, tc.SameContents, []string{otherUUID})
+}
+
 // TestHasModelSpaceConstraintsTrue verifies that the hasModelSpaceConstraint
 // method correctly identifies existing constraints.
 func (s *spaceDeleteSuite) TestHasModelSpaceConstraintsTrue(c *tc.C) {
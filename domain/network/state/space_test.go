@@ -382,3 +382,39 @@ func (s *stateSuite) TestUpdateSpaceFailNotFound(c *tc.C) {
 	err := st.UpdateSpace(c.Context(), "unknownSpace", "newSpaceName0")
 	c.Assert(err, tc.ErrorIs, networkerrors.SpaceNotFound)
 }
+
+func (s *stateSuite) TestSetSpacePreferredAddressType(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	uuid := networktesting.GenSpaceUUID(c)
+	err := st.AddSpace(c.Context(), uuid, "space0", "foo", []string{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	sp, err := st.GetSpace(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(sp.PreferredAddressType, tc.Equals, network.AddressType(""))
+
+	err = st.SetSpacePreferredAddressType(c.Context(), uuid, network.IPv6Address)
+	c.Assert(err, tc.ErrorIsNil)
+
+	sp, err = st.GetSpace(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(sp.PreferredAddressType, tc.Equals, network.IPv6Address)
+
+	err = st.SetSpacePreferredAddressType(c.Context(), uuid, network.IPv4Address)
+	c.Assert(err, tc.ErrorIsNil)
+
+	sp, err = st.GetSpace(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(sp.PreferredAddressType, tc.Equals, network.IPv4Address)
+}
+
+// TestSetSpacePreferredAddressTypeFailNotFound tests that if we try to call
+// State.SetSpacePreferredAddressType with a non-existent space, it will return
+// an error matching [networkerrors.SpaceNotFound].
+func (s *stateSuite) TestSetSpacePreferredAddressTypeFailNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.SetSpacePreferredAddressType(c.Context(), "unknownSpace", network.IPv6Address)
+	c.Assert(err, tc.ErrorIs, networkerrors.SpaceNotFound)
+}
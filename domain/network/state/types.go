@@ -102,6 +102,15 @@ type space struct {
 	UUID corenetwork.SpaceUUID `db:"uuid"`
 }
 
+// spaceAddressPreference represents a single row from the
+// space_address_preference table.
+type spaceAddressPreference struct {
+	// SpaceUUID is the UUID of the space.
+	SpaceUUID corenetwork.SpaceUUID `db:"space_uuid"`
+	// AddressTypeID is the ID of the preferred address type.
+	AddressTypeID int `db:"address_type_id"`
+}
+
 // providerSpace represents a single row from the provider_space table.
 type providerSpace struct {
 	// SpaceUUID is the unique ID of the space.
@@ -173,6 +182,9 @@ type spaceSubnetRow struct {
 
 	// ProviderID is the space provider id.
 	SpaceProviderID sql.NullString `db:"provider_id"`
+
+	// PreferredAddressType is the type of address preferred in the space.
+	PreferredAddressType sql.NullString `db:"preferred_address_type"`
 }
 
 // SpaceSubnetRows is a slice of SpaceSubnet rows.
@@ -202,6 +214,9 @@ func (sp SpaceSubnetRows) ToSpaceInfos() corenetwork.SpaceInfos {
 		if spaceSubnet.SpaceProviderID.Valid {
 			spInfo.ProviderId = corenetwork.Id(spaceSubnet.SpaceProviderID.String)
 		}
+		if spaceSubnet.PreferredAddressType.Valid {
+			spInfo.PreferredAddressType = corenetwork.AddressType(spaceSubnet.PreferredAddressType.String)
+		}
 		uniqueSpaces[spaceSubnet.SpaceUUID] = spInfo
 
 		snInfo := spaceSubnet.ToSubnetInfo()
@@ -682,3 +697,11 @@ func nilZeroPtr[T comparable](v T) *T {
 	}
 	return &v
 }
+
+// applicationUnitAddress represents an address of a unit in the space that
+// its application's default endpoint binding is bound to.
+type applicationUnitAddress struct {
+	ApplicationName string `db:"application_name"`
+	UnitName        string `db:"unit_name"`
+	AddressValue    string `db:"address_value"`
+}
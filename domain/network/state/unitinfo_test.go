@@ -113,6 +113,49 @@ WHERE uuid = ?
 	)
 }
 
+func (s *infoSuite) TestGetUnitEndpointNetworkInfoSpacePreferredAddressType(c *tc.C) {
+	nodeUUID := s.addNetNode(c)
+	deviceUUID := s.addLinkLayerDevice(
+		c, nodeUUID, "eth0", "00:11:22:33:44:55", corenetwork.EthernetDevice,
+	)
+	spaceUUID := corenetwork.AlphaSpaceId.String()
+	subnetV4UUID := s.addSubnet(c, "10.0.0.0/24", spaceUUID)
+	subnetV6UUID := s.addSubnet(c, "2001:db8::/64", spaceUUID)
+	s.addIPAddressWithSubnetAndScope(
+		c, deviceUUID, nodeUUID, subnetV4UUID, "10.0.0.10", corenetwork.ScopeCloudLocal,
+	)
+	v6UUID := s.addIPAddressWithSubnetAndScope(
+		c, deviceUUID, nodeUUID, subnetV6UUID, "2001:db8::10", corenetwork.ScopePublic,
+	)
+	s.query(c, `UPDATE ip_address SET type_id = 1 WHERE uuid = ?`, v6UUID)
+
+	charmUUID := s.addCharm(c)
+	appUUID := s.addApplication(c, charmUUID, spaceUUID)
+	unitUUID := s.addUnit(c, appUUID, charmUUID, nodeUUID)
+
+	endpointName := "endpoint1"
+	s.addApplicationEndpoint(c, appUUID, charmUUID, endpointName, "")
+
+	info, err := s.state.GetUnitEndpointNetworkInfo(
+		c.Context(), string(unitUUID), []string{endpointName},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(info, tc.HasLen, 1)
+	c.Check(info[0].IngressAddresses, tc.DeepEquals, []string{"10.0.0.10"})
+
+	err = s.state.SetSpacePreferredAddressType(
+		c.Context(), corenetwork.AlphaSpaceId, corenetwork.IPv6Address,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	info, err = s.state.GetUnitEndpointNetworkInfo(
+		c.Context(), string(unitUUID), []string{endpointName},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(info, tc.HasLen, 1)
+	c.Check(info[0].IngressAddresses, tc.DeepEquals, []string{"2001:db8::10"})
+}
+
 func (s *infoSuite) TestGetUnitEndpointNetworkInfoPrioritisesPrimaryIngress(c *tc.C) {
 	nodeUUID := s.addNetNode(c)
 	deviceUUID := s.addLinkLayerDevice(
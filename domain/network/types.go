@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/unit"
 )
 
 // NetAddr represents an IP address and its
@@ -151,6 +152,27 @@ type AddressInfo struct {
 	CIDR string
 }
 
+// ApplicationAddresses holds the addresses of the units of an application in
+// the space that the application's default endpoint binding is bound to.
+type ApplicationAddresses struct {
+	// ApplicationName is the name of the application.
+	ApplicationName string
+
+	// Units holds the addresses of each of the application's units. Units
+	// without an address in the bound space are omitted.
+	Units []UnitAddresses
+}
+
+// UnitAddresses holds the IP addresses of a unit in a single space.
+type UnitAddresses struct {
+	// UnitName is the name of the unit.
+	UnitName unit.Name
+
+	// Addresses are the IP addresses of the unit, without subnet prefix,
+	// ordered from most to least preferred.
+	Addresses []string
+}
+
 // MovedSubnets represents a subnet that has been moved from one network space
 // to another.
 type MovedSubnets struct {
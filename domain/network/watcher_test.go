@@ -93,3 +93,37 @@ func (s *watcherSuite) TestWatchWithDelete(c *tc.C) {
 	// Get the change.
 	watcherC.AssertChange(createdSubnetID.String())
 }
+
+func (s *watcherSuite) TestWatchSpaceAddressPreferences(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "space_address_preference")
+
+	svc := service.NewWatchableService(
+		state.NewState(func(ctx context.Context) (database.TxnRunner, error) { return factory(ctx) }, loggertesting.WrapCheckLog(c)),
+		nil, nil,
+		domain.NewWatcherFactory(factory,
+			loggertesting.WrapCheckLog(c),
+		),
+		loggertesting.WrapCheckLog(c),
+	)
+	watcher, err := svc.WatchSpaceAddressPreferences(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	watcherC := watchertest.NewNotifyWatcherC(c, watcher)
+	// Initial event.
+	watcherC.AssertOneChange()
+	s.AssertChangeStreamIdle(c)
+
+	// Prefer IPv6 addresses in the alpha space.
+	err = svc.SetSpacePreferredAddressType(c.Context(), network.AlphaSpaceName, network.IPv6Address)
+	c.Assert(err, tc.ErrorIsNil)
+	watcherC.AssertOneChange()
+
+	// Setting the same preference again does not notify.
+	err = svc.SetSpacePreferredAddressType(c.Context(), network.AlphaSpaceName, network.IPv6Address)
+	c.Assert(err, tc.ErrorIsNil)
+	watcherC.AssertNoChange()
+
+	// Prefer IPv4 addresses in the alpha space.
+	err = svc.SetSpacePreferredAddressType(c.Context(), network.AlphaSpaceName, network.IPv4Address)
+	c.Assert(err, tc.ErrorIsNil)
+	watcherC.AssertOneChange()
+}
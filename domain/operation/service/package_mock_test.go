@@ -15,8 +15,9 @@ import (
 	time "time"
 
 	machine "github.com/juju/juju/core/machine"
+	operation "github.com/juju/juju/core/operation"
 	unit "github.com/juju/juju/core/unit"
-	operation "github.com/juju/juju/domain/operation"
+	operation0 "github.com/juju/juju/domain/operation"
 	internal "github.com/juju/juju/domain/operation/internal"
 	uuid "github.com/juju/juju/internal/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -46,10 +47,10 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 }
 
 // AddActionOperation mocks base method.
-func (m *MockState) AddActionOperation(arg0 context.Context, arg1 uuid.UUID, arg2 []unit.Name, arg3 operation.TaskArgs) (operation.RunResult, error) {
+func (m *MockState) AddActionOperation(arg0 context.Context, arg1 uuid.UUID, arg2 []unit.Name, arg3 operation0.TaskArgs) (operation0.RunResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddActionOperation", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(operation.RunResult)
+	ret0, _ := ret[0].(operation0.RunResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -67,28 +68,28 @@ type MockStateAddActionOperationCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateAddActionOperationCall) Return(arg0 operation.RunResult, arg1 error) *MockStateAddActionOperationCall {
+func (c *MockStateAddActionOperationCall) Return(arg0 operation0.RunResult, arg1 error) *MockStateAddActionOperationCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateAddActionOperationCall) Do(f func(context.Context, uuid.UUID, []unit.Name, operation.TaskArgs) (operation.RunResult, error)) *MockStateAddActionOperationCall {
+func (c *MockStateAddActionOperationCall) Do(f func(context.Context, uuid.UUID, []unit.Name, operation0.TaskArgs) (operation0.RunResult, error)) *MockStateAddActionOperationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateAddActionOperationCall) DoAndReturn(f func(context.Context, uuid.UUID, []unit.Name, operation.TaskArgs) (operation.RunResult, error)) *MockStateAddActionOperationCall {
+func (c *MockStateAddActionOperationCall) DoAndReturn(f func(context.Context, uuid.UUID, []unit.Name, operation0.TaskArgs) (operation0.RunResult, error)) *MockStateAddActionOperationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // AddExecOperation mocks base method.
-func (m *MockState) AddExecOperation(arg0 context.Context, arg1 uuid.UUID, arg2 internal.ReceiversWithResolvedLeaders, arg3 operation.ExecArgs) (operation.RunResult, error) {
+func (m *MockState) AddExecOperation(arg0 context.Context, arg1 uuid.UUID, arg2 internal.ReceiversWithResolvedLeaders, arg3 operation0.ExecArgs) (operation0.RunResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddExecOperation", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(operation.RunResult)
+	ret0, _ := ret[0].(operation0.RunResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -106,28 +107,28 @@ type MockStateAddExecOperationCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateAddExecOperationCall) Return(arg0 operation.RunResult, arg1 error) *MockStateAddExecOperationCall {
+func (c *MockStateAddExecOperationCall) Return(arg0 operation0.RunResult, arg1 error) *MockStateAddExecOperationCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateAddExecOperationCall) Do(f func(context.Context, uuid.UUID, internal.ReceiversWithResolvedLeaders, operation.ExecArgs) (operation.RunResult, error)) *MockStateAddExecOperationCall {
+func (c *MockStateAddExecOperationCall) Do(f func(context.Context, uuid.UUID, internal.ReceiversWithResolvedLeaders, operation0.ExecArgs) (operation0.RunResult, error)) *MockStateAddExecOperationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateAddExecOperationCall) DoAndReturn(f func(context.Context, uuid.UUID, internal.ReceiversWithResolvedLeaders, operation.ExecArgs) (operation.RunResult, error)) *MockStateAddExecOperationCall {
+func (c *MockStateAddExecOperationCall) DoAndReturn(f func(context.Context, uuid.UUID, internal.ReceiversWithResolvedLeaders, operation0.ExecArgs) (operation0.RunResult, error)) *MockStateAddExecOperationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // AddExecOperationOnAllMachines mocks base method.
-func (m *MockState) AddExecOperationOnAllMachines(arg0 context.Context, arg1 uuid.UUID, arg2 operation.ExecArgs) (operation.RunResult, error) {
+func (m *MockState) AddExecOperationOnAllMachines(arg0 context.Context, arg1 uuid.UUID, arg2 operation0.ExecArgs) (operation0.RunResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddExecOperationOnAllMachines", arg0, arg1, arg2)
-	ret0, _ := ret[0].(operation.RunResult)
+	ret0, _ := ret[0].(operation0.RunResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -145,28 +146,28 @@ type MockStateAddExecOperationOnAllMachinesCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateAddExecOperationOnAllMachinesCall) Return(arg0 operation.RunResult, arg1 error) *MockStateAddExecOperationOnAllMachinesCall {
+func (c *MockStateAddExecOperationOnAllMachinesCall) Return(arg0 operation0.RunResult, arg1 error) *MockStateAddExecOperationOnAllMachinesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateAddExecOperationOnAllMachinesCall) Do(f func(context.Context, uuid.UUID, operation.ExecArgs) (operation.RunResult, error)) *MockStateAddExecOperationOnAllMachinesCall {
+func (c *MockStateAddExecOperationOnAllMachinesCall) Do(f func(context.Context, uuid.UUID, operation0.ExecArgs) (operation0.RunResult, error)) *MockStateAddExecOperationOnAllMachinesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateAddExecOperationOnAllMachinesCall) DoAndReturn(f func(context.Context, uuid.UUID, operation.ExecArgs) (operation.RunResult, error)) *MockStateAddExecOperationOnAllMachinesCall {
+func (c *MockStateAddExecOperationOnAllMachinesCall) DoAndReturn(f func(context.Context, uuid.UUID, operation0.ExecArgs) (operation0.RunResult, error)) *MockStateAddExecOperationOnAllMachinesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // CancelTask mocks base method.
-func (m *MockState) CancelTask(arg0 context.Context, arg1 string) (operation.Task, error) {
+func (m *MockState) CancelTask(arg0 context.Context, arg1 string) (operation0.Task, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CancelTask", arg0, arg1)
-	ret0, _ := ret[0].(operation.Task)
+	ret0, _ := ret[0].(operation0.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -184,19 +185,19 @@ type MockStateCancelTaskCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateCancelTaskCall) Return(arg0 operation.Task, arg1 error) *MockStateCancelTaskCall {
+func (c *MockStateCancelTaskCall) Return(arg0 operation0.Task, arg1 error) *MockStateCancelTaskCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateCancelTaskCall) Do(f func(context.Context, string) (operation.Task, error)) *MockStateCancelTaskCall {
+func (c *MockStateCancelTaskCall) Do(f func(context.Context, string) (operation0.Task, error)) *MockStateCancelTaskCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateCancelTaskCall) DoAndReturn(f func(context.Context, string) (operation.Task, error)) *MockStateCancelTaskCall {
+func (c *MockStateCancelTaskCall) DoAndReturn(f func(context.Context, string) (operation0.Task, error)) *MockStateCancelTaskCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -475,10 +476,10 @@ func (c *MockStateGetMachineUUIDByNameCall) DoAndReturn(f func(context.Context,
 }
 
 // GetOperationByID mocks base method.
-func (m *MockState) GetOperationByID(arg0 context.Context, arg1 string) (operation.OperationInfo, error) {
+func (m *MockState) GetOperationByID(arg0 context.Context, arg1 string) (operation0.OperationInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetOperationByID", arg0, arg1)
-	ret0, _ := ret[0].(operation.OperationInfo)
+	ret0, _ := ret[0].(operation0.OperationInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -496,28 +497,28 @@ type MockStateGetOperationByIDCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetOperationByIDCall) Return(arg0 operation.OperationInfo, arg1 error) *MockStateGetOperationByIDCall {
+func (c *MockStateGetOperationByIDCall) Return(arg0 operation0.OperationInfo, arg1 error) *MockStateGetOperationByIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetOperationByIDCall) Do(f func(context.Context, string) (operation.OperationInfo, error)) *MockStateGetOperationByIDCall {
+func (c *MockStateGetOperationByIDCall) Do(f func(context.Context, string) (operation0.OperationInfo, error)) *MockStateGetOperationByIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetOperationByIDCall) DoAndReturn(f func(context.Context, string) (operation.OperationInfo, error)) *MockStateGetOperationByIDCall {
+func (c *MockStateGetOperationByIDCall) DoAndReturn(f func(context.Context, string) (operation0.OperationInfo, error)) *MockStateGetOperationByIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetOperations mocks base method.
-func (m *MockState) GetOperations(arg0 context.Context, arg1 operation.QueryArgs) (operation.QueryResult, error) {
+func (m *MockState) GetOperations(arg0 context.Context, arg1 operation0.QueryArgs) (operation0.QueryResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetOperations", arg0, arg1)
-	ret0, _ := ret[0].(operation.QueryResult)
+	ret0, _ := ret[0].(operation0.QueryResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -535,19 +536,19 @@ type MockStateGetOperationsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetOperationsCall) Return(arg0 operation.QueryResult, arg1 error) *MockStateGetOperationsCall {
+func (c *MockStateGetOperationsCall) Return(arg0 operation0.QueryResult, arg1 error) *MockStateGetOperationsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetOperationsCall) Do(f func(context.Context, operation.QueryArgs) (operation.QueryResult, error)) *MockStateGetOperationsCall {
+func (c *MockStateGetOperationsCall) Do(f func(context.Context, operation0.QueryArgs) (operation0.QueryResult, error)) *MockStateGetOperationsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetOperationsCall) DoAndReturn(f func(context.Context, operation.QueryArgs) (operation.QueryResult, error)) *MockStateGetOperationsCall {
+func (c *MockStateGetOperationsCall) DoAndReturn(f func(context.Context, operation0.QueryArgs) (operation0.QueryResult, error)) *MockStateGetOperationsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -592,10 +593,10 @@ func (c *MockStateGetReceiverFromTaskIDCall) DoAndReturn(f func(context.Context,
 }
 
 // GetTask mocks base method.
-func (m *MockState) GetTask(arg0 context.Context, arg1 string) (operation.Task, *string, error) {
+func (m *MockState) GetTask(arg0 context.Context, arg1 string) (operation0.Task, *string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTask", arg0, arg1)
-	ret0, _ := ret[0].(operation.Task)
+	ret0, _ := ret[0].(operation0.Task)
 	ret1, _ := ret[1].(*string)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -614,19 +615,19 @@ type MockStateGetTaskCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetTaskCall) Return(arg0 operation.Task, arg1 *string, arg2 error) *MockStateGetTaskCall {
+func (c *MockStateGetTaskCall) Return(arg0 operation0.Task, arg1 *string, arg2 error) *MockStateGetTaskCall {
 	c.Call = c.Call.Return(arg0, arg1, arg2)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetTaskCall) Do(f func(context.Context, string) (operation.Task, *string, error)) *MockStateGetTaskCall {
+func (c *MockStateGetTaskCall) Do(f func(context.Context, string) (operation0.Task, *string, error)) *MockStateGetTaskCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetTaskCall) DoAndReturn(f func(context.Context, string) (operation.Task, *string, error)) *MockStateGetTaskCall {
+func (c *MockStateGetTaskCall) DoAndReturn(f func(context.Context, string) (operation0.Task, *string, error)) *MockStateGetTaskCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1018,18 +1019,18 @@ func (c *MockStateNamespaceForTaskLogWatcherCall) DoAndReturn(f func() string) *
 }
 
 // PruneOperations mocks base method.
-func (m *MockState) PruneOperations(arg0 context.Context, arg1 time.Duration, arg2 int) ([]string, error) {
+func (m *MockState) PruneOperations(arg0 context.Context, arg1 time.Duration, arg2 int, arg3 operation.RetentionPolicy) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PruneOperations", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "PruneOperations", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PruneOperations indicates an expected call of PruneOperations.
-func (mr *MockStateMockRecorder) PruneOperations(arg0, arg1, arg2 any) *MockStatePruneOperationsCall {
+func (mr *MockStateMockRecorder) PruneOperations(arg0, arg1, arg2, arg3 any) *MockStatePruneOperationsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneOperations", reflect.TypeOf((*MockState)(nil).PruneOperations), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneOperations", reflect.TypeOf((*MockState)(nil).PruneOperations), arg0, arg1, arg2, arg3)
 	return &MockStatePruneOperationsCall{Call: call}
 }
 
@@ -1045,13 +1046,13 @@ func (c *MockStatePruneOperationsCall) Return(arg0 []string, arg1 error) *MockSt
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStatePruneOperationsCall) Do(f func(context.Context, time.Duration, int) ([]string, error)) *MockStatePruneOperationsCall {
+func (c *MockStatePruneOperationsCall) Do(f func(context.Context, time.Duration, int, operation.RetentionPolicy) ([]string, error)) *MockStatePruneOperationsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStatePruneOperationsCall) DoAndReturn(f func(context.Context, time.Duration, int) ([]string, error)) *MockStatePruneOperationsCall {
+func (c *MockStatePruneOperationsCall) DoAndReturn(f func(context.Context, time.Duration, int, operation.RetentionPolicy) ([]string, error)) *MockStatePruneOperationsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
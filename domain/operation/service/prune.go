@@ -8,18 +8,22 @@ import (
 	"time"
 
 	coreerrors "github.com/juju/juju/core/errors"
+	coreoperation "github.com/juju/juju/core/operation"
 	"github.com/juju/juju/internal/errors"
 )
 
 // PruneOperations removes operations older than maxAge or larger than maxSizeMB
-// (in megabytes).
-func (s *Service) PruneOperations(ctx context.Context, maxAge time.Duration, maxSizeMB int) error {
+// (in megabytes). The retention policy overrides maxAge for the operations it
+// matches, and operations it marks for audit are never removed.
+func (s *Service) PruneOperations(
+	ctx context.Context, maxAge time.Duration, maxSizeMB int, policy coreoperation.RetentionPolicy,
+) error {
 	if maxAge < 0 || maxSizeMB < 0 {
 		return errors.Errorf("max age and size should be positive (maxAge=%s maxSizeMB=%d)", maxAge,
 			maxSizeMB).Add(coreerrors.NotValid)
 	}
 
-	storePaths, err := s.st.PruneOperations(ctx, maxAge, maxSizeMB)
+	storePaths, err := s.st.PruneOperations(ctx, maxAge, maxSizeMB, policy)
 	if err != nil {
 		return errors.Capture(err)
 	}
@@ -50,10 +50,10 @@ func (s *pruneSuite) TestPruneOperationsSuccess(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	age := time.Hour
 	sizeMB := 10
-	s.state.EXPECT().PruneOperations(gomock.Any(), age, sizeMB).Return(nil, nil)
+	s.state.EXPECT().PruneOperations(gomock.Any(), age, sizeMB, nil).Return(nil, nil)
 
 	// Act
-	err := s.service(c).PruneOperations(c.Context(), age, sizeMB)
+	err := s.service(c).PruneOperations(c.Context(), age, sizeMB, nil)
 
 	// Assert
 	c.Assert(err, tc.ErrorIsNil)
@@ -64,13 +64,13 @@ func (s *pruneSuite) TestPruneOperationsSuccess(c *tc.C) {
 func (s *pruneSuite) TestPruneOperationsSuccessWithPathToRemove(c *tc.C) {
 	// Arrange
 	defer s.setupMocks(c).Finish()
-	s.state.EXPECT().PruneOperations(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"/path1", "/path2"}, nil)
+	s.state.EXPECT().PruneOperations(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"/path1", "/path2"}, nil)
 	s.mockObjectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.mockObjectStore, nil)
 	s.mockObjectStore.EXPECT().Remove(gomock.Any(), "/path1").Return(nil)
 	s.mockObjectStore.EXPECT().Remove(gomock.Any(), "/path2").Return(nil)
 
 	// Act
-	err := s.service(c).PruneOperations(c.Context(), 1, 1)
+	err := s.service(c).PruneOperations(c.Context(), 1, 1, nil)
 
 	// Assert
 	c.Assert(err, tc.ErrorIsNil)
@@ -83,11 +83,11 @@ func (s *pruneSuite) TestPruneOperationsGetObjectStoreFailure(c *tc.C) {
 	// Arrange
 	defer s.setupMocks(c).Finish()
 	expectedErr := errors.New("boom")
-	s.state.EXPECT().PruneOperations(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"anything"}, nil)
+	s.state.EXPECT().PruneOperations(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"anything"}, nil)
 	s.mockObjectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(nil, expectedErr)
 
 	// Act
-	err := s.service(c).PruneOperations(c.Context(), 1, 1)
+	err := s.service(c).PruneOperations(c.Context(), 1, 1, nil)
 
 	// Assert
 	c.Assert(err, tc.ErrorIs, expectedErr)
@@ -101,13 +101,13 @@ func (s *pruneSuite) TestPruneOperationsGetObjectStoreRemovePathFailure(c *tc.C)
 	defer s.setupMocks(c).Finish()
 	expectedErr1 := errors.New("boom1")
 	expectedErr2 := errors.New("boom2")
-	s.state.EXPECT().PruneOperations(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"1", "2"}, nil)
+	s.state.EXPECT().PruneOperations(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"1", "2"}, nil)
 	s.mockObjectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.mockObjectStore, nil)
 	s.mockObjectStore.EXPECT().Remove(gomock.Any(), "1").Return(expectedErr1)
 	s.mockObjectStore.EXPECT().Remove(gomock.Any(), "2").Return(expectedErr2)
 
 	// Act
-	err := s.service(c).PruneOperations(c.Context(), 1, 1)
+	err := s.service(c).PruneOperations(c.Context(), 1, 1, nil)
 
 	// Assert: errors are joined
 	c.Check(err, tc.ErrorIs, expectedErr1)
@@ -119,10 +119,10 @@ func (s *pruneSuite) TestPruneOperationsSuccessZeroMaxAge(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	age := 0 * time.Hour
 	sizeMB := 10
-	s.state.EXPECT().PruneOperations(gomock.Any(), age, sizeMB).Return(nil, nil)
+	s.state.EXPECT().PruneOperations(gomock.Any(), age, sizeMB, nil).Return(nil, nil)
 
 	// Act
-	err := s.service(c).PruneOperations(c.Context(), age, sizeMB)
+	err := s.service(c).PruneOperations(c.Context(), age, sizeMB, nil)
 
 	// Assert
 	c.Assert(err, tc.ErrorIsNil)
@@ -133,10 +133,10 @@ func (s *pruneSuite) TestPruneOperationsSuccessZeroMaxSize(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	age := time.Hour
 	sizeMB := 0
-	s.state.EXPECT().PruneOperations(gomock.Any(), age, sizeMB).Return(nil, nil)
+	s.state.EXPECT().PruneOperations(gomock.Any(), age, sizeMB, nil).Return(nil, nil)
 
 	// Act
-	err := s.service(c).PruneOperations(c.Context(), age, sizeMB)
+	err := s.service(c).PruneOperations(c.Context(), age, sizeMB, nil)
 
 	// Assert
 	c.Assert(err, tc.ErrorIsNil)
@@ -152,7 +152,7 @@ func (s *pruneSuite) TestPruneOperationsValidationErrorNegativeMaxAge(c *tc.C) {
 	// No state expectation as validation should fail before any call.
 
 	// Act
-	err := s.service(c).PruneOperations(c.Context(), age, sizeMB)
+	err := s.service(c).PruneOperations(c.Context(), age, sizeMB, nil)
 
 	// Assert
 	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
@@ -169,7 +169,7 @@ func (s *pruneSuite) TestPruneOperationsValidationErrorNegativeMaxSizeDB(c *tc.C
 	// No state expectation as validation should fail before any call.
 
 	// Act
-	err := s.service(c).PruneOperations(c.Context(), age, sizeMB)
+	err := s.service(c).PruneOperations(c.Context(), age, sizeMB, nil)
 
 	// Assert
 	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
@@ -184,10 +184,10 @@ func (s *pruneSuite) TestPruneOperationsStateError(c *tc.C) {
 	age := 2 * time.Hour
 	sizeMB := 20
 	expectedErr := errors.New("boom")
-	s.state.EXPECT().PruneOperations(gomock.Any(), age, sizeMB).Return(nil, expectedErr)
+	s.state.EXPECT().PruneOperations(gomock.Any(), age, sizeMB, nil).Return(nil, expectedErr)
 
 	// Act
-	err := s.service(c).PruneOperations(c.Context(), age, sizeMB)
+	err := s.service(c).PruneOperations(c.Context(), age, sizeMB, nil)
 
 	// Assert
 	c.Assert(err, tc.ErrorIs, expectedErr)
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/juju/core/logger"
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/objectstore"
+	coreoperation "github.com/juju/juju/core/operation"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/eventsource"
@@ -127,8 +128,10 @@ type State interface {
 	NamespaceForTaskLogWatcher() string
 
 	// PruneOperations deletes operations that are older than maxAge and larger than maxSizeMB (in megabytes).
+	// The retention policy overrides maxAge for the operations it matches,
+	// and operations it marks for audit are never deleted.
 	// It returns the paths from objectStore that should be freed
-	PruneOperations(ctx context.Context, maxAge time.Duration, maxSizeMB int) ([]string, error)
+	PruneOperations(ctx context.Context, maxAge time.Duration, maxSizeMB int, policy coreoperation.RetentionPolicy) ([]string, error)
 
 	// AddExecOperation creates an exec operation with tasks for various machines
 	// and units, using the provided parameters.
@@ -14,6 +14,7 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/juju/collections/transform"
 
+	coreoperation "github.com/juju/juju/core/operation"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -23,17 +24,29 @@ const (
 )
 
 // PruneOperations deletes operations older than maxAge and larger than maxSizeMB.
+// The retention policy overrides maxAge for the operations it matches, and
+// operations it marks for audit are never deleted.
 // It returns the paths from objectStore that should be freed
-func (st *State) PruneOperations(ctx context.Context, maxAge time.Duration, maxSizeMB int) ([]string, error) {
+func (st *State) PruneOperations(
+	ctx context.Context, maxAge time.Duration, maxSizeMB int, policy coreoperation.RetentionPolicy,
+) ([]string, error) {
 
 	// Prune by age, completed only
-	ageStorePath, err := st.pruneCompletedOperationsOlderThan(ctx, maxAge)
+	var (
+		ageStorePath []string
+		err          error
+	)
+	if len(policy) == 0 {
+		ageStorePath, err = st.pruneCompletedOperationsOlderThan(ctx, maxAge)
+	} else {
+		ageStorePath, err = st.pruneCompletedOperationsByPolicy(ctx, maxAge, policy)
+	}
 	if err != nil {
 		return nil, errors.Errorf("pruning completed operation by age: %w", err)
 	}
 
 	// Prune by size
-	sizeStorePath, err := st.pruneOperationsToKeepUnderSizeMiB(ctx, maxSizeMB)
+	sizeStorePath, err := st.pruneOperationsToKeepUnderSizeMiB(ctx, maxSizeMB, policy)
 	if err != nil {
 		return nil, errors.Errorf("pruning operation to keep size under the Limit: %w", err)
 	}
@@ -111,8 +124,11 @@ func (st *State) getCompletedOperationUUIDsOlderThan(ctx context.Context, tx *sq
 // It retrieves the database and calculates the total size and average size of
 // operations. If pruning is required, it deletes a calculated number of
 // operations to meet the size constraint.
+// Operations marked for audit by the retention policy are never deleted.
 // Returns the list of storeUUID to delete or an error if any issues occur during pruning.
-func (st *State) pruneOperationsToKeepUnderSizeMiB(ctx context.Context, maxSizeMiB int) ([]string, error) {
+func (st *State) pruneOperationsToKeepUnderSizeMiB(
+	ctx context.Context, maxSizeMiB int, policy coreoperation.RetentionPolicy,
+) ([]string, error) {
 	if maxSizeMiB <= 0 {
 		// size shouldn't be negative, but zero size is valid. In any case, we ignore
 		// the prune by size as done in 3.6
@@ -145,6 +161,11 @@ func (st *State) pruneOperationsToKeepUnderSizeMiB(ctx context.Context, maxSizeM
 
 		opsToDeleteCount := (totalSizeKiB - maxSizeKiB) / averageOperationSizeKiB
 
+		if policy.HasAudit() {
+			toDeleteStorePaths, err = st.pruneOldestUnauditedOperations(ctx, tx, opsToDeleteCount, policy)
+			return errors.Capture(err)
+		}
+
 		toDeleteUUIDs, err := st.getOperationToPruneUpTo(ctx, tx, opsToDeleteCount)
 		if err != nil {
 			return errors.Errorf("getting operation UUIDs to delete: %w", err)
@@ -369,9 +369,9 @@ func (s *pruneBySizeSuite) TestPruneOperationsToKeepUnderSizeMiBIgnoresNonPositi
 	op2 := s.addOperation(c)
 
 	// Act: call with zero and negative; both should be no-ops and return nil.
-	_, err := s.state.pruneOperationsToKeepUnderSizeMiB(c.Context(), 0)
+	_, err := s.state.pruneOperationsToKeepUnderSizeMiB(c.Context(), 0, nil)
 	c.Assert(err, tc.ErrorIsNil)
-	_, err = s.state.pruneOperationsToKeepUnderSizeMiB(c.Context(), -5)
+	_, err = s.state.pruneOperationsToKeepUnderSizeMiB(c.Context(), -5, nil)
 	c.Assert(err, tc.ErrorIsNil)
 
 	// Assert: operations unchanged
@@ -385,7 +385,7 @@ func (s *pruneBySizeSuite) TestPruneOperationsToKeepUnderSizeMiBNoPruneWhenUnder
 	op2 := s.addOperation(c)
 
 	// Act
-	_, err := s.state.pruneOperationsToKeepUnderSizeMiB(c.Context(), 1)
+	_, err := s.state.pruneOperationsToKeepUnderSizeMiB(c.Context(), 1, nil)
 	c.Assert(err, tc.ErrorIsNil)
 
 	// Assert: nothing deleted
@@ -408,7 +408,7 @@ func (s *pruneBySizeSuite) TestPruneOperationsToKeepUnderSizeMiBPrunesExpected(c
 	// For max=1 MiB (1024 KiB), deletion count should be 1 based on average size.
 
 	// Act
-	storeUUIDs, err := s.state.pruneOperationsToKeepUnderSizeMiB(c.Context(), 1)
+	storeUUIDs, err := s.state.pruneOperationsToKeepUnderSizeMiB(c.Context(), 1, nil)
 	c.Assert(err, tc.ErrorIsNil)
 
 	// Assert: exactly one operation should remain: the newer one (opNew). The older completed opOld is deleted first.
@@ -0,0 +1,307 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coreoperation "github.com/juju/juju/core/operation"
+	"github.com/juju/juju/internal/errors"
+)
+
+// retentionInfo holds the details of an operation that a retention policy
+// is matched against.
+type retentionInfo struct {
+	retentionCandidate
+	Applications []string
+	Failed       bool
+}
+
+// retentionPageSize is the number of operations read at a time when a
+// retention policy is applied, so that neither the memory used nor the
+// number of parameters bound to each query grows with the number of
+// operations in the model.
+var retentionPageSize = 500
+
+// retentionPage selects a page of operations.
+type retentionPage struct {
+	Offset int `db:"offset"`
+	Limit  int `db:"limit"`
+}
+
+// pruneCompletedOperationsByPolicy deletes the completed operations which
+// the retention policy no longer keeps. Operations matched by no rule of the
+// policy are kept for maxAge. The operations are read and deleted a page at
+// a time, from the most recently completed.
+func (st *State) pruneCompletedOperationsByPolicy(
+	ctx context.Context, maxAge time.Duration, policy coreoperation.RetentionPolicy,
+) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	now := st.clock.Now().UTC()
+	var toDeleteStorePaths []string
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		toDeleteStorePaths = nil
+		selector := newRetentionSelector(policy, maxAge, now)
+		for offset := 0; ; {
+			infos, err := st.getOperationRetentionInfo(ctx, tx, false, offset)
+			if err != nil {
+				return errors.Errorf("getting operation retention details: %w", err)
+			}
+
+			toDelete := selector.selectExpired(infos)
+			storePaths, err := st.deleteOperationByUUIDs(ctx, tx, toDelete)
+			if err != nil {
+				return errors.Errorf("deleting operations with UUIDs %v: %w", toDelete, err)
+			}
+			toDeleteStorePaths = append(toDeleteStorePaths, storePaths...)
+
+			if len(infos) < retentionPageSize {
+				return nil
+			}
+			// The deleted operations no longer precede the next page.
+			offset += len(infos) - len(toDelete)
+		}
+	})
+	return toDeleteStorePaths, errors.Capture(err)
+}
+
+// pruneOldestUnauditedOperations deletes up to count operations, oldest
+// first, leaving out those the retention policy marks for audit. The
+// operations are read and deleted a page at a time.
+func (st *State) pruneOldestUnauditedOperations(
+	ctx context.Context, tx *sqlair.TX, count int, policy coreoperation.RetentionPolicy,
+) ([]string, error) {
+	var toDeleteStorePaths []string
+	for offset := 0; count > 0; {
+		infos, err := st.getOperationRetentionInfo(ctx, tx, true, offset)
+		if err != nil {
+			return nil, errors.Errorf("getting operation retention details: %w", err)
+		}
+
+		var toDelete []string
+		for _, info := range infos {
+			if len(toDelete) == count {
+				break
+			}
+			if rule, ok := policy.Match(info.Action, info.Applications); ok && rule.Audit {
+				continue
+			}
+			toDelete = append(toDelete, info.UUID)
+		}
+		storePaths, err := st.deleteOperationByUUIDs(ctx, tx, toDelete)
+		if err != nil {
+			return nil, errors.Errorf("deleting operations with UUIDs %v: %w", toDelete, err)
+		}
+		toDeleteStorePaths = append(toDeleteStorePaths, storePaths...)
+
+		if len(infos) < retentionPageSize {
+			break
+		}
+		count -= len(toDelete)
+		offset += len(infos) - len(toDelete)
+	}
+	return toDeleteStorePaths, nil
+}
+
+// retentionSelector selects the completed operations that a retention
+// policy no longer keeps. Operations are passed to it in pages, ordered from
+// the most recently completed, so that the operations kept by each rule with
+// keep-last can be counted across pages.
+type retentionSelector struct {
+	policy coreoperation.RetentionPolicy
+	maxAge time.Duration
+	now    time.Time
+
+	// kept counts the operations kept by each rule with keep-last, for
+	// each action.
+	kept map[string]int
+}
+
+func newRetentionSelector(policy coreoperation.RetentionPolicy, maxAge time.Duration, now time.Time) *retentionSelector {
+	return &retentionSelector{
+		policy: policy,
+		maxAge: maxAge,
+		now:    now,
+		kept:   make(map[string]int),
+	}
+}
+
+// selectExpired returns the UUIDs of the operations in the page that the
+// retention policy no longer keeps.
+//
+// For an operation matching a rule of the policy:
+//   - audited operations are never pruned;
+//   - failed operations are pruned once older than the failed max age of the
+//     rule, if it sets one;
+//   - otherwise operations beyond the last KeepLast of their action, or older
+//     than the max age of the rule (or maxAge if the rule sets none), are
+//     pruned.
+func (s *retentionSelector) selectExpired(infos []retentionInfo) []string {
+	expired := func(completedAt time.Time, age time.Duration) bool {
+		// A zero age means operations are not pruned by age, as with
+		// max-action-results-age.
+		return age > 0 && completedAt.Before(s.now.Add(-age))
+	}
+
+	var toDelete []string
+	for _, info := range infos {
+		if !info.CompletedAt.Valid {
+			continue
+		}
+		completedAt := info.CompletedAt.Time
+
+		rule, index, ok := matchRule(s.policy, info.Action, info.Applications)
+		if !ok {
+			if expired(completedAt, s.maxAge) {
+				toDelete = append(toDelete, info.UUID)
+			}
+			continue
+		}
+
+		switch {
+		case rule.Audit:
+		case info.Failed && rule.FailedMaxAge > 0:
+			if expired(completedAt, rule.FailedMaxAge) {
+				toDelete = append(toDelete, info.UUID)
+			}
+		default:
+			if rule.KeepLast > 0 {
+				key := strconv.Itoa(index) + "/" + info.Action
+				if s.kept[key] >= rule.KeepLast {
+					toDelete = append(toDelete, info.UUID)
+					continue
+				}
+				s.kept[key]++
+			}
+			age := s.maxAge
+			if rule.MaxAge > 0 {
+				age = rule.MaxAge
+			}
+			if expired(completedAt, age) {
+				toDelete = append(toDelete, info.UUID)
+			}
+		}
+	}
+	return toDelete
+}
+
+// matchRule returns the first rule of the policy matching the operation, and
+// its index in the policy.
+func matchRule(
+	policy coreoperation.RetentionPolicy, action string, applications []string,
+) (coreoperation.RetentionRule, int, bool) {
+	for i, rule := range policy {
+		if rule.Matches(action, applications) {
+			return rule, i, true
+		}
+	}
+	return coreoperation.RetentionRule{}, -1, false
+}
+
+// getOperationRetentionInfo returns the action, applications, completion
+// time and failure of a page of up to retentionPageSize operations, starting
+// at offset. With oldestFirst, every operation is returned, in the order in
+// which operations are pruned by size. Otherwise only completed operations
+// are returned, from the most recently completed.
+func (st *State) getOperationRetentionInfo(
+	ctx context.Context, tx *sqlair.TX, oldestFirst bool, offset int,
+) ([]retentionInfo, error) {
+	page := retentionPage{Offset: offset, Limit: retentionPageSize}
+	query := `
+SELECT    o.uuid AS &retentionCandidate.uuid,
+          COALESCE(oa.charm_action_key, '') AS &retentionCandidate.action,
+          o.completed_at AS &retentionCandidate.completed_at
+FROM      operation AS o
+LEFT JOIN operation_action AS oa ON o.uuid = oa.operation_uuid
+WHERE     o.completed_at IS NOT NULL
+ORDER BY  o.completed_at DESC, o.enqueued_at DESC, o.uuid DESC
+LIMIT     $retentionPage.limit
+OFFSET    $retentionPage.offset`
+	if oldestFirst {
+		query = `
+SELECT    o.uuid AS &retentionCandidate.uuid,
+          COALESCE(oa.charm_action_key, '') AS &retentionCandidate.action,
+          o.completed_at AS &retentionCandidate.completed_at
+FROM      operation AS o
+LEFT JOIN operation_action AS oa ON o.uuid = oa.operation_uuid
+ORDER BY  o.completed_at ASC NULLS LAST, o.enqueued_at ASC, o.uuid ASC
+LIMIT     $retentionPage.limit
+OFFSET    $retentionPage.offset`
+	}
+	stmt, err := st.Prepare(query, retentionCandidate{}, page)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var candidates []retentionCandidate
+	if err := tx.Query(ctx, stmt, page).GetAll(&candidates); errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Capture(err)
+	}
+	opUUIDs := make(uuids, len(candidates))
+	for i, candidate := range candidates {
+		opUUIDs[i] = candidate.UUID
+	}
+
+	appStmt, err := st.Prepare(`
+SELECT DISTINCT t.operation_uuid AS &operationApplication.operation_uuid,
+                a.name AS &operationApplication.name
+FROM            operation_task AS t
+JOIN            operation_unit_task AS ut ON t.uuid = ut.task_uuid
+JOIN            unit AS u ON ut.unit_uuid = u.uuid
+JOIN            application AS a ON u.application_uuid = a.uuid
+WHERE           t.operation_uuid IN ($uuids[:])`, operationApplication{}, opUUIDs)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var opApps []operationApplication
+	if err := tx.Query(ctx, appStmt, opUUIDs).GetAll(&opApps); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Capture(err)
+	}
+	applications := make(map[string][]string)
+	for _, opApp := range opApps {
+		applications[opApp.OperationUUID] = append(applications[opApp.OperationUUID], opApp.Name)
+	}
+
+	failedStmt, err := st.Prepare(`
+SELECT DISTINCT t.operation_uuid AS &uuid.uuid
+FROM            operation_task AS t
+JOIN            operation_task_status AS ts ON t.uuid = ts.task_uuid
+JOIN            operation_task_status_value AS tsv ON ts.status_id = tsv.id
+WHERE           tsv.status IN ('error', 'failed')
+AND             t.operation_uuid IN ($uuids[:])`, uuid{}, opUUIDs)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var failedOps []uuid
+	if err := tx.Query(ctx, failedStmt, opUUIDs).GetAll(&failedOps); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Capture(err)
+	}
+	failed := make(map[string]bool, len(failedOps))
+	for _, op := range failedOps {
+		failed[op.UUID] = true
+	}
+
+	infos := make([]retentionInfo, len(candidates))
+	for i, candidate := range candidates {
+		// Exec operations are not linked to a charm action.
+		if candidate.Action == "" {
+			candidate.Action = coreoperation.JujuExecActionName
+		}
+		infos[i] = retentionInfo{
+			retentionCandidate: candidate,
+			Applications:       applications[candidate.UUID],
+			Failed:             failed[candidate.UUID],
+		}
+	}
+	return infos, nil
+}
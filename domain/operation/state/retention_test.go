@@ -0,0 +1,192 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/tc"
+
+	coreoperation "github.com/juju/juju/core/operation"
+)
+
+type retentionSuite struct {
+	baseSuite
+}
+
+func TestRetentionSuite(t *testing.T) {
+	tc.Run(t, &retentionSuite{})
+}
+
+// TestPruneByPolicyMaxAge tests that the max age of a rule overrides the
+// default max age for the operations it matches.
+func (s *retentionSuite) TestPruneByPolicyMaxAge(c *tc.C) {
+	// Arrange: two operations completed two hours ago, one running the
+	// backup action which is kept for a year.
+	charmUUID := s.addCharm(c)
+	backup := s.addCompletedOperation(c, 2*time.Hour)
+	s.addOperationAction(c, backup, charmUUID, "backup")
+	other := s.addCompletedOperation(c, 2*time.Hour)
+	s.addOperationAction(c, other, charmUUID, "other")
+
+	policy := coreoperation.RetentionPolicy{{Action: "backup", MaxAge: 8760 * time.Hour}}
+
+	// Act
+	_, err := s.state.PruneOperations(c.Context(), time.Hour, 0, policy)
+
+	// Assert: only the backup operation is kept.
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.selectDistinctValues(c, "uuid", "operation"), tc.SameContents, []string{backup})
+}
+
+// TestPruneByPolicyKeepLast tests that only the latest operations of an
+// action are kept by a keep-last rule, whatever their age.
+func (s *retentionSuite) TestPruneByPolicyKeepLast(c *tc.C) {
+	// Arrange: four recent health-check operations, and one exec operation.
+	charmUUID := s.addCharm(c)
+	var checks []string
+	for i := 1; i <= 4; i++ {
+		op := s.addCompletedOperation(c, time.Duration(i)*time.Minute)
+		s.addOperationAction(c, op, charmUUID, "health-check")
+		checks = append(checks, op)
+	}
+	exec := s.addCompletedOperation(c, 5*time.Minute)
+
+	policy := coreoperation.RetentionPolicy{{Action: "health-check", KeepLast: 2}}
+
+	// Act
+	_, err := s.state.PruneOperations(c.Context(), time.Hour, 0, policy)
+
+	// Assert: the two most recent health checks are kept.
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.selectDistinctValues(c, "uuid", "operation"), tc.SameContents, []string{checks[0], checks[1], exec})
+}
+
+// TestPruneByPolicyFailedMaxAge tests that failed operations are kept for the
+// failed max age of the rule matching them.
+func (s *retentionSuite) TestPruneByPolicyFailedMaxAge(c *tc.C) {
+	// Arrange: a failed and a successful exec operation completed two hours
+	// ago.
+	failed := s.addCompletedOperation(c, 2*time.Hour)
+	s.addOperationTaskStatus(c, s.addOperationTask(c, failed), "failed")
+	succeeded := s.addCompletedOperation(c, 2*time.Hour)
+	s.addOperationTaskStatus(c, s.addOperationTask(c, succeeded), "completed")
+
+	policy := coreoperation.RetentionPolicy{{FailedMaxAge: 24 * time.Hour}}
+
+	// Act
+	_, err := s.state.PruneOperations(c.Context(), time.Hour, 0, policy)
+
+	// Assert: only the failed operation is kept.
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.selectDistinctValues(c, "uuid", "operation"), tc.SameContents, []string{failed})
+}
+
+// TestPruneByPolicyAuditApplication tests that operations on an application
+// marked for audit are never pruned by age.
+func (s *retentionSuite) TestPruneByPolicyAuditApplication(c *tc.C) {
+	// Arrange: an old operation on vault and another on mysql.
+	charmUUID := s.addCharm(c)
+	audited := s.addCompletedOperation(c, 1000*time.Hour)
+	s.addOperationUnitTask(c, s.addOperationTask(c, audited), s.addUnitWithName(c, charmUUID, "vault/0"))
+	other := s.addCompletedOperation(c, 1000*time.Hour)
+	s.addOperationUnitTask(c, s.addOperationTask(c, other), s.addUnitWithName(c, charmUUID, "mysql/0"))
+
+	policy := coreoperation.RetentionPolicy{{Application: "vault", Audit: true}}
+
+	// Act
+	_, err := s.state.PruneOperations(c.Context(), time.Hour, 0, policy)
+
+	// Assert: only the vault operation is kept.
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.selectDistinctValues(c, "uuid", "operation"), tc.SameContents, []string{audited})
+}
+
+// TestPruneOldestUnauditedOperations tests that operations marked for audit
+// are not pruned by size.
+func (s *retentionSuite) TestPruneOldestUnauditedOperations(c *tc.C) {
+	// Arrange: three operations, the oldest of which is audited.
+	charmUUID := s.addCharm(c)
+	audited := s.addCompletedOperation(c, 3*time.Hour)
+	s.addOperationAction(c, audited, charmUUID, "backup")
+	s.addCompletedOperation(c, 2*time.Hour)
+	newer := s.addCompletedOperation(c, time.Hour)
+
+	policy := coreoperation.RetentionPolicy{{Action: "backup", Audit: true}}
+
+	// Act: prune the oldest operation.
+	err := s.txn(c, func(ctx context.Context, tx *sqlair.TX) error {
+		_, err := s.state.pruneOldestUnauditedOperations(ctx, tx, 1, policy)
+		return err
+	})
+
+	// Assert: the audited operation is skipped.
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.selectDistinctValues(c, "uuid", "operation"), tc.SameContents, []string{audited, newer})
+}
+
+// TestPruneOldestUnauditedOperationsPaged tests that operations are pruned by
+// size across pages of operations.
+func (s *retentionSuite) TestPruneOldestUnauditedOperationsPaged(c *tc.C) {
+	s.setRetentionPageSize(c, 2)
+
+	// Arrange: five operations, the two oldest of which are audited.
+	charmUUID := s.addCharm(c)
+	var ops []string
+	for i := 5; i >= 1; i-- {
+		op := s.addCompletedOperation(c, time.Duration(i)*time.Hour)
+		if i > 3 {
+			s.addOperationAction(c, op, charmUUID, "backup")
+		}
+		ops = append(ops, op)
+	}
+
+	policy := coreoperation.RetentionPolicy{{Action: "backup", Audit: true}}
+
+	// Act: prune the two oldest operations.
+	err := s.txn(c, func(ctx context.Context, tx *sqlair.TX) error {
+		_, err := s.state.pruneOldestUnauditedOperations(ctx, tx, 2, policy)
+		return err
+	})
+
+	// Assert: the audited operations and the newest one are kept.
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.selectDistinctValues(c, "uuid", "operation"), tc.SameContents, []string{ops[0], ops[1], ops[4]})
+}
+
+// TestPruneByPolicyKeepLastPaged tests that keep-last rules count the
+// operations kept across pages, and that no page is skipped as operations
+// are deleted.
+func (s *retentionSuite) TestPruneByPolicyKeepLastPaged(c *tc.C) {
+	s.setRetentionPageSize(c, 2)
+
+	// Arrange: five recent health-check operations, and one old exec
+	// operation.
+	charmUUID := s.addCharm(c)
+	var checks []string
+	for i := 1; i <= 5; i++ {
+		op := s.addCompletedOperation(c, time.Duration(i)*time.Minute)
+		s.addOperationAction(c, op, charmUUID, "health-check")
+		checks = append(checks, op)
+	}
+	s.addCompletedOperation(c, 2*time.Hour)
+
+	policy := coreoperation.RetentionPolicy{{Action: "health-check", KeepLast: 3}}
+
+	// Act
+	_, err := s.state.PruneOperations(c.Context(), time.Hour, 0, policy)
+
+	// Assert: the three most recent health checks are kept.
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.selectDistinctValues(c, "uuid", "operation"), tc.SameContents, checks[:3])
+}
+
+func (s *retentionSuite) setRetentionPageSize(c *tc.C, size int) {
+	original := retentionPageSize
+	retentionPageSize = size
+	c.Cleanup(func() { retentionPageSize = original })
+}
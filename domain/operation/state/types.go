@@ -181,3 +181,18 @@ type queryFlags struct {
 	HasMachines     bool `db:"has_machines"`
 	HasUnits        bool `db:"has_units"`
 }
+
+// retentionCandidate holds the details of an operation used to decide
+// whether a retention policy keeps it.
+type retentionCandidate struct {
+	UUID        string       `db:"uuid"`
+	Action      string       `db:"action"`
+	CompletedAt sql.NullTime `db:"completed_at"`
+}
+
+// operationApplication links an operation to an application with a unit
+// running one of its tasks.
+type operationApplication struct {
+	OperationUUID string `db:"operation_uuid"`
+	Name          string `db:"name"`
+}
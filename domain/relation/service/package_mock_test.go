@@ -1866,6 +1866,45 @@ func (c *MockStateSetRemoteRelationSuspendedStateCall) DoAndReturn(f func(contex
 	return c
 }
 
+// WatcherAllRelationsNamespaces mocks base method.
+func (m *MockState) WatcherAllRelationsNamespaces() (string, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatcherAllRelationsNamespaces")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// WatcherAllRelationsNamespaces indicates an expected call of WatcherAllRelationsNamespaces.
+func (mr *MockStateMockRecorder) WatcherAllRelationsNamespaces() *MockStateWatcherAllRelationsNamespacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatcherAllRelationsNamespaces", reflect.TypeOf((*MockState)(nil).WatcherAllRelationsNamespaces))
+	return &MockStateWatcherAllRelationsNamespacesCall{Call: call}
+}
+
+// MockStateWatcherAllRelationsNamespacesCall wrap *gomock.Call
+type MockStateWatcherAllRelationsNamespacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateWatcherAllRelationsNamespacesCall) Return(arg0, arg1 string) *MockStateWatcherAllRelationsNamespacesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateWatcherAllRelationsNamespacesCall) Do(f func() (string, string)) *MockStateWatcherAllRelationsNamespacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateWatcherAllRelationsNamespacesCall) DoAndReturn(f func() (string, string)) *MockStateWatcherAllRelationsNamespacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatcherApplicationSettingsNamespace mocks base method.
 func (m *MockState) WatcherApplicationSettingsNamespace() string {
 	m.ctrl.T.Helper()
@@ -88,6 +88,10 @@ type WatcherState interface {
 	// WatcherApplicationSettingsNamespace provides the table name to set up
 	// watchers for relation application settings.
 	WatcherApplicationSettingsNamespace() string
+
+	// WatcherAllRelationsNamespaces provides the table names to set up
+	// watchers for all relations and the units in their scope.
+	WatcherAllRelationsNamespaces() (string, string)
 }
 
 // WatcherFactory describes methods for creating watchers that are used by the
@@ -238,6 +242,22 @@ func (s *WatchableService) WatchRelationsLifeSuspendedStatusForApplication(
 	)
 }
 
+// WatchAllRelations returns a watcher that notifies when any relation in the
+// model is added, removed, suspended or resumed, or changes life, and when
+// units enter or leave the scope of any relation.
+func (s *WatchableService) WatchAllRelations(ctx context.Context) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	relationNamespace, relationUnitNamespace := s.st.WatcherAllRelationsNamespaces()
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"all relations watcher",
+		eventsource.NamespaceFilter(relationNamespace, changestream.All),
+		eventsource.NamespaceFilter(relationUnitNamespace, changestream.All),
+	)
+}
+
 // WatchRelatedUnits returns a watcher that notifies of changes to counterpart
 // units in the relation.
 func (s *WatchableService) WatchRelatedUnits(
@@ -2824,6 +2824,12 @@ func (st *State) WatcherApplicationSettingsNamespace() string {
 	return "relation_application_settings_hash"
 }
 
+// WatcherAllRelationsNamespaces returns the namespace strings used for
+// tracking relations and the units in their scope in the database.
+func (st *State) WatcherAllRelationsNamespaces() (string, string) {
+	return "relation", "relation_unit"
+}
+
 // GetWatcherRelationUnitsData returns the data used to the RelationsUnits
 // watcher: relation endpoint UUID and namespaces.
 func (st *State) GetWatcherRelationUnitsData(
@@ -529,6 +529,58 @@ func (s *watcherSuite) TestWatchRelationLifeSuspendedStatus(c *tc.C) {
 	harness.Run(c, struct{}{})
 }
 
+func (s *watcherSuite) TestWatchAllRelations(c *tc.C) {
+	// Arrange: create a unit of the application under test.
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, s.ModelUUID())
+
+	unitUUID := unittesting.GenUnitUUID(c)
+	s.addUnit(c, unitUUID, "my-application/0", s.appUUID, s.charmUUID)
+
+	s.AssertChangeStreamIdle(c)
+
+	svc := s.setupService(c, factory)
+	watcher, err := svc.WatchAllRelations(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	var relationUUID relation.UUID
+	relationEndpointUUID := relationtesting.GenEndpointUUID(c)
+
+	// Act 0: add a relation.
+	harness.AddTest(c, func(c *tc.C) {
+		relationUUID = tc.Must(c, relation.NewUUID)
+		s.addRelation(c, relationUUID)
+		s.addRelationEndpoint(c, relationEndpointUUID, relationUUID, s.appEndpointUUID)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.Check(watchertest.SliceAssert(struct{}{}))
+	})
+
+	// Act 1: the unit enters the relation scope.
+	harness.AddTest(c, func(c *tc.C) {
+		s.act(c, "INSERT INTO relation_unit (uuid, relation_endpoint_uuid, unit_uuid) VALUES (?, ?, ?)",
+			relationtesting.GenRelationUnitUUID(c), relationEndpointUUID, unitUUID)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.Check(watchertest.SliceAssert(struct{}{}))
+	})
+
+	// Act 2: suspend the relation.
+	harness.AddTest(c, func(c *tc.C) {
+		s.act(c, "UPDATE relation SET suspended = TRUE WHERE uuid = ?", relationUUID)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.Check(watchertest.SliceAssert(struct{}{}))
+	})
+
+	// Act 3: the unit leaves the relation scope.
+	harness.AddTest(c, func(c *tc.C) {
+		s.act(c, "DELETE FROM relation_unit WHERE unit_uuid = ?", unitUUID)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.Check(watchertest.SliceAssert(struct{}{}))
+	})
+
+	harness.Run(c, struct{}{})
+}
+
 func (s *watcherSuite) setupSecondAppAndRelate(
 	c *tc.C, appNameTwo string,
 ) (relation.UUID, coreapplication.UUID, corecharm.ID) {
@@ -535,6 +535,7 @@ func (st *State) deleteSimpleApplicationReferences(ctx context.Context, tx *sqla
 		"DELETE FROM application_setting WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_exposed_endpoint_space WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_exposed_endpoint_cidr WHERE application_uuid = $entityUUID.uuid",
+		"DELETE FROM application_egress_rule WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_endpoint WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_extra_endpoint WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_storage_directive WHERE application_uuid = $entityUUID.uuid",
@@ -302,6 +302,7 @@ func (st *State) prepareSecretDeletions() ([]*sqlair.Statement, []*sqlair.Statem
 		"DELETE FROM secret_unit_consumer WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_remote_unit_consumer WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_rotation WHERE secret_id IN ($uuids[:])",
+		"DELETE FROM secret_rotate_schedule WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_reference WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_permission WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_application_owner WHERE secret_id IN ($uuids[:])",
@@ -492,6 +493,7 @@ WHERE revision_uuid IN ($uuids[:])`,
 	// No revisions remain, delete the secret and all related records.
 	deleteSecretQueries := []string{
 		`DELETE FROM secret_rotation WHERE secret_id = $secretID.secret_id`,
+		`DELETE FROM secret_rotate_schedule WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_unit_owner WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_application_owner WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_model_owner WHERE secret_id = $secretID.secret_id`,
@@ -499,6 +501,7 @@ WHERE revision_uuid IN ($uuids[:])`,
 		`DELETE FROM secret_remote_unit_consumer WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_reference WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_permission WHERE secret_id = $secretID.secret_id`,
+		`DELETE FROM secret_external_source WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_metadata WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret WHERE id = $secretID.secret_id`,
 	}
@@ -164,6 +164,10 @@ func (s *secretSuite) assertDeleteAllRevisions(c *tc.C, revs []int) {
 	_, err = s.DB().ExecContext(ctx, q, sec, unit)
 	c.Assert(err, tc.ErrorIsNil)
 
+	q = "INSERT INTO secret_external_source (secret_id, backend_name, path) VALUES (?, 'myvault', 'kv/prod/db')"
+	_, err = s.DB().ExecContext(ctx, q, sec)
+	c.Assert(err, tc.ErrorIsNil)
+
 	deleted, err := st.DeleteUserSecretRevisions(ctx, uri, revs)
 	c.Assert(err, tc.ErrorIsNil)
 	c.Check(deleted, tc.SameContents, []string{"revision_id_0", "revision_id_1", "revision_id_2"})
@@ -178,6 +182,7 @@ func (s *secretSuite) assertDeleteAllRevisions(c *tc.C, revs []int) {
 	s.checkCount(c, "secret_metadata", 0)
 	s.checkCount(c, "secret_application_owner", 0)
 	s.checkCount(c, "secret_unit_owner", 0)
+	s.checkCount(c, "secret_external_source", 0)
 	s.checkCount(c, "secret", 0)
 }
 
@@ -762,6 +762,26 @@ DELETE FROM storage_unit_owner WHERE storage_instance_uuid = $entityUUID.uuid
 		)
 	}
 
+	// Once the storage instance is gone the secret holding its encryption
+	// key may be removed.
+	deleteEncryptionKeyStmt, err := st.Prepare(`
+DELETE FROM storage_instance_encryption_key WHERE storage_instance_uuid = $entityUUID.uuid
+`, input)
+	if err != nil {
+		return errors.Errorf(
+			"preparing storage instance encryption key deletion: %w", err,
+		)
+	}
+
+	deleteSnapshotSourceStmt, err := st.Prepare(`
+DELETE FROM storage_instance_snapshot_source WHERE storage_instance_uuid = $entityUUID.uuid
+`, input)
+	if err != nil {
+		return errors.Errorf(
+			"preparing storage instance snapshot source deletion: %w", err,
+		)
+	}
+
 	deleteStorageInstanceStmt, err := st.Prepare(`
 DELETE FROM storage_instance WHERE uuid = $entityUUID.uuid
 `, input)
@@ -776,6 +796,14 @@ DELETE FROM storage_instance WHERE uuid = $entityUUID.uuid
 		if err != nil {
 			return errors.Errorf("deleting storage unit owner: %w", err)
 		}
+		err = tx.Query(ctx, deleteEncryptionKeyStmt, input).Run()
+		if err != nil {
+			return errors.Errorf("deleting storage instance encryption key: %w", err)
+		}
+		err = tx.Query(ctx, deleteSnapshotSourceStmt, input).Run()
+		if err != nil {
+			return errors.Errorf("deleting storage instance snapshot source: %w", err)
+		}
 		err = tx.Query(ctx, deleteStorageInstanceStmt, input).Run()
 		if err != nil {
 			return errors.Errorf("deleting storage instance: %w", err)
@@ -1299,6 +1327,12 @@ func (st *State) DeleteFilesystem(ctx context.Context, rUUID string) error {
 		"DELETE FROM machine_filesystem WHERE filesystem_uuid = $entityUUID.uuid",
 		"DELETE FROM storage_instance_filesystem WHERE storage_filesystem_uuid = $entityUUID.uuid",
 		"DELETE FROM storage_filesystem_status WHERE filesystem_uuid = $entityUUID.uuid",
+		`
+DELETE FROM storage_filesystem_attachment_usage
+WHERE storage_filesystem_attachment_uuid IN (
+    SELECT uuid FROM storage_filesystem_attachment
+    WHERE storage_filesystem_uuid = $entityUUID.uuid
+)`,
 		"DELETE FROM storage_filesystem_attachment WHERE storage_filesystem_uuid = $entityUUID.uuid",
 		"DELETE FROM storage_filesystem WHERE uuid = $entityUUID.uuid",
 	}
@@ -1491,6 +1525,16 @@ func (st *State) DeleteFilesystemAttachment(
 
 	uuid := entityUUID{UUID: fsaUUID}
 
+	deleteUsageStmt, err := st.Prepare(`
+DELETE FROM storage_filesystem_attachment_usage
+WHERE storage_filesystem_attachment_uuid = $entityUUID.uuid
+`, uuid)
+	if err != nil {
+		return errors.Errorf(
+			"preparing filesystem attachment usage deletion: %w", err,
+		)
+	}
+
 	deleteStmt, err := st.Prepare(`
 DELETE FROM storage_filesystem_attachment WHERE uuid = $entityUUID.uuid
 `, uuid)
@@ -1501,6 +1545,10 @@ DELETE FROM storage_filesystem_attachment WHERE uuid = $entityUUID.uuid
 	}
 
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err = tx.Query(ctx, deleteUsageStmt, uuid).Run()
+		if err != nil {
+			return errors.Errorf("deleting filesystem attachment usage: %w", err)
+		}
 		err = tx.Query(ctx, deleteStmt, uuid).Run()
 		if err != nil {
 			return errors.Errorf("deleting filesystem attachment: %w", err)
@@ -522,6 +522,51 @@ func (s *storageSuite) TestDeleteStorageInstance(c *tc.C) {
 	c.Check(row.Scan(&dummy), tc.ErrorIs, sql.ErrNoRows)
 }
 
+func (s *storageSuite) TestDeleteStorageInstanceWithEncryptionKey(c *tc.C) {
+	ctx := c.Context()
+
+	siUUID := s.addStorageInstance(c)
+	_, err := s.DB().ExecContext(ctx, "INSERT INTO secret (id) VALUES ('secret-id')")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().ExecContext(ctx, `
+INSERT INTO storage_instance_encryption_key (storage_instance_uuid, secret_id)
+VALUES (?, 'secret-id')`, siUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err = st.DeleteStorageInstance(ctx, siUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The key record is gone, while the secret is kept.
+	var dummy string
+	row := s.DB().QueryRowContext(
+		ctx, "SELECT secret_id FROM storage_instance_encryption_key WHERE storage_instance_uuid = ?", siUUID)
+	c.Check(row.Scan(&dummy), tc.ErrorIs, sql.ErrNoRows)
+	row = s.DB().QueryRowContext(ctx, "SELECT id FROM secret WHERE id = 'secret-id'")
+	c.Check(row.Scan(&dummy), tc.ErrorIsNil)
+}
+
+func (s *storageSuite) TestDeleteStorageInstanceWithSnapshotSource(c *tc.C) {
+	ctx := c.Context()
+
+	siUUID := s.addStorageInstance(c)
+	_, err := s.DB().ExecContext(ctx, `
+INSERT INTO storage_instance_snapshot_source (storage_instance_uuid, snapshot_id)
+VALUES (?, 'pool:vol/snap')`, siUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err = st.DeleteStorageInstance(ctx, siUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var dummy string
+	row := s.DB().QueryRowContext(
+		ctx, "SELECT snapshot_id FROM storage_instance_snapshot_source WHERE storage_instance_uuid = ?", siUUID)
+	c.Check(row.Scan(&dummy), tc.ErrorIs, sql.ErrNoRows)
+}
+
 func (s *storageSuite) TestDeleteStorageInstanceWithUnitOwned(c *tc.C) {
 	ctx := c.Context()
 
@@ -914,6 +959,25 @@ func (s *storageSuite) TestDeleteFilesystem(c *tc.C) {
 	c.Check(row.Scan(&dummy), tc.ErrorIs, sql.ErrNoRows)
 }
 
+func (s *storageSuite) TestDeleteFilesystemWithAttachmentUsage(c *tc.C) {
+	fsUUID, fsaUUID := s.addAttachedFilesystem(c)
+	_, err := s.DB().Exec(`
+INSERT INTO storage_filesystem_attachment_usage (storage_filesystem_attachment_uuid, size_mib, used_mib, updated_at)
+VALUES (?, 1024, 512, ?)`, fsaUUID, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err = st.DeleteFilesystem(c.Context(), fsUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Filesystem is gone.
+	var dummy string
+	row := s.DB().QueryRow(
+		"SELECT uuid FROM storage_filesystem WHERE uuid = ?", fsUUID)
+	c.Check(row.Scan(&dummy), tc.ErrorIs, sql.ErrNoRows)
+}
+
 func (s *storageSuite) TestDeleteFilesystemWithMachineFilesystem(c *tc.C) {
 	fsUUID := s.addFilesystem(c)
 	machineUUID := s.addMachine(c)
@@ -1081,6 +1145,26 @@ func (s *storageSuite) TestDeleteFilesystemAttachment(c *tc.C) {
 	c.Check(row.Scan(&dummy), tc.ErrorIs, sql.ErrNoRows)
 }
 
+func (s *storageSuite) TestDeleteFilesystemAttachmentWithUsage(c *tc.C) {
+	ctx := c.Context()
+
+	_, fsaUUID := s.addAttachedFilesystem(c)
+	_, err := s.DB().ExecContext(ctx, `
+INSERT INTO storage_filesystem_attachment_usage (storage_filesystem_attachment_uuid, size_mib, used_mib, updated_at)
+VALUES (?, 1024, 512, ?)`, fsaUUID, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err = st.DeleteFilesystemAttachment(ctx, fsaUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Attachment usage is gone.
+	var dummy string
+	row := s.DB().QueryRowContext(ctx, "SELECT storage_filesystem_attachment_uuid FROM storage_filesystem_attachment_usage WHERE storage_filesystem_attachment_uuid = ?", fsaUUID)
+	c.Check(row.Scan(&dummy), tc.ErrorIs, sql.ErrNoRows)
+}
+
 func (s *storageSuite) TestFilesystemAttachmentScheduleRemoval(c *tc.C) {
 	_, fsaUUID := s.addAttachedFilesystem(c)
 
@@ -0,0 +1,11 @@
+-- The data key encrypting the content of secrets stored by the internal
+-- secret backend for a model. The key is only stored wrapped by a controller
+-- master key, identified by master_key_id.
+CREATE TABLE model_secret_data_key (
+    model_uuid TEXT NOT NULL PRIMARY KEY,
+    wrapped_key BLOB NOT NULL,
+    master_key_id TEXT NOT NULL,
+    CONSTRAINT fk_model_secret_data_key_model_uuid
+    FOREIGN KEY (model_uuid)
+    REFERENCES model (uuid)
+);
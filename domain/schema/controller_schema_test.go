@@ -192,6 +192,7 @@ func (s *controllerSchemaSuite) TestControllerTables(c *tc.C) {
 		"secret_backend_type",
 		"secret_backend_reference",
 		"model_secret_backend",
+		"model_secret_data_key",
 
 		// macaroon bakery
 		"bakery_config",
@@ -17,18 +17,18 @@ import (
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/model-triggers.gen.go -package=triggers -tables=model_config,model_migrating
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/objectstore-triggers.gen.go -package=triggers -tables=object_store_metadata_path
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/secret-triggers.gen.go -package=triggers -tables=secret_metadata,secret_rotation,secret_revision,secret_revision_expire,secret_revision_obsolete,secret_reference,secret_deleted_value_ref
-//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/network-triggers.gen.go -package=triggers -tables=subnet,ip_address
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/network-triggers.gen.go -package=triggers -tables=subnet,ip_address,space_address_preference
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/machine-triggers.gen.go -package=triggers -tables=machine,machine_lxd_profile
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/machine-cloud-instance-triggers.gen.go -package=triggers -tables=machine_cloud_instance
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/machine-requires-reboot-triggers.gen.go -package=triggers -tables=machine_requires_reboot
-//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/application-triggers.gen.go -package=triggers -tables=application,application_config_hash,application_setting,charm,application_scale,port_range,application_exposed_endpoint_space,application_exposed_endpoint_cidr
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/application-triggers.gen.go -package=triggers -tables=application,application_config_hash,application_setting,charm,application_scale,port_range,application_exposed_endpoint_space,application_exposed_endpoint_cidr,application_egress_rule
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/unit-triggers.gen.go -package triggers -tables=unit,unit_principal,unit_resolved
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/relation-triggers.gen.go -package=triggers -tables=relation_application_settings_hash,relation_unit_settings_hash,relation_unit,relation,application_endpoint
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/cleanup-triggers.gen.go -package=triggers -tables=removal
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/operation-triggers.gen.go -package=triggers -tables=operation_task_log
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/crossmodelrelation-triggers.gen.go -package=triggers -tables=application_remote_offerer,application_remote_consumer,relation_network_ingress,relation_network_egress
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/offer-triggers.gen.go -package=triggers -tables=offer
-//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/status-triggers.gen.go -package=triggers -tables=application_status
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/status-triggers.gen.go -package=triggers -tables=application_status,machine_status,machine_cloud_instance_status,relation_status
 
 //go:embed model/sql/*.sql
 var modelSchemaDir embed.FS
@@ -64,6 +64,8 @@ const (
 	customNamespaceUnitWorkloadStatus
 	customNamespaceK8sPodStatus
 	customNamespaceRelationLifeSuspended
+	customNamespaceStorageVolumeSizeMachineProvisioning
+	customNamespaceStorageFilesystemSizeMachineProvisioning
 )
 
 const (
@@ -109,6 +111,11 @@ const (
 	tableRelationNetworkIngress
 	tableRelationNetworkEgress
 	tableModelMigrating
+	tableMachineStatus
+	tableMachineCloudInstanceStatus
+	tableRelationStatus
+	tableApplicationEgressRule
+	tableSpaceAddressPreference
 )
 
 // modelPostPatchFilesByVersion is used to categorise the post patch files
@@ -193,6 +200,11 @@ func ModelDDLForVersion(version semversion.Number) *schema.Schema {
 		triggers.ChangeLogTriggersForRelationNetworkIngress("relation_uuid", tableRelationNetworkIngress),
 		triggers.ChangeLogTriggersForRelationNetworkEgress("relation_uuid", tableRelationNetworkEgress),
 		triggers.ChangeLogTriggersForModelMigrating("model_uuid", tableModelMigrating),
+		triggers.ChangeLogTriggersForMachineStatus("machine_uuid", tableMachineStatus),
+		triggers.ChangeLogTriggersForMachineCloudInstanceStatus("machine_uuid", tableMachineCloudInstanceStatus),
+		triggers.ChangeLogTriggersForRelationStatus("relation_uuid", tableRelationStatus),
+		triggers.ChangeLogTriggersForApplicationEgressRule("application_uuid", tableApplicationEgressRule),
+		triggers.ChangeLogTriggersForSpaceAddressPreference("space_uuid", tableSpaceAddressPreference),
 	)
 
 	// Generic triggers.
@@ -0,0 +1,20 @@
+-- The desired_bundle table holds the bundle that the model is expected to
+-- match. The bundle drift worker periodically compares the bundle with the
+-- model and records any differences it finds in drift. There is at most one
+-- desired bundle for a model.
+CREATE TABLE desired_bundle (
+    model_uuid TEXT NOT NULL PRIMARY KEY,
+    -- uuid changes every time the bundle is set, so that the result of a
+    -- comparison against a previous bundle is not recorded.
+    uuid TEXT NOT NULL,
+    bundle TEXT NOT NULL,
+    set_at TIMESTAMP NOT NULL,
+    -- drift holds the differences between the bundle and the model, in the
+    -- same YAML format as used by diff-bundle. It is empty when the model
+    -- matches the bundle, and NULL until the bundle has been compared.
+    drift TEXT,
+    checked_at TIMESTAMP,
+    CONSTRAINT fk_desired_bundle_model
+    FOREIGN KEY (model_uuid)
+    REFERENCES model (uuid)
+);
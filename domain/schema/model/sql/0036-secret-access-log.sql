@@ -0,0 +1,23 @@
+-- secret_access_log records each read of secret content, so that operators
+-- can audit which entities read which secret revisions, and when. Entries are
+-- kept after the secret is removed. The log is bounded; once it holds more
+-- than a fixed number of entries, the oldest are removed as new ones are
+-- added.
+CREATE TABLE secret_access_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    secret_id TEXT NOT NULL,
+    revision INT NOT NULL,
+    -- accessor_kind is one of unit, application, model, user or machine.
+    accessor_kind TEXT NOT NULL,
+    accessor_id TEXT NOT NULL,
+    accessed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_secret_access_log_secret
+ON secret_access_log (secret_id, accessed_at);
+
+CREATE INDEX idx_secret_access_log_accessor
+ON secret_access_log (accessor_id, accessed_at);
+
+CREATE INDEX idx_secret_access_log_accessed_at
+ON secret_access_log (accessed_at);
@@ -0,0 +1,15 @@
+-- secret_external_source records the secret backend path from which the
+-- content of a user secret is synced. When the content at the path changes,
+-- a new revision of the secret is created.
+CREATE TABLE secret_external_source (
+    secret_id TEXT NOT NULL PRIMARY KEY,
+    -- backend_name is the name of the secret backend holding the content.
+    backend_name TEXT NOT NULL,
+    path TEXT NOT NULL,
+    -- content_key is the single key synced from the path, or empty if
+    -- every key at the path is synced.
+    content_key TEXT NOT NULL DEFAULT '',
+    CONSTRAINT fk_secret_external_source_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id)
+);
@@ -0,0 +1,45 @@
+-- A custom rotate policy, such as an interval or cron schedule, is recorded
+-- with the 'custom' policy in secret_metadata, and the policy itself in
+-- secret_rotate_schedule.
+INSERT INTO secret_rotate_policy VALUES
+(7, 'custom');
+
+CREATE TABLE secret_rotate_schedule (
+    secret_id TEXT NOT NULL PRIMARY KEY,
+    -- schedule is the custom rotate policy, such as '36h',
+    -- '0 3 * * SUN' or '45d@0 3 * * SUN~1h'.
+    schedule TEXT NOT NULL,
+    CONSTRAINT chk_empty_schedule
+    CHECK (schedule != ''),
+    CONSTRAINT fk_secret_rotate_schedule_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id)
+);
+
+-- The policy of a secret with a custom rotate policy is its schedule.
+DROP VIEW v_secret_metadata;
+
+CREATE VIEW v_secret_metadata AS
+SELECT
+    sm.secret_id,
+    sm.version,
+    sm.description,
+    sm.auto_prune,
+    sm.latest_revision_checksum,
+    sm.create_time,
+    sm.update_time,
+    COALESCE(srs.schedule, rp.policy) AS policy,
+    sro.next_rotation_time,
+    sre.expire_time,
+    sr.revision,
+    so.owner_kind,
+    so.owner_uuid,
+    so.owner_name,
+    so.label
+FROM secret_metadata AS sm
+JOIN secret_rotate_policy AS rp ON sm.rotate_policy_id = rp.id
+JOIN secret_revision AS sr ON sm.secret_id = sr.secret_id
+LEFT JOIN secret_rotate_schedule AS srs ON sm.secret_id = srs.secret_id
+LEFT JOIN secret_revision_expire AS sre ON sr.uuid = sre.revision_uuid
+LEFT JOIN secret_rotation AS sro ON sm.secret_id = sro.secret_id
+LEFT JOIN v_secret_owner AS so ON sm.secret_id = so.secret_id;
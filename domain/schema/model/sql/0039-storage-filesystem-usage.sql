@@ -0,0 +1,12 @@
+-- storage_filesystem_attachment_usage records the most recent usage of an
+-- attached filesystem, as reported by the agent of the machine on which it
+-- is mounted.
+CREATE TABLE storage_filesystem_attachment_usage (
+    storage_filesystem_attachment_uuid TEXT NOT NULL PRIMARY KEY,
+    size_mib INT NOT NULL,
+    used_mib INT NOT NULL,
+    updated_at DATETIME NOT NULL,
+    CONSTRAINT fk_storage_filesystem_attachment_usage_attachment
+    FOREIGN KEY (storage_filesystem_attachment_uuid)
+    REFERENCES storage_filesystem_attachment (uuid)
+);
@@ -0,0 +1,19 @@
+-- application_egress_rule records the destinations that the units of an
+-- application are allowed to initiate connections to. Machines hosting an
+-- application with egress rules, or every machine if the model config
+-- egress-default-deny is set, may only connect to the union of the
+-- destinations allowed for the applications they host.
+CREATE TABLE application_egress_rule (
+    application_uuid TEXT NOT NULL,
+    cidr TEXT NOT NULL,
+    protocol_id INT NOT NULL,
+    from_port INT NOT NULL,
+    to_port INT NOT NULL,
+    CONSTRAINT fk_application_egress_rule_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid),
+    CONSTRAINT fk_application_egress_rule_protocol
+    FOREIGN KEY (protocol_id)
+    REFERENCES protocol (id),
+    PRIMARY KEY (application_uuid, cidr, protocol_id, from_port, to_port)
+);
@@ -0,0 +1,136 @@
+-- space_address_preference records the type of IP address preferred when
+-- selecting among the addresses of dual-stack units in a space, such as the
+-- ingress addresses reported by network-get. IPv4 addresses are preferred in
+-- spaces without a preference.
+CREATE TABLE space_address_preference (
+    space_uuid TEXT NOT NULL PRIMARY KEY,
+    address_type_id INT NOT NULL,
+    CONSTRAINT fk_space_address_preference_space
+    FOREIGN KEY (space_uuid)
+    REFERENCES space (uuid),
+    CONSTRAINT fk_space_address_preference_address_type
+    FOREIGN KEY (address_type_id)
+    REFERENCES ip_address_type (id)
+);
+
+-- The address preference of a space is reported with its subnets.
+DROP VIEW v_space_subnet;
+
+CREATE VIEW v_space_subnet AS
+SELECT
+    space.uuid,
+    space.name,
+    provider_space.provider_id,
+    subnet.uuid AS subnet_uuid,
+    subnet.cidr AS subnet_cidr,
+    subnet.vlan_tag AS subnet_vlan_tag,
+    subnet.space_uuid AS subnet_space_uuid,
+    space.name AS subnet_space_name,
+    provider_subnet.provider_id AS subnet_provider_id,
+    provider_network.provider_network_id AS subnet_provider_network_id,
+    availability_zone.name AS subnet_az,
+    provider_space.provider_id AS subnet_provider_space_uuid,
+    ip_address_type.name AS preferred_address_type
+FROM
+    space
+LEFT JOIN provider_space ON space.uuid = provider_space.space_uuid
+LEFT JOIN subnet ON space.uuid = subnet.space_uuid
+LEFT JOIN provider_subnet ON subnet.uuid = provider_subnet.subnet_uuid
+LEFT JOIN provider_network_subnet ON subnet.uuid = provider_network_subnet.subnet_uuid
+LEFT JOIN provider_network ON provider_network_subnet.provider_network_uuid = provider_network.uuid
+LEFT JOIN availability_zone_subnet ON subnet.uuid = availability_zone_subnet.subnet_uuid
+LEFT JOIN availability_zone ON availability_zone_subnet.availability_zone_uuid = availability_zone.uuid
+LEFT JOIN space_address_preference ON space.uuid = space_address_preference.space_uuid
+LEFT JOIN ip_address_type ON space_address_preference.address_type_id = ip_address_type.id;
+
+-- In spaces with an address preference, addresses of the preferred type rank
+-- before any address of the other type, regardless of scope. Global IPv6
+-- addresses are public, so that otherwise a private IPv4 address would always
+-- be chosen over them on dual-stack machines.
+DROP VIEW v_unit_relation_network;
+
+CREATE VIEW v_unit_relation_network AS
+WITH unit_net_node AS (
+    SELECT
+        s.net_node_uuid,
+        u.uuid
+    FROM unit AS u
+    JOIN application AS a ON u.application_uuid = a.uuid
+    JOIN k8s_service AS s ON a.uuid = s.application_uuid
+    UNION
+    SELECT
+        net_node_uuid,
+        uuid
+    FROM unit
+),
+
+candidate AS (
+    SELECT
+        unn.uuid AS unit_uuid,
+        ipa.address_value,
+        ipa.device_uuid,
+        sn.space_uuid,
+        sn.cidr,
+        iact.name AS config_type_name,
+        iat.name AS type_name,
+        iao.name AS origin_name,
+        ias.name AS scope_name,
+        ipa.origin_id,
+        ipa.is_secondary,
+        lld.device_type_id,
+        CASE
+            WHEN sap.address_type_id IS NULL
+                THEN
+                    CASE
+                        WHEN ipa.scope_id = 2 /* local-cloud */
+                            AND ipa.type_id = 0 /* ipv4 */
+                            THEN 1
+                        WHEN ipa.scope_id = 2 /* local-cloud */
+                            AND ipa.type_id = 1 /* ipv6 */
+                            THEN 2
+                        WHEN ipa.scope_id IN (1 /* public */, 0 /* unknown */)
+                            AND ipa.type_id = 0 /* ipv4 */
+                            THEN 3
+                        WHEN ipa.scope_id IN (1 /* public */, 0 /* unknown */)
+                            AND ipa.type_id = 1 /* ipv6 */
+                            THEN 4
+                    END
+            ELSE
+                CASE
+                    WHEN ipa.scope_id = 2 /* local-cloud */
+                        THEN 1
+                    WHEN ipa.scope_id IN (1 /* public */, 0 /* unknown */)
+                        THEN 2
+                END
+                + CASE
+                    WHEN ipa.type_id = sap.address_type_id
+                        THEN 0
+                    ELSE 2
+                END
+        END AS scope_rank
+    FROM unit_net_node AS unn
+    JOIN ip_address AS ipa ON unn.net_node_uuid = ipa.net_node_uuid
+    JOIN link_layer_device AS lld ON ipa.device_uuid = lld.uuid
+    JOIN ip_address_config_type AS iact ON ipa.config_type_id = iact.id
+    JOIN ip_address_type AS iat ON ipa.type_id = iat.id
+    JOIN ip_address_origin AS iao ON ipa.origin_id = iao.id
+    JOIN ip_address_scope AS ias ON ipa.scope_id = ias.id
+    LEFT JOIN subnet AS sn ON ipa.subnet_uuid = sn.uuid
+    LEFT JOIN space_address_preference AS sap ON sn.space_uuid = sap.space_uuid
+)
+
+SELECT
+    candidate.unit_uuid,
+    candidate.address_value,
+    candidate.device_uuid,
+    candidate.space_uuid,
+    candidate.cidr,
+    candidate.config_type_name,
+    candidate.type_name,
+    candidate.origin_name,
+    candidate.scope_name,
+    candidate.scope_rank,
+    candidate.origin_id,
+    candidate.is_secondary,
+    candidate.device_type_id
+FROM candidate;
@@ -0,0 +1,16 @@
+-- storage_instance_encryption_key records the secret holding the key that
+-- the filesystem of a storage instance is encrypted with. The secret cannot
+-- be removed, nor its content changed, while the storage instance exists.
+CREATE TABLE storage_instance_encryption_key (
+    storage_instance_uuid TEXT NOT NULL PRIMARY KEY,
+    secret_id TEXT NOT NULL,
+    CONSTRAINT fk_storage_instance_encryption_key_storage_instance
+    FOREIGN KEY (storage_instance_uuid)
+    REFERENCES storage_instance (uuid),
+    CONSTRAINT fk_storage_instance_encryption_key_secret
+    FOREIGN KEY (secret_id)
+    REFERENCES secret (id)
+);
+
+CREATE UNIQUE INDEX idx_storage_instance_encryption_key_secret
+ON storage_instance_encryption_key (secret_id);
@@ -0,0 +1,10 @@
+-- storage_instance_snapshot_source records the provider snapshot that the
+-- volume, or filesystem when there is no volume, of a storage instance is
+-- to be created from.
+CREATE TABLE storage_instance_snapshot_source (
+    storage_instance_uuid TEXT NOT NULL PRIMARY KEY,
+    snapshot_id TEXT NOT NULL,
+    CONSTRAINT fk_storage_instance_snapshot_source_storage_instance
+    FOREIGN KEY (storage_instance_uuid)
+    REFERENCES storage_instance (uuid)
+);
@@ -86,6 +86,45 @@ END;`, columnName, namespaceID))
 	}
 }
 
+// ChangeLogTriggersForApplicationEgressRule generates the triggers for the
+// application_egress_rule table.
+func ChangeLogTriggersForApplicationEgressRule(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for ApplicationEgressRule
+INSERT INTO change_log_namespace VALUES (%[2]d, 'application_egress_rule', 'ApplicationEgressRule changes based on %[1]s');
+
+-- insert trigger for ApplicationEgressRule
+CREATE TRIGGER trg_log_application_egress_rule_insert
+AFTER INSERT ON application_egress_rule FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now', 'utc'));
+END;
+
+-- update trigger for ApplicationEgressRule
+CREATE TRIGGER trg_log_application_egress_rule_update
+AFTER UPDATE ON application_egress_rule FOR EACH ROW
+WHEN 
+	NEW.application_uuid != OLD.application_uuid OR
+	NEW.cidr != OLD.cidr OR
+	NEW.protocol_id != OLD.protocol_id OR
+	NEW.from_port != OLD.from_port OR
+	NEW.to_port != OLD.to_port 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;
+-- delete trigger for ApplicationEgressRule
+CREATE TRIGGER trg_log_application_egress_rule_delete
+AFTER DELETE ON application_egress_rule FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;`, columnName, namespaceID))
+	}
+}
+
 // ChangeLogTriggersForApplicationExposedEndpointCidr generates the triggers for the
 // application_exposed_endpoint_cidr table.
 func ChangeLogTriggersForApplicationExposedEndpointCidr(columnName string, namespaceID int) func() schema.Patch {
@@ -54,6 +54,42 @@ END;`, columnName, namespaceID))
 	}
 }
 
+// ChangeLogTriggersForSpaceAddressPreference generates the triggers for the
+// space_address_preference table.
+func ChangeLogTriggersForSpaceAddressPreference(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for SpaceAddressPreference
+INSERT INTO change_log_namespace VALUES (%[2]d, 'space_address_preference', 'SpaceAddressPreference changes based on %[1]s');
+
+-- insert trigger for SpaceAddressPreference
+CREATE TRIGGER trg_log_space_address_preference_insert
+AFTER INSERT ON space_address_preference FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now', 'utc'));
+END;
+
+-- update trigger for SpaceAddressPreference
+CREATE TRIGGER trg_log_space_address_preference_update
+AFTER UPDATE ON space_address_preference FOR EACH ROW
+WHEN 
+	NEW.space_uuid != OLD.space_uuid OR
+	NEW.address_type_id != OLD.address_type_id 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;
+-- delete trigger for SpaceAddressPreference
+CREATE TRIGGER trg_log_space_address_preference_delete
+AFTER DELETE ON space_address_preference FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;`, columnName, namespaceID))
+	}
+}
+
 // ChangeLogTriggersForSubnet generates the triggers for the
 // subnet table.
 func ChangeLogTriggersForSubnet(columnName string, namespaceID int) func() schema.Patch {
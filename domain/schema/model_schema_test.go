@@ -90,6 +90,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"application_config",
 		"application_constraint",
 		"application_controller",
+		"application_egress_rule",
 		"application_exposed_endpoint_cidr",
 		"application_exposed_endpoint_space",
 		"application_k8s_resources_managed",
@@ -271,6 +272,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		// Space
 		"provider_space",
 		"space",
+		"space_address_preference",
 
 		// Subnet
 		"availability_zone_subnet",
@@ -290,11 +292,14 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"model_storage_pool",
 		"storage_attachment",
 		"storage_filesystem_attachment",
+		"storage_filesystem_attachment_usage",
 		"storage_filesystem",
 		"storage_filesystem_status",
 		"storage_filesystem_status_value",
 		"storage_instance",
+		"storage_instance_encryption_key",
 		"storage_instance_filesystem",
+		"storage_instance_snapshot_source",
 		"storage_instance_volume",
 		"storage_kind",
 		"storage_pool_attribute",
@@ -385,6 +390,16 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"operation_task_status_value",
 		"operation_unit_task",
 		"operation_parameter",
+
+		// Desired bundle
+		"desired_bundle",
+
+		// Secret access log
+		"secret_access_log",
+
+		// Secret external source
+		"secret_external_source",
+		"secret_rotate_schedule",
 	)
 	got := readEntityNames(c, s.DB(), "table")
 	wanted := expected.Union(internalTableNames)
@@ -499,6 +514,10 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_log_application_endpoint_insert",
 		"trg_log_application_endpoint_update",
 
+		"trg_log_application_egress_rule_delete",
+		"trg_log_application_egress_rule_insert",
+		"trg_log_application_egress_rule_update",
+
 		"trg_log_application_exposed_endpoint_cidr_delete",
 		"trg_log_application_exposed_endpoint_cidr_insert",
 		"trg_log_application_exposed_endpoint_cidr_update",
@@ -589,6 +608,11 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 
 		"trg_log_custom_filesystem_provider_id_model_provisioning",
 
+		"trg_log_storage_instance_size_volume_model_provisioning",
+		"trg_log_storage_instance_size_filesystem_model_provisioning",
+		"trg_log_storage_instance_size_volume_machine_provisioning",
+		"trg_log_storage_instance_size_filesystem_machine_provisioning",
+
 		"trg_log_storage_filesystem_attachment_insert_life_machine_provisioning",
 		"trg_log_storage_filesystem_attachment_update_life_machine_provisioning",
 		"trg_log_storage_filesystem_attachment_delete_life_machine_provisioning",
@@ -634,6 +658,10 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_log_custom_storage_attachment_storage_volume_attachment_insert",
 		"trg_log_custom_storage_attachment_storage_volume_attachment_update",
 
+		"trg_log_space_address_preference_delete",
+		"trg_log_space_address_preference_insert",
+		"trg_log_space_address_preference_update",
+
 		"trg_log_subnet_delete",
 		"trg_log_subnet_insert",
 		"trg_log_subnet_update",
@@ -739,6 +767,18 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_log_application_status_insert",
 		"trg_log_application_status_update",
 
+		"trg_log_machine_status_delete",
+		"trg_log_machine_status_insert",
+		"trg_log_machine_status_update",
+
+		"trg_log_machine_cloud_instance_status_delete",
+		"trg_log_machine_cloud_instance_status_insert",
+		"trg_log_machine_cloud_instance_status_update",
+
+		"trg_log_relation_status_delete",
+		"trg_log_relation_status_insert",
+		"trg_log_relation_status_update",
+
 		"trg_log_custom_k8s_pod_status_delete",
 		"trg_log_custom_k8s_pod_status_insert",
 		"trg_log_custom_k8s_pod_status_update",
@@ -34,6 +34,22 @@ func customModelTriggers() []func() schema.Patch {
 			customNamespaceStorageFilesystemProviderIDModelProvisioning,
 		),
 
+		// Setup triggers for requested size changes of storage instances
+		// whose volumes or filesystems are model provisioned, so that the
+		// provisioner can resize them.
+		storageInstanceSizeModelProvisioningTrigger(
+			customNamespaceStorageVolumeLifeModelProvisioning,
+			customNamespaceStorageFilesystemLifeModelProvisioning,
+		),
+
+		// Setup triggers for requested size changes of storage instances
+		// whose volumes or filesystems are machine provisioned, so that the
+		// machine provisioners can resize them.
+		storageInstanceSizeMachineProvisioningTrigger(
+			customNamespaceStorageVolumeSizeMachineProvisioning,
+			customNamespaceStorageFilesystemSizeMachineProvisioning,
+		),
+
 		// Setup triggers for lifecycle events on filesystem attachments in the
 		// model that are machine provisioned.
 		storageAttachmentLifeMachineProvisioningTrigger(
@@ -381,6 +397,105 @@ END;
 	return func() schema.Patch { return schema.MakePatch(stmt) }
 }
 
+// storageInstanceSizeModelProvisioningTrigger creates triggers that emit
+// change events for the model provisioned volume and filesystem of a storage
+// instance when its requested size changes. The events are emitted into the
+// existing model provisioning life namespaces for volumes and filesystems, as
+// the storage provisioner reconciles the size of storage whenever it
+// processes an alive volume or filesystem.
+func storageInstanceSizeModelProvisioningTrigger(
+	volumeNamespace int,
+	filesystemNamespace int,
+) func() schema.Patch {
+	stmt := fmt.Sprintf(`
+-- update trigger for storage instance volumes.
+CREATE TRIGGER trg_log_storage_instance_size_volume_model_provisioning
+AFTER UPDATE ON storage_instance
+FOR EACH ROW
+    WHEN NEW.requested_size_mib != OLD.requested_size_mib
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    SELECT 2, %[1]d, sv.volume_id, DATETIME('now', 'utc')
+    FROM   storage_instance_volume siv
+    JOIN   storage_volume sv ON sv.uuid = siv.storage_volume_uuid
+    WHERE  siv.storage_instance_uuid = NEW.uuid
+    AND    sv.provision_scope_id = 0;
+END;
+
+-- update trigger for storage instance filesystems.
+CREATE TRIGGER trg_log_storage_instance_size_filesystem_model_provisioning
+AFTER UPDATE ON storage_instance
+FOR EACH ROW
+    WHEN NEW.requested_size_mib != OLD.requested_size_mib
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    SELECT 2, %[2]d, sf.filesystem_id, DATETIME('now', 'utc')
+    FROM   storage_instance_filesystem sif
+    JOIN   storage_filesystem sf ON sf.uuid = sif.storage_filesystem_uuid
+    WHERE  sif.storage_instance_uuid = NEW.uuid
+    AND    sf.provision_scope_id = 0;
+END;
+`, volumeNamespace, filesystemNamespace)
+
+	return func() schema.Patch { return schema.MakePatch(stmt) }
+}
+
+// storageInstanceSizeMachineProvisioningTrigger creates triggers that emit
+// change events for the machine provisioned volume and filesystem of a
+// storage instance when its requested size changes. Unlike the life
+// namespaces for machine provisioned storage, the change value is the volume
+// or filesystem id, as a machine provisioner needs to know which of its
+// entities to resize. Watchers only emit the ids of the entities that a
+// machine provisions.
+func storageInstanceSizeMachineProvisioningTrigger(
+	volumeNamespace int,
+	filesystemNamespace int,
+) func() schema.Patch {
+	stmt := fmt.Sprintf(`
+-- insert namespace for storage volume size changes.
+INSERT INTO change_log_namespace
+VALUES (%[1]d,
+        'storage_volume_size_machine_provisioning',
+        'requested size changes for storage volumes, that are machine provisioned');
+
+-- insert namespace for storage filesystem size changes.
+INSERT INTO change_log_namespace
+VALUES (%[2]d,
+        'storage_filesystem_size_machine_provisioning',
+        'requested size changes for storage filesystems, that are machine provisioned');
+
+-- update trigger for storage instance volumes.
+CREATE TRIGGER trg_log_storage_instance_size_volume_machine_provisioning
+AFTER UPDATE ON storage_instance
+FOR EACH ROW
+    WHEN NEW.requested_size_mib != OLD.requested_size_mib
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    SELECT 2, %[1]d, sv.volume_id, DATETIME('now', 'utc')
+    FROM   storage_instance_volume siv
+    JOIN   storage_volume sv ON sv.uuid = siv.storage_volume_uuid
+    WHERE  siv.storage_instance_uuid = NEW.uuid
+    AND    sv.provision_scope_id = 1;
+END;
+
+-- update trigger for storage instance filesystems.
+CREATE TRIGGER trg_log_storage_instance_size_filesystem_machine_provisioning
+AFTER UPDATE ON storage_instance
+FOR EACH ROW
+    WHEN NEW.requested_size_mib != OLD.requested_size_mib
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    SELECT 2, %[2]d, sf.filesystem_id, DATETIME('now', 'utc')
+    FROM   storage_instance_filesystem sif
+    JOIN   storage_filesystem sf ON sf.uuid = sif.storage_filesystem_uuid
+    WHERE  sif.storage_instance_uuid = NEW.uuid
+    AND    sf.provision_scope_id = 1;
+END;
+`, volumeNamespace, filesystemNamespace)
+
+	return func() schema.Patch { return schema.MakePatch(stmt) }
+}
+
 func filesystemAttachmentProviderIDModelProvisioningTrigger(
 	namespace int,
 ) func() schema.Patch {
@@ -536,6 +536,16 @@ VALUES (?, ?, ?, ?, 1, 0, 100, ?)`,
 	return storageInstanceUUID.String(), storageID
 }
 
+func (s *modelStorageSuite) changeStorageInstanceSize(
+	c *tc.C, storageInstanceUUID string, sizeMiB uint64,
+) {
+	_, err := s.DB().Exec(`
+UPDATE storage_instance
+SET    requested_size_mib = ?
+WHERE  uuid = ?`, sizeMiB, storageInstanceUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *modelStorageSuite) newStorageAttachment(
 	c *tc.C,
 	storageInstanceUUID string,
@@ -1274,6 +1284,57 @@ func (s *modelStorageSuite) TestDeleteMachineVolumeAttachmentPlanTrigger(c *tc.C
 	)
 }
 
+// TestMachineVolumeSizeTrigger tests that changing the requested size of a
+// storage instance results in one change event with the id of its machine
+// provision scoped volume.
+func (s *modelStorageSuite) TestMachineVolumeSizeTrigger(c *tc.C) {
+	_, charmUUID := s.newApplication(c, "foo")
+	poolUUID := s.newStoragePool(c, "foo", "foo", nil)
+	storageInstanceUUID, _ := s.newStorageInstanceWithCharmUUID(c, charmUUID, poolUUID)
+	vUUID, id := s.newMachineVolume(c)
+	s.newStorageInstanceVolume(c, storageInstanceUUID, vUUID)
+
+	s.changeStorageInstanceSize(c, storageInstanceUUID, 200)
+	s.assertChangeEvent(c, "storage_volume_size_machine_provisioning", id)
+}
+
+// TestMachineFilesystemSizeTrigger tests that changing the requested size of
+// a storage instance results in one change event with the id of its machine
+// provision scoped filesystem.
+func (s *modelStorageSuite) TestMachineFilesystemSizeTrigger(c *tc.C) {
+	_, charmUUID := s.newApplication(c, "foo")
+	poolUUID := s.newStoragePool(c, "foo", "foo", nil)
+	storageInstanceUUID, _ := s.newStorageInstanceWithCharmUUID(c, charmUUID, poolUUID)
+	fsUUID, id := s.newMachineFilesystem(c)
+	s.newStorageInstanceFilesystem(c, storageInstanceUUID, fsUUID)
+
+	s.changeStorageInstanceSize(c, storageInstanceUUID, 200)
+	s.assertChangeEvent(c, "storage_filesystem_size_machine_provisioning", id)
+}
+
+// TestModelFilesystemSizeTriggerNotMachine tests that changing the requested
+// size of a storage instance with a model provision scoped filesystem results
+// in no change event in the machine provisioning namespace.
+func (s *modelStorageSuite) TestModelFilesystemSizeTriggerNotMachine(c *tc.C) {
+	_, charmUUID := s.newApplication(c, "foo")
+	poolUUID := s.newStoragePool(c, "foo", "foo", nil)
+	storageInstanceUUID, _ := s.newStorageInstanceWithCharmUUID(c, charmUUID, poolUUID)
+	fsUUID, id := s.newModelFilesystem(c)
+	s.newStorageInstanceFilesystem(c, storageInstanceUUID, fsUUID)
+
+	s.changeStorageInstanceSize(c, storageInstanceUUID, 200)
+
+	nsID := s.getNamespaceID(c, "storage_filesystem_size_machine_provisioning")
+	var count int
+	err := s.DB().QueryRow(`
+SELECT COUNT(*)
+FROM   change_log
+WHERE  namespace_id = ?
+AND    changed = ?`, nsID, id).Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
+
 func (s *modelStorageSuite) TestCustomStorageAttachmentLifecycleUpdate(c *tc.C) {
 	appUUID, charmUUID := s.newApplication(c, "foo")
 	unitUUID, _ := s.newUnitWithNetNode(c, "foo/0", appUUID, charmUUID)
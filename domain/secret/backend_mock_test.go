@@ -82,6 +82,45 @@ func (c *MockSecretBackendStateAddSecretBackendReferenceCall) DoAndReturn(f func
 	return c
 }
 
+// EnsureModelSecretDataKey mocks base method.
+func (m *MockSecretBackendState) EnsureModelSecretDataKey(arg0 context.Context, arg1 model.UUID, arg2 secretbackend.WrappedDataKey) (secretbackend.WrappedDataKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureModelSecretDataKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(secretbackend.WrappedDataKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureModelSecretDataKey indicates an expected call of EnsureModelSecretDataKey.
+func (mr *MockSecretBackendStateMockRecorder) EnsureModelSecretDataKey(arg0, arg1, arg2 any) *MockSecretBackendStateEnsureModelSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureModelSecretDataKey", reflect.TypeOf((*MockSecretBackendState)(nil).EnsureModelSecretDataKey), arg0, arg1, arg2)
+	return &MockSecretBackendStateEnsureModelSecretDataKeyCall{Call: call}
+}
+
+// MockSecretBackendStateEnsureModelSecretDataKeyCall wrap *gomock.Call
+type MockSecretBackendStateEnsureModelSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateEnsureModelSecretDataKeyCall) Return(arg0 secretbackend.WrappedDataKey, arg1 error) *MockSecretBackendStateEnsureModelSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateEnsureModelSecretDataKeyCall) Do(f func(context.Context, model.UUID, secretbackend.WrappedDataKey) (secretbackend.WrappedDataKey, error)) *MockSecretBackendStateEnsureModelSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateEnsureModelSecretDataKeyCall) DoAndReturn(f func(context.Context, model.UUID, secretbackend.WrappedDataKey) (secretbackend.WrappedDataKey, error)) *MockSecretBackendStateEnsureModelSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetActiveModelSecretBackend mocks base method.
 func (m *MockSecretBackendState) GetActiveModelSecretBackend(arg0 context.Context, arg1 model.UUID) (string, *provider.ModelBackendConfig, error) {
 	m.ctrl.T.Helper()
@@ -161,6 +200,45 @@ func (c *MockSecretBackendStateGetModelSecretBackendDetailsCall) DoAndReturn(f f
 	return c
 }
 
+// GetModelSecretDataKey mocks base method.
+func (m *MockSecretBackendState) GetModelSecretDataKey(arg0 context.Context, arg1 model.UUID) (secretbackend.WrappedDataKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelSecretDataKey", arg0, arg1)
+	ret0, _ := ret[0].(secretbackend.WrappedDataKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelSecretDataKey indicates an expected call of GetModelSecretDataKey.
+func (mr *MockSecretBackendStateMockRecorder) GetModelSecretDataKey(arg0, arg1 any) *MockSecretBackendStateGetModelSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelSecretDataKey", reflect.TypeOf((*MockSecretBackendState)(nil).GetModelSecretDataKey), arg0, arg1)
+	return &MockSecretBackendStateGetModelSecretDataKeyCall{Call: call}
+}
+
+// MockSecretBackendStateGetModelSecretDataKeyCall wrap *gomock.Call
+type MockSecretBackendStateGetModelSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateGetModelSecretDataKeyCall) Return(arg0 secretbackend.WrappedDataKey, arg1 error) *MockSecretBackendStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateGetModelSecretDataKeyCall) Do(f func(context.Context, model.UUID) (secretbackend.WrappedDataKey, error)) *MockSecretBackendStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateGetModelSecretDataKeyCall) DoAndReturn(f func(context.Context, model.UUID) (secretbackend.WrappedDataKey, error)) *MockSecretBackendStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretBackendNamesByUUID mocks base method.
 func (m *MockSecretBackendState) GetSecretBackendNamesByUUID(arg0 context.Context) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +278,45 @@ func (c *MockSecretBackendStateGetSecretBackendNamesByUUIDCall) DoAndReturn(f fu
 	return c
 }
 
+// GetSecretEncryptionMasterKeySource mocks base method.
+func (m *MockSecretBackendState) GetSecretEncryptionMasterKeySource(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretEncryptionMasterKeySource", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretEncryptionMasterKeySource indicates an expected call of GetSecretEncryptionMasterKeySource.
+func (mr *MockSecretBackendStateMockRecorder) GetSecretEncryptionMasterKeySource(arg0 any) *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretEncryptionMasterKeySource", reflect.TypeOf((*MockSecretBackendState)(nil).GetSecretEncryptionMasterKeySource), arg0)
+	return &MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall{Call: call}
+}
+
+// MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall wrap *gomock.Call
+type MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall) Return(arg0 string, arg1 error) *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall) Do(f func(context.Context) (string, error)) *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall) DoAndReturn(f func(context.Context) (string, error)) *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListSecretBackendsForModel mocks base method.
 func (m *MockSecretBackendState) ListSecretBackendsForModel(arg0 context.Context, arg1 model.UUID, arg2 bool) ([]*secretbackend.SecretBackend, error) {
 	m.ctrl.T.Helper()
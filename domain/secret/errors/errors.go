@@ -41,4 +41,9 @@ const (
 
 	// MissingSecretBackendID describes an error that occurs when importing a secret and the backend doesn't exist.
 	MissingSecretBackendID = errors.ConstError("missing secret backend id")
+
+	// SecretInUse describes an error that occurs when a secret cannot be
+	// removed or changed because it holds the encryption key of storage
+	// that still exists.
+	SecretInUse = errors.ConstError("secret is in use")
 )
@@ -17,6 +17,9 @@ const (
 	RotateMonthly
 	RotateQuarterly
 	RotateYearly
+	// RotateCustom is an interval or cron schedule, recorded in the
+	// secret_rotate_schedule table.
+	RotateCustom
 )
 
 // MarshallRotatePolicy converts a secret rotate policy to a db rotate policy id.
@@ -38,5 +41,8 @@ func MarshallRotatePolicy(policy *coresecrets.RotatePolicy) RotatePolicy {
 	case coresecrets.RotateYearly:
 		return RotateYearly
 	}
+	if policy.IsCustom() {
+		return RotateCustom
+	}
 	return RotateNever
 }
@@ -46,9 +46,14 @@ func (s *rotatePolicySuite) TestRotatePolicyDBValues(c *tc.C) {
 		RotateMonthly:   "monthly",
 		RotateQuarterly: "quarterly",
 		RotateYearly:    "yearly",
+		RotateCustom:    "custom",
 	})
 	// Also check the core secret enums match.
-	for _, p := range dbValues {
+	for id, p := range dbValues {
+		if id == RotateCustom {
+			continue
+		}
+		c.Assert(coresecrets.RotatePolicy(p).IsCustom(), tc.IsFalse)
 		c.Assert(coresecrets.RotatePolicy(p).IsValid(), tc.IsTrue)
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/trace"
 	"github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/uuid"
 )
@@ -31,7 +32,9 @@ func (s *SecretService) DeleteObsoleteUserSecretRevisions(ctx context.Context) e
 
 // DeleteSecret schedules removal of the specified secret or specific revisions.
 // If revisions is nil or empty, the entire secret will be removed.
-// It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
+// It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor,
+// and an error satisfying [secreterrors.SecretInUse] if the secret holds the encryption
+// key of existing storage.
 func (s *SecretService) DeleteSecret(ctx context.Context, uri *secrets.URI, params secret.DeleteSecretParams) error {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
@@ -42,6 +45,10 @@ func (s *SecretService) DeleteSecret(ctx context.Context, uri *secrets.URI, para
 	}
 
 	return withCaveat(ctx, func(innerCtx context.Context) error {
+		if err := s.checkNotStorageEncryptionKey(ctx, uri); err != nil {
+			return errors.Capture(err)
+		}
+
 		// validate if provided revisions exist before scheduling job
 		if len(params.Revisions) > 0 {
 			for _, revision := range params.Revisions {
@@ -65,3 +72,20 @@ func (s *SecretService) DeleteSecret(ctx context.Context, uri *secrets.URI, para
 		return nil
 	})
 }
+
+// checkNotStorageEncryptionKey returns an error satisfying
+// [secreterrors.SecretInUse] if the secret holds the encryption key of a
+// storage instance that still exists, since the storage cannot be unlocked
+// without it.
+func (s *SecretService) checkNotStorageEncryptionKey(ctx context.Context, uri *secrets.URI) error {
+	storageID, err := s.secretState.GetEncryptionKeyStorageID(ctx, uri)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if storageID != "" {
+		return errors.Errorf(
+			"secret %q holds the encryption key of storage %q", uri.ID, storageID,
+		).Add(secreterrors.SecretInUse)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	coresecrets "github.com/juju/juju/core/secrets"
 	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
 )
 
 func (s *serviceSuite) TestDeleteObsoleteUserSecretRevisions(c *tc.C) {
@@ -31,6 +32,7 @@ func (s *serviceSuite) TestDeleteSecret(c *tc.C) {
 		SubjectTypeID: domainsecret.SubjectUnit,
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
+	s.state.EXPECT().GetEncryptionKeyStorageID(gomock.Any(), uri).Return("", nil)
 	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return("", nil)
 	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 2).Return("", nil)
 	s.state.EXPECT().ScheduleUserSecretRemoval(gomock.Any(), gomock.Any(), uri, []int{1, 2}, gomock.Any()).Return(nil)
@@ -44,3 +46,38 @@ func (s *serviceSuite) TestDeleteSecret(c *tc.C) {
 	})
 	c.Assert(err, tc.ErrorIsNil)
 }
+
+func (s *serviceSuite) TestDeleteSecretStorageEncryptionKey(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.state.EXPECT().GetEncryptionKeyStorageID(gomock.Any(), uri).Return("data/0", nil)
+
+	err := s.service.DeleteSecret(c.Context(), uri, domainsecret.DeleteSecretParams{
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.ModelAccessor,
+			ID:   s.modelID.String(),
+		},
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretInUse)
+	c.Assert(err, tc.ErrorMatches, `secret ".*" holds the encryption key of storage "data/0"`)
+}
+
+func (s *serviceSuite) TestUpdateUserSecretContentStorageEncryptionKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretExternalSource(gomock.Any(), uri).Return(nil, nil)
+	s.state.EXPECT().GetEncryptionKeyStorageID(gomock.Any(), uri).Return("data/0", nil)
+
+	err := s.service.UpdateUserSecret(c.Context(), uri, UpdateUserSecretParams{
+		Data: map[string]string{"foo": "bar"},
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretInUse)
+}
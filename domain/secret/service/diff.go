@@ -0,0 +1,94 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// DiffSecretRevisions returns the keys of the secret content which were
+// added, removed or changed between two revisions, sorted by key. The values
+// of the keys are only returned if params.RevealValues is true, so the
+// changes can be listed without handing out the content.
+//
+// It returns an error satisfying [secreterrors.SecretRevisionNotFound] if
+// either revision does not exist, and [coreerrors.NotValid] if the revisions
+// are the same.
+func (s *SecretService) DiffSecretRevisions(
+	ctx context.Context, uri *secrets.URI, params DiffSecretRevisionsParams,
+) ([]domainsecret.SecretKeyChange, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if params.FromRevision == params.ToRevision {
+		return nil, errors.Errorf("comparing revision %d with itself %w", params.FromRevision, coreerrors.NotValid)
+	}
+	from, err := s.revisionValues(ctx, uri, params.FromRevision)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	to, err := s.revisionValues(ctx, uri, params.ToRevision)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if params.RevealValues {
+		for _, rev := range []int{params.FromRevision, params.ToRevision} {
+			if err := s.recordSecretAccess(ctx, uri, rev, params.Accessor); err != nil {
+				return nil, errors.Capture(err)
+			}
+		}
+	}
+
+	var changes []domainsecret.SecretKeyChange
+	for key, fromVal := range from {
+		toVal, ok := to[key]
+		switch {
+		case !ok:
+			changes = append(changes, domainsecret.SecretKeyChange{
+				Key: key, Change: domainsecret.SecretKeyRemoved, From: fromVal,
+			})
+		case toVal != fromVal:
+			changes = append(changes, domainsecret.SecretKeyChange{
+				Key: key, Change: domainsecret.SecretKeyChanged, From: fromVal, To: toVal,
+			})
+		}
+	}
+	for key, toVal := range to {
+		if _, ok := from[key]; !ok {
+			changes = append(changes, domainsecret.SecretKeyChange{
+				Key: key, Change: domainsecret.SecretKeyAdded, To: toVal,
+			})
+		}
+	}
+	slices.SortFunc(changes, func(a, b domainsecret.SecretKeyChange) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	if !params.RevealValues {
+		for i := range changes {
+			changes[i].From, changes[i].To = "", ""
+		}
+	}
+	return changes, nil
+}
+
+// revisionValues returns the encoded content of the secret revision. The read
+// is not recorded since the values are only handed out if they are revealed.
+func (s *SecretService) revisionValues(ctx context.Context, uri *secrets.URI, rev int) (map[string]string, error) {
+	value, err := s.getSecretContentFromBackend(ctx, uri, rev)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if value == nil || value.IsEmpty() {
+		return map[string]string{}, nil
+	}
+	return value.EncodedValues(), nil
+}
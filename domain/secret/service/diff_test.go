@@ -0,0 +1,93 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+func (s *serviceSuite) expectDiffRevisions(uri *coresecrets.URI) {
+	s.service.activeBackendID = "backend-id"
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(
+		coresecrets.SecretData{"foo": "YmFy", "gone": "b2xk", "same": "c2FtZQ=="}, nil, nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 3).Return(
+		coresecrets.SecretData{"foo": "YmF6", "new": "bmV3", "same": "c2FtZQ=="}, nil, nil)
+}
+
+func (s *serviceSuite) TestDiffSecretRevisions(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.expectDiffRevisions(uri)
+
+	changes, err := s.service.DiffSecretRevisions(c.Context(), uri, DiffSecretRevisionsParams{
+		FromRevision: 1,
+		ToRevision:   3,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(changes, tc.DeepEquals, []domainsecret.SecretKeyChange{
+		{Key: "foo", Change: domainsecret.SecretKeyChanged},
+		{Key: "gone", Change: domainsecret.SecretKeyRemoved},
+		{Key: "new", Change: domainsecret.SecretKeyAdded},
+	})
+}
+
+func (s *serviceSuite) TestDiffSecretRevisionsRevealValues(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.expectDiffRevisions(uri)
+	accessor := domainsecret.SecretAccessor{Kind: domainsecret.UserAccessor, ID: "fred"}
+	for _, rev := range []int{1, 3} {
+		s.state.EXPECT().RecordSecretAccess(gomock.Any(), domainsecret.SecretAccessLogEntry{
+			SecretID:   uri.ID,
+			Revision:   rev,
+			Accessor:   accessor,
+			AccessedAt: s.clock.Now(),
+		}).Return(nil)
+	}
+
+	changes, err := s.service.DiffSecretRevisions(c.Context(), uri, DiffSecretRevisionsParams{
+		Accessor:     accessor,
+		FromRevision: 1,
+		ToRevision:   3,
+		RevealValues: true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(changes, tc.DeepEquals, []domainsecret.SecretKeyChange{
+		{Key: "foo", Change: domainsecret.SecretKeyChanged, From: "YmFy", To: "YmF6"},
+		{Key: "gone", Change: domainsecret.SecretKeyRemoved, From: "b2xk"},
+		{Key: "new", Change: domainsecret.SecretKeyAdded, To: "bmV3"},
+	})
+}
+
+func (s *serviceSuite) TestDiffSecretRevisionsSameRevision(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.DiffSecretRevisions(c.Context(), coresecrets.NewURI(), DiffSecretRevisionsParams{
+		FromRevision: 2,
+		ToRevision:   2,
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestDiffSecretRevisionsNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.activeBackendID = "backend-id"
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, nil, secreterrors.SecretRevisionNotFound)
+
+	_, err := s.service.DiffSecretRevisions(c.Context(), uri, DiffSecretRevisionsParams{
+		FromRevision: 1,
+		ToRevision:   3,
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+// getExternalContentReader returns a reader for content held outside of the
+// paths managed by Juju in the named secret backend. Only the secret backend
+// the model is configured to use can be read, so that a model can't read
+// content from the backends of other models. It returns an error satisfying
+// [backenderrors.Forbidden] if the backend is not the model's backend, or
+// [backenderrors.NotSupported] if the backend cannot read external content.
+func (s *SecretService) getExternalContentReader(ctx context.Context, backendName string) (provider.ExternalContentReader, error) {
+	modelUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting model UUID: %w", err)
+	}
+	modelBackend, err := s.secretBackendState.GetModelSecretBackendDetails(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Errorf("getting model secret backend: %w", err)
+	}
+	if modelBackend.SecretBackendName != backendName {
+		return nil, errors.Errorf("reading external content from secret backend %q not used by model %q",
+			backendName, modelBackend.ModelName).Add(backenderrors.Forbidden)
+	}
+	_, cfg, err := s.secretBackendState.GetActiveModelSecretBackend(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Errorf("getting model secret backend config: %w", err)
+	}
+
+	backend, err := s.getBackend(cfg)
+	if err != nil {
+		return nil, errors.Errorf("acquiring secret backend %q: %w", backendName, err)
+	}
+	reader, ok := backend.(provider.ExternalContentReader)
+	if !ok {
+		return nil, errors.Errorf("reading external content from %q backend %q",
+			cfg.BackendType, backendName).Add(backenderrors.NotSupported)
+	}
+	return reader, nil
+}
+
+// readExternalSource returns the content and checksum of a secret synced
+// from the specified source.
+func readExternalSource(
+	ctx context.Context, reader provider.ExternalContentReader, source secrets.ExternalSource,
+) (secrets.SecretData, string, error) {
+	value, err := reader.GetExternalContent(ctx, source.Path)
+	if err != nil {
+		return nil, "", errors.Errorf("reading external source %q: %w", source, err)
+	}
+	data, err := source.Select(value)
+	if err != nil {
+		return nil, "", errors.Capture(err)
+	}
+	checksum, err := secrets.NewSecretValue(data).Checksum()
+	if err != nil {
+		return nil, "", errors.Errorf("computing checksum of external source %q: %w", source, err)
+	}
+	return data, checksum, nil
+}
+
+// SyncExternalSecrets reads the content of each user secret with an
+// external source, creating a new revision of any secret whose source
+// content has changed. Failures to sync a secret are logged and do not
+// prevent the other secrets being synced. It returns the number of secrets
+// updated.
+func (s *SecretService) SyncExternalSecrets(ctx context.Context) (int, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	sources, err := s.secretState.ListSecretExternalSources(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	if len(sources) == 0 {
+		return 0, nil
+	}
+	modelUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return 0, errors.Errorf("getting model UUID: %w", err)
+	}
+	accessor := domainsecret.SecretAccessor{
+		Kind: domainsecret.ModelAccessor,
+		ID:   modelUUID.String(),
+	}
+
+	readers := make(map[string]provider.ExternalContentReader)
+	updated := 0
+	for _, src := range sources {
+		reader, ok := readers[src.Source.Backend]
+		if !ok {
+			reader, err = s.getExternalContentReader(ctx, src.Source.Backend)
+			if err != nil {
+				s.logger.Warningf(ctx, "cannot sync secret %q from %q: %v", src.URI.ID, src.Source, err)
+				continue
+			}
+			readers[src.Source.Backend] = reader
+		}
+		data, checksum, err := readExternalSource(ctx, reader, src.Source)
+		if err != nil {
+			s.logger.Warningf(ctx, "cannot sync secret %q: %v", src.URI.ID, err)
+			continue
+		}
+		if checksum == src.Checksum {
+			continue
+		}
+		_, err = s.updateUserSecret(ctx, src.URI, UpdateUserSecretParams{
+			Accessor: accessor,
+			Data:     data,
+			Checksum: checksum,
+		})
+		if err != nil {
+			s.logger.Warningf(ctx, "cannot sync secret %q from %q: %v", src.URI.ID, src.Source, err)
+			continue
+		}
+		s.logger.Infof(ctx, "synced new revision of secret %q from %q", src.URI.ID, src.Source)
+		updated++
+	}
+	return updated, nil
+}
+
+// checkNotExternallySourced returns an error satisfying
+// [coreerrors.NotValid] if the content of the secret is synced from an
+// external source.
+func (s *SecretService) checkNotExternallySourced(ctx context.Context, uri *secrets.URI) error {
+	source, err := s.secretState.GetSecretExternalSource(ctx, uri)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if source != nil {
+		return errors.Errorf("content of secret %q is synced from %q %w", uri.ID, source, coreerrors.NotValid)
+	}
+	return nil
+}
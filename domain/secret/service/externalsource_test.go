@@ -0,0 +1,194 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+// externalContentBackend is a secrets backend which can read external
+// content.
+type externalContentBackend struct {
+	*MockSecretsBackend
+	content map[string]coresecrets.SecretValue
+}
+
+func (b externalContentBackend) GetExternalContent(_ context.Context, path string) (coresecrets.SecretValue, error) {
+	value, ok := b.content[path]
+	if !ok {
+		return nil, errors.Errorf("content at %q %w", path, coreerrors.NotFound)
+	}
+	return value, nil
+}
+
+// expectExternalSourceBackends sets up the model to use the myvault
+// backend, returning its config.
+func (s *serviceSuite) expectExternalSourceBackends(reader provider.SecretsBackend, times int) *provider.ModelBackendConfig {
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil).AnyTimes()
+	s.secretBackendState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), s.modelID).Return(
+		secretbackend.ModelSecretBackend{
+			ControllerUUID:    coretesting.ControllerTag.Id(),
+			ModelID:           s.modelID,
+			ModelName:         "some-model",
+			SecretBackendID:   "vault-id",
+			SecretBackendName: "myvault",
+		}, nil,
+	).Times(times)
+	cfg := &provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      s.modelID.String(),
+		ModelName:      "some-model",
+		BackendConfig: provider.BackendConfig{
+			BackendType: "vault",
+			Config:      map[string]any{"endpoint": "http://vault"},
+		},
+	}
+	s.secretBackendState.EXPECT().GetActiveModelSecretBackend(gomock.Any(), s.modelID).Return(
+		"vault-id", cfg, nil,
+	).MaxTimes(times)
+	s.secretsBackendProvider.EXPECT().NewBackend(cfg).Return(reader, nil).MaxTimes(1)
+	return cfg
+}
+
+func (s *serviceSuite) TestCreateUserSecretWithSourceAndContent(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateUserSecret(c.Context(), coresecrets.NewURI(), CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Data: map[string]string{"foo": "bar"},
+		},
+		Version: 1,
+		Source:  &coresecrets.ExternalSource{Backend: "myvault", Path: "kv/prod/db"},
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestCreateUserSecretWithSourceNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectExternalSourceBackends(s.secretsBackend, 1)
+
+	err := s.service.CreateUserSecret(c.Context(), coresecrets.NewURI(), CreateUserSecretParams{
+		Version: 1,
+		Source:  &coresecrets.ExternalSource{Backend: "myvault", Path: "kv/prod/db"},
+	})
+	c.Assert(err, tc.ErrorIs, backenderrors.NotSupported)
+}
+
+func (s *serviceSuite) TestCreateUserSecretWithSourceOtherBackend(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectExternalSourceBackends(s.secretsBackend, 1)
+
+	// A model can't read external content from the backends of other
+	// models.
+	err := s.service.CreateUserSecret(c.Context(), coresecrets.NewURI(), CreateUserSecretParams{
+		Version: 1,
+		Source:  &coresecrets.ExternalSource{Backend: "other", Path: "kv/prod/db"},
+	})
+	c.Assert(err, tc.ErrorIs, backenderrors.Forbidden)
+}
+
+func (s *serviceSuite) TestUpdateUserSecretContentWithSource(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretExternalSource(gomock.Any(), uri).Return(
+		&coresecrets.ExternalSource{Backend: "myvault", Path: "kv/prod/db"}, nil,
+	)
+
+	err := s.service.UpdateUserSecret(c.Context(), uri, UpdateUserSecretParams{
+		Data: map[string]string{"foo": "bar"},
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, tc.ErrorMatches, `content of secret ".*" is synced from "myvault:kv/prod/db" not valid`)
+}
+
+func (s *serviceSuite) TestSyncExternalSecrets(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unchangedValue := coresecrets.NewSecretValue(map[string]string{"password": "czNjcmV0"})
+	unchangedChecksum, err := unchangedValue.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+	changedValue := coresecrets.NewSecretValue(map[string]string{"token": "bmV3"})
+	changedChecksum, err := changedValue.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+
+	reader := externalContentBackend{
+		MockSecretsBackend: s.secretsBackend,
+		content: map[string]coresecrets.SecretValue{
+			"kv/prod/db":  coresecrets.NewSecretValue(map[string]string{"username": "YWRtaW4=", "password": "czNjcmV0"}),
+			"kv/prod/api": changedValue,
+		},
+	}
+	unchangedURI := coresecrets.NewURI()
+	changedURI := coresecrets.NewURI()
+	missingURI := coresecrets.NewURI()
+	s.state.EXPECT().ListSecretExternalSources(gomock.Any()).Return([]domainsecret.SecretExternalSource{{
+		URI:      unchangedURI,
+		Source:   coresecrets.ExternalSource{Backend: "myvault", Path: "kv/prod/db", Key: "password"},
+		Checksum: unchangedChecksum,
+	}, {
+		URI:      changedURI,
+		Source:   coresecrets.ExternalSource{Backend: "myvault", Path: "kv/prod/api"},
+		Checksum: "old-checksum",
+	}, {
+		URI:    missingURI,
+		Source: coresecrets.ExternalSource{Backend: "other", Path: "kv/prod/db"},
+	}}, nil)
+	// The model's backend is resolved once to read the sources and once to
+	// save the changed content. The other backend isn't the model's
+	// backend, so it isn't read.
+	activeConfig := s.expectExternalSourceBackends(reader, 2)
+
+	// The changed secret is updated by the model.
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), changedURI, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.secretsBackendProvider.EXPECT().Initialise(activeConfig).Return(nil)
+	s.state.EXPECT().ListGrantedSecretsForBackend(gomock.Any(), "vault-id", gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.secretsBackendProvider.EXPECT().RestrictedConfig(
+		gomock.Any(), activeConfig, true, false, gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(&activeConfig.BackendConfig, nil)
+	s.secretsBackendProvider.EXPECT().NewBackend(activeConfig).Return(s.secretsBackend, nil)
+	s.state.EXPECT().GetLatestRevision(gomock.Any(), changedURI).Return(1, nil)
+	s.secretsBackend.EXPECT().SaveContent(gomock.Any(), changedURI, 2, changedValue).
+		Return("", errors.Errorf("not supported %w", coreerrors.NotSupported))
+	s.secretBackendState.EXPECT().AddSecretBackendReference(
+		gomock.Any(), nil, s.modelID, s.fakeUUID.String(), changedURI.ID,
+	).Return(func() error { return nil }, nil)
+	s.state.EXPECT().UpdateSecret(gomock.Any(), changedURI, domainsecret.UpsertSecretParams{
+		Data:       coresecrets.SecretData{"token": "bmV3"},
+		Checksum:   changedChecksum,
+		RevisionID: new(s.fakeUUID.String()),
+		UpdateTime: s.clock.Now(),
+	}).Return(nil)
+
+	updated, err := s.service.SyncExternalSecrets(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(updated, tc.Equals, 1)
+}
+
+func (s *serviceSuite) TestSyncExternalSecretsNone(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ListSecretExternalSources(gomock.Any()).Return(nil, nil)
+
+	updated, err := s.service.SyncExternalSecrets(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(updated, tc.Equals, 0)
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/juju/juju/core/trace"
 	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/domain/secretbackend"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
 )
 
 // ImportSecrets saves the supplied secret details to the model.
@@ -88,6 +90,9 @@ func (s *SecretService) importSecretWithRevisions(
 	if md.RotatePolicy != "" && md.RotatePolicy != coresecrets.RotateNever {
 		policy := secret.MarshallRotatePolicy(&md.RotatePolicy)
 		metaParams.RotatePolicy = &policy
+		if policy == secret.RotateCustom {
+			metaParams.RotateSchedule = md.RotatePolicy.String()
+		}
 	}
 
 	// Solve ownership.
@@ -125,11 +130,16 @@ func (s *SecretService) importSecretWithRevisions(
 		}
 
 		if rev.ValueRef == nil {
-			if data, ok := content[rev.Revision]; ok {
-				params.Data = data
-			} else {
+			data, ok := content[rev.Revision]
+			if !ok {
 				return errors.Errorf("missing content for secret %s/%d", md.URI.ID, rev.Revision)
 			}
+			if params.Data, err = secretbackend.SealSecretContent(ctx, s.secretBackendState, modelID, encryption.SecretRevision{
+				SecretID:   md.URI.ID,
+				RevisionID: revisionID.String(),
+			}, data); err != nil {
+				return errors.Capture(err)
+			}
 		}
 
 		rollBack, err := s.secretBackendState.AddSecretBackendReference(ctx, params.ValueRef, modelID,
@@ -67,6 +67,7 @@ type State interface {
 	GetRotatePolicy(ctx context.Context, uri *secrets.URI) (secrets.RotatePolicy, error)
 	GetRotationExpiryInfo(ctx context.Context, uri *secrets.URI) (*domainsecret.RotationExpiryInfo, error)
 	GetSecretRevisionID(ctx context.Context, uri *secrets.URI, revision int) (string, error)
+	GetSecretRevision(ctx context.Context, revisionID string) (int, error)
 	ChangeSecretBackend(
 		ctx context.Context, revisionID uuid.UUID, valueRef *secrets.ValueRef, data secrets.SecretData,
 	) error
@@ -78,6 +79,11 @@ type State interface {
 	UpdateSecret(ctx context.Context, uri *secrets.URI, secret domainsecret.UpsertSecretParams) error
 	ScheduleUserSecretRemoval(ctx context.Context, removalUUID string, uri *secrets.URI, revisions []int, when time.Time) error
 	ScheduleObsoleteUserSecretRevisionsPruning(ctx context.Context, jobUUID string, when time.Time) error
+	RecordSecretAccess(ctx context.Context, entry domainsecret.SecretAccessLogEntry) error
+	GetSecretAccessLog(ctx context.Context, filter domainsecret.SecretAccessLogFilter) ([]domainsecret.SecretAccessLogEntry, error)
+	GetSecretExternalSource(ctx context.Context, uri *secrets.URI) (*secrets.ExternalSource, error)
+	GetEncryptionKeyStorageID(ctx context.Context, uri *secrets.URI) (string, error)
+	ListSecretExternalSources(ctx context.Context) ([]domainsecret.SecretExternalSource, error)
 
 	// For watching obsolete secret revision changes.
 	InitialWatchStatementForObsoleteRevision(
@@ -153,6 +159,7 @@ type SecretBackendReferenceMutator interface {
 // with secret backends in the controller database.
 type SecretBackendState interface {
 	SecretBackendReferenceMutator
+	secretbackend.DataKeyState
 
 	// GetModelSecretBackendDetails returns the details of the secret
 	// backend that the input model is configured to use.
@@ -0,0 +1,306 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
+	"github.com/juju/juju/internal/secrets/provider"
+	"github.com/juju/juju/internal/secrets/provider/juju"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// migrationBackend is a secret backend taking part in a migration. The
+// content of revisions on the internal backend is held in the model
+// database, so it has no provider backend.
+type migrationBackend struct {
+	id      string
+	name    string
+	backend provider.SecretsBackend
+}
+
+func (b migrationBackend) internal() bool {
+	return b.backend == nil
+}
+
+// holds returns true if the revision content is stored in the backend.
+func (b migrationBackend) holds(rev *secrets.SecretRevisionMetadata) bool {
+	if b.internal() {
+		return rev.ValueRef == nil
+	}
+	return rev.ValueRef != nil && rev.ValueRef.BackendID == b.id
+}
+
+// MigrateSecrets moves the content of secret revisions between secret
+// backends. The content of each revision held in the source backend is
+// copied to the target backend and read back, and only once its checksum
+// matches the source is the revision changed to refer to the target. The
+// content is then removed from the source backend. A revision that cannot
+// be migrated is left referring to the source backend, and does not prevent
+// other revisions from being migrated.
+//
+// On a dry run, the content of each revision is read from the source backend
+// but nothing is written.
+//
+// It returns an error satisfying [backenderrors.NotFound] if either backend
+// does not exist, and [secreterrors.SecretNotFound] if a secret is specified
+// which does not exist.
+func (s *SecretService) MigrateSecrets(
+	ctx context.Context, params domainsecret.MigrateSecretsParams,
+) ([]domainsecret.SecretRevisionMigration, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if params.FromBackend == params.ToBackend {
+		return nil, errors.Errorf("source and target backend are both %q %w", params.FromBackend, coreerrors.NotValid)
+	}
+	modelUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting model UUID: %w", err)
+	}
+	from, to, err := s.getMigrationBackends(ctx, modelUUID, params.FromBackend, params.ToBackend)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var (
+		metadata  []*secrets.SecretMetadata
+		revisions [][]*secrets.SecretRevisionMetadata
+	)
+	if params.URI != nil {
+		md, revs, err := s.secretState.GetSecretByURI(ctx, *params.URI, nil)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		metadata, revisions = []*secrets.SecretMetadata{md}, [][]*secrets.SecretRevisionMetadata{revs}
+	} else {
+		metadata, revisions, err = s.secretState.ListAllSecrets(ctx)
+		if err != nil {
+			return nil, errors.Errorf("listing secrets: %w", err)
+		}
+	}
+
+	var result []domainsecret.SecretRevisionMigration
+	for i, md := range metadata {
+		for _, rev := range revisions[i] {
+			if !from.holds(rev) {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return result, errors.Capture(err)
+			}
+			migration := domainsecret.SecretRevisionMigration{
+				URI:      md.URI,
+				Revision: rev.Revision,
+			}
+			migration.Checksum, err = s.migrateSecretRevision(ctx, modelUUID, md.URI, rev, from, to, params.DryRun)
+			switch {
+			case err != nil:
+				s.logger.Warningf(ctx, "cannot migrate secret %s/%d from %q to %q: %v",
+					md.URI.ID, rev.Revision, from.name, to.name, err)
+				migration.Status = domainsecret.SecretMigrationFailed
+				migration.Error = err
+			case params.DryRun:
+				migration.Status = domainsecret.SecretMigrationPlanned
+			default:
+				migration.Status = domainsecret.SecretMigrationMigrated
+			}
+			result = append(result, migration)
+		}
+	}
+	return result, nil
+}
+
+// getMigrationBackends returns the named source and target backends of a
+// migration, using the admin config of each backend.
+func (s *SecretService) getMigrationBackends(
+	ctx context.Context, modelUUID coremodel.UUID, fromName, toName string,
+) (migrationBackend, migrationBackend, error) {
+	modelBackend, err := s.secretBackendState.GetModelSecretBackendDetails(ctx, modelUUID)
+	if err != nil {
+		return migrationBackend{}, migrationBackend{}, errors.Errorf("getting model secret backend: %w", err)
+	}
+	backends, err := s.secretBackendState.ListSecretBackendsForModel(ctx, modelUUID, true)
+	if err != nil {
+		return migrationBackend{}, migrationBackend{}, errors.Errorf("listing secret backends: %w", err)
+	}
+
+	get := func(name string) (migrationBackend, error) {
+		for _, b := range backends {
+			if b.Name != name {
+				continue
+			}
+			result := migrationBackend{id: b.ID, name: b.Name}
+			if b.BackendType == juju.BackendType {
+				return result, nil
+			}
+			result.backend, err = s.getBackend(&provider.ModelBackendConfig{
+				ControllerUUID: modelBackend.ControllerUUID,
+				ModelUUID:      modelUUID.String(),
+				ModelName:      modelBackend.ModelName,
+				BackendConfig: provider.BackendConfig{
+					BackendType: b.BackendType,
+					Config:      b.Config,
+				},
+			})
+			if err != nil {
+				return migrationBackend{}, errors.Errorf("acquiring secret backend %q: %w", name, err)
+			}
+			return result, nil
+		}
+		return migrationBackend{}, errors.Errorf("secret backend %q", name).Add(backenderrors.NotFound)
+	}
+
+	from, err := get(fromName)
+	if err != nil {
+		return migrationBackend{}, migrationBackend{}, errors.Capture(err)
+	}
+	to, err := get(toName)
+	if err != nil {
+		return migrationBackend{}, migrationBackend{}, errors.Capture(err)
+	}
+	return from, to, nil
+}
+
+// migrateSecretRevision moves the content of a secret revision from one
+// backend to another, returning the checksum of the content. On a dry run,
+// the content is only read.
+func (s *SecretService) migrateSecretRevision(
+	ctx context.Context, modelUUID coremodel.UUID, uri *secrets.URI, rev *secrets.SecretRevisionMetadata,
+	from, to migrationBackend, dryRun bool,
+) (_ string, errOut error) {
+	value, err := s.readRevisionContent(ctx, uri, rev, from)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	checksum, err := value.Checksum()
+	if err != nil {
+		return "", errors.Errorf("computing checksum: %w", err)
+	}
+	if dryRun {
+		return checksum, nil
+	}
+	revisionIDStr, err := s.secretState.GetSecretRevisionID(ctx, uri, rev.Revision)
+	if err != nil {
+		return checksum, errors.Capture(err)
+	}
+	revisionID, err := uuid.UUIDFromString(revisionIDStr)
+	if err != nil {
+		return checksum, errors.Capture(err)
+	}
+
+	// Copy the content to the target backend and check that what was
+	// written matches what was read.
+	var (
+		valueRef *secrets.ValueRef
+		data     secrets.SecretData
+	)
+	if to.internal() {
+		sealedRev := encryption.SecretRevision{
+			SecretID:   uri.ID,
+			RevisionID: revisionIDStr,
+		}
+		data = value.EncodedValues()
+		if data, err = secretbackend.SealSecretContent(ctx, s.secretBackendState, modelUUID, sealedRev, data); err != nil {
+			return checksum, errors.Capture(err)
+		}
+		opened, err := secretbackend.OpenSecretContent(ctx, s.secretBackendState, modelUUID, sealedRev, data)
+		if err != nil {
+			return checksum, errors.Errorf("verifying content: %w", err)
+		}
+		if err := verifyChecksum(secrets.NewSecretValue(opened), checksum); err != nil {
+			return checksum, errors.Capture(err)
+		}
+	} else {
+		revID, err := to.backend.SaveContent(ctx, uri, rev.Revision, value)
+		if err != nil {
+			return checksum, errors.Errorf("saving content to %q: %w", to.name, err)
+		}
+		defer func() {
+			if errOut == nil {
+				return
+			}
+			if err := to.backend.DeleteContent(ctx, revID); err != nil && !errors.Is(err, secreterrors.SecretRevisionNotFound) {
+				s.logger.Warningf(ctx, "failed to delete secret %q from %q: %v", revID, to.name, err)
+			}
+		}()
+		copied, err := to.backend.GetContent(ctx, revID)
+		if err != nil {
+			return checksum, errors.Errorf("verifying content: %w", err)
+		}
+		if err := verifyChecksum(copied, checksum); err != nil {
+			return checksum, errors.Capture(err)
+		}
+		valueRef = &secrets.ValueRef{
+			BackendID:  to.id,
+			RevisionID: revID,
+		}
+	}
+
+	// Only now that the copy is verified does the revision refer to it.
+	rollBack, err := s.secretBackendState.UpdateSecretBackendReference(
+		ctx, valueRef, modelUUID, revisionIDStr, uri.ID)
+	if err != nil {
+		return checksum, errors.Capture(err)
+	}
+	if err := s.secretState.ChangeSecretBackend(ctx, revisionID, valueRef, data); err != nil {
+		if err := rollBack(); err != nil {
+			s.logger.Warningf(ctx, "failed to roll back secret reference count: %v", err)
+		}
+		return checksum, errors.Errorf("changing secret backend: %w", err)
+	}
+
+	if !from.internal() {
+		err := from.backend.DeleteContent(ctx, rev.ValueRef.RevisionID)
+		if err != nil && !errors.Is(err, secreterrors.SecretRevisionNotFound) {
+			s.logger.Warningf(ctx, "failed to delete secret %s/%d from %q: %v", uri.ID, rev.Revision, from.name, err)
+		}
+	}
+	return checksum, nil
+}
+
+// readRevisionContent reads the content of a secret revision from the
+// backend holding it.
+func (s *SecretService) readRevisionContent(
+	ctx context.Context, uri *secrets.URI, rev *secrets.SecretRevisionMetadata, from migrationBackend,
+) (secrets.SecretValue, error) {
+	if !from.internal() {
+		value, err := from.backend.GetContent(ctx, rev.ValueRef.RevisionID)
+		if err != nil {
+			return nil, errors.Errorf("reading content from %q: %w", from.name, err)
+		}
+		return value, nil
+	}
+	data, _, err := s.secretState.GetSecretValue(ctx, uri, rev.Revision)
+	if err != nil {
+		return nil, errors.Errorf("reading content: %w", err)
+	}
+	if data, err = s.openContent(ctx, uri, rev.Revision, data); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return secrets.NewSecretValue(data), nil
+}
+
+// verifyChecksum returns an error if the checksum of the value is not the
+// expected checksum.
+func verifyChecksum(value secrets.SecretValue, expected string) error {
+	checksum, err := value.Checksum()
+	if err != nil {
+		return errors.Errorf("computing checksum of copied content: %w", err)
+	}
+	if checksum != expected {
+		return errors.Errorf("checksum of copied content %q does not match %q", checksum, expected)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+	"github.com/juju/juju/internal/secrets/provider/juju"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+func (s *serviceSuite) expectMigrationBackends() {
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil).AnyTimes()
+	s.secretBackendState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), s.modelID).Return(
+		secretbackend.ModelSecretBackend{
+			ControllerUUID:  coretesting.ControllerTag.Id(),
+			ModelID:         s.modelID,
+			ModelName:       "some-model",
+			SecretBackendID: "backend-id",
+		}, nil,
+	)
+	s.secretBackendState.EXPECT().ListSecretBackendsForModel(gomock.Any(), s.modelID, true).Return(
+		[]*secretbackend.SecretBackend{{
+			ID:          "internal-id",
+			Name:        juju.BackendName,
+			BackendType: juju.BackendType,
+		}, {
+			ID:          "vault-id",
+			Name:        "myvault",
+			BackendType: "vault",
+			Config:      map[string]any{"endpoint": "http://vault"},
+		}}, nil,
+	)
+	s.secretsBackendProvider.EXPECT().NewBackend(&provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      s.modelID.String(),
+		ModelName:      "some-model",
+		BackendConfig: provider.BackendConfig{
+			BackendType: "vault",
+			Config:      map[string]any{"endpoint": "http://vault"},
+		},
+	}).Return(s.secretsBackend, nil).MaxTimes(1)
+}
+
+func (s *serviceSuite) TestMigrateSecretsSameBackend(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.MigrateSecrets(c.Context(), domainsecret.MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "myvault",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestMigrateSecretsBackendNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectMigrationBackends()
+
+	_, err := s.service.MigrateSecrets(c.Context(), domainsecret.MigrateSecretsParams{
+		FromBackend: juju.BackendName,
+		ToBackend:   "other",
+	})
+	c.Assert(err, tc.ErrorIs, backenderrors.NotFound)
+}
+
+func (s *serviceSuite) TestMigrateSecretsDryRun(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectMigrationBackends()
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().ListAllSecrets(gomock.Any()).Return(
+		[]*coresecrets.SecretMetadata{{URI: uri}},
+		[][]*coresecrets.SecretRevisionMetadata{{
+			{Revision: 1},
+			{Revision: 2, ValueRef: &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-2"}},
+		}}, nil,
+	)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "YmFy"}, nil, nil)
+
+	result, err := s.service.MigrateSecrets(c.Context(), domainsecret.MigrateSecretsParams{
+		FromBackend: juju.BackendName,
+		ToBackend:   "myvault",
+		DryRun:      true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	checksum, err := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"}).Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, []domainsecret.SecretRevisionMigration{{
+		URI:      uri,
+		Revision: 1,
+		Status:   domainsecret.SecretMigrationPlanned,
+		Checksum: checksum,
+	}})
+}
+
+func (s *serviceSuite) TestMigrateSecretsToExternalBackend(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectMigrationBackends()
+	uri := coresecrets.NewURI()
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	s.state.EXPECT().GetSecretByURI(gomock.Any(), *uri, nil).Return(
+		&coresecrets.SecretMetadata{URI: uri},
+		[]*coresecrets.SecretRevisionMetadata{{Revision: 1}}, nil,
+	)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "YmFy"}, nil, nil)
+	s.secretsBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, value).Return("rev-1", nil)
+	s.secretsBackend.EXPECT().GetContent(gomock.Any(), "rev-1").Return(value, nil)
+	valueRef := &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-1"}
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.secretBackendState.EXPECT().UpdateSecretBackendReference(
+		gomock.Any(), valueRef, s.modelID, s.fakeUUID.String(), uri.ID).Return(func() error { return nil }, nil)
+	s.state.EXPECT().ChangeSecretBackend(gomock.Any(), s.fakeUUID, valueRef, nil).Return(nil)
+
+	result, err := s.service.MigrateSecrets(c.Context(), domainsecret.MigrateSecretsParams{
+		FromBackend: juju.BackendName,
+		ToBackend:   "myvault",
+		URI:         uri,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	checksum, err := value.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, []domainsecret.SecretRevisionMigration{{
+		URI:      uri,
+		Revision: 1,
+		Status:   domainsecret.SecretMigrationMigrated,
+		Checksum: checksum,
+	}})
+}
+
+func (s *serviceSuite) TestMigrateSecretsToInternalBackend(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectMigrationBackends()
+	uri := coresecrets.NewURI()
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	s.state.EXPECT().ListAllSecrets(gomock.Any()).Return(
+		[]*coresecrets.SecretMetadata{{URI: uri}},
+		[][]*coresecrets.SecretRevisionMetadata{{
+			{Revision: 1, ValueRef: &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-1"}},
+		}}, nil,
+	)
+	s.secretsBackend.EXPECT().GetContent(gomock.Any(), "rev-1").Return(value, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.secretBackendState.EXPECT().UpdateSecretBackendReference(
+		gomock.Any(), nil, s.modelID, s.fakeUUID.String(), uri.ID).Return(func() error { return nil }, nil)
+	s.state.EXPECT().ChangeSecretBackend(
+		gomock.Any(), s.fakeUUID, nil, coresecrets.SecretData{"foo": "YmFy"}).Return(nil)
+	s.secretsBackend.EXPECT().DeleteContent(gomock.Any(), "rev-1").Return(nil)
+
+	result, err := s.service.MigrateSecrets(c.Context(), domainsecret.MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   juju.BackendName,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 1)
+	c.Check(result[0].Status, tc.Equals, domainsecret.SecretMigrationMigrated)
+	c.Check(result[0].Error, tc.IsNil)
+}
+
+func (s *serviceSuite) TestMigrateSecretsChecksumMismatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectMigrationBackends()
+	uri := coresecrets.NewURI()
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	s.state.EXPECT().ListAllSecrets(gomock.Any()).Return(
+		[]*coresecrets.SecretMetadata{{URI: uri}},
+		[][]*coresecrets.SecretRevisionMetadata{{{Revision: 1}, {Revision: 2}}}, nil,
+	)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "YmFy"}, nil, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.secretsBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, value).Return("rev-1", nil)
+	s.secretsBackend.EXPECT().GetContent(gomock.Any(), "rev-1").Return(
+		coresecrets.NewSecretValue(map[string]string{"foo": "YmF6"}), nil)
+	s.secretsBackend.EXPECT().DeleteContent(gomock.Any(), "rev-1").Return(nil)
+
+	// A failure to migrate one revision does not stop the others.
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 2).Return(nil, nil, errors.New("boom"))
+
+	result, err := s.service.MigrateSecrets(c.Context(), domainsecret.MigrateSecretsParams{
+		FromBackend: juju.BackendName,
+		ToBackend:   "myvault",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 2)
+	c.Check(result[0].Status, tc.Equals, domainsecret.SecretMigrationFailed)
+	c.Check(result[0].Error, tc.ErrorMatches, `checksum of copied content .* does not match .*`)
+	c.Check(result[1].Status, tc.Equals, domainsecret.SecretMigrationFailed)
+	c.Check(result[1].Error, tc.ErrorMatches, `reading content: boom`)
+}
@@ -525,6 +525,45 @@ func (c *MockStateGetConsumedSecretURIsWithChangesCall) DoAndReturn(f func(conte
 	return c
 }
 
+// GetEncryptionKeyStorageID mocks base method.
+func (m *MockState) GetEncryptionKeyStorageID(arg0 context.Context, arg1 *secrets.URI) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptionKeyStorageID", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptionKeyStorageID indicates an expected call of GetEncryptionKeyStorageID.
+func (mr *MockStateMockRecorder) GetEncryptionKeyStorageID(arg0, arg1 any) *MockStateGetEncryptionKeyStorageIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptionKeyStorageID", reflect.TypeOf((*MockState)(nil).GetEncryptionKeyStorageID), arg0, arg1)
+	return &MockStateGetEncryptionKeyStorageIDCall{Call: call}
+}
+
+// MockStateGetEncryptionKeyStorageIDCall wrap *gomock.Call
+type MockStateGetEncryptionKeyStorageIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetEncryptionKeyStorageIDCall) Return(arg0 string, arg1 error) *MockStateGetEncryptionKeyStorageIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetEncryptionKeyStorageIDCall) Do(f func(context.Context, *secrets.URI) (string, error)) *MockStateGetEncryptionKeyStorageIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetEncryptionKeyStorageIDCall) DoAndReturn(f func(context.Context, *secrets.URI) (string, error)) *MockStateGetEncryptionKeyStorageIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetLatestRevision mocks base method.
 func (m *MockState) GetLatestRevision(arg0 context.Context, arg1 *secrets.URI) (int, error) {
 	m.ctrl.T.Helper()
@@ -993,6 +1032,45 @@ func (c *MockStateGetSecretAccessCall) DoAndReturn(f func(context.Context, *secr
 	return c
 }
 
+// GetSecretAccessLog mocks base method.
+func (m *MockState) GetSecretAccessLog(arg0 context.Context, arg1 secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretAccessLog", arg0, arg1)
+	ret0, _ := ret[0].([]secret.SecretAccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretAccessLog indicates an expected call of GetSecretAccessLog.
+func (mr *MockStateMockRecorder) GetSecretAccessLog(arg0, arg1 any) *MockStateGetSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretAccessLog", reflect.TypeOf((*MockState)(nil).GetSecretAccessLog), arg0, arg1)
+	return &MockStateGetSecretAccessLogCall{Call: call}
+}
+
+// MockStateGetSecretAccessLogCall wrap *gomock.Call
+type MockStateGetSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretAccessLogCall) Return(arg0 []secret.SecretAccessLogEntry, arg1 error) *MockStateGetSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretAccessLogCall) Do(f func(context.Context, secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error)) *MockStateGetSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretAccessLogCall) DoAndReturn(f func(context.Context, secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error)) *MockStateGetSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretAccessRelationScope mocks base method.
 func (m *MockState) GetSecretAccessRelationScope(arg0 context.Context, arg1 *secrets.URI, arg2 secret.AccessParams) (string, error) {
 	m.ctrl.T.Helper()
@@ -1112,6 +1190,45 @@ func (c *MockStateGetSecretConsumerCall) DoAndReturn(f func(context.Context, *se
 	return c
 }
 
+// GetSecretExternalSource mocks base method.
+func (m *MockState) GetSecretExternalSource(arg0 context.Context, arg1 *secrets.URI) (*secrets.ExternalSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretExternalSource", arg0, arg1)
+	ret0, _ := ret[0].(*secrets.ExternalSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretExternalSource indicates an expected call of GetSecretExternalSource.
+func (mr *MockStateMockRecorder) GetSecretExternalSource(arg0, arg1 any) *MockStateGetSecretExternalSourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretExternalSource", reflect.TypeOf((*MockState)(nil).GetSecretExternalSource), arg0, arg1)
+	return &MockStateGetSecretExternalSourceCall{Call: call}
+}
+
+// MockStateGetSecretExternalSourceCall wrap *gomock.Call
+type MockStateGetSecretExternalSourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretExternalSourceCall) Return(arg0 *secrets.ExternalSource, arg1 error) *MockStateGetSecretExternalSourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretExternalSourceCall) Do(f func(context.Context, *secrets.URI) (*secrets.ExternalSource, error)) *MockStateGetSecretExternalSourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretExternalSourceCall) DoAndReturn(f func(context.Context, *secrets.URI) (*secrets.ExternalSource, error)) *MockStateGetSecretExternalSourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretGrants mocks base method.
 func (m *MockState) GetSecretGrants(arg0 context.Context, arg1 *secrets.URI, arg2 secrets.SecretRole) ([]secret.GrantDetails, error) {
 	m.ctrl.T.Helper()
@@ -1151,6 +1268,45 @@ func (c *MockStateGetSecretGrantsCall) DoAndReturn(f func(context.Context, *secr
 	return c
 }
 
+// GetSecretRevision mocks base method.
+func (m *MockState) GetSecretRevision(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretRevision", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretRevision indicates an expected call of GetSecretRevision.
+func (mr *MockStateMockRecorder) GetSecretRevision(arg0, arg1 any) *MockStateGetSecretRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretRevision", reflect.TypeOf((*MockState)(nil).GetSecretRevision), arg0, arg1)
+	return &MockStateGetSecretRevisionCall{Call: call}
+}
+
+// MockStateGetSecretRevisionCall wrap *gomock.Call
+type MockStateGetSecretRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretRevisionCall) Return(arg0 int, arg1 error) *MockStateGetSecretRevisionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretRevisionCall) Do(f func(context.Context, string) (int, error)) *MockStateGetSecretRevisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretRevisionCall) DoAndReturn(f func(context.Context, string) (int, error)) *MockStateGetSecretRevisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretRevisionID mocks base method.
 func (m *MockState) GetSecretRevisionID(arg0 context.Context, arg1 *secrets.URI, arg2 int) (string, error) {
 	m.ctrl.T.Helper()
@@ -1903,6 +2059,45 @@ func (c *MockStateListGrantedSecretsForBackendCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// ListSecretExternalSources mocks base method.
+func (m *MockState) ListSecretExternalSources(arg0 context.Context) ([]secret.SecretExternalSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretExternalSources", arg0)
+	ret0, _ := ret[0].([]secret.SecretExternalSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretExternalSources indicates an expected call of ListSecretExternalSources.
+func (mr *MockStateMockRecorder) ListSecretExternalSources(arg0 any) *MockStateListSecretExternalSourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretExternalSources", reflect.TypeOf((*MockState)(nil).ListSecretExternalSources), arg0)
+	return &MockStateListSecretExternalSourcesCall{Call: call}
+}
+
+// MockStateListSecretExternalSourcesCall wrap *gomock.Call
+type MockStateListSecretExternalSourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListSecretExternalSourcesCall) Return(arg0 []secret.SecretExternalSource, arg1 error) *MockStateListSecretExternalSourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListSecretExternalSourcesCall) Do(f func(context.Context) ([]secret.SecretExternalSource, error)) *MockStateListSecretExternalSourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListSecretExternalSourcesCall) DoAndReturn(f func(context.Context) ([]secret.SecretExternalSource, error)) *MockStateListSecretExternalSourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListSecretsByLabels mocks base method.
 func (m *MockState) ListSecretsByLabels(arg0 context.Context, arg1 secret.Labels, arg2 *int) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
@@ -2058,6 +2253,44 @@ func (c *MockStateNamespaceForWatchSecretRevisionObsoleteCall) DoAndReturn(f fun
 	return c
 }
 
+// RecordSecretAccess mocks base method.
+func (m *MockState) RecordSecretAccess(arg0 context.Context, arg1 secret.SecretAccessLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSecretAccess", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSecretAccess indicates an expected call of RecordSecretAccess.
+func (mr *MockStateMockRecorder) RecordSecretAccess(arg0, arg1 any) *MockStateRecordSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSecretAccess", reflect.TypeOf((*MockState)(nil).RecordSecretAccess), arg0, arg1)
+	return &MockStateRecordSecretAccessCall{Call: call}
+}
+
+// MockStateRecordSecretAccessCall wrap *gomock.Call
+type MockStateRecordSecretAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRecordSecretAccessCall) Return(arg0 error) *MockStateRecordSecretAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRecordSecretAccessCall) Do(f func(context.Context, secret.SecretAccessLogEntry) error) *MockStateRecordSecretAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRecordSecretAccessCall) DoAndReturn(f func(context.Context, secret.SecretAccessLogEntry) error) *MockStateRecordSecretAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RevokeAccess mocks base method.
 func (m *MockState) RevokeAccess(arg0 context.Context, arg1 *secrets.URI, arg2 secret.RevokeParams) error {
 	m.ctrl.T.Helper()
@@ -2348,6 +2581,45 @@ func (c *MockSecretBackendStateAddSecretBackendReferenceCall) DoAndReturn(f func
 	return c
 }
 
+// EnsureModelSecretDataKey mocks base method.
+func (m *MockSecretBackendState) EnsureModelSecretDataKey(arg0 context.Context, arg1 model.UUID, arg2 secretbackend.WrappedDataKey) (secretbackend.WrappedDataKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureModelSecretDataKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(secretbackend.WrappedDataKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureModelSecretDataKey indicates an expected call of EnsureModelSecretDataKey.
+func (mr *MockSecretBackendStateMockRecorder) EnsureModelSecretDataKey(arg0, arg1, arg2 any) *MockSecretBackendStateEnsureModelSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureModelSecretDataKey", reflect.TypeOf((*MockSecretBackendState)(nil).EnsureModelSecretDataKey), arg0, arg1, arg2)
+	return &MockSecretBackendStateEnsureModelSecretDataKeyCall{Call: call}
+}
+
+// MockSecretBackendStateEnsureModelSecretDataKeyCall wrap *gomock.Call
+type MockSecretBackendStateEnsureModelSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateEnsureModelSecretDataKeyCall) Return(arg0 secretbackend.WrappedDataKey, arg1 error) *MockSecretBackendStateEnsureModelSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateEnsureModelSecretDataKeyCall) Do(f func(context.Context, model.UUID, secretbackend.WrappedDataKey) (secretbackend.WrappedDataKey, error)) *MockSecretBackendStateEnsureModelSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateEnsureModelSecretDataKeyCall) DoAndReturn(f func(context.Context, model.UUID, secretbackend.WrappedDataKey) (secretbackend.WrappedDataKey, error)) *MockSecretBackendStateEnsureModelSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetActiveModelSecretBackend mocks base method.
 func (m *MockSecretBackendState) GetActiveModelSecretBackend(arg0 context.Context, arg1 model.UUID) (string, *provider.ModelBackendConfig, error) {
 	m.ctrl.T.Helper()
@@ -2427,6 +2699,45 @@ func (c *MockSecretBackendStateGetModelSecretBackendDetailsCall) DoAndReturn(f f
 	return c
 }
 
+// GetModelSecretDataKey mocks base method.
+func (m *MockSecretBackendState) GetModelSecretDataKey(arg0 context.Context, arg1 model.UUID) (secretbackend.WrappedDataKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelSecretDataKey", arg0, arg1)
+	ret0, _ := ret[0].(secretbackend.WrappedDataKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelSecretDataKey indicates an expected call of GetModelSecretDataKey.
+func (mr *MockSecretBackendStateMockRecorder) GetModelSecretDataKey(arg0, arg1 any) *MockSecretBackendStateGetModelSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelSecretDataKey", reflect.TypeOf((*MockSecretBackendState)(nil).GetModelSecretDataKey), arg0, arg1)
+	return &MockSecretBackendStateGetModelSecretDataKeyCall{Call: call}
+}
+
+// MockSecretBackendStateGetModelSecretDataKeyCall wrap *gomock.Call
+type MockSecretBackendStateGetModelSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateGetModelSecretDataKeyCall) Return(arg0 secretbackend.WrappedDataKey, arg1 error) *MockSecretBackendStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateGetModelSecretDataKeyCall) Do(f func(context.Context, model.UUID) (secretbackend.WrappedDataKey, error)) *MockSecretBackendStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateGetModelSecretDataKeyCall) DoAndReturn(f func(context.Context, model.UUID) (secretbackend.WrappedDataKey, error)) *MockSecretBackendStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretBackendNamesByUUID mocks base method.
 func (m *MockSecretBackendState) GetSecretBackendNamesByUUID(arg0 context.Context) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -2466,6 +2777,45 @@ func (c *MockSecretBackendStateGetSecretBackendNamesByUUIDCall) DoAndReturn(f fu
 	return c
 }
 
+// GetSecretEncryptionMasterKeySource mocks base method.
+func (m *MockSecretBackendState) GetSecretEncryptionMasterKeySource(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretEncryptionMasterKeySource", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretEncryptionMasterKeySource indicates an expected call of GetSecretEncryptionMasterKeySource.
+func (mr *MockSecretBackendStateMockRecorder) GetSecretEncryptionMasterKeySource(arg0 any) *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretEncryptionMasterKeySource", reflect.TypeOf((*MockSecretBackendState)(nil).GetSecretEncryptionMasterKeySource), arg0)
+	return &MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall{Call: call}
+}
+
+// MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall wrap *gomock.Call
+type MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall) Return(arg0 string, arg1 error) *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall) Do(f func(context.Context) (string, error)) *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall) DoAndReturn(f func(context.Context) (string, error)) *MockSecretBackendStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListSecretBackendsForModel mocks base method.
 func (m *MockSecretBackendState) ListSecretBackendsForModel(arg0 context.Context, arg1 model.UUID, arg2 bool) ([]*secretbackend.SecretBackend, error) {
 	m.ctrl.T.Helper()
@@ -14,6 +14,11 @@ import (
 type CreateUserSecretParams struct {
 	UpdateUserSecretParams
 	Version int
+
+	// Source, if set, is the backend path the content of the secret is
+	// synced from. The content is read from the source and must not be
+	// specified.
+	Source *secrets.ExternalSource
 }
 
 // UpdateUserSecretParams are used to update a user secret.
@@ -28,6 +33,27 @@ type UpdateUserSecretParams struct {
 	AutoPrune   *bool
 }
 
+// RollbackUserSecretParams are used to roll back a user secret to the
+// content of an earlier revision.
+type RollbackUserSecretParams struct {
+	Accessor secret.SecretAccessor
+
+	Revision int
+}
+
+// DiffSecretRevisionsParams are used to compare the content of two
+// revisions of a secret.
+type DiffSecretRevisionsParams struct {
+	Accessor     secret.SecretAccessor
+	FromRevision int
+	ToRevision   int
+
+	// RevealValues is true if the values of the changed keys are to be
+	// returned as well as the keys. Revealed reads are recorded in the
+	// secret access log against the accessor.
+	RevealValues bool
+}
+
 // SecretRotatedParams are used to mark a secret as rotated.
 type SecretRotatedParams struct {
 	Accessor secret.SecretAccessor
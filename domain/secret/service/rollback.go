@@ -0,0 +1,64 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/internal/errors"
+)
+
+// RollbackUserSecret creates a new revision of a user secret with the
+// content of an earlier revision, returning the new revision. Earlier
+// revisions are left unchanged.
+//
+// It returns an error satisfying [secreterrors.SecretRevisionNotFound] if
+// the revision does not exist, and [coreerrors.NotValid] if the revision is
+// the latest revision or has the same content as the latest revision.
+// It returns [secreterrors.PermissionDenied] if the secret cannot be managed
+// by the accessor.
+func (s *SecretService) RollbackUserSecret(ctx context.Context, uri *secrets.URI, params RollbackUserSecretParams) (int, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	md, err := s.secretState.GetSecret(ctx, uri)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	if params.Revision == md.LatestRevision {
+		return 0, errors.Errorf("revision %d is already the latest revision %w", params.Revision, coreerrors.NotValid)
+	}
+
+	value, err := s.getSecretContentFromBackend(ctx, uri, params.Revision)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	checksum, err := value.Checksum()
+	if err != nil {
+		return 0, errors.Errorf("computing checksum: %w", err)
+	}
+	if checksum == md.LatestRevisionChecksum {
+		return 0, errors.Errorf("revision %d has the same content as the latest revision %d %w",
+			params.Revision, md.LatestRevision, coreerrors.NotValid)
+	}
+
+	revisionID, err := s.updateUserSecretChecked(ctx, uri, UpdateUserSecretParams{
+		Accessor: params.Accessor,
+		Data:     value.EncodedValues(),
+		Checksum: checksum,
+	})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	// Other updates may have been made since the metadata was read, so
+	// look up the revision which the update created.
+	revision, err := s.secretState.GetSecretRevision(ctx, revisionID)
+	if err != nil {
+		return 0, errors.Errorf("getting rolled back revision of secret %q: %w", uri.ID, err)
+	}
+	return revision, nil
+}
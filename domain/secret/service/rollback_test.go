@@ -0,0 +1,135 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+func (s *serviceSuite) modelAccessor() domainsecret.SecretAccessor {
+	return domainsecret.SecretAccessor{
+		Kind: domainsecret.ModelAccessor,
+		ID:   s.modelID.String(),
+	}
+}
+
+func (s *serviceSuite) TestRollbackUserSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.activeBackendID = "backend-id"
+
+	uri := coresecrets.NewURI()
+	old := coresecrets.SecretData{"foo": "YmFy"}
+	checksum, err := coresecrets.NewSecretValue(old).Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.state.EXPECT().GetSecret(gomock.Any(), uri).Return(&coresecrets.SecretMetadata{
+		URI:                    uri,
+		LatestRevision:         3,
+		LatestRevisionChecksum: "checksum-3",
+	}, nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(old, nil, nil)
+
+	// The content is saved as a new revision on the internal backend.
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil).AnyTimes()
+	s.state.EXPECT().GetSecretExternalSource(gomock.Any(), uri).Return(nil, nil)
+	s.state.EXPECT().GetEncryptionKeyStorageID(gomock.Any(), uri).Return("", nil)
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.secretBackendState.EXPECT().GetActiveModelSecretBackend(gomock.Any(), s.modelID).Return(
+		"backend-id", &provider.ModelBackendConfig{}, nil)
+	s.secretsBackendProvider.EXPECT().Initialise(gomock.Any()).Return(nil)
+	s.state.EXPECT().ListGrantedSecretsForBackend(gomock.Any(), "backend-id", gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.secretsBackendProvider.EXPECT().RestrictedConfig(
+		gomock.Any(), gomock.Any(), true, false, gomock.Any(), gomock.Any(), gomock.Any()).Return(&provider.BackendConfig{}, nil)
+	s.secretsBackendProvider.EXPECT().NewBackend(gomock.Any()).Return(s.secretsBackend, nil)
+	s.state.EXPECT().GetLatestRevision(gomock.Any(), uri).Return(3, nil)
+	s.secretsBackend.EXPECT().SaveContent(gomock.Any(), uri, 4, coresecrets.NewSecretValue(old)).
+		Return("", errors.Errorf("not supported %w", coreerrors.NotSupported))
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String(), uri.ID).Return(
+		func() error { return nil }, nil)
+	s.state.EXPECT().UpdateSecret(gomock.Any(), uri, domainsecret.UpsertSecretParams{
+		Data:       old,
+		Checksum:   checksum,
+		RevisionID: new(s.fakeUUID.String()),
+		UpdateTime: s.clock.Now(),
+	}).Return(nil)
+	// A concurrent update created revision 4 first, so the rolled back
+	// content is revision 5.
+	s.state.EXPECT().GetSecretRevision(gomock.Any(), s.fakeUUID.String()).Return(5, nil)
+
+	revision, err := s.service.RollbackUserSecret(c.Context(), uri, RollbackUserSecretParams{
+		Accessor: s.modelAccessor(),
+		Revision: 1,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(revision, tc.Equals, 5)
+}
+
+func (s *serviceSuite) TestRollbackUserSecretLatestRevision(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecret(gomock.Any(), uri).Return(&coresecrets.SecretMetadata{
+		URI:            uri,
+		LatestRevision: 3,
+	}, nil)
+
+	_, err := s.service.RollbackUserSecret(c.Context(), uri, RollbackUserSecretParams{
+		Accessor: s.modelAccessor(),
+		Revision: 3,
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, tc.ErrorMatches, "revision 3 is already the latest revision not valid")
+}
+
+func (s *serviceSuite) TestRollbackUserSecretSameContent(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.activeBackendID = "backend-id"
+
+	uri := coresecrets.NewURI()
+	old := coresecrets.SecretData{"foo": "YmFy"}
+	checksum, err := coresecrets.NewSecretValue(old).Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetSecret(gomock.Any(), uri).Return(&coresecrets.SecretMetadata{
+		URI:                    uri,
+		LatestRevision:         3,
+		LatestRevisionChecksum: checksum,
+	}, nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 2).Return(old, nil, nil)
+
+	_, err = s.service.RollbackUserSecret(c.Context(), uri, RollbackUserSecretParams{
+		Accessor: s.modelAccessor(),
+		Revision: 2,
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, tc.ErrorMatches, "revision 2 has the same content as the latest revision 3 not valid")
+}
+
+func (s *serviceSuite) TestRollbackUserSecretRevisionNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.activeBackendID = "backend-id"
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecret(gomock.Any(), uri).Return(&coresecrets.SecretMetadata{
+		URI:            uri,
+		LatestRevision: 3,
+	}, nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 7).Return(nil, nil, secreterrors.SecretRevisionNotFound)
+
+	_, err := s.service.RollbackUserSecret(c.Context(), uri, RollbackUserSecretParams{
+		Accessor: s.modelAccessor(),
+		Revision: 7,
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
@@ -18,8 +18,10 @@ import (
 	coreunit "github.com/juju/juju/core/unit"
 	domainsecret "github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
 	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/secrets/provider"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
@@ -207,18 +209,31 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 		span.End()
 	}()
 
+	if params.Source != nil {
+		if len(params.Data) > 0 {
+			return errors.Errorf("secret with an external source cannot have content %w", coreerrors.NotValid)
+		}
+		reader, err := s.getExternalContentReader(ctx, params.Source.Backend)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if params.Data, params.Checksum, err = readExternalSource(ctx, reader, *params.Source); err != nil {
+			return errors.Capture(err)
+		}
+	}
 	if len(params.Data) == 0 {
 		return errors.Errorf("empty secret value %w", coreerrors.NotValid)
 	}
 
 	now := s.clock.Now()
 	p := domainsecret.UpsertSecretParams{
-		Description: params.Description,
-		Label:       params.Label,
-		AutoPrune:   params.AutoPrune,
-		Checksum:    params.Checksum,
-		CreateTime:  now,
-		UpdateTime:  now,
+		Description:    params.Description,
+		Label:          params.Label,
+		AutoPrune:      params.AutoPrune,
+		Checksum:       params.Checksum,
+		CreateTime:     now,
+		UpdateTime:     now,
+		ExternalSource: params.Source,
 	}
 	// Take a copy as we may set it to nil below
 	// if the content is saved to a backend.
@@ -262,6 +277,12 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 	if err != nil {
 		return errors.Errorf("getting model uuid: %w", err)
 	}
+	if p.Data, err = secretbackend.SealSecretContent(ctx, s.secretBackendState, modelID, encryption.SecretRevision{
+		SecretID:   uri.ID,
+		RevisionID: revisionID.String(),
+	}, p.Data); err != nil {
+		return errors.Capture(err)
+	}
 	rollBack, err := s.secretBackendState.AddSecretBackendReference(ctx, p.ValueRef, modelID, revisionID.String(), uri.ID)
 	if err != nil {
 		return errors.Capture(err)
@@ -293,6 +314,11 @@ func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI,
 	if len(params.Data) > 0 && params.ValueRef != nil {
 		return errors.New("must specify either content or a value reference but not both")
 	}
+	if params.RotatePolicy != nil {
+		if err := params.RotatePolicy.Validate(); err != nil {
+			return errors.Errorf("secret rotate policy: %w", err)
+		}
+	}
 
 	now := s.clock.Now()
 	p := domainsecret.UpsertSecretParams{
@@ -310,6 +336,9 @@ func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI,
 
 	rotatePolicy := domainsecret.MarshallRotatePolicy(params.RotatePolicy)
 	p.RotatePolicy = &rotatePolicy
+	if rotatePolicy == domainsecret.RotateCustom {
+		p.RotateSchedule = params.RotatePolicy.String()
+	}
 	if params.RotatePolicy.WillRotate() {
 		p.NextRotateTime = params.RotatePolicy.NextRotateTime(s.clock.Now())
 	}
@@ -325,6 +354,12 @@ func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI,
 	if err != nil {
 		return errors.Errorf("getting model uuid: %w", err)
 	}
+	if p.Data, err = secretbackend.SealSecretContent(ctx, s.secretBackendState, modelID, encryption.SecretRevision{
+		SecretID:   uri.ID,
+		RevisionID: revisionID.String(),
+	}, p.Data); err != nil {
+		return errors.Capture(err)
+	}
 	rollBack, err := s.secretBackendState.AddSecretBackendReference(ctx, p.ValueRef, modelID, revisionID.String(), uri.ID)
 	if err != nil {
 		return errors.Capture(err)
@@ -366,14 +401,44 @@ func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI,
 // satisfying [secreterrors.SecretNotFound] if the secret does not exist.
 // It also returns an error satisfying [secreterrors.SecretLabelAlreadyExists] if
 // the secret owner already has a secret with the same label.
-// It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
+// It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor,
+// an error satisfying [coreerrors.NotValid] if new content is specified for a
+// secret whose content is synced from an external source, and an error satisfying
+// [secreterrors.SecretInUse] if new content is specified for a secret holding the
+// encryption key of existing storage.
 func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI, params UpdateUserSecretParams) error {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
+	_, err := s.updateUserSecretChecked(ctx, uri, params)
+	return errors.Capture(err)
+}
+
+// updateUserSecretChecked updates a user secret once it has checked that
+// the content of the secret may be changed, returning the UUID of the
+// revision created for new content.
+func (s *SecretService) updateUserSecretChecked(
+	ctx context.Context, uri *secrets.URI, params UpdateUserSecretParams,
+) (string, error) {
+	if len(params.Data) > 0 {
+		if err := s.checkNotExternallySourced(ctx, uri); err != nil {
+			return "", errors.Capture(err)
+		}
+		if err := s.checkNotStorageEncryptionKey(ctx, uri); err != nil {
+			return "", errors.Capture(err)
+		}
+	}
+	return s.updateUserSecret(ctx, uri, params)
+}
+
+// updateUserSecret updates a user secret, returning the UUID of the revision
+// created for new content, or an empty string if the content is unchanged.
+func (s *SecretService) updateUserSecret(
+	ctx context.Context, uri *secrets.URI, params UpdateUserSecretParams,
+) (string, error) {
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
-		return errors.Capture(err)
+		return "", errors.Capture(err)
 	}
 
 	p := domainsecret.UpsertSecretParams{
@@ -384,7 +449,7 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 		UpdateTime:  s.clock.Now(),
 	}
 
-	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
+	err = withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
 		// Take a copy as we may set it to nil below
 		// if the content is saved to a backend.
 		if len(params.Data) > 0 {
@@ -436,6 +501,12 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 			if err != nil {
 				return errors.Errorf("getting model uuid: %w", err)
 			}
+			if p.Data, err = secretbackend.SealSecretContent(innerCtx, s.secretBackendState, modelID, encryption.SecretRevision{
+				SecretID:   uri.ID,
+				RevisionID: revisionID.String(),
+			}, p.Data); err != nil {
+				return errors.Capture(err)
+			}
 			rollBack, err := s.secretBackendState.AddSecretBackendReference(
 				innerCtx, p.ValueRef, modelID, revisionID.String(), uri.ID)
 			if err != nil {
@@ -456,6 +527,13 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 		}
 		return nil
 	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	if p.RevisionID == nil {
+		return "", nil
+	}
+	return *p.RevisionID, nil
 }
 
 // UpdateCharmSecret updates a charm secret with the specified parameters, returning an error
@@ -470,6 +548,11 @@ func (s *SecretService) UpdateCharmSecret(ctx context.Context, uri *secrets.URI,
 	if len(params.Data) > 0 && params.ValueRef != nil {
 		return errors.New("must specify either content or a value reference but not both")
 	}
+	if params.RotatePolicy != nil {
+		if err := params.RotatePolicy.Validate(); err != nil {
+			return errors.Errorf("secret rotate policy: %w", err)
+		}
+	}
 
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
@@ -486,6 +569,9 @@ func (s *SecretService) UpdateCharmSecret(ctx context.Context, uri *secrets.URI,
 	}
 	rotatePolicy := domainsecret.MarshallRotatePolicy(params.RotatePolicy)
 	p.RotatePolicy = &rotatePolicy
+	if rotatePolicy == domainsecret.RotateCustom {
+		p.RotateSchedule = params.RotatePolicy.String()
+	}
 	if params.RotatePolicy.WillRotate() {
 		policy, err := s.secretState.GetRotatePolicy(ctx, uri)
 		if err != nil {
@@ -513,6 +599,12 @@ func (s *SecretService) UpdateCharmSecret(ctx context.Context, uri *secrets.URI,
 			if err != nil {
 				return errors.Errorf("getting model uuid: %w", err)
 			}
+			if p.Data, err = secretbackend.SealSecretContent(innerCtx, s.secretBackendState, modelID, encryption.SecretRevision{
+				SecretID:   uri.ID,
+				RevisionID: revisionID.String(),
+			}, p.Data); err != nil {
+				return errors.Capture(err)
+			}
 			rollBack, err := s.secretBackendState.AddSecretBackendReference(
 				innerCtx, p.ValueRef, modelID, revisionID.String(), uri.ID)
 			if err != nil {
@@ -738,24 +830,118 @@ func (s *SecretService) ListUserSecretsToDrain(ctx context.Context) ([]*secrets.
 
 // GetSecretValue returns the value of the specified secret revision.
 // If returns [secreterrors.SecretRevisionNotFound] is there's no such secret revision.
+// Each successful read is recorded in the secret access log.
 func (s *SecretService) GetSecretValue(ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error) {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
+	val, ref, err := s.getSecretValue(ctx, uri, rev, accessor)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	// The value is only handed out once the read has been recorded, so the
+	// access log does not miss reads.
+	if err := s.recordSecretAccess(ctx, uri, rev, accessor); err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	return val, ref, nil
+}
+
+// GetSecretValueForDrain returns the value of the specified secret revision
+// so that it can be moved to another backend or removed from its backend.
+// Unlike [SecretService.GetSecretValue], the read is not recorded in the
+// secret access log since the content is not handed to the accessor.
+func (s *SecretService) GetSecretValueForDrain(ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.getSecretValue(ctx, uri, rev, accessor)
+}
+
+func (s *SecretService) getSecretValue(ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error) {
 	if err := s.canRead(ctx, uri, accessor); err != nil {
 		return nil, nil, errors.Capture(err)
 	}
 	data, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
-	return secrets.NewSecretValue(data), ref, errors.Capture(err)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	if data, err = s.openContent(ctx, uri, rev, data); err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	return secrets.NewSecretValue(data), ref, nil
+}
+
+// recordSecretAccess adds an entry for the read of the secret revision by
+// the accessor to the secret access log.
+func (s *SecretService) recordSecretAccess(ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor) error {
+	if err := s.secretState.RecordSecretAccess(ctx, domainsecret.SecretAccessLogEntry{
+		SecretID:   uri.ID,
+		Revision:   rev,
+		Accessor:   accessor,
+		AccessedAt: s.clock.Now(),
+	}); err != nil {
+		return errors.Errorf("recording access to secret %q: %w", uri.ID, err)
+	}
+	return nil
+}
+
+// GetSecretAccessLog returns the entries of the secret access log matching
+// the filter, most recent first.
+func (s *SecretService) GetSecretAccessLog(
+	ctx context.Context, filter domainsecret.SecretAccessLogFilter,
+) ([]domainsecret.SecretAccessLogEntry, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.secretState.GetSecretAccessLog(ctx, filter)
+}
+
+// openContent decrypts the content of the secret revision read from the
+// model database.
+func (s *SecretService) openContent(
+	ctx context.Context, uri *secrets.URI, rev int, data secrets.SecretData,
+) (secrets.SecretData, error) {
+	if !encryption.HasSealed(data) {
+		return data, nil
+	}
+	revisionID, err := s.secretState.GetSecretRevisionID(ctx, uri, rev)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	modelID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting model uuid: %w", err)
+	}
+	return secretbackend.OpenSecretContent(ctx, s.secretBackendState, modelID, encryption.SecretRevision{
+		SecretID:   uri.ID,
+		RevisionID: revisionID,
+	}, data)
 }
 
 // GetSecretContentFromBackend retrieves the content for the specified secret revision.
 // If the content is not found, it may be that the secret has been drained so it tries
 // again using the new active backend.
-func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error) {
+// Each successful read is recorded in the secret access log against the accessor.
+func (s *SecretService) GetSecretContentFromBackend(
+	ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor,
+) (secrets.SecretValue, error) {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
+	val, err := s.getSecretContentFromBackend(ctx, uri, rev)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := s.recordSecretAccess(ctx, uri, rev, accessor); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return val, nil
+}
+
+// getSecretContentFromBackend retrieves the content for the specified secret
+// revision without recording the read.
+func (s *SecretService) getSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error) {
 	if s.activeBackendID == "" {
 		err := s.loadBackendInfo(ctx, false)
 		if err != nil {
@@ -765,7 +951,6 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 	lastBackendID := ""
 	for {
 		data, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
-		val := secrets.NewSecretValue(data)
 		if err != nil {
 			notFound := errors.Is(err, secreterrors.SecretNotFound) || errors.Is(err, secreterrors.SecretRevisionNotFound)
 			if notFound {
@@ -774,7 +959,10 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 			return nil, errors.Capture(err)
 		}
 		if ref == nil {
-			return val, nil
+			if data, err = s.openContent(ctx, uri, rev, data); err != nil {
+				return nil, errors.Capture(err)
+			}
+			return secrets.NewSecretValue(data), nil
 		}
 
 		backendID := ref.BackendID
@@ -782,7 +970,7 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 		if !ok {
 			return nil, errors.Errorf("external secret backend %q not found, have %q", backendID, s.backends).Add(backenderrors.NotFound)
 		}
-		val, err = backend.GetContent(ctx, ref.RevisionID)
+		val, err := backend.GetContent(ctx, ref.RevisionID)
 		notFound := errors.Is(err, secreterrors.SecretNotFound) || errors.Is(err, secreterrors.SecretRevisionNotFound)
 		if err == nil || !notFound || lastBackendID == backendID {
 			if notFound {
@@ -981,7 +1169,14 @@ func (s *SecretService) ChangeSecretBackend(
 			}
 		}()
 
-		err = s.secretState.ChangeSecretBackend(innerCtx, revisionID, params.ValueRef, params.Data)
+		data, err := secretbackend.SealSecretContent(innerCtx, s.secretBackendState, modelID, encryption.SecretRevision{
+			SecretID:   uri.ID,
+			RevisionID: revisionID.String(),
+		}, params.Data)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		err = s.secretState.ChangeSecretBackend(innerCtx, revisionID, params.ValueRef, data)
 		if err != nil {
 			return errors.Capture(err)
 		}
@@ -5,7 +5,10 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -30,8 +33,11 @@ import (
 	"github.com/juju/juju/domain/deployment/charm"
 	domainsecret "github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/secrets/provider"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	coretesting "github.com/juju/juju/internal/testing"
@@ -48,6 +54,10 @@ type serviceSuite struct {
 	state              *MockState
 	secretBackendState *MockSecretBackendState
 
+	// masterKeySource is the secret encryption master key source in the
+	// controller config; secret content is not encrypted if it is empty.
+	masterKeySource string
+
 	service  *SecretService
 	fakeUUID uuid.UUID
 }
@@ -62,6 +72,7 @@ func (s *serviceSuite) SetUpTest(c *tc.C) {
 	s.fakeUUID, err = uuid.NewUUID()
 	c.Assert(err, tc.ErrorIsNil)
 	s.clock = testclock.NewClock(time.Now().Truncate(24 * time.Hour))
+	s.masterKeySource = ""
 }
 
 func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
@@ -72,6 +83,11 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.secretsBackend = NewMockSecretsBackend(ctrl)
 	s.ensurer = NewMockEnsurer(ctrl)
 
+	s.secretBackendState.EXPECT().GetSecretEncryptionMasterKeySource(gomock.Any()).DoAndReturn(
+		func(context.Context) (string, error) {
+			return s.masterKeySource, nil
+		}).AnyTimes()
+
 	s.service = &SecretService{
 		secretState:        s.state,
 		secretBackendState: s.secretBackendState,
@@ -358,6 +374,8 @@ func (s *serviceSuite) assertUpdateUserSecret(c *tc.C, isInternal, finalStepFail
 		SubjectTypeID: domainsecret.SubjectModel,
 		SubjectID:     s.modelID.String(),
 	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretExternalSource(gomock.Any(), uri).Return(nil, nil)
+	s.state.EXPECT().GetEncryptionKeyStorageID(gomock.Any(), uri).Return("", nil)
 	s.state.EXPECT().GetLatestRevision(gomock.Any(), uri).Return(2, nil)
 	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil).AnyTimes()
 	rollbackCalled := false
@@ -572,6 +590,65 @@ func (s *serviceSuite) TestCreateCharmApplicationSecret(c *tc.C) {
 	c.Assert(rollbackCalled, tc.IsFalse)
 }
 
+func (s *serviceSuite) TestCreateCharmApplicationSecretCustomRotatePolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	appUUID, err := coreapplication.NewUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.ensurer.EXPECT().LeadershipCheck("mariadb", "mariadb/0").Return(goodToken{})
+
+	s.state.EXPECT().GetApplicationUUID(c.Context(), "mariadb").Return(appUUID, nil)
+	s.state.EXPECT().CreateCharmApplicationSecret(c.Context(), 1, uri, appUUID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, _ *coresecrets.URI, _ coreapplication.UUID,
+			got domainsecret.UpsertSecretParams) error {
+			c.Check(*got.RotatePolicy, tc.Equals, domainsecret.RotateCustom)
+			c.Check(got.RotateSchedule, tc.Equals, "36h")
+			c.Check(*got.NextRotateTime, tc.Equals, s.clock.Now().Add(36*time.Hour))
+			return nil
+		})
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String(), uri.ID).Return(
+		func() error { return nil }, nil)
+
+	err = s.service.CreateCharmSecret(c.Context(), uri, domainsecret.CreateCharmSecretParams{
+		UpdateCharmSecretParams: domainsecret.UpdateCharmSecretParams{
+			Accessor: domainsecret.SecretAccessor{
+				Kind: domainsecret.UnitAccessor,
+				ID:   "mariadb/0",
+			},
+			Data:         map[string]string{"foo": "bar"},
+			Checksum:     "checksum-1234",
+			RotatePolicy: new(coresecrets.RotatePolicy("36h")),
+		},
+		Version: 1,
+		CharmOwner: domainsecret.CharmSecretOwner{
+			Kind: domainsecret.ApplicationCharmSecretOwner,
+			ID:   "mariadb",
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCreateCharmApplicationSecretInvalidRotatePolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateCharmSecret(c.Context(), coresecrets.NewURI(), domainsecret.CreateCharmSecretParams{
+		UpdateCharmSecretParams: domainsecret.UpdateCharmSecretParams{
+			Data:         map[string]string{"foo": "bar"},
+			RotatePolicy: new(coresecrets.RotatePolicy("0 25 * * *")),
+		},
+		Version: 1,
+		CharmOwner: domainsecret.CharmSecretOwner{
+			Kind: domainsecret.ApplicationCharmSecretOwner,
+			ID:   "mariadb",
+		},
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, tc.ErrorMatches, `secret rotate policy: cron expression "0 25 \* \* \*": hour "25" not valid`)
+}
+
 func (s *serviceSuite) TestCreateCharmApplicationSecretFailedLabelExists(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -663,6 +740,118 @@ func (s *serviceSuite) TestUpdateCharmSecretNoRotate(c *tc.C) {
 	c.Assert(rollbackCalled, tc.IsFalse)
 }
 
+func (s *serviceSuite) TestUpdateCharmSecretEncrypted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.masterKeySource = "file:" + writeMasterKeyFile(c)
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	s.secretBackendState.EXPECT().GetModelSecretDataKey(gomock.Any(), s.modelID).
+		Return(secretbackend.WrappedDataKey{}, backenderrors.DataKeyNotFound)
+	var dataKey secretbackend.WrappedDataKey
+	s.secretBackendState.EXPECT().EnsureModelSecretDataKey(gomock.Any(), s.modelID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ coremodel.UUID, key secretbackend.WrappedDataKey) (secretbackend.WrappedDataKey, error) {
+			dataKey = key
+			return key, nil
+		})
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String(), uri.ID).
+		Return(func() error { return nil }, nil)
+
+	var stored coresecrets.SecretData
+	s.state.EXPECT().UpdateSecret(gomock.Any(), uri, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *coresecrets.URI, p domainsecret.UpsertSecretParams) error {
+			stored = p.Data
+			return nil
+		})
+
+	err := s.service.UpdateCharmSecret(c.Context(), uri, domainsecret.UpdateCharmSecretParams{
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data: map[string]string{"foo": "bar"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(stored, tc.HasLen, 1)
+	c.Check(stored["foo"], tc.Not(tc.Equals), "bar")
+	c.Check(encryption.HasSealed(stored), tc.IsTrue)
+
+	// Reading the secret decrypts the stored content.
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(stored, nil, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	s.secretBackendState.EXPECT().GetModelSecretDataKey(gomock.Any(), s.modelID).Return(dataKey, nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), gomock.Any()).Return(nil)
+
+	data, _, err := s.service.GetSecretValue(c.Context(), uri, 1, domainsecret.SecretAccessor{
+		Kind: domainsecret.UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+
+	// The stored content does not open as the content of another revision.
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 2).Return(stored, nil, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 2).Return(uuid.MustNewUUID().String(), nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	s.secretBackendState.EXPECT().GetModelSecretDataKey(gomock.Any(), s.modelID).Return(dataKey, nil)
+
+	_, _, err = s.service.GetSecretValue(c.Context(), uri, 2, domainsecret.SecretAccessor{
+		Kind: domainsecret.UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.NotNil)
+}
+
+func (s *serviceSuite) TestGetSecretValueEncryptedNotConfigured(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	dataKey, err := encryption.GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+	revisionID := uuid.MustNewUUID().String()
+	sealed, err := encryption.SealData(dataKey, encryption.SecretRevision{
+		SecretID:   uri.ID,
+		RevisionID: revisionID,
+	}, coresecrets.SecretData{"foo": "bar"})
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(sealed, nil, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(revisionID, nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+
+	_, _, err = s.service.GetSecretValue(c.Context(), uri, 1, domainsecret.SecretAccessor{
+		Kind: domainsecret.UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.ErrorIs, backenderrors.EncryptionNotConfigured)
+}
+
+func writeMasterKeyFile(c *tc.C) string {
+	key, err := encryption.GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+	path := filepath.Join(c.MkDir(), "secret.keys")
+	err = os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	return path
+}
+
 func (s *serviceSuite) TestUpdateCharmSecretRotatePolicyTransitions(c *tc.C) {
 	uri := coresecrets.NewURI()
 
@@ -939,6 +1128,15 @@ func (s *serviceSuite) TestGetSecretValue(c *tc.C) {
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
 	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), domainsecret.SecretAccessLogEntry{
+		SecretID: uri.ID,
+		Revision: 666,
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		AccessedAt: s.clock.Now(),
+	}).Return(nil)
 
 	data, ref, err := s.service.GetSecretValue(c.Context(), uri, 666, domainsecret.SecretAccessor{
 		Kind: domainsecret.UnitAccessor,
@@ -949,6 +1147,101 @@ func (s *serviceSuite) TestGetSecretValue(c *tc.C) {
 	c.Assert(data, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
 }
 
+func (s *serviceSuite) TestGetSecretValueRecordAccessFails(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	_, _, err := s.service.GetSecretValue(c.Context(), uri, 1, domainsecret.SecretAccessor{
+		Kind: domainsecret.UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.ErrorMatches, `recording access to secret .*: boom`)
+}
+
+func (s *serviceSuite) TestGetSecretValueForDrain(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+
+	data, ref, err := s.service.GetSecretValueForDrain(c.Context(), uri, 1, domainsecret.SecretAccessor{
+		Kind: domainsecret.UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ref, tc.IsNil)
+	c.Assert(data, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+}
+
+func (s *serviceSuite) TestGetSecretContentFromBackend(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.activeBackendID = "backend-id"
+
+	uri := coresecrets.NewURI()
+	accessor := domainsecret.SecretAccessor{
+		Kind: domainsecret.UserAccessor,
+		ID:   "fred",
+	}
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 2).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), domainsecret.SecretAccessLogEntry{
+		SecretID:   uri.ID,
+		Revision:   2,
+		Accessor:   accessor,
+		AccessedAt: s.clock.Now(),
+	}).Return(nil)
+
+	data, err := s.service.GetSecretContentFromBackend(c.Context(), uri, 2, accessor)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(data, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+}
+
+func (s *serviceSuite) TestGetSecretContentFromBackendNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.activeBackendID = "backend-id"
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 2).Return(nil, nil, secreterrors.SecretRevisionNotFound)
+
+	_, err := s.service.GetSecretContentFromBackend(c.Context(), uri, 2, domainsecret.SecretAccessor{
+		Kind: domainsecret.UserAccessor,
+		ID:   "fred",
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *serviceSuite) TestGetSecretAccessLog(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	filter := domainsecret.SecretAccessLogFilter{SecretID: "secret-id", Limit: 10}
+	entries := []domainsecret.SecretAccessLogEntry{{
+		SecretID: "secret-id",
+		Revision: 1,
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		AccessedAt: s.clock.Now(),
+	}}
+	s.state.EXPECT().GetSecretAccessLog(gomock.Any(), filter).Return(entries, nil)
+
+	got, err := s.service.GetSecretAccessLog(c.Context(), filter)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, entries)
+}
+
 func (s *serviceSuite) TestGetSecretConsumer(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
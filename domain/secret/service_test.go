@@ -107,6 +107,8 @@ func (s *serviceSuite) TestDeleteSecretExternal(c *tc.C) {
 func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.secretBackendState = secret.NewMockSecretBackendState(ctrl)
+	// Secret content is not encrypted.
+	s.secretBackendState.EXPECT().GetSecretEncryptionMasterKeySource(gomock.Any()).Return("", nil).AnyTimes()
 
 	s.svc = service.NewSecretService(
 		state.NewState(func(ctx context.Context) (database.TxnRunner, error) {
@@ -0,0 +1,135 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// maxSecretAccessLogEntries is the number of entries the secret access log
+// of a model is bounded to. Once exceeded, the oldest entries are removed.
+var maxSecretAccessLogEntries = 100000
+
+// RecordSecretAccess adds an entry to the secret access log, removing the
+// oldest entries once the log is full.
+func (st State) RecordSecretAccess(ctx context.Context, entry domainsecret.SecretAccessLogEntry) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := secretAccessLogEntry{
+		SecretID:     entry.SecretID,
+		Revision:     entry.Revision,
+		AccessorKind: string(entry.Accessor.Kind),
+		AccessorID:   entry.Accessor.ID,
+		AccessedAt:   entry.AccessedAt.UTC(),
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO secret_access_log (secret_id, revision, accessor_kind, accessor_id, accessed_at)
+VALUES ($secretAccessLogEntry.*)`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	bound := secretAccessLogBound{Entries: maxSecretAccessLogEntries}
+	pruneStmt, err := st.Prepare(`
+DELETE FROM secret_access_log
+WHERE  id <= (SELECT MAX(id) FROM secret_access_log) - $secretAccessLogBound.entries`, bound)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, insertStmt, row).Run(); err != nil {
+			return errors.Errorf("recording access to secret %q: %w", entry.SecretID, err)
+		}
+		if err := tx.Query(ctx, pruneStmt, bound).Run(); err != nil {
+			return errors.Errorf("pruning secret access log: %w", err)
+		}
+		return nil
+	})
+}
+
+// secretAccessLogQueryStmt selects entries from the secret access log, most
+// recent first. Each filter term applies only when its has_ flag is set, so
+// that a single static statement serves every combination of terms.
+const secretAccessLogQueryStmt = `
+SELECT &secretAccessLogEntry.*
+FROM   secret_access_log
+WHERE  ($secretAccessLogQuery.has_secret_id = FALSE OR secret_id = $secretAccessLogQuery.secret_id)
+AND    ($secretAccessLogQuery.has_accessor = FALSE OR (
+           accessor_kind = $secretAccessLogQuery.accessor_kind
+           AND accessor_id = $secretAccessLogQuery.accessor_id
+       ))
+AND    ($secretAccessLogQuery.has_since = FALSE OR accessed_at >= $secretAccessLogQuery.since)
+AND    ($secretAccessLogQuery.has_until = FALSE OR accessed_at < $secretAccessLogQuery.until)
+ORDER BY accessed_at DESC, id DESC
+LIMIT $secretAccessLogQuery.limit`
+
+// GetSecretAccessLog returns the entries of the secret access log matching
+// the filter, most recent first.
+func (st State) GetSecretAccessLog(
+	ctx context.Context, filter domainsecret.SecretAccessLogFilter,
+) ([]domainsecret.SecretAccessLogEntry, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	query := secretAccessLogQuery{
+		HasSecretID: filter.SecretID != "",
+		SecretID:    filter.SecretID,
+		HasSince:    !filter.Since.IsZero(),
+		Since:       filter.Since.UTC(),
+		HasUntil:    !filter.Until.IsZero(),
+		Until:       filter.Until.UTC(),
+		// A negative limit returns all rows.
+		Limit: -1,
+	}
+	if filter.Accessor != nil {
+		query.HasAccessor = true
+		query.AccessorKind = string(filter.Accessor.Kind)
+		query.AccessorID = filter.Accessor.ID
+	}
+	if filter.Limit > 0 {
+		query.Limit = filter.Limit
+	}
+
+	stmt, err := st.Prepare(secretAccessLogQueryStmt, query, secretAccessLogEntry{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []secretAccessLogEntry
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, query).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying secret access log: %w", err)
+	}
+
+	result := make([]domainsecret.SecretAccessLogEntry, len(rows))
+	for i, row := range rows {
+		result[i] = domainsecret.SecretAccessLogEntry{
+			SecretID: row.SecretID,
+			Revision: row.Revision,
+			Accessor: domainsecret.SecretAccessor{
+				Kind: domainsecret.SecretAccessorKind(row.AccessorKind),
+				ID:   row.AccessorID,
+			},
+			AccessedAt: row.AccessedAt,
+		}
+	}
+	return result, nil
+}
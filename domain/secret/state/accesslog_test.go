@@ -0,0 +1,76 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/tc"
+
+	domainsecret "github.com/juju/juju/domain/secret"
+)
+
+func (s *stateSuite) recordSecretAccess(c *tc.C, secretID string, revision int, unitName string, at time.Time) domainsecret.SecretAccessLogEntry {
+	entry := domainsecret.SecretAccessLogEntry{
+		SecretID: secretID,
+		Revision: revision,
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.UnitAccessor,
+			ID:   unitName,
+		},
+		AccessedAt: at.UTC(),
+	}
+	err := s.state.RecordSecretAccess(c.Context(), entry)
+	c.Assert(err, tc.ErrorIsNil)
+	return entry
+}
+
+func (s *stateSuite) TestGetSecretAccessLog(c *tc.C) {
+	now := time.Now().Truncate(time.Second)
+	first := s.recordSecretAccess(c, "secret-a", 1, "mysql/0", now.Add(-2*time.Hour))
+	second := s.recordSecretAccess(c, "secret-b", 1, "mysql/0", now.Add(-time.Hour))
+	third := s.recordSecretAccess(c, "secret-a", 2, "wordpress/0", now)
+
+	entries, err := s.state.GetSecretAccessLog(c.Context(), domainsecret.SecretAccessLogFilter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.DeepEquals, []domainsecret.SecretAccessLogEntry{third, second, first})
+
+	entries, err = s.state.GetSecretAccessLog(c.Context(), domainsecret.SecretAccessLogFilter{
+		SecretID: "secret-a",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.DeepEquals, []domainsecret.SecretAccessLogEntry{third, first})
+
+	entries, err = s.state.GetSecretAccessLog(c.Context(), domainsecret.SecretAccessLogFilter{
+		Accessor: &domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mysql/0"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.DeepEquals, []domainsecret.SecretAccessLogEntry{second, first})
+
+	entries, err = s.state.GetSecretAccessLog(c.Context(), domainsecret.SecretAccessLogFilter{
+		Since: now.Add(-90 * time.Minute),
+		Until: now,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.DeepEquals, []domainsecret.SecretAccessLogEntry{second})
+
+	entries, err = s.state.GetSecretAccessLog(c.Context(), domainsecret.SecretAccessLogFilter{
+		Limit: 1,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.DeepEquals, []domainsecret.SecretAccessLogEntry{third})
+}
+
+func (s *stateSuite) TestRecordSecretAccessBounded(c *tc.C) {
+	s.PatchValue(&maxSecretAccessLogEntries, 2)
+
+	now := time.Now().Truncate(time.Second)
+	s.recordSecretAccess(c, "secret-a", 1, "mysql/0", now.Add(-2*time.Hour))
+	second := s.recordSecretAccess(c, "secret-a", 1, "mysql/1", now.Add(-time.Hour))
+	third := s.recordSecretAccess(c, "secret-a", 1, "mysql/2", now)
+
+	entries, err := s.state.GetSecretAccessLog(c.Context(), domainsecret.SecretAccessLogFilter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.DeepEquals, []domainsecret.SecretAccessLogEntry{third, second})
+}
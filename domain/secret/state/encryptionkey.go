@@ -0,0 +1,49 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetEncryptionKeyStorageID returns the id of the storage instance whose
+// filesystem is encrypted with the key held in the specified secret, or an
+// empty string if the secret does not hold a storage encryption key.
+func (st State) GetEncryptionKeyStorageID(
+	ctx context.Context, uri *coresecrets.URI,
+) (string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	row := storageEncryptionKey{SecretID: uri.ID}
+	stmt, err := st.Prepare(`
+SELECT si.storage_id AS &storageEncryptionKey.storage_id
+FROM   storage_instance_encryption_key siek
+JOIN   storage_instance si ON siek.storage_instance_uuid = si.uuid
+WHERE  siek.secret_id = $storageEncryptionKey.secret_id`, row)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, row).Get(&row)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("getting storage encrypted with secret %q: %w", uri.ID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return row.StorageID, nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/tc"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	domainstorage "github.com/juju/juju/domain/storage"
+	"github.com/juju/juju/internal/uuid"
+)
+
+func (s *stateSuite) TestGetEncryptionKeyStorageID(c *tc.C) {
+	uri := coresecrets.NewURI()
+	err := s.createUserSecret(c, 1, uri, domainsecret.UpsertSecretParams{
+		Data:       coresecrets.SecretData{"key": "c2Vrcml0"},
+		RevisionID: new(uuid.MustNewUUID().String()),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	storageID, err := s.state.GetEncryptionKeyStorageID(c.Context(), uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(storageID, tc.Equals, "")
+
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	_, err = s.DB().Exec(`
+INSERT INTO storage_pool (uuid, name, type) VALUES (?, ?, ?)`,
+		poolUUID, "loop", "loop",
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	storageUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	_, err = s.DB().Exec(`
+INSERT INTO storage_instance (uuid, storage_id, storage_pool_uuid,
+                              storage_kind_id, requested_size_mib,
+                              storage_name, life_id)
+VALUES (?, ?, ?, 1, ?, ?, ?)
+`,
+		storageUUID, "data/0", poolUUID, 100, "data", 0,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO storage_instance_encryption_key (storage_instance_uuid, secret_id)
+VALUES (?, ?)`,
+		storageUUID, uri.ID,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	storageID, err = s.state.GetEncryptionKeyStorageID(c.Context(), uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(storageID, tc.Equals, "data/0")
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// setSecretExternalSource records the backend path from which the content of
+// the specified secret is synced.
+func (st State) setSecretExternalSource(
+	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI, source coresecrets.ExternalSource,
+) error {
+	row := secretExternalSource{
+		SecretID:    uri.ID,
+		BackendName: source.Backend,
+		Path:        source.Path,
+		ContentKey:  source.Key,
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO secret_external_source (*)
+VALUES ($secretExternalSource.*)`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, stmt, row).Run(); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+// GetSecretExternalSource returns the backend path from which the content of
+// the specified secret is synced, or nil if the secret has no external
+// source.
+func (st State) GetSecretExternalSource(
+	ctx context.Context, uri *coresecrets.URI,
+) (*coresecrets.ExternalSource, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	row := secretExternalSource{SecretID: uri.ID}
+	stmt, err := st.Prepare(`
+SELECT &secretExternalSource.*
+FROM   secret_external_source
+WHERE  secret_id = $secretExternalSource.secret_id`, row)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var found bool
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, row).Get(&row)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("getting external source for secret %q: %w", uri.ID, err)
+		}
+		found = true
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return &coresecrets.ExternalSource{
+		Backend: row.BackendName,
+		Path:    row.Path,
+		Key:     row.ContentKey,
+	}, nil
+}
+
+// ListSecretExternalSources returns the secrets whose content is synced from
+// a path in a secret backend, along with the checksum of the content of their
+// latest revision.
+func (st State) ListSecretExternalSources(ctx context.Context) ([]domainsecret.SecretExternalSource, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT (es.secret_id, es.backend_name, es.path, es.content_key) AS (&secretExternalSource.*),
+       COALESCE(sm.latest_revision_checksum, '') AS &secretExternalSourceChecksum.checksum
+FROM   secret_external_source es
+JOIN   secret_metadata sm ON sm.secret_id = es.secret_id
+ORDER BY es.secret_id`, secretExternalSource{}, secretExternalSourceChecksum{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var (
+		sources   []secretExternalSource
+		checksums []secretExternalSourceChecksum
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&sources, &checksums)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing secret external sources: %w", err)
+	}
+
+	result := make([]domainsecret.SecretExternalSource, len(sources))
+	for i, s := range sources {
+		uri, err := coresecrets.ParseURI(s.SecretID)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		result[i] = domainsecret.SecretExternalSource{
+			URI: uri,
+			Source: coresecrets.ExternalSource{
+				Backend: s.BackendName,
+				Path:    s.Path,
+				Key:     s.ContentKey,
+			},
+			Checksum: checksums[i].Checksum,
+		}
+	}
+	return result, nil
+}
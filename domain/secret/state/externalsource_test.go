@@ -0,0 +1,67 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/tc"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/uuid"
+)
+
+func (s *stateSuite) TestCreateUserSecretWithExternalSource(c *tc.C) {
+	source := coresecrets.ExternalSource{Backend: "myvault", Path: "kv/prod/db", Key: "password"}
+	sp := domainsecret.UpsertSecretParams{
+		Data:           coresecrets.SecretData{"password": "czNjcmV0"},
+		Checksum:       "checksum-1234",
+		RevisionID:     new(uuid.MustNewUUID().String()),
+		ExternalSource: &source,
+	}
+	uri := coresecrets.NewURI()
+	err := s.createUserSecret(c, 1, uri, sp)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := s.state.GetSecretExternalSource(c.Context(), uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, &source)
+
+	sources, err := s.state.ListSecretExternalSources(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(sources, tc.DeepEquals, []domainsecret.SecretExternalSource{{
+		URI:      uri,
+		Source:   source,
+		Checksum: "checksum-1234",
+	}})
+
+	err = s.state.UpdateSecret(c.Context(), uri, domainsecret.UpsertSecretParams{
+		Data:       coresecrets.SecretData{"password": "bmV3"},
+		Checksum:   "checksum-5678",
+		RevisionID: new(uuid.MustNewUUID().String()),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	sources, err = s.state.ListSecretExternalSources(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(sources, tc.HasLen, 1)
+	c.Check(sources[0].Checksum, tc.Equals, "checksum-5678")
+}
+
+func (s *stateSuite) TestGetSecretExternalSourceNone(c *tc.C) {
+	sp := domainsecret.UpsertSecretParams{
+		Data:       coresecrets.SecretData{"foo": "bar"},
+		RevisionID: new(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI()
+	err := s.createUserSecret(c, 1, uri, sp)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := s.state.GetSecretExternalSource(c.Context(), uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.IsNil)
+
+	sources, err := s.state.ListSecretExternalSources(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(sources, tc.HasLen, 0)
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/juju/tc"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/uuid"
+)
+
+func (s *stateSuite) TestCustomRotatePolicy(c *tc.C) {
+	s.setupUnits(c, "mysql")
+
+	rotateTime := time.Now().Add(time.Hour)
+	sp := domainsecret.UpsertSecretParams{
+		Data:           coresecrets.SecretData{"foo": "bar"},
+		RotatePolicy:   new(domainsecret.RotateCustom),
+		RotateSchedule: "45d@0 3 * * SUN~1h",
+		NextRotateTime: new(rotateTime),
+		RevisionID:     new(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI()
+	ctx := c.Context()
+	err := s.createCharmApplicationSecret(c, 1, uri, "mysql", sp)
+	c.Assert(err, tc.ErrorIsNil)
+
+	policy, err := s.state.GetRotatePolicy(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(policy, tc.Equals, coresecrets.RotatePolicy("45d@0 3 * * SUN~1h"))
+
+	info, err := s.state.GetRotationExpiryInfo(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(info.RotatePolicy, tc.Equals, coresecrets.RotatePolicy("45d@0 3 * * SUN~1h"))
+
+	md, err := s.state.GetSecret(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(md.RotatePolicy, tc.Equals, coresecrets.RotatePolicy("45d@0 3 * * SUN~1h"))
+
+	// Updating other attributes keeps the schedule.
+	err = s.state.UpdateSecret(ctx, uri, domainsecret.UpsertSecretParams{
+		Description: new("my secret"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	policy, err = s.state.GetRotatePolicy(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(policy, tc.Equals, coresecrets.RotatePolicy("45d@0 3 * * SUN~1h"))
+
+	err = s.state.UpdateSecret(ctx, uri, domainsecret.UpsertSecretParams{
+		RotatePolicy:   new(domainsecret.RotateCustom),
+		RotateSchedule: "36h",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	secrets, _, err := s.state.ListCharmSecrets(ctx, domainsecret.ApplicationOwners{"mysql"}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(secrets, tc.HasLen, 1)
+	c.Check(secrets[0].RotatePolicy, tc.Equals, coresecrets.RotatePolicy("36h"))
+
+	// A fixed policy replaces the schedule.
+	err = s.state.UpdateSecret(ctx, uri, domainsecret.UpsertSecretParams{
+		RotatePolicy: new(domainsecret.RotateDaily),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	policy, err = s.state.GetRotatePolicy(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(policy, tc.Equals, coresecrets.RotateDaily)
+
+	var count int
+	err = s.TxnRunner().StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM secret_rotate_schedule").Scan(&count)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
@@ -363,6 +363,11 @@ func (st State) createUserSecret(
 	if err := st.setSecretModelOwner(ctx, tx, uri, label); err != nil {
 		return errors.Errorf("setting secret model owner: %w", err)
 	}
+	if secret.ExternalSource != nil {
+		if err := st.setSecretExternalSource(ctx, tx, uri, *secret.ExternalSource); err != nil {
+			return errors.Errorf("setting secret external source: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -391,6 +396,9 @@ VALUES ($secretID.id)`
 	if err := st.upsertSecret(ctx, tx, dbSecret); err != nil {
 		return errors.Errorf("creating secret metadata: %w", err)
 	}
+	if err := st.upsertSecretRotateSchedule(ctx, tx, uri, secret); err != nil {
+		return errors.Errorf("inserting rotate schedule: %w", err)
+	}
 
 	if secret.NextRotateTime != nil {
 		if err := st.upsertSecretNextRotateTime(ctx, tx, uri, *secret.NextRotateTime); err != nil {
@@ -733,7 +741,7 @@ SELECT
        version AS &secretInfo.version,
        description AS &secretInfo.description,
        auto_prune AS &secretInfo.auto_prune,
-       rp.policy AS &secretInfo.policy,
+       COALESCE(srs.schedule, rp.policy) AS &secretInfo.policy,
        MAX(sr.revision) AS &secretInfo.latest_revision,
        sm.latest_revision_checksum AS &secretInfo.latest_revision_checksum,
        sr.uuid AS &secretInfo.latest_revision_uuid,
@@ -741,6 +749,7 @@ SELECT
 FROM   secret_metadata sm
        JOIN secret_revision sr ON sr.secret_id = sm.secret_id
        LEFT JOIN secret_rotate_policy rp ON rp.id = sm.rotate_policy_id
+       LEFT JOIN secret_rotate_schedule srs ON srs.secret_id = sm.secret_id
        LEFT JOIN v_secret_owner AS so ON so.secret_id = sm.secret_id
 WHERE  sm.secret_id = $secretID.id
 GROUP BY sm.secret_id`
@@ -782,6 +791,9 @@ GROUP BY sm.secret_id`
 	if err := st.upsertSecret(ctx, tx, dbSecret); err != nil {
 		return errors.Errorf("updating secret %q: %w", uri, err)
 	}
+	if err := st.upsertSecretRotateSchedule(ctx, tx, uri, secret); err != nil {
+		return errors.Errorf("updating rotate schedule for secret %q: %w", uri, err)
+	}
 
 	if secret.Label != nil {
 		if err := st.upsertSecretLabel(ctx, tx, existing.URI, *secret.Label, existingOwner); err != nil {
@@ -990,6 +1002,40 @@ ON CONFLICT(secret_id) DO UPDATE SET
 	return nil
 }
 
+// upsertSecretRotateSchedule records the custom rotate policy of a secret,
+// or removes it if the secret no longer has one. Nothing is done if the
+// params do not change the rotate policy.
+func (st State) upsertSecretRotateSchedule(
+	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI, p domainsecret.UpsertSecretParams,
+) error {
+	if p.RotatePolicy == nil {
+		return nil
+	}
+	schedule := secretRotateSchedule{
+		SecretID: uri.ID,
+		Schedule: p.RotateSchedule,
+	}
+	if *p.RotatePolicy != domainsecret.RotateCustom {
+		stmt, err := st.Prepare(`
+DELETE FROM secret_rotate_schedule
+WHERE secret_id = $secretRotateSchedule.secret_id`, schedule)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		return errors.Capture(tx.Query(ctx, stmt, schedule).Run())
+	}
+
+	stmt, err := st.Prepare(`
+INSERT INTO secret_rotate_schedule (*)
+VALUES ($secretRotateSchedule.*)
+ON CONFLICT(secret_id) DO UPDATE SET
+    schedule=excluded.schedule`, schedule)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(tx.Query(ctx, stmt, schedule).Run())
+}
+
 func (st State) grantSecretOwnerManage(
 	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI, ownerUUID string, ownerType domainsecret.GrantSubjectType,
 ) error {
@@ -1593,13 +1639,14 @@ func (st State) GetRotationExpiryInfo(ctx context.Context, uri *coresecrets.URI)
 	input := secretID{ID: uri.ID}
 	result := secretInfo{}
 	stmt, err := st.Prepare(`
-SELECT   sp.policy AS &secretInfo.policy,
+SELECT   COALESCE(srs.schedule, sp.policy) AS &secretInfo.policy,
          sro.next_rotation_time AS &secretInfo.next_rotation_time,
          sre.expire_time AS &secretInfo.latest_expire_time,
          MAX(sr.revision) AS &secretInfo.latest_revision
 FROM     secret_metadata sm
          JOIN secret_revision sr ON sm.secret_id = sr.secret_id
          JOIN secret_rotate_policy sp ON sp.id = sm.rotate_policy_id
+         LEFT JOIN secret_rotate_schedule srs ON srs.secret_id = sm.secret_id
          LEFT JOIN secret_rotation sro ON sro.secret_id = sm.secret_id
          LEFT JOIN secret_revision_expire sre ON sre.revision_uuid = sr.uuid
 WHERE    sm.secret_id = $secretID.id
@@ -1638,9 +1685,10 @@ func (st State) GetRotatePolicy(ctx context.Context, uri *coresecrets.URI) (core
 		return coresecrets.RotateNever, errors.Capture(err)
 	}
 	stmt, err := st.Prepare(`
-SELECT srp.policy AS &secretInfo.policy
+SELECT COALESCE(srs.schedule, srp.policy) AS &secretInfo.policy
 FROM   secret_metadata sm
        JOIN secret_rotate_policy srp ON srp.id = sm.rotate_policy_id
+       LEFT JOIN secret_rotate_schedule srs ON srs.secret_id = sm.secret_id
 WHERE  sm.secret_id = $secretID.id`, secretID{}, secretInfo{})
 	if err != nil {
 		return coresecrets.RotateNever, errors.Capture(err)
@@ -1830,7 +1878,7 @@ SELECT sm.secret_id AS &secretInfo.secret_id,
        sm.version AS &secretInfo.version,
        sm.description AS &secretInfo.description,
        sm.auto_prune AS &secretInfo.auto_prune,
-       rp.policy AS &secretInfo.policy,
+       COALESCE(srs.schedule, rp.policy) AS &secretInfo.policy,
        sro.next_rotation_time AS &secretInfo.next_rotation_time,
        sre.expire_time AS &secretInfo.latest_expire_time,
        sm.latest_revision_checksum AS &secretInfo.latest_revision_checksum,
@@ -1844,6 +1892,7 @@ FROM   secret_metadata sm
        JOIN secret_revision sr ON sr.secret_id = sm.secret_id
        LEFT JOIN secret_revision_expire sre ON sre.revision_uuid = sr.uuid
        LEFT JOIN secret_rotate_policy rp ON rp.id = sm.rotate_policy_id
+       LEFT JOIN secret_rotate_schedule srs ON srs.secret_id = sm.secret_id
        LEFT JOIN secret_rotation sro ON sro.secret_id = sm.secret_id`
 
 	queryParts = append(queryParts, query)
@@ -3214,6 +3263,36 @@ WHERE  secret_id = $secretRevision.secret_id
 	return secretRev.ID, nil
 }
 
+// GetSecretRevision returns the revision number of the secret revision with
+// the specified UUID, returning an error satisfying
+// [secreterrors.SecretRevisionNotFound] if there is no such revision.
+func (st State) GetSecretRevision(ctx context.Context, revisionID string) (int, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	secretRev := secretRevision{ID: revisionID}
+	stmt, err := st.Prepare(`
+SELECT revision AS &secretRevision.revision
+FROM   secret_revision
+WHERE  uuid = $secretRevision.uuid`, secretRev)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, secretRev).Get(&secretRev)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("%w: %s", secreterrors.SecretRevisionNotFound, revisionID)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	return secretRev.Revision, nil
+}
+
 type dbrevisionUUIDs []revisionUUID
 
 // InitialWatchStatementForConsumedSecretsChange returns the initial watch
@@ -4051,6 +4051,34 @@ func (s *stateSuite) TestGetSecretRevisionIDNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, fmt.Sprintf("secret revision not found: %s/%d", uri, 1))
 }
 
+func (s *stateSuite) TestGetSecretRevisionForRevisionID(c *tc.C) {
+	s.setupUnits(c, "mysql")
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID: new(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+	}
+	uri := coresecrets.NewURI()
+	ctx := c.Context()
+	err := s.createCharmApplicationSecret(c, 1, uri, "mysql", sp)
+	c.Assert(err, tc.ErrorIsNil)
+	sp2 := domainsecret.UpsertSecretParams{
+		RevisionID: new(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "baz"},
+	}
+	err = s.state.UpdateSecret(ctx, uri, sp2)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := s.state.GetSecretRevision(ctx, *sp2.RevisionID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.Equals, 2)
+}
+
+func (s *stateSuite) TestGetSecretRevisionForRevisionIDNotFound(c *tc.C) {
+	_, err := s.state.GetSecretRevision(c.Context(), uuid.MustNewUUID().String())
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
 func parseUUID(c *tc.C, s string) uuid.UUID {
 	id, err := uuid.UUIDFromString(s)
 	c.Assert(err, tc.ErrorIsNil)
@@ -514,3 +514,60 @@ type count struct {
 func getRevisionID(secretID string, revision int) string {
 	return fmt.Sprintf("%s/%d", secretID, revision)
 }
+
+// secretAccessLogEntry represents a row in the secret_access_log table.
+type secretAccessLogEntry struct {
+	SecretID     string    `db:"secret_id"`
+	Revision     int       `db:"revision"`
+	AccessorKind string    `db:"accessor_kind"`
+	AccessorID   string    `db:"accessor_id"`
+	AccessedAt   time.Time `db:"accessed_at"`
+}
+
+// secretAccessLogQuery holds the terms used to query the secret access log.
+// A term is ignored when its has_ flag is false.
+type secretAccessLogQuery struct {
+	HasSecretID  bool      `db:"has_secret_id"`
+	SecretID     string    `db:"secret_id"`
+	HasAccessor  bool      `db:"has_accessor"`
+	AccessorKind string    `db:"accessor_kind"`
+	AccessorID   string    `db:"accessor_id"`
+	HasSince     bool      `db:"has_since"`
+	Since        time.Time `db:"since"`
+	HasUntil     bool      `db:"has_until"`
+	Until        time.Time `db:"until"`
+	Limit        int       `db:"limit"`
+}
+
+// secretAccessLogBound holds the number of entries the secret access log
+// is bounded to.
+type secretAccessLogBound struct {
+	Entries int `db:"entries"`
+}
+
+// secretRotateSchedule represents a row in the secret_rotate_schedule table.
+type secretRotateSchedule struct {
+	SecretID string `db:"secret_id"`
+	Schedule string `db:"schedule"`
+}
+
+// secretExternalSource represents a row in the secret_external_source table.
+type secretExternalSource struct {
+	SecretID    string `db:"secret_id"`
+	BackendName string `db:"backend_name"`
+	Path        string `db:"path"`
+	ContentKey  string `db:"content_key"`
+}
+
+// secretExternalSourceChecksum holds the checksum of the latest revision of
+// a secret with an external source.
+type secretExternalSourceChecksum struct {
+	Checksum string `db:"checksum"`
+}
+
+// storageEncryptionKey holds the storage instance whose filesystem is
+// encrypted with the key held in a secret.
+type storageEncryptionKey struct {
+	SecretID  string `db:"secret_id"`
+	StorageID string `db:"storage_id"`
+}
@@ -56,6 +56,14 @@ type UpsertSecretParams struct {
 	Data     secrets.SecretData
 	ValueRef *secrets.ValueRef
 	Checksum string
+
+	// RotateSchedule is the custom rotate policy, such as "36h" or
+	// "0 3 * * SUN", of a secret whose RotatePolicy is RotateCustom.
+	RotateSchedule string
+
+	// ExternalSource is the backend path the content of a user secret is
+	// synced from. It is only used when creating the secret.
+	ExternalSource *secrets.ExternalSource
 }
 
 // HasUpdate returns true if at least one attribute to update is not nil.
@@ -245,6 +253,14 @@ const (
 	ModelAccessor       SecretAccessorKind = "model"
 )
 
+// These represent the kinds of entity which read secret content directly
+// rather than through a grant. They only identify the reader in the secret
+// access log.
+const (
+	UserAccessor    SecretAccessorKind = "user"
+	MachineAccessor SecretAccessorKind = "machine"
+)
+
 // SecretAccessScope represents the scope of a secret permission.
 type SecretAccessScope struct {
 	Kind SecretAccessScopeKind
@@ -261,3 +277,109 @@ const (
 	RelationAccessScope    SecretAccessScopeKind = "relation"
 	ModelAccessScope       SecretAccessScopeKind = "model"
 )
+
+// SecretKeyChangeKind describes how a key of the content of a secret
+// changed between two revisions.
+type SecretKeyChangeKind string
+
+// These represent the kinds of change to a key of secret content.
+const (
+	SecretKeyAdded   SecretKeyChangeKind = "added"
+	SecretKeyRemoved SecretKeyChangeKind = "removed"
+	SecretKeyChanged SecretKeyChangeKind = "changed"
+)
+
+// SecretKeyChange describes a key of the content of a secret which was
+// added, removed or changed between two revisions.
+type SecretKeyChange struct {
+	// Key is the content key which changed.
+	Key string
+	// Change is how the key changed.
+	Change SecretKeyChangeKind
+	// From is the encoded value of the key in the earlier revision. It is
+	// empty unless the values were requested.
+	From string
+	// To is the encoded value of the key in the later revision. It is
+	// empty unless the values were requested.
+	To string
+}
+
+// SecretAccessLogEntry records a read of secret content.
+type SecretAccessLogEntry struct {
+	// SecretID is the ID of the secret that was read.
+	SecretID string
+	// Revision is the revision of the secret that was read.
+	Revision int
+	// Accessor is the entity that read the secret.
+	Accessor SecretAccessor
+	// AccessedAt is when the secret was read.
+	AccessedAt time.Time
+}
+
+// SecretAccessLogFilter selects entries from the secret access log. Entries
+// match if they match every field that is set.
+type SecretAccessLogFilter struct {
+	// SecretID selects reads of the secret with this ID.
+	SecretID string
+	// Accessor selects reads by this entity.
+	Accessor *SecretAccessor
+	// Since selects reads at or after this time.
+	Since time.Time
+	// Until selects reads before this time.
+	Until time.Time
+	// Limit is the maximum number of entries to return, most recent
+	// first. If zero, all matching entries are returned.
+	Limit int
+}
+
+// SecretExternalSource is a user secret whose content is synced from a path
+// in a secret backend.
+type SecretExternalSource struct {
+	// URI is the secret the content is synced into.
+	URI *secrets.URI
+	// Source is where the content is synced from.
+	Source secrets.ExternalSource
+	// Checksum is the checksum of the content of the latest revision.
+	Checksum string
+}
+
+// MigrateSecretsParams are used to move secret revisions from one secret
+// backend to another.
+type MigrateSecretsParams struct {
+	// FromBackend is the name of the backend the revisions are moved from.
+	FromBackend string
+	// ToBackend is the name of the backend the revisions are moved to.
+	ToBackend string
+	// URI, if set, restricts the migration to the revisions of this secret.
+	URI *secrets.URI
+	// DryRun reports the revisions which would be moved without moving
+	// them.
+	DryRun bool
+}
+
+// SecretMigrationStatus is the outcome of migrating a secret revision.
+type SecretMigrationStatus string
+
+const (
+	// SecretMigrationPlanned is reported by a dry run for a revision which
+	// would be migrated.
+	SecretMigrationPlanned SecretMigrationStatus = "planned"
+	// SecretMigrationMigrated is reported for a revision whose content was
+	// copied and verified, and which now refers to the target backend.
+	SecretMigrationMigrated SecretMigrationStatus = "migrated"
+	// SecretMigrationFailed is reported for a revision which could not be
+	// migrated; it still refers to the source backend.
+	SecretMigrationFailed SecretMigrationStatus = "failed"
+)
+
+// SecretRevisionMigration reports the migration of a secret revision.
+type SecretRevisionMigration struct {
+	URI      *secrets.URI
+	Revision int
+	Status   SecretMigrationStatus
+	// Checksum is the checksum of the revision content read from the
+	// source backend.
+	Checksum string
+	// Error is why the revision could not be migrated.
+	Error error
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretbackend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+
+	coremodel "github.com/juju/juju/core/model"
+	coresecrets "github.com/juju/juju/core/secrets"
+	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
+)
+
+// DataKeyReader provides access to the data key encrypting the secret
+// content of a model.
+type DataKeyReader interface {
+	// GetSecretEncryptionMasterKeySource returns the source of the master
+	// key from the controller config, or an empty string if secret content
+	// is not encrypted.
+	GetSecretEncryptionMasterKeySource(ctx context.Context) (string, error)
+
+	// GetModelSecretDataKey returns the wrapped data key of the model,
+	// returning an error satisfying [secretbackenderrors.DataKeyNotFound]
+	// if the model has none.
+	GetModelSecretDataKey(ctx context.Context, modelUUID coremodel.UUID) (WrappedDataKey, error)
+}
+
+// DataKeyState provides access to, and creates, the data key encrypting
+// the secret content of a model.
+type DataKeyState interface {
+	DataKeyReader
+
+	// EnsureModelSecretDataKey stores the wrapped data key for the model,
+	// unless the model already has one, and returns the data key of the
+	// model.
+	EnsureModelSecretDataKey(ctx context.Context, modelUUID coremodel.UUID, key WrappedDataKey) (WrappedDataKey, error)
+}
+
+// SealSecretContent encrypts the content of a secret revision stored by the
+// internal secret backend with the data key of the model, creating the data
+// key if the model has none. The content is returned unchanged if the
+// controller has no master key source.
+func SealSecretContent(
+	ctx context.Context, st DataKeyState, modelUUID coremodel.UUID, rev encryption.SecretRevision, data coresecrets.SecretData,
+) (coresecrets.SecretData, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	masterKey, err := LoadMasterKey(ctx, st)
+	if err != nil {
+		return nil, errors.Capture(err)
+	} else if masterKey == nil {
+		return data, nil
+	}
+
+	wrapped, err := st.GetModelSecretDataKey(ctx, modelUUID)
+	if errors.Is(err, secretbackenderrors.DataKeyNotFound) {
+		wrapped, err = createDataKey(ctx, st, masterKey, modelUUID)
+	}
+	if err != nil {
+		return nil, errors.Errorf("getting secret data key: %w", err)
+	}
+	dataKey, err := unwrapDataKey(ctx, st, masterKey, wrapped)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	sealed, err := encryption.SealData(dataKey, rev, data)
+	if err != nil {
+		return nil, errors.Errorf("encrypting secret content: %w", err)
+	}
+	return sealed, nil
+}
+
+// OpenSecretContent decrypts the content of a secret revision stored by the
+// internal secret backend. Content stored before encryption was enabled is
+// returned unchanged.
+func OpenSecretContent(
+	ctx context.Context, st DataKeyReader, modelUUID coremodel.UUID, rev encryption.SecretRevision, data coresecrets.SecretData,
+) (coresecrets.SecretData, error) {
+	if !encryption.HasSealed(data) {
+		return data, nil
+	}
+	masterKey, err := LoadMasterKey(ctx, st)
+	if err != nil {
+		return nil, errors.Capture(err)
+	} else if masterKey == nil {
+		return nil, errors.Errorf("secret content is encrypted but no master key source is configured").
+			Add(secretbackenderrors.EncryptionNotConfigured)
+	}
+
+	wrapped, err := st.GetModelSecretDataKey(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Errorf("getting secret data key: %w", err)
+	}
+	dataKey, err := unwrapDataKey(ctx, st, masterKey, wrapped)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	opened, err := encryption.OpenData(dataKey, rev, data)
+	if err != nil {
+		return nil, errors.Errorf("decrypting secret content: %w", err)
+	}
+	return opened, nil
+}
+
+// masterKeyCacheTTL is how long a master key loaded from its source is used
+// before the source is read again. It bounds how long a controller which did
+// not run a rotation keeps wrapping new data keys with the previous active
+// key.
+const masterKeyCacheTTL = 5 * time.Minute
+
+// defaultMasterKeys caches the master key for the secret services of the
+// controller, which are created for each request.
+var defaultMasterKeys = NewMasterKeyCache(clock.WallClock)
+
+// LoadMasterKey returns the master key configured for the controller, or
+// nil if secret content is not encrypted. The key is read from its source
+// when the configured source changes, and otherwise at most once every
+// masterKeyCacheTTL.
+func LoadMasterKey(ctx context.Context, st DataKeyReader) (encryption.MasterKey, error) {
+	return defaultMasterKeys.Load(ctx, st)
+}
+
+// ReloadMasterKey reads the master key configured for the controller from
+// its source, replacing any cached key. It returns nil if secret content is
+// not encrypted.
+func ReloadMasterKey(ctx context.Context, st DataKeyReader) (encryption.MasterKey, error) {
+	return defaultMasterKeys.Reload(ctx, st)
+}
+
+// unwrapDataKey unwraps the data key with the master key. If the data key
+// is wrapped by a key the master key doesn't hold, the master key is read
+// again from its source, in case the data key was rewrapped with a key
+// added to the source since it was cached.
+func unwrapDataKey(
+	ctx context.Context, st DataKeyReader, masterKey encryption.MasterKey, wrapped WrappedDataKey,
+) ([]byte, error) {
+	dataKey, err := masterKey.Unwrap(wrapped.MasterKeyID, wrapped.Key)
+	if errors.Is(err, encryption.KeyNotFound) {
+		if masterKey, err = ReloadMasterKey(ctx, st); err != nil {
+			return nil, errors.Capture(err)
+		} else if masterKey == nil {
+			return nil, errors.Errorf("secret content is encrypted but no master key source is configured").
+				Add(secretbackenderrors.EncryptionNotConfigured)
+		}
+		dataKey, err = masterKey.Unwrap(wrapped.MasterKeyID, wrapped.Key)
+	}
+	if err != nil {
+		return nil, errors.Errorf("unwrapping secret data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+// MasterKeyCache caches the master key read from the master key source in
+// the controller config, so that the source is not read to seal or open
+// each secret.
+type MasterKeyCache struct {
+	clock clock.Clock
+
+	mu       sync.Mutex
+	source   encryption.Source
+	key      encryption.MasterKey
+	loadedAt time.Time
+}
+
+// NewMasterKeyCache returns an empty master key cache.
+func NewMasterKeyCache(clock clock.Clock) *MasterKeyCache {
+	return &MasterKeyCache{clock: clock}
+}
+
+// Load returns the master key configured for the controller, or nil if
+// secret content is not encrypted. The cached key is returned unless the
+// configured source has changed or the key was read more than
+// masterKeyCacheTTL ago.
+func (c *MasterKeyCache) Load(ctx context.Context, st DataKeyReader) (encryption.MasterKey, error) {
+	return c.load(ctx, st, false)
+}
+
+// Reload reads the master key configured for the controller from its
+// source, replacing the cached key.
+func (c *MasterKeyCache) Reload(ctx context.Context, st DataKeyReader) (encryption.MasterKey, error) {
+	return c.load(ctx, st, true)
+}
+
+func (c *MasterKeyCache) load(ctx context.Context, st DataKeyReader, force bool) (encryption.MasterKey, error) {
+	value, err := st.GetSecretEncryptionMasterKeySource(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting secret encryption master key source: %w", err)
+	}
+	source, err := encryption.ParseSource(value)
+	if err != nil {
+		return nil, errors.Capture(err)
+	} else if source.IsZero() {
+		return nil, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	if !force && c.key != nil && c.source == source && now.Before(c.loadedAt.Add(masterKeyCacheTTL)) {
+		return c.key, nil
+	}
+	masterKey, err := source.Load()
+	if err != nil {
+		return nil, errors.Errorf("loading secret encryption master key from %q: %w", source, err)
+	}
+	c.source, c.key, c.loadedAt = source, masterKey, now
+	return masterKey, nil
+}
+
+func createDataKey(
+	ctx context.Context, st DataKeyState, masterKey encryption.MasterKey, modelUUID coremodel.UUID,
+) (WrappedDataKey, error) {
+	dataKey, err := encryption.GenerateDataKey()
+	if err != nil {
+		return WrappedDataKey{}, errors.Capture(err)
+	}
+	wrapped, err := masterKey.Wrap(dataKey)
+	if err != nil {
+		return WrappedDataKey{}, errors.Errorf("wrapping secret data key: %w", err)
+	}
+	// Another controller may have created the data key concurrently, in
+	// which case that key is returned.
+	return st.EnsureModelSecretDataKey(ctx, modelUUID, WrappedDataKey{
+		MasterKeyID: masterKey.ActiveKeyID(),
+		Key:         wrapped,
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretbackend
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/secrets/encryption"
+)
+
+type masterKeyCacheSuite struct{}
+
+func TestMasterKeyCacheSuite(t *testing.T) {
+	tc.Run(t, &masterKeyCacheSuite{})
+}
+
+type sourceReader struct {
+	source string
+}
+
+func (r *sourceReader) GetSecretEncryptionMasterKeySource(context.Context) (string, error) {
+	return r.source, nil
+}
+
+func (r *sourceReader) GetModelSecretDataKey(context.Context, coremodel.UUID) (WrappedDataKey, error) {
+	return WrappedDataKey{}, nil
+}
+
+func writeMasterKeys(c *tc.C, path string, count int) {
+	var content string
+	for range count {
+		key, err := encryption.GenerateDataKey()
+		c.Assert(err, tc.ErrorIsNil)
+		content += base64.StdEncoding.EncodeToString(key) + "\n"
+	}
+	err := os.WriteFile(path, []byte(content), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *masterKeyCacheSuite) TestLoadNotConfigured(c *tc.C) {
+	cache := NewMasterKeyCache(testclock.NewClock(time.Now()))
+
+	masterKey, err := cache.Load(c.Context(), &sourceReader{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(masterKey, tc.IsNil)
+}
+
+func (s *masterKeyCacheSuite) TestLoadCachesUntilExpiry(c *tc.C) {
+	path := filepath.Join(c.MkDir(), "secret.keys")
+	writeMasterKeys(c, path, 1)
+	clock := testclock.NewClock(time.Now())
+	cache := NewMasterKeyCache(clock)
+	st := &sourceReader{source: "file:" + path}
+
+	first, err := cache.Load(c.Context(), st)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A key added to the source is not seen until the cached key expires.
+	writeMasterKeys(c, path, 1)
+	cached, err := cache.Load(c.Context(), st)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cached.ActiveKeyID(), tc.Equals, first.ActiveKeyID())
+
+	clock.Advance(masterKeyCacheTTL)
+	reloaded, err := cache.Load(c.Context(), st)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(reloaded.ActiveKeyID(), tc.Not(tc.Equals), first.ActiveKeyID())
+}
+
+func (s *masterKeyCacheSuite) TestLoadSourceChanged(c *tc.C) {
+	dir := c.MkDir()
+	writeMasterKeys(c, filepath.Join(dir, "a.keys"), 1)
+	writeMasterKeys(c, filepath.Join(dir, "b.keys"), 1)
+	cache := NewMasterKeyCache(testclock.NewClock(time.Now()))
+	st := &sourceReader{source: "file:" + filepath.Join(dir, "a.keys")}
+
+	first, err := cache.Load(c.Context(), st)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st.source = "file:" + filepath.Join(dir, "b.keys")
+	second, err := cache.Load(c.Context(), st)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(second.ActiveKeyID(), tc.Not(tc.Equals), first.ActiveKeyID())
+}
+
+func (s *masterKeyCacheSuite) TestReload(c *tc.C) {
+	path := filepath.Join(c.MkDir(), "secret.keys")
+	writeMasterKeys(c, path, 1)
+	cache := NewMasterKeyCache(testclock.NewClock(time.Now()))
+	st := &sourceReader{source: "file:" + path}
+
+	first, err := cache.Load(c.Context(), st)
+	c.Assert(err, tc.ErrorIsNil)
+
+	writeMasterKeys(c, path, 1)
+	reloaded, err := cache.Reload(c.Context(), st)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(reloaded.ActiveKeyID(), tc.Not(tc.Equals), first.ActiveKeyID())
+
+	// The reloaded key replaces the cached key.
+	cached, err := cache.Load(c.Context(), st)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cached.ActiveKeyID(), tc.Equals, reloaded.ActiveKeyID())
+}
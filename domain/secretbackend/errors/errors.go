@@ -26,4 +26,12 @@ const (
 
 	// NotSupported describes an error that occurs when the secret backend is not supported.
 	NotSupported = errors.ConstError("secret backend not supported")
+
+	// DataKeyNotFound describes an error that occurs when a model has no
+	// data key encrypting its secret content.
+	DataKeyNotFound = errors.ConstError("secret data key not found")
+
+	// EncryptionNotConfigured describes an error that occurs when secret
+	// content is encrypted but the controller has no master key source.
+	EncryptionNotConfigured = errors.ConstError("secret encryption not configured")
 )
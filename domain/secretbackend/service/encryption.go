@@ -0,0 +1,67 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/secretbackend"
+	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// RotateSecretEncryptionKey rewraps the secret data key of each model with
+// the active master key, returning the number of data keys rewrapped. The
+// secret content itself is not re-encrypted, so the rotation does not
+// require downtime. Once it completes, master keys other than the active
+// key can be removed from the master key source.
+func (s *Service) RotateSecretEncryptionKey(ctx context.Context) (int, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	// The source is read again, and the cached key replaced, since the
+	// rotation follows a change to the keys in the source.
+	masterKey, err := secretbackend.ReloadMasterKey(ctx, s.st)
+	if err != nil {
+		return 0, errors.Capture(err)
+	} else if masterKey == nil {
+		return 0, errors.Errorf("no secret encryption master key source configured").
+			Add(secretbackenderrors.EncryptionNotConfigured)
+	}
+
+	keys, err := s.st.ListModelSecretDataKeys(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	activeID := masterKey.ActiveKeyID()
+	rewrapped := 0
+	for _, key := range keys {
+		if key.MasterKeyID == activeID {
+			continue
+		}
+		dataKey, err := masterKey.Unwrap(key.MasterKeyID, key.Key)
+		if err != nil {
+			return rewrapped, errors.Errorf("unwrapping secret data key for model %q: %w", key.ModelID, err)
+		}
+		wrapped, err := masterKey.Wrap(dataKey)
+		if err != nil {
+			return rewrapped, errors.Errorf("wrapping secret data key for model %q: %w", key.ModelID, err)
+		}
+		err = s.st.RewrapModelSecretDataKey(ctx, key.ModelID, key.MasterKeyID, secretbackend.WrappedDataKey{
+			MasterKeyID: activeID,
+			Key:         wrapped,
+		})
+		if errors.Is(err, secretbackenderrors.DataKeyNotFound) {
+			// The data key was rewrapped concurrently, or the model removed.
+			s.logger.Debugf(ctx, "secret data key for model %q already rewrapped", key.ModelID)
+			continue
+		} else if err != nil {
+			return rewrapped, errors.Capture(err)
+		}
+		rewrapped++
+	}
+	s.logger.Infof(ctx, "rewrapped %d secret data keys with master key %q", rewrapped, activeID)
+	return rewrapped, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/domain/secretbackend"
+	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
+)
+
+func (s *serviceSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	path := filepath.Join(c.MkDir(), "secret.keys")
+	oldKey := newMasterKey(c)
+	err := os.WriteFile(path, []byte(oldKey+"\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	oldMasterKey, err := encryption.LoadFileKeys(path)
+	c.Assert(err, tc.ErrorIsNil)
+
+	dataKey, err := encryption.GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+	wrapped, err := oldMasterKey.Wrap(dataKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Add a new active master key ahead of the old one.
+	err = os.WriteFile(path, []byte(newMasterKey(c)+"\n"+oldKey+"\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	masterKey, err := encryption.LoadFileKeys(path)
+	c.Assert(err, tc.ErrorIsNil)
+
+	rotatedModel := tc.Must0(c, coremodel.NewUUID)
+	currentModel := tc.Must0(c, coremodel.NewUUID)
+	s.mockState.EXPECT().GetSecretEncryptionMasterKeySource(gomock.Any()).Return("file:"+path, nil)
+	s.mockState.EXPECT().ListModelSecretDataKeys(gomock.Any()).Return([]secretbackend.ModelWrappedDataKey{{
+		ModelID: rotatedModel,
+		WrappedDataKey: secretbackend.WrappedDataKey{
+			MasterKeyID: oldMasterKey.ActiveKeyID(),
+			Key:         wrapped,
+		},
+	}, {
+		ModelID: currentModel,
+		WrappedDataKey: secretbackend.WrappedDataKey{
+			MasterKeyID: masterKey.ActiveKeyID(),
+			Key:         []byte("current"),
+		},
+	}}, nil)
+	var rewrapped secretbackend.WrappedDataKey
+	s.mockState.EXPECT().RewrapModelSecretDataKey(gomock.Any(), rotatedModel, oldMasterKey.ActiveKeyID(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ coremodel.UUID, _ string, key secretbackend.WrappedDataKey) error {
+			rewrapped = key
+			return nil
+		})
+
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+	count, err := svc.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 1)
+
+	c.Check(rewrapped.MasterKeyID, tc.Equals, masterKey.ActiveKeyID())
+	unwrapped, err := masterKey.Unwrap(rewrapped.MasterKeyID, rewrapped.Key)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unwrapped, tc.DeepEquals, dataKey)
+}
+
+func (s *serviceSuite) TestRotateSecretEncryptionKeyNotConfigured(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.mockState.EXPECT().GetSecretEncryptionMasterKeySource(gomock.Any()).Return("", nil)
+
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+	_, err := svc.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIs, secretbackenderrors.EncryptionNotConfigured)
+}
+
+func newMasterKey(c *tc.C) string {
+	key, err := encryption.GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+	return base64.StdEncoding.EncodeToString(key)
+}
@@ -35,6 +35,13 @@ type State interface {
 	InitialWatchStatementForSecretBackendRotationChanges() (string, string)
 	GetSecretBackendRotateChanges(ctx context.Context, backendIDs ...string) ([]watcher.SecretBackendRotateChange, error)
 	NamespaceForWatchModelSecretBackend() string
+
+	GetSecretEncryptionMasterKeySource(ctx context.Context) (string, error)
+	GetModelSecretDataKey(ctx context.Context, modelUUID coremodel.UUID) (secretbackend.WrappedDataKey, error)
+	ListModelSecretDataKeys(ctx context.Context) ([]secretbackend.ModelWrappedDataKey, error)
+	RewrapModelSecretDataKey(
+		ctx context.Context, modelUUID coremodel.UUID, previousMasterKeyID string, key secretbackend.WrappedDataKey,
+	) error
 }
 
 // AdminBackendConfigGetterFunc returns a function that gets the
@@ -199,6 +199,45 @@ func (c *MockStateGetModelSecretBackendDetailsCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// GetModelSecretDataKey mocks base method.
+func (m *MockState) GetModelSecretDataKey(arg0 context.Context, arg1 model.UUID) (secretbackend.WrappedDataKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelSecretDataKey", arg0, arg1)
+	ret0, _ := ret[0].(secretbackend.WrappedDataKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelSecretDataKey indicates an expected call of GetModelSecretDataKey.
+func (mr *MockStateMockRecorder) GetModelSecretDataKey(arg0, arg1 any) *MockStateGetModelSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelSecretDataKey", reflect.TypeOf((*MockState)(nil).GetModelSecretDataKey), arg0, arg1)
+	return &MockStateGetModelSecretDataKeyCall{Call: call}
+}
+
+// MockStateGetModelSecretDataKeyCall wrap *gomock.Call
+type MockStateGetModelSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetModelSecretDataKeyCall) Return(arg0 secretbackend.WrappedDataKey, arg1 error) *MockStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetModelSecretDataKeyCall) Do(f func(context.Context, model.UUID) (secretbackend.WrappedDataKey, error)) *MockStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetModelSecretDataKeyCall) DoAndReturn(f func(context.Context, model.UUID) (secretbackend.WrappedDataKey, error)) *MockStateGetModelSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetModelType mocks base method.
 func (m *MockState) GetModelType(arg0 context.Context, arg1 model.UUID) (model.ModelType, error) {
 	m.ctrl.T.Helper()
@@ -321,6 +360,45 @@ func (c *MockStateGetSecretBackendRotateChangesCall) DoAndReturn(f func(context.
 	return c
 }
 
+// GetSecretEncryptionMasterKeySource mocks base method.
+func (m *MockState) GetSecretEncryptionMasterKeySource(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretEncryptionMasterKeySource", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretEncryptionMasterKeySource indicates an expected call of GetSecretEncryptionMasterKeySource.
+func (mr *MockStateMockRecorder) GetSecretEncryptionMasterKeySource(arg0 any) *MockStateGetSecretEncryptionMasterKeySourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretEncryptionMasterKeySource", reflect.TypeOf((*MockState)(nil).GetSecretEncryptionMasterKeySource), arg0)
+	return &MockStateGetSecretEncryptionMasterKeySourceCall{Call: call}
+}
+
+// MockStateGetSecretEncryptionMasterKeySourceCall wrap *gomock.Call
+type MockStateGetSecretEncryptionMasterKeySourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretEncryptionMasterKeySourceCall) Return(arg0 string, arg1 error) *MockStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretEncryptionMasterKeySourceCall) Do(f func(context.Context) (string, error)) *MockStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretEncryptionMasterKeySourceCall) DoAndReturn(f func(context.Context) (string, error)) *MockStateGetSecretEncryptionMasterKeySourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementForSecretBackendRotationChanges mocks base method.
 func (m *MockState) InitialWatchStatementForSecretBackendRotationChanges() (string, string) {
 	m.ctrl.T.Helper()
@@ -360,6 +438,45 @@ func (c *MockStateInitialWatchStatementForSecretBackendRotationChangesCall) DoAn
 	return c
 }
 
+// ListModelSecretDataKeys mocks base method.
+func (m *MockState) ListModelSecretDataKeys(arg0 context.Context) ([]secretbackend.ModelWrappedDataKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModelSecretDataKeys", arg0)
+	ret0, _ := ret[0].([]secretbackend.ModelWrappedDataKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModelSecretDataKeys indicates an expected call of ListModelSecretDataKeys.
+func (mr *MockStateMockRecorder) ListModelSecretDataKeys(arg0 any) *MockStateListModelSecretDataKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModelSecretDataKeys", reflect.TypeOf((*MockState)(nil).ListModelSecretDataKeys), arg0)
+	return &MockStateListModelSecretDataKeysCall{Call: call}
+}
+
+// MockStateListModelSecretDataKeysCall wrap *gomock.Call
+type MockStateListModelSecretDataKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListModelSecretDataKeysCall) Return(arg0 []secretbackend.ModelWrappedDataKey, arg1 error) *MockStateListModelSecretDataKeysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListModelSecretDataKeysCall) Do(f func(context.Context) ([]secretbackend.ModelWrappedDataKey, error)) *MockStateListModelSecretDataKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListModelSecretDataKeysCall) DoAndReturn(f func(context.Context) ([]secretbackend.ModelWrappedDataKey, error)) *MockStateListModelSecretDataKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListSecretBackendIDs mocks base method.
 func (m *MockState) ListSecretBackendIDs(arg0 context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -515,6 +632,44 @@ func (c *MockStateNamespaceForWatchModelSecretBackendCall) DoAndReturn(f func()
 	return c
 }
 
+// RewrapModelSecretDataKey mocks base method.
+func (m *MockState) RewrapModelSecretDataKey(arg0 context.Context, arg1 model.UUID, arg2 string, arg3 secretbackend.WrappedDataKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewrapModelSecretDataKey", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RewrapModelSecretDataKey indicates an expected call of RewrapModelSecretDataKey.
+func (mr *MockStateMockRecorder) RewrapModelSecretDataKey(arg0, arg1, arg2, arg3 any) *MockStateRewrapModelSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewrapModelSecretDataKey", reflect.TypeOf((*MockState)(nil).RewrapModelSecretDataKey), arg0, arg1, arg2, arg3)
+	return &MockStateRewrapModelSecretDataKeyCall{Call: call}
+}
+
+// MockStateRewrapModelSecretDataKeyCall wrap *gomock.Call
+type MockStateRewrapModelSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRewrapModelSecretDataKeyCall) Return(arg0 error) *MockStateRewrapModelSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRewrapModelSecretDataKeyCall) Do(f func(context.Context, model.UUID, string, secretbackend.WrappedDataKey) error) *MockStateRewrapModelSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRewrapModelSecretDataKeyCall) DoAndReturn(f func(context.Context, model.UUID, string, secretbackend.WrappedDataKey) error) *MockStateRewrapModelSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SecretBackendRotated mocks base method.
 func (m *MockState) SecretBackendRotated(arg0 context.Context, arg1 string, arg2 time.Time) error {
 	m.ctrl.T.Helper()
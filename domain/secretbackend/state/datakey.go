@@ -0,0 +1,216 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/controller"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/domain/secretbackend"
+	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetSecretEncryptionMasterKeySource returns the source of the master key
+// encrypting secret content from the controller config, or an empty string
+// if secret content is not encrypted.
+func (s *State) GetSecretEncryptionMasterKeySource(ctx context.Context) (string, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	value := controllerConfigValue{Key: controller.SecretEncryptionMasterKey}
+	stmt, err := s.Prepare(`
+SELECT value AS &controllerConfigValue.value
+FROM   v_controller_config
+WHERE  key = $controllerConfigValue.key`, value)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, value).Get(&value)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return "", errors.Errorf("getting %s from controller config: %w", controller.SecretEncryptionMasterKey, err)
+	}
+	return value.Value, nil
+}
+
+// GetModelSecretDataKey returns the wrapped data key encrypting the secret
+// content of the model, returning an error satisfying
+// [secretbackenderrors.DataKeyNotFound] if the model has none.
+func (s *State) GetModelSecretDataKey(ctx context.Context, modelUUID coremodel.UUID) (secretbackend.WrappedDataKey, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return secretbackend.WrappedDataKey{}, errors.Capture(err)
+	}
+
+	var key modelDataKey
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		key, err = s.getModelSecretDataKey(ctx, tx, modelUUID)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return secretbackend.WrappedDataKey{}, errors.Capture(err)
+	}
+	return secretbackend.WrappedDataKey{
+		MasterKeyID: key.MasterKeyID,
+		Key:         key.WrappedKey,
+	}, nil
+}
+
+// EnsureModelSecretDataKey stores the wrapped data key for the model, unless
+// the model already has one, and returns the data key of the model.
+func (s *State) EnsureModelSecretDataKey(
+	ctx context.Context, modelUUID coremodel.UUID, key secretbackend.WrappedDataKey,
+) (secretbackend.WrappedDataKey, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return secretbackend.WrappedDataKey{}, errors.Capture(err)
+	}
+
+	input := modelDataKey{
+		ModelID:     modelUUID,
+		WrappedKey:  key.Key,
+		MasterKeyID: key.MasterKeyID,
+	}
+	insertStmt, err := s.Prepare(`
+INSERT INTO model_secret_data_key (*) VALUES ($modelDataKey.*)
+ON CONFLICT (model_uuid) DO NOTHING`, input)
+	if err != nil {
+		return secretbackend.WrappedDataKey{}, errors.Capture(err)
+	}
+
+	var stored modelDataKey
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, insertStmt, input).Run(); err != nil {
+			return errors.Errorf("inserting secret data key for model %q: %w", modelUUID, err)
+		}
+		var err error
+		stored, err = s.getModelSecretDataKey(ctx, tx, modelUUID)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return secretbackend.WrappedDataKey{}, errors.Capture(err)
+	}
+	return secretbackend.WrappedDataKey{
+		MasterKeyID: stored.MasterKeyID,
+		Key:         stored.WrappedKey,
+	}, nil
+}
+
+func (s *State) getModelSecretDataKey(ctx context.Context, tx *sqlair.TX, modelUUID coremodel.UUID) (modelDataKey, error) {
+	key := modelDataKey{ModelID: modelUUID}
+	stmt, err := s.Prepare(`
+SELECT &modelDataKey.*
+FROM   model_secret_data_key
+WHERE  model_uuid = $modelDataKey.model_uuid`, key)
+	if err != nil {
+		return modelDataKey{}, errors.Capture(err)
+	}
+	err = tx.Query(ctx, stmt, key).Get(&key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return modelDataKey{}, errors.Errorf("model %q", modelUUID).Add(secretbackenderrors.DataKeyNotFound)
+	} else if err != nil {
+		return modelDataKey{}, errors.Capture(err)
+	}
+	return key, nil
+}
+
+// ListModelSecretDataKeys returns the wrapped data keys of all models.
+func (s *State) ListModelSecretDataKeys(ctx context.Context) ([]secretbackend.ModelWrappedDataKey, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := s.Prepare(`
+SELECT &modelDataKey.*
+FROM   model_secret_data_key`, modelDataKey{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var keys []modelDataKey
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&keys)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing secret data keys: %w", err)
+	}
+
+	result := make([]secretbackend.ModelWrappedDataKey, len(keys))
+	for i, key := range keys {
+		result[i] = secretbackend.ModelWrappedDataKey{
+			ModelID: key.ModelID,
+			WrappedDataKey: secretbackend.WrappedDataKey{
+				MasterKeyID: key.MasterKeyID,
+				Key:         key.WrappedKey,
+			},
+		}
+	}
+	return result, nil
+}
+
+// RewrapModelSecretDataKey replaces the data key of the model wrapped by the
+// master key identified by previousMasterKeyID with the same data key
+// wrapped by a new master key. It returns an error satisfying
+// [secretbackenderrors.DataKeyNotFound] if the model has no data key
+// wrapped by the previous master key, such as when the key has already been
+// rewrapped.
+func (s *State) RewrapModelSecretDataKey(
+	ctx context.Context, modelUUID coremodel.UUID, previousMasterKeyID string, key secretbackend.WrappedDataKey,
+) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	input := rewrappedDataKey{
+		ModelID:             modelUUID,
+		WrappedKey:          key.Key,
+		MasterKeyID:         key.MasterKeyID,
+		PreviousMasterKeyID: previousMasterKeyID,
+	}
+	stmt, err := s.Prepare(`
+UPDATE model_secret_data_key
+SET    wrapped_key = $rewrappedDataKey.wrapped_key,
+       master_key_id = $rewrappedDataKey.master_key_id
+WHERE  model_uuid = $rewrappedDataKey.model_uuid
+AND    master_key_id = $rewrappedDataKey.previous_master_key_id`, input)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, input).Get(&outcome); err != nil {
+			return errors.Errorf("rewrapping secret data key for model %q: %w", modelUUID, err)
+		}
+		affected, err := outcome.Result().RowsAffected()
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if affected == 0 {
+			return errors.Errorf("model %q with master key %q", modelUUID, previousMasterKeyID).
+				Add(secretbackenderrors.DataKeyNotFound)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/tc"
+
+	coremodel "github.com/juju/juju/core/model"
+	controllerconfigstate "github.com/juju/juju/domain/controllerconfig/state"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+)
+
+func (s *stateSuite) TestGetSecretEncryptionMasterKeySourceNotSet(c *tc.C) {
+	source, err := s.state.GetSecretEncryptionMasterKeySource(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(source, tc.Equals, "")
+}
+
+func (s *stateSuite) TestGetSecretEncryptionMasterKeySource(c *tc.C) {
+	ccState := controllerconfigstate.NewState(s.TxnRunnerFactory())
+	err := ccState.UpdateControllerConfig(c.Context(), map[string]string{
+		"secret-encryption-master-key": "file:/etc/juju/secret.keys",
+	}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	source, err := s.state.GetSecretEncryptionMasterKeySource(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(source, tc.Equals, "file:/etc/juju/secret.keys")
+}
+
+func (s *stateSuite) TestGetModelSecretDataKeyNotFound(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+
+	_, err := s.state.GetModelSecretDataKey(c.Context(), modelUUID)
+	c.Check(err, tc.ErrorIs, backenderrors.DataKeyNotFound)
+}
+
+func (s *stateSuite) TestEnsureModelSecretDataKey(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+
+	first := secretbackend.WrappedDataKey{MasterKeyID: "file:a", Key: []byte("first")}
+	key, err := s.state.EnsureModelSecretDataKey(c.Context(), modelUUID, first)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, first)
+
+	// A second key for the same model is discarded in favour of the first.
+	key, err = s.state.EnsureModelSecretDataKey(c.Context(), modelUUID, secretbackend.WrappedDataKey{
+		MasterKeyID: "file:b", Key: []byte("second"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, first)
+
+	key, err = s.state.GetModelSecretDataKey(c.Context(), modelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, first)
+}
+
+func (s *stateSuite) TestListModelSecretDataKeys(c *tc.C) {
+	keys, err := s.state.ListModelSecretDataKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys, tc.HasLen, 0)
+
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	key := secretbackend.WrappedDataKey{MasterKeyID: "file:a", Key: []byte("key")}
+	_, err = s.state.EnsureModelSecretDataKey(c.Context(), modelUUID, key)
+	c.Assert(err, tc.ErrorIsNil)
+
+	keys, err = s.state.ListModelSecretDataKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys, tc.DeepEquals, []secretbackend.ModelWrappedDataKey{{
+		ModelID:        modelUUID,
+		WrappedDataKey: key,
+	}})
+}
+
+func (s *stateSuite) TestRewrapModelSecretDataKey(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	_, err := s.state.EnsureModelSecretDataKey(c.Context(), modelUUID, secretbackend.WrappedDataKey{
+		MasterKeyID: "file:a", Key: []byte("old"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	rewrapped := secretbackend.WrappedDataKey{MasterKeyID: "file:b", Key: []byte("new")}
+	err = s.state.RewrapModelSecretDataKey(c.Context(), modelUUID, "file:a", rewrapped)
+	c.Assert(err, tc.ErrorIsNil)
+
+	key, err := s.state.GetModelSecretDataKey(c.Context(), modelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, rewrapped)
+
+	// The key is no longer wrapped by the previous master key.
+	err = s.state.RewrapModelSecretDataKey(c.Context(), modelUUID, "file:a", rewrapped)
+	c.Check(err, tc.ErrorIs, backenderrors.DataKeyNotFound)
+}
@@ -423,3 +423,36 @@ type Count struct {
 type entityUUID struct {
 	UUID string `db:"uuid"`
 }
+
+// controllerConfigValue represents a value of the controller config.
+type controllerConfigValue struct {
+	// Key is the controller config key.
+	Key string `db:"key"`
+	// Value is the value of the key.
+	Value string `db:"value"`
+}
+
+// modelDataKey represents a row of the model_secret_data_key table.
+type modelDataKey struct {
+	// ModelID is the unique identifier for the model.
+	ModelID coremodel.UUID `db:"model_uuid"`
+	// WrappedKey is the data key of the model, wrapped by a master key.
+	WrappedKey []byte `db:"wrapped_key"`
+	// MasterKeyID identifies the master key that wrapped the data key.
+	MasterKeyID string `db:"master_key_id"`
+}
+
+// rewrappedDataKey represents a data key wrapped by a new master key, to
+// replace the data key wrapped by the previous master key.
+type rewrappedDataKey struct {
+	// ModelID is the unique identifier for the model.
+	ModelID coremodel.UUID `db:"model_uuid"`
+	// WrappedKey is the data key of the model, wrapped by the new master
+	// key.
+	WrappedKey []byte `db:"wrapped_key"`
+	// MasterKeyID identifies the new master key.
+	MasterKeyID string `db:"master_key_id"`
+	// PreviousMasterKeyID identifies the master key that wrapped the data
+	// key being replaced.
+	PreviousMasterKeyID string `db:"previous_master_key_id"`
+}
@@ -22,3 +22,19 @@ type ModelSecretBackend struct {
 	// SecretBackendName is the name of the secret backend configured for the model.
 	SecretBackendName string
 }
+
+// WrappedDataKey is the data key encrypting the secret content of a model,
+// wrapped by a controller master key.
+type WrappedDataKey struct {
+	// MasterKeyID identifies the master key that wrapped the data key.
+	MasterKeyID string
+	// Key is the wrapped data key.
+	Key []byte
+}
+
+// ModelWrappedDataKey is the wrapped data key of a model.
+type ModelWrappedDataKey struct {
+	WrappedDataKey
+	// ModelID is the unique identifier for the model.
+	ModelID coremodel.UUID
+}
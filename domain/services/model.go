@@ -49,6 +49,8 @@ import (
 	crossmodelrelationservice "github.com/juju/juju/domain/crossmodelrelation/service"
 	crossmodelrelationstatecontroller "github.com/juju/juju/domain/crossmodelrelation/state/controller"
 	crossmodelrelationstatemodel "github.com/juju/juju/domain/crossmodelrelation/state/model"
+	desiredbundleservice "github.com/juju/juju/domain/desiredbundle/service"
+	desiredbundlestate "github.com/juju/juju/domain/desiredbundle/state"
 	exportservice "github.com/juju/juju/domain/export/service"
 	exportstate "github.com/juju/juju/domain/export/state/model"
 	keymanagerservice "github.com/juju/juju/domain/keymanager/service"
@@ -65,6 +67,7 @@ import (
 	modelagentmodelstate "github.com/juju/juju/domain/modelagent/state/model"
 	modelconfigservice "github.com/juju/juju/domain/modelconfig/service"
 	modelconfigstate "github.com/juju/juju/domain/modelconfig/state"
+	modelconfigvalidators "github.com/juju/juju/domain/modelconfig/validators"
 	modeldefaultsservice "github.com/juju/juju/domain/modeldefaults/service"
 	modeldefaultsstate "github.com/juju/juju/domain/modeldefaults/state"
 	modelmigrationservice "github.com/juju/juju/domain/modelmigration/service"
@@ -235,9 +238,17 @@ func (s *ModelServices) Config() *modelconfigservice.WatchableService {
 		)).ModelDefaultsProvider(s.modelUUID)
 
 	st := modelconfigstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB))
+	modelValidator := &config.AggregateValidator{
+		Validators: []config.Validator{
+			config.ModelValidator(),
+			modelconfigvalidators.EgressDefaultDenySupport(
+				providertracker.ProviderRunner[modelconfigvalidators.EgressRulesProvider](s.providerFactory, s.modelUUID.String()),
+			),
+		},
+	}
 	return modelconfigservice.NewWatchableService(
 		defaultsProvider,
-		config.ModelValidator(),
+		modelValidator,
 		modelconfigservice.ProviderModelConfigGetter(),
 		st,
 		s.modelWatcherFactory("modelconfig"),
@@ -381,6 +392,14 @@ func (s *ModelServices) Annotation() *annotationService.Service {
 	)
 }
 
+// DesiredBundle returns the service for the desired bundle of the model.
+func (s *ModelServices) DesiredBundle() *desiredbundleservice.Service {
+	return desiredbundleservice.NewService(
+		desiredbundlestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.clock,
+	)
+}
+
 // Storage returns the model's storage service.
 func (s *ModelServices) Storage() *storageservice.Service {
 	return storageservice.NewService(
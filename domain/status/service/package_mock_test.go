@@ -1333,6 +1333,45 @@ func (c *MockModelStateGetStorageInstancesCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// GetStorageUsageWarnings mocks base method.
+func (m *MockModelState) GetStorageUsageWarnings(ctx context.Context) ([]status.StorageUsageWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageUsageWarnings", ctx)
+	ret0, _ := ret[0].([]status.StorageUsageWarning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageUsageWarnings indicates an expected call of GetStorageUsageWarnings.
+func (mr *MockModelStateMockRecorder) GetStorageUsageWarnings(ctx any) *MockModelStateGetStorageUsageWarningsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageUsageWarnings", reflect.TypeOf((*MockModelState)(nil).GetStorageUsageWarnings), ctx)
+	return &MockModelStateGetStorageUsageWarningsCall{Call: call}
+}
+
+// MockModelStateGetStorageUsageWarningsCall wrap *gomock.Call
+type MockModelStateGetStorageUsageWarningsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateGetStorageUsageWarningsCall) Return(arg0 []status.StorageUsageWarning, arg1 error) *MockModelStateGetStorageUsageWarningsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateGetStorageUsageWarningsCall) Do(f func(context.Context) ([]status.StorageUsageWarning, error)) *MockModelStateGetStorageUsageWarningsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateGetStorageUsageWarningsCall) DoAndReturn(f func(context.Context) ([]status.StorageUsageWarning, error)) *MockModelStateGetStorageUsageWarningsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUnitAgentStatus mocks base method.
 func (m *MockModelState) GetUnitAgentStatus(arg0 context.Context, arg1 unit.UUID) (status.UnitStatusInfo[status.UnitAgentStatusType], error) {
 	m.ctrl.T.Helper()
@@ -1684,6 +1723,45 @@ func (c *MockModelStateGetVolumesCall) DoAndReturn(f func(context.Context, []sto
 	return c
 }
 
+// HasDesiredBundleDrift mocks base method.
+func (m *MockModelState) HasDesiredBundleDrift(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDesiredBundleDrift", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDesiredBundleDrift indicates an expected call of HasDesiredBundleDrift.
+func (mr *MockModelStateMockRecorder) HasDesiredBundleDrift(ctx any) *MockModelStateHasDesiredBundleDriftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDesiredBundleDrift", reflect.TypeOf((*MockModelState)(nil).HasDesiredBundleDrift), ctx)
+	return &MockModelStateHasDesiredBundleDriftCall{Call: call}
+}
+
+// MockModelStateHasDesiredBundleDriftCall wrap *gomock.Call
+type MockModelStateHasDesiredBundleDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateHasDesiredBundleDriftCall) Return(arg0 bool, arg1 error) *MockModelStateHasDesiredBundleDriftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateHasDesiredBundleDriftCall) Do(f func(context.Context) (bool, error)) *MockModelStateHasDesiredBundleDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateHasDesiredBundleDriftCall) DoAndReturn(f func(context.Context) (bool, error)) *MockModelStateHasDesiredBundleDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ImportRelationStatus mocks base method.
 func (m *MockModelState) ImportRelationStatus(ctx context.Context, relationUUID relation.UUID, sts status.StatusInfo[status.RelationStatusType]) error {
 	m.ctrl.T.Helper()
@@ -1761,6 +1839,44 @@ func (c *MockModelStateIsControllerModelCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// NamespacesForWatchModelStatus mocks base method.
+func (m *MockModelState) NamespacesForWatchModelStatus() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespacesForWatchModelStatus")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// NamespacesForWatchModelStatus indicates an expected call of NamespacesForWatchModelStatus.
+func (mr *MockModelStateMockRecorder) NamespacesForWatchModelStatus() *MockModelStateNamespacesForWatchModelStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespacesForWatchModelStatus", reflect.TypeOf((*MockModelState)(nil).NamespacesForWatchModelStatus))
+	return &MockModelStateNamespacesForWatchModelStatusCall{Call: call}
+}
+
+// MockModelStateNamespacesForWatchModelStatusCall wrap *gomock.Call
+type MockModelStateNamespacesForWatchModelStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateNamespacesForWatchModelStatusCall) Return(arg0 []string) *MockModelStateNamespacesForWatchModelStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateNamespacesForWatchModelStatusCall) Do(f func() []string) *MockModelStateNamespacesForWatchModelStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateNamespacesForWatchModelStatusCall) DoAndReturn(f func() []string) *MockModelStateNamespacesForWatchModelStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NamespacesForWatchOfferStatus mocks base method.
 func (m *MockModelState) NamespacesForWatchOfferStatus() (string, string, string, string, string) {
 	m.ctrl.T.Helper()
@@ -190,6 +190,15 @@ type ModelState interface {
 	// GetModelStatusInfo returns information about the current model.
 	GetModelStatusInfo(ctx context.Context) (status.ModelStatusInfo, error)
 
+	// HasDesiredBundleDrift returns true if the model was found to differ
+	// from its desired bundle when they were last compared.
+	HasDesiredBundleDrift(ctx context.Context) (bool, error)
+
+	// GetStorageUsageWarnings returns the storage attachments whose
+	// filesystem usage is at or past the model's storage usage warning
+	// threshold.
+	GetStorageUsageWarnings(ctx context.Context) ([]status.StorageUsageWarning, error)
+
 	// GetApplicationUUIDForOffer returns the UUID of the application that the
 	// specified offer belongs to.
 	GetApplicationUUIDForOffer(context.Context, string) (string, error)
@@ -198,6 +207,10 @@ type ModelState interface {
 	// for application status changes.
 	NamespacesForWatchOfferStatus() (offer, application, unitAgent, unitWorkload, unitPod string)
 
+	// NamespacesForWatchModelStatus returns the namespace string identifiers
+	// for changes to the status of the entities in the model.
+	NamespacesForWatchModelStatus() []string
+
 	// IsControllerModel returns if the model is a controller model.
 	IsControllerModel(ctx context.Context) (bool, error)
 }
@@ -947,7 +960,37 @@ func (s *Service) GetModelStatus(ctx context.Context) (corestatus.StatusInfo, er
 	if err != nil {
 		return corestatus.StatusInfo{}, errors.Capture(err)
 	}
-	return s.statusFromModelContext(modelState), nil
+	modelStatus := s.statusFromModelContext(modelState)
+	if modelStatus.Status != corestatus.Available {
+		return modelStatus, nil
+	}
+
+	// An available model that has drifted from its desired bundle says so,
+	// so that changes made to the model by hand are noticed.
+	drifted, err := s.modelState.HasDesiredBundleDrift(ctx)
+	if err != nil {
+		return corestatus.StatusInfo{}, errors.Errorf("checking desired bundle drift: %w", err)
+	}
+	var warnings []string
+	if drifted {
+		warnings = append(warnings, "model has drifted from its desired bundle")
+	}
+
+	// Storage filling up is also called out, as a full disk is a common
+	// cause of unit errors.
+	usageWarnings, err := s.modelState.GetStorageUsageWarnings(ctx)
+	if err != nil {
+		return corestatus.StatusInfo{}, errors.Errorf("checking storage usage: %w", err)
+	}
+	for _, w := range usageWarnings {
+		warnings = append(warnings, fmt.Sprintf(
+			"storage %s on unit %s is %d%% full", w.StorageID, w.Unit, w.UsedMiB*100/w.SizeMiB))
+	}
+
+	if len(warnings) > 0 {
+		modelStatus.Message = strings.Join(warnings, "; ")
+	}
+	return modelStatus, nil
 }
 
 // CheckMachineStatusesReadyForMigration returns an error if the statuses of any
@@ -2619,6 +2619,8 @@ func (s *serviceSuite) TestGetStatusAvailable(c *tc.C) {
 	}
 
 	s.controllerState.EXPECT().GetModelStatusContext(gomock.Any()).Return(modelStatusContext, nil)
+	s.modelState.EXPECT().HasDesiredBundleDrift(gomock.Any()).Return(false, nil)
+	s.modelState.EXPECT().GetStorageUsageWarnings(gomock.Any()).Return(nil, nil)
 
 	modelStatus, err := s.modelService.GetModelStatus(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
@@ -2627,6 +2629,43 @@ func (s *serviceSuite) TestGetStatusAvailable(c *tc.C) {
 	c.Assert(modelStatus.Data, tc.IsNil)
 }
 
+func (s *serviceSuite) TestGetStatusAvailableDrifted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().GetModelStatusContext(gomock.Any()).Return(status.ModelStatusContext{}, nil)
+	s.modelState.EXPECT().HasDesiredBundleDrift(gomock.Any()).Return(true, nil)
+	s.modelState.EXPECT().GetStorageUsageWarnings(gomock.Any()).Return(nil, nil)
+
+	modelStatus, err := s.modelService.GetModelStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(modelStatus.Status, tc.Equals, corestatus.Available)
+	c.Assert(modelStatus.Message, tc.Equals, "model has drifted from its desired bundle")
+}
+
+func (s *serviceSuite) TestGetStatusAvailableStorageUsageWarning(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().GetModelStatusContext(gomock.Any()).Return(status.ModelStatusContext{}, nil)
+	s.modelState.EXPECT().HasDesiredBundleDrift(gomock.Any()).Return(true, nil)
+	s.modelState.EXPECT().GetStorageUsageWarnings(gomock.Any()).Return([]status.StorageUsageWarning{{
+		StorageID: "data/0",
+		Unit:      "foo/0",
+		SizeMiB:   1000,
+		UsedMiB:   935,
+	}, {
+		StorageID: "logs/1",
+		Unit:      "bar/1",
+		SizeMiB:   100,
+		UsedMiB:   100,
+	}}, nil)
+
+	modelStatus, err := s.modelService.GetModelStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(modelStatus.Status, tc.Equals, corestatus.Available)
+	c.Assert(modelStatus.Message, tc.Equals, "model has drifted from its desired bundle; "+
+		"storage data/0 on unit foo/0 is 93% full; storage logs/1 on unit bar/1 is 100% full")
+}
+
 func (s *serviceSuite) TestGetStatusNotFound(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
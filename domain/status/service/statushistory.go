@@ -58,6 +58,71 @@ func (s *Service) GetStatusHistory(ctx context.Context, request StatusHistoryReq
 	return results, nil
 }
 
+// GetAllStatusHistory returns a page of the status history of every entity
+// in the model within the given range, in the order it was recorded. The
+// history is read no further than the end of the page.
+func (s *Service) GetAllStatusHistory(ctx context.Context, r StatusHistoryRange, page StatusHistoryPage) ([]StatusHistoryEntry, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	reader, err := s.statusHistoryReaderFn()
+	if err != nil {
+		return nil, errors.Errorf("reading status history: %v", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	var (
+		results []StatusHistoryEntry
+		skipped int
+	)
+	if err := reader.Walk(func(record statushistory.HistoryRecord) (bool, error) {
+		// Allow the context to cancel the walk.
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		default:
+		}
+
+		if !inRange(record, r) {
+			return false, nil
+		}
+		if skipped < page.Offset {
+			skipped++
+			return false, nil
+		}
+
+		results = append(results, StatusHistoryEntry{
+			Kind:   record.Kind,
+			ID:     record.Tag,
+			Status: record.Status,
+		})
+		return page.Limit > 0 && len(results) >= page.Limit, nil
+	}); err != nil {
+		return nil, errors.Errorf("reading status history: %w", err)
+	}
+
+	return results, nil
+}
+
+// inRange returns true if the record falls within the range. Records without
+// a time only match an unbounded range.
+func inRange(hr statushistory.HistoryRecord, r StatusHistoryRange) bool {
+	if r.From == nil && r.To == nil {
+		return true
+	}
+	since := hr.Status.Since
+	if since == nil {
+		return false
+	}
+	if r.From != nil && !since.After(*r.From) {
+		return false
+	}
+	if r.To != nil && since.After(*r.To) {
+		return false
+	}
+	return true
+}
+
 func matchesUnit(hr statushistory.HistoryRecord, req StatusHistoryRequest) bool {
 	switch req.Kind {
 	case status.KindUnit:
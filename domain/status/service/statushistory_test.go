@@ -554,6 +554,118 @@ func (s *statusHistorySuite) TestMatchesDelta(c *tc.C) {
 	}
 }
 
+func (s *statusHistorySuite) TestGetAllStatusHistory(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectResults([]statushistory.HistoryRecord{{
+		Kind: status.KindApplication,
+		Tag:  "foo",
+		Status: status.DetailedStatus{
+			Kind:   status.KindApplication,
+			Status: status.Waiting,
+			Since:  new(s.now.Add(-time.Hour)),
+		},
+	}, {
+		Kind: status.KindWorkload,
+		Tag:  "foo/0",
+		Status: status.DetailedStatus{
+			Kind:   status.KindWorkload,
+			Status: status.Active,
+			Info:   "ready",
+			Since:  new(s.now),
+		},
+	}})
+
+	service := s.newService()
+	results, err := service.GetAllStatusHistory(c.Context(), StatusHistoryRange{}, StatusHistoryPage{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, []StatusHistoryEntry{{
+		Kind: status.KindApplication,
+		ID:   "foo",
+		Status: status.DetailedStatus{
+			Kind:   status.KindApplication,
+			Status: status.Waiting,
+			Since:  new(s.now.Add(-time.Hour)),
+		},
+	}, {
+		Kind: status.KindWorkload,
+		ID:   "foo/0",
+		Status: status.DetailedStatus{
+			Kind:   status.KindWorkload,
+			Status: status.Active,
+			Info:   "ready",
+			Since:  new(s.now),
+		},
+	}})
+}
+
+func (s *statusHistorySuite) TestGetAllStatusHistoryPage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var records []statushistory.HistoryRecord
+	for _, tag := range []string{"a", "b", "c", "d"} {
+		records = append(records, statushistory.HistoryRecord{
+			Kind: status.KindApplication,
+			Tag:  tag,
+			Status: status.DetailedStatus{
+				Kind:   status.KindApplication,
+				Status: status.Active,
+				Since:  new(s.now),
+			},
+		})
+	}
+	s.expectResults(records)
+
+	service := s.newService()
+	results, err := service.GetAllStatusHistory(c.Context(), StatusHistoryRange{}, StatusHistoryPage{
+		Offset: 1,
+		Limit:  2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	c.Check(results[0].ID, tc.Equals, "b")
+	c.Check(results[1].ID, tc.Equals, "c")
+}
+
+func (s *statusHistorySuite) TestGetAllStatusHistoryContextCancelled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectResults([]statushistory.HistoryRecord{{}})
+
+	ctx, cancel := context.WithCancel(c.Context())
+	cancel()
+
+	service := s.newService()
+	_, err := service.GetAllStatusHistory(ctx, StatusHistoryRange{}, StatusHistoryPage{})
+	c.Assert(err, tc.ErrorIs, context.Canceled)
+}
+
+func (s *statusHistorySuite) TestInRange(c *tc.C) {
+	s.now = time.Now()
+	record := statushistory.HistoryRecord{
+		Status: status.DetailedStatus{Since: new(s.now)},
+	}
+
+	tests := []struct {
+		r        StatusHistoryRange
+		expected bool
+	}{
+		{r: StatusHistoryRange{}, expected: true},
+		{r: StatusHistoryRange{From: new(s.now.Add(-time.Second))}, expected: true},
+		{r: StatusHistoryRange{From: new(s.now)}, expected: false},
+		{r: StatusHistoryRange{To: new(s.now)}, expected: true},
+		{r: StatusHistoryRange{To: new(s.now.Add(-time.Second))}, expected: false},
+		{r: StatusHistoryRange{From: new(s.now.Add(-time.Second)), To: new(s.now.Add(time.Second))}, expected: true},
+	}
+	for i, test := range tests {
+		c.Logf("test %d", i)
+		c.Check(inRange(record, test.r), tc.Equals, test.expected)
+	}
+
+	c.Check(inRange(statushistory.HistoryRecord{}, StatusHistoryRange{}), tc.IsTrue)
+	c.Check(inRange(statushistory.HistoryRecord{}, StatusHistoryRange{To: new(s.now)}), tc.IsFalse)
+}
+
 func (s *statusHistorySuite) expectResults(records []statushistory.HistoryRecord) {
 	s.historyReader.EXPECT().Walk(gomock.Any()).DoAndReturn(
 		func(fn func(statushistory.HistoryRecord) (bool, error)) error {
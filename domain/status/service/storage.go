@@ -298,6 +298,13 @@ func (s *Service) transformStorageInstanceResults(
 				if dsa.FilesystemMountPoint != nil {
 					sa.Location = *dsa.FilesystemMountPoint
 				}
+				if dsa.FilesystemUsage != nil {
+					sa.Usage = &StorageUsage{
+						SizeMiB:   dsa.FilesystemUsage.SizeMiB,
+						UsedMiB:   dsa.FilesystemUsage.UsedMiB,
+						UpdatedAt: dsa.FilesystemUsage.UpdatedAt,
+					}
+				}
 			}
 			if si.Attachments == nil {
 				si.Attachments = map[unit.Name]StorageAttachment{}
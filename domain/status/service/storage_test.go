@@ -259,10 +259,16 @@ func (s *storageServiceSuite) TestGetStorageInstanceStatuses(c *tc.C) {
 		gomock.Any(), uuids).Return(si, nil)
 	sa := []status.StorageAttachment{
 		{
-			StorageInstanceUUID: uuids[0],
-			Life:                life.Alive,
-			Unit:                unit.Name("foo/10"),
-			Machine:             new(machine.Name("5")),
+			StorageInstanceUUID:  uuids[0],
+			Life:                 life.Alive,
+			Unit:                 unit.Name("foo/10"),
+			Machine:              new(machine.Name("5")),
+			FilesystemMountPoint: new("/srv/data"),
+			FilesystemUsage: &status.StorageUsage{
+				SizeMiB:   1024,
+				UsedMiB:   256,
+				UpdatedAt: now,
+			},
 		},
 	}
 	s.modelState.EXPECT().GetStorageInstanceAttachments(
@@ -280,9 +286,15 @@ func (s *storageServiceSuite) TestGetStorageInstanceStatuses(c *tc.C) {
 			Life:  corelife.Alive,
 			Attachments: map[unit.Name]StorageAttachment{
 				"foo/10": {
-					Life:    corelife.Alive,
-					Unit:    "foo/10",
-					Machine: new(machine.Name("5")),
+					Life:     corelife.Alive,
+					Unit:     "foo/10",
+					Machine:  new(machine.Name("5")),
+					Location: "/srv/data",
+					Usage: &StorageUsage{
+						SizeMiB:   1024,
+						UsedMiB:   256,
+						UpdatedAt: now,
+					},
 				},
 			},
 			Status: corestatus.StatusInfo{
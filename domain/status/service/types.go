@@ -97,6 +97,32 @@ type StatusHistoryRequest struct {
 	Tag    string
 }
 
+// StatusHistoryRange bounds the status history records returned when reading
+// the history of every entity in the model. A nil bound is unbounded.
+type StatusHistoryRange struct {
+	// From excludes records at or before this time.
+	From *time.Time
+	// To excludes records after this time.
+	To *time.Time
+}
+
+// StatusHistoryPage selects a page of the status history records returned
+// when reading the history of every entity in the model.
+type StatusHistoryPage struct {
+	// Offset is the number of matching records to skip.
+	Offset int
+	// Limit is the maximum number of records to return. Zero is unlimited.
+	Limit int
+}
+
+// StatusHistoryEntry is a single status history record for an entity in the
+// model.
+type StatusHistoryEntry struct {
+	Kind   status.HistoryKind
+	ID     string
+	Status status.DetailedStatus
+}
+
 // StorageInstance represents the status of a storage instance.
 type StorageInstance struct {
 	UUID        storage.StorageInstanceUUID
@@ -115,6 +141,15 @@ type StorageAttachment struct {
 	Unit     unit.Name
 	Machine  *machine.Name
 	Location string
+	// Usage is the last reported usage of the attached filesystem, if any.
+	Usage *StorageUsage
+}
+
+// StorageUsage represents the last reported usage of an attached filesystem.
+type StorageUsage struct {
+	SizeMiB   uint64
+	UsedMiB   uint64
+	UpdatedAt time.Time
 }
 
 // Filesystem represents the status of a filesystem.
@@ -24,6 +24,16 @@ import (
 
 // WatcherFactory describes methods for creating watchers.
 type WatcherFactory interface {
+	// NewNotifyWatcher returns a new watcher that filters changes from the
+	// input base watcher's db/queue. A single filter option is required,
+	// though additional filter options can be provided.
+	NewNotifyWatcher(
+		ctx context.Context,
+		summary string,
+		filter eventsource.FilterOption,
+		filterOpts ...eventsource.FilterOption,
+	) (watcher.NotifyWatcher, error)
+
 	// NewNotifyMapperWatcher returns a new watcher that receives changes from the
 	// input base watcher's db/queue. A single filter option is required, though
 	// additional filter options can be provided. Filtering of values is done first
@@ -153,3 +163,24 @@ func (s *WatchableService) WatchOfferStatus(ctx context.Context, offerUUID offer
 		),
 	)
 }
+
+// WatchModelStatus returns a watcher that notifies whenever an application,
+// unit, machine or relation in the model is added or removed, or its status
+// changes. The watcher does not indicate what has changed; callers are
+// expected to read the status of the model again.
+func (s *WatchableService) WatchModelStatus(ctx context.Context) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	namespaces := s.modelState.NamespacesForWatchModelStatus()
+	filters := transform.Slice(namespaces, func(namespace string) eventsource.FilterOption {
+		return eventsource.NamespaceFilter(namespace, changestream.All)
+	})
+
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"model status watcher",
+		filters[0],
+		filters[1:]...,
+	)
+}
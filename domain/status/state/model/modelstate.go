@@ -86,6 +86,34 @@ FROM   model
 	return status.ModelStatusInfo{Type: coremodel.ModelType(m.Type)}, nil
 }
 
+// HasDesiredBundleDrift returns true if the model was found to differ from
+// its desired bundle when they were last compared. A model without a desired
+// bundle has not drifted.
+func (st *ModelState) HasDesiredBundleDrift(ctx context.Context) (bool, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT COUNT(*) AS &bundleDriftCount.count
+FROM   desired_bundle
+WHERE  drift IS NOT NULL AND drift != ''
+`, bundleDriftCount{})
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	var result bundleDriftCount
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt).Get(&result)
+	})
+	if err != nil {
+		return false, errors.Errorf("getting desired bundle drift: %w", err)
+	}
+	return result.Count > 0, nil
+}
+
 // IsControllerModel returns if the model is a controller model.
 // The following error types can be expected to be returned:
 // - [modelerrors.NotFound]: When the model does not exist.
@@ -2565,6 +2593,25 @@ func (s *ModelState) NamespacesForWatchOfferStatus() (offer, application, unitAg
 	return "offer", "application_status", "custom_unit_agent_status", "custom_unit_workload_status", "custom_k8s_pod_status"
 }
 
+// NamespacesForWatchModelStatus returns the namespace string identifiers
+// for changes to the status of the applications, units, machines and
+// relations in the model, and to their existence.
+func (s *ModelState) NamespacesForWatchModelStatus() []string {
+	return []string{
+		"application",
+		"application_status",
+		"unit",
+		"custom_unit_agent_status",
+		"custom_unit_workload_status",
+		"custom_k8s_pod_status",
+		"machine",
+		"machine_status",
+		"machine_cloud_instance_status",
+		"relation",
+		"relation_status",
+	}
+}
+
 func encodeIPAddress(address machineSpaceAddress) (corenetwork.SpaceAddress, error) {
 	spaceUUID := corenetwork.AlphaSpaceId
 	if address.SpaceUUID.Valid {
@@ -90,6 +90,52 @@ func (s *modelStateSuite) TestGetModelStatusInfoNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, modelerrors.NotFound)
 }
 
+func (s *modelStateSuite) TestHasDesiredBundleDrift(c *tc.C) {
+	modelUUID := tc.Must0(c, model.NewUUID)
+	controllerUUID, err := uuid.NewUUID()
+	c.Check(err, tc.ErrorIsNil)
+
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO model (uuid, controller_uuid, name, qualifier, type, cloud, cloud_type, credential_owner)
+VALUES (?, ?, "test", "prod", "iaas", "test-model", "ec2", "owner")
+		`, modelUUID.String(), controllerUUID.String())
+		return err
+	})
+	c.Check(err, tc.ErrorIsNil)
+
+	// A model without a desired bundle has not drifted.
+	drifted, err := s.state.HasDesiredBundleDrift(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(drifted, tc.IsFalse)
+
+	setDrift := func(drift any) {
+		_, err := s.DB().ExecContext(c.Context(), `
+INSERT INTO desired_bundle (model_uuid, uuid, bundle, set_at, drift)
+VALUES (?, "bundle-uuid", "applications: {}", DATETIME('now'), ?)
+ON CONFLICT (model_uuid) DO UPDATE SET drift = excluded.drift
+`, modelUUID.String(), drift)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	// Nor has a model that has not been compared with its bundle yet, or
+	// that matches it.
+	setDrift(nil)
+	drifted, err = s.state.HasDesiredBundleDrift(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(drifted, tc.IsFalse)
+
+	setDrift("")
+	drifted, err = s.state.HasDesiredBundleDrift(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(drifted, tc.IsFalse)
+
+	setDrift("applications:\n  mysql:\n    missing: bundle\n")
+	drifted, err = s.state.HasDesiredBundleDrift(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(drifted, tc.IsTrue)
+}
+
 func (s *modelStateSuite) TestIsControllerModelNotControllerModel(c *tc.C) {
 	modelUUID := tc.Must0(c, model.NewUUID)
 	controllerUUID, err := uuid.NewUUID()
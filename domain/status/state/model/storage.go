@@ -573,7 +573,10 @@ SELECT &storageAttachmentStatusDetails.* FROM (
               u.name AS unit_name,
               m.name AS machine_name,
               sfa.mount_point AS filesystem_mount_point,
-              sva.block_device_uuid AS volume_block_device_uuid
+              sva.block_device_uuid AS volume_block_device_uuid,
+              sfau.size_mib AS usage_size_mib,
+              sfau.used_mib AS usage_used_mib,
+              sfau.updated_at AS usage_updated_at
     FROM      storage_attachment sa
     LEFT JOIN unit u ON sa.unit_uuid=u.uuid
     LEFT JOIN machine m ON u.net_node_uuid=m.net_node_uuid
@@ -583,6 +586,7 @@ SELECT &storageAttachmentStatusDetails.* FROM (
     LEFT JOIN storage_instance_filesystem sif ON sa.storage_instance_uuid=sif.storage_instance_uuid
     LEFT JOIN storage_filesystem_attachment sfa ON sif.storage_filesystem_uuid=sfa.storage_filesystem_uuid AND
                                                    u.net_node_uuid=sfa.net_node_uuid
+    LEFT JOIN storage_filesystem_attachment_usage sfau ON sfa.uuid=sfau.storage_filesystem_attachment_uuid
     WHERE     sa.storage_instance_uuid IN ($entityUUIDs[:])
 )
 `, storageAttachmentStatusDetails{}, ids)
@@ -625,6 +629,7 @@ SELECT &storageAttachmentStatusDetails.* FROM (
 			Machine:              machineName,
 			FilesystemMountPoint: filesystemMountPoint,
 			VolumeBlockDevice:    volumeBlockDevice,
+			FilesystemUsage:      storageUsage(v),
 		}
 	}), nil
 }
@@ -645,7 +650,10 @@ SELECT &storageAttachmentStatusDetails.* FROM (
               u.name AS unit_name,
               m.name AS machine_name,
               sfa.mount_point AS filesystem_mount_point,
-              sva.block_device_uuid AS volume_block_device_uuid
+              sva.block_device_uuid AS volume_block_device_uuid,
+              sfau.size_mib AS usage_size_mib,
+              sfau.used_mib AS usage_used_mib,
+              sfau.updated_at AS usage_updated_at
     FROM      storage_attachment sa
     LEFT JOIN unit u ON sa.unit_uuid=u.uuid
     LEFT JOIN machine m ON u.net_node_uuid=m.net_node_uuid
@@ -655,6 +663,7 @@ SELECT &storageAttachmentStatusDetails.* FROM (
     LEFT JOIN storage_instance_filesystem sif ON sa.storage_instance_uuid=sif.storage_instance_uuid
     LEFT JOIN storage_filesystem_attachment sfa ON sif.storage_filesystem_uuid=sfa.storage_filesystem_uuid AND
                                                    u.net_node_uuid=sfa.net_node_uuid
+    LEFT JOIN storage_filesystem_attachment_usage sfau ON sfa.uuid=sfau.storage_filesystem_attachment_uuid
 )
 `, storageAttachmentStatusDetails{})
 	if err != nil {
@@ -696,10 +705,24 @@ SELECT &storageAttachmentStatusDetails.* FROM (
 			Machine:              machineName,
 			FilesystemMountPoint: filesystemMountPoint,
 			VolumeBlockDevice:    volumeBlockDevice,
+			FilesystemUsage:      storageUsage(v),
 		}
 	}), nil
 }
 
+// storageUsage returns the last reported filesystem usage of a storage
+// attachment, or nil if none has been reported.
+func storageUsage(v storageAttachmentStatusDetails) *status.StorageUsage {
+	if !v.UsageSizeMiB.Valid {
+		return nil
+	}
+	return &status.StorageUsage{
+		SizeMiB:   uint64(v.UsageSizeMiB.Int64),
+		UsedMiB:   uint64(v.UsageUsedMiB.Int64),
+		UpdatedAt: v.UsageUpdatedAt.Time,
+	}
+}
+
 // GetFilesystems returns the specified filesystems if they exist.
 func (st *ModelState) GetFilesystems(
 	ctx context.Context, uuids []storage.FilesystemUUID,
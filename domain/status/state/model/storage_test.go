@@ -494,6 +494,93 @@ func (s *storageStatusSuite) TestGetAllStorageInstanceAttachments(c *tc.C) {
 	})
 }
 
+func (s *storageStatusSuite) TestGetAllStorageInstanceAttachmentsWithUsage(c *tc.C) {
+	ch0 := s.newCharm(c)
+	s.newCharmStorage(c, ch0, "fs", storage.StorageKindFilesystem)
+	fsPoolUUID := s.newStoragePool(c, "fspool", "fspool", nil)
+
+	a0 := s.newApplication(c, "foo", ch0)
+	nn0 := s.newNetNode(c)
+	_, m0n := s.newMachineWithNetNode(c, nn0)
+	u0, u0n := s.newUnitWithNetNode(c, a0, nn0)
+	s0, _ := s.newStorageInstance(c, ch0, "fs", fsPoolUUID, storage.StorageKindFilesystem)
+	s.newStorageAttachment(c, s0, u0)
+	f0, _ := s.newFilesystem(c)
+	s.newStorageInstanceFilesystem(c, s0, f0)
+	f0a := s.newFilesystemAttachment(c, f0, nn0)
+	s.changeFilesystemAttachmentInfo(c, f0a, "/srv/data", false)
+
+	updated := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	_, err := s.DB().Exec(`
+INSERT INTO storage_filesystem_attachment_usage (storage_filesystem_attachment_uuid, size_mib, used_mib, updated_at)
+VALUES (?, 1024, 512, ?)
+`, f0a.String(), updated)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := s.NewModelState(c)
+	res, err := st.GetAllStorageInstanceAttachments(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res, tc.HasLen, 1)
+	c.Check(res[0].Unit, tc.Equals, u0n)
+	c.Check(res[0].Machine, tc.DeepEquals, &m0n)
+	c.Check(*res[0].FilesystemMountPoint, tc.Equals, "/srv/data")
+	c.Assert(res[0].FilesystemUsage, tc.NotNil)
+	c.Check(res[0].FilesystemUsage.SizeMiB, tc.Equals, uint64(1024))
+	c.Check(res[0].FilesystemUsage.UsedMiB, tc.Equals, uint64(512))
+	c.Check(res[0].FilesystemUsage.UpdatedAt.Equal(updated), tc.IsTrue)
+}
+
+func (s *storageStatusSuite) TestGetStorageUsageWarnings(c *tc.C) {
+	ch0 := s.newCharm(c)
+	s.newCharmStorage(c, ch0, "fs", storage.StorageKindFilesystem)
+	fsPoolUUID := s.newStoragePool(c, "fspool", "fspool", nil)
+
+	a0 := s.newApplication(c, "foo", ch0)
+	nn0 := s.newNetNode(c)
+	s.newMachineWithNetNode(c, nn0)
+	u0, u0n := s.newUnitWithNetNode(c, a0, nn0)
+
+	setUsage := func(usedMiB int) string {
+		si, siID := s.newStorageInstance(c, ch0, "fs", fsPoolUUID, storage.StorageKindFilesystem)
+		s.newStorageAttachment(c, si, u0)
+		f, _ := s.newFilesystem(c)
+		s.newStorageInstanceFilesystem(c, si, f)
+		fa := s.newFilesystemAttachment(c, f, nn0)
+		_, err := s.DB().Exec(`
+INSERT INTO storage_filesystem_attachment_usage (storage_filesystem_attachment_uuid, size_mib, used_mib, updated_at)
+VALUES (?, 1000, ?, DATETIME('now'))
+`, fa.String(), usedMiB)
+		c.Assert(err, tc.ErrorIsNil)
+		return siID
+	}
+	setUsage(500)
+	fullID := setUsage(950)
+
+	st := s.NewModelState(c)
+
+	// With no threshold in model config the default applies.
+	res, err := st.GetStorageUsageWarnings(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(res, tc.DeepEquals, []status.StorageUsageWarning{{
+		StorageID: fullID,
+		Unit:      u0n,
+		SizeMiB:   1000,
+		UsedMiB:   950,
+	}})
+
+	_, err = s.DB().Exec(`INSERT INTO model_config (key, value) VALUES ('storage-usage-warning-threshold', '40')`)
+	c.Assert(err, tc.ErrorIsNil)
+	res, err = st.GetStorageUsageWarnings(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(res, tc.HasLen, 2)
+
+	_, err = s.DB().Exec(`UPDATE model_config SET value = '0' WHERE key = 'storage-usage-warning-threshold'`)
+	c.Assert(err, tc.ErrorIsNil)
+	res, err = st.GetStorageUsageWarnings(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(res, tc.HasLen, 0)
+}
+
 func (s *storageStatusSuite) TestGetAllFilesystemsEmpty(c *tc.C) {
 	st := s.NewModelState(c)
 	res, err := st.GetAllFilesystems(c.Context())
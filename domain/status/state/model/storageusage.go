@@ -0,0 +1,112 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/collections/transform"
+
+	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/status"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+)
+
+// storageUsageThreshold holds the storage usage warning threshold from model
+// config, as a percentage.
+type storageUsageThreshold struct {
+	Key       string `db:"key"`
+	Value     string `db:"value"`
+	Threshold int    `db:"threshold"`
+}
+
+// storageUsageWarning is used to retrieve the usage of a storage
+// attachment past the warning threshold.
+type storageUsageWarning struct {
+	StorageID string `db:"storage_id"`
+	UnitName  string `db:"unit_name"`
+	SizeMiB   uint64 `db:"size_mib"`
+	UsedMiB   uint64 `db:"used_mib"`
+}
+
+// GetStorageUsageWarnings returns the storage attachments whose last reported
+// filesystem usage is at or past the storage-usage-warning-threshold model
+// config value. No warnings are returned when the threshold is zero.
+func (st *ModelState) GetStorageUsageWarnings(
+	ctx context.Context,
+) ([]status.StorageUsageWarning, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	thresholdStmt, err := st.Prepare(`
+SELECT &storageUsageThreshold.value
+FROM   model_config
+WHERE  key = $storageUsageThreshold.key
+`, storageUsageThreshold{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	usageStmt, err := st.Prepare(`
+SELECT    si.storage_id AS &storageUsageWarning.storage_id,
+          u.name AS &storageUsageWarning.unit_name,
+          sfau.size_mib AS &storageUsageWarning.size_mib,
+          sfau.used_mib AS &storageUsageWarning.used_mib
+FROM      storage_filesystem_attachment_usage sfau
+JOIN      storage_filesystem_attachment sfa ON sfau.storage_filesystem_attachment_uuid=sfa.uuid
+JOIN      storage_instance_filesystem sif ON sfa.storage_filesystem_uuid=sif.storage_filesystem_uuid
+JOIN      storage_instance si ON sif.storage_instance_uuid=si.uuid
+JOIN      storage_attachment sa ON si.uuid=sa.storage_instance_uuid
+JOIN      unit u ON sa.unit_uuid=u.uuid AND u.net_node_uuid=sfa.net_node_uuid
+WHERE     sfau.size_mib > 0
+AND       sfau.used_mib * 100 >= sfau.size_mib * $storageUsageThreshold.threshold
+ORDER BY  si.storage_id, u.name
+`, storageUsageWarning{}, storageUsageThreshold{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var out []storageUsageWarning
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		threshold := storageUsageThreshold{
+			Key:       config.StorageUsageWarningThresholdKey,
+			Threshold: config.DefaultStorageUsageWarningThreshold,
+		}
+		err := tx.Query(ctx, thresholdStmt, threshold).Get(&threshold)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting storage usage warning threshold: %w", err)
+		} else if err == nil {
+			threshold.Threshold, err = strconv.Atoi(threshold.Value)
+			if err != nil {
+				return errors.Errorf("parsing storage usage warning threshold %q: %w", threshold.Value, err)
+			}
+		}
+		if threshold.Threshold <= 0 {
+			return nil
+		}
+
+		err = tx.Query(ctx, usageStmt, threshold).GetAll(&out)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting storage usage: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return transform.Slice(out, func(v storageUsageWarning) status.StorageUsageWarning {
+		return status.StorageUsageWarning{
+			StorageID: v.StorageID,
+			Unit:      unit.Name(v.UnitName),
+			SizeMiB:   v.SizeMiB,
+			UsedMiB:   v.UsedMiB,
+		}
+	}), nil
+}
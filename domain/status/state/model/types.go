@@ -237,6 +237,10 @@ type modelInfo struct {
 	Type string `db:"type"`
 }
 
+type bundleDriftCount struct {
+	Count int `db:"count"`
+}
+
 type controllerModelInfo struct {
 	IsCOntrollerModel bool `db:"is_controller_model"`
 }
@@ -304,6 +308,9 @@ type storageAttachmentStatusDetails struct {
 	LifeID                int            `db:"life_id"`
 	FilesystemMountPoint  sql.NullString `db:"filesystem_mount_point"`
 	VolumeBlockDeviceUUID sql.NullString `db:"volume_block_device_uuid"`
+	UsageSizeMiB          sql.NullInt64  `db:"usage_size_mib"`
+	UsageUsedMiB          sql.NullInt64  `db:"usage_used_mib"`
+	UsageUpdatedAt        sql.NullTime   `db:"usage_updated_at"`
 }
 
 // filesystemStatusDetails is used to retrieve all required information
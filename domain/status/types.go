@@ -4,6 +4,8 @@
 package status
 
 import (
+	"time"
+
 	"github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/machine"
@@ -91,6 +93,23 @@ type StorageAttachment struct {
 	Machine              *machine.Name
 	FilesystemMountPoint *string
 	VolumeBlockDevice    *blockdevice.BlockDeviceUUID
+	FilesystemUsage      *StorageUsage
+}
+
+// StorageUsage represents the last reported usage of an attached filesystem.
+type StorageUsage struct {
+	SizeMiB   uint64
+	UsedMiB   uint64
+	UpdatedAt time.Time
+}
+
+// StorageUsageWarning describes a storage attachment whose filesystem usage
+// is at or past the model's storage usage warning threshold.
+type StorageUsageWarning struct {
+	StorageID string
+	Unit      unit.Name
+	SizeMiB   uint64
+	UsedMiB   uint64
 }
 
 // Filesystem represents the status of a filesystem.
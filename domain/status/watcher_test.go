@@ -229,6 +229,80 @@ func (s *watcherSuite) TestWatchOfferNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.OfferNotFound)
 }
 
+func (s *watcherSuite) TestWatchModelStatus(c *tc.C) {
+	var unit application.AddIAASUnitArg
+	netNodeUUID := tc.Must(c, domainnetwork.NewNetNodeUUID)
+	unit.MachineUUID = tc.Must(c, coremachine.NewUUID)
+	unit.MachineNetNodeUUID = netNodeUUID
+	unit.UnitUUID = tc.Must(c, coreunit.NewUUID)
+	unit.NetNodeUUID = netNodeUUID
+	unit.WorkloadStatus = &domainstatus.StatusInfo[domainstatus.WorkloadStatusType]{
+		Status: domainstatus.WorkloadStatusActive,
+	}
+	s.createIAASApplication(c, "foo", life.Alive, unit)
+
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "status")
+	svc := s.setupService(c, factory)
+
+	s.AssertChangeStreamIdle(c)
+
+	watcher, err := svc.WatchModelStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	// Assert that setting the status of a unit triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetUnitWorkloadStatus(c.Context(), "foo/0", status.StatusInfo{
+			Status:  status.Maintenance,
+			Message: "installing",
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that setting the status of an application triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetApplicationStatus(c.Context(), "foo", status.StatusInfo{
+			Status:  status.Blocked,
+			Message: "it's blocked!",
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that setting the status of a machine triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetMachineStatus(c.Context(), "0", status.StatusInfo{
+			Status:  status.Started,
+			Message: "started",
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that setting the status of a machine instance triggers the
+	// watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetInstanceStatus(c.Context(), "0", status.StatusInfo{
+			Status:  status.Running,
+			Message: "running",
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	harness.Run(c, struct{}{})
+}
+
 func (s *watcherSuite) setupService(c *tc.C, factory domain.WatchableDBFactory) *service.WatchableService {
 	modelDB := func(ctx context.Context) (database.TxnRunner, error) {
 		return s.ModelTxnRunner(), nil
@@ -66,6 +66,33 @@ const (
 	// instance being operated on does not exist.
 	StorageInstanceNotFound = errors.ConstError("storage instance not found")
 
+	// StorageInstanceNotProvisioned describes an error that occurs when the
+	// operation requires the volume or filesystem of a storage instance to
+	// have been provisioned by its storage provider, and it has not been.
+	StorageInstanceNotProvisioned = errors.ConstError(
+		"storage instance not provisioned",
+	)
+
+	// StorageInstanceResizeNotSupported describes an error that occurs when
+	// attempting to resize a storage instance whose storage provider cannot
+	// grow its volume or filesystem.
+	StorageInstanceResizeNotSupported = errors.ConstError(
+		"resizing storage instance not supported",
+	)
+
+	// StorageInstanceSnapshotNotSupported describes an error that occurs when
+	// attempting to snapshot storage, or to create storage from a snapshot,
+	// using a storage provider that does not support snapshots.
+	StorageInstanceSnapshotNotSupported = errors.ConstError(
+		"storage snapshots not supported",
+	)
+
+	// StorageInstanceShrinkNotSupported describes an error that occurs when
+	// attempting to reduce the requested size of a storage instance.
+	StorageInstanceShrinkNotSupported = errors.ConstError(
+		"shrinking storage instance not supported",
+	)
+
 	// StoragePoolAlreadyExists is used when a storage pool already exists.
 	StoragePoolAlreadyExists = errors.ConstError("storage pool already exists")
 
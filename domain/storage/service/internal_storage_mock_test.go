@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/storage (interfaces: ProviderRegistry,Provider,VolumeSource,VolumeImporter,FilesystemSource,FilesystemImporter,FilesystemModelMigration)
+// Source: github.com/juju/juju/internal/storage (interfaces: ProviderRegistry,Provider,VolumeSource,VolumeImporter,FilesystemSource,FilesystemImporter,FilesystemModelMigration,StorageResizer,VolumeSnapshotter)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination internal_storage_mock_test.go github.com/juju/juju/internal/storage ProviderRegistry,Provider,VolumeSource,VolumeImporter,FilesystemSource,FilesystemImporter,FilesystemModelMigration
+//	mockgen -typed -package service -destination internal_storage_mock_test.go github.com/juju/juju/internal/storage ProviderRegistry,Provider,VolumeSource,VolumeImporter,FilesystemSource,FilesystemImporter,FilesystemModelMigration,StorageResizer,VolumeSnapshotter
 //
 
 // Package service is a generated GoMock package.
@@ -1298,3 +1298,205 @@ func (c *MockFilesystemModelMigrationGetPersistentVolumeClaimIdentifiersCall) Do
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockStorageResizer is a mock of StorageResizer interface.
+type MockStorageResizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageResizerMockRecorder
+}
+
+// MockStorageResizerMockRecorder is the mock recorder for MockStorageResizer.
+type MockStorageResizerMockRecorder struct {
+	mock *MockStorageResizer
+}
+
+// NewMockStorageResizer creates a new mock instance.
+func NewMockStorageResizer(ctrl *gomock.Controller) *MockStorageResizer {
+	mock := &MockStorageResizer{ctrl: ctrl}
+	mock.recorder = &MockStorageResizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageResizer) EXPECT() *MockStorageResizerMockRecorder {
+	return m.recorder
+}
+
+// ResizeStorage mocks base method.
+func (m *MockStorageResizer) ResizeStorage(arg0 context.Context, arg1 []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeStorage", arg0, arg1)
+	ret0, _ := ret[0].([]storage.ResizeStorageResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeStorage indicates an expected call of ResizeStorage.
+func (mr *MockStorageResizerMockRecorder) ResizeStorage(arg0, arg1 any) *MockStorageResizerResizeStorageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeStorage", reflect.TypeOf((*MockStorageResizer)(nil).ResizeStorage), arg0, arg1)
+	return &MockStorageResizerResizeStorageCall{Call: call}
+}
+
+// MockStorageResizerResizeStorageCall wrap *gomock.Call
+type MockStorageResizerResizeStorageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageResizerResizeStorageCall) Return(arg0 []storage.ResizeStorageResult, arg1 error) *MockStorageResizerResizeStorageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageResizerResizeStorageCall) Do(f func(context.Context, []storage.ResizeParams) ([]storage.ResizeStorageResult, error)) *MockStorageResizerResizeStorageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageResizerResizeStorageCall) DoAndReturn(f func(context.Context, []storage.ResizeParams) ([]storage.ResizeStorageResult, error)) *MockStorageResizerResizeStorageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockVolumeSnapshotter is a mock of VolumeSnapshotter interface.
+type MockVolumeSnapshotter struct {
+	ctrl     *gomock.Controller
+	recorder *MockVolumeSnapshotterMockRecorder
+}
+
+// MockVolumeSnapshotterMockRecorder is the mock recorder for MockVolumeSnapshotter.
+type MockVolumeSnapshotterMockRecorder struct {
+	mock *MockVolumeSnapshotter
+}
+
+// NewMockVolumeSnapshotter creates a new mock instance.
+func NewMockVolumeSnapshotter(ctrl *gomock.Controller) *MockVolumeSnapshotter {
+	mock := &MockVolumeSnapshotter{ctrl: ctrl}
+	mock.recorder = &MockVolumeSnapshotterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVolumeSnapshotter) EXPECT() *MockVolumeSnapshotterMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshots mocks base method.
+func (m *MockVolumeSnapshotter) CreateSnapshots(arg0 context.Context, arg1 []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]storage.CreateSnapshotsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshots indicates an expected call of CreateSnapshots.
+func (mr *MockVolumeSnapshotterMockRecorder) CreateSnapshots(arg0, arg1 any) *MockVolumeSnapshotterCreateSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshots", reflect.TypeOf((*MockVolumeSnapshotter)(nil).CreateSnapshots), arg0, arg1)
+	return &MockVolumeSnapshotterCreateSnapshotsCall{Call: call}
+}
+
+// MockVolumeSnapshotterCreateSnapshotsCall wrap *gomock.Call
+type MockVolumeSnapshotterCreateSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeSnapshotterCreateSnapshotsCall) Return(arg0 []storage.CreateSnapshotsResult, arg1 error) *MockVolumeSnapshotterCreateSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeSnapshotterCreateSnapshotsCall) Do(f func(context.Context, []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error)) *MockVolumeSnapshotterCreateSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeSnapshotterCreateSnapshotsCall) DoAndReturn(f func(context.Context, []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error)) *MockVolumeSnapshotterCreateSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DestroySnapshots mocks base method.
+func (m *MockVolumeSnapshotter) DestroySnapshots(arg0 context.Context, arg1 []string) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroySnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroySnapshots indicates an expected call of DestroySnapshots.
+func (mr *MockVolumeSnapshotterMockRecorder) DestroySnapshots(arg0, arg1 any) *MockVolumeSnapshotterDestroySnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySnapshots", reflect.TypeOf((*MockVolumeSnapshotter)(nil).DestroySnapshots), arg0, arg1)
+	return &MockVolumeSnapshotterDestroySnapshotsCall{Call: call}
+}
+
+// MockVolumeSnapshotterDestroySnapshotsCall wrap *gomock.Call
+type MockVolumeSnapshotterDestroySnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeSnapshotterDestroySnapshotsCall) Return(arg0 []error, arg1 error) *MockVolumeSnapshotterDestroySnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeSnapshotterDestroySnapshotsCall) Do(f func(context.Context, []string) ([]error, error)) *MockVolumeSnapshotterDestroySnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeSnapshotterDestroySnapshotsCall) DoAndReturn(f func(context.Context, []string) ([]error, error)) *MockVolumeSnapshotterDestroySnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSnapshots mocks base method.
+func (m *MockVolumeSnapshotter) ListSnapshots(arg0 context.Context, arg1 string) ([]storage.SnapshotInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]storage.SnapshotInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockVolumeSnapshotterMockRecorder) ListSnapshots(arg0, arg1 any) *MockVolumeSnapshotterListSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockVolumeSnapshotter)(nil).ListSnapshots), arg0, arg1)
+	return &MockVolumeSnapshotterListSnapshotsCall{Call: call}
+}
+
+// MockVolumeSnapshotterListSnapshotsCall wrap *gomock.Call
+type MockVolumeSnapshotterListSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeSnapshotterListSnapshotsCall) Return(arg0 []storage.SnapshotInfo, arg1 error) *MockVolumeSnapshotterListSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeSnapshotterListSnapshotsCall) Do(f func(context.Context, string) ([]storage.SnapshotInfo, error)) *MockVolumeSnapshotterListSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeSnapshotterListSnapshotsCall) DoAndReturn(f func(context.Context, string) ([]storage.SnapshotInfo, error)) *MockVolumeSnapshotterListSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
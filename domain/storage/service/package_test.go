@@ -11,7 +11,7 @@ import (
 )
 
 //go:generate go run go.uber.org/mock/mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/storage/service State,StoragePoolState,StorageImportState
-//go:generate go run go.uber.org/mock/mockgen -typed -package service -destination internal_storage_mock_test.go github.com/juju/juju/internal/storage ProviderRegistry,Provider,VolumeSource,VolumeImporter,FilesystemSource,FilesystemImporter,FilesystemModelMigration
+//go:generate go run go.uber.org/mock/mockgen -typed -package service -destination internal_storage_mock_test.go github.com/juju/juju/internal/storage ProviderRegistry,Provider,VolumeSource,VolumeImporter,FilesystemSource,FilesystemImporter,FilesystemModelMigration,StorageResizer,VolumeSnapshotter
 
 type modelStorageRegistryGetter func() storage.ProviderRegistry
 
@@ -0,0 +1,89 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/errors"
+	internalstorage "github.com/juju/juju/internal/storage"
+)
+
+// ResizeStorageInstance grows the storage instance to at least the supplied
+// size in MiB. The storage provisioner responsible for the storage instance's
+// volume or filesystem will resize it. Charms are not notified once the
+// storage has grown.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the supplied storage instance uuid is not
+// valid, or the supplied size is zero.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound] when
+// the storage instance does not exist.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotAlive] when
+// the storage instance is not alive.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceResizeNotSupported]
+// when the storage provider of the storage instance cannot grow its volume or
+// filesystem.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceShrinkNotSupported]
+// when the supplied size is smaller than the currently requested size.
+func (s *Service) ResizeStorageInstance(
+	ctx context.Context, uuid domainstorage.StorageInstanceUUID, sizeMiB uint64,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return errors.New(
+			"storage instance uuid is not valid",
+		).Add(coreerrors.NotValid)
+	}
+	if sizeMiB == 0 {
+		return errors.New(
+			"storage instance size must be greater than zero",
+		).Add(coreerrors.NotValid)
+	}
+
+	if err := s.checkStorageInstanceResizable(ctx, uuid); err != nil {
+		return errors.Capture(err)
+	}
+
+	previous, err := s.st.SetStorageInstanceRequestedSize(ctx, uuid, sizeMiB)
+	if err != nil {
+		return errors.Errorf(
+			"resizing storage instance %q: %w", uuid, err,
+		)
+	}
+	if previous != sizeMiB {
+		s.logger.Infof(ctx,
+			"resizing storage instance %q from %dMiB to %dMiB",
+			uuid, previous, sizeMiB,
+		)
+	}
+	return nil
+}
+
+// checkStorageInstanceResizable returns an error satisfying
+// [domainstorageerrors.StorageInstanceResizeNotSupported] if the storage
+// provider of the storage instance cannot grow the volume or filesystem
+// provisioned for it. Filesystems on volumes are grown on the machine once
+// their volume has grown, so only the volume source needs to support
+// resizing for them.
+func (s *Service) checkStorageInstanceResizable(
+	ctx context.Context, uuid domainstorage.StorageInstanceUUID,
+) error {
+	src, err := s.getStorageInstanceSource(ctx, uuid)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if _, ok := src.source.(internalstorage.StorageResizer); !ok {
+		return errors.Errorf(
+			"storage provider %q does not support resizing storage", src.providerType,
+		).Add(domainstorageerrors.StorageInstanceResizeNotSupported)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	internalstorage "github.com/juju/juju/internal/storage"
+)
+
+// resizeSuite is a test suite for asserting the parts of the [Service]
+// interface that relate to resizing storage instances.
+type resizeSuite struct {
+	state                 *MockState
+	storageRegistryGetter *MockModelStorageRegistryGetter
+	registry              *MockProviderRegistry
+	provider              *MockProvider
+	volumeSource          *mockVolumeSourceAndResizer
+	filesystemSource      *MockFilesystemSource
+}
+
+type mockVolumeSourceAndResizer struct {
+	*MockVolumeSource
+	*MockStorageResizer
+}
+
+// TestResizeSuite runs all of the tests contained within [resizeSuite].
+func TestResizeSuite(t *testing.T) {
+	tc.Run(t, &resizeSuite{})
+}
+
+func (s *resizeSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.storageRegistryGetter = NewMockModelStorageRegistryGetter(ctrl)
+	s.registry = NewMockProviderRegistry(ctrl)
+	s.provider = NewMockProvider(ctrl)
+	s.volumeSource = &mockVolumeSourceAndResizer{
+		MockVolumeSource:   NewMockVolumeSource(ctrl),
+		MockStorageResizer: NewMockStorageResizer(ctrl),
+	}
+	s.filesystemSource = NewMockFilesystemSource(ctrl)
+
+	c.Cleanup(func() {
+		s.state = nil
+		s.storageRegistryGetter = nil
+		s.registry = nil
+		s.provider = nil
+		s.volumeSource = nil
+		s.filesystemSource = nil
+	})
+	return ctrl
+}
+
+func (s *resizeSuite) newService(c *tc.C) *Service {
+	return NewService(
+		s.state, loggertesting.WrapCheckLog(c), clock.WallClock, s.storageRegistryGetter,
+	)
+}
+
+// TestResizeStorageInstance is a happy path test for
+// [Service.ResizeStorageInstance].
+func (s *resizeSuite) TestResizeStorageInstance(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.expectStorageProvider(c, uuid, domainstorage.StorageKindBlock)
+	s.provider.EXPECT().VolumeSource(gomock.Any()).Return(s.volumeSource, nil)
+	s.state.EXPECT().SetStorageInstanceRequestedSize(
+		gomock.Any(), uuid, uint64(204800),
+	).Return(uint64(102400), nil)
+
+	err := s.newService(c).ResizeStorageInstance(c.Context(), uuid, 204800)
+	c.Check(err, tc.ErrorIsNil)
+}
+
+// TestResizeStorageInstanceShrink tests that the state error is passed back
+// to the caller when attempting to shrink a storage instance.
+func (s *resizeSuite) TestResizeStorageInstanceShrink(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.expectStorageProvider(c, uuid, domainstorage.StorageKindBlock)
+	s.provider.EXPECT().VolumeSource(gomock.Any()).Return(s.volumeSource, nil)
+	s.state.EXPECT().SetStorageInstanceRequestedSize(
+		gomock.Any(), uuid, uint64(1024),
+	).Return(uint64(0), domainstorageerrors.StorageInstanceShrinkNotSupported)
+
+	err := s.newService(c).ResizeStorageInstance(c.Context(), uuid, 1024)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceShrinkNotSupported)
+}
+
+// TestResizeStorageInstanceNotValid tests that an invalid uuid or a zero
+// size results in a [coreerrors.NotValid] error.
+func (s *resizeSuite) TestResizeStorageInstanceNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.newService(c).ResizeStorageInstance(c.Context(), "", 1024)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	err = s.newService(c).ResizeStorageInstance(c.Context(), uuid, 0)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+// TestResizeStorageInstanceNotSupported tests that resizing a storage
+// instance whose filesystem source cannot resize returns
+// [domainstorageerrors.StorageInstanceResizeNotSupported] without changing
+// the requested size.
+func (s *resizeSuite) TestResizeStorageInstanceNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.expectStorageProvider(c, uuid, domainstorage.StorageKindFilesystem)
+	s.provider.EXPECT().FilesystemSource(gomock.Any()).Return(s.filesystemSource, nil)
+
+	err := s.newService(c).ResizeStorageInstance(c.Context(), uuid, 2048)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceResizeNotSupported)
+}
+
+// TestResizeStorageInstanceNotFound tests that resizing a storage instance
+// which does not exist returns
+// [domainstorageerrors.StorageInstanceNotFound].
+func (s *resizeSuite) TestResizeStorageInstanceNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.state.EXPECT().GetStorageInstancePoolAndKind(gomock.Any(), uuid).Return(
+		"", 0, domainstorageerrors.StorageInstanceNotFound,
+	)
+
+	err := s.newService(c).ResizeStorageInstance(c.Context(), uuid, 2048)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotFound)
+}
+
+// expectStorageProvider sets up the lookup of the storage provider for a
+// storage instance of the supplied kind in a pool of a model scoped
+// provider supporting only that kind.
+func (s *resizeSuite) expectStorageProvider(
+	c *tc.C, uuid domainstorage.StorageInstanceUUID, kind domainstorage.StorageKind,
+) {
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	s.state.EXPECT().GetStorageInstancePoolAndKind(gomock.Any(), uuid).Return(
+		poolUUID, kind, nil,
+	)
+	s.state.EXPECT().GetStoragePool(gomock.Any(), poolUUID).Return(
+		domainstorage.StoragePool{Name: "pool1", Provider: "provider1"}, nil,
+	)
+	s.storageRegistryGetter.EXPECT().GetStorageRegistry(gomock.Any()).Return(s.registry, nil)
+	s.registry.EXPECT().StorageProvider(
+		internalstorage.ProviderType("provider1"),
+	).Return(s.provider, nil)
+	s.provider.EXPECT().Supports(internalstorage.StorageKindBlock).Return(
+		kind == domainstorage.StorageKindBlock,
+	).AnyTimes()
+	s.provider.EXPECT().Supports(internalstorage.StorageKindFilesystem).Return(
+		kind == domainstorage.StorageKindFilesystem,
+	).AnyTimes()
+	s.provider.EXPECT().Scope().Return(internalstorage.ScopeEnviron).AnyTimes()
+}
@@ -5,10 +5,12 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/clock"
 
 	"github.com/juju/juju/core/logger"
+	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/providertracker"
 	corestorage "github.com/juju/juju/core/storage"
 	"github.com/juju/juju/core/trace"
@@ -79,6 +81,59 @@ type State interface {
 		ctx context.Context,
 		storageIDs []string,
 	) (map[string]domainstorage.StorageInstanceUUID, error)
+
+	// GetStorageInstancePoolAndKind returns the storage pool and the kind of
+	// the storage instance.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound]
+	// when no storage instance exists for the supplied uuid.
+	GetStorageInstancePoolAndKind(
+		ctx context.Context, uuid domainstorage.StorageInstanceUUID,
+	) (domainstorage.StoragePoolUUID, domainstorage.StorageKind, error)
+
+	// GetStorageInstanceProviderIDs returns the provider ids of the volume
+	// and filesystem of the storage instance. An empty id is returned for a
+	// volume or filesystem that the storage instance does not have, or that
+	// has not been provisioned yet.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound]
+	// when no storage instance exists for the supplied uuid.
+	GetStorageInstanceProviderIDs(
+		ctx context.Context, uuid domainstorage.StorageInstanceUUID,
+	) (string, string, error)
+
+	// SetStorageInstanceRequestedSize grows the requested size of the
+	// storage instance to the supplied size in MiB, returning the previously
+	// requested size.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound]
+	// when no storage instance exists for the supplied uuid.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotAlive]
+	// when the storage instance is not alive.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceShrinkNotSupported]
+	// when the supplied size is smaller than the currently requested size.
+	SetStorageInstanceRequestedSize(
+		ctx context.Context,
+		uuid domainstorage.StorageInstanceUUID,
+		sizeMiB uint64,
+	) (uint64, error)
+
+	// SetMachineFilesystemUsage records the usage of the filesystems
+	// mounted on the supplied machine against the filesystem attachments
+	// of the machine with matching mount points.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/machine/errors.MachineNotFound] when
+	// the machine does not exist in the model.
+	SetMachineFilesystemUsage(
+		ctx context.Context,
+		machineUUID coremachine.UUID,
+		usage []corestorage.FilesystemUsage,
+		updatedAt time.Time,
+	) error
 }
 
 // Service defines a service for interacting with the underlying state.
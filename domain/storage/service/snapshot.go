@@ -0,0 +1,109 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/errors"
+	internalstorage "github.com/juju/juju/internal/storage"
+)
+
+// snapshotNameLayout is the layout of the time used to name snapshots that
+// are not given a name.
+const snapshotNameLayout = "snap-20060102-150405"
+
+// CreateStorageInstanceSnapshot takes a snapshot of the volume, or the
+// filesystem when there is no volume, provisioned for the storage instance
+// and returns the provider id of the snapshot. The snapshot id can be used
+// to create new storage from the snapshot. When name is empty, a name is
+// generated from the current time.
+//
+// Snapshots are taken from the controller, so only storage provisioned by
+// model scoped storage providers can be snapshotted.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the supplied storage instance uuid is not
+// valid.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound] when
+// the storage instance does not exist.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceSnapshotNotSupported]
+// when the storage provider of the storage instance cannot snapshot its
+// volume or filesystem from the controller.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotProvisioned]
+// when the volume or filesystem of the storage instance has not been
+// provisioned yet.
+func (s *Service) CreateStorageInstanceSnapshot(
+	ctx context.Context, uuid domainstorage.StorageInstanceUUID, name string,
+) (string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return "", errors.New(
+			"storage instance uuid is not valid",
+		).Add(coreerrors.NotValid)
+	}
+	if name == "" {
+		name = s.clock.Now().UTC().Format(snapshotNameLayout)
+	}
+
+	src, err := s.getStorageInstanceSource(ctx, uuid)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	snapshotter, ok := src.source.(internalstorage.VolumeSnapshotter)
+	if !ok || src.scope != internalstorage.ScopeEnviron {
+		return "", errors.Errorf(
+			"storage provider %q does not support snapshotting storage",
+			src.providerType,
+		).Add(domainstorageerrors.StorageInstanceSnapshotNotSupported)
+	}
+
+	volumeID, filesystemID, err := s.st.GetStorageInstanceProviderIDs(ctx, uuid)
+	if err != nil {
+		return "", errors.Errorf(
+			"getting provider ids of storage instance %q: %w", uuid, err,
+		)
+	}
+	providerID := filesystemID
+	if src.volume {
+		providerID = volumeID
+	}
+	if providerID == "" {
+		return "", errors.Errorf(
+			"storage instance %q has not been provisioned", uuid,
+		).Add(domainstorageerrors.StorageInstanceNotProvisioned)
+	}
+
+	results, err := snapshotter.CreateSnapshots(ctx, []internalstorage.SnapshotParams{{
+		Name:       name,
+		ProviderId: providerID,
+	}})
+	if err != nil {
+		return "", errors.Errorf(
+			"creating snapshot of storage instance %q: %w", uuid, err,
+		)
+	}
+	if len(results) != 1 {
+		return "", errors.Errorf(
+			"expected 1 snapshot result, got %d", len(results),
+		)
+	}
+	if results[0].Error != nil {
+		return "", errors.Errorf(
+			"creating snapshot of storage instance %q: %w", uuid, results[0].Error,
+		)
+	}
+
+	s.logger.Infof(ctx,
+		"created snapshot %q of storage instance %q",
+		results[0].Snapshot.SnapshotId, uuid,
+	)
+	return results[0].Snapshot.SnapshotId, nil
+}
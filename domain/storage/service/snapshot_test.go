@@ -0,0 +1,206 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	internalstorage "github.com/juju/juju/internal/storage"
+)
+
+// snapshotSuite is a test suite for asserting the parts of the [Service]
+// interface that relate to snapshotting storage instances.
+type snapshotSuite struct {
+	state                 *MockState
+	storageRegistryGetter *MockModelStorageRegistryGetter
+	registry              *MockProviderRegistry
+	provider              *MockProvider
+	filesystemSource      *mockFilesystemSourceAndSnapshotter
+}
+
+type mockFilesystemSourceAndSnapshotter struct {
+	*MockFilesystemSource
+	*MockVolumeSnapshotter
+}
+
+// TestSnapshotSuite runs all of the tests contained within [snapshotSuite].
+func TestSnapshotSuite(t *testing.T) {
+	tc.Run(t, &snapshotSuite{})
+}
+
+func (s *snapshotSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.storageRegistryGetter = NewMockModelStorageRegistryGetter(ctrl)
+	s.registry = NewMockProviderRegistry(ctrl)
+	s.provider = NewMockProvider(ctrl)
+	s.filesystemSource = &mockFilesystemSourceAndSnapshotter{
+		MockFilesystemSource:  NewMockFilesystemSource(ctrl),
+		MockVolumeSnapshotter: NewMockVolumeSnapshotter(ctrl),
+	}
+
+	c.Cleanup(func() {
+		s.state = nil
+		s.storageRegistryGetter = nil
+		s.registry = nil
+		s.provider = nil
+		s.filesystemSource = nil
+	})
+	return ctrl
+}
+
+func (s *snapshotSuite) newService(c *tc.C) *Service {
+	return NewService(
+		s.state,
+		loggertesting.WrapCheckLog(c),
+		testclock.NewClock(time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)),
+		s.storageRegistryGetter,
+	)
+}
+
+// TestCreateStorageInstanceSnapshot is a happy path test for
+// [Service.CreateStorageInstanceSnapshot].
+func (s *snapshotSuite) TestCreateStorageInstanceSnapshot(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.expectStorageProvider(c, uuid, internalstorage.ScopeEnviron)
+	s.provider.EXPECT().FilesystemSource(gomock.Any()).Return(s.filesystemSource, nil)
+	s.state.EXPECT().GetStorageInstanceProviderIDs(gomock.Any(), uuid).Return(
+		"", "default:fs-1", nil,
+	)
+	s.filesystemSource.MockVolumeSnapshotter.EXPECT().CreateSnapshots(
+		gomock.Any(), []internalstorage.SnapshotParams{{
+			Name:       "nightly",
+			ProviderId: "default:fs-1",
+		}},
+	).Return([]internalstorage.CreateSnapshotsResult{{
+		Snapshot: &internalstorage.SnapshotInfo{
+			SnapshotId: "default:fs-1/nightly",
+			Name:       "nightly",
+			ProviderId: "default:fs-1",
+		},
+	}}, nil)
+
+	id, err := s.newService(c).CreateStorageInstanceSnapshot(
+		c.Context(), uuid, "nightly",
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, "default:fs-1/nightly")
+}
+
+// TestCreateStorageInstanceSnapshotDefaultName tests that a snapshot is
+// named after the current time when no name is supplied.
+func (s *snapshotSuite) TestCreateStorageInstanceSnapshotDefaultName(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.expectStorageProvider(c, uuid, internalstorage.ScopeEnviron)
+	s.provider.EXPECT().FilesystemSource(gomock.Any()).Return(s.filesystemSource, nil)
+	s.state.EXPECT().GetStorageInstanceProviderIDs(gomock.Any(), uuid).Return(
+		"", "default:fs-1", nil,
+	)
+	s.filesystemSource.MockVolumeSnapshotter.EXPECT().CreateSnapshots(
+		gomock.Any(), []internalstorage.SnapshotParams{{
+			Name:       "snap-20261019-093000",
+			ProviderId: "default:fs-1",
+		}},
+	).Return([]internalstorage.CreateSnapshotsResult{{
+		Snapshot: &internalstorage.SnapshotInfo{
+			SnapshotId: "default:fs-1/snap-20261019-093000",
+		},
+	}}, nil)
+
+	id, err := s.newService(c).CreateStorageInstanceSnapshot(c.Context(), uuid, "")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, "default:fs-1/snap-20261019-093000")
+}
+
+// TestCreateStorageInstanceSnapshotNotValid tests that an invalid uuid
+// results in a [coreerrors.NotValid] error.
+func (s *snapshotSuite) TestCreateStorageInstanceSnapshotNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.newService(c).CreateStorageInstanceSnapshot(c.Context(), "", "nightly")
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+// TestCreateStorageInstanceSnapshotNotSupported tests that snapshotting a
+// storage instance whose source cannot snapshot returns
+// [domainstorageerrors.StorageInstanceSnapshotNotSupported].
+func (s *snapshotSuite) TestCreateStorageInstanceSnapshotNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.expectStorageProvider(c, uuid, internalstorage.ScopeEnviron)
+	s.provider.EXPECT().FilesystemSource(gomock.Any()).Return(
+		s.filesystemSource.MockFilesystemSource, nil,
+	)
+
+	_, err := s.newService(c).CreateStorageInstanceSnapshot(c.Context(), uuid, "nightly")
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceSnapshotNotSupported)
+}
+
+// TestCreateStorageInstanceSnapshotMachineScoped tests that snapshotting a
+// storage instance of a machine scoped provider returns
+// [domainstorageerrors.StorageInstanceSnapshotNotSupported], as the
+// snapshot cannot be taken from the controller.
+func (s *snapshotSuite) TestCreateStorageInstanceSnapshotMachineScoped(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.expectStorageProvider(c, uuid, internalstorage.ScopeMachine)
+	s.provider.EXPECT().FilesystemSource(gomock.Any()).Return(s.filesystemSource, nil)
+
+	_, err := s.newService(c).CreateStorageInstanceSnapshot(c.Context(), uuid, "nightly")
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceSnapshotNotSupported)
+}
+
+// TestCreateStorageInstanceSnapshotNotProvisioned tests that snapshotting a
+// storage instance whose filesystem has not been provisioned returns
+// [domainstorageerrors.StorageInstanceNotProvisioned].
+func (s *snapshotSuite) TestCreateStorageInstanceSnapshotNotProvisioned(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.expectStorageProvider(c, uuid, internalstorage.ScopeEnviron)
+	s.provider.EXPECT().FilesystemSource(gomock.Any()).Return(s.filesystemSource, nil)
+	s.state.EXPECT().GetStorageInstanceProviderIDs(gomock.Any(), uuid).Return(
+		"", "", nil,
+	)
+
+	_, err := s.newService(c).CreateStorageInstanceSnapshot(c.Context(), uuid, "nightly")
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotProvisioned)
+}
+
+// expectStorageProvider sets up the lookup of the storage provider for a
+// filesystem storage instance in a pool of a provider with the supplied
+// scope that supports only filesystems.
+func (s *snapshotSuite) expectStorageProvider(
+	c *tc.C, uuid domainstorage.StorageInstanceUUID, scope internalstorage.Scope,
+) {
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	s.state.EXPECT().GetStorageInstancePoolAndKind(gomock.Any(), uuid).Return(
+		poolUUID, domainstorage.StorageKindFilesystem, nil,
+	)
+	s.state.EXPECT().GetStoragePool(gomock.Any(), poolUUID).Return(
+		domainstorage.StoragePool{Name: "pool1", Provider: "provider1"}, nil,
+	)
+	s.storageRegistryGetter.EXPECT().GetStorageRegistry(gomock.Any()).Return(s.registry, nil)
+	s.registry.EXPECT().StorageProvider(
+		internalstorage.ProviderType("provider1"),
+	).Return(s.provider, nil)
+	s.provider.EXPECT().Supports(internalstorage.StorageKindBlock).Return(false).AnyTimes()
+	s.provider.EXPECT().Supports(internalstorage.StorageKindFilesystem).Return(true).AnyTimes()
+	s.provider.EXPECT().Scope().Return(scope).AnyTimes()
+}
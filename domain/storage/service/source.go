@@ -0,0 +1,123 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/collections/transform"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+	domainstorageprovisioning "github.com/juju/juju/domain/storageprovisioning"
+	"github.com/juju/juju/internal/errors"
+	internalstorage "github.com/juju/juju/internal/storage"
+)
+
+// storageInstanceSource describes the source that provisions the volume, or
+// the filesystem when there is no volume, of a storage instance.
+type storageInstanceSource struct {
+	// providerType is the type of the storage provider of the storage
+	// instance's pool.
+	providerType string
+
+	// scope is the scope of the storage provider.
+	scope internalstorage.Scope
+
+	// volume is true when source is a volume source.
+	volume bool
+
+	// source is either a [internalstorage.VolumeSource] or a
+	// [internalstorage.FilesystemSource]. Callers are expected to check it
+	// for the optional interfaces they need.
+	source any
+}
+
+// getStorageInstanceSource returns the source of the storage provider that
+// provisions the volume of the storage instance, or its filesystem when the
+// storage instance has no volume.
+//
+// The following errors may be returned:
+// - [domainstorageerrors.StorageInstanceNotFound] when the storage instance
+// does not exist.
+// - [domainstorageerrors.ProviderTypeNotFound] when the storage provider of
+// the storage instance's pool does not exist.
+func (s *Service) getStorageInstanceSource(
+	ctx context.Context, uuid domainstorage.StorageInstanceUUID,
+) (storageInstanceSource, error) {
+	poolUUID, kind, err := s.st.GetStorageInstancePoolAndKind(ctx, uuid)
+	if err != nil {
+		return storageInstanceSource{}, errors.Errorf(
+			"getting storage pool of storage instance %q: %w", uuid, err,
+		)
+	}
+	pool, err := s.st.GetStoragePool(ctx, poolUUID)
+	if err != nil {
+		return storageInstanceSource{}, errors.Errorf(
+			"getting storage pool: %w", err,
+		)
+	}
+	poolConfig, err := internalstorage.NewConfig(
+		pool.Name,
+		internalstorage.ProviderType(pool.Provider),
+		transform.Map(pool.Attrs, func(k string, v string) (string, any) {
+			return k, v
+		}),
+	)
+	if err != nil {
+		return storageInstanceSource{}, errors.Errorf(
+			"storage pool %q is misconfigured: %w", pool.Name, err,
+		)
+	}
+
+	registry, err := s.StorageService.registryGetter.GetStorageRegistry(ctx)
+	if err != nil {
+		return storageInstanceSource{}, errors.Errorf(
+			"getting storage registry: %w", err,
+		)
+	}
+	sp, err := registry.StorageProvider(
+		internalstorage.ProviderType(pool.Provider))
+	if errors.Is(err, coreerrors.NotFound) {
+		return storageInstanceSource{}, errors.Errorf(
+			"storage provider type %q not found for pool %q",
+			pool.Provider, pool.Name,
+		).Add(domainstorageerrors.ProviderTypeNotFound)
+	} else if err != nil {
+		return storageInstanceSource{}, errors.Errorf(
+			"getting storage provider: %w", err,
+		)
+	}
+
+	ic, err := domainstorageprovisioning.CalculateStorageInstanceComposition(
+		kind, sp)
+	if err != nil {
+		return storageInstanceSource{}, errors.Errorf(
+			"calculating storage instance composition: %w", err,
+		)
+	}
+
+	rval := storageInstanceSource{
+		providerType: pool.Provider,
+		scope:        sp.Scope(),
+		volume:       ic.VolumeRequired,
+	}
+	if ic.VolumeRequired {
+		rval.source, err = sp.VolumeSource(poolConfig)
+		if err != nil {
+			return storageInstanceSource{}, errors.Errorf(
+				"getting volume source: %w", err,
+			)
+		}
+	} else {
+		rval.source, err = sp.FilesystemSource(poolConfig)
+		if err != nil {
+			return storageInstanceSource{}, errors.Errorf(
+				"getting filesystem source: %w", err,
+			)
+		}
+	}
+	return rval, nil
+}
@@ -12,11 +12,13 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	machine "github.com/juju/juju/core/machine"
+	storage "github.com/juju/juju/core/storage"
 	unit "github.com/juju/juju/core/unit"
 	network "github.com/juju/juju/domain/network"
-	storage "github.com/juju/juju/domain/storage"
+	storage0 "github.com/juju/juju/domain/storage"
 	internal "github.com/juju/juju/domain/storage/internal"
 	storageprovisioning "github.com/juju/juju/domain/storageprovisioning"
 	gomock "go.uber.org/mock/gomock"
@@ -200,10 +202,10 @@ func (c *MockStateDeleteStoragePoolCall) DoAndReturn(f func(context.Context, str
 }
 
 // GetFilesystemUUIDsByMachines mocks base method.
-func (m *MockState) GetFilesystemUUIDsByMachines(arg0 context.Context, arg1 []machine.UUID) ([]storage.FilesystemUUID, error) {
+func (m *MockState) GetFilesystemUUIDsByMachines(arg0 context.Context, arg1 []machine.UUID) ([]storage0.FilesystemUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetFilesystemUUIDsByMachines", arg0, arg1)
-	ret0, _ := ret[0].([]storage.FilesystemUUID)
+	ret0, _ := ret[0].([]storage0.FilesystemUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -221,28 +223,28 @@ type MockStateGetFilesystemUUIDsByMachinesCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetFilesystemUUIDsByMachinesCall) Return(arg0 []storage.FilesystemUUID, arg1 error) *MockStateGetFilesystemUUIDsByMachinesCall {
+func (c *MockStateGetFilesystemUUIDsByMachinesCall) Return(arg0 []storage0.FilesystemUUID, arg1 error) *MockStateGetFilesystemUUIDsByMachinesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetFilesystemUUIDsByMachinesCall) Do(f func(context.Context, []machine.UUID) ([]storage.FilesystemUUID, error)) *MockStateGetFilesystemUUIDsByMachinesCall {
+func (c *MockStateGetFilesystemUUIDsByMachinesCall) Do(f func(context.Context, []machine.UUID) ([]storage0.FilesystemUUID, error)) *MockStateGetFilesystemUUIDsByMachinesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetFilesystemUUIDsByMachinesCall) DoAndReturn(f func(context.Context, []machine.UUID) ([]storage.FilesystemUUID, error)) *MockStateGetFilesystemUUIDsByMachinesCall {
+func (c *MockStateGetFilesystemUUIDsByMachinesCall) DoAndReturn(f func(context.Context, []machine.UUID) ([]storage0.FilesystemUUID, error)) *MockStateGetFilesystemUUIDsByMachinesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetStorageAttachmentUUIDForStorageInstanceAndUnit mocks base method.
-func (m *MockState) GetStorageAttachmentUUIDForStorageInstanceAndUnit(arg0 context.Context, arg1 storage.StorageInstanceUUID, arg2 unit.UUID) (storage.StorageAttachmentUUID, error) {
+func (m *MockState) GetStorageAttachmentUUIDForStorageInstanceAndUnit(arg0 context.Context, arg1 storage0.StorageInstanceUUID, arg2 unit.UUID) (storage0.StorageAttachmentUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStorageAttachmentUUIDForStorageInstanceAndUnit", arg0, arg1, arg2)
-	ret0, _ := ret[0].(storage.StorageAttachmentUUID)
+	ret0, _ := ret[0].(storage0.StorageAttachmentUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -260,28 +262,28 @@ type MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall) Return(arg0 storage.StorageAttachmentUUID, arg1 error) *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall {
+func (c *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall) Return(arg0 storage0.StorageAttachmentUUID, arg1 error) *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall) Do(f func(context.Context, storage.StorageInstanceUUID, unit.UUID) (storage.StorageAttachmentUUID, error)) *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall {
+func (c *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall) Do(f func(context.Context, storage0.StorageInstanceUUID, unit.UUID) (storage0.StorageAttachmentUUID, error)) *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall) DoAndReturn(f func(context.Context, storage.StorageInstanceUUID, unit.UUID) (storage.StorageAttachmentUUID, error)) *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall {
+func (c *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall) DoAndReturn(f func(context.Context, storage0.StorageInstanceUUID, unit.UUID) (storage0.StorageAttachmentUUID, error)) *MockStateGetStorageAttachmentUUIDForStorageInstanceAndUnitCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetStorageInstanceAttachments mocks base method.
-func (m *MockState) GetStorageInstanceAttachments(arg0 context.Context, arg1 storage.StorageInstanceUUID) ([]storage.StorageAttachmentUUID, error) {
+func (m *MockState) GetStorageInstanceAttachments(arg0 context.Context, arg1 storage0.StorageInstanceUUID) ([]storage0.StorageAttachmentUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStorageInstanceAttachments", arg0, arg1)
-	ret0, _ := ret[0].([]storage.StorageAttachmentUUID)
+	ret0, _ := ret[0].([]storage0.StorageAttachmentUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -299,25 +301,25 @@ type MockStateGetStorageInstanceAttachmentsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetStorageInstanceAttachmentsCall) Return(arg0 []storage.StorageAttachmentUUID, arg1 error) *MockStateGetStorageInstanceAttachmentsCall {
+func (c *MockStateGetStorageInstanceAttachmentsCall) Return(arg0 []storage0.StorageAttachmentUUID, arg1 error) *MockStateGetStorageInstanceAttachmentsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetStorageInstanceAttachmentsCall) Do(f func(context.Context, storage.StorageInstanceUUID) ([]storage.StorageAttachmentUUID, error)) *MockStateGetStorageInstanceAttachmentsCall {
+func (c *MockStateGetStorageInstanceAttachmentsCall) Do(f func(context.Context, storage0.StorageInstanceUUID) ([]storage0.StorageAttachmentUUID, error)) *MockStateGetStorageInstanceAttachmentsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetStorageInstanceAttachmentsCall) DoAndReturn(f func(context.Context, storage.StorageInstanceUUID) ([]storage.StorageAttachmentUUID, error)) *MockStateGetStorageInstanceAttachmentsCall {
+func (c *MockStateGetStorageInstanceAttachmentsCall) DoAndReturn(f func(context.Context, storage0.StorageInstanceUUID) ([]storage0.StorageAttachmentUUID, error)) *MockStateGetStorageInstanceAttachmentsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetStorageInstanceInfo mocks base method.
-func (m *MockState) GetStorageInstanceInfo(arg0 context.Context, arg1 storage.StorageInstanceUUID) (internal.StorageInstanceInfo, error) {
+func (m *MockState) GetStorageInstanceInfo(arg0 context.Context, arg1 storage0.StorageInstanceUUID) (internal.StorageInstanceInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStorageInstanceInfo", arg0, arg1)
 	ret0, _ := ret[0].(internal.StorageInstanceInfo)
@@ -344,22 +346,102 @@ func (c *MockStateGetStorageInstanceInfoCall) Return(arg0 internal.StorageInstan
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetStorageInstanceInfoCall) Do(f func(context.Context, storage.StorageInstanceUUID) (internal.StorageInstanceInfo, error)) *MockStateGetStorageInstanceInfoCall {
+func (c *MockStateGetStorageInstanceInfoCall) Do(f func(context.Context, storage0.StorageInstanceUUID) (internal.StorageInstanceInfo, error)) *MockStateGetStorageInstanceInfoCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetStorageInstanceInfoCall) DoAndReturn(f func(context.Context, storage.StorageInstanceUUID) (internal.StorageInstanceInfo, error)) *MockStateGetStorageInstanceInfoCall {
+func (c *MockStateGetStorageInstanceInfoCall) DoAndReturn(f func(context.Context, storage0.StorageInstanceUUID) (internal.StorageInstanceInfo, error)) *MockStateGetStorageInstanceInfoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStorageInstancePoolAndKind mocks base method.
+func (m *MockState) GetStorageInstancePoolAndKind(arg0 context.Context, arg1 storage0.StorageInstanceUUID) (storage0.StoragePoolUUID, storage0.StorageKind, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageInstancePoolAndKind", arg0, arg1)
+	ret0, _ := ret[0].(storage0.StoragePoolUUID)
+	ret1, _ := ret[1].(storage0.StorageKind)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStorageInstancePoolAndKind indicates an expected call of GetStorageInstancePoolAndKind.
+func (mr *MockStateMockRecorder) GetStorageInstancePoolAndKind(arg0, arg1 any) *MockStateGetStorageInstancePoolAndKindCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageInstancePoolAndKind", reflect.TypeOf((*MockState)(nil).GetStorageInstancePoolAndKind), arg0, arg1)
+	return &MockStateGetStorageInstancePoolAndKindCall{Call: call}
+}
+
+// MockStateGetStorageInstancePoolAndKindCall wrap *gomock.Call
+type MockStateGetStorageInstancePoolAndKindCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStorageInstancePoolAndKindCall) Return(arg0 storage0.StoragePoolUUID, arg1 storage0.StorageKind, arg2 error) *MockStateGetStorageInstancePoolAndKindCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStorageInstancePoolAndKindCall) Do(f func(context.Context, storage0.StorageInstanceUUID) (storage0.StoragePoolUUID, storage0.StorageKind, error)) *MockStateGetStorageInstancePoolAndKindCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStorageInstancePoolAndKindCall) DoAndReturn(f func(context.Context, storage0.StorageInstanceUUID) (storage0.StoragePoolUUID, storage0.StorageKind, error)) *MockStateGetStorageInstancePoolAndKindCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStorageInstanceProviderIDs mocks base method.
+func (m *MockState) GetStorageInstanceProviderIDs(arg0 context.Context, arg1 storage0.StorageInstanceUUID) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageInstanceProviderIDs", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStorageInstanceProviderIDs indicates an expected call of GetStorageInstanceProviderIDs.
+func (mr *MockStateMockRecorder) GetStorageInstanceProviderIDs(arg0, arg1 any) *MockStateGetStorageInstanceProviderIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageInstanceProviderIDs", reflect.TypeOf((*MockState)(nil).GetStorageInstanceProviderIDs), arg0, arg1)
+	return &MockStateGetStorageInstanceProviderIDsCall{Call: call}
+}
+
+// MockStateGetStorageInstanceProviderIDsCall wrap *gomock.Call
+type MockStateGetStorageInstanceProviderIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStorageInstanceProviderIDsCall) Return(arg0, arg1 string, arg2 error) *MockStateGetStorageInstanceProviderIDsCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStorageInstanceProviderIDsCall) Do(f func(context.Context, storage0.StorageInstanceUUID) (string, string, error)) *MockStateGetStorageInstanceProviderIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStorageInstanceProviderIDsCall) DoAndReturn(f func(context.Context, storage0.StorageInstanceUUID) (string, string, error)) *MockStateGetStorageInstanceProviderIDsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetStorageInstanceUUIDByID mocks base method.
-func (m *MockState) GetStorageInstanceUUIDByID(arg0 context.Context, arg1 string) (storage.StorageInstanceUUID, error) {
+func (m *MockState) GetStorageInstanceUUIDByID(arg0 context.Context, arg1 string) (storage0.StorageInstanceUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStorageInstanceUUIDByID", arg0, arg1)
-	ret0, _ := ret[0].(storage.StorageInstanceUUID)
+	ret0, _ := ret[0].(storage0.StorageInstanceUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -377,28 +459,28 @@ type MockStateGetStorageInstanceUUIDByIDCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetStorageInstanceUUIDByIDCall) Return(arg0 storage.StorageInstanceUUID, arg1 error) *MockStateGetStorageInstanceUUIDByIDCall {
+func (c *MockStateGetStorageInstanceUUIDByIDCall) Return(arg0 storage0.StorageInstanceUUID, arg1 error) *MockStateGetStorageInstanceUUIDByIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetStorageInstanceUUIDByIDCall) Do(f func(context.Context, string) (storage.StorageInstanceUUID, error)) *MockStateGetStorageInstanceUUIDByIDCall {
+func (c *MockStateGetStorageInstanceUUIDByIDCall) Do(f func(context.Context, string) (storage0.StorageInstanceUUID, error)) *MockStateGetStorageInstanceUUIDByIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetStorageInstanceUUIDByIDCall) DoAndReturn(f func(context.Context, string) (storage.StorageInstanceUUID, error)) *MockStateGetStorageInstanceUUIDByIDCall {
+func (c *MockStateGetStorageInstanceUUIDByIDCall) DoAndReturn(f func(context.Context, string) (storage0.StorageInstanceUUID, error)) *MockStateGetStorageInstanceUUIDByIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetStorageInstanceUUIDsByIDs mocks base method.
-func (m *MockState) GetStorageInstanceUUIDsByIDs(arg0 context.Context, arg1 []string) (map[string]storage.StorageInstanceUUID, error) {
+func (m *MockState) GetStorageInstanceUUIDsByIDs(arg0 context.Context, arg1 []string) (map[string]storage0.StorageInstanceUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStorageInstanceUUIDsByIDs", arg0, arg1)
-	ret0, _ := ret[0].(map[string]storage.StorageInstanceUUID)
+	ret0, _ := ret[0].(map[string]storage0.StorageInstanceUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -416,28 +498,28 @@ type MockStateGetStorageInstanceUUIDsByIDsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetStorageInstanceUUIDsByIDsCall) Return(arg0 map[string]storage.StorageInstanceUUID, arg1 error) *MockStateGetStorageInstanceUUIDsByIDsCall {
+func (c *MockStateGetStorageInstanceUUIDsByIDsCall) Return(arg0 map[string]storage0.StorageInstanceUUID, arg1 error) *MockStateGetStorageInstanceUUIDsByIDsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetStorageInstanceUUIDsByIDsCall) Do(f func(context.Context, []string) (map[string]storage.StorageInstanceUUID, error)) *MockStateGetStorageInstanceUUIDsByIDsCall {
+func (c *MockStateGetStorageInstanceUUIDsByIDsCall) Do(f func(context.Context, []string) (map[string]storage0.StorageInstanceUUID, error)) *MockStateGetStorageInstanceUUIDsByIDsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetStorageInstanceUUIDsByIDsCall) DoAndReturn(f func(context.Context, []string) (map[string]storage.StorageInstanceUUID, error)) *MockStateGetStorageInstanceUUIDsByIDsCall {
+func (c *MockStateGetStorageInstanceUUIDsByIDsCall) DoAndReturn(f func(context.Context, []string) (map[string]storage0.StorageInstanceUUID, error)) *MockStateGetStorageInstanceUUIDsByIDsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetStoragePool mocks base method.
-func (m *MockState) GetStoragePool(arg0 context.Context, arg1 storage.StoragePoolUUID) (storage.StoragePool, error) {
+func (m *MockState) GetStoragePool(arg0 context.Context, arg1 storage0.StoragePoolUUID) (storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStoragePool", arg0, arg1)
-	ret0, _ := ret[0].(storage.StoragePool)
+	ret0, _ := ret[0].(storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -455,28 +537,28 @@ type MockStateGetStoragePoolCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetStoragePoolCall) Return(arg0 storage.StoragePool, arg1 error) *MockStateGetStoragePoolCall {
+func (c *MockStateGetStoragePoolCall) Return(arg0 storage0.StoragePool, arg1 error) *MockStateGetStoragePoolCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetStoragePoolCall) Do(f func(context.Context, storage.StoragePoolUUID) (storage.StoragePool, error)) *MockStateGetStoragePoolCall {
+func (c *MockStateGetStoragePoolCall) Do(f func(context.Context, storage0.StoragePoolUUID) (storage0.StoragePool, error)) *MockStateGetStoragePoolCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetStoragePoolCall) DoAndReturn(f func(context.Context, storage.StoragePoolUUID) (storage.StoragePool, error)) *MockStateGetStoragePoolCall {
+func (c *MockStateGetStoragePoolCall) DoAndReturn(f func(context.Context, storage0.StoragePoolUUID) (storage0.StoragePool, error)) *MockStateGetStoragePoolCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetStoragePoolUUID mocks base method.
-func (m *MockState) GetStoragePoolUUID(arg0 context.Context, arg1 string) (storage.StoragePoolUUID, error) {
+func (m *MockState) GetStoragePoolUUID(arg0 context.Context, arg1 string) (storage0.StoragePoolUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStoragePoolUUID", arg0, arg1)
-	ret0, _ := ret[0].(storage.StoragePoolUUID)
+	ret0, _ := ret[0].(storage0.StoragePoolUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -494,28 +576,28 @@ type MockStateGetStoragePoolUUIDCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetStoragePoolUUIDCall) Return(arg0 storage.StoragePoolUUID, arg1 error) *MockStateGetStoragePoolUUIDCall {
+func (c *MockStateGetStoragePoolUUIDCall) Return(arg0 storage0.StoragePoolUUID, arg1 error) *MockStateGetStoragePoolUUIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetStoragePoolUUIDCall) Do(f func(context.Context, string) (storage.StoragePoolUUID, error)) *MockStateGetStoragePoolUUIDCall {
+func (c *MockStateGetStoragePoolUUIDCall) Do(f func(context.Context, string) (storage0.StoragePoolUUID, error)) *MockStateGetStoragePoolUUIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetStoragePoolUUIDCall) DoAndReturn(f func(context.Context, string) (storage.StoragePoolUUID, error)) *MockStateGetStoragePoolUUIDCall {
+func (c *MockStateGetStoragePoolUUIDCall) DoAndReturn(f func(context.Context, string) (storage0.StoragePoolUUID, error)) *MockStateGetStoragePoolUUIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetStoragePoolUUIDsByName mocks base method.
-func (m *MockState) GetStoragePoolUUIDsByName(arg0 context.Context, arg1 []string) ([]storage.StoragePoolNameUUID, error) {
+func (m *MockState) GetStoragePoolUUIDsByName(arg0 context.Context, arg1 []string) ([]storage0.StoragePoolNameUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStoragePoolUUIDsByName", arg0, arg1)
-	ret0, _ := ret[0].([]storage.StoragePoolNameUUID)
+	ret0, _ := ret[0].([]storage0.StoragePoolNameUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -533,19 +615,19 @@ type MockStateGetStoragePoolUUIDsByNameCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetStoragePoolUUIDsByNameCall) Return(arg0 []storage.StoragePoolNameUUID, arg1 error) *MockStateGetStoragePoolUUIDsByNameCall {
+func (c *MockStateGetStoragePoolUUIDsByNameCall) Return(arg0 []storage0.StoragePoolNameUUID, arg1 error) *MockStateGetStoragePoolUUIDsByNameCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetStoragePoolUUIDsByNameCall) Do(f func(context.Context, []string) ([]storage.StoragePoolNameUUID, error)) *MockStateGetStoragePoolUUIDsByNameCall {
+func (c *MockStateGetStoragePoolUUIDsByNameCall) Do(f func(context.Context, []string) ([]storage0.StoragePoolNameUUID, error)) *MockStateGetStoragePoolUUIDsByNameCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetStoragePoolUUIDsByNameCall) DoAndReturn(f func(context.Context, []string) ([]storage.StoragePoolNameUUID, error)) *MockStateGetStoragePoolUUIDsByNameCall {
+func (c *MockStateGetStoragePoolUUIDsByNameCall) DoAndReturn(f func(context.Context, []string) ([]storage0.StoragePoolNameUUID, error)) *MockStateGetStoragePoolUUIDsByNameCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -590,10 +672,10 @@ func (c *MockStateGetStorageResourceTagInfoForModelCall) DoAndReturn(f func(cont
 }
 
 // GetVolumeUUIDsByMachines mocks base method.
-func (m *MockState) GetVolumeUUIDsByMachines(arg0 context.Context, arg1 []machine.UUID) ([]storage.VolumeUUID, error) {
+func (m *MockState) GetVolumeUUIDsByMachines(arg0 context.Context, arg1 []machine.UUID) ([]storage0.VolumeUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetVolumeUUIDsByMachines", arg0, arg1)
-	ret0, _ := ret[0].([]storage.VolumeUUID)
+	ret0, _ := ret[0].([]storage0.VolumeUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -611,28 +693,28 @@ type MockStateGetVolumeUUIDsByMachinesCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetVolumeUUIDsByMachinesCall) Return(arg0 []storage.VolumeUUID, arg1 error) *MockStateGetVolumeUUIDsByMachinesCall {
+func (c *MockStateGetVolumeUUIDsByMachinesCall) Return(arg0 []storage0.VolumeUUID, arg1 error) *MockStateGetVolumeUUIDsByMachinesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetVolumeUUIDsByMachinesCall) Do(f func(context.Context, []machine.UUID) ([]storage.VolumeUUID, error)) *MockStateGetVolumeUUIDsByMachinesCall {
+func (c *MockStateGetVolumeUUIDsByMachinesCall) Do(f func(context.Context, []machine.UUID) ([]storage0.VolumeUUID, error)) *MockStateGetVolumeUUIDsByMachinesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetVolumeUUIDsByMachinesCall) DoAndReturn(f func(context.Context, []machine.UUID) ([]storage.VolumeUUID, error)) *MockStateGetVolumeUUIDsByMachinesCall {
+func (c *MockStateGetVolumeUUIDsByMachinesCall) DoAndReturn(f func(context.Context, []machine.UUID) ([]storage0.VolumeUUID, error)) *MockStateGetVolumeUUIDsByMachinesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePools mocks base method.
-func (m *MockState) ListStoragePools(arg0 context.Context) ([]storage.StoragePool, error) {
+func (m *MockState) ListStoragePools(arg0 context.Context) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePools", arg0)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -650,28 +732,28 @@ type MockStateListStoragePoolsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateListStoragePoolsCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStateListStoragePoolsCall {
+func (c *MockStateListStoragePoolsCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStateListStoragePoolsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateListStoragePoolsCall) Do(f func(context.Context) ([]storage.StoragePool, error)) *MockStateListStoragePoolsCall {
+func (c *MockStateListStoragePoolsCall) Do(f func(context.Context) ([]storage0.StoragePool, error)) *MockStateListStoragePoolsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateListStoragePoolsCall) DoAndReturn(f func(context.Context) ([]storage.StoragePool, error)) *MockStateListStoragePoolsCall {
+func (c *MockStateListStoragePoolsCall) DoAndReturn(f func(context.Context) ([]storage0.StoragePool, error)) *MockStateListStoragePoolsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePoolsByNames mocks base method.
-func (m *MockState) ListStoragePoolsByNames(arg0 context.Context, arg1 []string) ([]storage.StoragePool, error) {
+func (m *MockState) ListStoragePoolsByNames(arg0 context.Context, arg1 []string) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePoolsByNames", arg0, arg1)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -689,28 +771,28 @@ type MockStateListStoragePoolsByNamesCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateListStoragePoolsByNamesCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStateListStoragePoolsByNamesCall {
+func (c *MockStateListStoragePoolsByNamesCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStateListStoragePoolsByNamesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateListStoragePoolsByNamesCall) Do(f func(context.Context, []string) ([]storage.StoragePool, error)) *MockStateListStoragePoolsByNamesCall {
+func (c *MockStateListStoragePoolsByNamesCall) Do(f func(context.Context, []string) ([]storage0.StoragePool, error)) *MockStateListStoragePoolsByNamesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateListStoragePoolsByNamesCall) DoAndReturn(f func(context.Context, []string) ([]storage.StoragePool, error)) *MockStateListStoragePoolsByNamesCall {
+func (c *MockStateListStoragePoolsByNamesCall) DoAndReturn(f func(context.Context, []string) ([]storage0.StoragePool, error)) *MockStateListStoragePoolsByNamesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePoolsByNamesAndProviders mocks base method.
-func (m *MockState) ListStoragePoolsByNamesAndProviders(arg0 context.Context, arg1, arg2 []string) ([]storage.StoragePool, error) {
+func (m *MockState) ListStoragePoolsByNamesAndProviders(arg0 context.Context, arg1, arg2 []string) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePoolsByNamesAndProviders", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -728,28 +810,28 @@ type MockStateListStoragePoolsByNamesAndProvidersCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateListStoragePoolsByNamesAndProvidersCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStateListStoragePoolsByNamesAndProvidersCall {
+func (c *MockStateListStoragePoolsByNamesAndProvidersCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStateListStoragePoolsByNamesAndProvidersCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateListStoragePoolsByNamesAndProvidersCall) Do(f func(context.Context, []string, []string) ([]storage.StoragePool, error)) *MockStateListStoragePoolsByNamesAndProvidersCall {
+func (c *MockStateListStoragePoolsByNamesAndProvidersCall) Do(f func(context.Context, []string, []string) ([]storage0.StoragePool, error)) *MockStateListStoragePoolsByNamesAndProvidersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateListStoragePoolsByNamesAndProvidersCall) DoAndReturn(f func(context.Context, []string, []string) ([]storage.StoragePool, error)) *MockStateListStoragePoolsByNamesAndProvidersCall {
+func (c *MockStateListStoragePoolsByNamesAndProvidersCall) DoAndReturn(f func(context.Context, []string, []string) ([]storage0.StoragePool, error)) *MockStateListStoragePoolsByNamesAndProvidersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePoolsByProviders mocks base method.
-func (m *MockState) ListStoragePoolsByProviders(arg0 context.Context, arg1 []string) ([]storage.StoragePool, error) {
+func (m *MockState) ListStoragePoolsByProviders(arg0 context.Context, arg1 []string) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePoolsByProviders", arg0, arg1)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -767,25 +849,25 @@ type MockStateListStoragePoolsByProvidersCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateListStoragePoolsByProvidersCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStateListStoragePoolsByProvidersCall {
+func (c *MockStateListStoragePoolsByProvidersCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStateListStoragePoolsByProvidersCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateListStoragePoolsByProvidersCall) Do(f func(context.Context, []string) ([]storage.StoragePool, error)) *MockStateListStoragePoolsByProvidersCall {
+func (c *MockStateListStoragePoolsByProvidersCall) Do(f func(context.Context, []string) ([]storage0.StoragePool, error)) *MockStateListStoragePoolsByProvidersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateListStoragePoolsByProvidersCall) DoAndReturn(f func(context.Context, []string) ([]storage.StoragePool, error)) *MockStateListStoragePoolsByProvidersCall {
+func (c *MockStateListStoragePoolsByProvidersCall) DoAndReturn(f func(context.Context, []string) ([]storage0.StoragePool, error)) *MockStateListStoragePoolsByProvidersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ReplaceStoragePool mocks base method.
-func (m *MockState) ReplaceStoragePool(arg0 context.Context, arg1 storage.StoragePool) error {
+func (m *MockState) ReplaceStoragePool(arg0 context.Context, arg1 storage0.StoragePool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReplaceStoragePool", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -811,13 +893,90 @@ func (c *MockStateReplaceStoragePoolCall) Return(arg0 error) *MockStateReplaceSt
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateReplaceStoragePoolCall) Do(f func(context.Context, storage.StoragePool) error) *MockStateReplaceStoragePoolCall {
+func (c *MockStateReplaceStoragePoolCall) Do(f func(context.Context, storage0.StoragePool) error) *MockStateReplaceStoragePoolCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateReplaceStoragePoolCall) DoAndReturn(f func(context.Context, storage0.StoragePool) error) *MockStateReplaceStoragePoolCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetMachineFilesystemUsage mocks base method.
+func (m *MockState) SetMachineFilesystemUsage(arg0 context.Context, arg1 machine.UUID, arg2 []storage.FilesystemUsage, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMachineFilesystemUsage", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMachineFilesystemUsage indicates an expected call of SetMachineFilesystemUsage.
+func (mr *MockStateMockRecorder) SetMachineFilesystemUsage(arg0, arg1, arg2, arg3 any) *MockStateSetMachineFilesystemUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMachineFilesystemUsage", reflect.TypeOf((*MockState)(nil).SetMachineFilesystemUsage), arg0, arg1, arg2, arg3)
+	return &MockStateSetMachineFilesystemUsageCall{Call: call}
+}
+
+// MockStateSetMachineFilesystemUsageCall wrap *gomock.Call
+type MockStateSetMachineFilesystemUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetMachineFilesystemUsageCall) Return(arg0 error) *MockStateSetMachineFilesystemUsageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetMachineFilesystemUsageCall) Do(f func(context.Context, machine.UUID, []storage.FilesystemUsage, time.Time) error) *MockStateSetMachineFilesystemUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetMachineFilesystemUsageCall) DoAndReturn(f func(context.Context, machine.UUID, []storage.FilesystemUsage, time.Time) error) *MockStateSetMachineFilesystemUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetStorageInstanceRequestedSize mocks base method.
+func (m *MockState) SetStorageInstanceRequestedSize(arg0 context.Context, arg1 storage0.StorageInstanceUUID, arg2 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageInstanceRequestedSize", arg0, arg1, arg2)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStorageInstanceRequestedSize indicates an expected call of SetStorageInstanceRequestedSize.
+func (mr *MockStateMockRecorder) SetStorageInstanceRequestedSize(arg0, arg1, arg2 any) *MockStateSetStorageInstanceRequestedSizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageInstanceRequestedSize", reflect.TypeOf((*MockState)(nil).SetStorageInstanceRequestedSize), arg0, arg1, arg2)
+	return &MockStateSetStorageInstanceRequestedSizeCall{Call: call}
+}
+
+// MockStateSetStorageInstanceRequestedSizeCall wrap *gomock.Call
+type MockStateSetStorageInstanceRequestedSizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetStorageInstanceRequestedSizeCall) Return(arg0 uint64, arg1 error) *MockStateSetStorageInstanceRequestedSizeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetStorageInstanceRequestedSizeCall) Do(f func(context.Context, storage0.StorageInstanceUUID, uint64) (uint64, error)) *MockStateSetStorageInstanceRequestedSizeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateReplaceStoragePoolCall) DoAndReturn(f func(context.Context, storage.StoragePool) error) *MockStateReplaceStoragePoolCall {
+func (c *MockStateSetStorageInstanceRequestedSizeCall) DoAndReturn(f func(context.Context, storage0.StorageInstanceUUID, uint64) (uint64, error)) *MockStateSetStorageInstanceRequestedSizeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -922,10 +1081,10 @@ func (c *MockStoragePoolStateDeleteStoragePoolCall) DoAndReturn(f func(context.C
 }
 
 // GetStoragePool mocks base method.
-func (m *MockStoragePoolState) GetStoragePool(arg0 context.Context, arg1 storage.StoragePoolUUID) (storage.StoragePool, error) {
+func (m *MockStoragePoolState) GetStoragePool(arg0 context.Context, arg1 storage0.StoragePoolUUID) (storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStoragePool", arg0, arg1)
-	ret0, _ := ret[0].(storage.StoragePool)
+	ret0, _ := ret[0].(storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -943,28 +1102,28 @@ type MockStoragePoolStateGetStoragePoolCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoragePoolStateGetStoragePoolCall) Return(arg0 storage.StoragePool, arg1 error) *MockStoragePoolStateGetStoragePoolCall {
+func (c *MockStoragePoolStateGetStoragePoolCall) Return(arg0 storage0.StoragePool, arg1 error) *MockStoragePoolStateGetStoragePoolCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoragePoolStateGetStoragePoolCall) Do(f func(context.Context, storage.StoragePoolUUID) (storage.StoragePool, error)) *MockStoragePoolStateGetStoragePoolCall {
+func (c *MockStoragePoolStateGetStoragePoolCall) Do(f func(context.Context, storage0.StoragePoolUUID) (storage0.StoragePool, error)) *MockStoragePoolStateGetStoragePoolCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoragePoolStateGetStoragePoolCall) DoAndReturn(f func(context.Context, storage.StoragePoolUUID) (storage.StoragePool, error)) *MockStoragePoolStateGetStoragePoolCall {
+func (c *MockStoragePoolStateGetStoragePoolCall) DoAndReturn(f func(context.Context, storage0.StoragePoolUUID) (storage0.StoragePool, error)) *MockStoragePoolStateGetStoragePoolCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetStoragePoolUUID mocks base method.
-func (m *MockStoragePoolState) GetStoragePoolUUID(arg0 context.Context, arg1 string) (storage.StoragePoolUUID, error) {
+func (m *MockStoragePoolState) GetStoragePoolUUID(arg0 context.Context, arg1 string) (storage0.StoragePoolUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStoragePoolUUID", arg0, arg1)
-	ret0, _ := ret[0].(storage.StoragePoolUUID)
+	ret0, _ := ret[0].(storage0.StoragePoolUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -982,28 +1141,28 @@ type MockStoragePoolStateGetStoragePoolUUIDCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoragePoolStateGetStoragePoolUUIDCall) Return(arg0 storage.StoragePoolUUID, arg1 error) *MockStoragePoolStateGetStoragePoolUUIDCall {
+func (c *MockStoragePoolStateGetStoragePoolUUIDCall) Return(arg0 storage0.StoragePoolUUID, arg1 error) *MockStoragePoolStateGetStoragePoolUUIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoragePoolStateGetStoragePoolUUIDCall) Do(f func(context.Context, string) (storage.StoragePoolUUID, error)) *MockStoragePoolStateGetStoragePoolUUIDCall {
+func (c *MockStoragePoolStateGetStoragePoolUUIDCall) Do(f func(context.Context, string) (storage0.StoragePoolUUID, error)) *MockStoragePoolStateGetStoragePoolUUIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoragePoolStateGetStoragePoolUUIDCall) DoAndReturn(f func(context.Context, string) (storage.StoragePoolUUID, error)) *MockStoragePoolStateGetStoragePoolUUIDCall {
+func (c *MockStoragePoolStateGetStoragePoolUUIDCall) DoAndReturn(f func(context.Context, string) (storage0.StoragePoolUUID, error)) *MockStoragePoolStateGetStoragePoolUUIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetStoragePoolUUIDsByName mocks base method.
-func (m *MockStoragePoolState) GetStoragePoolUUIDsByName(arg0 context.Context, arg1 []string) ([]storage.StoragePoolNameUUID, error) {
+func (m *MockStoragePoolState) GetStoragePoolUUIDsByName(arg0 context.Context, arg1 []string) ([]storage0.StoragePoolNameUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStoragePoolUUIDsByName", arg0, arg1)
-	ret0, _ := ret[0].([]storage.StoragePoolNameUUID)
+	ret0, _ := ret[0].([]storage0.StoragePoolNameUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1021,28 +1180,28 @@ type MockStoragePoolStateGetStoragePoolUUIDsByNameCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoragePoolStateGetStoragePoolUUIDsByNameCall) Return(arg0 []storage.StoragePoolNameUUID, arg1 error) *MockStoragePoolStateGetStoragePoolUUIDsByNameCall {
+func (c *MockStoragePoolStateGetStoragePoolUUIDsByNameCall) Return(arg0 []storage0.StoragePoolNameUUID, arg1 error) *MockStoragePoolStateGetStoragePoolUUIDsByNameCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoragePoolStateGetStoragePoolUUIDsByNameCall) Do(f func(context.Context, []string) ([]storage.StoragePoolNameUUID, error)) *MockStoragePoolStateGetStoragePoolUUIDsByNameCall {
+func (c *MockStoragePoolStateGetStoragePoolUUIDsByNameCall) Do(f func(context.Context, []string) ([]storage0.StoragePoolNameUUID, error)) *MockStoragePoolStateGetStoragePoolUUIDsByNameCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoragePoolStateGetStoragePoolUUIDsByNameCall) DoAndReturn(f func(context.Context, []string) ([]storage.StoragePoolNameUUID, error)) *MockStoragePoolStateGetStoragePoolUUIDsByNameCall {
+func (c *MockStoragePoolStateGetStoragePoolUUIDsByNameCall) DoAndReturn(f func(context.Context, []string) ([]storage0.StoragePoolNameUUID, error)) *MockStoragePoolStateGetStoragePoolUUIDsByNameCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePools mocks base method.
-func (m *MockStoragePoolState) ListStoragePools(arg0 context.Context) ([]storage.StoragePool, error) {
+func (m *MockStoragePoolState) ListStoragePools(arg0 context.Context) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePools", arg0)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1060,28 +1219,28 @@ type MockStoragePoolStateListStoragePoolsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoragePoolStateListStoragePoolsCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStoragePoolStateListStoragePoolsCall {
+func (c *MockStoragePoolStateListStoragePoolsCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStoragePoolStateListStoragePoolsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoragePoolStateListStoragePoolsCall) Do(f func(context.Context) ([]storage.StoragePool, error)) *MockStoragePoolStateListStoragePoolsCall {
+func (c *MockStoragePoolStateListStoragePoolsCall) Do(f func(context.Context) ([]storage0.StoragePool, error)) *MockStoragePoolStateListStoragePoolsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoragePoolStateListStoragePoolsCall) DoAndReturn(f func(context.Context) ([]storage.StoragePool, error)) *MockStoragePoolStateListStoragePoolsCall {
+func (c *MockStoragePoolStateListStoragePoolsCall) DoAndReturn(f func(context.Context) ([]storage0.StoragePool, error)) *MockStoragePoolStateListStoragePoolsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePoolsByNames mocks base method.
-func (m *MockStoragePoolState) ListStoragePoolsByNames(arg0 context.Context, arg1 []string) ([]storage.StoragePool, error) {
+func (m *MockStoragePoolState) ListStoragePoolsByNames(arg0 context.Context, arg1 []string) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePoolsByNames", arg0, arg1)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1099,28 +1258,28 @@ type MockStoragePoolStateListStoragePoolsByNamesCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoragePoolStateListStoragePoolsByNamesCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStoragePoolStateListStoragePoolsByNamesCall {
+func (c *MockStoragePoolStateListStoragePoolsByNamesCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStoragePoolStateListStoragePoolsByNamesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoragePoolStateListStoragePoolsByNamesCall) Do(f func(context.Context, []string) ([]storage.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByNamesCall {
+func (c *MockStoragePoolStateListStoragePoolsByNamesCall) Do(f func(context.Context, []string) ([]storage0.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByNamesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoragePoolStateListStoragePoolsByNamesCall) DoAndReturn(f func(context.Context, []string) ([]storage.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByNamesCall {
+func (c *MockStoragePoolStateListStoragePoolsByNamesCall) DoAndReturn(f func(context.Context, []string) ([]storage0.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByNamesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePoolsByNamesAndProviders mocks base method.
-func (m *MockStoragePoolState) ListStoragePoolsByNamesAndProviders(arg0 context.Context, arg1, arg2 []string) ([]storage.StoragePool, error) {
+func (m *MockStoragePoolState) ListStoragePoolsByNamesAndProviders(arg0 context.Context, arg1, arg2 []string) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePoolsByNamesAndProviders", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1138,28 +1297,28 @@ type MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall {
+func (c *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall) Do(f func(context.Context, []string, []string) ([]storage.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall {
+func (c *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall) Do(f func(context.Context, []string, []string) ([]storage0.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall) DoAndReturn(f func(context.Context, []string, []string) ([]storage.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall {
+func (c *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall) DoAndReturn(f func(context.Context, []string, []string) ([]storage0.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByNamesAndProvidersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePoolsByProviders mocks base method.
-func (m *MockStoragePoolState) ListStoragePoolsByProviders(arg0 context.Context, arg1 []string) ([]storage.StoragePool, error) {
+func (m *MockStoragePoolState) ListStoragePoolsByProviders(arg0 context.Context, arg1 []string) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePoolsByProviders", arg0, arg1)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1177,25 +1336,25 @@ type MockStoragePoolStateListStoragePoolsByProvidersCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoragePoolStateListStoragePoolsByProvidersCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStoragePoolStateListStoragePoolsByProvidersCall {
+func (c *MockStoragePoolStateListStoragePoolsByProvidersCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStoragePoolStateListStoragePoolsByProvidersCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoragePoolStateListStoragePoolsByProvidersCall) Do(f func(context.Context, []string) ([]storage.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByProvidersCall {
+func (c *MockStoragePoolStateListStoragePoolsByProvidersCall) Do(f func(context.Context, []string) ([]storage0.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByProvidersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoragePoolStateListStoragePoolsByProvidersCall) DoAndReturn(f func(context.Context, []string) ([]storage.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByProvidersCall {
+func (c *MockStoragePoolStateListStoragePoolsByProvidersCall) DoAndReturn(f func(context.Context, []string) ([]storage0.StoragePool, error)) *MockStoragePoolStateListStoragePoolsByProvidersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ReplaceStoragePool mocks base method.
-func (m *MockStoragePoolState) ReplaceStoragePool(arg0 context.Context, arg1 storage.StoragePool) error {
+func (m *MockStoragePoolState) ReplaceStoragePool(arg0 context.Context, arg1 storage0.StoragePool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReplaceStoragePool", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -1221,13 +1380,13 @@ func (c *MockStoragePoolStateReplaceStoragePoolCall) Return(arg0 error) *MockSto
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoragePoolStateReplaceStoragePoolCall) Do(f func(context.Context, storage.StoragePool) error) *MockStoragePoolStateReplaceStoragePoolCall {
+func (c *MockStoragePoolStateReplaceStoragePoolCall) Do(f func(context.Context, storage0.StoragePool) error) *MockStoragePoolStateReplaceStoragePoolCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoragePoolStateReplaceStoragePoolCall) DoAndReturn(f func(context.Context, storage.StoragePool) error) *MockStoragePoolStateReplaceStoragePoolCall {
+func (c *MockStoragePoolStateReplaceStoragePoolCall) DoAndReturn(f func(context.Context, storage0.StoragePool) error) *MockStoragePoolStateReplaceStoragePoolCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1373,10 +1532,10 @@ func (c *MockStorageImportStateGetNetNodeUUIDsByMachineOrUnitNameCall) DoAndRetu
 }
 
 // GetStorageInstanceUUIDsByIDs mocks base method.
-func (m *MockStorageImportState) GetStorageInstanceUUIDsByIDs(arg0 context.Context, arg1 []string) (map[string]storage.StorageInstanceUUID, error) {
+func (m *MockStorageImportState) GetStorageInstanceUUIDsByIDs(arg0 context.Context, arg1 []string) (map[string]storage0.StorageInstanceUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStorageInstanceUUIDsByIDs", arg0, arg1)
-	ret0, _ := ret[0].(map[string]storage.StorageInstanceUUID)
+	ret0, _ := ret[0].(map[string]storage0.StorageInstanceUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1394,19 +1553,19 @@ type MockStorageImportStateGetStorageInstanceUUIDsByIDsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall) Return(arg0 map[string]storage.StorageInstanceUUID, arg1 error) *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall {
+func (c *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall) Return(arg0 map[string]storage0.StorageInstanceUUID, arg1 error) *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall) Do(f func(context.Context, []string) (map[string]storage.StorageInstanceUUID, error)) *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall {
+func (c *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall) Do(f func(context.Context, []string) (map[string]storage0.StorageInstanceUUID, error)) *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall) DoAndReturn(f func(context.Context, []string) (map[string]storage.StorageInstanceUUID, error)) *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall {
+func (c *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall) DoAndReturn(f func(context.Context, []string) (map[string]storage0.StorageInstanceUUID, error)) *MockStorageImportStateGetStorageInstanceUUIDsByIDsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1604,7 +1763,7 @@ func (c *MockStorageImportStateImportVolumesCall) DoAndReturn(f func(context.Con
 }
 
 // SetModelStoragePools mocks base method.
-func (m *MockStorageImportState) SetModelStoragePools(arg0 context.Context, arg1 []storage.RecommendedStoragePoolArg) error {
+func (m *MockStorageImportState) SetModelStoragePools(arg0 context.Context, arg1 []storage0.RecommendedStoragePoolArg) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetModelStoragePools", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -1630,13 +1789,13 @@ func (c *MockStorageImportStateSetModelStoragePoolsCall) Return(arg0 error) *Moc
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageImportStateSetModelStoragePoolsCall) Do(f func(context.Context, []storage.RecommendedStoragePoolArg) error) *MockStorageImportStateSetModelStoragePoolsCall {
+func (c *MockStorageImportStateSetModelStoragePoolsCall) Do(f func(context.Context, []storage0.RecommendedStoragePoolArg) error) *MockStorageImportStateSetModelStoragePoolsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageImportStateSetModelStoragePoolsCall) DoAndReturn(f func(context.Context, []storage.RecommendedStoragePoolArg) error) *MockStorageImportStateSetModelStoragePoolsCall {
+func (c *MockStorageImportStateSetModelStoragePoolsCall) DoAndReturn(f func(context.Context, []storage0.RecommendedStoragePoolArg) error) *MockStorageImportStateSetModelStoragePoolsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,61 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coremachine "github.com/juju/juju/core/machine"
+	corestorage "github.com/juju/juju/core/storage"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SetMachineFilesystemUsage records the usage of the filesystems mounted on
+// the supplied machine, as reported by the machine agent. Usage is recorded
+// against the filesystem attachments of the machine with the same mount
+// point; usage of filesystems that are not Juju storage is ignored.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the supplied machine uuid is not valid, or
+// the supplied usage is not valid.
+// - [github.com/juju/juju/domain/machine/errors.MachineNotFound] when the
+// machine does not exist in the model.
+func (s *StorageService) SetMachineFilesystemUsage(
+	ctx context.Context,
+	machineUUID coremachine.UUID,
+	usage []corestorage.FilesystemUsage,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := machineUUID.Validate(); err != nil {
+		return errors.Errorf(
+			"validating machine uuid: %w", err,
+		).Add(coreerrors.NotValid)
+	}
+	for _, u := range usage {
+		if u.MountPoint == "" {
+			return errors.New(
+				"filesystem usage mount point cannot be empty",
+			).Add(coreerrors.NotValid)
+		}
+		if u.UsedMiB > u.SizeMiB {
+			return errors.Errorf(
+				"filesystem usage for %q exceeds its size", u.MountPoint,
+			).Add(coreerrors.NotValid)
+		}
+	}
+
+	err := s.st.SetMachineFilesystemUsage(
+		ctx, machineUUID, usage, s.clock.Now().UTC(),
+	)
+	if err != nil {
+		return errors.Errorf(
+			"recording filesystem usage for machine %q: %w", machineUUID, err,
+		)
+	}
+	return nil
+}
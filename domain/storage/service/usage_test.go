@@ -0,0 +1,108 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coremachine "github.com/juju/juju/core/machine"
+	corestorage "github.com/juju/juju/core/storage"
+	domainmachineerrors "github.com/juju/juju/domain/machine/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+// usageSuite is a test suite for asserting the parts of the [Service]
+// interface that relate to recording filesystem usage.
+type usageSuite struct {
+	state                 *MockState
+	storageRegistryGetter *MockModelStorageRegistryGetter
+	clock                 *testclock.Clock
+}
+
+// TestUsageSuite runs all of the tests contained within [usageSuite].
+func TestUsageSuite(t *testing.T) {
+	tc.Run(t, &usageSuite{})
+}
+
+func (s *usageSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.storageRegistryGetter = NewMockModelStorageRegistryGetter(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+
+	c.Cleanup(func() {
+		s.state = nil
+		s.storageRegistryGetter = nil
+		s.clock = nil
+	})
+	return ctrl
+}
+
+func (s *usageSuite) newService(c *tc.C) *Service {
+	return NewService(
+		s.state, loggertesting.WrapCheckLog(c), s.clock, s.storageRegistryGetter,
+	)
+}
+
+// TestSetMachineFilesystemUsage is a happy path test for
+// [Service.SetMachineFilesystemUsage].
+func (s *usageSuite) TestSetMachineFilesystemUsage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	machineUUID := tc.Must(c, coremachine.NewUUID)
+	usage := []corestorage.FilesystemUsage{{
+		MountPoint: "/srv/data",
+		SizeMiB:    1024,
+		UsedMiB:    512,
+	}}
+	s.state.EXPECT().SetMachineFilesystemUsage(
+		gomock.Any(), machineUUID, usage, s.clock.Now().UTC(),
+	).Return(nil)
+
+	err := s.newService(c).SetMachineFilesystemUsage(c.Context(), machineUUID, usage)
+	c.Check(err, tc.ErrorIsNil)
+}
+
+// TestSetMachineFilesystemUsageMachineNotFound tests that the state error is
+// passed back to the caller when the machine does not exist.
+func (s *usageSuite) TestSetMachineFilesystemUsageMachineNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	machineUUID := tc.Must(c, coremachine.NewUUID)
+	s.state.EXPECT().SetMachineFilesystemUsage(
+		gomock.Any(), machineUUID, gomock.Any(), gomock.Any(),
+	).Return(domainmachineerrors.MachineNotFound)
+
+	err := s.newService(c).SetMachineFilesystemUsage(c.Context(), machineUUID, nil)
+	c.Check(err, tc.ErrorIs, domainmachineerrors.MachineNotFound)
+}
+
+// TestSetMachineFilesystemUsageNotValid tests that an invalid machine uuid or
+// invalid usage results in a [coreerrors.NotValid] error.
+func (s *usageSuite) TestSetMachineFilesystemUsageNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := s.newService(c)
+	err := svc.SetMachineFilesystemUsage(c.Context(), "", nil)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	machineUUID := tc.Must(c, coremachine.NewUUID)
+	err = svc.SetMachineFilesystemUsage(c.Context(), machineUUID, []corestorage.FilesystemUsage{{
+		SizeMiB: 1024,
+	}})
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	err = svc.SetMachineFilesystemUsage(c.Context(), machineUUID, []corestorage.FilesystemUsage{{
+		MountPoint: "/srv/data",
+		SizeMiB:    1024,
+		UsedMiB:    2048,
+	}})
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	domainlife "github.com/juju/juju/domain/life"
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// storageInstanceSize represents the life and requested size of a storage
+// instance.
+type storageInstanceSize struct {
+	UUID             string `db:"uuid"`
+	LifeID           int    `db:"life_id"`
+	RequestedSizeMiB uint64 `db:"requested_size_mib"`
+}
+
+// storageInstancePoolAndKind represents the storage pool and kind of a
+// storage instance.
+type storageInstancePoolAndKind struct {
+	UUID            string `db:"uuid"`
+	StoragePoolUUID string `db:"storage_pool_uuid"`
+	StorageKindID   int    `db:"storage_kind_id"`
+}
+
+// GetStorageInstancePoolAndKind returns the storage pool and the kind of the
+// storage instance.
+//
+// The following errors may be returned:
+// - [domainstorageerrors.StorageInstanceNotFound] when no storage instance
+// exists for the supplied uuid.
+func (s *State) GetStorageInstancePoolAndKind(
+	ctx context.Context, uuid domainstorage.StorageInstanceUUID,
+) (domainstorage.StoragePoolUUID, domainstorage.StorageKind, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return "", 0, errors.Capture(err)
+	}
+
+	input := storageInstancePoolAndKind{UUID: uuid.String()}
+	stmt, err := s.Prepare(`
+SELECT &storageInstancePoolAndKind.*
+FROM   storage_instance
+WHERE  uuid = $storageInstancePoolAndKind.uuid`,
+		input,
+	)
+	if err != nil {
+		return "", 0, errors.Capture(err)
+	}
+
+	var result storageInstancePoolAndKind
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, input).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage instance %q does not exist", uuid,
+			).Add(domainstorageerrors.StorageInstanceNotFound)
+		}
+		return err
+	})
+	if err != nil {
+		return "", 0, errors.Capture(err)
+	}
+	return domainstorage.StoragePoolUUID(result.StoragePoolUUID),
+		domainstorage.StorageKind(result.StorageKindID), nil
+}
+
+// SetStorageInstanceRequestedSize grows the requested size of the storage
+// instance to the supplied size in MiB, returning the previously requested
+// size. If the supplied size is the same as the currently requested size
+// no change is made.
+//
+// The following errors may be returned:
+// - [domainstorageerrors.StorageInstanceNotFound] when no storage instance
+// exists for the supplied uuid.
+// - [domainstorageerrors.StorageInstanceNotAlive] when the storage instance
+// is not alive.
+// - [domainstorageerrors.StorageInstanceShrinkNotSupported] when the supplied
+// size is smaller than the currently requested size.
+func (s *State) SetStorageInstanceRequestedSize(
+	ctx context.Context, uuid domainstorage.StorageInstanceUUID, sizeMiB uint64,
+) (uint64, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	input := storageInstanceSize{
+		UUID:             uuid.String(),
+		RequestedSizeMiB: sizeMiB,
+	}
+
+	selectStmt, err := s.Prepare(`
+SELECT &storageInstanceSize.*
+FROM   storage_instance
+WHERE  uuid = $storageInstanceSize.uuid`,
+		input,
+	)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	updateStmt, err := s.Prepare(`
+UPDATE storage_instance
+SET    requested_size_mib = $storageInstanceSize.requested_size_mib
+WHERE  uuid = $storageInstanceSize.uuid`,
+		input,
+	)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var current storageInstanceSize
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, selectStmt, input).Get(&current)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage instance %q does not exist", uuid,
+			).Add(domainstorageerrors.StorageInstanceNotFound)
+		} else if err != nil {
+			return errors.Errorf("getting storage instance size: %w", err)
+		}
+
+		if domainlife.Life(current.LifeID) != domainlife.Alive {
+			return errors.Errorf(
+				"storage instance %q is not alive", uuid,
+			).Add(domainstorageerrors.StorageInstanceNotAlive)
+		}
+		if sizeMiB < current.RequestedSizeMiB {
+			return errors.Errorf(
+				"cannot shrink storage instance %q from %dMiB to %dMiB",
+				uuid, current.RequestedSizeMiB, sizeMiB,
+			).Add(domainstorageerrors.StorageInstanceShrinkNotSupported)
+		} else if sizeMiB == current.RequestedSizeMiB {
+			return nil
+		}
+
+		err = tx.Query(ctx, updateStmt, input).Run()
+		if err != nil {
+			return errors.Errorf("updating storage instance size: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	return current.RequestedSizeMiB, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+)
+
+// resizeSuite is a test suite for asserting the resizing of storage
+// instances.
+type resizeSuite struct {
+	baseSuite
+}
+
+// TestResizeSuite runs the tests contained within [resizeSuite].
+func TestResizeSuite(t *testing.T) {
+	tc.Run(t, &resizeSuite{})
+}
+
+func (s *resizeSuite) getRequestedSize(
+	c *tc.C, uuid domainstorage.StorageInstanceUUID,
+) uint64 {
+	var size uint64
+	err := s.DB().QueryRowContext(
+		c.Context(),
+		"SELECT requested_size_mib FROM storage_instance WHERE uuid = ?",
+		uuid.String(),
+	).Scan(&size)
+	c.Assert(err, tc.ErrorIsNil)
+	return size
+}
+
+// TestSetStorageInstanceRequestedSize tests that growing a storage instance
+// updates its requested size and emits a change for its model provisioned
+// volume.
+func (s *resizeSuite) TestSetStorageInstanceRequestedSize(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, _ := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "data",
+	)
+	volumeUUID := s.newModelVolume(c, uuid)
+
+	var volumeID string
+	err := s.DB().QueryRowContext(
+		c.Context(),
+		"SELECT volume_id FROM storage_volume WHERE uuid = ?",
+		volumeUUID.String(),
+	).Scan(&volumeID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory())
+	previous, err := st.SetStorageInstanceRequestedSize(c.Context(), uuid, 200)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(previous, tc.Equals, uint64(100))
+	c.Check(s.getRequestedSize(c, uuid), tc.Equals, uint64(200))
+
+	var changed string
+	err = s.DB().QueryRowContext(
+		c.Context(), `
+SELECT cl.changed
+FROM   change_log cl
+JOIN   change_log_namespace cln ON cln.id = cl.namespace_id
+WHERE  cln.namespace = 'storage_volume_life_model_provisioning'
+AND    cl.edit_type_id = 2`,
+	).Scan(&changed)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(changed, tc.Equals, volumeID)
+}
+
+// TestSetStorageInstanceRequestedSizeUnchanged tests that requesting the
+// current size of a storage instance is not an error.
+func (s *resizeSuite) TestSetStorageInstanceRequestedSizeUnchanged(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, _ := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "data",
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	previous, err := st.SetStorageInstanceRequestedSize(c.Context(), uuid, 100)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(previous, tc.Equals, uint64(100))
+	c.Check(s.getRequestedSize(c, uuid), tc.Equals, uint64(100))
+}
+
+// TestSetStorageInstanceRequestedSizeShrink tests that the requested size of
+// a storage instance cannot be reduced.
+func (s *resizeSuite) TestSetStorageInstanceRequestedSizeShrink(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, _ := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "data",
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	_, err := st.SetStorageInstanceRequestedSize(c.Context(), uuid, 50)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceShrinkNotSupported)
+	c.Check(s.getRequestedSize(c, uuid), tc.Equals, uint64(100))
+}
+
+// TestSetStorageInstanceRequestedSizeNotAlive tests that a storage instance
+// that is not alive cannot be resized.
+func (s *resizeSuite) TestSetStorageInstanceRequestedSizeNotAlive(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, _ := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "data",
+	)
+	_, err := s.DB().Exec(
+		"UPDATE storage_instance SET life_id = 1 WHERE uuid = ?", uuid.String(),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory())
+	_, err = st.SetStorageInstanceRequestedSize(c.Context(), uuid, 200)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotAlive)
+}
+
+// TestSetStorageInstanceRequestedSizeNotFound tests that resizing a storage
+// instance that does not exist returns
+// [domainstorageerrors.StorageInstanceNotFound].
+func (s *resizeSuite) TestSetStorageInstanceRequestedSizeNotFound(c *tc.C) {
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+
+	st := NewState(s.TxnRunnerFactory())
+	_, err := st.SetStorageInstanceRequestedSize(c.Context(), uuid, 200)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotFound)
+}
+
+// TestGetStorageInstancePoolAndKind tests that the storage pool and kind of
+// a storage instance are returned.
+func (s *resizeSuite) TestGetStorageInstancePoolAndKind(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, _ := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "data",
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	gotPool, gotKind, err := st.GetStorageInstancePoolAndKind(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotPool, tc.Equals, poolUUID)
+	c.Check(gotKind, tc.Equals, domainstorage.StorageKindBlock)
+}
+
+// TestGetStorageInstancePoolAndKindNotFound tests that getting the pool and
+// kind of a storage instance which does not exist returns
+// [domainstorageerrors.StorageInstanceNotFound].
+func (s *resizeSuite) TestGetStorageInstancePoolAndKindNotFound(c *tc.C) {
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+
+	st := NewState(s.TxnRunnerFactory())
+	_, _, err := st.GetStorageInstancePoolAndKind(c.Context(), uuid)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotFound)
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// storageInstanceProviderIDs represents the provider ids of the volume and
+// filesystem of a storage instance.
+type storageInstanceProviderIDs struct {
+	UUID                 string           `db:"uuid"`
+	VolumeProviderID     sql.Null[string] `db:"volume_provider_id"`
+	FilesystemProviderID sql.Null[string] `db:"filesystem_provider_id"`
+}
+
+// GetStorageInstanceProviderIDs returns the provider ids of the volume and
+// filesystem of the storage instance. An empty id is returned for a volume
+// or filesystem that the storage instance does not have, or that has not
+// been provisioned yet.
+//
+// The following errors may be returned:
+// - [domainstorageerrors.StorageInstanceNotFound] when no storage instance
+// exists for the supplied uuid.
+func (s *State) GetStorageInstanceProviderIDs(
+	ctx context.Context, uuid domainstorage.StorageInstanceUUID,
+) (string, string, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return "", "", errors.Capture(err)
+	}
+
+	input := storageInstanceProviderIDs{UUID: uuid.String()}
+	stmt, err := s.Prepare(`
+SELECT &storageInstanceProviderIDs.* FROM (
+    SELECT    si.uuid,
+              sv.provider_id AS volume_provider_id,
+              sf.provider_id AS filesystem_provider_id
+    FROM      storage_instance si
+    LEFT JOIN storage_instance_volume siv ON si.uuid = siv.storage_instance_uuid
+    LEFT JOIN storage_volume sv ON siv.storage_volume_uuid = sv.uuid
+    LEFT JOIN storage_instance_filesystem sif ON si.uuid = sif.storage_instance_uuid
+    LEFT JOIN storage_filesystem sf ON sif.storage_filesystem_uuid = sf.uuid
+    WHERE     si.uuid = $storageInstanceProviderIDs.uuid
+)`,
+		input,
+	)
+	if err != nil {
+		return "", "", errors.Capture(err)
+	}
+
+	var result storageInstanceProviderIDs
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, input).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage instance %q does not exist", uuid,
+			).Add(domainstorageerrors.StorageInstanceNotFound)
+		}
+		return err
+	})
+	if err != nil {
+		return "", "", errors.Capture(err)
+	}
+	return result.VolumeProviderID.V, result.FilesystemProviderID.V, nil
+}
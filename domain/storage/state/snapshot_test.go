@@ -0,0 +1,74 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+)
+
+// snapshotSuite is a test suite for asserting the state needed to snapshot
+// storage instances.
+type snapshotSuite struct {
+	baseSuite
+}
+
+// TestSnapshotSuite runs the tests contained within [snapshotSuite].
+func TestSnapshotSuite(t *testing.T) {
+	tc.Run(t, &snapshotSuite{})
+}
+
+// TestGetStorageInstanceProviderIDsFilesystem tests that the provider id of
+// the filesystem of a storage instance is returned.
+func (s *snapshotSuite) TestGetStorageInstanceProviderIDsFilesystem(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, _ := s.newFilesystemStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "data",
+	)
+	fsUUID := s.newModelFilesystem(c, uuid)
+	_, err := s.DB().Exec(
+		"UPDATE storage_filesystem SET provider_id = 'fs-provider-id' WHERE uuid = ?",
+		fsUUID.String(),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory())
+	volumeID, filesystemID, err := st.GetStorageInstanceProviderIDs(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(volumeID, tc.Equals, "")
+	c.Check(filesystemID, tc.Equals, "fs-provider-id")
+}
+
+// TestGetStorageInstanceProviderIDsNotProvisioned tests that empty provider
+// ids are returned for a volume that has not been provisioned.
+func (s *snapshotSuite) TestGetStorageInstanceProviderIDsNotProvisioned(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, _ := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "data",
+	)
+	s.newModelVolume(c, uuid)
+
+	st := NewState(s.TxnRunnerFactory())
+	volumeID, filesystemID, err := st.GetStorageInstanceProviderIDs(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(volumeID, tc.Equals, "")
+	c.Check(filesystemID, tc.Equals, "")
+}
+
+// TestGetStorageInstanceProviderIDsNotFound tests that asking for the
+// provider ids of a storage instance that does not exist returns an error
+// satisfying [domainstorageerrors.StorageInstanceNotFound].
+func (s *snapshotSuite) TestGetStorageInstanceProviderIDsNotFound(c *tc.C) {
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+
+	st := NewState(s.TxnRunnerFactory())
+	_, _, err := st.GetStorageInstanceProviderIDs(c.Context(), uuid)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotFound)
+}
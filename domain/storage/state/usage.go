@@ -0,0 +1,153 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coremachine "github.com/juju/juju/core/machine"
+	corestorage "github.com/juju/juju/core/storage"
+	domainmachineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// machineNetNode represents the net node of a machine.
+type machineNetNode struct {
+	MachineUUID string `db:"uuid"`
+	NetNodeUUID string `db:"net_node_uuid"`
+}
+
+// filesystemAttachmentMountPoint represents the mount point of a filesystem
+// attachment.
+type filesystemAttachmentMountPoint struct {
+	UUID       string `db:"uuid"`
+	MountPoint string `db:"mount_point"`
+}
+
+// filesystemAttachmentUsage represents a row in the
+// storage_filesystem_attachment_usage table.
+type filesystemAttachmentUsage struct {
+	AttachmentUUID string    `db:"storage_filesystem_attachment_uuid"`
+	SizeMiB        uint64    `db:"size_mib"`
+	UsedMiB        uint64    `db:"used_mib"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+// SetMachineFilesystemUsage records the usage of the filesystems mounted on
+// the supplied machine against the filesystem attachments of the machine
+// with matching mount points. Usage previously recorded for filesystem
+// attachments of the machine that are not in the supplied usage is removed.
+//
+// The following errors may be returned:
+// - [domainmachineerrors.MachineNotFound] when the machine does not exist in
+// the model.
+func (st *State) SetMachineFilesystemUsage(
+	ctx context.Context,
+	machineUUID coremachine.UUID,
+	usage []corestorage.FilesystemUsage,
+	updatedAt time.Time,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	machine := machineNetNode{MachineUUID: machineUUID.String()}
+	netNodeStmt, err := st.Prepare(`
+SELECT &machineNetNode.*
+FROM   machine
+WHERE  uuid = $machineNetNode.uuid`,
+		machine,
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	attachmentsStmt, err := st.Prepare(`
+SELECT &filesystemAttachmentMountPoint.*
+FROM   storage_filesystem_attachment
+WHERE  net_node_uuid = $machineNetNode.net_node_uuid
+AND    mount_point IS NOT NULL`,
+		machine, filesystemAttachmentMountPoint{},
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	upsertStmt, err := st.Prepare(`
+INSERT INTO storage_filesystem_attachment_usage (*)
+VALUES ($filesystemAttachmentUsage.*)
+ON CONFLICT (storage_filesystem_attachment_uuid) DO UPDATE SET
+    size_mib = excluded.size_mib,
+    used_mib = excluded.used_mib,
+    updated_at = excluded.updated_at`,
+		filesystemAttachmentUsage{},
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	deleteStmt, err := st.Prepare(`
+DELETE FROM storage_filesystem_attachment_usage
+WHERE  storage_filesystem_attachment_uuid = $filesystemAttachmentMountPoint.uuid`,
+		filesystemAttachmentMountPoint{},
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	byMountPoint := make(map[string]corestorage.FilesystemUsage, len(usage))
+	for _, u := range usage {
+		byMountPoint[u.MountPoint] = u
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, netNodeStmt, machine).Get(&machine)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"machine %q does not exist", machineUUID,
+			).Add(domainmachineerrors.MachineNotFound)
+		} else if err != nil {
+			return errors.Errorf("getting machine net node: %w", err)
+		}
+
+		var attachments []filesystemAttachmentMountPoint
+		err = tx.Query(ctx, attachmentsStmt, machine).GetAll(&attachments)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("getting machine filesystem attachments: %w", err)
+		}
+
+		for _, attachment := range attachments {
+			u, ok := byMountPoint[attachment.MountPoint]
+			if !ok {
+				if err := tx.Query(ctx, deleteStmt, attachment).Run(); err != nil {
+					return errors.Errorf(
+						"removing usage of filesystem attachment %q: %w",
+						attachment.UUID, err,
+					)
+				}
+				continue
+			}
+			row := filesystemAttachmentUsage{
+				AttachmentUUID: attachment.UUID,
+				SizeMiB:        u.SizeMiB,
+				UsedMiB:        u.UsedMiB,
+				UpdatedAt:      updatedAt,
+			}
+			if err := tx.Query(ctx, upsertStmt, row).Run(); err != nil {
+				return errors.Errorf(
+					"recording usage of filesystem attachment %q: %w",
+					attachment.UUID, err,
+				)
+			}
+		}
+		return nil
+	})
+	return errors.Capture(err)
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coremachine "github.com/juju/juju/core/machine"
+	corestorage "github.com/juju/juju/core/storage"
+	domainmachineerrors "github.com/juju/juju/domain/machine/errors"
+	domainnetwork "github.com/juju/juju/domain/network"
+	domainstorage "github.com/juju/juju/domain/storage"
+)
+
+// usageSuite is a test suite for asserting the recording of filesystem
+// usage.
+type usageSuite struct {
+	baseSuite
+}
+
+// TestUsageSuite runs the tests contained within [usageSuite].
+func TestUsageSuite(t *testing.T) {
+	tc.Run(t, &usageSuite{})
+}
+
+// newMachineFilesystemAttachment creates a filesystem storage instance with
+// a filesystem attached to a new machine at the supplied mount point.
+func (s *usageSuite) newMachineFilesystemAttachment(
+	c *tc.C, mountPoint string,
+) (coremachine.UUID, domainstorage.FilesystemAttachmentUUID) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	siUUID, _ := s.newFilesystemStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "data",
+	)
+	fsUUID := s.newModelFilesystem(c, siUUID)
+	machineUUID, _ := s.newMachine(c)
+
+	var netNodeUUID string
+	err := s.DB().QueryRowContext(
+		c.Context(),
+		"SELECT net_node_uuid FROM machine WHERE uuid = ?",
+		machineUUID.String(),
+	).Scan(&netNodeUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	attachmentUUID := s.newModelFilesystemAttachmentWithMountPoint(
+		c, fsUUID, domainnetwork.NetNodeUUID(netNodeUUID), mountPoint,
+	)
+	return machineUUID, attachmentUUID
+}
+
+func (s *usageSuite) getUsage(
+	c *tc.C, uuid domainstorage.FilesystemAttachmentUUID,
+) (uint64, uint64, error) {
+	var size, used uint64
+	err := s.DB().QueryRowContext(
+		c.Context(),
+		`
+SELECT size_mib, used_mib
+FROM   storage_filesystem_attachment_usage
+WHERE  storage_filesystem_attachment_uuid = ?`,
+		uuid.String(),
+	).Scan(&size, &used)
+	return size, used, err
+}
+
+// TestSetMachineFilesystemUsage tests that usage reported for the mount
+// point of a filesystem attachment is recorded, and usage of other mount
+// points is ignored.
+func (s *usageSuite) TestSetMachineFilesystemUsage(c *tc.C) {
+	machineUUID, attachmentUUID := s.newMachineFilesystemAttachment(c, "/srv/data")
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.SetMachineFilesystemUsage(c.Context(), machineUUID, []corestorage.FilesystemUsage{{
+		MountPoint: "/",
+		SizeMiB:    10240,
+		UsedMiB:    2048,
+	}, {
+		MountPoint: "/srv/data",
+		SizeMiB:    1024,
+		UsedMiB:    512,
+	}}, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	size, used, err := s.getUsage(c, attachmentUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(size, tc.Equals, uint64(1024))
+	c.Check(used, tc.Equals, uint64(512))
+
+	// Reporting again updates the recorded usage.
+	err = st.SetMachineFilesystemUsage(c.Context(), machineUUID, []corestorage.FilesystemUsage{{
+		MountPoint: "/srv/data",
+		SizeMiB:    1024,
+		UsedMiB:    1000,
+	}}, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, used, err = s.getUsage(c, attachmentUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(used, tc.Equals, uint64(1000))
+}
+
+// TestSetMachineFilesystemUsageRemovesStale tests that usage previously
+// recorded for a filesystem attachment is removed when its mount point is no
+// longer reported.
+func (s *usageSuite) TestSetMachineFilesystemUsageRemovesStale(c *tc.C) {
+	machineUUID, attachmentUUID := s.newMachineFilesystemAttachment(c, "/srv/data")
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.SetMachineFilesystemUsage(c.Context(), machineUUID, []corestorage.FilesystemUsage{{
+		MountPoint: "/srv/data",
+		SizeMiB:    1024,
+		UsedMiB:    512,
+	}}, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.SetMachineFilesystemUsage(c.Context(), machineUUID, nil, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, _, err = s.getUsage(c, attachmentUUID)
+	c.Check(err, tc.ErrorIs, sql.ErrNoRows)
+}
+
+// TestSetMachineFilesystemUsageMachineNotFound tests that recording usage
+// for a machine that does not exist returns
+// [domainmachineerrors.MachineNotFound].
+func (s *usageSuite) TestSetMachineFilesystemUsageMachineNotFound(c *tc.C) {
+	machineUUID := tc.Must(c, coremachine.NewUUID)
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.SetMachineFilesystemUsage(c.Context(), machineUUID, nil, time.Now().UTC())
+	c.Check(err, tc.ErrorIs, domainmachineerrors.MachineNotFound)
+}
@@ -457,6 +457,11 @@ type CreateUnitStorageInstanceArg struct {
 	// provisioned.
 	StoragePoolUUID StoragePoolUUID
 
+	// SnapshotID, when not empty, is the provider ID of the snapshot that
+	// the volume, or filesystem when there is no volume, of this storage
+	// instance is created from.
+	SnapshotID string
+
 	// Volume describes the properties of a new volume to be created alongside
 	// the storage instance. If this value is not nil a new volume will be
 	// created with the storage instance.
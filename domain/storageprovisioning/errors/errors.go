@@ -14,6 +14,14 @@ const (
 	// expected to be dead, but is not dead.
 	FilesystemNotDead = errors.ConstError("filesystem not dead")
 
+	// FilesystemEncryptionKeyNotFound describes an error that occurs when no
+	// encryption key secret has been recorded for a filesystem.
+	FilesystemEncryptionKeyNotFound = errors.ConstError("filesystem encryption key not found")
+
+	// FilesystemEncryptionKeyAlreadySet describes an error that occurs when
+	// an encryption key secret has already been recorded for a filesystem.
+	FilesystemEncryptionKeyAlreadySet = errors.ConstError("filesystem encryption key already set")
+
 	// FilesystemAttachmentNotFound describes an error that occurs when no
 	// filesystem attachment was found in the model.
 	FilesystemAttachmentNotFound = errors.ConstError("filesystem attachment not found")
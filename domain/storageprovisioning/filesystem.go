@@ -59,6 +59,11 @@ type FilesystemParams struct {
 	ProviderID    *string
 	SizeMiB       uint64
 	BackingVolume *FilesystemBackingVolume
+
+	// SnapshotID, when not empty, is the provider ID of the snapshot the
+	// filesystem is created from. It is never set for a filesystem backed
+	// by a volume, as the volume is created from the snapshot instead.
+	SnapshotID string
 }
 
 // FilesystemRemovalParams defines the set of parameters that a caller needs to
@@ -41,15 +41,20 @@ type EntityLifeGetter func(context.Context) (map[string]domainlife.Life, error)
 // values to start performing change detection on top of. This func will be
 // called once on the first invocation of the returned mapper.
 //
+// Change events in any of entityNamespaces carry the id of an entity that
+// has changed other than in life. The entity is included in the change set if
+// it is one of the concern's entities.
+//
 // The returned mapper is not thread safe.
 func entityLifeMapperFunc(
 	initialLife EntityLifeGetter,
 	lifeGetter EntityLifeGetter,
+	entityNamespaces ...string,
 ) eventsource.Mapper {
 	var haveInitialLife bool
 	var knownLife map[string]domainlife.Life
 	return func(
-		ctx context.Context, _ []changestream.ChangeEvent,
+		ctx context.Context, events []changestream.ChangeEvent,
 	) ([]string, error) {
 		if !haveInitialLife {
 			l, err := initialLife(ctx)
@@ -78,6 +83,18 @@ func entityLifeMapperFunc(
 		// embodies.
 		changes = slices.AppendSeq(changes, maps.Keys(knownLife))
 
+		// Append the entities of the concern that have changed other than in
+		// life.
+		for _, event := range events {
+			if !slices.Contains(entityNamespaces, event.Namespace()) {
+				continue
+			}
+			id := event.Changed()
+			if _, has := latestLife[id]; has && !slices.Contains(changes, id) {
+				changes = append(changes, id)
+			}
+		}
+
 		// Reset knownLife
 		knownLife = latestLife
 		return changes, nil
@@ -100,10 +117,13 @@ func entityLifeMapperFunc(
 // another.
 //
 // This function returns a new initial query that can be supplied to the watcher
-// for seeding a set of initial values.
+// for seeding a set of initial values. Change events in any of
+// entityNamespaces name entities that have changed other than in life; see
+// [entityLifeMapperFunc].
 func makeEntityLifePrerequisites(
 	initialQuery eventsource.Query[map[string]domainlife.Life],
 	lifeGetter EntityLifeGetter,
+	entityNamespaces ...string,
 ) (eventsource.NamespaceQuery, eventsource.Mapper) {
 	// Make a buffered channel to capture the initial query values.
 	// Shimmed query is responsible for closing the channel.
@@ -141,5 +161,5 @@ func makeEntityLifePrerequisites(
 	}
 
 	return shimmedInitialQuery, entityLifeMapperFunc(
-		initialLifeForMapper, lifeGetter)
+		initialLifeForMapper, lifeGetter, entityNamespaces...)
 }
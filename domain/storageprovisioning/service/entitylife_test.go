@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/tc"
 
+	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/domain/life"
@@ -196,6 +197,56 @@ func TestEntityLifeMapper(t *testing.T) {
 	}
 }
 
+// entityChangeEvent is a testing implementation of
+// [changestream.ChangeEvent].
+type entityChangeEvent struct {
+	namespace string
+	changed   string
+}
+
+func (e entityChangeEvent) Type() changestream.ChangeType {
+	return changestream.Changed
+}
+
+func (e entityChangeEvent) Namespace() string {
+	return e.namespace
+}
+
+func (e entityChangeEvent) Changed() string {
+	return e.changed
+}
+
+// TestEntityLifeMapperEntityNamespaces tests that the mapper returns the
+// entities of the concern named by change events in the entity namespaces,
+// along with those whose life has changed, and drops the entities of other
+// concerns.
+func TestEntityLifeMapperEntityNamespaces(t *testing.T) {
+	getter, stop := entityLifeGetter(slices.Values([]map[string]life.Life{
+		{
+			"1": life.Alive,
+			"2": life.Dying,
+		},
+	}))
+	defer stop()
+
+	initialLifeFn := func(context.Context) (map[string]life.Life, error) {
+		return map[string]life.Life{
+			"1": life.Alive,
+			"2": life.Alive,
+		}, nil
+	}
+	mapper := entityLifeMapperFunc(initialLifeFn, getter, "size")
+
+	changes, err := mapper(t.Context(), []changestream.ChangeEvent{
+		entityChangeEvent{namespace: "life", changed: "net-node"},
+		entityChangeEvent{namespace: "size", changed: "1"},
+		entityChangeEvent{namespace: "size", changed: "2"},
+		entityChangeEvent{namespace: "size", changed: "3"},
+	})
+	tc.Check(t, err, tc.ErrorIsNil)
+	tc.Check(t, changes, tc.SameContents, []string{"1", "2"})
+}
+
 // TestMakeEntityLifePrerequisites tests the common case of the mapper and
 // initial returned by [makeEntityLifePrerequisites].
 func TestMakeEntityLifePrerequisties(t *testing.T) {
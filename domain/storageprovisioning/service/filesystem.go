@@ -13,6 +13,7 @@ import (
 	corechangestream "github.com/juju/juju/core/changestream"
 	coreerrors "github.com/juju/juju/core/errors"
 	coremachine "github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/trace"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
@@ -154,6 +155,45 @@ type FilesystemState interface {
 		context.Context, domainstorage.FilesystemUUID,
 	) (storageprovisioning.FilesystemRemovalParams, error)
 
+	// GetFilesystemStorageID returns the id of the storage instance of the
+	// filesystem with the supplied uuid.
+	//
+	// The following errors may be returned:
+	// - [storageprovisioningerrors.FilesystemNotFound] when no filesystem
+	// exists for the uuid.
+	// - [storageerrors.StorageInstanceNotFound] when the filesystem is not
+	// associated with a storage instance.
+	GetFilesystemStorageID(
+		context.Context, domainstorage.FilesystemUUID,
+	) (string, error)
+
+	// GetFilesystemEncryptionKeySecret returns the id of the secret holding
+	// the key that the filesystem with the supplied uuid is encrypted with.
+	//
+	// The following errors may be returned:
+	// - [storageprovisioningerrors.FilesystemNotFound] when no filesystem
+	// exists for the uuid.
+	// - [storageprovisioningerrors.FilesystemEncryptionKeyNotFound] when no
+	// key secret has been recorded for the filesystem's storage instance.
+	GetFilesystemEncryptionKeySecret(
+		context.Context, domainstorage.FilesystemUUID,
+	) (string, error)
+
+	// SetFilesystemEncryptionKeySecret records the secret holding the key
+	// that the filesystem with the supplied uuid is encrypted with against
+	// the filesystem's storage instance.
+	//
+	// The following errors may be returned:
+	// - [storageprovisioningerrors.FilesystemNotFound] when no filesystem
+	// exists for the uuid.
+	// - [storageerrors.StorageInstanceNotFound] when the filesystem is not
+	// associated with a storage instance.
+	// - [storageprovisioningerrors.FilesystemEncryptionKeyAlreadySet] when a
+	// key secret has already been recorded for the storage instance.
+	SetFilesystemEncryptionKeySecret(
+		context.Context, domainstorage.FilesystemUUID, string,
+	) error
+
 	// GetFilesystemUUIDForID returns the UUID for a filesystem with the
 	// supplied id.
 	//
@@ -164,14 +204,14 @@ type FilesystemState interface {
 		context.Context, string,
 	) (domainstorage.FilesystemUUID, error)
 
-	// InitialWatchStatementMachineProvisionedFilesystems returns both the
-	// namespace for watching filesystem life changes where the filesystem is
-	// machine provisioned and the query for getting the current set of machine
-	// provisioned filesystems.
+	// InitialWatchStatementMachineProvisionedFilesystems returns the
+	// namespaces for watching life and requested size changes of filesystems
+	// that are machine provisioned and the query for getting the current set
+	// of machine provisioned filesystems.
 	//
 	// Only filesystems that can be provisioned by the machine connected to the
 	// supplied net node will be emitted.
-	InitialWatchStatementMachineProvisionedFilesystems(netNodeUUID domainnetwork.NetNodeUUID) (string, eventsource.Query[map[string]domainlife.Life])
+	InitialWatchStatementMachineProvisionedFilesystems(netNodeUUID domainnetwork.NetNodeUUID) (string, string, eventsource.Query[map[string]domainlife.Life])
 
 	// InitialWatchStatementModelProvisionedFilesystems returns both the
 	// namespace for watching filesystem life changes where the filesystem is
@@ -711,6 +751,98 @@ func (s *Service) GetFilesystemRemovalParams(
 	return s.st.GetFilesystemRemovalParams(ctx, uuid)
 }
 
+// GetFilesystemStorageID returns the id of the storage instance of the
+// filesystem with the supplied uuid.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the supplied filesystem UUID is not valid.
+// - [storageprovisioningerrors.FilesystemNotFound] when no filesystem exists
+// for the uuid.
+// - [storageerrors.StorageInstanceNotFound] when the filesystem is not
+// associated with a storage instance.
+func (s *Service) GetFilesystemStorageID(
+	ctx context.Context, uuid domainstorage.FilesystemUUID,
+) (string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return "", errors.New(
+			"filesystem uuid is not valid",
+		).Add(coreerrors.NotValid)
+	}
+
+	return s.st.GetFilesystemStorageID(ctx, uuid)
+}
+
+// GetFilesystemEncryptionKeySecret returns the uri of the secret holding the
+// key that the filesystem with the supplied uuid is encrypted with.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the supplied filesystem UUID is not valid.
+// - [storageprovisioningerrors.FilesystemNotFound] when no filesystem exists
+// for the uuid.
+// - [storageprovisioningerrors.FilesystemEncryptionKeyNotFound] when no key
+// secret has been recorded for the filesystem's storage instance.
+func (s *Service) GetFilesystemEncryptionKeySecret(
+	ctx context.Context, uuid domainstorage.FilesystemUUID,
+) (*secrets.URI, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return nil, errors.New(
+			"filesystem uuid is not valid",
+		).Add(coreerrors.NotValid)
+	}
+
+	secretID, err := s.st.GetFilesystemEncryptionKeySecret(ctx, uuid)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	uri, err := secrets.ParseURI(secretID)
+	if err != nil {
+		return nil, errors.Errorf(
+			"parsing encryption key secret for filesystem %q: %w", uuid, err,
+		)
+	}
+	return uri, nil
+}
+
+// SetFilesystemEncryptionKeySecret records the secret holding the key that
+// the filesystem with the supplied uuid is encrypted with against the
+// filesystem's storage instance. While the storage instance exists the
+// secret cannot be removed.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the supplied filesystem UUID or secret uri is
+// not valid.
+// - [storageprovisioningerrors.FilesystemNotFound] when no filesystem exists
+// for the uuid.
+// - [storageerrors.StorageInstanceNotFound] when the filesystem is not
+// associated with a storage instance.
+// - [storageprovisioningerrors.FilesystemEncryptionKeyAlreadySet] when a key
+// secret has already been recorded for the storage instance.
+func (s *Service) SetFilesystemEncryptionKeySecret(
+	ctx context.Context, uuid domainstorage.FilesystemUUID, uri *secrets.URI,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return errors.New(
+			"filesystem uuid is not valid",
+		).Add(coreerrors.NotValid)
+	}
+	if uri == nil {
+		return errors.New(
+			"encryption key secret uri is not valid",
+		).Add(coreerrors.NotValid)
+	}
+
+	return s.st.SetFilesystemEncryptionKeySecret(ctx, uuid, uri.ID)
+}
+
 // GetFilesystemUUIDForID returns the UUID for a filesystem with the supplied
 // id.
 //
@@ -750,7 +882,8 @@ func (s *Service) WatchModelProvisionedFilesystems(
 }
 
 // WatchMachineProvisionedFilesystems returns a watcher that emits filesystem IDs,
-// whenever the given machine's provisioned filsystem's life changes.
+// whenever the life or requested size of the given machine's provisioned
+// filesystems changes.
 //
 // The following errors may be returned:
 // - [github.com/juju/juju/core/errors.NotValid] when the supplied machine UUID
@@ -775,17 +908,20 @@ func (s *Service) WatchMachineProvisionedFilesystems(
 		return s.st.GetFilesystemLifeForNetNode(ctx, netNodeUUID)
 	}
 
-	ns, initialLifeQuery := s.st.InitialWatchStatementMachineProvisionedFilesystems(netNodeUUID)
-	initialQuery, mapper := makeEntityLifePrerequisites(initialLifeQuery, lifeGetter)
+	lifeNS, sizeNS, initialLifeQuery := s.st.InitialWatchStatementMachineProvisionedFilesystems(netNodeUUID)
+	initialQuery, mapper := makeEntityLifePrerequisites(initialLifeQuery, lifeGetter, sizeNS)
 	filter := eventsource.PredicateFilter(
-		ns, corechangestream.All, eventsource.EqualsPredicate(netNodeUUID.String()),
+		lifeNS, corechangestream.All, eventsource.EqualsPredicate(netNodeUUID.String()),
 	)
+	// Size changes are emitted for the filesystem rather than the net node, and
+	// the mapper drops those of other machines.
+	sizeFilter := eventsource.NamespaceFilter(sizeNS, corechangestream.Changed)
 
 	w, err := s.watcherFactory.NewNamespaceMapperWatcher(
 		ctx,
 		initialQuery,
 		fmt.Sprintf("machine provisioned filesystem watcher for %q", machineUUID),
-		mapper, filter)
+		mapper, filter, sizeFilter)
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
@@ -14,6 +14,7 @@ import (
 	coreerrors "github.com/juju/juju/core/errors"
 	coremachine "github.com/juju/juju/core/machine"
 	machinetesting "github.com/juju/juju/core/machine/testing"
+	"github.com/juju/juju/core/secrets"
 	coreunit "github.com/juju/juju/core/unit"
 	unittesting "github.com/juju/juju/core/unit/testing"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
@@ -323,15 +324,19 @@ func (s *filesystemSuite) TestWatchMachineProvisionedFilesystems(c *tc.C) {
 	s.state.EXPECT().InitialWatchStatementMachineProvisionedFilesystems(
 		netNodeUUID,
 	).Return(
-		"test_namespace", namespaceLifeQueryReturningError(c.T),
+		"test_namespace", "test_size_namespace", namespaceLifeQueryReturningError(c.T),
 	)
 	matcher := eventSourcePredFilterMatcher{
 		ChangeMask: changestream.All,
 		Namespace:  "test_namespace",
 		Predicate:  netNodeUUID.String(),
 	}
+	sizeMatcher := eventSourceFilterMatcher{
+		ChangeMask: changestream.Changed,
+		Namespace:  "test_size_namespace",
+	}
 	s.watcherFactory.EXPECT().NewNamespaceMapperWatcher(
-		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), matcher,
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), matcher, sizeMatcher,
 	)
 
 	_, err = NewService(s.state, s.watcherFactory, loggertesting.WrapCheckLog(c)).
@@ -992,6 +997,80 @@ func (s *filesystemSuite) TestGetFilesystemParamsNotFound(c *tc.C) {
 	c.Check(err, tc.ErrorIs, storageprovisioningerrors.FilesystemNotFound)
 }
 
+func (s *filesystemSuite) TestGetFilesystemStorageID(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := NewService(s.state, s.watcherFactory, loggertesting.WrapCheckLog(c))
+	fsUUID := tc.Must(c, domainstorage.NewFilesystemUUID)
+
+	s.state.EXPECT().GetFilesystemStorageID(gomock.Any(), fsUUID).Return(
+		"data/0", nil,
+	)
+
+	storageID, err := svc.GetFilesystemStorageID(c.Context(), fsUUID)
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(storageID, tc.Equals, "data/0")
+}
+
+func (s *filesystemSuite) TestGetFilesystemStorageIDNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := NewService(s.state, s.watcherFactory, loggertesting.WrapCheckLog(c))
+
+	_, err := svc.GetFilesystemStorageID(c.Context(), "invalid")
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *filesystemSuite) TestGetFilesystemEncryptionKeySecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := NewService(s.state, s.watcherFactory, loggertesting.WrapCheckLog(c))
+	fsUUID := tc.Must(c, domainstorage.NewFilesystemUUID)
+	uri := secrets.NewURI()
+
+	s.state.EXPECT().GetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID).Return(
+		uri.ID, nil,
+	)
+
+	got, err := svc.GetFilesystemEncryptionKeySecret(c.Context(), fsUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got.ID, tc.Equals, uri.ID)
+}
+
+func (s *filesystemSuite) TestGetFilesystemEncryptionKeySecretNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := NewService(s.state, s.watcherFactory, loggertesting.WrapCheckLog(c))
+	fsUUID := tc.Must(c, domainstorage.NewFilesystemUUID)
+
+	s.state.EXPECT().GetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID).Return(
+		"", storageprovisioningerrors.FilesystemEncryptionKeyNotFound,
+	)
+
+	_, err := svc.GetFilesystemEncryptionKeySecret(c.Context(), fsUUID)
+	c.Check(err, tc.ErrorIs, storageprovisioningerrors.FilesystemEncryptionKeyNotFound)
+}
+
+func (s *filesystemSuite) TestSetFilesystemEncryptionKeySecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := NewService(s.state, s.watcherFactory, loggertesting.WrapCheckLog(c))
+	fsUUID := tc.Must(c, domainstorage.NewFilesystemUUID)
+	uri := secrets.NewURI()
+
+	s.state.EXPECT().SetFilesystemEncryptionKeySecret(gomock.Any(), fsUUID, uri.ID).Return(nil)
+
+	err := svc.SetFilesystemEncryptionKeySecret(c.Context(), fsUUID, uri)
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *filesystemSuite) TestSetFilesystemEncryptionKeySecretNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := NewService(s.state, s.watcherFactory, loggertesting.WrapCheckLog(c))
+	fsUUID := tc.Must(c, domainstorage.NewFilesystemUUID)
+
+	err := svc.SetFilesystemEncryptionKeySecret(c.Context(), "invalid", secrets.NewURI())
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	err = svc.SetFilesystemEncryptionKeySecret(c.Context(), fsUUID, nil)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
 func (s *filesystemSuite) TestGetFilesystemRemovalParams(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	svc := NewService(s.state, s.watcherFactory, loggertesting.WrapCheckLog(c))
@@ -556,6 +556,45 @@ func (c *MockStateGetFilesystemAttachmentUUIDForFilesystemNetNodeCall) DoAndRetu
 	return c
 }
 
+// GetFilesystemEncryptionKeySecret mocks base method.
+func (m *MockState) GetFilesystemEncryptionKeySecret(arg0 context.Context, arg1 storage.FilesystemUUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesystemEncryptionKeySecret", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesystemEncryptionKeySecret indicates an expected call of GetFilesystemEncryptionKeySecret.
+func (mr *MockStateMockRecorder) GetFilesystemEncryptionKeySecret(arg0, arg1 any) *MockStateGetFilesystemEncryptionKeySecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesystemEncryptionKeySecret", reflect.TypeOf((*MockState)(nil).GetFilesystemEncryptionKeySecret), arg0, arg1)
+	return &MockStateGetFilesystemEncryptionKeySecretCall{Call: call}
+}
+
+// MockStateGetFilesystemEncryptionKeySecretCall wrap *gomock.Call
+type MockStateGetFilesystemEncryptionKeySecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetFilesystemEncryptionKeySecretCall) Return(arg0 string, arg1 error) *MockStateGetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetFilesystemEncryptionKeySecretCall) Do(f func(context.Context, storage.FilesystemUUID) (string, error)) *MockStateGetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetFilesystemEncryptionKeySecretCall) DoAndReturn(f func(context.Context, storage.FilesystemUUID) (string, error)) *MockStateGetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetFilesystemLife mocks base method.
 func (m *MockState) GetFilesystemLife(arg0 context.Context, arg1 storage.FilesystemUUID) (life.Life, error) {
 	m.ctrl.T.Helper()
@@ -712,6 +751,45 @@ func (c *MockStateGetFilesystemRemovalParamsCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// GetFilesystemStorageID mocks base method.
+func (m *MockState) GetFilesystemStorageID(arg0 context.Context, arg1 storage.FilesystemUUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesystemStorageID", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesystemStorageID indicates an expected call of GetFilesystemStorageID.
+func (mr *MockStateMockRecorder) GetFilesystemStorageID(arg0, arg1 any) *MockStateGetFilesystemStorageIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesystemStorageID", reflect.TypeOf((*MockState)(nil).GetFilesystemStorageID), arg0, arg1)
+	return &MockStateGetFilesystemStorageIDCall{Call: call}
+}
+
+// MockStateGetFilesystemStorageIDCall wrap *gomock.Call
+type MockStateGetFilesystemStorageIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetFilesystemStorageIDCall) Return(arg0 string, arg1 error) *MockStateGetFilesystemStorageIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetFilesystemStorageIDCall) Do(f func(context.Context, storage.FilesystemUUID) (string, error)) *MockStateGetFilesystemStorageIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetFilesystemStorageIDCall) DoAndReturn(f func(context.Context, storage.FilesystemUUID) (string, error)) *MockStateGetFilesystemStorageIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetFilesystemTemplatesForApplication mocks base method.
 func (m *MockState) GetFilesystemTemplatesForApplication(arg0 context.Context, arg1 application.UUID) ([]internal.FilesystemTemplate, error) {
 	m.ctrl.T.Helper()
@@ -1961,12 +2039,13 @@ func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemAttachmentsCa
 }
 
 // InitialWatchStatementMachineProvisionedFilesystems mocks base method.
-func (m *MockState) InitialWatchStatementMachineProvisionedFilesystems(netNodeUUID network.NetNodeUUID) (string, eventsource.Query[map[string]life.Life]) {
+func (m *MockState) InitialWatchStatementMachineProvisionedFilesystems(netNodeUUID network.NetNodeUUID) (string, string, eventsource.Query[map[string]life.Life]) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "InitialWatchStatementMachineProvisionedFilesystems", netNodeUUID)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(eventsource.Query[map[string]life.Life])
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(eventsource.Query[map[string]life.Life])
+	return ret0, ret1, ret2
 }
 
 // InitialWatchStatementMachineProvisionedFilesystems indicates an expected call of InitialWatchStatementMachineProvisionedFilesystems.
@@ -1982,19 +2061,19 @@ type MockStateInitialWatchStatementMachineProvisionedFilesystemsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall) Return(arg0 string, arg1 eventsource.Query[map[string]life.Life]) *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall) Return(arg0, arg1 string, arg2 eventsource.Query[map[string]life.Life]) *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall) Do(f func(network.NetNodeUUID) (string, eventsource.Query[map[string]life.Life])) *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall {
+func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall) Do(f func(network.NetNodeUUID) (string, string, eventsource.Query[map[string]life.Life])) *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall) DoAndReturn(f func(network.NetNodeUUID) (string, eventsource.Query[map[string]life.Life])) *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall {
+func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall) DoAndReturn(f func(network.NetNodeUUID) (string, string, eventsource.Query[map[string]life.Life])) *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -2039,12 +2118,13 @@ func (c *MockStateInitialWatchStatementMachineProvisionedVolumeAttachmentsCall)
 }
 
 // InitialWatchStatementMachineProvisionedVolumes mocks base method.
-func (m *MockState) InitialWatchStatementMachineProvisionedVolumes(arg0 network.NetNodeUUID) (string, eventsource.Query[map[string]life.Life]) {
+func (m *MockState) InitialWatchStatementMachineProvisionedVolumes(arg0 network.NetNodeUUID) (string, string, eventsource.Query[map[string]life.Life]) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "InitialWatchStatementMachineProvisionedVolumes", arg0)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(eventsource.Query[map[string]life.Life])
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(eventsource.Query[map[string]life.Life])
+	return ret0, ret1, ret2
 }
 
 // InitialWatchStatementMachineProvisionedVolumes indicates an expected call of InitialWatchStatementMachineProvisionedVolumes.
@@ -2060,19 +2140,19 @@ type MockStateInitialWatchStatementMachineProvisionedVolumesCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateInitialWatchStatementMachineProvisionedVolumesCall) Return(arg0 string, arg1 eventsource.Query[map[string]life.Life]) *MockStateInitialWatchStatementMachineProvisionedVolumesCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockStateInitialWatchStatementMachineProvisionedVolumesCall) Return(arg0, arg1 string, arg2 eventsource.Query[map[string]life.Life]) *MockStateInitialWatchStatementMachineProvisionedVolumesCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateInitialWatchStatementMachineProvisionedVolumesCall) Do(f func(network.NetNodeUUID) (string, eventsource.Query[map[string]life.Life])) *MockStateInitialWatchStatementMachineProvisionedVolumesCall {
+func (c *MockStateInitialWatchStatementMachineProvisionedVolumesCall) Do(f func(network.NetNodeUUID) (string, string, eventsource.Query[map[string]life.Life])) *MockStateInitialWatchStatementMachineProvisionedVolumesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateInitialWatchStatementMachineProvisionedVolumesCall) DoAndReturn(f func(network.NetNodeUUID) (string, eventsource.Query[map[string]life.Life])) *MockStateInitialWatchStatementMachineProvisionedVolumesCall {
+func (c *MockStateInitialWatchStatementMachineProvisionedVolumesCall) DoAndReturn(f func(network.NetNodeUUID) (string, string, eventsource.Query[map[string]life.Life])) *MockStateInitialWatchStatementMachineProvisionedVolumesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -2388,6 +2468,44 @@ func (c *MockStateSetFilesystemAttachmentProvisionedInfoCall) DoAndReturn(f func
 	return c
 }
 
+// SetFilesystemEncryptionKeySecret mocks base method.
+func (m *MockState) SetFilesystemEncryptionKeySecret(arg0 context.Context, arg1 storage.FilesystemUUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFilesystemEncryptionKeySecret", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFilesystemEncryptionKeySecret indicates an expected call of SetFilesystemEncryptionKeySecret.
+func (mr *MockStateMockRecorder) SetFilesystemEncryptionKeySecret(arg0, arg1, arg2 any) *MockStateSetFilesystemEncryptionKeySecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFilesystemEncryptionKeySecret", reflect.TypeOf((*MockState)(nil).SetFilesystemEncryptionKeySecret), arg0, arg1, arg2)
+	return &MockStateSetFilesystemEncryptionKeySecretCall{Call: call}
+}
+
+// MockStateSetFilesystemEncryptionKeySecretCall wrap *gomock.Call
+type MockStateSetFilesystemEncryptionKeySecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetFilesystemEncryptionKeySecretCall) Return(arg0 error) *MockStateSetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetFilesystemEncryptionKeySecretCall) Do(f func(context.Context, storage.FilesystemUUID, string) error) *MockStateSetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetFilesystemEncryptionKeySecretCall) DoAndReturn(f func(context.Context, storage.FilesystemUUID, string) error) *MockStateSetFilesystemEncryptionKeySecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetFilesystemProvisionedInfo mocks base method.
 func (m *MockState) SetFilesystemProvisionedInfo(ctx context.Context, filesystemUUID storage.FilesystemUUID, info storageprovisioning.FilesystemProvisionedInfo) error {
 	m.ctrl.T.Helper()
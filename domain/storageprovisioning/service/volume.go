@@ -252,16 +252,16 @@ type VolumeState interface {
 		blockDeviceUUID blockdevice.BlockDeviceUUID,
 	) error
 
-	// InitialWatchStatementMachineProvisionedVolumes returns both the
-	// namespace for watching volume life changes where the volume is
-	// machine provisioned and the initial query for getting the set of volumes
-	// that are provisioned by the supplied machine in the model.
+	// InitialWatchStatementMachineProvisionedVolumes returns the namespaces
+	// for watching life and requested size changes of volumes that are
+	// machine provisioned and the initial query for getting the set of
+	// volumes that are provisioned by the supplied machine in the model.
 	//
 	// Only volumes that can be provisioned by the machine connected to the
 	// supplied net node will be emitted.
 	InitialWatchStatementMachineProvisionedVolumes(
 		domainnetwork.NetNodeUUID,
-	) (string, eventsource.Query[map[string]domainlife.Life])
+	) (string, string, eventsource.Query[map[string]domainlife.Life])
 
 	// InitialWatchStatementModelProvisionedVolumes returns both the
 	// namespace for watching volume life changes where the volume is
@@ -840,7 +840,8 @@ func (s *Service) WatchModelProvisionedVolumes(
 }
 
 // WatchMachineProvisionedVolumes returns a watcher that emits volume IDs,
-// whenever the given machine's provisioned volume life changes.
+// whenever the life or requested size of the given machine's provisioned
+// volumes changes.
 //
 // The following errors may be returned:
 // - [coreerrors.NotValid] when the provided machine uuid is not valid.
@@ -864,17 +865,20 @@ func (s *Service) WatchMachineProvisionedVolumes(
 		return s.st.GetVolumeLifeForNetNode(ctx, netNodeUUID)
 	}
 
-	ns, initialLifeQuery := s.st.InitialWatchStatementMachineProvisionedVolumes(netNodeUUID)
-	initialQuery, mapper := makeEntityLifePrerequisites(initialLifeQuery, lifeGetter)
+	lifeNS, sizeNS, initialLifeQuery := s.st.InitialWatchStatementMachineProvisionedVolumes(netNodeUUID)
+	initialQuery, mapper := makeEntityLifePrerequisites(initialLifeQuery, lifeGetter, sizeNS)
 	filter := eventsource.PredicateFilter(
-		ns, corechangestream.All, eventsource.EqualsPredicate(netNodeUUID.String()),
+		lifeNS, corechangestream.All, eventsource.EqualsPredicate(netNodeUUID.String()),
 	)
+	// Size changes are emitted for the volume rather than the net node, and
+	// the mapper drops those of other machines.
+	sizeFilter := eventsource.NamespaceFilter(sizeNS, corechangestream.Changed)
 
 	w, err := s.watcherFactory.NewNamespaceMapperWatcher(
 		ctx,
 		initialQuery,
 		fmt.Sprintf("machine provisioned volume watcher for %q", machineUUID),
-		mapper, filter)
+		mapper, filter, sizeFilter)
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
@@ -94,15 +94,19 @@ func (s *volumeSuite) TestWatchMachineProvisionedVolumes(c *tc.C) {
 	s.state.EXPECT().InitialWatchStatementMachineProvisionedVolumes(
 		netNodeUUID,
 	).Return(
-		"test_namespace", namespaceLifeQueryReturningError(c.T),
+		"test_namespace", "test_size_namespace", namespaceLifeQueryReturningError(c.T),
 	)
 	matcher := eventSourcePredFilterMatcher{
 		ChangeMask: changestream.All,
 		Namespace:  "test_namespace",
 		Predicate:  netNodeUUID.String(),
 	}
+	sizeMatcher := eventSourceFilterMatcher{
+		ChangeMask: changestream.Changed,
+		Namespace:  "test_size_namespace",
+	}
 	s.watcherFactory.EXPECT().NewNamespaceMapperWatcher(
-		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), matcher,
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), matcher, sizeMatcher,
 	)
 
 	_, err = NewService(s.state, s.watcherFactory, loggertesting.WrapCheckLog(c)).
@@ -431,6 +431,17 @@ VALUES (?, ?)`, instanceUUID.String(), volumeUUID.String())
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+// newStorageInstanceSnapshotSource records that the storage instance is
+// created from the supplied provider snapshot.
+func (s *baseSuite) newStorageInstanceSnapshotSource(
+	c *tc.C, instanceUUID domainstorage.StorageInstanceUUID, snapshotID string,
+) {
+	_, err := s.DB().ExecContext(c.Context(), `
+INSERT INTO storage_instance_snapshot_source (storage_instance_uuid, snapshot_id)
+VALUES (?, ?)`, instanceUUID.String(), snapshotID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *baseSuite) newStorageInstanceFilesystem(
 	c *tc.C, instanceUUID domainstorage.StorageInstanceUUID,
 	filesystemUUID domainstorage.FilesystemUUID,
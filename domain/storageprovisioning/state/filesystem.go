@@ -21,6 +21,7 @@ import (
 	domainnetwork "github.com/juju/juju/domain/network"
 	networkerrors "github.com/juju/juju/domain/network/errors"
 	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
 	"github.com/juju/juju/domain/storageprovisioning"
 	storageprovisioningerrors "github.com/juju/juju/domain/storageprovisioning/errors"
 	"github.com/juju/juju/domain/storageprovisioning/internal"
@@ -892,13 +893,15 @@ SELECT &filesystemProvisioningParams.* FROM (
               sf.provider_id,
               si.requested_size_mib AS size_mib,
               sp.type,
-              sv.volume_id
+              sv.volume_id,
+              siss.snapshot_id
     FROM      storage_filesystem sf
     JOIN      storage_instance_filesystem sif ON sif.storage_filesystem_uuid = sf.uuid
     JOIN      storage_instance si ON sif.storage_instance_uuid = si.uuid
     JOIN      storage_pool sp ON si.storage_pool_uuid = sp.uuid
     LEFT JOIN storage_instance_volume siv ON si.uuid = siv.storage_instance_uuid
     LEFT JOIN storage_volume sv ON siv.storage_volume_uuid = sv.uuid
+    LEFT JOIN storage_instance_snapshot_source siss ON si.uuid = siss.storage_instance_uuid
     WHERE  sf.uuid = $filesystemUUID.uuid
 )
 `,
@@ -978,6 +981,8 @@ WHERE  sf.uuid = $filesystemUUID.uuid
 		retVal.BackingVolume = &storageprovisioning.FilesystemBackingVolume{
 			VolumeID: paramsVal.VolumeID.V,
 		}
+	} else if paramsVal.SnapshotID.Valid {
+		retVal.SnapshotID = paramsVal.SnapshotID.V
 	}
 
 	if paramsVal.ProviderID.Valid {
@@ -1062,6 +1067,211 @@ SELECT &filesystemRemovalParams.* FROM (
 	return retVal, nil
 }
 
+// GetFilesystemStorageID returns the id of the storage instance of the
+// filesystem with the supplied uuid.
+//
+// The following errors may be returned:
+// - [storageprovisioningerrors.FilesystemNotFound] when no filesystem exists
+// for the provided uuid.
+// - [storageerrors.StorageInstanceNotFound] when the filesystem is not
+// associated with a storage instance.
+func (st *State) GetFilesystemStorageID(
+	ctx context.Context, uuid domainstorage.FilesystemUUID,
+) (string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var (
+		input = filesystemUUID{UUID: uuid.String()}
+		dbVal storageID
+	)
+	stmt, err := st.Prepare(`
+SELECT si.storage_id AS &storageID.storage_id
+FROM   storage_instance_filesystem sif
+JOIN   storage_instance si ON sif.storage_instance_uuid = si.uuid
+WHERE  sif.storage_filesystem_uuid = $filesystemUUID.uuid
+`,
+		input, dbVal,
+	)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkFilesystemExists(ctx, tx, uuid)
+		if err != nil {
+			return errors.Errorf("checking if filesystem %q exists: %w", uuid, err)
+		}
+		if !exists {
+			return errors.Errorf(
+				"filesystem %q does not exist", uuid,
+			).Add(storageprovisioningerrors.FilesystemNotFound)
+		}
+
+		err = tx.Query(ctx, stmt, input).Get(&dbVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"filesystem %q has no storage instance", uuid,
+			).Add(storageerrors.StorageInstanceNotFound)
+		}
+		return err
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	return dbVal.ID, nil
+}
+
+// GetFilesystemEncryptionKeySecret returns the id of the secret holding the
+// key that the filesystem with the supplied uuid is encrypted with.
+//
+// The following errors may be returned:
+// - [storageprovisioningerrors.FilesystemNotFound] when no filesystem exists
+// for the provided uuid.
+// - [storageprovisioningerrors.FilesystemEncryptionKeyNotFound] when no key
+// secret has been recorded for the filesystem's storage instance.
+func (st *State) GetFilesystemEncryptionKeySecret(
+	ctx context.Context, uuid domainstorage.FilesystemUUID,
+) (string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var (
+		input = filesystemUUID{UUID: uuid.String()}
+		dbVal storageInstanceEncryptionKey
+	)
+	stmt, err := st.Prepare(`
+SELECT siek.storage_instance_uuid AS &storageInstanceEncryptionKey.storage_instance_uuid,
+       siek.secret_id AS &storageInstanceEncryptionKey.secret_id
+FROM   storage_instance_filesystem sif
+JOIN   storage_instance_encryption_key siek ON sif.storage_instance_uuid = siek.storage_instance_uuid
+WHERE  sif.storage_filesystem_uuid = $filesystemUUID.uuid
+`,
+		input, dbVal,
+	)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkFilesystemExists(ctx, tx, uuid)
+		if err != nil {
+			return errors.Errorf("checking if filesystem %q exists: %w", uuid, err)
+		}
+		if !exists {
+			return errors.Errorf(
+				"filesystem %q does not exist", uuid,
+			).Add(storageprovisioningerrors.FilesystemNotFound)
+		}
+
+		err = tx.Query(ctx, stmt, input).Get(&dbVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"no encryption key recorded for filesystem %q", uuid,
+			).Add(storageprovisioningerrors.FilesystemEncryptionKeyNotFound)
+		}
+		return err
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	return dbVal.SecretID, nil
+}
+
+// SetFilesystemEncryptionKeySecret records the secret holding the key that
+// the filesystem with the supplied uuid is encrypted with against the
+// filesystem's storage instance.
+//
+// The following errors may be returned:
+// - [storageprovisioningerrors.FilesystemNotFound] when no filesystem exists
+// for the provided uuid.
+// - [storageerrors.StorageInstanceNotFound] when the filesystem is not
+// associated with a storage instance.
+// - [storageprovisioningerrors.FilesystemEncryptionKeyAlreadySet] when a key
+// secret has already been recorded for the storage instance.
+func (st *State) SetFilesystemEncryptionKeySecret(
+	ctx context.Context, uuid domainstorage.FilesystemUUID, secretID string,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var (
+		input  = filesystemUUID{UUID: uuid.String()}
+		siUUID storageInstanceUUID
+		dbVal  storageInstanceEncryptionKey
+	)
+	storageInstanceStmt, err := st.Prepare(`
+SELECT storage_instance_uuid AS &storageInstanceUUID.uuid
+FROM   storage_instance_filesystem
+WHERE  storage_filesystem_uuid = $filesystemUUID.uuid
+`,
+		input, siUUID,
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	existingStmt, err := st.Prepare(`
+SELECT &storageInstanceEncryptionKey.*
+FROM   storage_instance_encryption_key
+WHERE  storage_instance_uuid = $storageInstanceEncryptionKey.storage_instance_uuid
+`, dbVal)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO storage_instance_encryption_key (*)
+VALUES ($storageInstanceEncryptionKey.*)
+`, dbVal)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkFilesystemExists(ctx, tx, uuid)
+		if err != nil {
+			return errors.Errorf("checking if filesystem %q exists: %w", uuid, err)
+		}
+		if !exists {
+			return errors.Errorf(
+				"filesystem %q does not exist", uuid,
+			).Add(storageprovisioningerrors.FilesystemNotFound)
+		}
+
+		err = tx.Query(ctx, storageInstanceStmt, input).Get(&siUUID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"filesystem %q has no storage instance", uuid,
+			).Add(storageerrors.StorageInstanceNotFound)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		dbVal = storageInstanceEncryptionKey{
+			StorageInstanceUUID: siUUID.UUID,
+			SecretID:            secretID,
+		}
+		var existing storageInstanceEncryptionKey
+		err = tx.Query(ctx, existingStmt, dbVal).Get(&existing)
+		if err == nil {
+			return errors.Errorf(
+				"encryption key for filesystem %q already recorded", uuid,
+			).Add(storageprovisioningerrors.FilesystemEncryptionKeyAlreadySet)
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+
+		return tx.Query(ctx, insertStmt, dbVal).Run()
+	})
+}
+
 // GetFilesystemUUIDForID returns the uuid for a filesystem with the supplied
 // id.
 //
@@ -1108,8 +1318,8 @@ WHERE  filesystem_id = $filesystemID.filesystem_id
 	return domainstorage.FilesystemUUID(dbVal.UUID), nil
 }
 
-// InitialWatchStatementMachineProvisionedFilesystems returns both the
-// namespace for watching filesystem life changes where the filesystem is
+// InitialWatchStatementMachineProvisionedFilesystems returns the namespaces
+// for watching life and requested size changes of filesystems that are
 // machine provisioned. On top of this the initial query for getting all
 // filesystems in the model that are machine provisioned is returned.
 //
@@ -1117,13 +1327,15 @@ WHERE  filesystem_id = $filesystemID.filesystem_id
 // supplied net node will be emitted.
 func (st *State) InitialWatchStatementMachineProvisionedFilesystems(
 	netNodeUUID domainnetwork.NetNodeUUID,
-) (string, eventsource.Query[map[string]domainlife.Life]) {
+) (string, string, eventsource.Query[map[string]domainlife.Life]) {
 	query := func(ctx context.Context, db database.TxnRunner) (
 		map[string]domainlife.Life, error,
 	) {
 		return st.getFilesystemLifeForNetNode(ctx, db, netNodeUUID)
 	}
-	return "storage_filesystem_life_machine_provisioning", query
+	return "storage_filesystem_life_machine_provisioning",
+		"storage_filesystem_size_machine_provisioning",
+		query
 }
 
 // InitialWatchStatementModelProvisionedFilesystems returns both the namespace
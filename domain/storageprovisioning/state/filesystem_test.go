@@ -17,6 +17,7 @@ import (
 	domainnetwork "github.com/juju/juju/domain/network"
 	networkerrors "github.com/juju/juju/domain/network/errors"
 	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
 	"github.com/juju/juju/domain/storageprovisioning"
 	storageprovisioningerrors "github.com/juju/juju/domain/storageprovisioning/errors"
 	"github.com/juju/juju/domain/storageprovisioning/internal"
@@ -466,10 +467,11 @@ func (s *filesystemSuite) TestInitialWatchStatementMachineProvisionedFilesystems
 	fsIDOtherMachine, _ := s.newMachineFilesystem(c)
 	_ = s.newMachineFilesystemAttachment(c, fsIDOtherMachine, s.newNetNode(c))
 
-	ns, initialQuery := st.InitialWatchStatementMachineProvisionedFilesystems(
+	lifeNS, sizeNS, initialQuery := st.InitialWatchStatementMachineProvisionedFilesystems(
 		netNodeUUID,
 	)
-	c.Check(ns, tc.Equals, "storage_filesystem_life_machine_provisioning")
+	c.Check(lifeNS, tc.Equals, "storage_filesystem_life_machine_provisioning")
+	c.Check(sizeNS, tc.Equals, "storage_filesystem_size_machine_provisioning")
 
 	db := s.TxnRunner()
 	fsUUIDs, err := initialQuery(c.Context(), db)
@@ -494,10 +496,11 @@ func (s *filesystemSuite) TestInitialWatchStatementMachineProvisionedFilesystems
 	fsIDOtherMachine, _ := s.newMachineFilesystem(c)
 	s.newMachineFilesystemAttachment(c, fsIDOtherMachine, s.newNetNode(c))
 
-	ns, initialQuery := st.InitialWatchStatementMachineProvisionedFilesystems(
+	lifeNS, sizeNS, initialQuery := st.InitialWatchStatementMachineProvisionedFilesystems(
 		netNodeUUID,
 	)
-	c.Check(ns, tc.Equals, "storage_filesystem_life_machine_provisioning")
+	c.Check(lifeNS, tc.Equals, "storage_filesystem_life_machine_provisioning")
+	c.Check(sizeNS, tc.Equals, "storage_filesystem_size_machine_provisioning")
 
 	db := s.TxnRunner()
 	fsUUIDs, err := initialQuery(c.Context(), db)
@@ -514,10 +517,11 @@ func (s *filesystemSuite) TestInitialWatchStatementMachineProvisionedFilesystems
 	netNodeUUID, err := domainnetwork.NewNetNodeUUID()
 	c.Assert(err, tc.ErrorIsNil)
 
-	ns, initialQuery := st.InitialWatchStatementMachineProvisionedFilesystems(
+	lifeNS, sizeNS, initialQuery := st.InitialWatchStatementMachineProvisionedFilesystems(
 		netNodeUUID,
 	)
-	c.Check(ns, tc.Equals, "storage_filesystem_life_machine_provisioning")
+	c.Check(lifeNS, tc.Equals, "storage_filesystem_life_machine_provisioning")
+	c.Check(sizeNS, tc.Equals, "storage_filesystem_size_machine_provisioning")
 
 	db := s.TxnRunner()
 	_, err = initialQuery(c.Context(), db)
@@ -926,11 +930,126 @@ func (s *filesystemSuite) TestGetFilesystemParamsUsingPool(c *tc.C) {
 	})
 }
 
+// TestGetFilesystemParamsFromSnapshot is testing that the snapshot a
+// filesystem is to be created from is returned in its params.
+func (s *filesystemSuite) TestGetFilesystemParamsFromSnapshot(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	poolUUID := s.newStoragePool(c, "mypool", "mypoolprovider", nil)
+	charmUUID := s.newCharm(c)
+	s.newCharmStorage(c, charmUUID, "mystorage", "filesystem", false, false, "")
+	suuid, _ := s.newStorageInstanceForCharmWithPool(c, charmUUID, poolUUID, "mystorage")
+	fsUUID, fsID := s.newMachineFilesystemWithSize(c, 100)
+	s.newStorageInstanceFilesystem(c, suuid, fsUUID)
+	s.newStorageInstanceSnapshotSource(c, suuid, "default:juju-abc/snap1")
+
+	params, err := st.GetFilesystemParams(c.Context(), fsUUID)
+
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(params, tc.DeepEquals, storageprovisioning.FilesystemParams{
+		Attributes: map[string]string{},
+		ID:         fsID,
+		Provider:   "mypoolprovider",
+		SizeMiB:    100,
+		SnapshotID: "default:juju-abc/snap1",
+	})
+}
+
 func (s *filesystemSuite) TestGetFilesystemParamsVolumeBacked(c *tc.C) {
 	// TODO(storage): test that a volume backed filesystem returns the backing
 	// volume information.
 }
 
+func (s *filesystemSuite) TestGetFilesystemStorageID(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	poolUUID := s.newStoragePool(c, "mypool", "mypoolprovider", nil)
+	charmUUID := s.newCharm(c)
+	s.newCharmStorage(c, charmUUID, "mystorage", "filesystem", false, false, "")
+	suuid, storageID := s.newStorageInstanceForCharmWithPool(c, charmUUID, poolUUID, "mystorage")
+	fsUUID, _ := s.newMachineFilesystem(c)
+	s.newStorageInstanceFilesystem(c, suuid, fsUUID)
+
+	id, err := st.GetFilesystemStorageID(c.Context(), fsUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, storageID)
+}
+
+func (s *filesystemSuite) TestGetFilesystemStorageIDNoStorageInstance(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	fsUUID, _ := s.newMachineFilesystem(c)
+
+	_, err := st.GetFilesystemStorageID(c.Context(), fsUUID)
+	c.Assert(err, tc.ErrorIs, storageerrors.StorageInstanceNotFound)
+}
+
+func (s *filesystemSuite) TestGetFilesystemStorageIDNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	fsUUID := tc.Must(c, domainstorage.NewFilesystemUUID)
+
+	_, err := st.GetFilesystemStorageID(c.Context(), fsUUID)
+	c.Assert(err, tc.ErrorIs, storageprovisioningerrors.FilesystemNotFound)
+}
+
+func (s *filesystemSuite) TestSetAndGetFilesystemEncryptionKeySecret(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	poolUUID := s.newStoragePool(c, "mypool", "mypoolprovider", nil)
+	charmUUID := s.newCharm(c)
+	s.newCharmStorage(c, charmUUID, "mystorage", "filesystem", false, false, "")
+	suuid, _ := s.newStorageInstanceForCharmWithPool(c, charmUUID, poolUUID, "mystorage")
+	fsUUID, _ := s.newMachineFilesystem(c)
+	s.newStorageInstanceFilesystem(c, suuid, fsUUID)
+	s.newSecret(c, "secret-id")
+
+	_, err := st.GetFilesystemEncryptionKeySecret(c.Context(), fsUUID)
+	c.Assert(err, tc.ErrorIs, storageprovisioningerrors.FilesystemEncryptionKeyNotFound)
+
+	err = st.SetFilesystemEncryptionKeySecret(c.Context(), fsUUID, "secret-id")
+	c.Assert(err, tc.ErrorIsNil)
+
+	secretID, err := st.GetFilesystemEncryptionKeySecret(c.Context(), fsUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(secretID, tc.Equals, "secret-id")
+}
+
+func (s *filesystemSuite) TestSetFilesystemEncryptionKeySecretAlreadySet(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	poolUUID := s.newStoragePool(c, "mypool", "mypoolprovider", nil)
+	charmUUID := s.newCharm(c)
+	s.newCharmStorage(c, charmUUID, "mystorage", "filesystem", false, false, "")
+	suuid, _ := s.newStorageInstanceForCharmWithPool(c, charmUUID, poolUUID, "mystorage")
+	fsUUID, _ := s.newMachineFilesystem(c)
+	s.newStorageInstanceFilesystem(c, suuid, fsUUID)
+	s.newSecret(c, "secret-id")
+	s.newSecret(c, "other-secret-id")
+
+	err := st.SetFilesystemEncryptionKeySecret(c.Context(), fsUUID, "secret-id")
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.SetFilesystemEncryptionKeySecret(c.Context(), fsUUID, "other-secret-id")
+	c.Assert(err, tc.ErrorIs, storageprovisioningerrors.FilesystemEncryptionKeyAlreadySet)
+}
+
+func (s *filesystemSuite) TestSetFilesystemEncryptionKeySecretNoStorageInstance(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	fsUUID, _ := s.newMachineFilesystem(c)
+
+	err := st.SetFilesystemEncryptionKeySecret(c.Context(), fsUUID, "secret-id")
+	c.Assert(err, tc.ErrorIs, storageerrors.StorageInstanceNotFound)
+}
+
+func (s *filesystemSuite) TestGetFilesystemEncryptionKeySecretNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	fsUUID := tc.Must(c, domainstorage.NewFilesystemUUID)
+
+	_, err := st.GetFilesystemEncryptionKeySecret(c.Context(), fsUUID)
+	c.Assert(err, tc.ErrorIs, storageprovisioningerrors.FilesystemNotFound)
+}
+
+// newSecret inserts a secret with the supplied id into the model.
+func (s *filesystemSuite) newSecret(c *tc.C, id string) {
+	_, err := s.DB().Exec("INSERT INTO secret (id) VALUES (?)", id)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *filesystemSuite) TestGetFilesystemRemovalParamsNotFound(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
 	fsUUID := tc.Must(c, domainstorage.NewFilesystemUUID)
@@ -131,6 +131,7 @@ type filesystemProvisioningParams struct {
 	SizeMiB      uint64           `db:"size_mib"`
 	VolumeID     sql.Null[string] `db:"volume_id"`
 	ProviderID   sql.Null[string] `db:"provider_id"`
+	SnapshotID   sql.Null[string] `db:"snapshot_id"`
 }
 
 // filesystemRemovalParams represents the removal params for a filesystem from
@@ -353,10 +354,11 @@ type machineVolumeProvisioningParams struct {
 // volumeProvisioningParams represents the provisioning params for a volume from the model
 // database.
 type volumeProvisioningParams struct {
-	VolumeID             string `db:"volume_id"`
-	Type                 string `db:"type"`
-	RequestedSizeMiB     uint64 `db:"requested_size_mib"`
-	VolumeAttachmentUUID string `db:"volume_attachment_uuid"`
+	VolumeID             string           `db:"volume_id"`
+	Type                 string           `db:"type"`
+	RequestedSizeMiB     uint64           `db:"requested_size_mib"`
+	VolumeAttachmentUUID string           `db:"volume_attachment_uuid"`
+	SnapshotID           sql.Null[string] `db:"snapshot_id"`
 }
 
 // volumeRemovalParams represents the removal params for a volume from the model
@@ -407,6 +409,13 @@ type storageID struct {
 
 type storageIDs []storageID
 
+// storageInstanceEncryptionKey represents a record in the
+// storage_instance_encryption_key table.
+type storageInstanceEncryptionKey struct {
+	StorageInstanceUUID string `db:"storage_instance_uuid"`
+	SecretID            string `db:"secret_id"`
+}
+
 type unitUUIDRef struct {
 	UUID string `db:"unit_uuid"`
 }
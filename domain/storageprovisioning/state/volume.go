@@ -1304,12 +1304,14 @@ SELECT &volumeProvisioningParams.* FROM (
     SELECT    sv.volume_id,
               si.requested_size_mib,
               sp.type,
-              sva.uuid AS volume_attachment_uuid
+              sva.uuid AS volume_attachment_uuid,
+              siss.snapshot_id
     FROM      storage_volume sv
     JOIN      storage_instance_volume siv ON siv.storage_volume_uuid = sv.uuid
     JOIN      storage_instance si ON siv.storage_instance_uuid = si.uuid
     JOIN      storage_pool sp ON si.storage_pool_uuid = sp.uuid
     LEFT JOIN storage_volume_attachment sva ON sva.storage_volume_uuid = sv.uuid
+    LEFT JOIN storage_instance_snapshot_source siss ON si.uuid = siss.storage_instance_uuid
     WHERE  sv.uuid = $volumeUUID.uuid
 )
 `,
@@ -1382,6 +1384,7 @@ WHERE  sv.uuid = $volumeUUID.uuid
 		ID:         paramsVal.VolumeID,
 		Provider:   paramsVal.Type,
 		SizeMiB:    paramsVal.RequestedSizeMiB,
+		SnapshotID: paramsVal.SnapshotID.V,
 	}
 	if paramsVal.VolumeAttachmentUUID != "" {
 		vaUUID := domainstorage.VolumeAttachmentUUID(
@@ -1950,23 +1953,25 @@ WHERE  uuid = $volumeAttachmentUUID.uuid
 	return nil
 }
 
-// InitialWatchStatementMachineProvisionedVolumes returns both the namespace for
-// watching volume life changes where the volume is machine provisioned. On top
-// of this the initial query for getting all volumes in the model that are
-// machine provisioned is returned.
+// InitialWatchStatementMachineProvisionedVolumes returns the namespaces for
+// watching life and requested size changes of volumes that are machine
+// provisioned. On top of this the initial query for getting all volumes in
+// the model that are machine provisioned is returned.
 //
 // Only volumes that can be provisioned by the machine connected to the
 // supplied net node will be emitted.
 func (st *State) InitialWatchStatementMachineProvisionedVolumes(
 	netNodeUUID domainnetwork.NetNodeUUID,
-) (string, eventsource.Query[map[string]domainlife.Life]) {
+) (string, string, eventsource.Query[map[string]domainlife.Life]) {
 	query := func(
 		ctx context.Context,
 		db database.TxnRunner,
 	) (map[string]domainlife.Life, error) {
 		return st.getVolumeLifeForNetNode(ctx, db, netNodeUUID)
 	}
-	return "storage_volume_life_machine_provisioning", query
+	return "storage_volume_life_machine_provisioning",
+		"storage_volume_size_machine_provisioning",
+		query
 }
 
 // InitialWatchStatementModelProvisionedVolumes returns both the namespace for
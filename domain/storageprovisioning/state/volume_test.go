@@ -387,10 +387,11 @@ func (s *volumeSuite) TestInitialWatchStatementMachineProvisionedVolumes(c *tc.C
 	vsIDOtherMachine, _ := s.newMachineVolume(c)
 	_ = s.newMachineVolumeAttachment(c, vsIDOtherMachine, s.newNetNode(c))
 
-	ns, initialQuery := st.InitialWatchStatementMachineProvisionedVolumes(
+	lifeNS, sizeNS, initialQuery := st.InitialWatchStatementMachineProvisionedVolumes(
 		netNodeUUID,
 	)
-	c.Check(ns, tc.Equals, "storage_volume_life_machine_provisioning")
+	c.Check(lifeNS, tc.Equals, "storage_volume_life_machine_provisioning")
+	c.Check(sizeNS, tc.Equals, "storage_volume_size_machine_provisioning")
 
 	db := s.TxnRunner()
 	vsUUIDs, err := initialQuery(c.Context(), db)
@@ -415,10 +416,11 @@ func (s *volumeSuite) TestInitialWatchStatementMachineProvisionedVolumesNone(c *
 	vsIDOtherMachine, _ := s.newMachineVolume(c)
 	s.newMachineVolumeAttachment(c, vsIDOtherMachine, s.newNetNode(c))
 
-	ns, initialQuery := st.InitialWatchStatementMachineProvisionedVolumes(
+	lifeNS, sizeNS, initialQuery := st.InitialWatchStatementMachineProvisionedVolumes(
 		netNodeUUID,
 	)
-	c.Check(ns, tc.Equals, "storage_volume_life_machine_provisioning")
+	c.Check(lifeNS, tc.Equals, "storage_volume_life_machine_provisioning")
+	c.Check(sizeNS, tc.Equals, "storage_volume_size_machine_provisioning")
 
 	db := s.TxnRunner()
 	vsUUIDs, err := initialQuery(c.Context(), db)
@@ -435,10 +437,11 @@ func (s *volumeSuite) TestInitialWatchStatementMachineProvisionedVolumesNetNodeM
 	netNodeUUID, err := domainnetwork.NewNetNodeUUID()
 	c.Assert(err, tc.ErrorIsNil)
 
-	ns, initialQuery := st.InitialWatchStatementMachineProvisionedVolumes(
+	lifeNS, sizeNS, initialQuery := st.InitialWatchStatementMachineProvisionedVolumes(
 		netNodeUUID,
 	)
-	c.Check(ns, tc.Equals, "storage_volume_life_machine_provisioning")
+	c.Check(lifeNS, tc.Equals, "storage_volume_life_machine_provisioning")
+	c.Check(sizeNS, tc.Equals, "storage_volume_size_machine_provisioning")
 
 	db := s.TxnRunner()
 	_, err = initialQuery(c.Context(), db)
@@ -908,6 +911,28 @@ func (s *volumeSuite) TestGetVolumeParams(c *tc.C) {
 	})
 }
 
+func (s *volumeSuite) TestGetVolumeParamsFromSnapshot(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	poolUUID := s.newStoragePool(c, "mypool", "mypoolprovider", nil)
+	charmUUID := s.newCharm(c)
+	s.newCharmStorage(c, charmUUID, "mystorage", "block", false, false, "")
+	suuid, _ := s.newStorageInstanceForCharmWithPool(c, charmUUID, poolUUID, "mystorage")
+	volUUID, volID := s.newMachineVolume(c)
+	s.newStorageInstanceVolume(c, suuid, volUUID)
+	s.newStorageInstanceSnapshotSource(c, suuid, "juju-abc@snap1")
+
+	params, err := st.GetVolumeParams(c.Context(), volUUID)
+
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(params, tc.DeepEquals, domainstorageprovisioning.VolumeParams{
+		Attributes: map[string]string{},
+		ID:         volID,
+		Provider:   "mypoolprovider",
+		SizeMiB:    100,
+		SnapshotID: "juju-abc@snap1",
+	})
+}
+
 func (s *volumeSuite) TestGetVolumeParamsWithVolumeAttachment(c *tc.C) {
 	// Construct the app, unit and machine
 	netNodeUUID := s.newNetNode(c)
@@ -189,6 +189,10 @@ type VolumeParams struct {
 	Provider             string
 	SizeMiB              uint64
 	VolumeAttachmentUUID *domainstorage.VolumeAttachmentUUID
+
+	// SnapshotID, when not empty, is the provider ID of the snapshot the
+	// volume is created from.
+	SnapshotID string
 }
 
 // VolumeRemovalParams defines the set of parameters that a caller needs to
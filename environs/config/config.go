@@ -26,11 +26,14 @@ import (
 
 	corebase "github.com/juju/juju/core/base"
 	coremodelconfig "github.com/juju/juju/core/modelconfig"
+	"github.com/juju/juju/core/operation"
+	coresecrets "github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/semversion"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/internal/charmhub"
 	"github.com/juju/juju/internal/featureflag"
+	"github.com/juju/juju/internal/network/dns"
 	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/juju/osenv"
 )
@@ -53,6 +56,16 @@ const (
 	FwNone = "none"
 )
 
+const (
+	// HostFirewallNone requests that the machine agents do not enforce
+	// opened ports on the machines they run on.
+	HostFirewallNone = "none"
+
+	// HostFirewallNftables requests that the machine agents enforce the
+	// opened ports of their units with an nftables rule set.
+	HostFirewallNftables = "nftables"
+)
+
 // TODO(katco-): Please grow this over time.
 // Centralized place to store values of config keys. This transitions
 // mistakes in referencing key-values to a compile-time error.
@@ -242,6 +255,17 @@ const (
 	// grow to before it is pruned, eg "5M"
 	MaxActionResultsSize = "max-action-results-size"
 
+	// OperationRetentionPolicy holds the rules deciding how long operations
+	// are kept when pruning, as semicolon-separated rules of space-separated
+	// key=value fields, eg "action=backup max-age=8760h; application=vault
+	// audit=true".
+	OperationRetentionPolicy = "operation-retention-policy"
+
+	// StorageUsageWarningThresholdKey is the percentage of a filesystem's
+	// size which, once used, raises a warning on the model status. A value
+	// of 0 disables the warning.
+	StorageUsageWarningThresholdKey = "storage-usage-warning-threshold"
+
 	// UpdateStatusHookInterval is how often to run the update-status hook.
 	UpdateStatusHookInterval = "update-status-hook-interval"
 
@@ -249,6 +273,34 @@ const (
 	// originates if the model is deployed such that NAT or similar is in use.
 	EgressSubnets = "egress-subnets"
 
+	// EgressDefaultDenyKey restricts the outbound traffic of all machines
+	// in the model to the egress rules of their applications, rather than
+	// only the machines hosting applications with egress rules.
+	EgressDefaultDenyKey = "egress-default-deny"
+
+	// HostFirewallKey is the backend the machine agents use to enforce the
+	// opened ports of their units on the machine itself, in addition to
+	// any firewalling done by the provider.
+	HostFirewallKey = "host-firewall"
+
+	// RelationNetworkPolicyKey restricts the traffic accepted by units on
+	// their opened ports to the units related to them, plus the CIDRs
+	// they are exposed to.
+	RelationNetworkPolicyKey = "relation-network-policy"
+
+	// DNSUpdateServerKey is the host[:port] of an external DNS server to
+	// which the names of the model's applications and units are published
+	// using RFC 2136 dynamic updates. An empty value disables updates.
+	DNSUpdateServerKey = "dns-update-server"
+
+	// DNSUpdateZoneKey is the zone on the external DNS server under which
+	// names are published.
+	DNSUpdateZoneKey = "dns-update-zone"
+
+	// DNSUpdateTSIGKeySecretKey is the URI of the user secret holding the
+	// TSIG key used to sign dynamic updates.
+	DNSUpdateTSIGKeySecretKey = "dns-update-tsig-key-secret"
+
 	// CloudInitUserDataKey is the key to specify cloud-init yaml the user
 	// wants to add into the cloud-config data produced by Juju when
 	// provisioning machines.
@@ -436,6 +488,10 @@ const (
 
 	// DefaultSecretBackend is the default secret backend to use.
 	DefaultSecretBackend = "auto"
+
+	// DefaultStorageUsageWarningThreshold is the default percentage of a
+	// filesystem's size which, once used, raises a model status warning.
+	DefaultStorageUsageWarningThreshold = 90
 )
 
 var defaultConfigValues = map[string]any{
@@ -481,6 +537,14 @@ var defaultConfigValues = map[string]any{
 	TransmitVendorMetricsKey:        true,
 	UpdateStatusHookInterval:        DefaultUpdateStatusHookInterval,
 	EgressSubnets:                   "",
+	EgressDefaultDenyKey:            false,
+	HostFirewallKey:                 HostFirewallNone,
+	RelationNetworkPolicyKey:        false,
+	DNSUpdateServerKey:              "",
+	DNSUpdateZoneKey:                dns.DefaultDomain,
+	DNSUpdateTSIGKeySecretKey:       "",
+	OperationRetentionPolicy:        "",
+	StorageUsageWarningThresholdKey: DefaultStorageUsageWarningThreshold,
 	CloudInitUserDataKey:            "",
 	ContainerInheritPropertiesKey:   "",
 	BackupDirKey:                    "",
@@ -681,6 +745,16 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[OperationRetentionPolicy].(string); ok {
+		if _, err := operation.ParseRetentionPolicy(v); err != nil {
+			return errors.Annotate(err, "invalid operation retention policy in model configuration")
+		}
+	}
+
+	if err := cfg.validateDNSUpdate(); err != nil {
+		return errors.Trace(err)
+	}
+
 	if v, ok := cfg.defined[UpdateStatusHookInterval].(string); ok {
 		duration, err := time.ParseDuration(v)
 		if err != nil {
@@ -784,6 +858,10 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		return errors.Trace(err)
 	}
 
+	if v, ok := cfg.defined[StorageUsageWarningThresholdKey].(int); ok && (v < 0 || v > 100) {
+		return errors.Errorf("%s: must be between 0 and 100, got %d", StorageUsageWarningThresholdKey, v)
+	}
+
 	if old != nil {
 		// Check the immutable config values.  These can't change
 		for _, attr := range immutableAttributes {
@@ -1523,6 +1601,14 @@ func (c *Config) MaxActionResultsSizeMB() uint {
 	return uint(val)
 }
 
+// OperationRetentionPolicy returns the rules deciding how long operations
+// are kept when pruning.
+func (c *Config) OperationRetentionPolicy() operation.RetentionPolicy {
+	// Value has already been validated.
+	val, _ := operation.ParseRetentionPolicy(c.asString(OperationRetentionPolicy))
+	return val
+}
+
 // UpdateStatusHookInterval is how often to run the charm
 // update-status hook.
 func (c *Config) UpdateStatusHookInterval() time.Duration {
@@ -1547,6 +1633,75 @@ func (c *Config) EgressSubnets() []string {
 	return result
 }
 
+// EgressDefaultDeny returns whether the outbound traffic of every machine in
+// the model is denied unless allowed by the egress rules of its applications.
+func (c *Config) EgressDefaultDeny() bool {
+	val, _ := c.defined[EgressDefaultDenyKey].(bool)
+	return val
+}
+
+// HostFirewall returns the backend the machine agents use to enforce the
+// opened ports of their units (HostFirewallNone or HostFirewallNftables).
+func (c *Config) HostFirewall() string {
+	if v, ok := c.defined[HostFirewallKey].(string); ok && v != "" {
+		return v
+	}
+	return HostFirewallNone
+}
+
+// RelationNetworkPolicy returns whether units only accept traffic on their
+// opened ports from the units related to them and the CIDRs they are
+// exposed to.
+func (c *Config) RelationNetworkPolicy() bool {
+	val, _ := c.defined[RelationNetworkPolicyKey].(bool)
+	return val
+}
+
+// DNSUpdateServer returns the host[:port] of the external DNS server the
+// model's names are published to, or an empty string if disabled.
+func (c *Config) DNSUpdateServer() string {
+	return c.asString(DNSUpdateServerKey)
+}
+
+// DNSUpdateZone returns the zone under which the model's names are
+// published on the external DNS server.
+func (c *Config) DNSUpdateZone() string {
+	if v := c.asString(DNSUpdateZoneKey); v != "" {
+		return v
+	}
+	return dns.DefaultDomain
+}
+
+// DNSUpdateTSIGKeySecret returns the URI of the user secret holding the
+// TSIG key used to sign dynamic updates, or an empty string if updates are
+// not signed.
+func (c *Config) DNSUpdateTSIGKeySecret() string {
+	return c.asString(DNSUpdateTSIGKeySecretKey)
+}
+
+func (c *Config) validateDNSUpdate() error {
+	if v := c.asString(DNSUpdateServerKey); v != "" {
+		host := v
+		if h, _, err := net.SplitHostPort(v); err == nil {
+			host = h
+		}
+		if strings.Trim(host, "[]") == "" {
+			return errors.NotValidf("%s %q", DNSUpdateServerKey, v)
+		}
+	}
+	if v := c.asString(DNSUpdateZoneKey); v != "" {
+		if err := dns.ValidateName(v); err != nil {
+			return errors.NotValidf("%s %q", DNSUpdateZoneKey, v)
+		}
+	}
+	if v := c.asString(DNSUpdateTSIGKeySecretKey); v != "" {
+		if _, err := coresecrets.ParseURI(v); err != nil {
+			return errors.Annotatef(err, "invalid %s in model configuration", DNSUpdateTSIGKeySecretKey)
+		}
+	}
+	return nil
+}
+
 // CloudInitUserData returns a copy of the raw user data attributes
 // that were specified by the user.
 func (c *Config) CloudInitUserData() map[string]any {
@@ -1686,6 +1841,14 @@ var alwaysOptional = schema.Defaults{
 	MaxActionResultsSize:            schema.Omit,
 	UpdateStatusHookInterval:        schema.Omit,
 	EgressSubnets:                   schema.Omit,
+	EgressDefaultDenyKey:            schema.Omit,
+	HostFirewallKey:                 schema.Omit,
+	RelationNetworkPolicyKey:        schema.Omit,
+	DNSUpdateServerKey:              schema.Omit,
+	DNSUpdateZoneKey:                schema.Omit,
+	DNSUpdateTSIGKeySecretKey:       schema.Omit,
+	OperationRetentionPolicy:        schema.Omit,
+	StorageUsageWarningThresholdKey: schema.Omit,
 	CloudInitUserDataKey:            schema.Omit,
 	ContainerInheritPropertiesKey:   schema.Omit,
 	BackupDirKey:                    schema.Omit,
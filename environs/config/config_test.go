@@ -6,6 +6,7 @@ package config_test
 import (
 	"fmt"
 	"maps"
+	"regexp"
 	"strings"
 	stdtesting "testing"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/juju/schema"
 	"github.com/juju/tc"
 
+	"github.com/juju/juju/core/operation"
 	"github.com/juju/juju/core/semversion"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/environs/config"
@@ -1343,6 +1345,115 @@ func (s *ConfigSuite) TestEgressSubnets(c *tc.C) {
 	c.Assert(cfg.EgressSubnets(), tc.DeepEquals, []string{"10.0.0.1/32", "192.168.1.1/16"})
 }
 
+func (s *ConfigSuite) TestOperationRetentionPolicy(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"operation-retention-policy": "action=backup max-age=8760h; application=vault audit=true",
+	})
+	c.Assert(cfg.OperationRetentionPolicy(), tc.DeepEquals, operation.RetentionPolicy{
+		{Action: "backup", MaxAge: 8760 * time.Hour},
+		{Application: "vault", Audit: true},
+	})
+
+	cfg = newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.OperationRetentionPolicy(), tc.HasLen, 0)
+}
+
+func (s *ConfigSuite) TestOperationRetentionPolicyInvalid(c *tc.C) {
+	_, err := config.New(config.UseDefaults, testing.Attrs{
+		"type": "my-type", "name": "my-name",
+		"uuid":                       testing.ModelTag.Id(),
+		"operation-retention-policy": "action=backup",
+	})
+	c.Assert(err, tc.ErrorMatches, "invalid operation retention policy in model configuration: rule 1: .*")
+}
+
+func (s *ConfigSuite) TestStorageUsageWarningThreshold(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"storage-usage-warning-threshold": 75,
+	})
+	c.Assert(cfg.AllAttrs()["storage-usage-warning-threshold"], tc.Equals, 75)
+
+	cfg = newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.AllAttrs()["storage-usage-warning-threshold"], tc.Equals, config.DefaultStorageUsageWarningThreshold)
+}
+
+func (s *ConfigSuite) TestStorageUsageWarningThresholdInvalid(c *tc.C) {
+	_, err := config.New(config.UseDefaults, testing.Attrs{
+		"type": "my-type", "name": "my-name",
+		"uuid":                            testing.ModelTag.Id(),
+		"storage-usage-warning-threshold": 101,
+	})
+	c.Assert(err, tc.ErrorMatches, "storage-usage-warning-threshold: must be between 0 and 100, got 101")
+}
+
+func (s *ConfigSuite) TestEgressDefaultDeny(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.EgressDefaultDeny(), tc.IsFalse)
+
+	cfg = newTestConfig(c, testing.Attrs{
+		"egress-default-deny": true,
+	})
+	c.Assert(cfg.EgressDefaultDeny(), tc.IsTrue)
+}
+
+func (s *ConfigSuite) TestHostFirewall(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.HostFirewall(), tc.Equals, config.HostFirewallNone)
+
+	cfg = newTestConfig(c, testing.Attrs{
+		"host-firewall": "nftables",
+	})
+	c.Assert(cfg.HostFirewall(), tc.Equals, config.HostFirewallNftables)
+}
+
+func (s *ConfigSuite) TestHostFirewallInvalid(c *tc.C) {
+	_, err := config.New(config.UseDefaults, testing.Attrs{
+		"type": "my-type", "name": "my-name",
+		"uuid":          testing.ModelTag.Id(),
+		"host-firewall": "iptables",
+	})
+	c.Assert(err, tc.ErrorMatches, `host-firewall: expected one of \[none nftables\], got "iptables"`)
+}
+
+func (s *ConfigSuite) TestRelationNetworkPolicy(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.RelationNetworkPolicy(), tc.IsFalse)
+
+	cfg = newTestConfig(c, testing.Attrs{
+		"relation-network-policy": true,
+	})
+	c.Assert(cfg.RelationNetworkPolicy(), tc.IsTrue)
+}
+
+func (s *ConfigSuite) TestDNSUpdate(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.DNSUpdateServer(), tc.Equals, "")
+	c.Assert(cfg.DNSUpdateZone(), tc.Equals, "juju")
+	c.Assert(cfg.DNSUpdateTSIGKeySecret(), tc.Equals, "")
+
+	cfg = newTestConfig(c, testing.Attrs{
+		"dns-update-server":          "[2001:db8::53]:53",
+		"dns-update-zone":            "example.com",
+		"dns-update-tsig-key-secret": "secret:9m4e2mr0ui3e8a215n4g",
+	})
+	c.Assert(cfg.DNSUpdateServer(), tc.Equals, "[2001:db8::53]:53")
+	c.Assert(cfg.DNSUpdateZone(), tc.Equals, "example.com")
+	c.Assert(cfg.DNSUpdateTSIGKeySecret(), tc.Equals, "secret:9m4e2mr0ui3e8a215n4g")
+}
+
+func (s *ConfigSuite) TestDNSUpdateInvalid(c *tc.C) {
+	for expected, attrs := range map[string]testing.Attrs{
+		`dns-update-server ":53" not valid`: {"dns-update-server": ":53"},
+		`dns-update-zone "bad..zone" not valid`: {"dns-update-zone": "bad..zone"},
+		`invalid dns-update-tsig-key-secret in model configuration: secret URI scheme "hmac-md5" not valid`: {
+			"dns-update-tsig-key-secret": "hmac-md5:juju:c2VjcmV0",
+		},
+	} {
+		_, err := config.New(config.UseDefaults, testing.FakeConfig().Merge(attrs))
+		c.Check(err, tc.ErrorMatches, regexp.QuoteMeta(expected))
+	}
+}
+
 func (s *ConfigSuite) TestCloudInitUserDataFromEnvironment(c *tc.C) {
 	cfg := newTestConfig(c, testing.Attrs{
 		config.CloudInitUserDataKey: validCloudInitUserData,
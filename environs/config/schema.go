@@ -537,6 +537,20 @@ manually using:
 		Type:        configschema.Tstring,
 		Group:       configschema.EnvironGroup,
 	},
+	OperationRetentionPolicy: {
+		Description: `Rules deciding how long operations are kept, overriding max-action-results-age for the operations they match. Rules are separated by semicolons and the first matching rule applies. Each rule is a space-separated list of key=value fields: application and action select operations, max-age and failed-max-age set how long completed and failed operations are kept, keep-last keeps only the latest operations per action, and audit=true means the operations are never pruned (eg "action=backup max-age=8760h; action=health-check keep-last=10")`,
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
+	StorageUsageWarningThresholdKey: {
+		Description: "The percentage of a filesystem's size which, once used, raises a warning on the model status (default 90, 0 disables the warning)",
+		Documentation: `
+Filesystem usage is reported by the machine agents for the storage attached to
+machines. The storage of units on Kubernetes models is not reported, and never
+raises the warning.`,
+		Type:  configschema.Tint,
+		Group: configschema.EnvironGroup,
+	},
 	UpdateStatusHookInterval: {
 		Description: "How often to run the charm update-status hook, in human-readable time format (default 5m, range 1-60m)",
 		Type:        configschema.Tstring,
@@ -547,6 +561,83 @@ manually using:
 		Type:        configschema.Tstring,
 		Group:       configschema.EnvironGroup,
 	},
+	EgressDefaultDenyKey: {
+		Description: "Whether outbound traffic from machines is denied unless allowed by an application's egress rules",
+		Documentation: `
+By default, machines may initiate connections to any destination, and only
+machines hosting applications with egress rules (see juju set-egress) are
+restricted. When this option is true, the outbound traffic of every machine
+in the model is restricted to the egress rules of the applications it hosts.
+Connections to the controller are always allowed.
+
+This option can only be enabled on clouds that can restrict the outbound
+traffic of machines, such as AWS.`,
+		Type:  configschema.Tbool,
+		Group: configschema.EnvironGroup,
+	},
+	HostFirewallKey: {
+		Description: "The backend used by machine agents to enforce opened ports on the machine itself",
+		Documentation: `
+- 'none' leaves the enforcement of opened ports to the provider's firewall.
+
+- 'nftables' makes each machine agent install an nftables rule set that
+only accepts connections to the ports opened by its units, from the CIDRs
+they are exposed to, and any connection from the model's subnets unless
+relation-network-policy is true. SSH is always accepted. This allows ports to be enforced on machines where the
+provider has no firewall, such as manual machines and LXD containers.`,
+		Type:   configschema.Tstring,
+		Values: []any{HostFirewallNone, HostFirewallNftables},
+		Group:  configschema.EnvironGroup,
+	},
+	RelationNetworkPolicyKey: {
+		Description: "Whether units only accept traffic on their opened ports from related units and the CIDRs they are exposed to",
+		Documentation: `
+By default, machines in the model accept any connection from the model's
+subnets. When this option is true, a unit only accepts connections to its
+opened ports from the units of the applications it is related to, and from
+the CIDRs its application is exposed to. Suspended relations grant no
+access.
+
+On machines this is enforced by the host firewall, so host-firewall must
+also be set to 'nftables'. On Kubernetes a NetworkPolicy is created for each
+application.`,
+		Type:  configschema.Tbool,
+		Group: configschema.EnvironGroup,
+	},
+	DNSUpdateServerKey: {
+		Description: "The host[:port] of a DNS server to publish the names of applications and units to using dynamic updates",
+		Documentation: `
+When set, the controller publishes names for the model's applications and
+units to this server using RFC 2136 dynamic updates over TCP. The port
+defaults to 53. Names are published as
+<unit-number>.<application>.<model>.<dns-update-zone> and
+<application>.<model>.<dns-update-zone>, and resolve to the addresses of
+units in the space their application's default endpoint is bound to. An
+empty value disables dynamic updates.`,
+		Type:  configschema.Tstring,
+		Group: configschema.EnvironGroup,
+	},
+	DNSUpdateZoneKey: {
+		Description: "The zone on the dns-update-server under which names are published",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
+	DNSUpdateTSIGKeySecretKey: {
+		Description: "The URI of a user secret holding the TSIG key used to sign dynamic updates sent to the dns-update-server",
+		Documentation: `
+The secret holds the key as generated by tsig-keygen: the key name in the
+"name" field, the base64 encoded secret in the "secret" field and, optionally,
+the algorithm in the "algorithm" field, one of hmac-sha1, hmac-sha256 (the
+default) or hmac-sha512. For example:
+
+    juju add-secret dns-update-key name=juju-key secret=<base64> algorithm=hmac-sha512
+    juju model-config dns-update-tsig-key-secret=secret:<id>
+
+Only secrets added with juju add-secret can be used. Updating the secret
+rotates the key used to sign updates. An empty value sends unsigned updates.`,
+		Type:  configschema.Tstring,
+		Group: configschema.EnvironGroup,
+	},
 	CloudInitUserDataKey: {
 		Description: `Cloud-init user-data (in yaml format) to be added to userdata for new machines created in this model`,
 		Documentation: `
@@ -42,3 +42,19 @@ type InstanceFirewaller interface {
 	// address rules for that port range.
 	IngressRules(ctx context.Context, machineId string) (firewall.IngressRules, error)
 }
+
+// InstanceEgressFirewaller provides instance-level egress firewall
+// functionality. It is implemented by instances whose provider can restrict
+// outbound traffic.
+type InstanceEgressFirewaller interface {
+	// EgressRules returns the set of egress rules applied to the instance,
+	// which should have been started with the given machine id. A nil
+	// result means that outbound traffic is unrestricted.
+	EgressRules(ctx context.Context, machineId string) (firewall.EgressRules, error)
+
+	// SetEgressRules replaces the egress rules applied to the instance,
+	// which should have been started with the given machine id. Passing
+	// nil removes any restriction on outbound traffic, while an empty
+	// list denies all outbound traffic.
+	SetEgressRules(ctx context.Context, machineId string, rules firewall.EgressRules) error
+}
@@ -529,6 +529,14 @@ type FirewallFeatureQuerier interface {
 	SupportsRulesWithIPV6CIDRs(ctx context.Context) (bool, error)
 }
 
+// EgressFirewallFeatureQuerier exposes methods for detecting whether the
+// environment firewall can restrict the outbound traffic of instances.
+type EgressFirewallFeatureQuerier interface {
+	// SupportsEgressRules returns true if the environment can apply egress
+	// rules to its instances.
+	SupportsEgressRules(ctx context.Context) (bool, error)
+}
+
 // InstanceTagger is an interface that can be used for tagging instances.
 type InstanceTagger interface {
 	// TagInstance tags the given instance with the specified tags.
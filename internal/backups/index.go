@@ -0,0 +1,93 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package backups reads and writes the index of scheduled backups kept in
+// the controller object store.
+package backups
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+// ReadIndex reads the index from the object store, returning an empty
+// index if none has been written yet.
+func ReadIndex(ctx context.Context, store objectstore.ReadObjectStore) (corebackups.Index, error) {
+	for _, p := range []string{corebackups.IndexNextPath, corebackups.IndexPath} {
+		reader, _, err := store.Get(ctx, p)
+		if errors.Is(err, objectstoreerrors.ObjectNotFound) {
+			continue
+		} else if err != nil {
+			return corebackups.Index{}, errors.Errorf("reading backups index: %w", err)
+		}
+		defer func() { _ = reader.Close() }()
+		return corebackups.ReadIndex(reader)
+	}
+	return corebackups.Index{}, nil
+}
+
+// WriteIndex writes the index to the object store. Objects can't be
+// replaced in place, so the index is first written to IndexNextPath, which
+// takes precedence over IndexPath when read, and then moved to IndexPath.
+// Either the old or the new index can be read at every step, so a failure
+// part way through never loses the index.
+func WriteIndex(ctx context.Context, store objectstore.ObjectStore, index corebackups.Index) error {
+	data, err := index.Bytes()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	// Finish moving an index left at IndexNextPath by an earlier failure,
+	// so that IndexNextPath is free for the new index.
+	reader, _, err := store.Get(ctx, corebackups.IndexNextPath)
+	if err == nil {
+		current, err := io.ReadAll(reader)
+		_ = reader.Close()
+		if err != nil {
+			return errors.Errorf("reading backups index: %w", err)
+		}
+		if err := moveIndex(ctx, store, current); err != nil {
+			return errors.Capture(err)
+		}
+	} else if !errors.Is(err, objectstoreerrors.ObjectNotFound) {
+		return errors.Errorf("reading backups index: %w", err)
+	}
+
+	if err := putIndex(ctx, store, corebackups.IndexNextPath, data); err != nil {
+		return errors.Capture(err)
+	}
+	return moveIndex(ctx, store, data)
+}
+
+// moveIndex replaces the index at IndexPath with data, which must already
+// be stored at IndexNextPath, and then removes IndexNextPath.
+func moveIndex(ctx context.Context, store objectstore.ObjectStore, data []byte) error {
+	if err := removeIndex(ctx, store, corebackups.IndexPath); err != nil {
+		return errors.Capture(err)
+	}
+	if err := putIndex(ctx, store, corebackups.IndexPath, data); err != nil {
+		return errors.Capture(err)
+	}
+	return removeIndex(ctx, store, corebackups.IndexNextPath)
+}
+
+func putIndex(ctx context.Context, store objectstore.ObjectStore, p string, data []byte) error {
+	if _, err := store.Put(ctx, p, bytes.NewReader(data), int64(len(data))); err != nil {
+		return errors.Errorf("writing backups index %q: %w", p, err)
+	}
+	return nil
+}
+
+func removeIndex(ctx context.Context, store objectstore.ObjectStore, p string) error {
+	err := store.Remove(ctx, p)
+	if err != nil && !errors.Is(err, objectstoreerrors.ObjectNotFound) {
+		return errors.Errorf("removing backups index %q: %w", p, err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/backups"
+	"github.com/juju/juju/internal/errors"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+	"github.com/juju/juju/internal/testing"
+)
+
+type indexSuite struct {
+	testing.BaseSuite
+}
+
+func TestIndexSuite(t *stdtesting.T) {
+	tc.Run(t, &indexSuite{})
+}
+
+func (s *indexSuite) TestReadIndexEmpty(c *tc.C) {
+	index, err := backups.ReadIndex(c.Context(), newFakeStore())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(index.Entries, tc.HasLen, 0)
+}
+
+func (s *indexSuite) TestWriteIndex(c *tc.C) {
+	store := newFakeStore()
+
+	first := corebackups.Index{Entries: []corebackups.IndexEntry{{Filename: "a"}}}
+	err := backups.WriteIndex(c.Context(), store, first)
+	c.Assert(err, tc.ErrorIsNil)
+
+	second := corebackups.Index{Entries: []corebackups.IndexEntry{{Filename: "a"}, {Filename: "b"}}}
+	err = backups.WriteIndex(c.Context(), store, second)
+	c.Assert(err, tc.ErrorIsNil)
+
+	index, err := backups.ReadIndex(c.Context(), store)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(index.Entries, tc.HasLen, 2)
+
+	// Only the index at IndexPath remains.
+	c.Check(store.paths(), tc.SameContents, []string{corebackups.IndexPath})
+}
+
+func (s *indexSuite) TestWriteIndexFailureKeepsIndex(c *tc.C) {
+	old := corebackups.Index{Entries: []corebackups.IndexEntry{{Filename: "a"}}}
+	updated := corebackups.Index{Entries: []corebackups.IndexEntry{{Filename: "a"}, {Filename: "b"}}}
+
+	// Fail each step of writing the index in turn, and check that either
+	// the old or the new index can always be read.
+	for step := 0; ; step++ {
+		store := newFakeStore()
+		err := backups.WriteIndex(c.Context(), store, old)
+		c.Assert(err, tc.ErrorIsNil)
+
+		store.failAfter = step
+		err = backups.WriteIndex(c.Context(), store, updated)
+		if err == nil {
+			break
+		}
+
+		index, err := backups.ReadIndex(c.Context(), store)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(len(index.Entries) == 1 || len(index.Entries) == 2, tc.IsTrue, tc.Commentf("step %d", step))
+
+		// A later write recovers.
+		store.failAfter = -1
+		err = backups.WriteIndex(c.Context(), store, updated)
+		c.Assert(err, tc.ErrorIsNil)
+		index, err = backups.ReadIndex(c.Context(), store)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(index.Entries, tc.HasLen, 2)
+		c.Check(store.paths(), tc.SameContents, []string{corebackups.IndexPath})
+	}
+}
+
+// fakeStore is an in-memory object store, which fails all writes after
+// failAfter writes if failAfter is not negative.
+type fakeStore struct {
+	objectstore.ObjectStore
+	objects   map[string][]byte
+	failAfter int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		objects:   make(map[string][]byte),
+		failAfter: -1,
+	}
+}
+
+func (f *fakeStore) paths() []string {
+	var paths []string
+	for p := range f.objects {
+		paths = append(paths, p)
+	}
+	return paths
+}
+
+func (f *fakeStore) write() error {
+	if f.failAfter == 0 {
+		return errors.New("boom")
+	}
+	if f.failAfter > 0 {
+		f.failAfter--
+	}
+	return nil
+}
+
+func (f *fakeStore) Get(_ context.Context, p string) (io.ReadCloser, objectstore.Digest, error) {
+	data, ok := f.objects[p]
+	if !ok {
+		return nil, objectstore.Digest{}, objectstoreerrors.ObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), objectstore.Digest{Size: int64(len(data))}, nil
+}
+
+func (f *fakeStore) Put(_ context.Context, p string, r io.Reader, _ int64) (objectstore.UUID, error) {
+	if err := f.write(); err != nil {
+		return "", err
+	}
+	if _, ok := f.objects[p]; ok {
+		return "", errors.New("path exists")
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	f.objects[p] = data
+	return "", nil
+}
+
+func (f *fakeStore) Remove(_ context.Context, p string) error {
+	if err := f.write(); err != nil {
+		return err
+	}
+	if _, ok := f.objects[p]; !ok {
+		return objectstoreerrors.ObjectNotFound
+	}
+	delete(f.objects, p)
+	return nil
+}
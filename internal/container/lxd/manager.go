@@ -244,7 +244,8 @@ func (m *containerManager) getContainerSpec(
 	logger.Debugf(ctx, "configuring container %q with network devices: %v", name, nics)
 
 	// If the default LXD bridge was supplied in network config,
-	// but without a CIDR, attempt to ensure it is configured for IPv4.
+	// but without a CIDR, attempt to ensure it is configured for IPv4,
+	// unless it is configured for IPv6 only.
 	// If there are others with incomplete info, log a warning.
 	if len(unknown) > 0 {
 		if len(unknown) == 1 && unknown[0] == network.DefaultLXDBridge && m.server.networkAPISupport {
@@ -59,7 +59,8 @@ func (s *Server) EnableHTTPSListener() error {
 }
 
 // EnsureIPv4 retrieves the network for the input name and checks its IPv4
-// configuration. If none is detected, it is set to "auto".
+// configuration. If none is detected, it is set to "auto", unless the network
+// is configured for IPv6 only.
 // The boolean return indicates if modification was necessary.
 func (s *Server) EnsureIPv4(netName string) (bool, error) {
 	var modified bool
@@ -69,6 +70,12 @@ func (s *Server) EnsureIPv4(netName string) (bool, error) {
 		return false, errors.Trace(err)
 	}
 
+	if cfg := net.Config["ipv6.address"]; cfg != "" && cfg != "none" {
+		if cfg := net.Config["ipv4.address"]; cfg == "" || cfg == "none" {
+			return false, nil
+		}
+	}
+
 	cfg, ok := net.Config["ipv4.address"]
 	if !ok || cfg == "none" {
 		if net.Config == nil {
@@ -125,11 +132,9 @@ func (s *Server) VerifyNetworkDevice(profile *api.Profile, eTag string) error {
 	return errors.Annotatef(s.verifyNICsWithAPI(nics), "profile %q", profile.Name)
 }
 
-// ensureDefaultNetworking ensures that the default LXD bridge exists,
-// that it is not configured to use IPv6, and that a NIC device exists in
-// the input profile.
-// An error is returned if the bridge exists with IPv6 configuration.
-// If the bridge does not exist, it is created.
+// ensureDefaultNetworking ensures that the default LXD bridge exists and that
+// a NIC device exists in the input profile.
+// If the bridge does not exist, it is created with IPv4 configuration only.
 func (s *Server) ensureDefaultNetworking(profile *api.Profile, eTag string) error {
 	net, _, err := s.GetNetwork(network.DefaultLXDBridge)
 	if err != nil {
@@ -74,6 +74,27 @@ func (s *networkSuite) TestEnsureIPv4NoChange(c *tc.C) {
 	c.Check(mod, tc.IsFalse)
 }
 
+func (s *networkSuite) TestEnsureIPv4NoChangeIPv6Only(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	cSvr := s.NewMockServerWithExtensions(ctrl, "network")
+
+	net := &lxdapi.Network{
+		Config: map[string]string{
+			"ipv4.address": "none",
+			"ipv6.address": "fd42:1::1/64",
+		},
+	}
+	cSvr.EXPECT().GetNetwork(network.DefaultLXDBridge).Return(net, lxdtesting.ETag, nil)
+
+	jujuSvr, err := lxd.NewServer(cSvr)
+	c.Assert(err, tc.ErrorIsNil)
+
+	mod, err := jujuSvr.EnsureIPv4(network.DefaultLXDBridge)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mod, tc.IsFalse)
+}
+
 func (s *networkSuite) TestEnsureIPv4Modified(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
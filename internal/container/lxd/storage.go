@@ -39,6 +39,30 @@ func (s *Server) CreateVolume(pool, name string, cfg map[string]string) error {
 	return nil
 }
 
+// CreateVolumeFromSnapshot creates a new custom storage volume in the
+// input pool as a copy of a snapshot of a volume in the source pool. The
+// snapshot is identified as "<volume-name>/<snapshot-name>".
+func (s *Server) CreateVolumeFromSnapshot(pool, name, sourcePool, snapshot string, cfg map[string]string) error {
+	req := api.StorageVolumesPost{
+		Name:             name,
+		Type:             "custom",
+		StorageVolumePut: api.StorageVolumePut{Config: cfg},
+		Source: api.StorageVolumeSource{
+			Type: "copy",
+			Pool: sourcePool,
+			Name: snapshot,
+		},
+	}
+	op, err := s.CreateStoragePoolVolume(pool, req)
+	if err == nil {
+		err = op.Wait()
+	}
+	if err != nil {
+		return errors.Annotatef(err, "creating storage pool volume %q from snapshot %q", name, snapshot)
+	}
+	return nil
+}
+
 // EnsureDefaultStorage ensures that the input profile is configured with a
 // disk device, creating a new storage pool and a device if required.
 func (s *Server) EnsureDefaultStorage(profile *api.Profile, eTag string) error {
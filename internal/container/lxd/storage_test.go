@@ -106,6 +106,37 @@ func (s *storageSuite) TestCreateVolume(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *storageSuite) TestCreateVolumeFromSnapshot(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	cSvr := s.NewMockServerWithExtensions(ctrl, "storage")
+
+	op := lxdtesting.NewMockOperation(ctrl)
+	op.EXPECT().Wait().Return(nil)
+
+	cfg := map[string]string{"size": "1024MB"}
+
+	req := lxdapi.StorageVolumesPost{
+		Name: "volume",
+		Type: "custom",
+		StorageVolumePut: lxdapi.StorageVolumePut{
+			Config: cfg,
+		},
+		Source: lxdapi.StorageVolumeSource{
+			Type: "copy",
+			Pool: "source-pool",
+			Name: "source/snap0",
+		},
+	}
+	cSvr.EXPECT().CreateStoragePoolVolume("default-pool", req).Return(op, nil)
+
+	jujuSvr, err := lxd.NewServer(cSvr)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = jujuSvr.CreateVolumeFromSnapshot("default-pool", "volume", "source-pool", "source/snap0", cfg)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *storageSuite) TestEnsureDefaultStorageDevicePresent(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
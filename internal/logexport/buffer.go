@@ -0,0 +1,342 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// segmentSuffix is the suffix of buffer segment files.
+	segmentSuffix = ".jsonl"
+
+	// maxSegmentSize is the size at which a segment is sealed and a new
+	// one started. A sealed segment is exported as a single batch.
+	maxSegmentSize = 1 << 20
+
+	// maxRecordSize is the largest size of a JSON encoded log record in a
+	// segment, including its newline. Longer messages are truncated when
+	// appended, and longer lines are skipped when read.
+	maxRecordSize = 64 * 1024
+
+	// truncatedSuffix is appended to the messages of truncated records.
+	truncatedSuffix = "... (truncated)"
+)
+
+// segment is a file of JSON encoded log records, one per line.
+type segment struct {
+	seq  int
+	size int64
+}
+
+// Buffer is an on-disk FIFO of log records waiting to be exported. Records
+// are appended to the newest segment file, and segments are read and removed
+// oldest first. Segments survive restarts of the agent. When the total size
+// of the buffer exceeds its limit the oldest segments are dropped.
+type Buffer struct {
+	dir     string
+	maxSize int64
+
+	mu       sync.Mutex
+	segments []segment
+	nextSeq  int
+	// sealed is true when the newest segment must not be appended to,
+	// because it has been handed out by Oldest.
+	sealed  bool
+	dropped int
+}
+
+// NewBuffer returns a buffer in the given directory, which is created if
+// needed. Any segments left from a previous run are kept.
+func NewBuffer(dir string, maxSize int64) (*Buffer, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Errorf("creating log export buffer: %w", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Errorf("reading log export buffer: %w", err)
+	}
+
+	b := &Buffer{
+		dir:     dir,
+		maxSize: maxSize,
+		// Segments from a previous run may have been partially handed
+		// out, so never append to them.
+		sealed: true,
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentSuffix) {
+			continue
+		}
+		seq, err := strconv.Atoi(strings.TrimSuffix(name, segmentSuffix))
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		b.segments = append(b.segments, segment{seq: seq, size: info.Size()})
+		if seq >= b.nextSeq {
+			b.nextSeq = seq + 1
+		}
+	}
+	sort.Slice(b.segments, func(i, j int) bool {
+		return b.segments[i].seq < b.segments[j].seq
+	})
+	return b, nil
+}
+
+// SetMaxSize changes the maximum size of the buffer. Segments are dropped on
+// the next Append if the buffer is over the new limit.
+func (b *Buffer) SetMaxSize(maxSize int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.maxSize = maxSize
+}
+
+// Append writes the records to the newest segment.
+func (b *Buffer) Append(records []corelogger.LogRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, rec := range records {
+		line, err := encodeRecord(rec)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		buf.Write(line)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := len(b.segments)
+	if n == 0 || b.sealed || b.segments[n-1].size >= maxSegmentSize {
+		b.segments = append(b.segments, segment{seq: b.nextSeq})
+		b.nextSeq++
+		b.sealed = false
+		n++
+	}
+
+	current := &b.segments[n-1]
+	f, err := os.OpenFile(b.segmentPath(current.seq), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Errorf("opening log export buffer segment: %w", err)
+	}
+	written, err := f.Write(buf.Bytes())
+	current.size += int64(written)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Errorf("writing log export buffer segment: %w", err)
+	}
+
+	return b.enforceMaxSize()
+}
+
+// Oldest returns the sequence number and records of the oldest segment.
+// If the oldest segment is the one being appended to, it is sealed so that
+// further records go to a new segment. It returns false if the buffer is
+// empty.
+func (b *Buffer) Oldest() (int, []corelogger.LogRecord, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for len(b.segments) > 0 {
+		oldest := b.segments[0]
+		if len(b.segments) == 1 {
+			b.sealed = true
+		}
+
+		records, err := b.readSegment(oldest.seq)
+		if errors.Is(err, os.ErrNotExist) {
+			b.segments = b.segments[1:]
+			continue
+		} else if err != nil {
+			return 0, nil, false, errors.Capture(err)
+		}
+		if len(records) == 0 {
+			if err := b.remove(oldest.seq); err != nil {
+				return 0, nil, false, errors.Capture(err)
+			}
+			continue
+		}
+		return oldest.seq, records, true, nil
+	}
+	return 0, nil, false, nil
+}
+
+// Remove removes the segment with the given sequence number, once its
+// records have been exported.
+func (b *Buffer) Remove(seq int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.remove(seq)
+}
+
+// Clear removes all segments from the buffer.
+func (b *Buffer) Clear() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for len(b.segments) > 0 {
+		if err := b.remove(b.segments[0].seq); err != nil {
+			return errors.Capture(err)
+		}
+	}
+	return nil
+}
+
+// Len returns the number of segments in the buffer.
+func (b *Buffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.segments)
+}
+
+// Dropped returns the number of segments dropped because the buffer was
+// full.
+func (b *Buffer) Dropped() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.dropped
+}
+
+// enforceMaxSize drops the oldest segments until the buffer is within its
+// maximum size. The newest segment is never dropped.
+func (b *Buffer) enforceMaxSize() error {
+	var total int64
+	for _, s := range b.segments {
+		total += s.size
+	}
+	for total > b.maxSize && len(b.segments) > 1 {
+		oldest := b.segments[0]
+		if err := b.remove(oldest.seq); err != nil {
+			return errors.Capture(err)
+		}
+		total -= oldest.size
+		b.dropped++
+	}
+	return nil
+}
+
+func (b *Buffer) remove(seq int) error {
+	for i, s := range b.segments {
+		if s.seq != seq {
+			continue
+		}
+		if err := os.Remove(b.segmentPath(seq)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return errors.Errorf("removing log export buffer segment: %w", err)
+		}
+		b.segments = append(b.segments[:i], b.segments[i+1:]...)
+		return nil
+	}
+	return nil
+}
+
+func (b *Buffer) readSegment(seq int) ([]corelogger.LogRecord, error) {
+	f, err := os.Open(b.segmentPath(seq))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var records []corelogger.LogRecord
+	reader := bufio.NewReaderSize(f, maxRecordSize)
+	for {
+		line, err := reader.ReadSlice('\n')
+		if errors.Is(err, bufio.ErrBufferFull) {
+			// A line longer than any record written by Append is skipped,
+			// like a malformed one, rather than blocking the export of
+			// the rest of the buffer.
+			if err := skipLine(reader); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return nil, errors.Errorf("reading log export buffer segment: %w", err)
+			}
+			continue
+		} else if err != nil && !errors.Is(err, io.EOF) {
+			return nil, errors.Errorf("reading log export buffer segment: %w", err)
+		}
+
+		var rec corelogger.LogRecord
+		if len(bytes.TrimSpace(line)) > 0 {
+			if jsonErr := json.Unmarshal(line, &rec); jsonErr == nil {
+				records = append(records, rec)
+			}
+			// A partially written line is skipped rather than blocking
+			// the export of the rest of the buffer.
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+	return records, nil
+}
+
+// skipLine discards the rest of the current line, including its newline.
+func skipLine(reader *bufio.Reader) error {
+	for {
+		_, err := reader.ReadSlice('\n')
+		if !errors.Is(err, bufio.ErrBufferFull) {
+			return err
+		}
+	}
+}
+
+// encodeRecord returns the JSON encoding of the record followed by a
+// newline. The message of a record whose encoding would be longer than
+// maxRecordSize is truncated to fit; if that isn't enough, nil is returned
+// and the record isn't buffered.
+func encodeRecord(rec corelogger.LogRecord) ([]byte, error) {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return nil, errors.Errorf("encoding log record: %w", err)
+	}
+	line = append(line, '\n')
+	excess := len(line) - maxRecordSize
+	if excess <= 0 {
+		return line, nil
+	}
+
+	// Each byte removed from the message removes at least one byte from
+	// its encoding, so removing the excess is enough.
+	keep := len(rec.Message) - excess - len(truncatedSuffix)
+	if keep < 0 {
+		// The record is too large even without its message, which can
+		// only happen with outsized labels, so it is dropped.
+		return nil, nil
+	}
+	for keep > 0 && !utf8.RuneStart(rec.Message[keep]) {
+		keep--
+	}
+	rec.Message = rec.Message[:keep] + truncatedSuffix
+	line, err = json.Marshal(rec)
+	if err != nil {
+		return nil, errors.Errorf("encoding log record: %w", err)
+	}
+	return append(line, '\n'), nil
+}
+
+func (b *Buffer) segmentPath(seq int) string {
+	return filepath.Join(b.dir, fmt.Sprintf("%010d%s", seq, segmentSuffix))
+}
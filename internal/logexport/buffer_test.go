@@ -0,0 +1,206 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexport
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type bufferSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestBufferSuite(t *testing.T) {
+	tc.Run(t, &bufferSuite{})
+}
+
+func (s *bufferSuite) TestAppendOldestRemove(c *tc.C) {
+	buffer, err := NewBuffer(c.MkDir(), 1<<30)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, _, ok, err := buffer.Oldest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ok, tc.IsFalse)
+
+	records := makeRecords(3)
+	err = buffer.Append(records[:2])
+	c.Assert(err, tc.ErrorIsNil)
+
+	seq, got, ok, err := buffer.Oldest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(got, tc.DeepEquals, records[:2])
+
+	// The segment handed out is sealed, so further records go to a new
+	// segment and are not lost when it is removed.
+	err = buffer.Append(records[2:])
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(buffer.Len(), tc.Equals, 2)
+
+	err = buffer.Remove(seq)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, got, ok, err = buffer.Oldest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(got, tc.DeepEquals, records[2:])
+}
+
+func (s *bufferSuite) TestSegmentsSurviveRestart(c *tc.C) {
+	dir := c.MkDir()
+	buffer, err := NewBuffer(dir, 1<<30)
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := makeRecords(2)
+	err = buffer.Append(records[:1])
+	c.Assert(err, tc.ErrorIsNil)
+
+	buffer, err = NewBuffer(dir, 1<<30)
+	c.Assert(err, tc.ErrorIsNil)
+	err = buffer.Append(records[1:])
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(buffer.Len(), tc.Equals, 2)
+
+	seq, got, ok, err := buffer.Oldest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(got, tc.DeepEquals, records[:1])
+	c.Assert(buffer.Remove(seq), tc.ErrorIsNil)
+
+	_, got, ok, err = buffer.Oldest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(got, tc.DeepEquals, records[1:])
+}
+
+func (s *bufferSuite) TestMaxSizeDropsOldest(c *tc.C) {
+	buffer, err := NewBuffer(c.MkDir(), 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := makeRecords(3)
+	for i := range records {
+		// Read the oldest segment each time so that every append starts
+		// a new segment.
+		c.Assert(buffer.Append(records[i:i+1]), tc.ErrorIsNil)
+		_, _, _, err := buffer.Oldest()
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	c.Check(buffer.Len(), tc.Equals, 1)
+	c.Check(buffer.Dropped(), tc.Equals, 2)
+
+	_, got, ok, err := buffer.Oldest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(got, tc.DeepEquals, records[2:])
+}
+
+func (s *bufferSuite) TestSkipsCorruptLines(c *tc.C) {
+	dir := c.MkDir()
+	buffer, err := NewBuffer(dir, 1<<30)
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := makeRecords(1)
+	c.Assert(buffer.Append(records), tc.ErrorIsNil)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"+segmentSuffix))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(files, tc.HasLen, 1)
+	f, err := os.OpenFile(files[0], os.O_APPEND|os.O_WRONLY, 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = f.WriteString(`{"model-uuid": "trunc`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(f.Close(), tc.ErrorIsNil)
+
+	_, got, ok, err := buffer.Oldest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(got, tc.DeepEquals, records)
+}
+
+func (s *bufferSuite) TestClear(c *tc.C) {
+	dir := c.MkDir()
+	buffer, err := NewBuffer(dir, 1<<30)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(buffer.Append(makeRecords(2)), tc.ErrorIsNil)
+	c.Assert(buffer.Clear(), tc.ErrorIsNil)
+	c.Check(buffer.Len(), tc.Equals, 0)
+
+	entries, err := os.ReadDir(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+func makeRecords(n int) []corelogger.LogRecord {
+	start := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	records := make([]corelogger.LogRecord, n)
+	for i := range records {
+		records[i] = corelogger.LogRecord{
+			Time:      start.Add(time.Duration(i) * time.Second),
+			ModelUUID: "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+			Entity:    "machine-0",
+			Level:     corelogger.INFO,
+			Module:    "juju.worker.test",
+			Location:  "test.go:42",
+			Message:   fmt.Sprintf("message %d %s", i, strings.Repeat("x", i)),
+			Labels:    map[string]string{"domain": "test"},
+		}
+	}
+	return records
+}
+
+func (s *bufferSuite) TestAppendTruncatesLongMessage(c *tc.C) {
+	buffer, err := NewBuffer(c.MkDir(), 1<<30)
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := makeRecords(2)
+	records[0].Message = strings.Repeat("x", 2*maxRecordSize)
+	err = buffer.Append(records)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, got, ok, err := buffer.Oldest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ok, tc.IsTrue)
+	c.Assert(got, tc.HasLen, 2)
+	c.Check(len(got[0].Message) < maxRecordSize, tc.IsTrue)
+	c.Check(strings.HasSuffix(got[0].Message, truncatedSuffix), tc.IsTrue)
+	c.Check(got[1], tc.DeepEquals, records[1])
+}
+
+func (s *bufferSuite) TestOldestSkipsOversizedLine(c *tc.C) {
+	dir := c.MkDir()
+	buffer, err := NewBuffer(dir, 1<<30)
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := makeRecords(2)
+	err = buffer.Append(records[:1])
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A line longer than maxSegmentSize, for instance written by an
+	// earlier version, is skipped rather than failing the segment.
+	path := filepath.Join(dir, fmt.Sprintf("%010d%s", 0, segmentSuffix))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = f.WriteString(`{"message":"` + strings.Repeat("x", 2*maxSegmentSize) + "\"}\n")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(f.Close(), tc.ErrorIsNil)
+	err = buffer.Append(records[1:])
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, got, ok, err := buffer.Oldest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(got, tc.DeepEquals, records)
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package logexport provides exporters that forward agent log records to an
+// external collector, along with the on-disk buffer used to hold records
+// while the collector is unavailable.
+package logexport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/juju/juju/controller"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	jujuhttp "github.com/juju/juju/internal/http"
+)
+
+// ErrRejected is returned by an exporter when the collector rejected the
+// records it was sent. Retrying the same records will not succeed.
+const ErrRejected = errors.ConstError("log records rejected by collector")
+
+// Exporter sends batches of log records to an external collector.
+type Exporter interface {
+	// Export sends the records to the collector. An error wrapping
+	// ErrRejected indicates that the records should not be retried.
+	Export(context.Context, []corelogger.LogRecord) error
+
+	// Close releases any resources held by the exporter.
+	Close() error
+}
+
+// Config holds the settings used to create an exporter.
+type Config struct {
+	// Protocol is one of the controller.LogExport* protocols.
+	Protocol string
+
+	// Endpoint is the collector endpoint. It is a URL for OTLP and a
+	// host:port for syslog.
+	Endpoint string
+
+	// CACert is an optional PEM encoded CA certificate used to verify the
+	// collector. The system roots are used if it is empty.
+	CACert string
+
+	// ControllerUUID identifies the controller the records come from.
+	ControllerUUID string
+}
+
+// NewExporter returns an exporter for the configured protocol.
+func NewExporter(cfg Config) (Exporter, error) {
+	tlsConfig, err := newTLSConfig(cfg.CACert)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	switch cfg.Protocol {
+	case controller.LogExportOTLPHTTP:
+		return newOTLPExporter(cfg.Endpoint, tlsConfig, cfg.ControllerUUID), nil
+	case controller.LogExportSyslogTCP:
+		return newSyslogExporter(cfg.Endpoint, nil), nil
+	case controller.LogExportSyslogTLS:
+		return newSyslogExporter(cfg.Endpoint, tlsConfig), nil
+	default:
+		return nil, errors.Errorf("log export protocol %q not supported", cfg.Protocol)
+	}
+}
+
+func newTLSConfig(caCert string) (*tls.Config, error) {
+	tlsConfig := jujuhttp.SecureTLSConfig()
+	if caCert == "" {
+		return tlsConfig, nil
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(caCert)) {
+		return nil, errors.New("parsing log export CA certificate")
+	}
+	tlsConfig.RootCAs = pool
+	return tlsConfig, nil
+}
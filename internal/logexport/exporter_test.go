@@ -0,0 +1,222 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/juju/juju/controller"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type exporterSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestExporterSuite(t *testing.T) {
+	tc.Run(t, &exporterSuite{})
+}
+
+func (s *exporterSuite) TestNewExporterUnknownProtocol(c *tc.C) {
+	_, err := NewExporter(Config{Protocol: "carrier-pigeon"})
+	c.Assert(err, tc.ErrorMatches, `log export protocol "carrier-pigeon" not supported`)
+}
+
+func (s *exporterSuite) TestNewExporterInvalidCACert(c *tc.C) {
+	_, err := NewExporter(Config{
+		Protocol: controller.LogExportOTLPHTTP,
+		CACert:   "not a cert",
+	})
+	c.Assert(err, tc.ErrorMatches, `parsing log export CA certificate`)
+}
+
+func (s *exporterSuite) TestOTLPExport(c *tc.C) {
+	requests := make(chan *collogspb.ExportLogsServiceRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, http.MethodPost)
+		c.Check(r.Header.Get("Content-Type"), tc.Equals, "application/x-protobuf")
+		data, err := io.ReadAll(r.Body)
+		c.Check(err, tc.ErrorIsNil)
+		var req collogspb.ExportLogsServiceRequest
+		c.Check(proto.Unmarshal(data, &req), tc.ErrorIsNil)
+		requests <- &req
+	}))
+	defer srv.Close()
+
+	exporter, err := NewExporter(Config{
+		Protocol:       controller.LogExportOTLPHTTP,
+		Endpoint:       srv.URL + "/v1/logs",
+		ControllerUUID: "controller-uuid",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer exporter.Close()
+
+	records := makeRecords(2)
+	records[1].ModelUUID = "other-model"
+	records[1].Level = corelogger.ERROR
+	err = exporter.Export(c.Context(), records)
+	c.Assert(err, tc.ErrorIsNil)
+
+	req := <-requests
+	c.Assert(req.ResourceLogs, tc.HasLen, 2)
+
+	resource := req.ResourceLogs[0]
+	c.Check(attributes(resource.Resource.Attributes), tc.DeepEquals, map[string]string{
+		"service.name":         "juju",
+		"juju.controller.uuid": "controller-uuid",
+		"juju.model.uuid":      records[0].ModelUUID,
+	})
+	c.Assert(resource.ScopeLogs, tc.HasLen, 1)
+	c.Assert(resource.ScopeLogs[0].LogRecords, tc.HasLen, 1)
+
+	rec := resource.ScopeLogs[0].LogRecords[0]
+	c.Check(rec.TimeUnixNano, tc.Equals, uint64(records[0].Time.UnixNano()))
+	c.Check(rec.SeverityNumber, tc.Equals, logspb.SeverityNumber_SEVERITY_NUMBER_INFO)
+	c.Check(rec.SeverityText, tc.Equals, "INFO")
+	c.Check(rec.Body.GetStringValue(), tc.Equals, records[0].Message)
+	c.Check(attributes(rec.Attributes), tc.DeepEquals, map[string]string{
+		"juju.entity":       "machine-0",
+		"juju.module":       "juju.worker.test",
+		"juju.location":     "test.go:42",
+		"juju.label.domain": "test",
+	})
+
+	other := req.ResourceLogs[1].ScopeLogs[0].LogRecords[0]
+	c.Check(other.SeverityNumber, tc.Equals, logspb.SeverityNumber_SEVERITY_NUMBER_ERROR)
+}
+
+func (s *exporterSuite) TestOTLPExportStatus(c *tc.C) {
+	status := http.StatusServiceUnavailable
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	exporter, err := NewExporter(Config{
+		Protocol: controller.LogExportOTLPHTTP,
+		Endpoint: srv.URL,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer exporter.Close()
+
+	err = exporter.Export(c.Context(), makeRecords(1))
+	c.Assert(err, tc.ErrorMatches, `OTLP collector returned 503 Service Unavailable`)
+	c.Check(errors.Is(err, ErrRejected), tc.IsFalse)
+
+	status = http.StatusBadRequest
+	err = exporter.Export(c.Context(), makeRecords(1))
+	c.Assert(err, tc.ErrorIs, ErrRejected)
+}
+
+func (s *exporterSuite) TestSyslogExport(c *tc.C) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	defer listener.Close()
+
+	messages := make(chan string, 2)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		for {
+			msg, err := readFrame(reader)
+			if err != nil {
+				return
+			}
+			messages <- msg
+		}
+	}()
+
+	exporter, err := NewExporter(Config{
+		Protocol: controller.LogExportSyslogTCP,
+		Endpoint: listener.Addr().String(),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer exporter.Close()
+
+	records := makeRecords(2)
+	records[1].Level = corelogger.WARNING
+	err = exporter.Export(c.Context(), records)
+	c.Assert(err, tc.ErrorIsNil)
+
+	msg := <-messages
+	header := "<14>1 2026-04-01T12:00:00.000000Z machine-0 juju - - - "
+	c.Assert(strings.HasPrefix(msg, header), tc.IsTrue, tc.Commentf("%q", msg))
+
+	var rec corelogger.LogRecord
+	err = json.Unmarshal([]byte(strings.TrimPrefix(msg, header)), &rec)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rec, tc.DeepEquals, records[0])
+
+	msg = <-messages
+	c.Check(strings.HasPrefix(msg, "<12>1 "), tc.IsTrue, tc.Commentf("%q", msg))
+}
+
+func (s *exporterSuite) TestSyslogExportConnectionRefused(c *tc.C) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	addr := listener.Addr().String()
+	c.Assert(listener.Close(), tc.ErrorIsNil)
+
+	exporter, err := NewExporter(Config{
+		Protocol: controller.LogExportSyslogTCP,
+		Endpoint: addr,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer exporter.Close()
+
+	err = exporter.Export(context.Background(), makeRecords(1))
+	c.Assert(err, tc.ErrorMatches, `connecting to syslog collector .*`)
+	c.Check(errors.Is(err, ErrRejected), tc.IsFalse)
+}
+
+func (s *exporterSuite) TestSyslogHeaderValue(c *tc.C) {
+	c.Check(syslogHeaderValue("", 10), tc.Equals, "-")
+	c.Check(syslogHeaderValue("unit-foo 0", 10), tc.Equals, "unit-foo0")
+	c.Check(syslogHeaderValue("machine-123456", 7), tc.Equals, "machine")
+}
+
+func attributes(kvs []*commonpb.KeyValue) map[string]string {
+	result := make(map[string]string)
+	for _, kv := range kvs {
+		result[kv.Key] = kv.Value.GetStringValue()
+	}
+	return result
+}
+
+func readFrame(reader *bufio.Reader) (string, error) {
+	length, err := reader.ReadString(' ')
+	if err != nil {
+		return "", err
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(reader, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexport
+
+import (
+	"sync"
+
+	corelogger "github.com/juju/juju/core/logger"
+)
+
+// Forwarder is a log sink that writes records to an underlying sink and,
+// when one is set, also hands them to a secondary log writer. It lets the
+// log exporter worker observe every record written to the agent log sink
+// without owning the sink itself.
+type Forwarder struct {
+	sink corelogger.LogSink
+
+	mu     sync.RWMutex
+	writer corelogger.LogWriter
+}
+
+// NewForwarder returns a forwarder writing to the given sink.
+func NewForwarder(sink corelogger.LogSink) *Forwarder {
+	return &Forwarder{
+		sink: sink,
+	}
+}
+
+// Log is part of the corelogger.LogSink interface. Records are always
+// written to the underlying sink; a failure to forward them does not
+// prevent that.
+func (f *Forwarder) Log(records []corelogger.LogRecord) error {
+	err := f.sink.Log(records)
+
+	f.mu.RLock()
+	writer := f.writer
+	f.mu.RUnlock()
+
+	if writer != nil {
+		_ = writer.Log(records)
+	}
+	return err
+}
+
+// SetLogWriter sets the writer that records are forwarded to. A nil writer
+// stops forwarding.
+func (f *Forwarder) SetLogWriter(writer corelogger.LogWriter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.writer = writer
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexport
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type forwarderSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestForwarderSuite(t *testing.T) {
+	tc.Run(t, &forwarderSuite{})
+}
+
+func (s *forwarderSuite) TestLog(c *tc.C) {
+	sink := &recordingWriter{}
+	forwarder := NewForwarder(sink)
+
+	records := makeRecords(2)
+	c.Assert(forwarder.Log(records[:1]), tc.ErrorIsNil)
+
+	writer := &recordingWriter{}
+	forwarder.SetLogWriter(writer)
+	c.Assert(forwarder.Log(records[1:]), tc.ErrorIsNil)
+
+	forwarder.SetLogWriter(nil)
+	c.Assert(forwarder.Log(records[:1]), tc.ErrorIsNil)
+
+	c.Check(sink.records, tc.DeepEquals, append(records, records[0]))
+	c.Check(writer.records, tc.DeepEquals, records[1:])
+}
+
+func (s *forwarderSuite) TestLogWriterErrorIgnored(c *tc.C) {
+	sink := &recordingWriter{}
+	forwarder := NewForwarder(sink)
+	forwarder.SetLogWriter(&recordingWriter{err: errors.New("boom")})
+
+	records := makeRecords(1)
+	c.Assert(forwarder.Log(records), tc.ErrorIsNil)
+	c.Check(sink.records, tc.DeepEquals, records)
+}
+
+func (s *forwarderSuite) TestLogSinkError(c *tc.C) {
+	forwarder := NewForwarder(&recordingWriter{err: errors.New("boom")})
+	writer := &recordingWriter{}
+	forwarder.SetLogWriter(writer)
+
+	records := makeRecords(1)
+	c.Assert(forwarder.Log(records), tc.ErrorMatches, "boom")
+	c.Check(writer.records, tc.DeepEquals, records)
+}
+
+type recordingWriter struct {
+	records []corelogger.LogRecord
+	err     error
+}
+
+func (w *recordingWriter) Log(records []corelogger.LogRecord) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.records = append(w.records, records...)
+	return nil
+}
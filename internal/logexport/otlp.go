@@ -0,0 +1,192 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexport
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	jujuhttp "github.com/juju/juju/internal/http"
+)
+
+const (
+	// otlpTimeout is the maximum time a single export request may take.
+	otlpTimeout = 30 * time.Second
+
+	// otlpScopeName is the instrumentation scope of exported records.
+	otlpScopeName = "juju"
+
+	// otlpLabelPrefix prefixes the attribute key of each record label.
+	otlpLabelPrefix = "juju.label."
+)
+
+// otlpExporter exports log records using OTLP over HTTP, encoded as
+// protobuf.
+type otlpExporter struct {
+	client         *http.Client
+	endpoint       string
+	controllerUUID string
+}
+
+func newOTLPExporter(endpoint string, tlsConfig *tls.Config, controllerUUID string) *otlpExporter {
+	transport := jujuhttp.NewHTTPTLSTransport(jujuhttp.TransportConfig{
+		TLSConfig:           tlsConfig,
+		TLSHandshakeTimeout: 20 * time.Second,
+		Middlewares: []jujuhttp.TransportMiddleware{
+			jujuhttp.ProxyMiddleware,
+		},
+	})
+	return &otlpExporter{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   otlpTimeout,
+		},
+		endpoint:       endpoint,
+		controllerUUID: controllerUUID,
+	}
+}
+
+// Export is part of the Exporter interface.
+func (e *otlpExporter) Export(ctx context.Context, records []corelogger.LogRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	data, err := proto.Marshal(otlpRequest(e.controllerUUID, records))
+	if err != nil {
+		return errors.Errorf("encoding OTLP request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return errors.Capture(err)
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return errors.Errorf("sending OTLP request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusBadGateway,
+		resp.StatusCode == http.StatusServiceUnavailable,
+		resp.StatusCode == http.StatusGatewayTimeout:
+		// These are the responses the OTLP specification marks as
+		// retryable.
+		return errors.Errorf("OTLP collector returned %s", resp.Status)
+	default:
+		return errors.Errorf("OTLP collector returned %s: %w", resp.Status, ErrRejected)
+	}
+}
+
+// Close is part of the Exporter interface.
+func (e *otlpExporter) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// otlpRequest builds an OTLP export request for the records, with one
+// resource per model.
+func otlpRequest(controllerUUID string, records []corelogger.LogRecord) *collogspb.ExportLogsServiceRequest {
+	var (
+		resources []*logspb.ResourceLogs
+		byModel   = make(map[string]*logspb.ScopeLogs)
+	)
+	for _, rec := range records {
+		scope, ok := byModel[rec.ModelUUID]
+		if !ok {
+			scope = &logspb.ScopeLogs{
+				Scope: &commonpb.InstrumentationScope{Name: otlpScopeName},
+			}
+			byModel[rec.ModelUUID] = scope
+			resources = append(resources, &logspb.ResourceLogs{
+				Resource: &resourcepb.Resource{
+					Attributes: []*commonpb.KeyValue{
+						stringAttribute("service.name", "juju"),
+						stringAttribute("juju.controller.uuid", controllerUUID),
+						stringAttribute("juju.model.uuid", rec.ModelUUID),
+					},
+				},
+				ScopeLogs: []*logspb.ScopeLogs{scope},
+			})
+		}
+		scope.LogRecords = append(scope.LogRecords, otlpLogRecord(rec))
+	}
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: resources,
+	}
+}
+
+func otlpLogRecord(rec corelogger.LogRecord) *logspb.LogRecord {
+	attrs := []*commonpb.KeyValue{
+		stringAttribute("juju.entity", rec.Entity),
+		stringAttribute("juju.module", rec.Module),
+		stringAttribute("juju.location", rec.Location),
+	}
+	keys := make([]string, 0, len(rec.Labels))
+	for k := range rec.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		attrs = append(attrs, stringAttribute(otlpLabelPrefix+k, rec.Labels[k]))
+	}
+
+	return &logspb.LogRecord{
+		TimeUnixNano:   uint64(rec.Time.UnixNano()),
+		SeverityNumber: otlpSeverity(rec.Level),
+		SeverityText:   rec.Level.String(),
+		Body: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: rec.Message},
+		},
+		Attributes: attrs,
+	}
+}
+
+func otlpSeverity(level corelogger.Level) logspb.SeverityNumber {
+	switch level {
+	case corelogger.TRACE:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_TRACE
+	case corelogger.DEBUG:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG
+	case corelogger.INFO:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO
+	case corelogger.WARNING:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+	case corelogger.ERROR:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	case corelogger.CRITICAL:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL
+	default:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED
+	}
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
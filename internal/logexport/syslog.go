@@ -0,0 +1,177 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexport
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// syslogTimeout is the maximum time to connect to the collector or to
+	// write a batch of records.
+	syslogTimeout = 30 * time.Second
+
+	// syslogFacility is the facility of exported records (user-level).
+	syslogFacility = 1
+
+	// syslogAppName is the APP-NAME of exported records.
+	syslogAppName = "juju"
+
+	// syslogTimestamp is the RFC 5424 TIMESTAMP format, with microsecond
+	// precision.
+	syslogTimestamp = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// syslogExporter exports log records as RFC 5424 syslog messages over TCP,
+// optionally using TLS. Messages are framed using octet counting as
+// described in RFC 6587 and RFC 5425. The message body is the JSON encoding
+// of the record, so the model UUID, entity and labels are preserved.
+type syslogExporter struct {
+	endpoint  string
+	tlsConfig *tls.Config
+
+	conn net.Conn
+}
+
+func newSyslogExporter(endpoint string, tlsConfig *tls.Config) *syslogExporter {
+	return &syslogExporter{
+		endpoint:  endpoint,
+		tlsConfig: tlsConfig,
+	}
+}
+
+// Export is part of the Exporter interface.
+func (e *syslogExporter) Export(ctx context.Context, records []corelogger.LogRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, rec := range records {
+		msg, err := syslogMessage(rec)
+		if err != nil {
+			return errors.Errorf("encoding syslog message: %w: %w", err, ErrRejected)
+		}
+		fmt.Fprintf(&buf, "%d %s", len(msg), msg)
+	}
+
+	conn, err := e.connect(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := conn.SetWriteDeadline(time.Now().Add(syslogTimeout)); err != nil {
+		e.closeConn()
+		return errors.Capture(err)
+	}
+	if _, err := conn.Write(buf.Bytes()); err != nil {
+		// The connection is in an unknown state, so start again with a
+		// new one on the next export.
+		e.closeConn()
+		return errors.Errorf("writing syslog messages: %w", err)
+	}
+	return nil
+}
+
+// Close is part of the Exporter interface.
+func (e *syslogExporter) Close() error {
+	e.closeConn()
+	return nil
+}
+
+func (e *syslogExporter) connect(ctx context.Context) (net.Conn, error) {
+	if e.conn != nil {
+		return e.conn, nil
+	}
+
+	dialer := &net.Dialer{Timeout: syslogTimeout}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if e.tlsConfig != nil {
+		host, _, splitErr := net.SplitHostPort(e.endpoint)
+		if splitErr != nil {
+			return nil, errors.Capture(splitErr)
+		}
+		tlsConfig := e.tlsConfig.Clone()
+		tlsConfig.ServerName = host
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", e.endpoint)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", e.endpoint)
+	}
+	if err != nil {
+		return nil, errors.Errorf("connecting to syslog collector %q: %w", e.endpoint, err)
+	}
+	e.conn = conn
+	return conn, nil
+}
+
+func (e *syslogExporter) closeConn() {
+	if e.conn == nil {
+		return
+	}
+	_ = e.conn.Close()
+	e.conn = nil
+}
+
+// syslogMessage returns the RFC 5424 encoding of the record:
+//
+//	<PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+//
+// The entity that logged the record is used as the HOSTNAME.
+func syslogMessage(rec corelogger.LogRecord) ([]byte, error) {
+	body, err := json.Marshal(rec)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	hostname := syslogHeaderValue(rec.Entity, 255)
+	pri := syslogFacility*8 + syslogSeverity(rec.Level)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<%d>1 %s %s %s - - - ",
+		pri, rec.Time.UTC().Format(syslogTimestamp), hostname, syslogAppName)
+	buf.Write(body)
+	return buf.Bytes(), nil
+}
+
+// syslogSeverity maps a log level onto a syslog severity.
+func syslogSeverity(level corelogger.Level) int {
+	switch level {
+	case corelogger.CRITICAL:
+		return 2
+	case corelogger.ERROR:
+		return 3
+	case corelogger.WARNING:
+		return 4
+	case corelogger.INFO:
+		return 6
+	default:
+		return 7
+	}
+}
+
+// syslogHeaderValue returns the value as a header field, which must be
+// printable US-ASCII without spaces, or the nil value "-" if it is empty.
+func syslogHeaderValue(value string, maxLen int) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(value) && buf.Len() < maxLen; i++ {
+		if c := value[i]; c > 32 && c < 127 {
+			buf.WriteByte(c)
+		}
+	}
+	if buf.Len() == 0 {
+		return "-"
+	}
+	return buf.String()
+}
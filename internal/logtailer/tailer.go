@@ -6,6 +6,7 @@ package logtailer
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"regexp"
@@ -55,7 +56,13 @@ type LogTailerParams struct {
 	ExcludeModule []string
 	IncludeLabels map[string]string
 	ExcludeLabels map[string]string
-	FromTheStart  bool
+	// IncludeMessage lists regular expressions, of which the message of a
+	// record must match at least one.
+	IncludeMessage []string
+	// ExcludeMessage lists regular expressions, none of which the message
+	// of a record may match.
+	ExcludeMessage []string
+	FromTheStart   bool
 }
 
 // maxInitialLines limits the number of documents we will load into memory
@@ -68,12 +75,23 @@ func NewLogTailer(
 	modelUUID string,
 	logFile string, params LogTailerParams,
 ) (LogTailer, error) {
+	includeMessage, err := makeMessagePattern(params.IncludeMessage)
+	if err != nil {
+		return nil, errors.Annotate(err, "include message")
+	}
+	excludeMessage, err := makeMessagePattern(params.ExcludeMessage)
+	if err != nil {
+		return nil, errors.Annotate(err, "exclude message")
+	}
+
 	t := &logTailer{
 		modelUUID:       modelUUID,
 		params:          params,
 		logCh:           make(chan corelogger.LogRecord),
 		maxInitialLines: maxInitialLines,
 		logFile:         logFile,
+		includeMessage:  includeMessage,
+		excludeMessage:  excludeMessage,
 	}
 	t.tomb.Go(func() error {
 		defer close(t.logCh)
@@ -92,6 +110,9 @@ type logTailer struct {
 	maxInitialLines int
 
 	logFile string
+
+	includeMessage *regexp.Regexp
+	excludeMessage *regexp.Regexp
 }
 
 // Logs implements the LogTailer interface.
@@ -340,6 +361,12 @@ func (t *logTailer) includeRecord(rec corelogger.LogRecord) bool {
 			return false
 		}
 	}
+	if t.includeMessage != nil && !t.includeMessage.MatchString(rec.Message) {
+		return false
+	}
+	if t.excludeMessage != nil && t.excludeMessage.MatchString(rec.Message) {
+		return false
+	}
 	return true
 }
 
@@ -363,6 +390,22 @@ func makeModulePattern(modules []string) string {
 	return `^(` + strings.Join(patterns, "|") + `)(\..+)?$`
 }
 
+// makeMessagePattern combines the regular expressions into one that matches
+// if any of them match. It returns nil if there are no expressions.
+func makeMessagePattern(exprs []string) (*regexp.Regexp, error) {
+	if len(exprs) == 0 {
+		return nil, nil
+	}
+	patterns := make([]string, len(exprs))
+	for i, expr := range exprs {
+		if _, err := regexp.Compile(expr); err != nil {
+			return nil, errors.NewNotValid(err, fmt.Sprintf("pattern %q", expr))
+		}
+		patterns[i] = `(?:` + expr + `)`
+	}
+	return regexp.Compile(strings.Join(patterns, "|"))
+}
+
 func logLineToRecord(modelUUID string, line string) (corelogger.LogRecord, error) {
 	var result corelogger.LogRecord
 	err := json.Unmarshal([]byte(line), &result)
@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5/workertest"
 
@@ -444,6 +445,57 @@ func (s *LogFilterSuite) TestIncludeExcludeModule(c *tc.C) {
 	s.checkLogTailerFiltering(c, params, writeLogs, assert)
 }
 
+func (s *LogFilterSuite) TestIncludeMessage(c *tc.C) {
+	req0 := &corelogger.LogRecord{Message: "handling request-id=abc123"}
+	req1 := &corelogger.LogRecord{Message: "handling request-id=def456"}
+	other := &corelogger.LogRecord{Message: "something else"}
+	logFile := filepath.Join(c.MkDir(), "logs.log")
+	writeLogs := func() string {
+		s.writeLogs(c, logFile, 1, req0)
+		s.writeLogs(c, logFile, 2, other)
+		s.writeLogs(c, logFile, 1, req1)
+		s.writeLogs(c, logFile, 1, req0)
+		return logFile
+	}
+	params := logtailer.LogTailerParams{
+		IncludeMessage: []string{"abc1[0-9]+", "^nomatch$"},
+	}
+	assert := func(tailer logtailer.LogTailer) {
+		s.assertTailer(c, tailer, req0, req0)
+	}
+	s.checkLogTailerFiltering(c, params, writeLogs, assert)
+}
+
+func (s *LogFilterSuite) TestExcludeMessage(c *tc.C) {
+	req0 := &corelogger.LogRecord{Message: "handling request-id=abc123"}
+	req1 := &corelogger.LogRecord{Message: "handling request-id=def456"}
+	other := &corelogger.LogRecord{Message: "something else"}
+	logFile := filepath.Join(c.MkDir(), "logs.log")
+	writeLogs := func() string {
+		s.writeLogs(c, logFile, 1, req0)
+		s.writeLogs(c, logFile, 1, other)
+		s.writeLogs(c, logFile, 1, req1)
+		return logFile
+	}
+	params := logtailer.LogTailerParams{
+		IncludeMessage: []string{"request-id"},
+		ExcludeMessage: []string{"def"},
+	}
+	assert := func(tailer logtailer.LogTailer) {
+		s.assertTailer(c, tailer, req0)
+	}
+	s.checkLogTailerFiltering(c, params, writeLogs, assert)
+}
+
+func (s *LogFilterSuite) TestInvalidMessagePattern(c *tc.C) {
+	logFile := filepath.Join(c.MkDir(), "logs.log")
+	_, err := logtailer.NewLogTailer(coretesting.ModelTag.Id(), logFile, logtailer.LogTailerParams{
+		ExcludeMessage: []string{"("},
+	})
+	c.Assert(err, tc.ErrorIs, errors.NotValid)
+	c.Assert(err, tc.ErrorMatches, `exclude message: pattern "\(": .*`)
+}
+
 func (s *LogFilterSuite) checkLogTailerFiltering(
 	c *tc.C,
 	params logtailer.LogTailerParams,
@@ -0,0 +1,114 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package dns publishes names for the applications and units of a model.
+//
+// Names are published under a domain, "juju" by default, as:
+//
+//	<unit-number>.<application>.<model>.<domain>
+//	<application>.<model>.<domain>
+//
+// A unit name resolves to the unit's addresses in the space that its
+// application's default endpoint binding is bound to. An application name
+// resolves to the addresses of all of its units in that space.
+//
+// Names can be served by a DNS responder hosted by the controller, see
+// [Server], or pushed to an external DNS server using RFC 2136 dynamic
+// updates, see [Updater].
+package dns
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	domainnetwork "github.com/juju/juju/domain/network"
+	"github.com/juju/juju/internal/errors"
+)
+
+// DefaultDomain is the domain under which names are published when no
+// other domain is configured.
+const DefaultDomain = "juju"
+
+// TTL is the time to live, in seconds, of the published records.
+const TTL = 30
+
+// Record is a published name and the addresses it resolves to.
+type Record struct {
+	// Name is the fully qualified name, with a trailing dot.
+	Name string
+
+	// Addresses are the IP addresses the name resolves to.
+	Addresses []net.IP
+}
+
+// ModelRecords returns the records for the applications and units of the
+// named model, published under the input domain.
+func ModelRecords(domain, modelName string, apps []domainnetwork.ApplicationAddresses) []Record {
+	modelFQDN := Name(modelName, domain)
+
+	var records []Record
+	for _, app := range apps {
+		appRecord := Record{
+			Name: Name(app.ApplicationName, modelFQDN),
+		}
+		seen := make(map[string]bool)
+		for _, unit := range app.Units {
+			number := unit.UnitName.Number()
+			if number < 0 {
+				continue
+			}
+			unitRecord := Record{
+				Name: Name(strconv.Itoa(number), appRecord.Name),
+			}
+			for _, addr := range unit.Addresses {
+				ip := net.ParseIP(addr)
+				if ip == nil {
+					continue
+				}
+				unitRecord.Addresses = append(unitRecord.Addresses, ip)
+				if !seen[ip.String()] {
+					seen[ip.String()] = true
+					appRecord.Addresses = append(appRecord.Addresses, ip)
+				}
+			}
+			if len(unitRecord.Addresses) > 0 {
+				records = append(records, unitRecord)
+			}
+		}
+		if len(appRecord.Addresses) > 0 {
+			records = append(records, appRecord)
+		}
+	}
+	return records
+}
+
+// Name returns the fully qualified, lower case, name made up of the input
+// labels, with a trailing dot. Labels may themselves be dotted names.
+func Name(labels ...string) string {
+	parts := make([]string, 0, len(labels))
+	for _, label := range labels {
+		label = strings.Trim(label, ".")
+		if label != "" {
+			parts = append(parts, strings.ToLower(label))
+		}
+	}
+	return strings.Join(parts, ".") + "."
+}
+
+// ValidateName returns an error satisfying [coreerrors.NotValid] if the
+// input is not a valid DNS name.
+func ValidateName(name string) error {
+	if _, err := wireName(name); err != nil {
+		return errors.Errorf("%w", err).Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// InDomain reports whether the fully qualified name is the domain itself or
+// a name within it.
+func InDomain(name, domain string) bool {
+	name, domain = Name(name), Name(domain)
+	return name == domain || strings.HasSuffix(name, "."+domain)
+}
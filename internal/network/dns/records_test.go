@@ -0,0 +1,90 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dns_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/unit"
+	domainnetwork "github.com/juju/juju/domain/network"
+	"github.com/juju/juju/internal/network/dns"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type recordsSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestRecordsSuite(t *testing.T) {
+	tc.Run(t, &recordsSuite{})
+}
+
+func (*recordsSuite) TestModelRecords(c *tc.C) {
+	records := dns.ModelRecords("juju", "prod", []domainnetwork.ApplicationAddresses{{
+		ApplicationName: "mysql",
+		Units: []domainnetwork.UnitAddresses{{
+			UnitName:  unit.Name("mysql/0"),
+			Addresses: []string{"10.0.0.10", "2001:db8::10"},
+		}, {
+			UnitName:  unit.Name("mysql/1"),
+			Addresses: []string{"10.0.0.11"},
+		}},
+	}, {
+		ApplicationName: "wordpress",
+		Units: []domainnetwork.UnitAddresses{{
+			UnitName:  unit.Name("wordpress/3"),
+			Addresses: []string{"10.0.0.20", "not-an-ip"},
+		}},
+	}})
+
+	c.Check(records, tc.DeepEquals, []dns.Record{{
+		Name:      "0.mysql.prod.juju.",
+		Addresses: []net.IP{net.ParseIP("10.0.0.10"), net.ParseIP("2001:db8::10")},
+	}, {
+		Name:      "1.mysql.prod.juju.",
+		Addresses: []net.IP{net.ParseIP("10.0.0.11")},
+	}, {
+		Name:      "mysql.prod.juju.",
+		Addresses: []net.IP{net.ParseIP("10.0.0.10"), net.ParseIP("2001:db8::10"), net.ParseIP("10.0.0.11")},
+	}, {
+		Name:      "3.wordpress.prod.juju.",
+		Addresses: []net.IP{net.ParseIP("10.0.0.20")},
+	}, {
+		Name:      "wordpress.prod.juju.",
+		Addresses: []net.IP{net.ParseIP("10.0.0.20")},
+	}})
+}
+
+func (*recordsSuite) TestModelRecordsNoAddresses(c *tc.C) {
+	records := dns.ModelRecords("juju", "prod", []domainnetwork.ApplicationAddresses{{
+		ApplicationName: "mysql",
+		Units: []domainnetwork.UnitAddresses{{
+			UnitName: unit.Name("mysql/0"),
+		}},
+	}})
+	c.Check(records, tc.HasLen, 0)
+}
+
+func (*recordsSuite) TestName(c *tc.C) {
+	c.Check(dns.Name("MySQL", "prod.juju."), tc.Equals, "mysql.prod.juju.")
+	c.Check(dns.Name("", "example.com"), tc.Equals, "example.com.")
+}
+
+func (*recordsSuite) TestInDomain(c *tc.C) {
+	c.Check(dns.InDomain("mysql.prod.juju.", "juju"), tc.IsTrue)
+	c.Check(dns.InDomain("juju.", "juju."), tc.IsTrue)
+	c.Check(dns.InDomain("notjuju.", "juju"), tc.IsFalse)
+	c.Check(dns.InDomain("example.com.", "juju"), tc.IsFalse)
+}
+
+func (*recordsSuite) TestValidateName(c *tc.C) {
+	c.Check(dns.ValidateName("example.com."), tc.ErrorIsNil)
+	c.Check(dns.ValidateName("bad..name"), tc.ErrorIs, coreerrors.NotValid)
+	c.Check(dns.ValidateName(strings.Repeat("a", 64)+".com"), tc.ErrorIs, coreerrors.NotValid)
+}
@@ -0,0 +1,268 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// maxUDPSize is the largest response sent over UDP. Larger responses
+	// are truncated, so that the client retries over TCP.
+	maxUDPSize = 512
+
+	// maxTCPSize is the largest response sent over TCP, limited by the size
+	// of the length prefix.
+	maxTCPSize = 65535
+
+	// tcpIdleTimeout is how long a TCP connection is kept open waiting for
+	// the next query.
+	tcpIdleTimeout = 10 * time.Second
+)
+
+// Resolver looks up the addresses of published names.
+type Resolver interface {
+	// Lookup returns the addresses that the fully qualified, lower case,
+	// name resolves to. If the name is not published, false is returned.
+	Lookup(ctx context.Context, name string) ([]net.IP, bool, error)
+}
+
+// Server answers DNS queries for the names within a domain, using a
+// Resolver to look up the addresses of names. It is authoritative for the
+// domain and refuses queries for names outside of it.
+type Server struct {
+	domain   string
+	resolver Resolver
+	logger   logger.Logger
+}
+
+// NewServer returns a new Server answering queries for names within the
+// input domain.
+func NewServer(domain string, resolver Resolver, logger logger.Logger) *Server {
+	return &Server{
+		domain:   Name(domain),
+		resolver: resolver,
+		logger:   logger,
+	}
+}
+
+// ServePacketConn answers the queries received on the packet connection
+// until the connection is closed.
+func (s *Server) ServePacketConn(ctx context.Context, conn net.PacketConn) error {
+	buf := make([]byte, maxTCPSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		resp, err := s.Handle(ctx, buf[:n], maxUDPSize)
+		if err != nil {
+			s.logger.Debugf(ctx, "handling query from %v: %v", addr, err)
+			continue
+		}
+		if _, err := conn.WriteTo(resp, addr); err != nil {
+			s.logger.Debugf(ctx, "writing response to %v: %v", addr, err)
+		}
+	}
+}
+
+// ServeListener answers the queries received on connections accepted by
+// the listener until the listener is closed. Each message is preceded by
+// its length, as described in RFC 1035 section 4.2.2. Open connections are
+// closed when the context is done.
+func (s *Server) ServeListener(ctx context.Context, l net.Listener) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		conn, err := l.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+			defer stop()
+			defer func() { _ = conn.Close() }()
+			if err := s.serveConn(ctx, conn); err != nil {
+				s.logger.Debugf(ctx, "serving %v: %v", conn.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+func (s *Server) serveConn(ctx context.Context, conn net.Conn) error {
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(tcpIdleTimeout)); err != nil {
+			return errors.Capture(err)
+		}
+		req, err := readMessage(conn)
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		resp, err := s.Handle(ctx, req, maxTCPSize)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if err := writeMessage(conn, resp); err != nil {
+			return errors.Capture(err)
+		}
+	}
+}
+
+// Handle returns the response to the input query message. Responses larger
+// than maxSize have their answers removed and are marked as truncated.
+func (s *Server) Handle(ctx context.Context, req []byte, maxSize int) ([]byte, error) {
+	var p dnsmessage.Parser
+	reqHeader, err := p.Start(req)
+	if err != nil {
+		return nil, errors.Errorf("parsing query: %w", err)
+	}
+	if reqHeader.Response {
+		return nil, errors.Errorf("message is not a query")
+	}
+	questions, err := p.AllQuestions()
+	if err != nil {
+		return s.response(reqHeader, nil, dnsmessage.RCodeFormatError, nil, maxSize)
+	}
+	if reqHeader.OpCode != 0 {
+		return s.response(reqHeader, questions, dnsmessage.RCodeNotImplemented, nil, maxSize)
+	}
+	if len(questions) != 1 {
+		return s.response(reqHeader, questions, dnsmessage.RCodeFormatError, nil, maxSize)
+	}
+
+	q := questions[0]
+	name := strings.ToLower(q.Name.String())
+	if !InDomain(name, s.domain) {
+		return s.response(reqHeader, questions, dnsmessage.RCodeRefused, nil, maxSize)
+	}
+
+	addrs, found, err := s.resolver.Lookup(ctx, name)
+	if err != nil {
+		s.logger.Warningf(ctx, "looking up %q: %v", name, err)
+		return s.response(reqHeader, questions, dnsmessage.RCodeServerFailure, nil, maxSize)
+	}
+	if !found {
+		return s.response(reqHeader, questions, dnsmessage.RCodeNameError, nil, maxSize)
+	}
+
+	var answers []net.IP
+	for _, addr := range addrs {
+		isV4 := addr.To4() != nil
+		switch q.Type {
+		case dnsmessage.TypeA:
+			if isV4 {
+				answers = append(answers, addr)
+			}
+		case dnsmessage.TypeAAAA:
+			if !isV4 {
+				answers = append(answers, addr)
+			}
+		case dnsmessage.TypeALL:
+			answers = append(answers, addr)
+		}
+	}
+	return s.response(reqHeader, questions, dnsmessage.RCodeSuccess, answers, maxSize)
+}
+
+func (s *Server) response(
+	reqHeader dnsmessage.Header, questions []dnsmessage.Question, rcode dnsmessage.RCode, answers []net.IP, maxSize int,
+) ([]byte, error) {
+	header := dnsmessage.Header{
+		ID:               reqHeader.ID,
+		Response:         true,
+		OpCode:           reqHeader.OpCode,
+		Authoritative:    rcode == dnsmessage.RCodeSuccess || rcode == dnsmessage.RCodeNameError,
+		RecursionDesired: reqHeader.RecursionDesired,
+		RCode:            rcode,
+	}
+	resp, err := buildResponse(header, questions, answers)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if len(resp) <= maxSize {
+		return resp, nil
+	}
+	header.Truncated = true
+	return buildResponse(header, questions, nil)
+}
+
+func buildResponse(header dnsmessage.Header, questions []dnsmessage.Question, answers []net.IP) ([]byte, error) {
+	b := dnsmessage.NewBuilder(nil, header)
+	b.EnableCompression()
+	if err := b.StartQuestions(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	for _, q := range questions {
+		if err := b.Question(q); err != nil {
+			return nil, errors.Capture(err)
+		}
+	}
+	if err := b.StartAnswers(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	for _, addr := range answers {
+		if err := addAddressResource(&b, questions[0].Name, dnsmessage.ClassINET, TTL, addr); err != nil {
+			return nil, errors.Capture(err)
+		}
+	}
+	return b.Finish()
+}
+
+// addAddressResource adds an A or AAAA resource, depending on the type of
+// the input address, to the current section of the message being built.
+func addAddressResource(b *dnsmessage.Builder, name dnsmessage.Name, class dnsmessage.Class, ttl uint32, addr net.IP) error {
+	header := dnsmessage.ResourceHeader{
+		Name:  name,
+		Class: class,
+		TTL:   ttl,
+	}
+	if v4 := addr.To4(); v4 != nil {
+		var r dnsmessage.AResource
+		copy(r.A[:], v4)
+		return b.AResource(header, r)
+	}
+	var r dnsmessage.AAAAResource
+	copy(r.AAAA[:], addr.To16())
+	return b.AAAAResource(header, r)
+}
+
+// readMessage reads a length-prefixed DNS message from a stream.
+func readMessage(r io.Reader) ([]byte, error) {
+	var length uint16
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	msg := make([]byte, length)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return msg, nil
+}
+
+// writeMessage writes a length-prefixed DNS message to a stream.
+func writeMessage(w io.Writer, msg []byte) error {
+	buf := make([]byte, 2, 2+len(msg))
+	binary.BigEndian.PutUint16(buf, uint16(len(msg)))
+	_, err := w.Write(append(buf, msg...))
+	return errors.Capture(err)
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dns_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/network/dns"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type serverSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestServerSuite(t *testing.T) {
+	tc.Run(t, &serverSuite{})
+}
+
+type fakeResolver map[string][]net.IP
+
+func (r fakeResolver) Lookup(_ context.Context, name string) ([]net.IP, bool, error) {
+	if name == "broken.prod.juju." {
+		return nil, false, errors.New("boom")
+	}
+	addrs, ok := r[name]
+	return addrs, ok, nil
+}
+
+func (s *serverSuite) server(c *tc.C) *dns.Server {
+	return dns.NewServer("juju", fakeResolver{
+		"mysql.prod.juju.": {net.ParseIP("10.0.0.10"), net.ParseIP("2001:db8::10")},
+	}, loggertesting.WrapCheckLog(c))
+}
+
+func query(c *tc.C, name string, qType dnsmessage.Type) []byte {
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: 42, RecursionDesired: true})
+	c.Assert(b.StartQuestions(), tc.ErrorIsNil)
+	c.Assert(b.Question(dnsmessage.Question{
+		Name:  dnsmessage.MustNewName(name),
+		Type:  qType,
+		Class: dnsmessage.ClassINET,
+	}), tc.ErrorIsNil)
+	msg, err := b.Finish()
+	c.Assert(err, tc.ErrorIsNil)
+	return msg
+}
+
+func parseResponse(c *tc.C, resp []byte) (dnsmessage.Header, []dnsmessage.Resource) {
+	var p dnsmessage.Parser
+	header, err := p.Start(resp)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(p.SkipAllQuestions(), tc.ErrorIsNil)
+	answers, err := p.AllAnswers()
+	c.Assert(err, tc.ErrorIsNil)
+	return header, answers
+}
+
+func (s *serverSuite) TestHandleA(c *tc.C) {
+	resp, err := s.server(c).Handle(c.Context(), query(c, "MySQL.prod.juju.", dnsmessage.TypeA), 512)
+	c.Assert(err, tc.ErrorIsNil)
+
+	header, answers := parseResponse(c, resp)
+	c.Check(header.ID, tc.Equals, uint16(42))
+	c.Check(header.Response, tc.IsTrue)
+	c.Check(header.Authoritative, tc.IsTrue)
+	c.Check(header.RCode, tc.Equals, dnsmessage.RCodeSuccess)
+	c.Assert(answers, tc.HasLen, 1)
+	c.Check(answers[0].Header.TTL, tc.Equals, uint32(dns.TTL))
+	c.Check(answers[0].Body, tc.DeepEquals, &dnsmessage.AResource{A: [4]byte{10, 0, 0, 10}})
+}
+
+func (s *serverSuite) TestHandleAAAA(c *tc.C) {
+	resp, err := s.server(c).Handle(c.Context(), query(c, "mysql.prod.juju.", dnsmessage.TypeAAAA), 512)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, answers := parseResponse(c, resp)
+	c.Assert(answers, tc.HasLen, 1)
+	var expected [16]byte
+	copy(expected[:], net.ParseIP("2001:db8::10"))
+	c.Check(answers[0].Body, tc.DeepEquals, &dnsmessage.AAAAResource{AAAA: expected})
+}
+
+func (s *serverSuite) TestHandleOtherType(c *tc.C) {
+	resp, err := s.server(c).Handle(c.Context(), query(c, "mysql.prod.juju.", dnsmessage.TypeMX), 512)
+	c.Assert(err, tc.ErrorIsNil)
+
+	header, answers := parseResponse(c, resp)
+	c.Check(header.RCode, tc.Equals, dnsmessage.RCodeSuccess)
+	c.Check(answers, tc.HasLen, 0)
+}
+
+func (s *serverSuite) TestHandleNotFound(c *tc.C) {
+	resp, err := s.server(c).Handle(c.Context(), query(c, "wordpress.prod.juju.", dnsmessage.TypeA), 512)
+	c.Assert(err, tc.ErrorIsNil)
+
+	header, answers := parseResponse(c, resp)
+	c.Check(header.RCode, tc.Equals, dnsmessage.RCodeNameError)
+	c.Check(header.Authoritative, tc.IsTrue)
+	c.Check(answers, tc.HasLen, 0)
+}
+
+func (s *serverSuite) TestHandleOutsideDomain(c *tc.C) {
+	resp, err := s.server(c).Handle(c.Context(), query(c, "example.com.", dnsmessage.TypeA), 512)
+	c.Assert(err, tc.ErrorIsNil)
+
+	header, _ := parseResponse(c, resp)
+	c.Check(header.RCode, tc.Equals, dnsmessage.RCodeRefused)
+	c.Check(header.Authoritative, tc.IsFalse)
+}
+
+func (s *serverSuite) TestHandleLookupError(c *tc.C) {
+	resp, err := s.server(c).Handle(c.Context(), query(c, "broken.prod.juju.", dnsmessage.TypeA), 512)
+	c.Assert(err, tc.ErrorIsNil)
+
+	header, _ := parseResponse(c, resp)
+	c.Check(header.RCode, tc.Equals, dnsmessage.RCodeServerFailure)
+}
+
+func (s *serverSuite) TestHandleTruncated(c *tc.C) {
+	resp, err := s.server(c).Handle(c.Context(), query(c, "mysql.prod.juju.", dnsmessage.TypeALL), 64)
+	c.Assert(err, tc.ErrorIsNil)
+
+	header, answers := parseResponse(c, resp)
+	c.Check(header.Truncated, tc.IsTrue)
+	c.Check(answers, tc.HasLen, 0)
+}
+
+func (s *serverSuite) TestServePacketConn(c *tc.C) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.server(c).ServePacketConn(c.Context(), conn)
+	}()
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	c.Assert(err, tc.ErrorIsNil)
+	defer client.Close()
+	_, err = client.Write(query(c, "mysql.prod.juju.", dnsmessage.TypeA))
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(client.SetReadDeadline(time.Now().Add(testhelpers.LongWait)), tc.ErrorIsNil)
+	buf := make([]byte, 512)
+	n, err := client.Read(buf)
+	c.Assert(err, tc.ErrorIsNil)
+	_, answers := parseResponse(c, buf[:n])
+	c.Check(answers, tc.HasLen, 1)
+
+	c.Assert(conn.Close(), tc.ErrorIsNil)
+	c.Check(<-done, tc.ErrorIsNil)
+}
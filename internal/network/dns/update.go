@@ -0,0 +1,361 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dns
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"hash"
+	"math/rand/v2"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"golang.org/x/net/dns/dnsmessage"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// opCodeUpdate is the operation code of dynamic update messages.
+	opCodeUpdate dnsmessage.OpCode = 5
+
+	// classNone is used in the update section to delete a single record.
+	classNone dnsmessage.Class = 254
+
+	// typeTSIG is the type of the transaction signature record.
+	typeTSIG dnsmessage.Type = 250
+
+	// tsigFudge is the number of seconds of clock skew permitted between
+	// the signer and the server.
+	tsigFudge = 300
+
+	// maxUpdateNames is the maximum number of names changed by a single
+	// update message, keeping messages well within the maximum size.
+	maxUpdateNames = 100
+
+	// updateTimeout is how long to wait for the server to respond to an
+	// update.
+	updateTimeout = 30 * time.Second
+)
+
+// tsigAlgorithms maps the names of the supported TSIG algorithms to their
+// hash functions.
+var tsigAlgorithms = map[string]func() hash.Hash{
+	"hmac-sha1":   sha1.New,
+	"hmac-sha256": sha256.New,
+	"hmac-sha512": sha512.New,
+}
+
+// TSIGKey is a shared secret used to sign dynamic updates, as described in
+// RFC 8945.
+type TSIGKey struct {
+	// Name is the name of the key.
+	Name string
+
+	// Algorithm is the name of the HMAC algorithm: hmac-sha1, hmac-sha256
+	// or hmac-sha512.
+	Algorithm string
+
+	// Secret is the shared secret.
+	Secret []byte
+}
+
+// NewTSIGKey returns the TSIG key with the given algorithm, name and base64
+// encoded secret, as generated by tsig-keygen. The algorithm defaults to
+// hmac-sha256.
+func NewTSIGKey(algorithm, name, secret string) (TSIGKey, error) {
+	key := TSIGKey{
+		Name:      name,
+		Algorithm: strings.ToLower(algorithm),
+	}
+	if key.Algorithm == "" {
+		key.Algorithm = "hmac-sha256"
+	}
+	if _, ok := tsigAlgorithms[key.Algorithm]; !ok {
+		return TSIGKey{}, errors.Errorf("TSIG algorithm %q not supported", key.Algorithm).Add(coreerrors.NotValid)
+	}
+	if key.Name == "" {
+		return TSIGKey{}, errors.Errorf("empty TSIG key name").Add(coreerrors.NotValid)
+	}
+	var err error
+	if key.Secret, err = base64.StdEncoding.DecodeString(secret); err != nil {
+		return TSIGKey{}, errors.Errorf("decoding TSIG key secret: %w", err).Add(coreerrors.NotValid)
+	}
+	if len(key.Secret) == 0 {
+		return TSIGKey{}, errors.Errorf("empty TSIG key secret").Add(coreerrors.NotValid)
+	}
+	return key, nil
+}
+
+// sign returns the input message with a transaction signature record,
+// signed at the input time, appended to its additional section.
+func (k TSIGKey) sign(msg []byte, now time.Time) ([]byte, error) {
+	newHash, ok := tsigAlgorithms[k.Algorithm]
+	if !ok {
+		return nil, errors.Errorf("TSIG algorithm %q not supported", k.Algorithm)
+	}
+	keyName, err := wireName(k.Name)
+	if err != nil {
+		return nil, errors.Errorf("packing TSIG key name: %w", err)
+	}
+	algName, _ := wireName(k.Algorithm)
+	timeSigned := uint64(now.Unix())
+
+	// The MAC covers the message followed by the TSIG variables, as
+	// described in RFC 8945 section 4.3.3.
+	var vars []byte
+	vars = append(vars, keyName...)
+	vars = binary.BigEndian.AppendUint16(vars, uint16(dnsmessage.ClassANY))
+	vars = binary.BigEndian.AppendUint32(vars, 0)
+	vars = append(vars, algName...)
+	vars = appendUint48(vars, timeSigned)
+	vars = binary.BigEndian.AppendUint16(vars, tsigFudge)
+	// Error and other data length.
+	vars = binary.BigEndian.AppendUint16(vars, 0)
+	vars = binary.BigEndian.AppendUint16(vars, 0)
+
+	mac := hmac.New(newHash, k.Secret)
+	mac.Write(msg)
+	mac.Write(vars)
+	sum := mac.Sum(nil)
+
+	var rdata []byte
+	rdata = append(rdata, algName...)
+	rdata = appendUint48(rdata, timeSigned)
+	rdata = binary.BigEndian.AppendUint16(rdata, tsigFudge)
+	rdata = binary.BigEndian.AppendUint16(rdata, uint16(len(sum)))
+	rdata = append(rdata, sum...)
+	// Original ID, error and other data length.
+	rdata = append(rdata, msg[0:2]...)
+	rdata = binary.BigEndian.AppendUint16(rdata, 0)
+	rdata = binary.BigEndian.AppendUint16(rdata, 0)
+
+	signed := make([]byte, len(msg), len(msg)+len(keyName)+10+len(rdata))
+	copy(signed, msg)
+	signed = append(signed, keyName...)
+	signed = binary.BigEndian.AppendUint16(signed, uint16(typeTSIG))
+	signed = binary.BigEndian.AppendUint16(signed, uint16(dnsmessage.ClassANY))
+	signed = binary.BigEndian.AppendUint32(signed, 0)
+	signed = binary.BigEndian.AppendUint16(signed, uint16(len(rdata)))
+	signed = append(signed, rdata...)
+
+	// Account for the signature in the additional records count.
+	arCount := binary.BigEndian.Uint16(signed[10:12])
+	binary.BigEndian.PutUint16(signed[10:12], arCount+1)
+	return signed, nil
+}
+
+// Updater publishes records to a zone on an external DNS server using
+// dynamic updates, as described in RFC 2136. Updates are sent over TCP and
+// signed with a TSIG key, if one is supplied. Signatures on the server's
+// responses are not verified.
+type Updater struct {
+	server string
+	zone   string
+	key    *TSIGKey
+	clock  clock.Clock
+}
+
+// NewUpdater returns a new Updater sending updates for the zone to the
+// server, given as host:port. The port defaults to 53. The key may be nil
+// if the server accepts unsigned updates.
+func NewUpdater(server, zone string, key *TSIGKey, clock clock.Clock) *Updater {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(strings.Trim(server, "[]"), "53")
+	}
+	return &Updater{
+		server: server,
+		zone:   Name(zone),
+		key:    key,
+		clock:  clock,
+	}
+}
+
+// Update replaces the addresses of the names of the input records with the
+// records' addresses, and removes all records of the names to be removed.
+// All names must be within the updater's zone.
+func (u *Updater) Update(ctx context.Context, set []Record, remove []string) error {
+	for len(set) > 0 || len(remove) > 0 {
+		batchSet := set[:min(len(set), maxUpdateNames)]
+		set = set[len(batchSet):]
+		batchRemove := remove[:min(len(remove), maxUpdateNames-len(batchSet))]
+		remove = remove[len(batchRemove):]
+
+		msg, err := updateMessage(rand.N[uint16](65535), u.zone, batchSet, batchRemove)
+		if err != nil {
+			return errors.Errorf("building update: %w", err)
+		}
+		if u.key != nil {
+			if msg, err = u.key.sign(msg, u.clock.Now()); err != nil {
+				return errors.Errorf("signing update: %w", err)
+			}
+		}
+		if err := u.exchange(ctx, msg); err != nil {
+			return errors.Errorf("updating zone %q on %s: %w", u.zone, u.server, err)
+		}
+	}
+	return nil
+}
+
+// exchange sends the update message to the server and checks its response.
+func (u *Updater) exchange(ctx context.Context, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", u.server)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = conn.Close() }()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	if err := writeMessage(conn, msg); err != nil {
+		return errors.Errorf("sending update: %w", err)
+	}
+	resp, err := readMessage(conn)
+	if err != nil {
+		return errors.Errorf("reading response: %w", err)
+	}
+
+	var p dnsmessage.Parser
+	header, err := p.Start(resp)
+	if err != nil {
+		return errors.Errorf("parsing response: %w", err)
+	}
+	if header.ID != binary.BigEndian.Uint16(msg[0:2]) {
+		return errors.Errorf("response ID %d does not match update", header.ID)
+	}
+	if header.RCode != dnsmessage.RCodeSuccess {
+		return errors.Errorf("update rejected: %s", rcodeName(header.RCode))
+	}
+	return nil
+}
+
+// updateMessage builds an update message for the zone. Each record replaces
+// the A and AAAA records of its name, and all records of the names to be
+// removed are deleted.
+func updateMessage(id uint16, zone string, set []Record, remove []string) ([]byte, error) {
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{
+		ID:     id,
+		OpCode: opCodeUpdate,
+	})
+	b.EnableCompression()
+
+	// The zone section uses the layout of the question section.
+	zoneName, err := dnsmessage.NewName(zone)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := b.StartQuestions(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := b.Question(dnsmessage.Question{
+		Name:  zoneName,
+		Type:  dnsmessage.TypeSOA,
+		Class: dnsmessage.ClassINET,
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	// There are no prerequisites, so the answer section is empty, and the
+	// updates make up the authority section.
+	if err := b.StartAnswers(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := b.StartAuthorities(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	for _, record := range set {
+		name, err := dnsmessage.NewName(Name(record.Name))
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		for _, rrType := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+			if err := deleteRRSet(&b, name, rrType); err != nil {
+				return nil, errors.Capture(err)
+			}
+		}
+		for _, addr := range record.Addresses {
+			if err := addAddressResource(&b, name, dnsmessage.ClassINET, TTL, addr); err != nil {
+				return nil, errors.Capture(err)
+			}
+		}
+	}
+	for _, n := range remove {
+		name, err := dnsmessage.NewName(Name(n))
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		if err := deleteRRSet(&b, name, dnsmessage.TypeALL); err != nil {
+			return nil, errors.Capture(err)
+		}
+	}
+	return b.Finish()
+}
+
+// deleteRRSet adds an update deleting the records of the input type for the
+// name, or all of its records for TypeALL.
+func deleteRRSet(b *dnsmessage.Builder, name dnsmessage.Name, rrType dnsmessage.Type) error {
+	return b.UnknownResource(dnsmessage.ResourceHeader{
+		Name:  name,
+		Class: dnsmessage.ClassANY,
+	}, dnsmessage.UnknownResource{
+		Type: rrType,
+	})
+}
+
+// wireName returns the uncompressed wire format of the lower case name.
+func wireName(name string) ([]byte, error) {
+	name = strings.ToLower(strings.Trim(name, "."))
+	var out []byte
+	if name != "" {
+		for _, label := range strings.Split(name, ".") {
+			if len(label) == 0 || len(label) > 63 {
+				return nil, errors.Errorf("invalid label %q in name %q", label, name)
+			}
+			out = append(out, byte(len(label)))
+			out = append(out, label...)
+		}
+	}
+	out = append(out, 0)
+	if len(out) > 255 {
+		return nil, errors.Errorf("name %q too long", name)
+	}
+	return out, nil
+}
+
+func appendUint48(b []byte, v uint64) []byte {
+	return append(b, byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// rcodeName returns the mnemonic of the response code, including those
+// specific to dynamic updates.
+func rcodeName(rcode dnsmessage.RCode) string {
+	switch rcode {
+	case 6:
+		return "YXDomain"
+	case 7:
+		return "YXRRSet"
+	case 8:
+		return "NXRRSet"
+	case 9:
+		return "NotAuth"
+	case 10:
+		return "NotZone"
+	}
+	return strings.TrimPrefix(rcode.String(), "RCode")
+}
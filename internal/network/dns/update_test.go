@@ -0,0 +1,224 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dns
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"golang.org/x/net/dns/dnsmessage"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type updateSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestUpdateSuite(t *testing.T) {
+	tc.Run(t, &updateSuite{})
+}
+
+func (*updateSuite) TestNewTSIGKey(c *tc.C) {
+	key, err := NewTSIGKey("", "juju-key", "c2VjcmV0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, TSIGKey{
+		Name:      "juju-key",
+		Algorithm: "hmac-sha256",
+		Secret:    []byte("secret"),
+	})
+
+	key, err = NewTSIGKey("HMAC-SHA512", "juju-key", "c2VjcmV0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key.Algorithm, tc.Equals, "hmac-sha512")
+}
+
+func (*updateSuite) TestNewTSIGKeyInvalid(c *tc.C) {
+	for _, args := range [][3]string{
+		{"hmac-md5", "juju-key", "c2VjcmV0"},
+		{"", "", "c2VjcmV0"},
+		{"", "juju-key", "not base64"},
+		{"", "juju-key", ""},
+	} {
+		_, err := NewTSIGKey(args[0], args[1], args[2])
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid, tc.Commentf("key %q", args))
+	}
+}
+
+func (*updateSuite) TestUpdateMessage(c *tc.C) {
+	msg, err := updateMessage(42, "juju.", []Record{{
+		Name:      "0.mysql.prod.juju.",
+		Addresses: []net.IP{net.ParseIP("10.0.0.10"), net.ParseIP("2001:db8::10")},
+	}}, []string{"1.mysql.prod.juju."})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var p dnsmessage.Parser
+	header, err := p.Start(msg)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(header.ID, tc.Equals, uint16(42))
+	c.Check(header.OpCode, tc.Equals, opCodeUpdate)
+
+	zone, err := p.AllQuestions()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(zone, tc.DeepEquals, []dnsmessage.Question{{
+		Name:  dnsmessage.MustNewName("juju."),
+		Type:  dnsmessage.TypeSOA,
+		Class: dnsmessage.ClassINET,
+	}})
+	c.Assert(p.SkipAllAnswers(), tc.ErrorIsNil)
+
+	type update struct {
+		name  string
+		class dnsmessage.Class
+		typ   dnsmessage.Type
+	}
+	var updates []update
+	for {
+		h, err := p.AuthorityHeader()
+		if err == dnsmessage.ErrSectionDone {
+			break
+		}
+		c.Assert(err, tc.ErrorIsNil)
+		updates = append(updates, update{name: h.Name.String(), class: h.Class, typ: h.Type})
+		c.Assert(p.SkipAuthority(), tc.ErrorIsNil)
+	}
+	c.Check(updates, tc.DeepEquals, []update{
+		{name: "0.mysql.prod.juju.", class: dnsmessage.ClassANY, typ: dnsmessage.TypeA},
+		{name: "0.mysql.prod.juju.", class: dnsmessage.ClassANY, typ: dnsmessage.TypeAAAA},
+		{name: "0.mysql.prod.juju.", class: dnsmessage.ClassINET, typ: dnsmessage.TypeA},
+		{name: "0.mysql.prod.juju.", class: dnsmessage.ClassINET, typ: dnsmessage.TypeAAAA},
+		{name: "1.mysql.prod.juju.", class: dnsmessage.ClassANY, typ: dnsmessage.TypeALL},
+	})
+}
+
+func (*updateSuite) TestSign(c *tc.C) {
+	msg, err := updateMessage(42, "juju.", nil, []string{"0.mysql.prod.juju."})
+	c.Assert(err, tc.ErrorIsNil)
+
+	key := TSIGKey{Name: "juju-key", Algorithm: "hmac-sha256", Secret: []byte("secret")}
+	now := time.Unix(1700000000, 0)
+	signed, err := key.sign(msg, now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(signed[:10], tc.DeepEquals, msg[:10])
+	c.Check(binary.BigEndian.Uint16(signed[10:12]), tc.Equals, uint16(1))
+
+	var p dnsmessage.Parser
+	_, err = p.Start(signed)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(p.SkipAllQuestions(), tc.ErrorIsNil)
+	c.Assert(p.SkipAllAnswers(), tc.ErrorIsNil)
+	c.Assert(p.SkipAllAuthorities(), tc.ErrorIsNil)
+	additional, err := p.Additional()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(additional.Header.Name.String(), tc.Equals, "juju-key.")
+	c.Check(additional.Header.Type, tc.Equals, typeTSIG)
+	c.Check(additional.Header.Class, tc.Equals, dnsmessage.ClassANY)
+
+	// Recompute the MAC over the unsigned message and TSIG variables.
+	keyName, _ := wireName("juju-key")
+	algName, _ := wireName("hmac-sha256")
+	var vars []byte
+	vars = append(vars, keyName...)
+	vars = append(vars, 0, 255, 0, 0, 0, 0)
+	vars = append(vars, algName...)
+	vars = appendUint48(vars, uint64(now.Unix()))
+	vars = append(vars, 1, 44, 0, 0, 0, 0)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(msg)
+	mac.Write(vars)
+
+	rdata := additional.Body.(*dnsmessage.UnknownResource).Data
+	macOff := len(algName) + 6 + 2 + 2
+	c.Check(binary.BigEndian.Uint16(rdata[macOff-2:macOff]), tc.Equals, uint16(sha256.Size))
+	c.Check(rdata[macOff:macOff+sha256.Size], tc.DeepEquals, mac.Sum(nil))
+	c.Check(rdata[macOff+sha256.Size:macOff+sha256.Size+2], tc.DeepEquals, msg[0:2])
+}
+
+func (*updateSuite) TestUpdate(c *tc.C) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	defer l.Close()
+
+	received := make(chan []byte, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		msg, err := readMessage(conn)
+		if err != nil {
+			return
+		}
+		received <- msg
+
+		var p dnsmessage.Parser
+		header, _ := p.Start(msg)
+		b := dnsmessage.NewBuilder(nil, dnsmessage.Header{
+			ID: header.ID, Response: true, OpCode: opCodeUpdate,
+		})
+		resp, _ := b.Finish()
+		_ = writeMessage(conn, resp)
+	}()
+
+	updater := NewUpdater(l.Addr().String(), "juju", nil, testclock.NewClock(time.Now()))
+	err = updater.Update(c.Context(), []Record{{
+		Name:      "0.mysql.prod.juju.",
+		Addresses: []net.IP{net.ParseIP("10.0.0.10")},
+	}}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	select {
+	case msg := <-received:
+		var p dnsmessage.Parser
+		header, err := p.Start(msg)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(header.OpCode, tc.Equals, opCodeUpdate)
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("update not received")
+	}
+}
+
+func (*updateSuite) TestUpdateRejected(c *tc.C) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	defer l.Close()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		msg, err := readMessage(conn)
+		if err != nil {
+			return
+		}
+		var p dnsmessage.Parser
+		header, _ := p.Start(msg)
+		b := dnsmessage.NewBuilder(nil, dnsmessage.Header{
+			ID: header.ID, Response: true, OpCode: opCodeUpdate, RCode: 9,
+		})
+		resp, _ := b.Finish()
+		_ = writeMessage(conn, resp)
+	}()
+
+	updater := NewUpdater(l.Addr().String(), "juju", nil, testclock.NewClock(time.Now()))
+	err = updater.Update(c.Context(), nil, []string{"0.mysql.prod.juju."})
+	c.Assert(err, tc.ErrorMatches, `updating zone "juju." on .*: update rejected: NotAuth`)
+}
+
+func (*updateSuite) TestNewUpdaterDefaultPort(c *tc.C) {
+	c.Check(NewUpdater("ns1.example.com", "juju", nil, nil).server, tc.Equals, "ns1.example.com:53")
+	c.Check(NewUpdater("2001:db8::53", "juju", nil, nil).server, tc.Equals, "[2001:db8::53]:53")
+	c.Check(NewUpdater("[2001:db8::53]:5353", "juju", nil, nil).server, tc.Equals, "[2001:db8::53]:5353")
+}
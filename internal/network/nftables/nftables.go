@@ -0,0 +1,168 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package nftables renders the nftables rule sets used by machine agents to
+// enforce the ports opened by their units on the machine itself.
+package nftables
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juju/collections/set"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+)
+
+const (
+	// TableName is the name of the inet table holding all the rules
+	// managed by Juju. Nothing outside of this table is ever changed.
+	TableName = "juju"
+
+	// nftablesIngressComment is the comment attached to rules directly
+	// related to ingress rules.
+	nftablesIngressComment = "juju ingress"
+
+	// nftablesModelComment is the comment attached to rules accepting
+	// traffic from the subnets of the model.
+	nftablesModelComment = "juju model"
+
+	// nftablesInternalComment is the comment attached to rules that are
+	// not directly related to ingress rules, e.g. for SSH or DHCP.
+	nftablesInternalComment = "juju internal"
+)
+
+// Ruleset describes the inbound traffic accepted by a machine.
+type Ruleset struct {
+	// IngressRules are the port ranges opened by the units on the
+	// machine, along with the CIDRs allowed to connect to them.
+	IngressRules firewall.IngressRules
+
+	// ModelCIDRs are the CIDRs of the model's subnets. Connections from
+	// them are accepted on any port, matching the way provider firewalls
+	// allow machines in the same model to talk to each other.
+	ModelCIDRs []string
+}
+
+// Render renders the rule set as an nft script which atomically replaces
+// the Juju table when passed to "nft -f". All inbound connections which
+// are not explicitly accepted are dropped.
+func (r Ruleset) Render() string {
+	var buf strings.Builder
+	buf.WriteString(deleteTable())
+	fmt.Fprintf(&buf, "table inet %s {\n", TableName)
+	buf.WriteString("\tchain input {\n")
+	buf.WriteString("\t\ttype filter hook input priority 0; policy drop;\n")
+	buf.WriteString("\t\tct state established,related accept\n")
+	buf.WriteString("\t\tct state invalid drop\n")
+	buf.WriteString("\t\tiif \"lo\" accept\n")
+
+	// ICMPv6 is required for neighbour discovery, and the ICMP error
+	// messages for path MTU discovery. Echo requests are only accepted
+	// if ICMP has been opened.
+	writeRule(&buf, "icmp type { destination-unreachable, time-exceeded, parameter-problem }", nftablesInternalComment)
+	writeRule(&buf, "meta l4proto ipv6-icmp", nftablesInternalComment)
+	writeRule(&buf, "udp dport { 68, 546 }", nftablesInternalComment)
+	writeRule(&buf, "tcp dport 22", nftablesInternalComment)
+
+	// Containers use the services of the LXD managed bridge on the host.
+	writeRule(&buf, `iifname "lxdbr0"`, nftablesInternalComment)
+
+	v4, v6 := splitCIDRs(r.ModelCIDRs)
+	if len(v4) > 0 {
+		writeRule(&buf, "ip saddr "+renderSet(v4), nftablesModelComment)
+	}
+	if len(v6) > 0 {
+		writeRule(&buf, "ip6 saddr "+renderSet(v6), nftablesModelComment)
+	}
+
+	rules := make(firewall.IngressRules, len(r.IngressRules))
+	copy(rules, r.IngressRules)
+	sort.Slice(rules, func(i, j int) bool { return rules[i].LessThan(rules[j]) })
+	for _, rule := range rules {
+		for _, match := range renderIngressRule(rule) {
+			writeRule(&buf, match, nftablesIngressComment)
+		}
+	}
+
+	buf.WriteString("\t}\n")
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// RenderDelete renders an nft script which removes the Juju table, if it
+// exists, when passed to "nft -f".
+func RenderDelete() string {
+	return deleteTable()
+}
+
+// deleteTable returns the commands for deleting the Juju table. The table
+// is declared first so that deleting it never fails if it doesn't exist.
+func deleteTable() string {
+	return fmt.Sprintf("table inet %[1]s\ndelete table inet %[1]s\n", TableName)
+}
+
+func writeRule(buf *strings.Builder, match, comment string) {
+	fmt.Fprintf(buf, "\t\t%s accept comment %q\n", match, comment)
+}
+
+// renderIngressRule returns the nftables matches for the rule, one per
+// address family of its source CIDRs. A rule without source CIDRs accepts
+// traffic from all networks.
+func renderIngressRule(rule firewall.IngressRule) []string {
+	sourceCIDRs := rule.SourceCIDRs.SortedValues()
+	if len(sourceCIDRs) == 0 {
+		sourceCIDRs = []string{firewall.AllNetworksIPV4CIDR, firewall.AllNetworksIPV6CIDR}
+	}
+	v4, v6 := splitCIDRs(sourceCIDRs)
+
+	var matches []string
+	if len(v4) > 0 {
+		matches = append(matches, fmt.Sprintf("ip saddr %s %s", renderSet(v4), renderPortRange(rule.PortRange, false)))
+	}
+	if len(v6) > 0 {
+		matches = append(matches, fmt.Sprintf("ip6 saddr %s %s", renderSet(v6), renderPortRange(rule.PortRange, true)))
+	}
+	return matches
+}
+
+func renderPortRange(portRange network.PortRange, ipv6 bool) string {
+	if portRange.Protocol == "icmp" {
+		if ipv6 {
+			return "icmpv6 type echo-request"
+		}
+		return "icmp type echo-request"
+	}
+	if portRange.FromPort == portRange.ToPort {
+		return fmt.Sprintf("%s dport %d", portRange.Protocol, portRange.FromPort)
+	}
+	return fmt.Sprintf("%s dport %d-%d", portRange.Protocol, portRange.FromPort, portRange.ToPort)
+}
+
+// splitCIDRs splits the CIDRs into sorted lists of unique IPV4 and IPV6
+// CIDRs. Invalid CIDRs are ignored.
+func splitCIDRs(cidrs []string) ([]string, []string) {
+	v4, v6 := set.NewStrings(), set.NewStrings()
+	for _, cidr := range cidrs {
+		addrType, err := network.CIDRAddressType(cidr)
+		if err != nil {
+			continue
+		}
+		switch addrType {
+		case network.IPv4Address:
+			v4.Add(cidr)
+		case network.IPv6Address:
+			v6.Add(cidr)
+		}
+	}
+	return v4.SortedValues(), v6.SortedValues()
+}
+
+func renderSet(values []string) string {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return "{ " + strings.Join(values, ", ") + " }"
+}
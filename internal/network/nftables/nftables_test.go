@@ -0,0 +1,80 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package nftables_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/internal/network/nftables"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type NftablesSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestNftablesSuite(t *testing.T) {
+	tc.Run(t, &NftablesSuite{})
+}
+
+const rulesetPreamble = `table inet juju
+delete table inet juju
+table inet juju {
+	chain input {
+		type filter hook input priority 0; policy drop;
+		ct state established,related accept
+		ct state invalid drop
+		iif "lo" accept
+		icmp type { destination-unreachable, time-exceeded, parameter-problem } accept comment "juju internal"
+		meta l4proto ipv6-icmp accept comment "juju internal"
+		udp dport { 68, 546 } accept comment "juju internal"
+		tcp dport 22 accept comment "juju internal"
+		iifname "lxdbr0" accept comment "juju internal"
+`
+
+func (*NftablesSuite) TestRenderEmpty(c *tc.C) {
+	c.Assert(nftables.Ruleset{}.Render(), tc.Equals, rulesetPreamble+`	}
+}
+`)
+}
+
+func (*NftablesSuite) TestRenderModelCIDRs(c *tc.C) {
+	ruleset := nftables.Ruleset{
+		ModelCIDRs: []string{"10.0.1.0/24", "10.0.0.0/24", "2001:db8::/64", "10.0.0.0/24", "not-a-cidr"},
+	}
+	c.Assert(ruleset.Render(), tc.Equals, rulesetPreamble+`		ip saddr { 10.0.0.0/24, 10.0.1.0/24 } accept comment "juju model"
+		ip6 saddr 2001:db8::/64 accept comment "juju model"
+	}
+}
+`)
+}
+
+func (*NftablesSuite) TestRenderIngressRules(c *tc.C) {
+	ruleset := nftables.Ruleset{
+		IngressRules: firewall.IngressRules{
+			firewall.NewIngressRule(network.MustParsePortRange("8000-8080/tcp"), "192.168.0.0/24"),
+			firewall.NewIngressRule(network.MustParsePortRange("80/tcp"), "0.0.0.0/0", "::/0"),
+			firewall.NewIngressRule(network.MustParsePortRange("53/udp")),
+			firewall.NewIngressRule(network.MustParsePortRange("icmp"), "10.0.0.0/8", "2001:db8::/32"),
+		},
+	}
+	c.Assert(ruleset.Render(), tc.Equals, rulesetPreamble+`		ip saddr 10.0.0.0/8 icmp type echo-request accept comment "juju ingress"
+		ip6 saddr 2001:db8::/32 icmpv6 type echo-request accept comment "juju ingress"
+		ip saddr 0.0.0.0/0 tcp dport 80 accept comment "juju ingress"
+		ip6 saddr ::/0 tcp dport 80 accept comment "juju ingress"
+		ip saddr 192.168.0.0/24 tcp dport 8000-8080 accept comment "juju ingress"
+		ip saddr 0.0.0.0/0 udp dport 53 accept comment "juju ingress"
+		ip6 saddr ::/0 udp dport 53 accept comment "juju ingress"
+	}
+}
+`)
+}
+
+func (*NftablesSuite) TestRenderDelete(c *tc.C) {
+	c.Assert(nftables.RenderDelete(), tc.Equals, "table inet juju\ndelete table inet juju\n")
+}
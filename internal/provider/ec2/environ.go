@@ -84,6 +84,9 @@ var (
 
 	// Ensure that environ implements FirewallFeatureQuerier.
 	_ environs.FirewallFeatureQuerier = (*environ)(nil)
+
+	// Ensure that environ implements EgressFirewallFeatureQuerier.
+	_ environs.EgressFirewallFeatureQuerier = (*environ)(nil)
 )
 
 var _ Client = (*ec2.Client)(nil)
@@ -1986,6 +1989,197 @@ func (e *environ) ingressRulesInGroup(ctx context.Context, name string) (rules f
 	return rules, nil
 }
 
+// egressPermKey identifies a single destination CIDR for a protocol and
+// port range in the egress permissions of a security group.
+type egressPermKey struct {
+	protocol string
+	fromPort int32
+	toPort   int32
+	cidr     string
+}
+
+// unrestrictedEgressKey is the permission that allows all outbound IPv4
+// traffic; EC2 adds it to every new security group.
+var unrestrictedEgressKey = egressPermKey{protocol: "-1", cidr: defaultRouteIpv4CIDRBlock}
+
+func egressPermsToKeys(perms []types.IpPermission) []egressPermKey {
+	var keys []egressPermKey
+	for _, p := range perms {
+		k := egressPermKey{
+			protocol: aws.ToString(p.IpProtocol),
+			fromPort: aws.ToInt32(p.FromPort),
+			toPort:   aws.ToInt32(p.ToPort),
+		}
+		if k.protocol == "-1" {
+			k.fromPort, k.toPort = 0, 0
+		}
+		for _, r := range p.IpRanges {
+			k.cidr = aws.ToString(r.CidrIp)
+			keys = append(keys, k)
+		}
+		for _, r := range p.Ipv6Ranges {
+			k.cidr = aws.ToString(r.CidrIpv6)
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+func egressKeysToIPPerms(keys []egressPermKey) []types.IpPermission {
+	ipPerms := make([]types.IpPermission, len(keys))
+	for i, k := range keys {
+		ipPerms[i].IpProtocol = aws.String(k.protocol)
+		if k.protocol != "-1" {
+			ipPerms[i].FromPort = aws.Int32(k.fromPort)
+			ipPerms[i].ToPort = aws.Int32(k.toPort)
+		}
+		if addrType, _ := network.CIDRAddressType(k.cidr); addrType == network.IPv6Address {
+			ipPerms[i].Ipv6Ranges = []types.Ipv6Range{{CidrIpv6: aws.String(k.cidr)}}
+		} else {
+			ipPerms[i].IpRanges = []types.IpRange{{CidrIp: aws.String(k.cidr)}}
+		}
+	}
+	return ipPerms
+}
+
+func egressRulesToKeys(rules firewall.EgressRules) map[egressPermKey]bool {
+	if rules == nil {
+		return map[egressPermKey]bool{unrestrictedEgressKey: true}
+	}
+	keys := make(map[egressPermKey]bool)
+	for _, r := range rules.Compact() {
+		for cidr := range r.DestinationCIDRs {
+			keys[egressPermKey{
+				protocol: r.PortRange.Protocol,
+				fromPort: int32(r.PortRange.FromPort),
+				toPort:   int32(r.PortRange.ToPort),
+				cidr:     cidr,
+			}] = true
+		}
+	}
+	return keys
+}
+
+// egressRulesInGroup returns the egress rules of the named security group.
+// A nil result means that the group allows all outbound traffic.
+func (e *environ) egressRulesInGroup(ctx context.Context, name string) (firewall.EgressRules, error) {
+	group, err := e.groupByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	rules := firewall.EgressRules{}
+	for _, k := range egressPermsToKeys(group.IpPermissionsEgress) {
+		if k == unrestrictedEgressKey {
+			return nil, nil
+		}
+		if k.protocol == "-1" {
+			// Juju never creates protocol-agnostic rules for a CIDR
+			// other than the default route.
+			continue
+		}
+		portRange := network.PortRange{
+			Protocol: k.protocol,
+			FromPort: int(k.fromPort),
+			ToPort:   int(k.toPort),
+		}
+		rules = append(rules, firewall.NewEgressRule(portRange, k.cidr))
+	}
+	return rules.Compact(), nil
+}
+
+// setEgressRulesInGroup replaces the egress rules of the named security
+// group. Passing nil rules allows all outbound traffic.
+func (e *environ) setEgressRulesInGroup(ctx context.Context, name string, rules firewall.EgressRules) error {
+	group, err := e.groupByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	wanted := egressRulesToKeys(rules)
+	existing := make(map[egressPermKey]bool)
+	for _, k := range egressPermsToKeys(group.IpPermissionsEgress) {
+		existing[k] = true
+	}
+
+	var toAuthorize, toRevoke []egressPermKey
+	for k := range wanted {
+		if !existing[k] {
+			toAuthorize = append(toAuthorize, k)
+		}
+	}
+	for k := range existing {
+		if wanted[k] || (rules == nil && k.protocol == "-1") {
+			continue
+		}
+		toRevoke = append(toRevoke, k)
+	}
+	sortEgressKeys(toAuthorize)
+	sortEgressKeys(toRevoke)
+
+	// Authorize before revoking so that traffic which is allowed both
+	// before and after the change is never interrupted.
+	if len(toAuthorize) > 0 {
+		_, err = e.ec2Client.AuthorizeSecurityGroupEgress(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: egressKeysToIPPerms(toAuthorize),
+		})
+		if err != nil && ec2ErrCode(err) != "InvalidPermission.Duplicate" {
+			return errors.Annotate(e.HandleCredentialError(ctx, err), "cannot authorize egress")
+		}
+	}
+	if len(toRevoke) > 0 {
+		_, err = e.ec2Client.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: egressKeysToIPPerms(toRevoke),
+		})
+		if err != nil {
+			return errors.Annotate(e.HandleCredentialError(ctx, err), "cannot revoke egress")
+		}
+	}
+	return nil
+}
+
+// revokeUnrestrictedEgressInGroup removes any rule allowing all outbound
+// traffic from the named security group.
+func (e *environ) revokeUnrestrictedEgressInGroup(ctx context.Context, name string) error {
+	group, err := e.groupByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	var toRevoke []egressPermKey
+	for _, k := range egressPermsToKeys(group.IpPermissionsEgress) {
+		if k.protocol == "-1" {
+			toRevoke = append(toRevoke, k)
+		}
+	}
+	if len(toRevoke) == 0 {
+		return nil
+	}
+	_, err = e.ec2Client.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+		GroupId:       group.GroupId,
+		IpPermissions: egressKeysToIPPerms(toRevoke),
+	})
+	if err != nil {
+		return errors.Annotate(e.HandleCredentialError(ctx, err), "cannot revoke egress")
+	}
+	return nil
+}
+
+func sortEgressKeys(keys []egressPermKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.protocol != b.protocol {
+			return a.protocol < b.protocol
+		}
+		if a.fromPort != b.fromPort {
+			return a.fromPort < b.fromPort
+		}
+		if a.toPort != b.toPort {
+			return a.toPort < b.toPort
+		}
+		return a.cidr < b.cidr
+	})
+}
+
 func (e *environ) OpenPorts(ctx context.Context, rules firewall.IngressRules) error {
 	if e.Config().FirewallMode() != config.FwGlobal {
 		return errors.Errorf("invalid firewall mode %q for opening ports on model", e.Config().FirewallMode())
@@ -2700,6 +2894,15 @@ func (e *environ) SupportsRulesWithIPV6CIDRs(context.Context) (bool, error) {
 	return true, nil
 }
 
+// SupportsEgressRules returns true if the environment can apply egress rules
+// to its instances. The egress rules of an instance are applied to its
+// machine security group.
+//
+// This is part of the environs.EgressFirewallFeatureQuerier interface.
+func (e *environ) SupportsEgressRules(context.Context) (bool, error) {
+	return true, nil
+}
+
 // CreateTagSpecification creates an AWS tag specification for the given
 // resource type and tags.
 func CreateTagSpecification(resourceType types.ResourceType, tags map[string]string) types.TagSpecification {
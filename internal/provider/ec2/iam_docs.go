@@ -32,6 +32,7 @@ const (
       "Action": [
         "ec2:AssociateIamInstanceProfile",
         "ec2:AttachVolume",
+        "ec2:AuthorizeSecurityGroupEgress",
         "ec2:AuthorizeSecurityGroupIngress",
         "ec2:CreateSecurityGroup",
         "ec2:CreateTags",
@@ -53,6 +54,7 @@ const (
         "ec2:DescribeVpcs",
         "ec2:DetachVolume",
 	"ec2:ModifyNetworkInterfaceAttribute",
+        "ec2:RevokeSecurityGroupEgress",
         "ec2:RevokeSecurityGroupIngress",
         "ec2:RunInstances",
         "ec2:TerminateInstances"
@@ -146,6 +146,38 @@ func (inst *sdkInstance) IngressRules(ctx context.Context, machineId string) (fi
 	return ranges, nil
 }
 
+// EgressRules implements instances.InstanceEgressFirewaller.
+func (inst *sdkInstance) EgressRules(ctx context.Context, machineId string) (firewall.EgressRules, error) {
+	if inst.e.Config().FirewallMode() != config.FwInstance {
+		return nil, fmt.Errorf("invalid firewall mode %q for retrieving egress rules from instance",
+			inst.e.Config().FirewallMode())
+	}
+	return inst.e.egressRulesInGroup(ctx, inst.e.machineGroupName(machineId))
+}
+
+// SetEgressRules implements instances.InstanceEgressFirewaller.
+func (inst *sdkInstance) SetEgressRules(ctx context.Context, machineId string, rules firewall.EgressRules) error {
+	if inst.e.Config().FirewallMode() != config.FwInstance {
+		return fmt.Errorf("invalid firewall mode %q for setting egress rules on instance",
+			inst.e.Config().FirewallMode())
+	}
+	if rules != nil {
+		// Security groups are permissive, so the machine group can only
+		// restrict outbound traffic once the model group no longer allows
+		// all of it. Every machine group keeps its own default rule for
+		// allowing all outbound traffic until it is restricted.
+		if err := inst.e.revokeUnrestrictedEgressInGroup(ctx, inst.e.jujuGroupName()); err != nil {
+			return err
+		}
+	}
+	name := inst.e.machineGroupName(machineId)
+	if err := inst.e.setEgressRulesInGroup(ctx, name, rules); err != nil {
+		return err
+	}
+	logger.Infof(ctx, "set egress rules in security group %s: %v", name, rules)
+	return nil
+}
+
 // FetchInstanceClient describes the funcs needed from the EC2 client for
 // fetching instance types in a region. It's assumed that the ec2 client
 // conforming to this interface is scoped to the region that instances are being
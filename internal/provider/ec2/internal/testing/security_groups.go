@@ -32,6 +32,8 @@ func (srv *Server) CreateSecurityGroup(ctx context.Context, in *ec2.CreateSecuri
 		description: aws.ToString(in.Description),
 		id:          fmt.Sprintf("sg-%d", srv.groupId.next()),
 		perms:       make(map[permKey]bool),
+		// EC2 allows all outbound traffic from a new security group.
+		egressPerms: map[permKey]bool{{protocol: "-1", ipAddr: "0.0.0.0/0"}: true},
 		tags:        tagSpecForType(types.ResourceTypeSecurityGroup, in.TagSpecifications).Tags,
 	}
 	vpcId := aws.ToString(in.VpcId)
@@ -159,14 +161,83 @@ func (srv *Server) RevokeSecurityGroupIngress(ctx context.Context, in *ec2.Revok
 	return &ec2.RevokeSecurityGroupIngressOutput{}, nil
 }
 
+// AuthorizeSecurityGroupEgress implements ec2.Client.
+func (srv *Server) AuthorizeSecurityGroupEgress(ctx context.Context, in *ec2.AuthorizeSecurityGroupEgressInput, opts ...func(*ec2.Options)) (*ec2.AuthorizeSecurityGroupEgressOutput, error) {
+	srv.groupMutatingCalls.next()
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	g := srv.group(types.GroupIdentifier{GroupId: in.GroupId})
+	if g == nil {
+		return nil, apiError("InvalidGroup.NotFound", "group not found")
+	}
+
+	perms := parseEgressPerms(in.IpPermissions)
+	for _, p := range perms {
+		if g.egressPerms[p] {
+			return nil, apiError("InvalidPermission.Duplicate", "Permission has already been authorized on the specified group")
+		}
+	}
+	for _, p := range perms {
+		g.egressPerms[p] = true
+	}
+	return &ec2.AuthorizeSecurityGroupEgressOutput{}, nil
+}
+
+// RevokeSecurityGroupEgress implements ec2.Client.
+func (srv *Server) RevokeSecurityGroupEgress(ctx context.Context, in *ec2.RevokeSecurityGroupEgressInput, opts ...func(*ec2.Options)) (*ec2.RevokeSecurityGroupEgressOutput, error) {
+	srv.groupMutatingCalls.next()
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	g := srv.group(types.GroupIdentifier{GroupId: in.GroupId})
+	if g == nil {
+		return nil, apiError("InvalidGroup.NotFound", "group not found")
+	}
+
+	perms := parseEgressPerms(in.IpPermissions)
+	for _, p := range perms {
+		if !g.egressPerms[p] {
+			return nil, apiError("InvalidPermission.NotFound", "The specified rule does not exist in this security group")
+		}
+	}
+	for _, p := range perms {
+		delete(g.egressPerms, p)
+	}
+	return &ec2.RevokeSecurityGroupEgressOutput{}, nil
+}
+
+// parseEgressPerms returns a permKey for each destination CIDR of the
+// given egress permissions.
+func parseEgressPerms(in []types.IpPermission) []permKey {
+	var result []permKey
+	for _, p := range in {
+		k := permKey{
+			protocol: aws.ToString(p.IpProtocol),
+			fromPort: aws.ToInt32(p.FromPort),
+			toPort:   aws.ToInt32(p.ToPort),
+		}
+		for _, ip := range p.IpRanges {
+			k.ipAddr = aws.ToString(ip.CidrIp)
+			result = append(result, k)
+		}
+		for _, ip := range p.Ipv6Ranges {
+			k.ipAddr = aws.ToString(ip.CidrIpv6)
+			result = append(result, k)
+		}
+	}
+	return result
+}
+
 type securityGroup struct {
 	id          string
 	name        string
 	description string
 	vpcId       string
 
-	perms map[permKey]bool
-	tags  []types.Tag
+	perms       map[permKey]bool
+	egressPerms map[permKey]bool
+	tags        []types.Tag
 }
 
 // permKey represents permission for a given security group.
@@ -277,6 +348,25 @@ func (g *securityGroup) ec2Perms() (perms []types.IpPermission) {
 	return
 }
 
+// ec2EgressPerms returns the list of EC2 egress permissions granted to g,
+// with one permission for each destination CIDR.
+func (g *securityGroup) ec2EgressPerms() (perms []types.IpPermission) {
+	for k := range g.egressPerms {
+		p := types.IpPermission{IpProtocol: aws.String(k.protocol)}
+		if k.protocol != "-1" {
+			p.FromPort = aws.Int32(k.fromPort)
+			p.ToPort = aws.Int32(k.toPort)
+		}
+		if strings.Contains(k.ipAddr, ":") {
+			p.Ipv6Ranges = []types.Ipv6Range{{CidrIpv6: aws.String(k.ipAddr)}}
+		} else {
+			p.IpRanges = []types.IpRange{{CidrIp: aws.String(k.ipAddr)}}
+		}
+		perms = append(perms, p)
+	}
+	return
+}
+
 func (srv *Server) DescribeSecurityGroups(ctx context.Context, in *ec2.DescribeSecurityGroupsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
 	srv.mu.Lock()
 	defer srv.mu.Unlock()
@@ -304,11 +394,12 @@ func (srv *Server) DescribeSecurityGroups(ctx context.Context, in *ec2.DescribeS
 		ok, err := f.ok(group)
 		if ok {
 			resp.SecurityGroups = append(resp.SecurityGroups, types.SecurityGroup{
-				OwnerId:       aws.String(ownerId),
-				GroupId:       aws.String(group.id),
-				GroupName:     aws.String(group.name),
-				Description:   aws.String(group.description),
-				IpPermissions: group.ec2Perms(),
+				OwnerId:             aws.String(ownerId),
+				GroupId:             aws.String(group.id),
+				GroupName:           aws.String(group.name),
+				Description:         aws.String(group.description),
+				IpPermissions:       group.ec2Perms(),
+				IpPermissionsEgress: group.ec2EgressPerms(),
 			})
 		} else if err != nil {
 			return nil, apiError("InvalidParameterValue", "describe security groups: %v", err)
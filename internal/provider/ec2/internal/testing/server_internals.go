@@ -42,6 +42,7 @@ func (srv *Server) addDefaultZonesAndGroups() {
 			group:    g,
 		}: true,
 	}
+	g.egressPerms = map[permKey]bool{{protocol: "-1", ipAddr: "0.0.0.0/0"}: true}
 	srv.groups[g.id] = g
 
 	// Add a default availability zone.
@@ -2897,6 +2897,67 @@ func (t *localServerSuite) TestPorts(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, `invalid firewall mode "instance" for retrieving ingress rules from model`)
 }
 
+func (t *localServerSuite) TestEgressRules(c *tc.C) {
+	t.prepareAndBootstrap(c)
+
+	inst1, _ := testing.AssertStartInstance(c, t.Env, t.ControllerUUID, "1")
+	c.Assert(inst1, tc.NotNil)
+	defer func() { _ = t.Env.StopInstances(c.Context(), inst1.Id()) }()
+	fwInst1, ok := inst1.(instances.InstanceEgressFirewaller)
+	c.Assert(ok, tc.Equals, true)
+
+	// New machines have unrestricted egress.
+	rules, err := fwInst1.EgressRules(c.Context(), "1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rules, tc.IsNil)
+
+	err = fwInst1.SetEgressRules(c.Context(), "1", firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8", "2001:db8::/32"),
+		firewall.NewEgressRule(network.MustParsePortRange("17070/tcp"), "10.1.1.1/32"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	rules, err = fwInst1.EgressRules(c.Context(), "1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rules, tc.DeepEquals, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8", "2001:db8::/32"),
+		firewall.NewEgressRule(network.MustParsePortRange("17070/tcp"), "10.1.1.1/32"),
+	})
+
+	// The model group must no longer allow all outbound traffic.
+	groups, err := t.client.DescribeSecurityGroups(c.Context(), &awsec2.DescribeSecurityGroupsInput{
+		GroupNames: []string{ec2.JujuGroupName(t.Env)},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(groups.SecurityGroups, tc.HasLen, 1)
+	c.Check(groups.SecurityGroups[0].IpPermissionsEgress, tc.HasLen, 0)
+
+	// Replacing the rules revokes stale ones.
+	err = fwInst1.SetEgressRules(c.Context(), "1", firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("17070/tcp"), "10.1.1.1/32"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	rules, err = fwInst1.EgressRules(c.Context(), "1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rules, tc.DeepEquals, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("17070/tcp"), "10.1.1.1/32"),
+	})
+
+	// An empty list denies all outbound traffic.
+	err = fwInst1.SetEgressRules(c.Context(), "1", firewall.EgressRules{})
+	c.Assert(err, tc.ErrorIsNil)
+	rules, err = fwInst1.EgressRules(c.Context(), "1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rules, tc.NotNil)
+	c.Assert(rules, tc.HasLen, 0)
+
+	// Nil restores unrestricted egress.
+	err = fwInst1.SetEgressRules(c.Context(), "1", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	rules, err = fwInst1.EgressRules(c.Context(), "1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rules, tc.IsNil)
+}
+
 func (t *localServerSuite) TestGlobalPorts(c *tc.C) {
 	t.prepareAndBootstrap(c)
 
@@ -1124,6 +1124,7 @@ func (a *app) Delete() error {
 	applier.Delete(resources.NewClusterRoleBinding(a.client.RbacV1().ClusterRoleBindings(), a.qualifiedClusterName(), nil))
 	applier.Delete(resources.NewClusterRole(a.client.RbacV1().ClusterRoles(), a.qualifiedClusterName(), nil))
 	applier.Delete(resources.NewServiceAccount(a.client.CoreV1().ServiceAccounts(a.namespace), a.namespace, a.serviceAccountName(), nil))
+	applier.Delete(resources.NewNetworkPolicy(a.client.NetworkingV1().NetworkPolicies(a.namespace), a.namespace, a.name, nil))
 
 	resourcesToDelete := []resources.Resource(nil)
 
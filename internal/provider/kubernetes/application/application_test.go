@@ -22,6 +22,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -1789,6 +1790,9 @@ func (s *applicationSuite) TestDeleteStateful(c *tc.C) {
 		s.applier.EXPECT().Delete(resourceMatcher{
 			expectedResource: resources.NewServiceAccount(
 				s.client.CoreV1().ServiceAccounts("test"), "test", "gitlab", nil).ServiceAccount}),
+		s.applier.EXPECT().Delete(resourceMatcher{
+			expectedResource: resources.NewNetworkPolicy(
+				s.client.NetworkingV1().NetworkPolicies("test"), "test", "gitlab", nil).NetworkPolicy}),
 		s.applier.EXPECT().Run(gomock.Any(), false).Return(nil),
 	)
 	c.Assert(app.Delete(), tc.ErrorIsNil)
@@ -1823,6 +1827,9 @@ func (s *applicationSuite) TestDeleteStateless(c *tc.C) {
 		s.applier.EXPECT().Delete(resourceMatcher{
 			expectedResource: resources.NewServiceAccount(
 				s.client.CoreV1().ServiceAccounts("test"), "test", "gitlab", nil).ServiceAccount}),
+		s.applier.EXPECT().Delete(resourceMatcher{
+			expectedResource: resources.NewNetworkPolicy(
+				s.client.NetworkingV1().NetworkPolicies("test"), "test", "gitlab", nil).NetworkPolicy}),
 		s.applier.EXPECT().Run(gomock.Any(), false).Return(nil),
 	)
 	c.Assert(app.Delete(), tc.ErrorIsNil)
@@ -1857,6 +1864,9 @@ func (s *applicationSuite) TestDeleteDaemon(c *tc.C) {
 		s.applier.EXPECT().Delete(resourceMatcher{
 			expectedResource: resources.NewServiceAccount(
 				s.client.CoreV1().ServiceAccounts("test"), "test", "gitlab", nil).ServiceAccount}),
+		s.applier.EXPECT().Delete(resourceMatcher{
+			expectedResource: resources.NewNetworkPolicy(
+				s.client.NetworkingV1().NetworkPolicies("test"), "test", "gitlab", nil).NetworkPolicy}),
 		s.applier.EXPECT().Run(gomock.Any(), false).Return(nil),
 	)
 	c.Assert(app.Delete(), tc.ErrorIsNil)
@@ -2629,6 +2639,8 @@ func (m resourceMatcher) Matches(x any) bool {
 		return reflect.DeepEqual(m.expectedResource, res.ClusterRole)
 	case *resources.ServiceAccount:
 		return reflect.DeepEqual(m.expectedResource, res.ServiceAccount)
+	case *resources.NetworkPolicy:
+		return reflect.DeepEqual(m.expectedResource, res.NetworkPolicy)
 	}
 	return false
 }
@@ -4289,3 +4301,96 @@ func (s *applicationSuite) TestDeleteAllCreatedResources(c *tc.C) {
 	c.Assert(validatingWebhookConfigurations.Items, tc.NotNil)
 	c.Assert(validatingWebhookConfigurations.Items, tc.HasLen, 1)
 }
+
+func (s *applicationSuite) TestEnsureNetworkPolicy(c *tc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.EnsureNetworkPolicy(caas.NetworkPolicy{
+		Ingress: []caas.NetworkPolicyIngressRule{{
+			Ports: []caas.ServicePort{{
+				Name:     "port1",
+				Port:     8080,
+				Protocol: "TCP",
+			}},
+			Applications: []string{"mysql"},
+			CIDRs:        []string{"10.0.0.0/24"},
+		}, {
+			// Rules without any peers are ignored.
+			Ports: []caas.ServicePort{{
+				Name:     "port2",
+				Port:     9090,
+				Protocol: "TCP",
+			}},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	tcp := corev1.ProtocolTCP
+	port := intstr.FromInt(8080)
+	np, err := s.client.NetworkingV1().NetworkPolicies("test").Get(c.Context(), "gitlab", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(np.Labels, tc.DeepEquals, map[string]string{
+		"app.kubernetes.io/managed-by": "juju",
+		"app.kubernetes.io/name":       "gitlab",
+	})
+	c.Assert(np.Spec, tc.DeepEquals, networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"app.kubernetes.io/name": "gitlab"},
+		},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{{
+			From: []networkingv1.NetworkPolicyPeer{{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app.kubernetes.io/name": "mysql"},
+				},
+			}, {
+				IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/24"},
+			}},
+			Ports: []networkingv1.NetworkPolicyPort{{
+				Protocol: &tcp,
+				Port:     &port,
+			}},
+		}},
+	})
+
+	// Applying an empty policy denies all inbound traffic.
+	err = app.EnsureNetworkPolicy(caas.NetworkPolicy{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	np, err = s.client.NetworkingV1().NetworkPolicies("test").Get(c.Context(), "gitlab", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(np.Spec.Ingress, tc.HasLen, 0)
+	c.Assert(np.Spec.PolicyTypes, tc.DeepEquals, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress})
+}
+
+func (s *applicationSuite) TestEnsureNetworkPolicyInvalidProtocol(c *tc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.EnsureNetworkPolicy(caas.NetworkPolicy{
+		Ingress: []caas.NetworkPolicyIngressRule{{
+			Ports: []caas.ServicePort{{
+				Name:     "port1",
+				Port:     8080,
+				Protocol: "bad-protocol",
+			}},
+			Applications: []string{"mysql"},
+		}},
+	})
+	c.Assert(err, tc.ErrorMatches, `protocol "bad-protocol" for service "port1" not valid`)
+}
+
+func (s *applicationSuite) TestDeleteNetworkPolicy(c *tc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	// Deleting a policy which doesn't exist is not an error.
+	c.Assert(app.DeleteNetworkPolicy(), tc.ErrorIsNil)
+
+	c.Assert(app.EnsureNetworkPolicy(caas.NetworkPolicy{}), tc.ErrorIsNil)
+	c.Assert(app.DeleteNetworkPolicy(), tc.ErrorIsNil)
+
+	_, err := s.client.NetworkingV1().NetworkPolicies("test").Get(c.Context(), "gitlab", metav1.GetOptions{})
+	c.Assert(k8serrors.IsNotFound(err), tc.IsTrue)
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/internal/provider/kubernetes/resources"
+	"github.com/juju/juju/internal/provider/kubernetes/utils"
+)
+
+// EnsureNetworkPolicy ensures that the inbound traffic of the application's
+// units is restricted to the given policy, using a NetworkPolicy selecting
+// the application's pods. The units of an application are selected by the
+// same labels as its pods.
+func (a *app) EnsureNetworkPolicy(policy caas.NetworkPolicy) error {
+	np, err := a.networkPolicy(policy)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	applier := a.newApplier()
+	applier.Apply(np)
+	return errors.Trace(applier.Run(context.TODO(), false))
+}
+
+// DeleteNetworkPolicy removes the application's NetworkPolicy, if any.
+func (a *app) DeleteNetworkPolicy() error {
+	applier := a.newApplier()
+	applier.Delete(resources.NewNetworkPolicy(a.client.NetworkingV1().NetworkPolicies(a.namespace), a.namespace, a.name, nil))
+	return errors.Trace(applier.Run(context.TODO(), false))
+}
+
+func (a *app) networkPolicy(policy caas.NetworkPolicy) (*resources.NetworkPolicy, error) {
+	// A non nil but empty list of ingress rules denies all inbound traffic.
+	ingress := make([]netv1.NetworkPolicyIngressRule, 0, len(policy.Ingress))
+	for _, rule := range policy.Ingress {
+		var from []netv1.NetworkPolicyPeer
+		for _, appName := range rule.Applications {
+			from = append(from, netv1.NetworkPolicyPeer{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: utils.SelectorLabelsForApp(appName, a.labelVersion),
+				},
+			})
+		}
+		for _, cidr := range rule.CIDRs {
+			from = append(from, netv1.NetworkPolicyPeer{
+				IPBlock: &netv1.IPBlock{CIDR: cidr},
+			})
+		}
+		// A rule without peers would accept traffic from anywhere.
+		if len(from) == 0 {
+			continue
+		}
+
+		var ports []netv1.NetworkPolicyPort
+		for _, port := range rule.Ports {
+			sp, err := convertServicePort(port)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			portNumber := intstr.FromInt(port.Port)
+			ports = append(ports, netv1.NetworkPolicyPort{
+				Protocol: &sp.Protocol,
+				Port:     &portNumber,
+			})
+		}
+		ingress = append(ingress, netv1.NetworkPolicyIngressRule{
+			From:  from,
+			Ports: ports,
+		})
+	}
+
+	return resources.NewNetworkPolicy(a.client.NetworkingV1().NetworkPolicies(a.namespace), a.namespace, a.name, &netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: a.labels(),
+		},
+		Spec: netv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: a.selectorLabels(),
+			},
+			PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeIngress},
+			Ingress:     ingress,
+		},
+	}), nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	netv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	netv1client "k8s.io/client-go/kubernetes/typed/networking/v1"
+
+	"github.com/juju/juju/core/status"
+	k8sconstants "github.com/juju/juju/internal/provider/kubernetes/constants"
+)
+
+// NetworkPolicy extends the k8s NetworkPolicy.
+type NetworkPolicy struct {
+	client netv1client.NetworkPolicyInterface
+	netv1.NetworkPolicy
+}
+
+// NewNetworkPolicy creates a new NetworkPolicy resource.
+func NewNetworkPolicy(client netv1client.NetworkPolicyInterface, namespace string, name string, in *netv1.NetworkPolicy) *NetworkPolicy {
+	if in == nil {
+		in = &netv1.NetworkPolicy{}
+	}
+	in.SetName(name)
+	in.SetNamespace(namespace)
+	return &NetworkPolicy{client, *in}
+}
+
+// Clone returns a copy of the resource.
+func (np *NetworkPolicy) Clone() Resource {
+	clone := *np
+	return &clone
+}
+
+// ID returns a comparable ID for the Resource.
+func (np *NetworkPolicy) ID() ID {
+	return ID{"NetworkPolicy", np.Name, np.Namespace}
+}
+
+// Apply creates or replaces the resource, so that ingress rules which are no
+// longer wanted are removed.
+func (np *NetworkPolicy) Apply(ctx context.Context) error {
+	existing, err := np.client.Get(ctx, np.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		res, err := np.client.Create(ctx, &np.NetworkPolicy, metav1.CreateOptions{
+			FieldManager: JujuFieldManager,
+		})
+		if err != nil {
+			return errors.Annotatef(err, "creating NetworkPolicy %q", np.Name)
+		}
+		np.NetworkPolicy = *res
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	np.ResourceVersion = existing.ResourceVersion
+	res, err := np.client.Update(ctx, &np.NetworkPolicy, metav1.UpdateOptions{
+		FieldManager: JujuFieldManager,
+	})
+	if k8serrors.IsConflict(err) {
+		return errors.Annotatef(errConflict, "NetworkPolicy %q", np.Name)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	np.NetworkPolicy = *res
+	return nil
+}
+
+// Get refreshes the resource.
+func (np *NetworkPolicy) Get(ctx context.Context) error {
+	res, err := np.client.Get(ctx, np.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return errors.NotFoundf("NetworkPolicy %q", np.Name)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	np.NetworkPolicy = *res
+	return nil
+}
+
+// Delete removes the resource.
+func (np *NetworkPolicy) Delete(ctx context.Context) error {
+	err := np.client.Delete(ctx, np.Name, metav1.DeleteOptions{
+		PropagationPolicy: k8sconstants.DefaultPropagationPolicy(),
+	})
+	if k8serrors.IsNotFound(err) {
+		return errors.NewNotFound(err, "k8s network policy for deletion")
+	}
+	return errors.Trace(err)
+}
+
+// ComputeStatus returns a juju status for the resource.
+func (np *NetworkPolicy) ComputeStatus(_ context.Context, now time.Time) (string, status.Status, time.Time, error) {
+	if np.DeletionTimestamp != nil {
+		return "", status.Terminated, np.DeletionTimestamp.Time, nil
+	}
+	return "", status.Active, now, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	netv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/juju/juju/internal/provider/kubernetes/resources"
+)
+
+type networkPolicySuite struct {
+	resourceSuite
+}
+
+func TestNetworkPolicySuite(t *testing.T) {
+	tc.Run(t, &networkPolicySuite{})
+}
+
+func (s *networkPolicySuite) TestApply(c *tc.C) {
+	np := &netv1.NetworkPolicy{
+		Spec: netv1.NetworkPolicySpec{
+			Ingress: []netv1.NetworkPolicyIngressRule{{
+				From: []netv1.NetworkPolicyPeer{{
+					IPBlock: &netv1.IPBlock{CIDR: "10.0.0.0/24"},
+				}},
+			}, {
+				From: []netv1.NetworkPolicyPeer{{
+					IPBlock: &netv1.IPBlock{CIDR: "192.168.0.0/24"},
+				}},
+			}},
+		},
+	}
+	// Create.
+	npResource := resources.NewNetworkPolicy(s.client.NetworkingV1().NetworkPolicies("test"), "test", "np1", np)
+	c.Assert(npResource.Apply(c.Context()), tc.ErrorIsNil)
+	result, err := s.client.NetworkingV1().NetworkPolicies("test").Get(c.Context(), "np1", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Spec.Ingress, tc.HasLen, 2)
+
+	// Update replaces the ingress rules.
+	np.Spec.Ingress = np.Spec.Ingress[1:]
+	npResource = resources.NewNetworkPolicy(s.client.NetworkingV1().NetworkPolicies("test"), "test", "np1", np)
+	c.Assert(npResource.Apply(c.Context()), tc.ErrorIsNil)
+
+	result, err = s.client.NetworkingV1().NetworkPolicies("test").Get(c.Context(), "np1", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.GetName(), tc.Equals, `np1`)
+	c.Assert(result.GetNamespace(), tc.Equals, `test`)
+	c.Assert(result.Spec.Ingress, tc.DeepEquals, []netv1.NetworkPolicyIngressRule{{
+		From: []netv1.NetworkPolicyPeer{{
+			IPBlock: &netv1.IPBlock{CIDR: "192.168.0.0/24"},
+		}},
+	}})
+}
+
+func (s *networkPolicySuite) TestGet(c *tc.C) {
+	template := netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "np1",
+			Namespace: "test",
+		},
+	}
+	np1 := template
+	np1.SetAnnotations(map[string]string{"a": "b"})
+	_, err := s.client.NetworkingV1().NetworkPolicies("test").Create(c.Context(), &np1, metav1.CreateOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	npResource := resources.NewNetworkPolicy(s.client.NetworkingV1().NetworkPolicies("test"), "test", "np1", &template)
+	c.Assert(len(npResource.GetAnnotations()), tc.Equals, 0)
+	err = npResource.Get(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(npResource.GetName(), tc.Equals, `np1`)
+	c.Assert(npResource.GetNamespace(), tc.Equals, `test`)
+	c.Assert(npResource.GetAnnotations(), tc.DeepEquals, map[string]string{"a": "b"})
+}
+
+func (s *networkPolicySuite) TestDelete(c *tc.C) {
+	np := netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "np1",
+			Namespace: "test",
+		},
+	}
+	_, err := s.client.NetworkingV1().NetworkPolicies("test").Create(c.Context(), &np, metav1.CreateOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	npResource := resources.NewNetworkPolicy(s.client.NetworkingV1().NetworkPolicies("test"), "test", "np1", &np)
+	err = npResource.Delete(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = npResource.Delete(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+
+	err = npResource.Get(c.Context())
+	c.Assert(err, tc.Satisfies, errors.IsNotFound)
+
+	_, err = s.client.NetworkingV1().NetworkPolicies("test").Get(c.Context(), "np1", metav1.GetOptions{})
+	c.Assert(err, tc.Satisfies, k8serrors.IsNotFound)
+}
@@ -353,6 +353,45 @@ func (c *MockServerCreateProfileWithConfigCall) DoAndReturn(f func(string, map[s
 	return c
 }
 
+// CreateStoragePoolVolumeSnapshot mocks base method.
+func (m *MockServer) CreateStoragePoolVolumeSnapshot(arg0, arg1, arg2 string, arg3 api.StorageVolumeSnapshotsPost) (lxd.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStoragePoolVolumeSnapshot", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(lxd.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStoragePoolVolumeSnapshot indicates an expected call of CreateStoragePoolVolumeSnapshot.
+func (mr *MockServerMockRecorder) CreateStoragePoolVolumeSnapshot(arg0, arg1, arg2, arg3 any) *MockServerCreateStoragePoolVolumeSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStoragePoolVolumeSnapshot", reflect.TypeOf((*MockServer)(nil).CreateStoragePoolVolumeSnapshot), arg0, arg1, arg2, arg3)
+	return &MockServerCreateStoragePoolVolumeSnapshotCall{Call: call}
+}
+
+// MockServerCreateStoragePoolVolumeSnapshotCall wrap *gomock.Call
+type MockServerCreateStoragePoolVolumeSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerCreateStoragePoolVolumeSnapshotCall) Return(arg0 lxd.Operation, arg1 error) *MockServerCreateStoragePoolVolumeSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerCreateStoragePoolVolumeSnapshotCall) Do(f func(string, string, string, api.StorageVolumeSnapshotsPost) (lxd.Operation, error)) *MockServerCreateStoragePoolVolumeSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerCreateStoragePoolVolumeSnapshotCall) DoAndReturn(f func(string, string, string, api.StorageVolumeSnapshotsPost) (lxd.Operation, error)) *MockServerCreateStoragePoolVolumeSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateVolume mocks base method.
 func (m *MockServer) CreateVolume(arg0, arg1 string, arg2 map[string]string) error {
 	m.ctrl.T.Helper()
@@ -391,6 +430,44 @@ func (c *MockServerCreateVolumeCall) DoAndReturn(f func(string, string, map[stri
 	return c
 }
 
+// CreateVolumeFromSnapshot mocks base method.
+func (m *MockServer) CreateVolumeFromSnapshot(arg0, arg1, arg2, arg3 string, arg4 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeFromSnapshot", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVolumeFromSnapshot indicates an expected call of CreateVolumeFromSnapshot.
+func (mr *MockServerMockRecorder) CreateVolumeFromSnapshot(arg0, arg1, arg2, arg3, arg4 any) *MockServerCreateVolumeFromSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeFromSnapshot", reflect.TypeOf((*MockServer)(nil).CreateVolumeFromSnapshot), arg0, arg1, arg2, arg3, arg4)
+	return &MockServerCreateVolumeFromSnapshotCall{Call: call}
+}
+
+// MockServerCreateVolumeFromSnapshotCall wrap *gomock.Call
+type MockServerCreateVolumeFromSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerCreateVolumeFromSnapshotCall) Return(arg0 error) *MockServerCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerCreateVolumeFromSnapshotCall) Do(f func(string, string, string, string, map[string]string) error) *MockServerCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerCreateVolumeFromSnapshotCall) DoAndReturn(f func(string, string, string, string, map[string]string) error) *MockServerCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteCertificate mocks base method.
 func (m *MockServer) DeleteCertificate(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -506,6 +583,45 @@ func (c *MockServerDeleteStoragePoolVolumeCall) DoAndReturn(f func(string, strin
 	return c
 }
 
+// DeleteStoragePoolVolumeSnapshot mocks base method.
+func (m *MockServer) DeleteStoragePoolVolumeSnapshot(arg0, arg1, arg2, arg3 string) (lxd.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStoragePoolVolumeSnapshot", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(lxd.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStoragePoolVolumeSnapshot indicates an expected call of DeleteStoragePoolVolumeSnapshot.
+func (mr *MockServerMockRecorder) DeleteStoragePoolVolumeSnapshot(arg0, arg1, arg2, arg3 any) *MockServerDeleteStoragePoolVolumeSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoragePoolVolumeSnapshot", reflect.TypeOf((*MockServer)(nil).DeleteStoragePoolVolumeSnapshot), arg0, arg1, arg2, arg3)
+	return &MockServerDeleteStoragePoolVolumeSnapshotCall{Call: call}
+}
+
+// MockServerDeleteStoragePoolVolumeSnapshotCall wrap *gomock.Call
+type MockServerDeleteStoragePoolVolumeSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerDeleteStoragePoolVolumeSnapshotCall) Return(arg0 lxd.Operation, arg1 error) *MockServerDeleteStoragePoolVolumeSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerDeleteStoragePoolVolumeSnapshotCall) Do(f func(string, string, string, string) (lxd.Operation, error)) *MockServerDeleteStoragePoolVolumeSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerDeleteStoragePoolVolumeSnapshotCall) DoAndReturn(f func(string, string, string, string) (lxd.Operation, error)) *MockServerDeleteStoragePoolVolumeSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // EnableHTTPSListener mocks base method.
 func (m *MockServer) EnableHTTPSListener() error {
 	m.ctrl.T.Helper()
@@ -1174,6 +1290,45 @@ func (c *MockServerGetStoragePoolVolumeCall) DoAndReturn(f func(string, string,
 	return c
 }
 
+// GetStoragePoolVolumeSnapshots mocks base method.
+func (m *MockServer) GetStoragePoolVolumeSnapshots(arg0, arg1, arg2 string) ([]api.StorageVolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoragePoolVolumeSnapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]api.StorageVolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoragePoolVolumeSnapshots indicates an expected call of GetStoragePoolVolumeSnapshots.
+func (mr *MockServerMockRecorder) GetStoragePoolVolumeSnapshots(arg0, arg1, arg2 any) *MockServerGetStoragePoolVolumeSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolVolumeSnapshots", reflect.TypeOf((*MockServer)(nil).GetStoragePoolVolumeSnapshots), arg0, arg1, arg2)
+	return &MockServerGetStoragePoolVolumeSnapshotsCall{Call: call}
+}
+
+// MockServerGetStoragePoolVolumeSnapshotsCall wrap *gomock.Call
+type MockServerGetStoragePoolVolumeSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerGetStoragePoolVolumeSnapshotsCall) Return(arg0 []api.StorageVolumeSnapshot, arg1 error) *MockServerGetStoragePoolVolumeSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerGetStoragePoolVolumeSnapshotsCall) Do(f func(string, string, string) ([]api.StorageVolumeSnapshot, error)) *MockServerGetStoragePoolVolumeSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerGetStoragePoolVolumeSnapshotsCall) DoAndReturn(f func(string, string, string) ([]api.StorageVolumeSnapshot, error)) *MockServerGetStoragePoolVolumeSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetStoragePoolVolumes mocks base method.
 func (m *MockServer) GetStoragePoolVolumes(arg0 string) ([]api.StorageVolume, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +72,10 @@ type Server interface {
 	CreateVolume(pool, name string, config map[string]string) error
 	UpdateStoragePoolVolume(pool string, volType string, name string, volume lxdapi.StorageVolumePut, ETag string) (lxdclient.Operation, error)
 	DeleteStoragePoolVolume(pool string, volType string, name string) (lxdclient.Operation, error)
+	CreateVolumeFromSnapshot(pool, name, sourcePool, snapshot string, config map[string]string) error
+	CreateStoragePoolVolumeSnapshot(pool string, volType string, name string, snapshot lxdapi.StorageVolumeSnapshotsPost) (lxdclient.Operation, error)
+	GetStoragePoolVolumeSnapshots(pool string, volType string, name string) ([]lxdapi.StorageVolumeSnapshot, error)
+	DeleteStoragePoolVolumeSnapshot(pool string, volType string, name string, snapshot string) (lxdclient.Operation, error)
 	ServerCertificate() string
 	HostArch() string
 	SupportedArches() []string
@@ -128,7 +132,8 @@ type ServerFactory interface {
 type InterfaceAddress interface {
 
 	// InterfaceAddress looks for the network interface
-	// and returns the IPv4 address from the possible addresses.
+	// and returns the IPv4 address from the possible addresses, or the
+	// IPv6 address if the interface has no IPv4 address.
 	// Returns an error if there is an issue locating the interface name or
 	// the address associated with it.
 	InterfaceAddress(string) (string, error)
@@ -137,7 +142,7 @@ type InterfaceAddress interface {
 type interfaceAddress struct{}
 
 func (interfaceAddress) InterfaceAddress(interfaceName string) (string, error) {
-	return utils.GetAddressForInterface(interfaceName)
+	return utils.GetV4OrV6AddressForInterface(interfaceName)
 }
 
 // NewHTTPClientFunc is responsible for generating a new http client every time
@@ -298,6 +303,10 @@ func (s *serverFactory) bootstrapLocalServer(ctx context.Context, svr Server) (S
 	if err != nil {
 		return nil, "", errors.Trace(err)
 	}
+	// LXD reports IPv6 addresses in brackets, as they appear in URLs.
+	if ip := net.ParseIP(hostAddress); ip != nil && ip.To4() == nil {
+		hostAddress = "[" + hostAddress + "]"
+	}
 	hostAddress = lxd.EnsureHTTPS(hostAddress)
 
 	// The following retry mechanism is required for newer LXD versions, where
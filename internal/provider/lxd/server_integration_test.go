@@ -67,6 +67,44 @@ func (s *serverIntegrationSuite) TestLocalServer(c *tc.C) {
 	c.Assert(err, tc.IsNil)
 }
 
+func (s *serverIntegrationSuite) TestLocalServerIPv6(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	profile := &api.Profile{}
+	etag := "etag"
+	bridgeName := "lxdbr0"
+	hostAddress := "fd42:1::1"
+	connectionInfo := &client.ConnectionInfo{
+		Addresses: []string{
+			"https://[fd42:1::1]:8443",
+		},
+	}
+
+	factory, server, interfaceAddr := lxd.NewLocalServerFactory(ctrl)
+
+	gomock.InOrder(
+		server.EXPECT().GetProfile("default").Return(profile, etag, nil),
+		server.EXPECT().VerifyNetworkDevice(profile, etag).Return(nil),
+		server.EXPECT().EnableHTTPSListener().Return(nil),
+		server.EXPECT().LocalBridgeName().Return(bridgeName),
+		interfaceAddr.EXPECT().InterfaceAddress(bridgeName).Return(hostAddress, nil),
+		server.EXPECT().GetConnectionInfo().Return(connectionInfo, nil),
+		server.EXPECT().StorageSupported().Return(true),
+		server.EXPECT().GetProfile("default").Return(profile, etag, nil),
+		server.EXPECT().EnsureDefaultStorage(profile, etag).Return(nil),
+		server.EXPECT().ServerVersion().Return("5.2"),
+	)
+
+	svr, err := factory.LocalServer()
+	c.Assert(err, tc.IsNil)
+	c.Assert(svr, tc.Equals, server)
+
+	addr, err := factory.LocalServerAddress()
+	c.Assert(err, tc.IsNil)
+	c.Check(addr, tc.Equals, "https://[fd42:1::1]:8443")
+}
+
 func (s *serverIntegrationSuite) TestLocalServerRetrySemantics(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -263,6 +263,11 @@ type lxdFilesystemSource struct {
 	env *environ
 }
 
+var (
+	_ storage.VolumeSnapshotter = (*lxdFilesystemSource)(nil)
+	_ storage.StorageResizer    = (*lxdFilesystemSource)(nil)
+)
+
 // CreateFilesystems is specified on the storage.FilesystemSource interface.
 func (s *lxdFilesystemSource) CreateFilesystems(ctx context.Context, args []storage.FilesystemParams) (_ []storage.CreateFilesystemsResult, err error) {
 	results := make([]storage.CreateFilesystemsResult, len(args))
@@ -312,7 +317,17 @@ func (s *lxdFilesystemSource) createFilesystem(
 		config["size"] = fmt.Sprintf("%dMiB", arg.Size)
 	}
 
-	if err := s.env.server().CreateVolume(cfg.lxdPool, volumeName, config); err != nil {
+	if arg.SnapshotId != "" {
+		snapshotPool, snapshotVolume, snapshotName, err := parseSnapshotId(arg.SnapshotId)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if err := s.env.server().CreateVolumeFromSnapshot(
+			cfg.lxdPool, volumeName, snapshotPool, snapshotVolume+"/"+snapshotName, config,
+		); err != nil {
+			return nil, errors.Annotate(err, "creating volume from snapshot")
+		}
+	} else if err := s.env.server().CreateVolume(cfg.lxdPool, volumeName, config); err != nil {
 		return nil, errors.Annotate(err, "creating volume")
 	}
 
@@ -344,6 +359,25 @@ func parseFilesystemId(id string) (lxdPool, volumeName string, _ error) {
 	return fields[0], fields[1], nil
 }
 
+func makeSnapshotId(filesystemId, snapshotName string) string {
+	return fmt.Sprintf("%s/%s", filesystemId, snapshotName)
+}
+
+// parseSnapshotId parses the given snapshot ID, returning the underlying
+// LXD storage pool name, volume name and snapshot name.
+func parseSnapshotId(id string) (lxdPool, volumeName, snapshotName string, _ error) {
+	filesystemId, snapshotName, ok := strings.Cut(id, "/")
+	if ok {
+		lxdPool, volumeName, err := parseFilesystemId(filesystemId)
+		if err == nil && snapshotName != "" {
+			return lxdPool, volumeName, snapshotName, nil
+		}
+	}
+	return "", "", "", errors.Errorf(
+		"invalid snapshot ID %q; expected ID in format <lxd-pool>:<volume-name>/<snapshot-name>", id,
+	)
+}
+
 // TODO (manadart 2018-06-28) Add a test for DestroyController that properly
 // verifies this behaviour.
 func destroyControllerFilesystems(env *environ, controllerUUID string) error {
@@ -662,3 +696,161 @@ func (s *lxdFilesystemSource) ImportFilesystem(
 		Size:       size,
 	}, nil
 }
+
+// CreateSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (s *lxdFilesystemSource) CreateSnapshots(ctx context.Context, args []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	results := make([]storage.CreateSnapshotsResult, len(args))
+	for i, arg := range args {
+		snapshot, err := s.createSnapshot(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(
+				s.env.HandleCredentialError(ctx, err), "creating snapshot %q of %q", arg.Name, arg.ProviderId,
+			)
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func (s *lxdFilesystemSource) createSnapshot(arg storage.SnapshotParams) (*storage.SnapshotInfo, error) {
+	if arg.Name == "" || strings.Contains(arg.Name, "/") {
+		return nil, errors.NotValidf("snapshot name %q", arg.Name)
+	}
+	poolName, volumeName, err := parseFilesystemId(arg.ProviderId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// LXD snapshots have no config of their own, so the resource tags
+	// are not recorded.
+	op, err := s.env.server().CreateStoragePoolVolumeSnapshot(
+		poolName, storagePoolVolumeType, volumeName, api.StorageVolumeSnapshotsPost{Name: arg.Name},
+	)
+	if err == nil {
+		err = op.Wait()
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &storage.SnapshotInfo{
+		SnapshotId: makeSnapshotId(arg.ProviderId, arg.Name),
+		Name:       arg.Name,
+		ProviderId: arg.ProviderId,
+	}, nil
+}
+
+// ListSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (s *lxdFilesystemSource) ListSnapshots(ctx context.Context, filesystemId string) ([]storage.SnapshotInfo, error) {
+	poolName, volumeName, err := parseFilesystemId(filesystemId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	lxdSnapshots, err := s.env.server().GetStoragePoolVolumeSnapshots(poolName, storagePoolVolumeType, volumeName)
+	if err != nil {
+		return nil, errors.Trace(s.env.HandleCredentialError(ctx, err))
+	}
+	snapshots := make([]storage.SnapshotInfo, len(lxdSnapshots))
+	for i, snapshot := range lxdSnapshots {
+		// Depending on the LXD version, the name may be qualified
+		// with the volume name.
+		name := snapshot.Name[strings.LastIndex(snapshot.Name, "/")+1:]
+		snapshots[i] = storage.SnapshotInfo{
+			SnapshotId: makeSnapshotId(filesystemId, name),
+			Name:       name,
+			ProviderId: filesystemId,
+			CreatedAt:  snapshot.CreatedAt.UTC(),
+		}
+		if sizeString := snapshot.Config["size"]; sizeString != "" {
+			if n, err := units.ParseByteSizeString(sizeString); err == nil {
+				snapshots[i].Size = uint64(n / (1024 * 1024))
+			}
+		}
+	}
+	return snapshots, nil
+}
+
+// DestroySnapshots is specified on the storage.VolumeSnapshotter interface.
+func (s *lxdFilesystemSource) DestroySnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		err := s.destroySnapshot(snapshotId)
+		if err == nil {
+			continue
+		}
+		results[i] = s.env.HandleCredentialError(ctx, err)
+	}
+	return results, nil
+}
+
+func (s *lxdFilesystemSource) destroySnapshot(snapshotId string) error {
+	poolName, volumeName, snapshotName, err := parseSnapshotId(snapshotId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	op, err := s.env.server().DeleteStoragePoolVolumeSnapshot(poolName, storagePoolVolumeType, volumeName, snapshotName)
+	if err == nil {
+		err = op.Wait()
+	}
+	if err != nil && !lxd.IsLXDNotFound(err) {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// ResizeStorage is specified on the storage.StorageResizer interface.
+func (s *lxdFilesystemSource) ResizeStorage(ctx context.Context, args []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+	results := make([]storage.ResizeStorageResult, len(args))
+	for i, arg := range args {
+		size, err := s.resizeFilesystem(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(
+				s.env.HandleCredentialError(ctx, err), "resizing filesystem %q", arg.ProviderId,
+			)
+			continue
+		}
+		results[i].Size = size
+	}
+	return results, nil
+}
+
+func (s *lxdFilesystemSource) resizeFilesystem(arg storage.ResizeParams) (uint64, error) {
+	poolName, volumeName, err := parseFilesystemId(arg.ProviderId)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	server := s.env.server()
+	volume, eTag, err := server.GetStoragePoolVolume(poolName, storagePoolVolumeType, volumeName)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	// NOTE(axw) for the "dir" driver, the size attribute is rejected
+	// by LXD, so volumes without a size cannot be resized.
+	sizeString := volume.Config["size"]
+	if sizeString == "" {
+		return 0, errors.NotSupportedf("resizing volume %q in pool %q without a size", volumeName, poolName)
+	}
+	n, err := units.ParseByteSizeString(sizeString)
+	if err != nil {
+		return 0, errors.Annotate(err, "parsing size")
+	}
+	currentSize := uint64(n / (1024 * 1024))
+	if arg.Size < currentSize {
+		return 0, errors.NotValidf(
+			"shrinking filesystem from %dMiB to %dMiB", currentSize, arg.Size,
+		)
+	} else if arg.Size == currentSize {
+		return currentSize, nil
+	}
+
+	volume.Config["size"] = fmt.Sprintf("%dMiB", arg.Size)
+	op, err := server.UpdateStoragePoolVolume(
+		poolName, storagePoolVolumeType, volumeName, volume.Writable(), eTag)
+	if err == nil {
+		err = op.Wait()
+	}
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return arg.Size, nil
+}
@@ -5,6 +5,7 @@ package lxd_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/canonical/lxd/shared/api"
 	"github.com/juju/errors"
@@ -687,6 +688,209 @@ func (s *storageSuite) TestImportFilesystemInvalidCredentialsUpdatePool(c *tc.C)
 	c.Assert(info, tc.DeepEquals, storage.FilesystemInfo{})
 }
 
+func (s *storageSuite) TestCreateFilesystemsFromSnapshot(c *tc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	source := s.filesystemSource(c, "source")
+	results, err := source.CreateFilesystems(c.Context(), []storage.FilesystemParams{{
+		Tag:        names.NewFilesystemTag("1"),
+		Provider:   "lxd",
+		Size:       1024,
+		SnapshotId: "radiance:juju-f75cba-filesystem-0/pre-refresh",
+		Attributes: map[string]any{
+			"lxd-pool": "radiance",
+			"driver":   "btrfs",
+		},
+	}, {
+		Tag:        names.NewFilesystemTag("2"),
+		Provider:   "lxd",
+		Size:       1024,
+		SnapshotId: "radiance:juju-f75cba-filesystem-0",
+		Attributes: map[string]any{
+			"lxd-pool": "radiance",
+			"driver":   "btrfs",
+		},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Filesystem.ProviderId, tc.Equals, "radiance:juju-f75cba-filesystem-1")
+	c.Check(results[1].Error, tc.ErrorMatches, `invalid snapshot ID .*; expected ID in format <lxd-pool>:<volume-name>/<snapshot-name>`)
+
+	s.Stub.CheckCallNames(c, "CreatePool", "CreateVolumeFromSnapshot", "CreatePool")
+	s.Stub.CheckCall(c, 1, "CreateVolumeFromSnapshot",
+		"radiance", "juju-f75cba-filesystem-1", "radiance", "juju-f75cba-filesystem-0/pre-refresh",
+		map[string]string{"size": "1024MiB"},
+	)
+}
+
+func (s *storageSuite) TestCreateSnapshots(c *tc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	s.Stub.SetErrors(nil, errors.New("boom"))
+	snapshotter := s.snapshotter(c)
+	results, err := snapshotter.CreateSnapshots(c.Context(), []storage.SnapshotParams{{
+		Name:       "pre-refresh",
+		ProviderId: "pool0:filesystem-0",
+	}, {
+		Name:       "pre-refresh",
+		ProviderId: "pool1:filesystem-1",
+	}, {
+		Name:       "not/valid",
+		ProviderId: "pool0:filesystem-0",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 3)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Snapshot, tc.DeepEquals, &storage.SnapshotInfo{
+		SnapshotId: "pool0:filesystem-0/pre-refresh",
+		Name:       "pre-refresh",
+		ProviderId: "pool0:filesystem-0",
+	})
+	c.Check(results[1].Error, tc.ErrorMatches, `creating snapshot "pre-refresh" of "pool1:filesystem-1": boom`)
+	c.Check(results[2].Error, tc.ErrorIs, errors.NotValid)
+
+	s.Stub.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "CreateStoragePoolVolumeSnapshot", Args: []any{
+			"pool0", "custom", "filesystem-0", api.StorageVolumeSnapshotsPost{Name: "pre-refresh"},
+		}},
+		{FuncName: "CreateStoragePoolVolumeSnapshot", Args: []any{
+			"pool1", "custom", "filesystem-1", api.StorageVolumeSnapshotsPost{Name: "pre-refresh"},
+		}},
+	})
+}
+
+func (s *storageSuite) TestListSnapshots(c *tc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	created := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+	s.Client.Snapshots = map[string][]api.StorageVolumeSnapshot{
+		"pool0:filesystem-0": {{
+			Name:      "filesystem-0/pre-refresh",
+			CreatedAt: created,
+			Config:    map[string]string{"size": "1GiB"},
+		}, {
+			Name:      "nightly",
+			CreatedAt: created,
+		}},
+	}
+	snapshotter := s.snapshotter(c)
+	snapshots, err := snapshotter.ListSnapshots(c.Context(), "pool0:filesystem-0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(snapshots, tc.DeepEquals, []storage.SnapshotInfo{{
+		SnapshotId: "pool0:filesystem-0/pre-refresh",
+		Name:       "pre-refresh",
+		ProviderId: "pool0:filesystem-0",
+		Size:       1024,
+		CreatedAt:  created,
+	}, {
+		SnapshotId: "pool0:filesystem-0/nightly",
+		Name:       "nightly",
+		ProviderId: "pool0:filesystem-0",
+		CreatedAt:  created,
+	}})
+	s.Stub.CheckCall(c, 0, "GetStoragePoolVolumeSnapshots", "pool0", "custom", "filesystem-0")
+}
+
+func (s *storageSuite) TestDestroySnapshots(c *tc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	s.Stub.SetErrors(nil, errors.New("boom"))
+	snapshotter := s.snapshotter(c)
+	results, err := snapshotter.DestroySnapshots(c.Context(), []string{
+		"pool0:filesystem-0",
+		"pool0:filesystem-0/one",
+		"pool1:filesystem-1/two",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 3)
+	c.Check(results[0], tc.ErrorMatches, `invalid snapshot ID "pool0:filesystem-0"; expected ID in format <lxd-pool>:<volume-name>/<snapshot-name>`)
+	c.Check(results[1], tc.ErrorIsNil)
+	c.Check(results[2], tc.ErrorMatches, "boom")
+
+	s.Stub.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "DeleteStoragePoolVolumeSnapshot", Args: []any{"pool0", "custom", "filesystem-0", "one"}},
+		{FuncName: "DeleteStoragePoolVolumeSnapshot", Args: []any{"pool1", "custom", "filesystem-1", "two"}},
+	})
+}
+
+func (s *storageSuite) TestDestroySnapshotsInvalidCredentials(c *tc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	s.Invalidator.EXPECT().InvalidateCredentials(gomock.Any(), gomock.Any()).Return(nil)
+
+	s.Client.Stub.SetErrors(errTestUnAuth)
+	snapshotter := s.snapshotter(c)
+	results, err := snapshotter.DestroySnapshots(c.Context(), []string{
+		"pool0:filesystem-0/one",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Check(results[0], tc.ErrorMatches, "not authorized")
+}
+
+func (s *storageSuite) TestResizeStorage(c *tc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	s.Client.Volumes = map[string][]api.StorageVolume{
+		"foo": {{
+			Name: "bar",
+			Config: map[string]string{
+				"size": "10GiB",
+			},
+		}, {
+			Name:   "dir",
+			Config: map[string]string{},
+		}},
+	}
+
+	resizer := s.resizer(c)
+	results, err := resizer.ResizeStorage(c.Context(), []storage.ResizeParams{{
+		ProviderId: "foo:bar",
+		Size:       20 * 1024,
+	}, {
+		ProviderId: "foo:bar",
+		Size:       1024,
+	}, {
+		ProviderId: "foo:dir",
+		Size:       1024,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 3)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Size, tc.Equals, uint64(20*1024))
+	c.Check(results[1].Error, tc.ErrorIs, errors.NotValid)
+	c.Check(results[2].Error, tc.ErrorIs, errors.NotSupported)
+
+	update := api.StorageVolumePut{
+		Config: map[string]string{
+			"size": "20480MiB",
+		},
+	}
+	s.Stub.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "GetStoragePoolVolume", Args: []any{"foo", "custom", "bar"}},
+		{FuncName: "UpdateStoragePoolVolume", Args: []any{"foo", "custom", "bar", update, "eTag"}},
+		{FuncName: "GetStoragePoolVolume", Args: []any{"foo", "custom", "bar"}},
+		{FuncName: "GetStoragePoolVolume", Args: []any{"foo", "custom", "dir"}},
+	})
+}
+
+func (s *storageSuite) TestResizeStorageInvalidCredentials(c *tc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	s.Invalidator.EXPECT().InvalidateCredentials(gomock.Any(), gomock.Any()).Return(nil)
+
+	s.Client.Stub.SetErrors(errTestUnAuth)
+	resizer := s.resizer(c)
+	results, err := resizer.ResizeStorage(c.Context(), []storage.ResizeParams{{
+		ProviderId: "foo:bar",
+		Size:       1024,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Check(results[0].Error, tc.ErrorMatches, `resizing filesystem "foo:bar": not authorized`)
+}
+
 func (s *storageSuite) SetupMocks(c *tc.C) *gomock.Controller {
 	ctrl := s.BaseSuite.SetupMocks(c)
 
@@ -704,3 +908,15 @@ func (s *storageSuite) filesystemSource(c *tc.C, pool string) storage.Filesystem
 	c.Assert(err, tc.ErrorIsNil)
 	return filesystemSource
 }
+
+func (s *storageSuite) snapshotter(c *tc.C) storage.VolumeSnapshotter {
+	snapshotter, ok := s.filesystemSource(c, "source").(storage.VolumeSnapshotter)
+	c.Assert(ok, tc.IsTrue)
+	return snapshotter
+}
+
+func (s *storageSuite) resizer(c *tc.C) storage.StorageResizer {
+	resizer, ok := s.filesystemSource(c, "source").(storage.StorageResizer)
+	c.Assert(ok, tc.IsTrue)
+	return resizer
+}
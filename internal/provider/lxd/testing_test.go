@@ -401,6 +401,7 @@ type StubClient struct {
 	Profile            *api.Profile
 	StorageIsSupported bool
 	Volumes            map[string][]api.StorageVolume
+	Snapshots          map[string][]api.StorageVolumeSnapshot
 	ServerCert         string
 	ServerHostArch     string
 	ServerVer          string
@@ -622,6 +623,35 @@ func (conn *StubClient) UpdateStoragePoolVolume(
 	return stubOperation{}, conn.NextErr()
 }
 
+func (conn *StubClient) CreateVolumeFromSnapshot(pool, volume, sourcePool, snapshot string, config map[string]string) error {
+	conn.AddCall("CreateVolumeFromSnapshot", pool, volume, sourcePool, snapshot, config)
+	return conn.NextErr()
+}
+
+func (conn *StubClient) CreateStoragePoolVolumeSnapshot(
+	pool string, volType string, name string, snapshot api.StorageVolumeSnapshotsPost,
+) (lxdclient.Operation, error) {
+	conn.AddCall("CreateStoragePoolVolumeSnapshot", pool, volType, name, snapshot)
+	return stubOperation{}, conn.NextErr()
+}
+
+func (conn *StubClient) GetStoragePoolVolumeSnapshots(
+	pool string, volType string, name string,
+) ([]api.StorageVolumeSnapshot, error) {
+	conn.AddCall("GetStoragePoolVolumeSnapshots", pool, volType, name)
+	if err := conn.NextErr(); err != nil {
+		return nil, err
+	}
+	return conn.Snapshots[pool+":"+name], nil
+}
+
+func (conn *StubClient) DeleteStoragePoolVolumeSnapshot(
+	pool string, volType string, name string, snapshot string,
+) (lxdclient.Operation, error) {
+	conn.AddCall("DeleteStoragePoolVolumeSnapshot", pool, volType, name, snapshot)
+	return stubOperation{}, conn.NextErr()
+}
+
 func (conn *StubClient) AliveContainers(prefix string) ([]lxd.Container, error) {
 	conn.AddCall("AliveContainers", prefix)
 	if err := conn.NextErr(); err != nil {
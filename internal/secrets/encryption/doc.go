@@ -0,0 +1,31 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package encryption provides the envelope encryption of secret content
+// stored by the internal juju secret backend.
+//
+// Each model has its own data key, generated when the first secret content
+// of the model is stored. Secret content is encrypted with the data key
+// using AES-256-GCM. The data key itself is only ever stored wrapped by a
+// controller master key, which is never stored in the database.
+//
+// Each value is sealed with the ID of its secret, the UUID of its revision
+// and its key as additional authenticated data. Someone able to write to
+// the database, but without the data key, cannot make a sealed value read
+// as the content of another key, revision or secret of the model; it fails
+// to open instead.
+//
+// The master key comes from a source named by the controller config:
+//   - file:<path> reads the keys from a local file, one base64 encoded
+//     256-bit key per line. The first key is the active key, used to wrap
+//     new data keys; the other keys are only used to unwrap data keys
+//     wrapped before the active key was added.
+//   - keystore:<dir> uses a local stand-in for a KMIP or PKCS#11 key
+//     manager: each key is stored in <dir>/<label>.key, the label of the
+//     active key is read from <dir>/active, and data keys are wrapped with
+//     the AES key wrap algorithm (RFC 3394, CKM_AES_KEY_WRAP).
+//
+// Rotating the master key adds a new active key to the source and then
+// re-wraps the data key of each model with it. Secret content does not
+// need to be encrypted again, so secrets remain readable throughout.
+package encryption
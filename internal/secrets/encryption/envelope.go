@@ -0,0 +1,159 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package encryption
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// DataKeySize is the size in bytes of a data key or master key.
+	DataKeySize = 32
+
+	// sealedPrefix marks a sealed value. Secret content values are base64
+	// encoded, so they never contain the colon of the prefix.
+	sealedPrefix = "enc:v1:"
+)
+
+// GenerateDataKey returns a new random data key.
+func GenerateDataKey() ([]byte, error) {
+	key := make([]byte, DataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Errorf("generating data key: %w", err)
+	}
+	return key, nil
+}
+
+// SecretRevision identifies the secret revision holding sealed content.
+type SecretRevision struct {
+	// SecretID is the ID of the secret URI.
+	SecretID string
+
+	// RevisionID is the UUID of the revision.
+	RevisionID string
+}
+
+// Validate returns an error if the secret or revision is not set.
+func (r SecretRevision) Validate() error {
+	if r.SecretID == "" || r.RevisionID == "" {
+		return errors.Errorf("sealing secret content requires a secret ID and revision ID, got %q and %q",
+			r.SecretID, r.RevisionID)
+	}
+	return nil
+}
+
+// additionalData returns the additional authenticated data for the named
+// value of the revision. The IDs never contain a slash, so the name, which
+// may, is unambiguous as the last element.
+func (r SecretRevision) additionalData(name string) []byte {
+	return []byte(r.SecretID + "/" + r.RevisionID + "/" + name)
+}
+
+// IsSealed returns true if the value was sealed by Seal.
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
+
+// Seal encrypts the value with the data key. The secret, the revision and
+// the name of the value, such as the key of a secret content value, are
+// bound to the ciphertext as additional authenticated data. A sealed value
+// copied to another key, revision or secret sharing the data key of the
+// model therefore fails to open, rather than disclosing its content there.
+func Seal(dataKey []byte, rev SecretRevision, name, value string) (string, error) {
+	if err := rev.Validate(); err != nil {
+		return "", errors.Capture(err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Errorf("generating nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(value), rev.additionalData(name))
+	return sealedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value sealed with the data key for the named value of the
+// secret revision. Values that are not sealed, such as those stored before
+// encryption was enabled, are returned unchanged.
+func Open(dataKey []byte, rev SecretRevision, name, value string) (string, error) {
+	if !IsSealed(value) {
+		return value, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, sealedPrefix))
+	if err != nil {
+		return "", errors.Errorf("decoding sealed value %q: %w", name, err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.Errorf("sealed value %q too short", name)
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, rev.additionalData(name))
+	if err != nil {
+		return "", errors.Errorf("decrypting sealed value %q: %w", name, err)
+	}
+	return string(plaintext), nil
+}
+
+// SealData seals each value of the content of the secret revision with the
+// data key.
+func SealData(dataKey []byte, rev SecretRevision, data map[string]string) (map[string]string, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	sealed := make(map[string]string, len(data))
+	for name, value := range data {
+		v, err := Seal(dataKey, rev, name, value)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		sealed[name] = v
+	}
+	return sealed, nil
+}
+
+// OpenData opens each sealed value of the content of the secret revision
+// with the data key.
+func OpenData(dataKey []byte, rev SecretRevision, data map[string]string) (map[string]string, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	opened := make(map[string]string, len(data))
+	for name, value := range data {
+		v, err := Open(dataKey, rev, name, value)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		opened[name] = v
+	}
+	return opened, nil
+}
+
+// HasSealed returns true if any value of the secret content is sealed.
+func HasSealed(data map[string]string) bool {
+	for _, value := range data {
+		if IsSealed(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != DataKeySize {
+		return nil, errors.Errorf("expected a %d byte data key, got %d bytes", DataKeySize, len(key))
+	}
+	return newGCM(key)
+}
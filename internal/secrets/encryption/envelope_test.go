@@ -0,0 +1,127 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+)
+
+type envelopeSuite struct{}
+
+var testRevision = SecretRevision{
+	SecretID:   "d3d9p4ulnfvc7ahgr0f0",
+	RevisionID: "5e7a0bb6-3b83-4d2c-8a1a-5a0f9f0e3a11",
+}
+
+func TestEnvelopeSuite(t *testing.T) {
+	tc.Run(t, &envelopeSuite{})
+}
+
+func (s *envelopeSuite) TestSealOpen(c *tc.C) {
+	key, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	sealed, err := Seal(key, testRevision, "password", "c2VjcmV0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(IsSealed(sealed), tc.IsTrue)
+	c.Check(sealed, tc.Not(tc.Contains), "c2VjcmV0")
+
+	opened, err := Open(key, testRevision, "password", sealed)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(opened, tc.Equals, "c2VjcmV0")
+}
+
+func (s *envelopeSuite) TestSealIsRandomised(c *tc.C) {
+	key, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	first, err := Seal(key, testRevision, "password", "c2VjcmV0")
+	c.Assert(err, tc.ErrorIsNil)
+	second, err := Seal(key, testRevision, "password", "c2VjcmV0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(first, tc.Not(tc.Equals), second)
+}
+
+func (s *envelopeSuite) TestOpenPlaintext(c *tc.C) {
+	key, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Values stored before encryption was enabled are returned as is.
+	opened, err := Open(key, testRevision, "password", "c2VjcmV0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(opened, tc.Equals, "c2VjcmV0")
+}
+
+func (s *envelopeSuite) TestOpenWrongName(c *tc.C) {
+	key, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	sealed, err := Seal(key, testRevision, "password", "c2VjcmV0")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = Open(key, testRevision, "username", sealed)
+	c.Check(err, tc.ErrorMatches, `decrypting sealed value "username": .*`)
+}
+
+func (s *envelopeSuite) TestOpenWrongKey(c *tc.C) {
+	key, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+	other, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	sealed, err := Seal(key, testRevision, "password", "c2VjcmV0")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = Open(other, testRevision, "password", sealed)
+	c.Check(err, tc.ErrorMatches, `decrypting sealed value "password": .*`)
+}
+
+func (s *envelopeSuite) TestSealOpenData(c *tc.C) {
+	key, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	data := map[string]string{"username": "Ym9i", "password": "c2VjcmV0"}
+	sealed, err := SealData(key, testRevision, data)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(sealed, tc.HasLen, 2)
+	c.Check(HasSealed(sealed), tc.IsTrue)
+	c.Check(HasSealed(data), tc.IsFalse)
+
+	opened, err := OpenData(key, testRevision, sealed)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(opened, tc.DeepEquals, data)
+}
+
+func (s *envelopeSuite) TestSealInvalidKey(c *tc.C) {
+	_, err := Seal([]byte("short"), testRevision, "password", "c2VjcmV0")
+	c.Check(err, tc.ErrorMatches, "expected a 32 byte data key, got 5 bytes")
+}
+
+func (s *envelopeSuite) TestOpenWrongRevision(c *tc.C) {
+	key, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	sealed, err := Seal(key, testRevision, "password", "c2VjcmV0")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The value cannot be opened as part of another revision of the
+	// secret, nor as part of another secret.
+	otherRevision := testRevision
+	otherRevision.RevisionID = "0b1f8c52-7d4e-4f0a-9c3b-2e6d8a7f1c90"
+	_, err = Open(key, otherRevision, "password", sealed)
+	c.Check(err, tc.ErrorMatches, `decrypting sealed value "password": .*`)
+
+	otherSecret := testRevision
+	otherSecret.SecretID = "d3d9p4ulnfvc7ahgr0g0"
+	_, err = Open(key, otherSecret, "password", sealed)
+	c.Check(err, tc.ErrorMatches, `decrypting sealed value "password": .*`)
+}
+
+func (s *envelopeSuite) TestSealRequiresRevision(c *tc.C) {
+	key, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = Seal(key, SecretRevision{SecretID: testRevision.SecretID}, "password", "c2VjcmV0")
+	c.Check(err, tc.ErrorMatches, `sealing secret content requires a secret ID and revision ID, .*`)
+}
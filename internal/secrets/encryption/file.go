@@ -0,0 +1,125 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// wrapAAD is bound to data keys wrapped by file keys, so that a wrapped
+// data key cannot be mistaken for other data encrypted by the same key.
+var wrapAAD = []byte("juju-secret-data-key")
+
+// fileKeys holds master keys read from a local file.
+type fileKeys struct {
+	activeID string
+	keys     map[string][]byte
+}
+
+// LoadFileKeys reads the master keys from a file holding one base64 encoded
+// 256-bit key per line. The first key is the active key. Blank lines and
+// lines starting with # are ignored.
+func LoadFileKeys(path string) (MasterKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Errorf("reading master key file: %w", err).Add(InvalidSource)
+	}
+	return parseFileKeys(data)
+}
+
+func parseFileKeys(data []byte) (*fileKeys, error) {
+	fk := &fileKeys{keys: make(map[string][]byte)}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(line)
+		if err != nil {
+			return nil, errors.Errorf("line %d: decoding key: %w", n, err).Add(InvalidSource)
+		}
+		if len(key) != DataKeySize {
+			return nil, errors.Errorf("line %d: expected a %d byte key, got %d bytes",
+				n, DataKeySize, len(key)).Add(InvalidSource)
+		}
+		id := fileKeyID(key)
+		if fk.activeID == "" {
+			fk.activeID = id
+		}
+		fk.keys[id] = key
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Errorf("reading master key file: %w", err).Add(InvalidSource)
+	}
+	if fk.activeID == "" {
+		return nil, errors.Errorf("master key file holds no keys").Add(InvalidSource)
+	}
+	return fk, nil
+}
+
+// fileKeyID identifies a key by its fingerprint, so that the key itself
+// is never stored.
+func fileKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return SourceFile + ":" + hex.EncodeToString(sum[:8])
+}
+
+// ActiveKeyID is part of the [MasterKey] interface.
+func (fk *fileKeys) ActiveKeyID() string {
+	return fk.activeID
+}
+
+// Wrap is part of the [MasterKey] interface.
+func (fk *fileKeys) Wrap(dataKey []byte) ([]byte, error) {
+	aead, err := newGCM(fk.keys[fk.activeID])
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Errorf("generating nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, dataKey, wrapAAD), nil
+}
+
+// Unwrap is part of the [MasterKey] interface.
+func (fk *fileKeys) Unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	key, ok := fk.keys[keyID]
+	if !ok {
+		return nil, errors.Errorf("key %q not found", keyID).Add(KeyNotFound)
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.Errorf("wrapped data key too short")
+	}
+	nonce, ciphertext := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, ciphertext, wrapAAD)
+	if err != nil {
+		return nil, errors.Errorf("unwrapping data key with key %q: %w", keyID, err)
+	}
+	return dataKey, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// activeLabelFile names the file in a keystore holding the label of the
+	// active key.
+	activeLabelFile = "active"
+
+	// keyFileSuffix is the suffix of the files in a keystore holding keys.
+	keyFileSuffix = ".key"
+)
+
+// keystore is a local stand-in for a KMIP or PKCS#11 key manager. Keys are
+// addressed by label, as with CKA_LABEL, and data keys are wrapped with the
+// AES key wrap algorithm, as with CKM_AES_KEY_WRAP.
+type keystore struct {
+	dir         string
+	activeLabel string
+	active      []byte
+}
+
+// LoadKeystore opens the keystore in dir. The directory holds a file named
+// active containing the label of the active key, and a <label>.key file
+// holding each base64 encoded 256-bit key.
+func LoadKeystore(dir string) (MasterKey, error) {
+	label, err := os.ReadFile(filepath.Join(dir, activeLabelFile))
+	if err != nil {
+		return nil, errors.Errorf("reading active key label: %w", err).Add(InvalidSource)
+	}
+	ks := &keystore{
+		dir:         dir,
+		activeLabel: strings.TrimSpace(string(label)),
+	}
+	if ks.activeLabel == "" {
+		return nil, errors.Errorf("empty active key label").Add(InvalidSource)
+	}
+	ks.active, err = ks.readKey(ks.activeLabel)
+	if errors.Is(err, KeyNotFound) {
+		return nil, errors.Errorf("active key %q: %w", ks.activeLabel, err).Add(InvalidSource)
+	} else if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return ks, nil
+}
+
+// readKey reads the key with the given label. Keys other than the active
+// key are only read when unwrapping data keys wrapped before a rotation.
+func (ks *keystore) readKey(label string) ([]byte, error) {
+	if label == "" || label != filepath.Base(label) || strings.HasPrefix(label, ".") {
+		return nil, errors.Errorf("invalid key label %q", label).Add(KeyNotFound)
+	}
+	data, err := os.ReadFile(filepath.Join(ks.dir, label+keyFileSuffix))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Errorf("key %q not found", label).Add(KeyNotFound)
+	} else if err != nil {
+		return nil, errors.Errorf("reading key %q: %w", label, err).Add(InvalidSource)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, errors.Errorf("decoding key %q: %w", label, err).Add(InvalidSource)
+	}
+	if len(key) != DataKeySize {
+		return nil, errors.Errorf("key %q: expected %d bytes, got %d bytes",
+			label, DataKeySize, len(key)).Add(InvalidSource)
+	}
+	return key, nil
+}
+
+// ActiveKeyID is part of the [MasterKey] interface.
+func (ks *keystore) ActiveKeyID() string {
+	return SourceKeystore + ":" + ks.activeLabel
+}
+
+// Wrap is part of the [MasterKey] interface.
+func (ks *keystore) Wrap(dataKey []byte) ([]byte, error) {
+	return aesKeyWrap(ks.active, dataKey)
+}
+
+// Unwrap is part of the [MasterKey] interface.
+func (ks *keystore) Unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	label, ok := strings.CutPrefix(keyID, SourceKeystore+":")
+	if !ok {
+		return nil, errors.Errorf("key %q not found", keyID).Add(KeyNotFound)
+	}
+	key := ks.active
+	if label != ks.activeLabel {
+		var err error
+		if key, err = ks.readKey(label); err != nil {
+			return nil, errors.Capture(err)
+		}
+	}
+	dataKey, err := aesKeyUnwrap(key, wrapped)
+	if err != nil {
+		return nil, errors.Errorf("unwrapping data key with key %q: %w", keyID, err)
+	}
+	return dataKey, nil
+}
+
+// defaultIV is the initial value of the AES key wrap algorithm.
+var defaultIV = []byte{0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6}
+
+// aesKeyWrap wraps the plaintext key with the key encryption key, as
+// described in RFC 3394 section 2.2.1.
+func aesKeyWrap(kek, plaintext []byte) ([]byte, error) {
+	if len(plaintext)%8 != 0 || len(plaintext) < 16 {
+		return nil, errors.Errorf("key to wrap must be a multiple of 8 bytes and at least 16 bytes")
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	n := len(plaintext) / 8
+	out := make([]byte, 8+len(plaintext))
+	copy(out, defaultIV)
+	copy(out[8:], plaintext)
+
+	buf := make([]byte, 16)
+	for j := 0; j < 6; j++ {
+		for i := 1; i <= n; i++ {
+			copy(buf, out[:8])
+			copy(buf[8:], out[i*8:i*8+8])
+			block.Encrypt(buf, buf)
+			t := uint64(n*j + i)
+			binary.BigEndian.PutUint64(out[:8], binary.BigEndian.Uint64(buf[:8])^t)
+			copy(out[i*8:], buf[8:])
+		}
+	}
+	return out, nil
+}
+
+// aesKeyUnwrap unwraps a key wrapped by aesKeyWrap, as described in
+// RFC 3394 section 2.2.2.
+func aesKeyUnwrap(kek, wrapped []byte) ([]byte, error) {
+	if len(wrapped)%8 != 0 || len(wrapped) < 24 {
+		return nil, errors.Errorf("wrapped key must be a multiple of 8 bytes and at least 24 bytes")
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	n := len(wrapped)/8 - 1
+	out := make([]byte, len(wrapped))
+	copy(out, wrapped)
+
+	buf := make([]byte, 16)
+	for j := 5; j >= 0; j-- {
+		for i := n; i >= 1; i-- {
+			t := uint64(n*j + i)
+			binary.BigEndian.PutUint64(buf[:8], binary.BigEndian.Uint64(out[:8])^t)
+			copy(buf[8:], out[i*8:i*8+8])
+			block.Decrypt(buf, buf)
+			copy(out[:8], buf[:8])
+			copy(out[i*8:], buf[8:])
+		}
+	}
+	if subtle.ConstantTimeCompare(out[:8], defaultIV) != 1 {
+		return nil, errors.Errorf("integrity check failed")
+	}
+	return out[8:], nil
+}
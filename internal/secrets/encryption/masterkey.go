@@ -0,0 +1,97 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package encryption
+
+import (
+	"strings"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// KeyNotFound is returned when a data key is wrapped by a master key
+	// that the master key source no longer holds.
+	KeyNotFound = errors.ConstError("master key not found")
+
+	// InvalidSource is returned when a master key source cannot be parsed
+	// or loaded.
+	InvalidSource = errors.ConstError("invalid master key source")
+)
+
+// Master key source types.
+const (
+	// SourceFile reads the master keys from a local file.
+	SourceFile = "file"
+
+	// SourceKeystore reads the master keys from a local keystore directory
+	// standing in for a KMIP or PKCS#11 key manager.
+	SourceKeystore = "keystore"
+)
+
+// MasterKey wraps and unwraps model data keys.
+type MasterKey interface {
+	// ActiveKeyID returns the ID of the key used to wrap new data keys.
+	ActiveKeyID() string
+
+	// Wrap wraps the data key with the active key.
+	Wrap(dataKey []byte) ([]byte, error)
+
+	// Unwrap unwraps a data key wrapped by the key with the given ID. It
+	// returns an error satisfying [KeyNotFound] if the source does not hold
+	// the key.
+	Unwrap(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// Source identifies where the master keys are read from.
+type Source struct {
+	// Type is the type of the source, one of SourceFile or SourceKeystore.
+	Type string
+
+	// Path is the file or keystore directory holding the keys.
+	Path string
+}
+
+// ParseSource parses a master key source of the form <type>:<path>. An
+// empty string is a valid source with an empty type, meaning that secret
+// content is not encrypted.
+func ParseSource(s string) (Source, error) {
+	if s == "" {
+		return Source{}, nil
+	}
+	sourceType, path, ok := strings.Cut(s, ":")
+	if !ok || path == "" {
+		return Source{}, errors.Errorf("expected <type>:<path>, got %q", s).Add(InvalidSource)
+	}
+	switch sourceType {
+	case SourceFile, SourceKeystore:
+	default:
+		return Source{}, errors.Errorf("unknown source type %q", sourceType).Add(InvalidSource)
+	}
+	return Source{Type: sourceType, Path: path}, nil
+}
+
+// IsZero returns true if the source is empty, so encryption is disabled.
+func (s Source) IsZero() bool {
+	return s.Type == ""
+}
+
+// String returns the source in the form accepted by ParseSource.
+func (s Source) String() string {
+	if s.IsZero() {
+		return ""
+	}
+	return s.Type + ":" + s.Path
+}
+
+// Load reads the master keys from the source.
+func (s Source) Load() (MasterKey, error) {
+	switch s.Type {
+	case SourceFile:
+		return LoadFileKeys(s.Path)
+	case SourceKeystore:
+		return LoadKeystore(s.Path)
+	default:
+		return nil, errors.Errorf("no master key source configured").Add(InvalidSource)
+	}
+}
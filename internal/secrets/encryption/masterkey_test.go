@@ -0,0 +1,204 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+)
+
+type masterKeySuite struct{}
+
+func TestMasterKeySuite(t *testing.T) {
+	tc.Run(t, &masterKeySuite{})
+}
+
+func newKey(c *tc.C) string {
+	key, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func (s *masterKeySuite) TestParseSource(c *tc.C) {
+	source, err := ParseSource("file:/etc/juju/secret.keys")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(source, tc.Equals, Source{Type: SourceFile, Path: "/etc/juju/secret.keys"})
+	c.Check(source.String(), tc.Equals, "file:/etc/juju/secret.keys")
+
+	source, err = ParseSource("keystore:/var/lib/keystore")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(source, tc.Equals, Source{Type: SourceKeystore, Path: "/var/lib/keystore"})
+
+	source, err = ParseSource("")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(source.IsZero(), tc.IsTrue)
+}
+
+func (s *masterKeySuite) TestParseSourceInvalid(c *tc.C) {
+	_, err := ParseSource("/etc/juju/secret.keys")
+	c.Check(err, tc.ErrorIs, InvalidSource)
+	c.Check(err, tc.ErrorMatches, `expected <type>:<path>, got "/etc/juju/secret.keys"`)
+
+	_, err = ParseSource("file:")
+	c.Check(err, tc.ErrorIs, InvalidSource)
+
+	_, err = ParseSource("hsm:/dev/hsm0")
+	c.Check(err, tc.ErrorMatches, `unknown source type "hsm"`)
+}
+
+func (s *masterKeySuite) TestFileKeysWrapUnwrap(c *tc.C) {
+	path := filepath.Join(c.MkDir(), "secret.keys")
+	err := os.WriteFile(path, []byte("# active key\n"+newKey(c)+"\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	mk, err := Source{Type: SourceFile, Path: path}.Load()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mk.ActiveKeyID(), tc.Matches, "file:[0-9a-f]{16}")
+
+	dataKey, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+	wrapped, err := mk.Wrap(dataKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	unwrapped, err := mk.Unwrap(mk.ActiveKeyID(), wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unwrapped, tc.DeepEquals, dataKey)
+}
+
+func (s *masterKeySuite) TestFileKeysRotation(c *tc.C) {
+	path := filepath.Join(c.MkDir(), "secret.keys")
+	oldKey := newKey(c)
+	err := os.WriteFile(path, []byte(oldKey+"\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	oldMK, err := LoadFileKeys(path)
+	c.Assert(err, tc.ErrorIsNil)
+	dataKey, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+	wrapped, err := oldMK.Wrap(dataKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A new active key is added before the old key.
+	err = os.WriteFile(path, []byte(newKey(c)+"\n"+oldKey+"\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	mk, err := LoadFileKeys(path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mk.ActiveKeyID(), tc.Not(tc.Equals), oldMK.ActiveKeyID())
+
+	unwrapped, err := mk.Unwrap(oldMK.ActiveKeyID(), wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unwrapped, tc.DeepEquals, dataKey)
+
+	// Once the old key is removed, data keys it wrapped cannot be unwrapped.
+	err = os.WriteFile(path, []byte(strings.SplitN(string(mustRead(c, path)), "\n", 2)[0]), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	mk, err = LoadFileKeys(path)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = mk.Unwrap(oldMK.ActiveKeyID(), wrapped)
+	c.Check(err, tc.ErrorIs, KeyNotFound)
+}
+
+func (s *masterKeySuite) TestFileKeysInvalid(c *tc.C) {
+	dir := c.MkDir()
+
+	_, err := LoadFileKeys(filepath.Join(dir, "missing"))
+	c.Check(err, tc.ErrorIs, InvalidSource)
+
+	path := filepath.Join(dir, "empty")
+	err = os.WriteFile(path, []byte("# no keys\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = LoadFileKeys(path)
+	c.Check(err, tc.ErrorMatches, "master key file holds no keys")
+
+	path = filepath.Join(dir, "short")
+	err = os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString([]byte("short"))), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = LoadFileKeys(path)
+	c.Check(err, tc.ErrorMatches, "line 1: expected a 32 byte key, got 5 bytes")
+}
+
+func (s *masterKeySuite) TestKeystoreWrapUnwrap(c *tc.C) {
+	dir := c.MkDir()
+	writeKeystoreKey(c, dir, "juju-2026", newKey(c))
+	err := os.WriteFile(filepath.Join(dir, "active"), []byte("juju-2026\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	oldMK, err := Source{Type: SourceKeystore, Path: dir}.Load()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(oldMK.ActiveKeyID(), tc.Equals, "keystore:juju-2026")
+
+	dataKey, err := GenerateDataKey()
+	c.Assert(err, tc.ErrorIsNil)
+	wrapped, err := oldMK.Wrap(dataKey)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(wrapped, tc.HasLen, DataKeySize+8)
+
+	// Rotate to a new label; the old key still unwraps.
+	writeKeystoreKey(c, dir, "juju-2027", newKey(c))
+	err = os.WriteFile(filepath.Join(dir, "active"), []byte("juju-2027\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	mk, err := LoadKeystore(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mk.ActiveKeyID(), tc.Equals, "keystore:juju-2027")
+
+	unwrapped, err := mk.Unwrap("keystore:juju-2026", wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unwrapped, tc.DeepEquals, dataKey)
+
+	_, err = mk.Unwrap("keystore:juju-2025", wrapped)
+	c.Check(err, tc.ErrorIs, KeyNotFound)
+	_, err = mk.Unwrap("keystore:../active", wrapped)
+	c.Check(err, tc.ErrorIs, KeyNotFound)
+	_, err = mk.Unwrap("keystore:juju-2027", wrapped)
+	c.Check(err, tc.ErrorMatches, `unwrapping data key with key "keystore:juju-2027": integrity check failed`)
+}
+
+func (s *masterKeySuite) TestKeystoreMissingActiveKey(c *tc.C) {
+	dir := c.MkDir()
+	err := os.WriteFile(filepath.Join(dir, "active"), []byte("juju-2026"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = LoadKeystore(dir)
+	c.Check(err, tc.ErrorIs, InvalidSource)
+	c.Check(err, tc.ErrorMatches, `active key "juju-2026": key "juju-2026" not found`)
+}
+
+func (s *masterKeySuite) TestAESKeyWrapRFC3394(c *tc.C) {
+	// Test vector from RFC 3394 section 4.6: wrap 256 bits of key data with
+	// a 256-bit KEK.
+	kek := mustHex(c, "000102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F")
+	keyData := mustHex(c, "00112233445566778899AABBCCDDEEFF000102030405060708090A0B0C0D0E0F")
+	expected := mustHex(c, "28C9F404C4B810F4CBCCB35CFB87F8263F5786E2D80ED326CBC7F0E71A99F43BFB988B9B7A02DD21")
+
+	wrapped, err := aesKeyWrap(kek, keyData)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(wrapped, tc.DeepEquals, expected)
+
+	unwrapped, err := aesKeyUnwrap(kek, wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unwrapped, tc.DeepEquals, keyData)
+}
+
+func writeKeystoreKey(c *tc.C, dir, label, key string) {
+	err := os.WriteFile(filepath.Join(dir, label+".key"), []byte(key), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func mustHex(c *tc.C, s string) []byte {
+	b, err := hex.DecodeString(s)
+	c.Assert(err, tc.ErrorIsNil)
+	return b
+}
+
+func mustRead(c *tc.C, path string) []byte {
+	b, err := os.ReadFile(path)
+	c.Assert(err, tc.ErrorIsNil)
+	return b
+}
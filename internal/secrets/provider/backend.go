@@ -22,6 +22,15 @@ type SecretsBackend interface {
 	DeleteContent(_ context.Context, revisionId string) error
 }
 
+// ExternalContentReader is implemented by secrets backends which can read
+// content stored outside of the paths managed by Juju, so that it can be
+// synced into a user secret.
+type ExternalContentReader interface {
+	// GetExternalContent returns the content stored at the specified path.
+	// It returns a NotFound error if there is no content at the path.
+	GetExternalContent(_ context.Context, path string) (secrets.SecretValue, error)
+}
+
 // BackendConfig is used when constructing a secrets backend.
 type BackendConfig struct {
 	BackendType string
@@ -5,7 +5,10 @@ package vault
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"path"
+	"strings"
 
 	"github.com/juju/errors"
 	vault "github.com/mittwald/vaultgo"
@@ -17,6 +20,10 @@ import (
 type vaultBackend struct {
 	mountPath string
 	client    *vault.Client
+
+	// externalPaths are the paths under which external content may be
+	// read, as configured by the operator.
+	externalPaths []string
 }
 
 // GetContent implements SecretsBackend.
@@ -71,6 +78,82 @@ func (k vaultBackend) SaveContent(ctx context.Context, uri *secrets.URI, revisio
 	return path, nil
 }
 
+// GetExternalContent implements ExternalContentReader. Only content under
+// the paths listed in the backend's external-paths config can be read.
+func (k vaultBackend) GetExternalContent(ctx context.Context, path string) (_ secrets.SecretValue, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	path = strings.Trim(path, "/")
+	if !k.externalPathAllowed(path) {
+		return nil, errors.Forbiddenf("reading vault content at %q outside of %q", path, ExternalPathsKey)
+	}
+	readPath, err := k.kvReadPath(ctx, path)
+	if err != nil {
+		return nil, errors.Annotatef(err, "reading vault mount of %q", path)
+	}
+
+	s, err := k.client.Logical().ReadWithContext(ctx, readPath)
+	if isNotFound(err) || (err == nil && (s == nil || s.Data == nil)) {
+		return nil, errors.NotFoundf("vault content at %q", path)
+	} else if err != nil {
+		return nil, errors.Annotatef(err, "reading vault content at %q", path)
+	}
+	data := s.Data
+	// Content read from a KV version 2 engine is nested under "data",
+	// alongside the version metadata.
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	val := make(map[string]string)
+	for k, v := range data {
+		val[k] = base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%v", v)))
+	}
+	return secrets.NewSecretValue(val), nil
+}
+
+// externalPathAllowed reports whether the path is one of, or under one of,
+// the allowed external paths. Paths with empty, "." or ".." segments are
+// refused, since they could resolve outside of the allowed paths.
+func (k vaultBackend) externalPathAllowed(extPath string) bool {
+	if extPath == "" || path.Clean(extPath) != extPath || strings.HasPrefix(extPath, "../") || extPath == ".." {
+		return false
+	}
+	for _, allowed := range k.externalPaths {
+		if extPath == allowed || strings.HasPrefix(extPath, allowed+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// kvReadPath returns the API path from which to read the content at path.
+// Content in a KV version 2 engine is read from under the "data" path of
+// the mount, so "kv/prod/db" is read from "kv/data/prod/db". This is what
+// the vault CLI does for "vault kv get".
+func (k vaultBackend) kvReadPath(ctx context.Context, path string) (string, error) {
+	s, err := k.client.Logical().ReadWithContext(ctx, "sys/internal/ui/mounts/"+path)
+	if isNotFound(err) || (err == nil && (s == nil || s.Data == nil)) {
+		return path, nil
+	} else if err != nil {
+		return "", errors.Trace(err)
+	}
+	options, _ := s.Data["options"].(map[string]any)
+	if version, _ := options["version"].(string); version != "2" {
+		return path, nil
+	}
+	mount, _ := s.Data["path"].(string)
+	mount = strings.Trim(mount, "/")
+	relative := strings.TrimPrefix(strings.TrimPrefix(path, mount), "/")
+	if relative == "data" || strings.HasPrefix(relative, "data/") {
+		return path, nil
+	}
+	return mount + "/data/" + relative, nil
+}
+
 // Ping implements SecretsBackend.
 func (k vaultBackend) Ping() error {
 	h, err := k.client.Sys().Health()
@@ -5,6 +5,7 @@ package vault
 
 import (
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/juju/errors"
@@ -24,6 +25,7 @@ const (
 	ClientCertKey    = "client-cert"
 	ClientKeyKey     = "client-key"
 	TLSServerNameKey = "tls-server-name"
+	ExternalPathsKey = "external-paths"
 )
 
 var configSchema = configschema.Fields{
@@ -65,6 +67,11 @@ var configSchema = configschema.Fields{
 		Description: "The vault TLS server name.",
 		Type:        configschema.Tstring,
 	},
+	ExternalPathsKey: {
+		Description: "A comma separated list of vault paths under which content may be synced into secrets. " +
+			"No external content can be read if this is not set.",
+		Type: configschema.Tstring,
+	},
 }
 
 var configDefaults = schema.Defaults{}
@@ -112,6 +119,17 @@ func (c *backendConfig) tlsServerName() string {
 	return v
 }
 
+func (c *backendConfig) externalPaths() []string {
+	v, _ := c.validAttrs[ExternalPathsKey].(string)
+	var paths []string
+	for _, p := range strings.Split(v, ",") {
+		if p = strings.Trim(strings.TrimSpace(p), "/"); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
 // ConfigSchema implements SecretBackendProvider.
 func (p vaultProvider) ConfigSchema() configschema.Fields {
 	return configSchema
@@ -332,8 +332,9 @@ func (p vaultProvider) newBackendNoMount(cfg *provider.BackendConfig) (*vaultBac
 		c.SetNamespace(ns)
 	}
 	return &vaultBackend{
-		client:    c,
-		mountPath: validCfg.mountPath(),
+		client:        c,
+		mountPath:     validCfg.mountPath(),
+		externalPaths: validCfg.externalPaths(),
 	}, nil
 }
 
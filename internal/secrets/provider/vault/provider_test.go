@@ -399,3 +399,142 @@ func (s *providerSuite) TestNewBackendWithMountPath(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(jujuvault.MountPath(b), tc.Equals, "/some/path/fred-06f00d")
 }
+
+func (s *providerSuite) newExternalContentReader(c *tc.C) provider.ExternalContentReader {
+	_, newVaultClient := s.newVaultClient(c, nil)
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+
+	b, err := p.NewBackend(&provider.ModelBackendConfig{
+		ModelName: "fred",
+		ModelUUID: coretesting.ModelTag.Id(),
+		BackendConfig: provider.BackendConfig{
+			BackendType: jujuvault.BackendType,
+			Config: map[string]any{
+				"endpoint":        "http://vault-ip:8200/",
+				"token":           "vault-token",
+				"ca-cert":         coretesting.CACert,
+				"tls-server-name": "tls-server",
+				"external-paths":  "kv/prod, /other/",
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	reader, ok := b.(provider.ExternalContentReader)
+	c.Assert(ok, tc.IsTrue)
+	return reader
+}
+
+// expectVaultGets sets up the expected GET requests, mapping each request
+// URL path to the status and body of the response.
+func (s *providerSuite) expectVaultGets(c *tc.C, responses ...vaultResponse) {
+	calls := make([]any, len(responses))
+	for i, r := range responses {
+		calls[i] = s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+			func(req *http.Request) (*http.Response, error) {
+				c.Check(req.Method, tc.Equals, http.MethodGet)
+				c.Check(req.URL.Path, tc.Equals, r.path)
+				return &http.Response{
+					Request:    req,
+					StatusCode: r.status,
+					Body:       io.NopCloser(strings.NewReader(r.body)),
+				}, nil
+			},
+		)
+	}
+	gomock.InOrder(calls...)
+}
+
+type vaultResponse struct {
+	path   string
+	status int
+	body   string
+}
+
+func (s *providerSuite) TestGetExternalContent(c *tc.C) {
+	reader := s.newExternalContentReader(c)
+	s.expectVaultGets(c, vaultResponse{
+		path:   "/v1/sys/internal/ui/mounts/kv/prod/db",
+		status: http.StatusOK,
+		body:   `{"data": {"path": "kv/", "type": "kv", "options": {"version": "1"}}}`,
+	}, vaultResponse{
+		path:   "/v1/kv/prod/db",
+		status: http.StatusOK,
+		body:   `{"data": {"username": "admin", "password": "s3cret"}}`,
+	})
+
+	value, err := reader.GetExternalContent(c.Context(), "kv/prod/db")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(value.EncodedValues(), tc.DeepEquals, map[string]string{
+		"username": "YWRtaW4=",
+		"password": "czNjcmV0",
+	})
+}
+
+func (s *providerSuite) TestGetExternalContentKVv2(c *tc.C) {
+	reader := s.newExternalContentReader(c)
+	s.expectVaultGets(c, vaultResponse{
+		path:   "/v1/sys/internal/ui/mounts/kv/prod/db",
+		status: http.StatusOK,
+		body:   `{"data": {"path": "kv/", "type": "kv", "options": {"version": "2"}}}`,
+	}, vaultResponse{
+		path:   "/v1/kv/data/prod/db",
+		status: http.StatusOK,
+		body:   `{"data": {"data": {"password": "s3cret"}, "metadata": {"version": 2}}}`,
+	})
+
+	value, err := reader.GetExternalContent(c.Context(), "kv/prod/db")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(value.EncodedValues(), tc.DeepEquals, map[string]string{
+		"password": "czNjcmV0",
+	})
+}
+
+func (s *providerSuite) TestGetExternalContentKVv2DataPath(c *tc.C) {
+	reader := s.newExternalContentReader(c)
+	s.expectVaultGets(c, vaultResponse{
+		path:   "/v1/sys/internal/ui/mounts/other/data/db",
+		status: http.StatusOK,
+		body:   `{"data": {"path": "other/", "type": "kv", "options": {"version": "2"}}}`,
+	}, vaultResponse{
+		path:   "/v1/other/data/db",
+		status: http.StatusOK,
+		body:   `{"data": {"data": {"password": "s3cret"}, "metadata": {"version": 2}}}`,
+	})
+
+	value, err := reader.GetExternalContent(c.Context(), "other/data/db")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(value.EncodedValues(), tc.DeepEquals, map[string]string{
+		"password": "czNjcmV0",
+	})
+}
+
+func (s *providerSuite) TestGetExternalContentNotFound(c *tc.C) {
+	reader := s.newExternalContentReader(c)
+	s.expectVaultGets(c, vaultResponse{
+		path:   "/v1/sys/internal/ui/mounts/kv/prod/db",
+		status: http.StatusNotFound,
+		body:   `{"errors": []}`,
+	}, vaultResponse{
+		path:   "/v1/kv/prod/db",
+		status: http.StatusNotFound,
+		body:   `{"errors": []}`,
+	})
+
+	_, err := reader.GetExternalContent(c.Context(), "kv/prod/db")
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *providerSuite) TestGetExternalContentNotAllowed(c *tc.C) {
+	reader := s.newExternalContentReader(c)
+
+	for _, path := range []string{
+		"kv/production/db", "kv", "sys/mounts", "juju/model/secret",
+		"kv/prod/../production/db", "kv/prod/./db", "kv/prod//db", "kv/prod/..",
+		"other/../sys/mounts",
+	} {
+		_, err := reader.GetExternalContent(c.Context(), path)
+		c.Check(err, tc.ErrorIs, errors.Forbidden, tc.Commentf("path %q", path))
+	}
+}
@@ -25,6 +25,7 @@ import (
 	controllerupgraderservice "github.com/juju/juju/domain/controllerupgrader/service"
 	credentialservice "github.com/juju/juju/domain/credential/service"
 	crossmodelrelationservice "github.com/juju/juju/domain/crossmodelrelation/service"
+	desiredbundleservice "github.com/juju/juju/domain/desiredbundle/service"
 	exportservice "github.com/juju/juju/domain/export/service"
 	externalcontrollerservice "github.com/juju/juju/domain/externalcontroller/service"
 	flagservice "github.com/juju/juju/domain/flag/service"
@@ -119,6 +120,8 @@ type ModelDomainServices interface {
 	ControllerUpgrader() *controllerupgraderservice.Service
 	// CrossModelRelation returns a service for managing cross model relations.
 	CrossModelRelation() *crossmodelrelationservice.WatchableService
+	// DesiredBundle returns the service for the desired bundle of the model.
+	DesiredBundle() *desiredbundleservice.Service
 	// Machine returns the machine service.
 	Machine() *machineservice.WatchableService
 	// BlockDevice returns the block device service.
@@ -0,0 +1,366 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package statusquery evaluates conditions on the status of an entity in a
+// model, such as those given to `juju wait-for`. The conditions are parsed
+// by the client, to report errors early, and evaluated by the controller
+// against the model status, so that clients don't have to fetch it.
+package statusquery
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Kind is the kind of entity a query is about.
+type Kind string
+
+const (
+	KindModel       Kind = "model"
+	KindApplication Kind = "application"
+	KindUnit        Kind = "unit"
+	KindMachine     Kind = "machine"
+)
+
+// Validate returns an error if the kind is not one that can be queried.
+func (k Kind) Validate() error {
+	if _, ok := fields[k]; !ok {
+		return errors.Errorf("entity kind %q (expected model, application, unit or machine) not valid", k).Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// fieldType determines how the value of a field is compared.
+type fieldType int
+
+const (
+	stringField fieldType = iota
+	numberField
+	boolField
+)
+
+// fields holds the fields that conditions can be written against, for each
+// kind of entity.
+var fields = map[Kind]map[string]fieldType{
+	KindModel: {
+		"status":       stringField,
+		"applications": numberField,
+		"machines":     numberField,
+	},
+	KindApplication: {
+		"status":          stringField,
+		"workload-status": stringField,
+		"agent-status":    stringField,
+		"life":            stringField,
+		"exposed":         boolField,
+		"units":           numberField,
+		"scale":           numberField,
+		"charm-rev":       numberField,
+	},
+	KindUnit: {
+		"workload-status": stringField,
+		"agent-status":    stringField,
+		"life":            stringField,
+		"machine":         stringField,
+		"leader":          boolField,
+	},
+	KindMachine: {
+		"status":          stringField,
+		"instance-status": stringField,
+		"life":            stringField,
+		"containers":      numberField,
+	},
+}
+
+// operator compares the value of a field with the value in a condition.
+type operator string
+
+const (
+	opEqual        operator = "="
+	opNotEqual     operator = "!="
+	opLess         operator = "<"
+	opLessEqual    operator = "<="
+	opGreater      operator = ">"
+	opGreaterEqual operator = ">="
+)
+
+// operators holds the operators in the order they are matched, so that
+// the two character operators are matched before their prefixes.
+var operators = []operator{
+	opGreaterEqual, opLessEqual, opNotEqual, opEqual, opGreater, opLess,
+}
+
+// Condition is a single comparison of a field of an entity with a value.
+type Condition struct {
+	field string
+	op    operator
+	value string
+}
+
+func (c Condition) String() string {
+	return c.field + string(c.op) + c.value
+}
+
+// ParseCondition parses a condition of the form <field><operator><value>
+// for the given kind of entity.
+func ParseCondition(kind Kind, arg string) (Condition, error) {
+	i := strings.IndexAny(arg, "=!<>")
+	if i <= 0 {
+		return Condition{}, errors.Errorf("condition %q not valid", arg).Add(coreerrors.NotValid)
+	}
+	cond := Condition{field: arg[:i]}
+	for _, op := range operators {
+		if strings.HasPrefix(arg[i:], string(op)) {
+			cond.op = op
+			cond.value = arg[i+len(op):]
+			break
+		}
+	}
+	if cond.op == "" || cond.value == "" {
+		return Condition{}, errors.Errorf("condition %q not valid", arg).Add(coreerrors.NotValid)
+	}
+
+	ft, ok := fields[kind][cond.field]
+	if !ok {
+		return Condition{}, errors.Errorf("%s field %q in condition %q (expected one of %s) not valid",
+			kind, cond.field, arg, strings.Join(sortedKeys(fields[kind]), ", ")).Add(coreerrors.NotValid)
+	}
+	switch ft {
+	case numberField:
+		if _, err := strconv.Atoi(cond.value); err != nil {
+			return Condition{}, errors.Errorf("number %q in condition %q not valid", cond.value, arg).Add(coreerrors.NotValid)
+		}
+	case boolField:
+		if _, err := strconv.ParseBool(cond.value); err != nil {
+			return Condition{}, errors.Errorf("boolean %q in condition %q not valid", cond.value, arg).Add(coreerrors.NotValid)
+		}
+		fallthrough
+	default:
+		if cond.op != opEqual && cond.op != opNotEqual {
+			return Condition{}, errors.Errorf("operator %q for field %q not valid", cond.op, cond.field).Add(coreerrors.NotValid)
+		}
+	}
+	return cond, nil
+}
+
+// matches returns true if every value satisfies the condition. A field
+// without any values, such as the workload status of an application
+// without units, does not satisfy any condition.
+func (c Condition) matches(ft fieldType, values []string) bool {
+	if len(values) == 0 {
+		return false
+	}
+	for _, value := range values {
+		if !c.compare(ft, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c Condition) compare(ft fieldType, value string) bool {
+	switch ft {
+	case numberField:
+		want, _ := strconv.Atoi(c.value)
+		got, err := strconv.Atoi(value)
+		if err != nil {
+			return false
+		}
+		switch c.op {
+		case opEqual:
+			return got == want
+		case opNotEqual:
+			return got != want
+		case opLess:
+			return got < want
+		case opLessEqual:
+			return got <= want
+		case opGreater:
+			return got > want
+		case opGreaterEqual:
+			return got >= want
+		}
+	case boolField:
+		want, _ := strconv.ParseBool(c.value)
+		got, _ := strconv.ParseBool(value)
+		return (got == want) == (c.op == opEqual)
+	default:
+		return (value == c.value) == (c.op == opEqual)
+	}
+	return false
+}
+
+// Query is an entity and the conditions it must meet.
+type Query struct {
+	Kind       Kind
+	Name       string
+	Conditions []Condition
+}
+
+// ParseQuery returns the query for the conditions, each of the form
+// <field><operator><value>, on the named entity of the given kind.
+func ParseQuery(kind Kind, name string, conditions []string) (Query, error) {
+	if err := kind.Validate(); err != nil {
+		return Query{}, errors.Capture(err)
+	}
+	q := Query{Kind: kind, Name: name}
+	for _, arg := range conditions {
+		cond, err := ParseCondition(kind, arg)
+		if err != nil {
+			return Query{}, errors.Capture(err)
+		}
+		q.Conditions = append(q.Conditions, cond)
+	}
+	return q, nil
+}
+
+// String describes the entity queried.
+func (q Query) String() string {
+	return fmt.Sprintf("%s %q", q.Kind, q.Name)
+}
+
+// Patterns returns the status patterns that select the entity, so that
+// the status of the rest of the model is not fetched.
+func (q Query) Patterns() []string {
+	if q.Kind == KindModel {
+		return nil
+	}
+	return []string{q.Name}
+}
+
+// Unmet returns a description of each condition the entity does not meet
+// in the given status, along with its current value. An entity that is not
+// in the status does not meet any condition.
+func (q Query) Unmet(fullStatus *params.FullStatus) []string {
+	values, found := entityFields(q.Kind, q.Name, fullStatus)
+	if !found {
+		return []string{fmt.Sprintf("%s not found", q)}
+	}
+	var unmet []string
+	for _, cond := range q.Conditions {
+		current := values[cond.field]
+		if cond.matches(fields[q.Kind][cond.field], current) {
+			continue
+		}
+		unmet = append(unmet, fmt.Sprintf("%s (%s=%s)", cond, cond.field, strings.Join(current, ",")))
+	}
+	return unmet
+}
+
+// entityFields returns the current values of the fields of an entity. Most
+// fields have a single value, but the unit statuses of an application have
+// a value for each unit.
+func entityFields(kind Kind, name string, fullStatus *params.FullStatus) (map[string][]string, bool) {
+	switch kind {
+	case KindModel:
+		return map[string][]string{
+			"status":       {fullStatus.Model.ModelStatus.Status},
+			"applications": {strconv.Itoa(len(fullStatus.Applications))},
+			"machines":     {strconv.Itoa(len(fullStatus.Machines))},
+		}, true
+
+	case KindApplication:
+		app, ok := fullStatus.Applications[name]
+		if !ok {
+			return nil, false
+		}
+		values := map[string][]string{
+			"status":    {app.Status.Status},
+			"life":      {lifeValue(app.Life)},
+			"exposed":   {strconv.FormatBool(app.Exposed)},
+			"units":     {strconv.Itoa(len(app.Units))},
+			"scale":     {strconv.Itoa(app.Scale)},
+			"charm-rev": {strconv.Itoa(app.CharmRev)},
+		}
+		for _, unitName := range sortedKeys(app.Units) {
+			unit := app.Units[unitName]
+			values["workload-status"] = append(values["workload-status"], unit.WorkloadStatus.Status)
+			values["agent-status"] = append(values["agent-status"], unit.AgentStatus.Status)
+		}
+		return values, true
+
+	case KindUnit:
+		unit, ok := findUnit(fullStatus, name)
+		if !ok {
+			return nil, false
+		}
+		return map[string][]string{
+			"workload-status": {unit.WorkloadStatus.Status},
+			"agent-status":    {unit.AgentStatus.Status},
+			"life":            {lifeValue(unit.AgentStatus.Life)},
+			"machine":         {unit.Machine},
+			"leader":          {strconv.FormatBool(unit.Leader)},
+		}, true
+
+	case KindMachine:
+		machine, ok := findMachine(fullStatus.Machines, name)
+		if !ok {
+			return nil, false
+		}
+		return map[string][]string{
+			"status":          {machine.AgentStatus.Status},
+			"instance-status": {machine.InstanceStatus.Status},
+			"life":            {lifeValue(machine.AgentStatus.Life)},
+			"containers":      {strconv.Itoa(len(machine.Containers))},
+		}, true
+	}
+	return nil, false
+}
+
+// lifeValue returns the life of an entity, which is only reported in the
+// status once the entity is no longer alive.
+func lifeValue(value life.Value) string {
+	if value == "" {
+		return string(life.Alive)
+	}
+	return string(value)
+}
+
+func findUnit(fullStatus *params.FullStatus, name string) (params.UnitStatus, bool) {
+	var find func(map[string]params.UnitStatus) (params.UnitStatus, bool)
+	find = func(units map[string]params.UnitStatus) (params.UnitStatus, bool) {
+		for unitName, unit := range units {
+			if unitName == name {
+				return unit, true
+			}
+			if sub, ok := find(unit.Subordinates); ok {
+				return sub, true
+			}
+		}
+		return params.UnitStatus{}, false
+	}
+	for _, app := range fullStatus.Applications {
+		if unit, ok := find(app.Units); ok {
+			return unit, true
+		}
+	}
+	return params.UnitStatus{}, false
+}
+
+func findMachine(machines map[string]params.MachineStatus, id string) (params.MachineStatus, bool) {
+	for machineID, machine := range machines {
+		if machineID == id {
+			return machine, true
+		}
+		if container, ok := findMachine(machine.Containers, id); ok {
+			return container, true
+		}
+	}
+	return params.MachineStatus{}, false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package statusquery
+
+import (
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/rpc/params"
+)
+
+type querySuite struct{}
+
+func TestQuerySuite(t *stdtesting.T) {
+	tc.Run(t, &querySuite{})
+}
+
+func (s *querySuite) TestParseCondition(c *tc.C) {
+	for i, test := range []struct {
+		arg      string
+		expected Condition
+	}{{
+		arg:      "status=active",
+		expected: Condition{field: "status", op: opEqual, value: "active"},
+	}, {
+		arg:      "status!=error",
+		expected: Condition{field: "status", op: opNotEqual, value: "error"},
+	}, {
+		arg:      "units>=3",
+		expected: Condition{field: "units", op: opGreaterEqual, value: "3"},
+	}, {
+		arg:      "units<=3",
+		expected: Condition{field: "units", op: opLessEqual, value: "3"},
+	}, {
+		arg:      "units>0",
+		expected: Condition{field: "units", op: opGreater, value: "0"},
+	}, {
+		arg:      "units<5",
+		expected: Condition{field: "units", op: opLess, value: "5"},
+	}, {
+		arg:      "exposed=true",
+		expected: Condition{field: "exposed", op: opEqual, value: "true"},
+	}} {
+		c.Logf("test %d: %s", i, test.arg)
+		cond, err := ParseCondition(KindApplication, test.arg)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(cond, tc.Equals, test.expected)
+		c.Check(cond.String(), tc.Equals, test.arg)
+	}
+}
+
+func (s *querySuite) TestParseConditionInvalid(c *tc.C) {
+	for i, arg := range []string{
+		"=active", "status", "status=", "units=many", "exposed=maybe", "exposed>true",
+	} {
+		c.Logf("test %d: %s", i, arg)
+		_, err := ParseCondition(KindApplication, arg)
+		c.Check(err, tc.NotNil)
+	}
+}
+
+func (s *querySuite) TestUnmetApplication(c *tc.C) {
+	fullStatus := &params.FullStatus{
+		Applications: map[string]params.ApplicationStatus{
+			"mysql": {
+				Status: params.DetailedStatus{Status: "active"},
+				Life:   life.Dying,
+				Units: map[string]params.UnitStatus{
+					"mysql/0": {WorkloadStatus: params.DetailedStatus{Status: "active"}},
+					"mysql/1": {WorkloadStatus: params.DetailedStatus{Status: "error"}},
+				},
+			},
+			"empty": {},
+		},
+	}
+	for i, test := range []struct {
+		name       string
+		conditions []Condition
+		unmet      []string
+	}{{
+		name: "mysql",
+		conditions: []Condition{
+			{field: "status", op: opEqual, value: "active"},
+			{field: "units", op: opGreater, value: "1"},
+			{field: "life", op: opEqual, value: "dying"},
+		},
+	}, {
+		name: "mysql",
+		conditions: []Condition{
+			{field: "workload-status", op: opEqual, value: "active"},
+			{field: "workload-status", op: opNotEqual, value: "error"},
+			{field: "exposed", op: opEqual, value: "true"},
+		},
+		unmet: []string{
+			"workload-status=active (workload-status=active,error)",
+			"workload-status!=error (workload-status=active,error)",
+			"exposed=true (exposed=false)",
+		},
+	}, {
+		name: "empty",
+		conditions: []Condition{
+			{field: "workload-status", op: opNotEqual, value: "error"},
+			{field: "life", op: opEqual, value: "alive"},
+		},
+		unmet: []string{"workload-status!=error (workload-status=)"},
+	}, {
+		name:  "missing",
+		unmet: []string{`application "missing" not found`},
+	}} {
+		c.Logf("test %d", i)
+		q := Query{Kind: KindApplication, Name: test.name, Conditions: test.conditions}
+		c.Check(q.Unmet(fullStatus), tc.DeepEquals, test.unmet)
+	}
+}
+
+func (s *querySuite) TestUnmetUnit(c *tc.C) {
+	fullStatus := &params.FullStatus{
+		Applications: map[string]params.ApplicationStatus{
+			"mysql": {
+				Units: map[string]params.UnitStatus{
+					"mysql/0": {
+						AgentStatus: params.DetailedStatus{Status: "idle"},
+						Machine:     "0",
+						Leader:      true,
+						Subordinates: map[string]params.UnitStatus{
+							"logging/0": {AgentStatus: params.DetailedStatus{Status: "executing"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	q := Query{Kind: KindUnit, Name: "mysql/0", Conditions: []Condition{
+		{field: "agent-status", op: opEqual, value: "idle"},
+		{field: "machine", op: opEqual, value: "0"},
+		{field: "leader", op: opEqual, value: "true"},
+	}}
+	c.Check(q.Unmet(fullStatus), tc.HasLen, 0)
+
+	q = Query{Kind: KindUnit, Name: "logging/0", Conditions: []Condition{
+		{field: "agent-status", op: opEqual, value: "idle"},
+	}}
+	c.Check(q.Unmet(fullStatus), tc.DeepEquals, []string{"agent-status=idle (agent-status=executing)"})
+}
+
+func (s *querySuite) TestUnmetModel(c *tc.C) {
+	fullStatus := &params.FullStatus{
+		Model: params.ModelStatusInfo{
+			ModelStatus: params.DetailedStatus{Status: "available"},
+		},
+		Machines: map[string]params.MachineStatus{"0": {}},
+	}
+	q := Query{Kind: KindModel, Name: "test", Conditions: []Condition{
+		{field: "status", op: opEqual, value: "available"},
+		{field: "machines", op: opEqual, value: "1"},
+		{field: "applications", op: opGreaterEqual, value: "1"},
+	}}
+	c.Check(q.Unmet(fullStatus), tc.DeepEquals, []string{"applications>=1 (applications=0)"})
+}
+
+func (s *querySuite) TestParseQuery(c *tc.C) {
+	q, err := ParseQuery(KindApplication, "mysql", []string{"status=active", "units>=3"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(q, tc.DeepEquals, Query{
+		Kind: KindApplication,
+		Name: "mysql",
+		Conditions: []Condition{
+			{field: "status", op: opEqual, value: "active"},
+			{field: "units", op: opGreaterEqual, value: "3"},
+		},
+	})
+
+	_, err = ParseQuery("relation", "mysql:db", nil)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	_, err = ParseQuery(KindUnit, "mysql/0", []string{"units>=3"})
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
@@ -5,6 +5,7 @@ package storage
 
 import (
 	"maps"
+	"strconv"
 
 	"github.com/juju/errors"
 	"github.com/juju/schema"
@@ -21,6 +22,12 @@ const (
 	// should not be relied upon until a storage source is
 	// constructed.
 	ConfigStorageDir = "storage-dir"
+
+	// ConfigEncrypt is the pool attribute which, when true, has filesystems
+	// that Juju creates on block devices attached to a machine encrypted
+	// with LUKS. It has no effect on filesystems the storage provider
+	// creates itself.
+	ConfigEncrypt = "encrypt"
 )
 
 // Attrs defines storage attributes.
@@ -33,11 +40,15 @@ type Config struct {
 	attrs    Attrs
 }
 
-var fields = schema.Fields{}
+var fields = schema.Fields{
+	ConfigEncrypt: schema.Bool(),
+}
 
 var configChecker = schema.FieldMap(
 	fields,
-	schema.Defaults{},
+	schema.Defaults{
+		ConfigEncrypt: schema.Omit,
+	},
 )
 
 // NewConfig creates a new Config for instantiating a storage source.
@@ -79,3 +90,16 @@ func (c *Config) ValueString(name string) (string, bool) {
 	v, ok := c.attrs[name].(string)
 	return v, ok
 }
+
+// Encrypted reports whether the storage attributes request that filesystems
+// created on machine block devices are encrypted.
+func Encrypted(attrs map[string]any) bool {
+	switch v := attrs[ConfigEncrypt].(type) {
+	case bool:
+		return v
+	case string:
+		encrypt, _ := strconv.ParseBool(v)
+		return encrypt
+	}
+	return false
+}
@@ -242,6 +242,40 @@ type VolumeImporter interface {
 	) (VolumeInfo, error)
 }
 
+// VolumeSnapshotter provides an interface for taking point-in-time
+// snapshots of storage, and destroying them. It is an optional interface
+// which may be implemented by a VolumeSource or a FilesystemSource;
+// callers should check for it with a type assertion.
+//
+// A source implementing VolumeSnapshotter must also support creating
+// storage from one of its snapshots, as identified by the SnapshotId
+// field of VolumeParams or FilesystemParams.
+type VolumeSnapshotter interface {
+	// CreateSnapshots creates snapshots with the specified parameters.
+	CreateSnapshots(ctx context.Context, params []SnapshotParams) ([]CreateSnapshotsResult, error)
+
+	// ListSnapshots returns the snapshots of the volume or filesystem
+	// with the specified provider ID.
+	ListSnapshots(ctx context.Context, providerId string) ([]SnapshotInfo, error)
+
+	// DestroySnapshots destroys the snapshots with the specified
+	// provider snapshot IDs.
+	DestroySnapshots(ctx context.Context, snapshotIds []string) ([]error, error)
+}
+
+// StorageResizer provides an interface for growing provisioned storage
+// in place. It is an optional interface which may be implemented by a
+// VolumeSource or a FilesystemSource; callers should check for it with
+// a type assertion.
+//
+// Storage may only be grown; a resize request for a size smaller than
+// the current size of the volume or filesystem is an error.
+type StorageResizer interface {
+	// ResizeStorage grows the volumes or filesystems with the specified
+	// parameters to at least the requested size.
+	ResizeStorage(ctx context.Context, params []ResizeParams) ([]ResizeStorageResult, error)
+}
+
 // VolumeParams is a fully specified set of parameters for volume creation,
 // derived from one or more of user-specified storage directives, a
 // storage pool definition, and charm storage metadata.
@@ -265,6 +299,11 @@ type VolumeParams struct {
 	// storage provider supports tags.
 	ResourceTags map[string]string
 
+	// SnapshotId is the provider ID of a snapshot from which to create
+	// the volume, or empty to create an empty volume. It may only be set
+	// if the volume source implements VolumeSnapshotter.
+	SnapshotId string
+
 	// Attachment identifies the machine that the volume should be attached
 	// to initially, or nil if the volume should not be attached to any
 	// machine. Some providers, such as MAAS, do not support dynamic
@@ -350,6 +389,11 @@ type FilesystemParams struct {
 	// storage provider supports tags.
 	ResourceTags map[string]string
 
+	// SnapshotId is the provider ID of a snapshot from which to create
+	// the filesystem, or empty to create an empty filesystem. It may only
+	// be set if the filesystem source implements VolumeSnapshotter.
+	SnapshotId string
+
 	// Attachment identifies the machine that the filesystem should be attached
 	// to initially, or nil if the filesystem should not be attached to any
 	// machine.
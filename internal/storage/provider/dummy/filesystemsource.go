@@ -24,8 +24,11 @@ type FilesystemSource struct {
 	ValidateFilesystemParamsFunc func(storage.FilesystemParams) error
 	AttachFilesystemsFunc        func(context.Context, []storage.FilesystemAttachmentParams) ([]storage.AttachFilesystemsResult, error)
 	DetachFilesystemsFunc        func(context.Context, []storage.FilesystemAttachmentParams) ([]error, error)
+	ResizeStorageFunc            func(context.Context, []storage.ResizeParams) ([]storage.ResizeStorageResult, error)
 }
 
+var _ storage.StorageResizer = (*FilesystemSource)(nil)
+
 // CreateFilesystems is defined on storage.FilesystemSource.
 func (s *FilesystemSource) CreateFilesystems(ctx context.Context, params []storage.FilesystemParams) ([]storage.CreateFilesystemsResult, error) {
 	s.MethodCall(s, "CreateFilesystems", ctx, params)
@@ -79,3 +82,12 @@ func (s *FilesystemSource) DetachFilesystems(ctx context.Context, params []stora
 	}
 	return nil, errors.NotImplementedf("DetachFilesystems")
 }
+
+// ResizeStorage is defined on storage.StorageResizer.
+func (s *FilesystemSource) ResizeStorage(ctx context.Context, params []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+	s.MethodCall(s, "ResizeStorage", ctx, params)
+	if s.ResizeStorageFunc != nil {
+		return s.ResizeStorageFunc(ctx, params)
+	}
+	return nil, errors.NotImplementedf("ResizeStorage")
+}
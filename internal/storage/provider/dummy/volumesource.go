@@ -26,8 +26,17 @@ type VolumeSource struct {
 	ValidateVolumeParamsFunc func(storage.VolumeParams) error
 	AttachVolumesFunc        func(context.Context, []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error)
 	DetachVolumesFunc        func(context.Context, []storage.VolumeAttachmentParams) ([]error, error)
+	CreateSnapshotsFunc      func(context.Context, []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error)
+	ListSnapshotsFunc        func(context.Context, string) ([]storage.SnapshotInfo, error)
+	DestroySnapshotsFunc     func(context.Context, []string) ([]error, error)
+	ResizeStorageFunc        func(context.Context, []storage.ResizeParams) ([]storage.ResizeStorageResult, error)
 }
 
+var (
+	_ storage.VolumeSnapshotter = (*VolumeSource)(nil)
+	_ storage.StorageResizer    = (*VolumeSource)(nil)
+)
+
 // CreateVolumes is defined on storage.VolumeSource.
 func (s *VolumeSource) CreateVolumes(ctx context.Context, params []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
 	s.MethodCall(s, "CreateVolumes", ctx, params)
@@ -99,3 +108,39 @@ func (s *VolumeSource) DetachVolumes(ctx context.Context, params []storage.Volum
 	}
 	return nil, errors.NotImplementedf("DetachVolumes")
 }
+
+// CreateSnapshots is defined on storage.VolumeSnapshotter.
+func (s *VolumeSource) CreateSnapshots(ctx context.Context, params []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	s.MethodCall(s, "CreateSnapshots", ctx, params)
+	if s.CreateSnapshotsFunc != nil {
+		return s.CreateSnapshotsFunc(ctx, params)
+	}
+	return nil, errors.NotImplementedf("CreateSnapshots")
+}
+
+// ListSnapshots is defined on storage.VolumeSnapshotter.
+func (s *VolumeSource) ListSnapshots(ctx context.Context, volumeId string) ([]storage.SnapshotInfo, error) {
+	s.MethodCall(s, "ListSnapshots", ctx, volumeId)
+	if s.ListSnapshotsFunc != nil {
+		return s.ListSnapshotsFunc(ctx, volumeId)
+	}
+	return nil, nil
+}
+
+// DestroySnapshots is defined on storage.VolumeSnapshotter.
+func (s *VolumeSource) DestroySnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	s.MethodCall(s, "DestroySnapshots", ctx, snapshotIds)
+	if s.DestroySnapshotsFunc != nil {
+		return s.DestroySnapshotsFunc(ctx, snapshotIds)
+	}
+	return nil, errors.NotImplementedf("DestroySnapshots")
+}
+
+// ResizeStorage is defined on storage.StorageResizer.
+func (s *VolumeSource) ResizeStorage(ctx context.Context, params []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+	s.MethodCall(s, "ResizeStorage", ctx, params)
+	if s.ResizeStorageFunc != nil {
+		return s.ResizeStorageFunc(ctx, params)
+	}
+	return nil, errors.NotImplementedf("ResizeStorage")
+}
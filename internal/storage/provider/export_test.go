@@ -47,9 +47,21 @@ func NewMockManagedFilesystemSource(
 	return &managedFilesystemSource{
 		run, dirFuncs,
 		volumeBlockDevices, filesystems,
+		nil, etcDir,
 	}, dirFuncs
 }
 
+// SetManagedFilesystemEncryptionKeys sets the function supplying the
+// encryption keys of a managed filesystem source, and the directory the
+// source writes key files to.
+func SetManagedFilesystemEncryptionKeys(
+	source storage.FilesystemSource, keyDir string, keys FilesystemEncryptionKeyFunc,
+) {
+	s := source.(*managedFilesystemSource)
+	s.keyDir = keyDir
+	s.encryptionKeys = keys
+}
+
 var _ dirFuncs = (*MockDirFuncs)(nil)
 
 // MockDirFuncs stub out the real mkdir and lstat functions from stdlib.
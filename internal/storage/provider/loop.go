@@ -148,14 +148,21 @@ func (lvs *loopVolumeSource) createVolume(
 	if err := ensureDir(lvs.dirFuncs, filepath.Dir(loopFilePath)); err != nil {
 		return storage.Volume{}, errors.Trace(err)
 	}
-	if err := createBlockFile(ctx, lvs.run, loopFilePath, params.Size); err != nil {
+	size := params.Size
+	if params.SnapshotId != "" {
+		var err error
+		size, err = lvs.createVolumeFromSnapshot(ctx, params.SnapshotId, loopFilePath, params.Size)
+		if err != nil {
+			return storage.Volume{}, errors.Annotate(err, "could not create block file from snapshot")
+		}
+	} else if err := createBlockFile(ctx, lvs.run, loopFilePath, params.Size); err != nil {
 		return storage.Volume{}, errors.Annotate(err, "could not create block file")
 	}
 	return storage.Volume{
 		params.Tag,
 		storage.VolumeInfo{
 			VolumeId: volumeId,
-			Size:     params.Size,
+			Size:     size,
 		},
 	}, nil
 }
@@ -0,0 +1,69 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/internal/storage"
+)
+
+var _ storage.StorageResizer = (*loopVolumeSource)(nil)
+
+// ResizeStorage is defined on the StorageResizer interface.
+func (lvs *loopVolumeSource) ResizeStorage(ctx context.Context, args []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+	results := make([]storage.ResizeStorageResult, len(args))
+	for i, arg := range args {
+		size, err := lvs.resizeVolume(ctx, arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %q", arg.ProviderId)
+			continue
+		}
+		results[i].Size = size
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) resizeVolume(ctx context.Context, arg storage.ResizeParams) (uint64, error) {
+	tag, err := names.ParseVolumeTag(arg.ProviderId)
+	if err != nil {
+		return 0, errors.Errorf("invalid loop volume ID %q", arg.ProviderId)
+	}
+	loopFilePath := lvs.volumeFilePath(tag)
+	info, err := os.Stat(loopFilePath)
+	if os.IsNotExist(err) {
+		return 0, errors.NotFoundf("volume %q", arg.ProviderId)
+	} else if err != nil {
+		return 0, errors.Trace(err)
+	}
+	currentSize := bytesToMiB(info.Size())
+	if arg.Size < currentSize {
+		return 0, errors.NotValidf(
+			"shrinking volume from %dMiB to %dMiB", currentSize, arg.Size,
+		)
+	} else if arg.Size == currentSize {
+		return currentSize, nil
+	}
+	if err := createBlockFile(ctx, lvs.run, loopFilePath, arg.Size); err != nil {
+		return 0, errors.Annotate(err, "growing volume")
+	}
+
+	// Any loop devices backed by the file must be told to pick up
+	// the new size of the file.
+	deviceNames, err := associatedLoopDevices(ctx, lvs.run, loopFilePath)
+	if err != nil {
+		return 0, errors.Annotate(err, "locating loop device")
+	}
+	for _, deviceName := range deviceNames {
+		if _, err := lvs.run(ctx, "losetup", "-c", path.Join("/dev", deviceName)); err != nil {
+			return 0, errors.Annotatef(err, "refreshing capacity of loop device %q", deviceName)
+		}
+	}
+	return arg.Size, nil
+}
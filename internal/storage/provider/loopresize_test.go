@@ -0,0 +1,80 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"path/filepath"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/storage"
+)
+
+func (s *loopSuite) loopStorageResizer(c *tc.C) storage.StorageResizer {
+	source, _ := s.loopVolumeSource(c)
+	resizer, ok := source.(storage.StorageResizer)
+	c.Assert(ok, tc.IsTrue)
+	return resizer
+}
+
+func (s *loopSuite) TestResizeStorage(c *tc.C) {
+	resizer := s.loopStorageResizer(c)
+	volumePath := filepath.Join(s.storageDir, "volume-0")
+	s.writeFile(c, volumePath, 1<<20)
+	s.commands.expect("fallocate", "-l", "3MiB", volumePath)
+	cmd := s.commands.expect("losetup", "-j", volumePath)
+	cmd.respond("/dev/loop0: foo\n", nil)
+	s.commands.expect("losetup", "-c", "/dev/loop0")
+
+	results, err := resizer.ResizeStorage(c.Context(), []storage.ResizeParams{{
+		Tag:        names.NewVolumeTag("0"),
+		ProviderId: "volume-0",
+		Size:       3,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Size, tc.Equals, uint64(3))
+}
+
+func (s *loopSuite) TestResizeStorageUnchanged(c *tc.C) {
+	resizer := s.loopStorageResizer(c)
+	s.writeFile(c, filepath.Join(s.storageDir, "volume-0"), 2<<20)
+
+	results, err := resizer.ResizeStorage(c.Context(), []storage.ResizeParams{{
+		Tag:        names.NewVolumeTag("0"),
+		ProviderId: "volume-0",
+		Size:       2,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Size, tc.Equals, uint64(2))
+}
+
+func (s *loopSuite) TestResizeStorageErrors(c *tc.C) {
+	resizer := s.loopStorageResizer(c)
+	s.writeFile(c, filepath.Join(s.storageDir, "volume-0"), 2<<20)
+
+	results, err := resizer.ResizeStorage(c.Context(), []storage.ResizeParams{{
+		Tag:        names.NewVolumeTag("0"),
+		ProviderId: "volume-0",
+		Size:       1,
+	}, {
+		Tag:        names.NewVolumeTag("1"),
+		ProviderId: "volume-1",
+		Size:       1,
+	}, {
+		Tag:        names.NewVolumeTag("2"),
+		ProviderId: "junk",
+		Size:       1,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 3)
+	c.Check(results[0].Error, tc.ErrorIs, errors.NotValid)
+	c.Check(results[1].Error, tc.ErrorIs, errors.NotFound)
+	c.Check(results[2].Error, tc.ErrorMatches, `resizing volume "junk": invalid loop volume ID "junk"`)
+}
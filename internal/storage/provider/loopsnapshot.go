@@ -0,0 +1,181 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/internal/storage"
+)
+
+// loopSnapshotsDir is the directory, relative to the storage directory,
+// holding copies of loop backing files taken as snapshots.
+const loopSnapshotsDir = "snapshots"
+
+var validLoopSnapshotName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+var _ storage.VolumeSnapshotter = (*loopVolumeSource)(nil)
+
+// CreateSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) CreateSnapshots(ctx context.Context, args []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	results := make([]storage.CreateSnapshotsResult, len(args))
+	for i, arg := range args {
+		snapshot, err := lvs.createSnapshot(ctx, arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "creating snapshot %q of %q", arg.Name, arg.ProviderId)
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) createSnapshot(ctx context.Context, arg storage.SnapshotParams) (*storage.SnapshotInfo, error) {
+	if !validLoopSnapshotName.MatchString(arg.Name) {
+		return nil, errors.NotValidf("snapshot name %q", arg.Name)
+	}
+	tag, err := names.ParseVolumeTag(arg.ProviderId)
+	if err != nil {
+		return nil, errors.Errorf("invalid loop volume ID %q", arg.ProviderId)
+	}
+	info, err := os.Stat(lvs.volumeFilePath(tag))
+	if os.IsNotExist(err) {
+		return nil, errors.NotFoundf("volume %q", arg.ProviderId)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	snapshotId := makeLoopSnapshotId(arg.ProviderId, arg.Name)
+	snapshotPath := lvs.snapshotFilePath(snapshotId)
+	if _, err := os.Stat(snapshotPath); err == nil {
+		return nil, errors.AlreadyExistsf("snapshot %q", snapshotId)
+	}
+	if err := ensureDir(lvs.dirFuncs, filepath.Dir(snapshotPath)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := copyBlockFile(ctx, lvs.run, lvs.volumeFilePath(tag), snapshotPath); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &storage.SnapshotInfo{
+		SnapshotId: snapshotId,
+		Name:       arg.Name,
+		ProviderId: arg.ProviderId,
+		Size:       bytesToMiB(info.Size()),
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// ListSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) ListSnapshots(ctx context.Context, volumeId string) ([]storage.SnapshotInfo, error) {
+	entries, err := os.ReadDir(filepath.Join(lvs.storageDir, loopSnapshotsDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Annotate(err, "listing snapshots")
+	}
+	var snapshots []storage.SnapshotInfo
+	for _, entry := range entries {
+		providerId, name, err := parseLoopSnapshotId(entry.Name())
+		if err != nil || providerId != volumeId {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, errors.Annotatef(err, "reading snapshot %q", entry.Name())
+		}
+		snapshots = append(snapshots, storage.SnapshotInfo{
+			SnapshotId: entry.Name(),
+			Name:       name,
+			ProviderId: providerId,
+			Size:       bytesToMiB(info.Size()),
+			CreatedAt:  info.ModTime().UTC(),
+		})
+	}
+	return snapshots, nil
+}
+
+// DestroySnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) DestroySnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		if _, _, err := parseLoopSnapshotId(snapshotId); err != nil {
+			results[i] = errors.Trace(err)
+			continue
+		}
+		err := os.Remove(lvs.snapshotFilePath(snapshotId))
+		if err != nil && !os.IsNotExist(err) {
+			results[i] = errors.Annotatef(err, "destroying snapshot %q", snapshotId)
+		}
+	}
+	return results, nil
+}
+
+// createVolumeFromSnapshot creates the loop backing file for a volume as a
+// copy of a snapshot, growing it to the requested size if the snapshot is
+// smaller. The resulting size of the volume, in MiB, is returned.
+func (lvs *loopVolumeSource) createVolumeFromSnapshot(
+	ctx context.Context, snapshotId, loopFilePath string, sizeInMiB uint64,
+) (uint64, error) {
+	if _, _, err := parseLoopSnapshotId(snapshotId); err != nil {
+		return 0, errors.Trace(err)
+	}
+	snapshotPath := lvs.snapshotFilePath(snapshotId)
+	info, err := os.Stat(snapshotPath)
+	if os.IsNotExist(err) {
+		return 0, errors.NotFoundf("snapshot %q", snapshotId)
+	} else if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if err := copyBlockFile(ctx, lvs.run, snapshotPath, loopFilePath); err != nil {
+		return 0, errors.Trace(err)
+	}
+	if snapshotSize := bytesToMiB(info.Size()); snapshotSize >= sizeInMiB {
+		return snapshotSize, nil
+	}
+	if err := createBlockFile(ctx, lvs.run, loopFilePath, sizeInMiB); err != nil {
+		return 0, errors.Annotate(err, "growing volume")
+	}
+	return sizeInMiB, nil
+}
+
+func (lvs *loopVolumeSource) snapshotFilePath(snapshotId string) string {
+	return filepath.Join(lvs.storageDir, loopSnapshotsDir, snapshotId)
+}
+
+func makeLoopSnapshotId(volumeId, name string) string {
+	return volumeId + "@" + name
+}
+
+// parseLoopSnapshotId parses the given snapshot ID, returning the ID of the
+// volume the snapshot was taken of and the snapshot name.
+func parseLoopSnapshotId(snapshotId string) (volumeId, name string, _ error) {
+	volumeId, name, ok := strings.Cut(snapshotId, "@")
+	if _, err := names.ParseVolumeTag(volumeId); err != nil || !ok || !validLoopSnapshotName.MatchString(name) {
+		return "", "", errors.Errorf(
+			"invalid loop snapshot ID %q; expected ID in format <volume-id>@<name>", snapshotId,
+		)
+	}
+	return volumeId, name, nil
+}
+
+// copyBlockFile copies a loop backing file, preserving any holes so that
+// the copy does not take up more space than the original.
+func copyBlockFile(ctx context.Context, run RunCommandFunc, from, to string) error {
+	if _, err := run(ctx, "cp", "--sparse=always", from, to); err != nil {
+		return errors.Annotatef(err, "copying loop backing file %q", from)
+	}
+	return nil
+}
+
+func bytesToMiB(n int64) uint64 {
+	return uint64((n + (1<<20 - 1)) >> 20)
+}
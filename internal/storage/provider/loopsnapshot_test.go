@@ -0,0 +1,160 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/storage"
+)
+
+func (s *loopSuite) loopVolumeSnapshotter(c *tc.C) (storage.VolumeSource, storage.VolumeSnapshotter) {
+	source, _ := s.loopVolumeSource(c)
+	snapshotter, ok := source.(storage.VolumeSnapshotter)
+	c.Assert(ok, tc.IsTrue)
+	return source, snapshotter
+}
+
+func (s *loopSuite) writeFile(c *tc.C, path string, size int) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.WriteFile(path, make([]byte, size), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *loopSuite) TestCreateSnapshots(c *tc.C) {
+	_, snapshotter := s.loopVolumeSnapshotter(c)
+	s.writeFile(c, filepath.Join(s.storageDir, "volume-0"), 2<<20)
+	s.commands.expect("cp", "--sparse=always",
+		filepath.Join(s.storageDir, "volume-0"),
+		filepath.Join(s.storageDir, "snapshots", "volume-0@pre-refresh"),
+	)
+
+	results, err := snapshotter.CreateSnapshots(c.Context(), []storage.SnapshotParams{{
+		Name:       "pre-refresh",
+		ProviderId: "volume-0",
+	}, {
+		Name:       "pre-refresh",
+		ProviderId: "volume-1",
+	}, {
+		Name:       "not/valid",
+		ProviderId: "volume-0",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 3)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Snapshot.SnapshotId, tc.Equals, "volume-0@pre-refresh")
+	c.Check(results[0].Snapshot.Name, tc.Equals, "pre-refresh")
+	c.Check(results[0].Snapshot.ProviderId, tc.Equals, "volume-0")
+	c.Check(results[0].Snapshot.Size, tc.Equals, uint64(2))
+	c.Check(results[1].Error, tc.ErrorIs, errors.NotFound)
+	c.Check(results[2].Error, tc.ErrorIs, errors.NotValid)
+}
+
+func (s *loopSuite) TestCreateSnapshotsAlreadyExists(c *tc.C) {
+	_, snapshotter := s.loopVolumeSnapshotter(c)
+	s.writeFile(c, filepath.Join(s.storageDir, "volume-0"), 0)
+	s.writeFile(c, filepath.Join(s.storageDir, "snapshots", "volume-0@pre-refresh"), 0)
+
+	results, err := snapshotter.CreateSnapshots(c.Context(), []storage.SnapshotParams{{
+		Name:       "pre-refresh",
+		ProviderId: "volume-0",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Check(results[0].Error, tc.ErrorIs, errors.AlreadyExists)
+}
+
+func (s *loopSuite) TestListSnapshots(c *tc.C) {
+	_, snapshotter := s.loopVolumeSnapshotter(c)
+
+	snapshots, err := snapshotter.ListSnapshots(c.Context(), "volume-0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(snapshots, tc.HasLen, 0)
+
+	s.writeFile(c, filepath.Join(s.storageDir, "snapshots", "volume-0@one"), 1<<20)
+	s.writeFile(c, filepath.Join(s.storageDir, "snapshots", "volume-1@two"), 1<<20)
+	s.writeFile(c, filepath.Join(s.storageDir, "snapshots", "junk"), 0)
+
+	snapshots, err = snapshotter.ListSnapshots(c.Context(), "volume-0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(snapshots, tc.HasLen, 1)
+	c.Check(snapshots[0].SnapshotId, tc.Equals, "volume-0@one")
+	c.Check(snapshots[0].Name, tc.Equals, "one")
+	c.Check(snapshots[0].ProviderId, tc.Equals, "volume-0")
+	c.Check(snapshots[0].Size, tc.Equals, uint64(1))
+}
+
+func (s *loopSuite) TestDestroySnapshots(c *tc.C) {
+	_, snapshotter := s.loopVolumeSnapshotter(c)
+	snapshotPath := filepath.Join(s.storageDir, "snapshots", "volume-0@one")
+	s.writeFile(c, snapshotPath, 0)
+
+	errs, err := snapshotter.DestroySnapshots(c.Context(), []string{"volume-0@one", "volume-0@gone", "junk"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errs, tc.HasLen, 3)
+	c.Check(errs[0], tc.ErrorIsNil)
+	c.Check(errs[1], tc.ErrorIsNil)
+	c.Check(errs[2], tc.ErrorMatches, `invalid loop snapshot ID "junk"; expected ID in format <volume-id>@<name>`)
+
+	_, err = os.Stat(snapshotPath)
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+}
+
+func (s *loopSuite) TestCreateVolumesFromSnapshot(c *tc.C) {
+	source, _ := s.loopVolumeSnapshotter(c)
+	snapshotPath := filepath.Join(s.storageDir, "snapshots", "volume-0@one")
+	s.writeFile(c, snapshotPath, 1<<20)
+	volumePath := filepath.Join(s.storageDir, "volume-1")
+	s.commands.expect("cp", "--sparse=always", snapshotPath, volumePath)
+	s.commands.expect("fallocate", "-l", "2MiB", volumePath)
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("1"),
+		Size:       2,
+		SnapshotId: "volume-0@one",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Volume.VolumeInfo, tc.DeepEquals, storage.VolumeInfo{
+		VolumeId: "volume-1",
+		Size:     2,
+	})
+}
+
+func (s *loopSuite) TestCreateVolumesFromLargerSnapshot(c *tc.C) {
+	source, _ := s.loopVolumeSnapshotter(c)
+	snapshotPath := filepath.Join(s.storageDir, "snapshots", "volume-0@one")
+	s.writeFile(c, snapshotPath, 3<<20)
+	s.commands.expect("cp", "--sparse=always", snapshotPath, filepath.Join(s.storageDir, "volume-1"))
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("1"),
+		Size:       2,
+		SnapshotId: "volume-0@one",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Volume.Size, tc.Equals, uint64(3))
+}
+
+func (s *loopSuite) TestCreateVolumesFromSnapshotNotFound(c *tc.C) {
+	source, _ := s.loopVolumeSnapshotter(c)
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("1"),
+		Size:       2,
+		SnapshotId: "volume-0@one",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Check(results[0].Error, tc.ErrorMatches, `creating volume: could not create block file from snapshot: snapshot "volume-0@one" not found`)
+}
@@ -25,8 +25,16 @@ const (
 	// defaultFilesystemType is the default filesystem type
 	// to create for volume-backed managed filesystems.
 	defaultFilesystemType = "ext4"
+
+	// encryptionKeyDir is the directory holding encryption keys while
+	// cryptsetup reads them. It is expected to be on a tmpfs.
+	encryptionKeyDir = "/run/juju-storage"
 )
 
+// FilesystemEncryptionKeyFunc returns the LUKS key of the specified
+// filesystem, or an empty string if the filesystem is not encrypted.
+type FilesystemEncryptionKeyFunc func(context.Context, names.FilesystemTag) (string, error)
+
 // managedFilesystemSource is an implementation of storage.FilesystemSource
 // that manages filesystems on volumes attached to the host machine.
 //
@@ -36,23 +44,30 @@ type managedFilesystemSource struct {
 	dirFuncs           dirFuncs
 	volumeBlockDevices map[names.VolumeTag]blockdevice.BlockDevice
 	filesystems        map[names.FilesystemTag]storage.Filesystem
+	encryptionKeys     FilesystemEncryptionKeyFunc
+	keyDir             string
 }
 
 // NewManagedFilesystemSource returns a storage.FilesystemSource that manages
 // filesystems on block devices on the host machine.
 //
-// The parameters are maps that the caller will update with information about
+// The maps are ones that the caller will update with information about
 // block devices and filesystems created by the source. The caller must not
-// update the maps during calls to the source's methods.
+// update the maps during calls to the source's methods. The encryption keys
+// function supplies the LUKS keys of filesystems in pools with the encrypt
+// attribute set.
 func NewManagedFilesystemSource(
 	volumeBlockDevices map[names.VolumeTag]blockdevice.BlockDevice,
 	filesystems map[names.FilesystemTag]storage.Filesystem,
+	encryptionKeys FilesystemEncryptionKeyFunc,
 ) storage.FilesystemSource {
 	return &managedFilesystemSource{
 		run:                LogAndExec,
 		dirFuncs:           &osDirFuncs{run: LogAndExec},
 		volumeBlockDevices: volumeBlockDevices,
 		filesystems:        filesystems,
+		encryptionKeys:     encryptionKeys,
+		keyDir:             encryptionKeyDir,
 	}
 }
 
@@ -97,6 +112,15 @@ func (s *managedFilesystemSource) createFilesystem(
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	var key string
+	if storage.Encrypted(arg.Attributes) {
+		if key, err = s.encryptionKey(ctx, arg.Tag); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if key == "" {
+			return nil, errors.Errorf("no encryption key for filesystem %s", arg.Tag.Id())
+		}
+	}
 	devicePath := devicePath(blockDevice)
 	if isDiskDevice(devicePath) {
 		if err := destroyPartitions(ctx, s.run, devicePath); err != nil {
@@ -107,6 +131,14 @@ func (s *managedFilesystemSource) createFilesystem(
 		}
 		devicePath = partitionDevicePath(devicePath)
 	}
+	if key != "" {
+		if err := s.formatEncryptedDevice(ctx, arg.Tag, devicePath, key); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if devicePath, err = s.openEncryptedDevice(ctx, arg.Tag, devicePath, key); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 	if err := createFilesystem(ctx, s.run, devicePath); err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -162,14 +194,29 @@ func (s *managedFilesystemSource) attachFilesystem(
 	if isDiskDevice(devicePath) {
 		devicePath = partitionDevicePath(devicePath)
 	}
+	key, err := s.encryptionKey(ctx, arg.Filesystem)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	uuid, persist := blockDevice.FilesystemUUID, true
+	if key != "" {
+		// The encrypted device is unlocked when the filesystem is
+		// (re)attached, which is also how it is remounted after a
+		// reboot, so there is no fstab entry for it.
+		if devicePath, err = s.openEncryptedDevice(ctx, arg.Filesystem, devicePath, key); err != nil {
+			return nil, errors.Trace(err)
+		}
+		uuid, persist = "", false
+	}
 	if err := mountFilesystem(
 		ctx,
 		s.run,
 		s.dirFuncs,
 		devicePath,
-		blockDevice.FilesystemUUID,
+		uuid,
 		arg.Path,
 		arg.ReadOnly,
+		persist,
 	); err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -189,11 +236,108 @@ func (s *managedFilesystemSource) DetachFilesystems(ctx context.Context, args []
 	for i, arg := range args {
 		if err := maybeUnmount(ctx, s.run, s.dirFuncs, arg.Path); err != nil {
 			results[i] = err
+			continue
+		}
+		if err := s.closeEncryptedDevice(ctx, arg.Filesystem); err != nil {
+			results[i] = err
 		}
 	}
 	return results, nil
 }
 
+// encryptionKey returns the LUKS key of the specified filesystem, or an empty
+// string if it is not encrypted.
+func (s *managedFilesystemSource) encryptionKey(ctx context.Context, tag names.FilesystemTag) (string, error) {
+	if s.encryptionKeys == nil {
+		return "", nil
+	}
+	key, err := s.encryptionKeys(ctx, tag)
+	if err != nil {
+		return "", errors.Annotatef(err, "getting encryption key for filesystem %s", tag.Id())
+	}
+	return key, nil
+}
+
+// withKeyFile writes the filesystem's key to a file only readable by the
+// agent for the duration of f, as cryptsetup reads keys from files.
+func (s *managedFilesystemSource) withKeyFile(
+	tag names.FilesystemTag, key string, f func(keyFile string) error,
+) error {
+	if err := s.dirFuncs.mkDirAll(s.keyDir, 0700); err != nil {
+		return errors.Annotate(err, "creating encryption key directory")
+	}
+	keyFile := filepath.Join(s.keyDir, encryptedDeviceName(tag)+".key")
+	// Remove any stale file so that the permissions below apply.
+	_ = os.Remove(keyFile)
+	defer func() { _ = os.Remove(keyFile) }()
+	if err := os.WriteFile(keyFile, []byte(key), 0600); err != nil {
+		return errors.Annotate(err, "writing encryption key file")
+	}
+	return f(keyFile)
+}
+
+// formatEncryptedDevice sets up LUKS on the device, destroying any data on it.
+func (s *managedFilesystemSource) formatEncryptedDevice(
+	ctx context.Context, tag names.FilesystemTag, devicePath, key string,
+) error {
+	logger.Debugf(ctx, "setting up encryption on %q", devicePath)
+	return s.withKeyFile(tag, key, func(keyFile string) error {
+		if _, err := s.run(
+			ctx, "cryptsetup", "luksFormat", "--batch-mode", "--type", "luks2",
+			"--key-file", keyFile, devicePath,
+		); err != nil {
+			return errors.Annotate(err, "cryptsetup luksFormat failed")
+		}
+		return nil
+	})
+}
+
+// openEncryptedDevice unlocks the encrypted device for the filesystem if it
+// is not unlocked already, returning the path of the unlocked device.
+func (s *managedFilesystemSource) openEncryptedDevice(
+	ctx context.Context, tag names.FilesystemTag, devicePath, key string,
+) (string, error) {
+	name := encryptedDeviceName(tag)
+	mapperPath := path.Join("/dev/mapper", name)
+	if _, err := s.dirFuncs.lstat(mapperPath); err == nil {
+		logger.Debugf(ctx, "encrypted device %q already open at %q", devicePath, mapperPath)
+		return mapperPath, nil
+	}
+	err := s.withKeyFile(tag, key, func(keyFile string) error {
+		if _, err := s.run(
+			ctx, "cryptsetup", "open", "--type", "luks", "--key-file", keyFile, devicePath, name,
+		); err != nil {
+			return errors.Annotate(err, "cryptsetup open failed")
+		}
+		return nil
+	})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	logger.Infof(ctx, "opened encrypted device %q at %q", devicePath, mapperPath)
+	return mapperPath, nil
+}
+
+// closeEncryptedDevice locks the encrypted device for the filesystem, if
+// there is one open.
+func (s *managedFilesystemSource) closeEncryptedDevice(ctx context.Context, tag names.FilesystemTag) error {
+	name := encryptedDeviceName(tag)
+	if _, err := s.dirFuncs.lstat(path.Join("/dev/mapper", name)); err != nil {
+		return nil
+	}
+	if _, err := s.run(ctx, "cryptsetup", "close", name); err != nil {
+		return errors.Annotate(err, "cryptsetup close failed")
+	}
+	logger.Infof(ctx, "closed encrypted device %q", name)
+	return nil
+}
+
+// encryptedDeviceName returns the device mapper name of the unlocked
+// encrypted device for the filesystem.
+func encryptedDeviceName(tag names.FilesystemTag) string {
+	return "juju-" + tag.String()
+}
+
 func destroyPartitions(ctx context.Context, run RunCommandFunc, devicePath string) error {
 	logger.Debugf(ctx, "destroying partitions on %q", devicePath)
 	if _, err := run(ctx, "sgdisk", "--zap-all", devicePath); err != nil {
@@ -235,6 +379,7 @@ func mountFilesystem(
 	uuid,
 	mountPoint string,
 	readOnly bool,
+	persist bool,
 ) error {
 	logger.Debugf(ctx, "attempting to mount filesystem on %q at %q", devicePath, mountPoint)
 	if err := dirFuncs.mkDirAll(mountPoint, 0755); err != nil {
@@ -257,6 +402,9 @@ func mountFilesystem(
 		}
 		logger.Debugf(ctx, "mounted filesystem on %q at %q", devicePath, mountPoint)
 	}
+	if !persist {
+		return nil
+	}
 	// Look for the mtab entry resulting from the mount and copy it to fstab.
 	// This ensures the mount is available after a reboot.
 	etcDir := dirFuncs.etcDir()
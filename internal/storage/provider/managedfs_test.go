@@ -4,6 +4,7 @@
 package provider_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -249,3 +250,104 @@ func (s *managedfsSuite) TestDetachFilesystemsUnattached(c *tc.C) {
 	source := s.initSource(c)
 	testDetachFilesystems(c, s.commands, source, false, s.fakeEtcDir, "")
 }
+
+func (s *managedfsSuite) TestCreateEncryptedFilesystem(c *tc.C) {
+	source := s.initSource(c)
+	keyDir := c.MkDir()
+	provider.SetManagedFilesystemEncryptionKeys(source, keyDir,
+		func(_ context.Context, tag names.FilesystemTag) (string, error) {
+			c.Check(tag, tc.Equals, names.NewFilesystemTag("0/0"))
+			return "sekrit", nil
+		},
+	)
+	keyFile := filepath.Join(keyDir, "juju-filesystem-0-0.key")
+	s.commands.expect("sgdisk", "--zap-all", "/dev/sda")
+	s.commands.expect("sgdisk", "-n", "1:0:-1", "/dev/sda")
+	s.commands.expect("cryptsetup", "luksFormat", "--batch-mode", "--type", "luks2", "--key-file", keyFile, "/dev/sda1")
+	s.commands.expect("cryptsetup", "open", "--type", "luks", "--key-file", keyFile, "/dev/sda1", "juju-filesystem-0-0")
+	s.commands.expect("mkfs.ext4", "/dev/mapper/juju-filesystem-0-0")
+
+	s.blockDevices[names.NewVolumeTag("0")] = blockdevice.BlockDevice{
+		DeviceName: "sda",
+		HardwareId: "capncrunch",
+		SizeMiB:    2,
+	}
+	results, err := source.CreateFilesystems(c.Context(), []storage.FilesystemParams{{
+		Tag:        names.NewFilesystemTag("0/0"),
+		Volume:     names.NewVolumeTag("0"),
+		Size:       2,
+		Attributes: map[string]any{"encrypt": "true"},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+
+	// The key is never left on disk.
+	_, err = os.Stat(keyFile)
+	c.Assert(err, tc.Satisfies, os.IsNotExist)
+}
+
+func (s *managedfsSuite) TestCreateEncryptedFilesystemNoKey(c *tc.C) {
+	source := s.initSource(c)
+	provider.SetManagedFilesystemEncryptionKeys(source, c.MkDir(),
+		func(context.Context, names.FilesystemTag) (string, error) {
+			return "", nil
+		},
+	)
+	s.blockDevices[names.NewVolumeTag("0")] = blockdevice.BlockDevice{
+		DeviceName: "sda",
+		HardwareId: "capncrunch",
+		SizeMiB:    2,
+	}
+	results, err := source.CreateFilesystems(c.Context(), []storage.FilesystemParams{{
+		Tag:        names.NewFilesystemTag("0/0"),
+		Volume:     names.NewVolumeTag("0"),
+		Size:       2,
+		Attributes: map[string]any{"encrypt": true},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorMatches, "no encryption key for filesystem 0/0")
+}
+
+func (s *managedfsSuite) TestAttachEncryptedFilesystem(c *tc.C) {
+	source := s.initSource(c)
+	keyDir := c.MkDir()
+	provider.SetManagedFilesystemEncryptionKeys(source, keyDir,
+		func(context.Context, names.FilesystemTag) (string, error) {
+			return "sekrit", nil
+		},
+	)
+	keyFile := filepath.Join(keyDir, "juju-filesystem-0-0.key")
+	s.commands.expect("cryptsetup", "open", "--type", "luks", "--key-file", keyFile, "/dev/sda1", "juju-filesystem-0-0")
+	s.commands.expect("mount", "/dev/mapper/juju-filesystem-0-0", testMountPoint)
+
+	s.blockDevices[names.NewVolumeTag("0")] = blockdevice.BlockDevice{
+		DeviceName: "sda1",
+		HardwareId: "capncrunch",
+		SizeMiB:    2,
+	}
+	s.filesystems[names.NewFilesystemTag("0/0")] = storage.Filesystem{
+		Tag:    names.NewFilesystemTag("0/0"),
+		Volume: names.NewVolumeTag("0"),
+	}
+	results, err := source.AttachFilesystems(c.Context(), []storage.FilesystemAttachmentParams{{
+		Filesystem:           names.NewFilesystemTag("0/0"),
+		FilesystemProviderId: "filesystem-0-0",
+		AttachmentParams: storage.AttachmentParams{
+			Machine:    names.NewMachineTag("0"),
+			InstanceId: "inst-ance",
+		},
+		Path: testMountPoint,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+
+	// The unlocked device is opened by the storage provisioner on each
+	// start, so it must not be mounted from fstab at boot.
+	_, err = os.Stat(filepath.Join(s.fakeEtcDir, "fstab"))
+	c.Assert(err, tc.Satisfies, os.IsNotExist)
+	_, err = os.Stat(keyFile)
+	c.Assert(err, tc.Satisfies, os.IsNotExist)
+}
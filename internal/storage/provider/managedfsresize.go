@@ -0,0 +1,106 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/internal/storage"
+)
+
+var _ storage.StorageResizer = (*managedFilesystemSource)(nil)
+
+// ResizeStorage is defined on the StorageResizer interface. Managed
+// filesystems are grown to fill their backing volumes, which must already
+// have been grown to the requested size.
+func (s *managedFilesystemSource) ResizeStorage(ctx context.Context, args []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+	results := make([]storage.ResizeStorageResult, len(args))
+	for i, arg := range args {
+		size, err := s.resizeFilesystem(ctx, arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing filesystem %q", arg.ProviderId)
+			continue
+		}
+		results[i].Size = size
+	}
+	return results, nil
+}
+
+func (s *managedFilesystemSource) resizeFilesystem(ctx context.Context, arg storage.ResizeParams) (uint64, error) {
+	tag, ok := arg.Tag.(names.FilesystemTag)
+	if !ok {
+		return 0, errors.NotValidf("filesystem tag %v", arg.Tag)
+	}
+	filesystem, ok := s.filesystems[tag]
+	if !ok {
+		return 0, errors.Errorf("filesystem %v is not yet provisioned", tag.Id())
+	}
+	blockDevice, err := s.backingVolumeBlockDevice(filesystem.Volume)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if arg.Size > blockDevice.SizeMiB {
+		return 0, errors.Errorf(
+			"backing-volume %s is %dMiB, smaller than the requested %dMiB",
+			filesystem.Volume.Id(), blockDevice.SizeMiB, arg.Size,
+		)
+	}
+
+	devicePath := devicePath(blockDevice)
+	if isDiskDevice(devicePath) {
+		if err := growPartition(ctx, s.run, devicePath); err != nil {
+			return 0, errors.Trace(err)
+		}
+		devicePath = partitionDevicePath(devicePath)
+	}
+	key, err := s.encryptionKey(ctx, tag)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if key != "" {
+		if devicePath, err = s.openEncryptedDevice(ctx, tag, devicePath, key); err != nil {
+			return 0, errors.Trace(err)
+		}
+		if err := s.resizeEncryptedDevice(ctx, tag, key); err != nil {
+			return 0, errors.Trace(err)
+		}
+	}
+	// ext4 filesystems can be grown while mounted.
+	if _, err := s.run(ctx, "resize2fs", devicePath); err != nil {
+		return 0, errors.Annotate(err, "resize2fs failed")
+	}
+	logger.Infof(ctx, "grew filesystem on %q to %dMiB", devicePath, blockDevice.SizeMiB)
+	return blockDevice.SizeMiB, nil
+}
+
+// resizeEncryptedDevice grows the unlocked encrypted device for the
+// filesystem to fill the device holding it.
+func (s *managedFilesystemSource) resizeEncryptedDevice(ctx context.Context, tag names.FilesystemTag, key string) error {
+	return s.withKeyFile(tag, key, func(keyFile string) error {
+		if _, err := s.run(
+			ctx, "cryptsetup", "resize", "--key-file", keyFile, encryptedDeviceName(tag),
+		); err != nil {
+			return errors.Annotate(err, "cryptsetup resize failed")
+		}
+		return nil
+	})
+}
+
+// growPartition grows the first (and only) partition on the disk, which
+// holds the filesystem, to fill the disk.
+func growPartition(ctx context.Context, run RunCommandFunc, devicePath string) error {
+	logger.Debugf(ctx, "growing partition on %q", devicePath)
+	if _, err := run(ctx, "growpart", devicePath, "1"); err != nil {
+		// growpart fails when the partition already fills the disk.
+		if strings.Contains(err.Error(), "NOCHANGE") {
+			return nil
+		}
+		return errors.Annotate(err, "growpart failed")
+	}
+	return nil
+}
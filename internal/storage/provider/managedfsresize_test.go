@@ -0,0 +1,115 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/storage/provider"
+)
+
+func (s *managedfsSuite) managedStorageResizer(c *tc.C) (storage.FilesystemSource, storage.StorageResizer) {
+	source := s.initSource(c)
+	resizer, ok := source.(storage.StorageResizer)
+	c.Assert(ok, tc.IsTrue)
+	return source, resizer
+}
+
+func (s *managedfsSuite) addVolumeBackedFilesystem(deviceName string, sizeMiB uint64) {
+	s.blockDevices[names.NewVolumeTag("0")] = blockdevice.BlockDevice{
+		DeviceName: deviceName,
+		SizeMiB:    sizeMiB,
+	}
+	s.filesystems[names.NewFilesystemTag("0/0")] = storage.Filesystem{
+		Tag:    names.NewFilesystemTag("0/0"),
+		Volume: names.NewVolumeTag("0"),
+	}
+}
+
+func (s *managedfsSuite) TestResizeStorage(c *tc.C) {
+	_, resizer := s.managedStorageResizer(c)
+	s.addVolumeBackedFilesystem("sda", 4)
+	s.commands.expect("growpart", "/dev/sda", "1")
+	s.commands.expect("resize2fs", "/dev/sda1")
+
+	results, err := resizer.ResizeStorage(c.Context(), []storage.ResizeParams{{
+		Tag:        names.NewFilesystemTag("0/0"),
+		ProviderId: "filesystem-0-0",
+		Size:       4,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Size, tc.Equals, uint64(4))
+}
+
+func (s *managedfsSuite) TestResizeStoragePartitionUnchanged(c *tc.C) {
+	_, resizer := s.managedStorageResizer(c)
+	s.addVolumeBackedFilesystem("sda", 4)
+	cmd := s.commands.expect("growpart", "/dev/sda", "1")
+	cmd.respond("", errors.New("NOCHANGE: partition 1 is size 8388608. it cannot be grown"))
+	s.commands.expect("resize2fs", "/dev/sda1")
+
+	results, err := resizer.ResizeStorage(c.Context(), []storage.ResizeParams{{
+		Tag:        names.NewFilesystemTag("0/0"),
+		ProviderId: "filesystem-0-0",
+		Size:       4,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+}
+
+func (s *managedfsSuite) TestResizeStorageEncrypted(c *tc.C) {
+	source, resizer := s.managedStorageResizer(c)
+	keyDir := c.MkDir()
+	provider.SetManagedFilesystemEncryptionKeys(source, keyDir,
+		func(context.Context, names.FilesystemTag) (string, error) {
+			return "sekrit", nil
+		},
+	)
+	keyFile := filepath.Join(keyDir, "juju-filesystem-0-0.key")
+	s.addVolumeBackedFilesystem("xvdf1", 4)
+	s.commands.expect("cryptsetup", "open", "--type", "luks", "--key-file", keyFile, "/dev/xvdf1", "juju-filesystem-0-0")
+	s.commands.expect("cryptsetup", "resize", "--key-file", keyFile, "juju-filesystem-0-0")
+	s.commands.expect("resize2fs", "/dev/mapper/juju-filesystem-0-0")
+
+	results, err := resizer.ResizeStorage(c.Context(), []storage.ResizeParams{{
+		Tag:        names.NewFilesystemTag("0/0"),
+		ProviderId: "filesystem-0-0",
+		Size:       4,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Size, tc.Equals, uint64(4))
+}
+
+func (s *managedfsSuite) TestResizeStorageErrors(c *tc.C) {
+	_, resizer := s.managedStorageResizer(c)
+	s.addVolumeBackedFilesystem("sda", 2)
+
+	results, err := resizer.ResizeStorage(c.Context(), []storage.ResizeParams{{
+		Tag:        names.NewFilesystemTag("0/0"),
+		ProviderId: "filesystem-0-0",
+		Size:       4,
+	}, {
+		Tag:        names.NewFilesystemTag("0/1"),
+		ProviderId: "filesystem-0-1",
+		Size:       4,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	c.Check(results[0].Error, tc.ErrorMatches,
+		`resizing filesystem "filesystem-0-0": backing-volume 0 is 2MiB, smaller than the requested 4MiB`)
+	c.Check(results[1].Error, tc.ErrorMatches,
+		`resizing filesystem "filesystem-0-1": filesystem 0/1 is not yet provisioned`)
+}
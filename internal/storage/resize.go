@@ -0,0 +1,31 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import "github.com/juju/names/v6"
+
+// ResizeParams is a set of parameters for growing a provisioned volume
+// or filesystem.
+type ResizeParams struct {
+	// Tag is the tag of the volume or filesystem to resize.
+	Tag names.Tag
+
+	// ProviderId is the provider's identifier for the volume or
+	// filesystem to resize.
+	ProviderId string
+
+	// Size is the requested minimum size of the volume or filesystem,
+	// in MiB.
+	Size uint64
+}
+
+// ResizeStorageResult contains the result of a
+// StorageResizer.ResizeStorage call for one volume or filesystem.
+// Size should only be used if Error is nil.
+type ResizeStorageResult struct {
+	// Size is the size of the volume or filesystem after resizing,
+	// in MiB.
+	Size  uint64
+	Error error
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import "time"
+
+// SnapshotParams is a set of parameters for taking a snapshot of a volume
+// or filesystem.
+type SnapshotParams struct {
+	// Name is a name assigned by Juju to the snapshot, unique amongst
+	// the snapshots of the volume or filesystem.
+	Name string
+
+	// ProviderId is the provider's identifier for the volume or
+	// filesystem to snapshot.
+	ProviderId string
+
+	// ResourceTags is a set of tags to set on the created snapshot, if
+	// the storage provider supports tags.
+	ResourceTags map[string]string
+}
+
+// SnapshotInfo describes a snapshot of a volume or filesystem.
+type SnapshotInfo struct {
+	// SnapshotId is a unique provider-supplied ID for the snapshot.
+	SnapshotId string
+
+	// Name is the name assigned by Juju to the snapshot.
+	Name string
+
+	// ProviderId is the provider's identifier for the volume or
+	// filesystem that the snapshot was taken of.
+	ProviderId string
+
+	// Size is the size of the volume or filesystem when the snapshot
+	// was taken, in MiB. Not all providers report the size, so this may
+	// be zero.
+	Size uint64
+
+	// CreatedAt is when the snapshot was taken. Not all providers report
+	// the time when the snapshot is created, so this may be zero.
+	CreatedAt time.Time
+}
+
+// CreateSnapshotsResult contains the result of a
+// VolumeSnapshotter.CreateSnapshots call for one snapshot. Snapshot
+// should only be used if Error is nil.
+type CreateSnapshotsResult struct {
+	Snapshot *SnapshotInfo
+	Error    error
+}
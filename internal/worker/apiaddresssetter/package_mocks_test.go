@@ -425,6 +425,45 @@ func (c *MockNetworkServiceSpaceByNameCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// WatchSpaceAddressPreferences mocks base method.
+func (m *MockNetworkService) WatchSpaceAddressPreferences(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSpaceAddressPreferences", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchSpaceAddressPreferences indicates an expected call of WatchSpaceAddressPreferences.
+func (mr *MockNetworkServiceMockRecorder) WatchSpaceAddressPreferences(arg0 any) *MockNetworkServiceWatchSpaceAddressPreferencesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSpaceAddressPreferences", reflect.TypeOf((*MockNetworkService)(nil).WatchSpaceAddressPreferences), arg0)
+	return &MockNetworkServiceWatchSpaceAddressPreferencesCall{Call: call}
+}
+
+// MockNetworkServiceWatchSpaceAddressPreferencesCall wrap *gomock.Call
+type MockNetworkServiceWatchSpaceAddressPreferencesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceWatchSpaceAddressPreferencesCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockNetworkServiceWatchSpaceAddressPreferencesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceWatchSpaceAddressPreferencesCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockNetworkServiceWatchSpaceAddressPreferencesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceWatchSpaceAddressPreferencesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockNetworkServiceWatchSpaceAddressPreferencesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockModelService is a mock of ModelService interface.
 type MockModelService struct {
 	ctrl     *gomock.Controller
@@ -76,12 +76,16 @@ type NetworkService interface {
 	// space is not found, an error is returned matching
 	// [github.com/juju/juju/domain/network/errors.SpaceNotFound].
 	SpaceByName(ctx context.Context, name network.SpaceName) (*network.SpaceInfo, error)
+	// WatchSpaceAddressPreferences returns a watcher that notifies when the
+	// address preference of any space changes.
+	WatchSpaceAddressPreferences(ctx context.Context) (watcher.NotifyWatcher, error)
 }
 
 // apiAddressSetterWorker is a worker which sets the API addresses for the
 // controller, watching for changes both in the controller node's ip addresses
 // and the controller config (the juju-mgmt-space key) to filter the addresses
-// based on the management space.
+// based on the management space, as well as in the address preference of the
+// management space.
 type apiAddressSetterWorker struct {
 	catacomb catacomb.Catacomb
 
@@ -180,6 +184,11 @@ func (w *apiAddressSetterWorker) loop() error {
 		return errors.Capture(err)
 	}
 
+	spacePreferenceChanges, err := w.watchForSpacePreferenceChanges(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
 	for {
 		w.config.Logger.Tracef(ctx, "waiting for controller nodes or addresses changes")
 		select {
@@ -210,6 +219,14 @@ func (w *apiAddressSetterWorker) loop() error {
 				w.config.Logger.Errorf(ctx, "no controller information, ignoring config change")
 				continue
 			}
+
+		case <-spacePreferenceChanges:
+			// The address preference of a space has changed.
+			w.config.Logger.Tracef(ctx, "<-w.spacePreferenceChanges")
+
+			if len(w.runner.WorkerNames()) == 0 {
+				continue
+			}
 		}
 
 		if err := w.updateAPIAddresses(ctx); err != nil {
@@ -246,6 +263,21 @@ func (w *apiAddressSetterWorker) watchForConfigChanges(ctx context.Context) (<-c
 	return watcher.Changes(), nil
 }
 
+// watchForSpacePreferenceChanges starts a watcher for changes to the address
+// preferences of spaces. It returns a channel which will receive events if
+// the watcher fires.
+func (w *apiAddressSetterWorker) watchForSpacePreferenceChanges(ctx context.Context) (<-chan struct{}, error) {
+	watcher, err := w.config.NetworkService.WatchSpaceAddressPreferences(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := w.catacomb.Add(watcher); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return watcher.Changes(), nil
+}
+
 // updateControllerNodes updates the current list of tracked controller nodes,
 // as well as starting and stopping trackers for them as they are added and
 // removed.
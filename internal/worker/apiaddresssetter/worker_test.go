@@ -61,6 +61,7 @@ func (s *workerSuite) TestWorkerCleanKill(c *tc.C) {
 
 	nodeWatcher := watchertest.NewMockNotifyWatcher(make(chan struct{}))
 	s.controllerNodeService.EXPECT().WatchControllerNodes(gomock.Any()).Return(nodeWatcher, nil)
+	s.networkService.EXPECT().WatchSpaceAddressPreferences(gomock.Any()).Return(watchertest.NewMockNotifyWatcher(make(chan struct{})), nil)
 	// We use the consume of the initial event as a sync point to decide
 	// whether the worker has started. This channel is then used to stop
 	// waiting for the worker to start.
@@ -112,6 +113,7 @@ func (s *workerSuite) TestNewControllerNode(c *tc.C) {
 	nodeCh := make(chan struct{})
 	nodeWatcher := watchertest.NewMockNotifyWatcher(nodeCh)
 	s.controllerNodeService.EXPECT().WatchControllerNodes(gomock.Any()).Return(nodeWatcher, nil)
+	s.networkService.EXPECT().WatchSpaceAddressPreferences(gomock.Any()).Return(watchertest.NewMockNotifyWatcher(make(chan struct{})), nil)
 
 	cfgCh := make(chan []string)
 	cfgWatcher := watchertest.NewMockStringsWatcher(cfgCh)
@@ -190,6 +192,7 @@ func (s *workerSuite) TestUnchangedControllerNodes(c *tc.C) {
 	nodeCh := make(chan struct{})
 	nodeWatcher := watchertest.NewMockNotifyWatcher(nodeCh)
 	s.controllerNodeService.EXPECT().WatchControllerNodes(gomock.Any()).Return(nodeWatcher, nil)
+	s.networkService.EXPECT().WatchSpaceAddressPreferences(gomock.Any()).Return(watchertest.NewMockNotifyWatcher(make(chan struct{})), nil)
 
 	s.controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).Return(watchertest.NewMockStringsWatcher(make(chan []string)), nil)
 
@@ -287,6 +290,7 @@ func (s *workerSuite) TestConfigChange(c *tc.C) {
 	nodeCh := make(chan struct{})
 	nodeWatcher := watchertest.NewMockNotifyWatcher(nodeCh)
 	s.controllerNodeService.EXPECT().WatchControllerNodes(gomock.Any()).Return(nodeWatcher, nil)
+	s.networkService.EXPECT().WatchSpaceAddressPreferences(gomock.Any()).Return(watchertest.NewMockNotifyWatcher(make(chan struct{})), nil)
 
 	cfgCh := make(chan []string)
 	cfgWatcher := watchertest.NewMockStringsWatcher(cfgCh)
@@ -393,6 +397,123 @@ func (s *workerSuite) TestConfigChange(c *tc.C) {
 	workertest.CleanKill(c, w)
 }
 
+// TestSpacePreferenceChange tests that when the address preference of a
+// space changes, the worker will update the api addresses for the controller.
+func (s *workerSuite) TestSpacePreferenceChange(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	// Mock the controller node watcher.
+	nodeCh := make(chan struct{})
+	nodeWatcher := watchertest.NewMockNotifyWatcher(nodeCh)
+	s.controllerNodeService.EXPECT().WatchControllerNodes(gomock.Any()).Return(nodeWatcher, nil)
+	prefCh := make(chan struct{})
+	s.networkService.EXPECT().WatchSpaceAddressPreferences(gomock.Any()).Return(watchertest.NewMockNotifyWatcher(prefCh), nil)
+
+	s.controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).Return(watchertest.NewMockStringsWatcher(make(chan []string)), nil)
+
+	// Starts the controller tracker for the new node.
+	s.controllerNodeService.EXPECT().GetControllerIDs(gomock.Any()).Return([]string{"1"}, nil)
+	s.applicationService.EXPECT().WatchUnitAddresses(gomock.Any(), unit.Name("controller/1")).Return(watchertest.NewMockNotifyWatcher(make(chan struct{})), nil)
+
+	// Updates the API addresses for the new node.
+	addrs := network.SpaceAddresses{
+		{
+			MachineAddress: network.MachineAddress{
+				Value: "10.0.0.1",
+			},
+			SpaceID: "space0",
+		},
+	}
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.JujuManagementSpace: "space0",
+	}, nil)
+	sp0 := &network.SpaceInfo{
+		ID: "space0",
+	}
+	s.networkService.EXPECT().GetControllerAPIAddresses(gomock.Any(), unit.Name("controller/1")).Return(addrs, nil)
+	s.networkService.EXPECT().SpaceByName(gomock.Any(), network.SpaceName("space0")).Return(sp0, nil)
+	// Synchronization point to ensure the worker processes the event.
+	sync := make(chan struct{})
+	hostPorts := network.SpaceAddressesWithPort(addrs, 17070)
+	args := controllernode.SetAPIAddressArgs{
+		MgmtSpace: sp0,
+		APIAddresses: map[string]network.SpaceHostPorts{
+			"1": hostPorts,
+		},
+	}
+	s.controllerNodeService.EXPECT().SetAPIAddresses(gomock.Any(), args).DoAndReturn(func(context.Context, controllernode.SetAPIAddressArgs) error {
+		close(sync)
+		return nil
+	})
+
+	// Expected calls after the space address preference change.
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.JujuManagementSpace: "space0",
+	}, nil)
+	sp1 := &network.SpaceInfo{
+		ID:                   "space0",
+		PreferredAddressType: network.IPv6Address,
+	}
+	s.networkService.EXPECT().GetControllerAPIAddresses(gomock.Any(), unit.Name("controller/1")).Return(addrs, nil)
+	s.networkService.EXPECT().SpaceByName(gomock.Any(), network.SpaceName("space0")).Return(sp1, nil)
+	args2 := controllernode.SetAPIAddressArgs{
+		MgmtSpace: sp1,
+		APIAddresses: map[string]network.SpaceHostPorts{
+			"1": hostPorts,
+		},
+	}
+	// Synchronization point to ensure the worker processes the preference
+	// event.
+	prefSync := make(chan struct{})
+	s.controllerNodeService.EXPECT().SetAPIAddresses(gomock.Any(), args2).DoAndReturn(func(context.Context, controllernode.SetAPIAddressArgs) error {
+		close(prefSync)
+		return nil
+	})
+
+	cfg := Config{
+		ControllerConfigService: s.controllerConfigService,
+		ApplicationService:      s.applicationService,
+		ControllerNodeService:   s.controllerNodeService,
+		NetworkService:          s.networkService,
+		APIPort:                 17070,
+		Logger:                  loggertesting.WrapCheckLog(c),
+	}
+	w, err := New(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	// Simulate a new controller node event.
+	select {
+	case nodeCh <- struct{}{}:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out sending controller node event")
+	}
+
+	// Wait for the worker to process the initial (new node) event.
+	select {
+	case <-sync:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for API address update")
+	}
+
+	// Now we can trigger the space address preference change, and sync on
+	// the second set api addresses call.
+	select {
+	case prefCh <- struct{}{}:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out sending space address preference change")
+	}
+
+	// Wait for the worker to process the space address preference event.
+	select {
+	case <-prefSync:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for API address update after space address preference change")
+	}
+
+	workertest.CleanKill(c, w)
+}
+
 // TestNodeAddressChange tests that when the controller node address changes,
 // the worker will update the api addresses for the controller.
 func (s *workerSuite) TestNodeAddressChange(c *tc.C) {
@@ -402,6 +523,7 @@ func (s *workerSuite) TestNodeAddressChange(c *tc.C) {
 	nodeCh := make(chan struct{})
 	nodeWatcher := watchertest.NewMockNotifyWatcher(nodeCh)
 	s.controllerNodeService.EXPECT().WatchControllerNodes(gomock.Any()).Return(nodeWatcher, nil)
+	s.networkService.EXPECT().WatchSpaceAddressPreferences(gomock.Any()).Return(watchertest.NewMockNotifyWatcher(make(chan struct{})), nil)
 
 	s.controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).Return(watchertest.NewMockStringsWatcher(make(chan []string)), nil)
 
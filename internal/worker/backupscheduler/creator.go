@@ -0,0 +1,440 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/objectstore"
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// dumpDir is the directory in the backup archive holding a SQL dump
+	// of each database, named after its namespace.
+	dumpDir = "juju-backup/dump"
+
+	// objectStoreDir is the directory in the backup archive holding the
+	// contents of the object store of each namespace.
+	objectStoreDir = "juju-backup/objectstore"
+
+	// metadataFile is the path in the backup archive of the metadata.
+	metadataFile = "juju-backup/metadata.json"
+
+	// timestampFormat is the format in which Dqlite stores timestamps.
+	timestampFormat = "2006-01-02 15:04:05.999999999-07:00"
+)
+
+// CreatorConfig holds the dependencies of the backup creator.
+type CreatorConfig struct {
+	// DBGetter provides the databases to back up.
+	DBGetter database.DBGetter
+
+	// ObjectStoreGetter provides the object store of each namespace.
+	ObjectStoreGetter objectstore.ObjectStoreGetter
+
+	// ControllerUUID, ControllerModelUUID and MachineID identify where
+	// the backups are taken, and are recorded in their metadata.
+	ControllerUUID      string
+	ControllerModelUUID string
+	MachineID           string
+
+	Clock clock.Clock
+}
+
+// NewBackupCreator returns the BackupCreator used by the controller agent.
+func NewBackupCreator(config CreatorConfig) BackupCreator {
+	return dqliteBackupCreator{config: config}
+}
+
+// dqliteBackupCreator creates backups of the controller's Dqlite databases
+// and object stores.
+//
+// Each database is dumped within a single read transaction, so the dump of
+// each database is consistent, in the text format of the sqlite3 .dump
+// command. The databases of different namespaces are dumped one after the
+// other, so changes made between dumps may be seen in one and not another.
+type dqliteBackupCreator struct {
+	config CreatorConfig
+}
+
+// Create is part of the BackupCreator interface. The archive is written to
+// a temporary file, which is removed when the returned reader is closed.
+func (c dqliteBackupCreator) Create(ctx context.Context) (_ io.ReadCloser, _ *corebackups.Metadata, err error) {
+	meta := corebackups.NewMetadata()
+	meta.Started = c.config.Clock.Now().UTC()
+	hostname, _ := os.Hostname()
+	meta.Origin = corebackups.Origin{
+		Model:    c.config.ControllerModelUUID,
+		Machine:  c.config.MachineID,
+		Hostname: hostname,
+		Version:  jujuversion.Current,
+	}
+	meta.Controller = corebackups.ControllerMetadata{
+		UUID:      c.config.ControllerUUID,
+		MachineID: c.config.MachineID,
+	}
+
+	file, err := os.CreateTemp("", "juju-backup-*.tar.gz")
+	if err != nil {
+		return nil, nil, errors.Errorf("creating backup file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	hasher := sha1.New()
+	gzw := gzip.NewWriter(io.MultiWriter(file, hasher))
+	tw := tar.NewWriter(gzw)
+
+	namespaces, err := c.namespaces(ctx)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	for _, namespace := range namespaces {
+		if err := c.backupNamespace(ctx, tw, namespace); err != nil {
+			return nil, nil, errors.Errorf("backing up %q: %w", namespace, err)
+		}
+	}
+
+	// The size and checksum of the archive can't be known until it has
+	// been written, so the metadata in the archive doesn't include them.
+	if err := c.writeMetadata(tw, meta); err != nil {
+		return nil, nil, errors.Errorf("writing metadata: %w", err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	if err := gzw.Close(); err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	if err := meta.MarkComplete(size, base64.StdEncoding.EncodeToString(hasher.Sum(nil))); err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	return &tempFile{File: file}, meta, nil
+}
+
+// namespaces returns the namespaces of the databases to back up: the
+// controller database followed by the database of each model.
+func (c dqliteBackupCreator) namespaces(ctx context.Context) ([]string, error) {
+	db, err := c.config.DBGetter.GetDB(ctx, database.ControllerNS)
+	if err != nil {
+		return nil, errors.Errorf("getting controller database: %w", err)
+	}
+	namespaces := []string{database.ControllerNS}
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT namespace FROM model_namespace ORDER BY namespace")
+		if err != nil {
+			return errors.Capture(err)
+		}
+		defer func() { _ = rows.Close() }()
+		for rows.Next() {
+			var namespace string
+			if err := rows.Scan(&namespace); err != nil {
+				return errors.Capture(err)
+			}
+			namespaces = append(namespaces, namespace)
+		}
+		return errors.Capture(rows.Err())
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing model databases: %w", err)
+	}
+	return namespaces, nil
+}
+
+// backupNamespace writes the dump of the namespace's database and the
+// contents of its object store to the archive.
+func (c dqliteBackupCreator) backupNamespace(ctx context.Context, tw *tar.Writer, namespace string) error {
+	db, err := c.config.DBGetter.GetDB(ctx, namespace)
+	if err != nil {
+		return errors.Errorf("getting database: %w", err)
+	}
+
+	// The size of the dump must be known before it is added to the archive,
+	// and a database dump can be large, so it is written to a temporary
+	// file rather than held in memory.
+	dump, err := os.CreateTemp("", "juju-backup-dump-*.sql")
+	if err != nil {
+		return errors.Errorf("creating dump file: %w", err)
+	}
+	defer func() {
+		_ = dump.Close()
+		_ = os.Remove(dump.Name())
+	}()
+
+	var (
+		size  int64
+		paths []string
+	)
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		// The transaction may be retried, so start afresh each time.
+		if err := dump.Truncate(0); err != nil {
+			return errors.Capture(err)
+		}
+		if _, err := dump.Seek(0, io.SeekStart); err != nil {
+			return errors.Capture(err)
+		}
+		w := bufio.NewWriter(dump)
+		if err := dumpDatabase(ctx, tx, w); err != nil {
+			return errors.Capture(err)
+		}
+		if err := w.Flush(); err != nil {
+			return errors.Capture(err)
+		}
+		if size, err = dump.Seek(0, io.SeekCurrent); err != nil {
+			return errors.Capture(err)
+		}
+		paths, err = objectStorePaths(ctx, tx)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return errors.Errorf("dumping database: %w", err)
+	}
+	if _, err := dump.Seek(0, io.SeekStart); err != nil {
+		return errors.Capture(err)
+	}
+	if err := c.writeFile(tw, path.Join(dumpDir, namespace+".sql"), size, dump); err != nil {
+		return errors.Capture(err)
+	}
+
+	store, err := c.config.ObjectStoreGetter.GetObjectStore(ctx, namespace)
+	if err != nil {
+		return errors.Errorf("getting object store: %w", err)
+	}
+	for _, p := range paths {
+		// Don't include earlier backups in each backup.
+		if namespace == database.ControllerNS && strings.HasPrefix(p, corebackups.ObjectStoreDir+"/") {
+			continue
+		}
+		if err := c.backupObject(ctx, tw, store, namespace, p); err != nil {
+			return errors.Errorf("backing up object %q: %w", p, err)
+		}
+	}
+	return nil
+}
+
+func (c dqliteBackupCreator) backupObject(
+	ctx context.Context, tw *tar.Writer, store objectstore.ObjectStore, namespace, p string,
+) error {
+	reader, digest, err := store.Get(ctx, p)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = reader.Close() }()
+	return c.writeFile(tw, path.Join(objectStoreDir, namespace, p), digest.Size, reader)
+}
+
+func (c dqliteBackupCreator) writeMetadata(tw *tar.Writer, meta *corebackups.Metadata) error {
+	r, err := meta.AsJSONBuffer()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		return errors.Capture(err)
+	}
+	return c.writeFile(tw, metadataFile, int64(buf.Len()), &buf)
+}
+
+func (c dqliteBackupCreator) writeFile(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0600,
+		ModTime:  c.config.Clock.Now(),
+	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if _, err := io.Copy(tw, r); err != nil {
+		return errors.Errorf("writing %q: %w", name, err)
+	}
+	return nil
+}
+
+// objectStorePaths returns the paths of the objects in the object store
+// whose metadata is held in the database.
+func objectStorePaths(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT path FROM object_store_metadata_path ORDER BY path")
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var paths []string
+	for rows.Next() {
+		var p string
+		if err := rows.Scan(&p); err != nil {
+			return nil, errors.Capture(err)
+		}
+		paths = append(paths, p)
+	}
+	return paths, errors.Capture(rows.Err())
+}
+
+// dumpDatabase writes the schema and contents of the database to w, in the
+// format of the sqlite3 .dump command, so that the database can be
+// recreated by executing the output.
+func dumpDatabase(ctx context.Context, tx *sql.Tx, w io.Writer) error {
+	type object struct {
+		kind, name, sql string
+	}
+	// Tables are created and filled before the indexes, triggers and views
+	// that depend on them, so that the triggers don't fire on load.
+	rows, err := tx.QueryContext(ctx, `
+SELECT type, name, sql FROM sqlite_master
+WHERE sql IS NOT NULL AND name NOT LIKE 'sqlite_%'
+ORDER BY CASE type WHEN 'table' THEN 0 ELSE 1 END, rowid`)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	var objects []object
+	for rows.Next() {
+		var o object
+		if err := rows.Scan(&o.kind, &o.name, &o.sql); err != nil {
+			_ = rows.Close()
+			return errors.Capture(err)
+		}
+		objects = append(objects, o)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return errors.Capture(err)
+	}
+
+	if _, err := io.WriteString(w, "PRAGMA foreign_keys=OFF;\nBEGIN TRANSACTION;\n"); err != nil {
+		return errors.Capture(err)
+	}
+	for _, o := range objects {
+		if _, err := fmt.Fprintf(w, "%s;\n", o.sql); err != nil {
+			return errors.Capture(err)
+		}
+		if o.kind != "table" {
+			continue
+		}
+		if err := dumpTable(ctx, tx, w, o.name); err != nil {
+			return errors.Errorf("dumping table %q: %w", o.name, err)
+		}
+	}
+	_, err = io.WriteString(w, "COMMIT;\n")
+	return errors.Capture(err)
+}
+
+// dumpTable writes an insert statement for each row of the table to w.
+func dumpTable(ctx context.Context, tx *sql.Tx, w io.Writer, table string) error {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT * FROM %s", quoteIdentifier(table)))
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	values := make([]any, len(cols))
+	for i := range values {
+		values[i] = new(any)
+	}
+	for rows.Next() {
+		if err := rows.Scan(values...); err != nil {
+			return errors.Capture(err)
+		}
+		literals := make([]string, len(values))
+		for i, v := range values {
+			if literals[i], err = sqlLiteral(*v.(*any)); err != nil {
+				return errors.Errorf("column %q: %w", cols[i], err)
+			}
+		}
+		_, err := fmt.Fprintf(w, "INSERT INTO %s VALUES(%s);\n", quoteIdentifier(table), strings.Join(literals, ","))
+		if err != nil {
+			return errors.Capture(err)
+		}
+	}
+	return errors.Capture(rows.Err())
+}
+
+// sqlLiteral returns the SQLite literal for a value read from a database.
+func sqlLiteral(v any) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "NULL", nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return "NULL", nil
+		}
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	case bool:
+		if v {
+			return "1", nil
+		}
+		return "0", nil
+	case string:
+		return quoteString(v), nil
+	case []byte:
+		return "X'" + hex.EncodeToString(v) + "'", nil
+	case time.Time:
+		return quoteString(v.Format(timestampFormat)), nil
+	default:
+		return "", errors.Errorf("unexpected value type %T", v)
+	}
+}
+
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// tempFile is a temporary file that is removed when it is closed.
+type tempFile struct {
+	*os.File
+}
+
+// Close closes and removes the file.
+func (f *tempFile) Close() error {
+	err := f.File.Close()
+	if removeErr := os.Remove(f.File.Name()); err == nil {
+		err = removeErr
+	}
+	return err
+}
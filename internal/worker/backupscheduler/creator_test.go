@@ -0,0 +1,237 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/base64"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	corebackups "github.com/juju/juju/core/backups"
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/objectstore"
+	jujuversion "github.com/juju/juju/core/version"
+	databasetesting "github.com/juju/juju/internal/database/testing"
+	"github.com/juju/juju/internal/errors"
+)
+
+type creatorSuite struct {
+	databasetesting.DqliteSuite
+}
+
+func TestCreatorSuite(t *testing.T) {
+	tc.Run(t, &creatorSuite{})
+}
+
+const (
+	modelNS = "model-ns"
+
+	controllerSchema = `
+CREATE TABLE model_namespace (namespace TEXT NOT NULL, model_uuid TEXT NOT NULL);
+CREATE TABLE object_store_metadata_path (path TEXT NOT NULL PRIMARY KEY);
+INSERT INTO model_namespace VALUES ('model-ns', 'model-uuid');
+INSERT INTO object_store_metadata_path VALUES ('tools/1'), ('backups/juju-backup-1.tar.gz');
+`
+
+	modelSchema = `
+CREATE TABLE object_store_metadata_path (path TEXT NOT NULL PRIMARY KEY);
+CREATE TABLE thing (
+    id INT NOT NULL PRIMARY KEY,
+    name TEXT,
+    data BLOB,
+    ratio REAL,
+    created_at DATETIME
+);
+CREATE INDEX idx_thing_name ON thing (name);
+CREATE TABLE thing_log (id INT NOT NULL);
+CREATE TRIGGER trg_thing_insert AFTER INSERT ON thing
+BEGIN
+    INSERT INTO thing_log VALUES (NEW.id);
+END;
+INSERT INTO object_store_metadata_path VALUES ('charms/mysql');
+INSERT INTO thing VALUES (1, 'it''s', X'00ff', 1.5, '2026-01-02 03:04:05+00:00');
+INSERT INTO thing VALUES (2, NULL, NULL, NULL, NULL);
+`
+)
+
+func (s *creatorSuite) TestCreate(c *tc.C) {
+	controllerDB, _ := s.OpenDBForNamespace(c, "controller", true)
+	modelDB, _ := s.OpenDBForNamespace(c, "model", true)
+	s.exec(c, controllerDB, controllerSchema)
+	s.exec(c, modelDB, modelSchema)
+
+	clock := testclock.NewClock(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	creator := NewBackupCreator(CreatorConfig{
+		DBGetter: fakeDBGetter{
+			coredatabase.ControllerNS: controllerDB,
+			modelNS:                   modelDB,
+		},
+		ObjectStoreGetter: fakeObjectStoreGetter{
+			coredatabase.ControllerNS: {"tools/1": "tools"},
+			modelNS:                   {"charms/mysql": "charm"},
+		},
+		ControllerUUID:      "controller-uuid",
+		ControllerModelUUID: "controller-model-uuid",
+		MachineID:           "0",
+		Clock:               clock,
+	})
+
+	archive, meta, err := creator.Create(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = archive.Close() }()
+
+	data, err := io.ReadAll(archive)
+	c.Assert(err, tc.ErrorIsNil)
+	sum := sha1.Sum(data)
+	c.Check(meta.Size(), tc.Equals, int64(len(data)))
+	c.Check(meta.Checksum(), tc.Equals, base64.StdEncoding.EncodeToString(sum[:]))
+	c.Check(meta.Started, tc.Equals, clock.Now())
+	c.Check(meta.Origin.Version, tc.Equals, jujuversion.Current)
+	c.Check(meta.Controller.UUID, tc.Equals, "controller-uuid")
+
+	files := s.untar(c, data)
+	archived, err := corebackups.NewMetadataJSONReader(bytes.NewBufferString(files["juju-backup/metadata.json"]))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(archived.Controller.UUID, tc.Equals, "controller-uuid")
+	c.Check(archived.Origin.Machine, tc.Equals, "0")
+
+	c.Check(files["juju-backup/objectstore/controller/tools/1"], tc.Equals, "tools")
+	c.Check(files["juju-backup/objectstore/model-ns/charms/mysql"], tc.Equals, "charm")
+
+	// Earlier backups are not included.
+	_, ok := files["juju-backup/objectstore/controller/backups/juju-backup-1.tar.gz"]
+	c.Check(ok, tc.IsFalse)
+
+	// The dump recreates the database, without firing the triggers on
+	// load.
+	dump, ok := files["juju-backup/dump/model-ns.sql"]
+	c.Assert(ok, tc.IsTrue)
+	c.Check(dump, tc.Contains, `INSERT INTO "thing" VALUES(1,'it''s',X'00ff',1.5,'2026-01-02 03:04:05+00:00');`)
+
+	// The dump has its own transaction, so it is loaded outside of one.
+	_, db := s.OpenDBForNamespace(c, "restored", false)
+	_, err = db.ExecContext(c.Context(), dump)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var (
+		name      string
+		blob      []byte
+		ratio     float64
+		createdAt time.Time
+		nulls     int
+		logged    int
+	)
+	row := db.QueryRow("SELECT name, data, ratio, created_at FROM thing WHERE id = 1")
+	c.Assert(row.Scan(&name, &blob, &ratio, &createdAt), tc.ErrorIsNil)
+	c.Check(name, tc.Equals, "it's")
+	c.Check(blob, tc.DeepEquals, []byte{0, 0xff})
+	c.Check(ratio, tc.Equals, 1.5)
+	c.Check(createdAt.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)), tc.IsTrue)
+
+	row = db.QueryRow("SELECT COUNT(*) FROM thing WHERE name IS NULL AND data IS NULL AND created_at IS NULL")
+	c.Assert(row.Scan(&nulls), tc.ErrorIsNil)
+	c.Check(nulls, tc.Equals, 1)
+
+	row = db.QueryRow("SELECT COUNT(*) FROM thing_log")
+	c.Assert(row.Scan(&logged), tc.ErrorIsNil)
+	c.Check(logged, tc.Equals, 2)
+}
+
+func (s *creatorSuite) TestCreateObjectStoreError(c *tc.C) {
+	controllerDB, _ := s.OpenDBForNamespace(c, "controller", true)
+	s.exec(c, controllerDB, controllerSchema)
+
+	creator := NewBackupCreator(CreatorConfig{
+		DBGetter: fakeDBGetter{
+			coredatabase.ControllerNS: controllerDB,
+		},
+		ObjectStoreGetter: fakeObjectStoreGetter{},
+		Clock:             testclock.NewClock(time.Now()),
+	})
+
+	_, _, err := creator.Create(c.Context())
+	c.Assert(err, tc.ErrorMatches, `backing up "controller": backing up object "tools/1": .*not found.*`)
+}
+
+func (s *creatorSuite) exec(c *tc.C, runner coredatabase.TxnRunner, stmts string) {
+	err := runner.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, stmts)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *creatorSuite) untar(c *tc.C, data []byte) map[string]string {
+	gzr, err := gzip.NewReader(bytes.NewReader(data))
+	c.Assert(err, tc.ErrorIsNil)
+	tr := tar.NewReader(gzr)
+	files := make(map[string]string)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, tc.ErrorIsNil)
+		content, err := io.ReadAll(tr)
+		c.Assert(err, tc.ErrorIsNil)
+		files[hdr.Name] = string(content)
+	}
+	return files
+}
+
+type fakeDBGetter map[string]coredatabase.TxnRunner
+
+func (f fakeDBGetter) GetDB(_ context.Context, namespace string) (coredatabase.TxnRunner, error) {
+	db, ok := f[namespace]
+	if !ok {
+		return nil, errors.Errorf("database %q not found", namespace)
+	}
+	return db, nil
+}
+
+type fakeObjectStoreGetter map[string]map[string]string
+
+func (f fakeObjectStoreGetter) GetObjectStore(_ context.Context, namespace string) (objectstore.ObjectStore, error) {
+	return fakeObjectStore(f[namespace]), nil
+}
+
+type fakeObjectStore map[string]string
+
+func (f fakeObjectStore) Get(_ context.Context, p string) (io.ReadCloser, objectstore.Digest, error) {
+	content, ok := f[p]
+	if !ok {
+		return nil, objectstore.Digest{}, errors.Errorf("object %q not found", p)
+	}
+	return io.NopCloser(bytes.NewBufferString(content)), objectstore.Digest{Size: int64(len(content))}, nil
+}
+
+func (f fakeObjectStore) GetBySHA256(context.Context, string) (io.ReadCloser, objectstore.Digest, error) {
+	return nil, objectstore.Digest{}, errors.New("not implemented")
+}
+
+func (f fakeObjectStore) GetBySHA256Prefix(context.Context, string) (io.ReadCloser, objectstore.Digest, error) {
+	return nil, objectstore.Digest{}, errors.New("not implemented")
+}
+
+func (f fakeObjectStore) Put(context.Context, string, io.Reader, int64) (objectstore.UUID, error) {
+	return "", errors.New("not implemented")
+}
+
+func (f fakeObjectStore) PutAndCheckHash(context.Context, string, io.Reader, int64, string) (objectstore.UUID, error) {
+	return "", errors.New("not implemented")
+}
+
+func (f fakeObjectStore) Remove(context.Context, string) error {
+	return errors.New("not implemented")
+}
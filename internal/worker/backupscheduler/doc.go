@@ -0,0 +1,27 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package backupscheduler provides a worker that takes backups of the
+// controller on a schedule and keeps a bounded number of them in the
+// controller object store.
+//
+// The schedule and the number of backups kept are read from the
+// controller.BackupSchedule and controller.BackupRetention controller config
+// keys, and are updated whenever either key changes. A zero schedule disables
+// scheduled backups. Each backup is due one schedule period after the last
+// backup in the index started, so restarting the worker, or moving it to
+// another controller, doesn't postpone it. A backup that is already overdue
+// is taken as soon as the worker starts.
+//
+// Each attempt, successful or not, is recorded as an entry in the index at
+// backups.IndexPath in the controller object store. The index is what
+// `juju backups` reads to report the status of scheduled backups, and the
+// archives of successful backups can be fetched with `juju download-backup`.
+// When the number of entries exceeds the retention, the oldest entries are
+// dropped and their archives removed.
+//
+// See github.com/juju/juju/core/backups for the archive and index formats,
+// and github.com/juju/juju/internal/backups for how the index is stored.
+//
+// The worker is intended to be run on the primary controller only.
+package backupscheduler
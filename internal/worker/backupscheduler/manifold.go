@@ -0,0 +1,142 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/services"
+)
+
+// ManifoldConfig describes the resources used by the backup scheduler worker.
+type ManifoldConfig struct {
+	AgentName          string
+	DBAccessorName     string
+	DomainServicesName string
+	ObjectStoreName    string
+
+	// GetControllerConfigService is used to extract the controller config
+	// service from the domain services dependency.
+	GetControllerConfigService func(getter dependency.Getter, name string) (ControllerConfigService, error)
+
+	NewBackupCreator func(CreatorConfig) BackupCreator
+	NewWorker        func(Config) (worker.Worker, error)
+	Clock            clock.Clock
+	Logger           logger.Logger
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.AgentName == "" {
+		return errors.NotValidf("empty AgentName")
+	}
+	if config.DBAccessorName == "" {
+		return errors.NotValidf("empty DBAccessorName")
+	}
+	if config.DomainServicesName == "" {
+		return errors.NotValidf("empty DomainServicesName")
+	}
+	if config.ObjectStoreName == "" {
+		return errors.NotValidf("empty ObjectStoreName")
+	}
+	if config.GetControllerConfigService == nil {
+		return errors.NotValidf("nil GetControllerConfigService")
+	}
+	if config.NewBackupCreator == nil {
+		return errors.NotValidf("nil NewBackupCreator")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// start starts the backup scheduler worker.
+func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var a agent.Agent
+	if err := getter.Get(config.AgentName, &a); err != nil {
+		return nil, errors.Trace(err)
+	}
+	agentConfig := a.CurrentConfig()
+
+	var dbGetter database.DBGetter
+	if err := getter.Get(config.DBAccessorName, &dbGetter); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	controllerConfigService, err := config.GetControllerConfigService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var objectStoreGetter objectstore.ObjectStoreGetter
+	if err := getter.Get(config.ObjectStoreName, &objectStoreGetter); err != nil {
+		return nil, errors.Trace(err)
+	}
+	objectStore, err := objectStoreGetter.GetObjectStore(ctx, database.ControllerNS)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := config.NewWorker(Config{
+		ControllerConfigService: controllerConfigService,
+		ObjectStore:             objectStore,
+		BackupCreator: config.NewBackupCreator(CreatorConfig{
+			DBGetter:            dbGetter,
+			ObjectStoreGetter:   objectStoreGetter,
+			ControllerUUID:      agentConfig.Controller().Id(),
+			ControllerModelUUID: agentConfig.Model().Id(),
+			MachineID:           agentConfig.Tag().Id(),
+			Clock:               config.Clock,
+		}),
+		Clock:  config.Clock,
+		Logger: config.Logger,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Manifold returns a Manifold that encapsulates the backup scheduler worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.AgentName,
+			config.DBAccessorName,
+			config.DomainServicesName,
+			config.ObjectStoreName,
+		},
+		Start: config.start,
+	}
+}
+
+// GetControllerConfigService extracts the controller config service from the
+// controller domain services dependency.
+func GetControllerConfigService(getter dependency.Getter, name string) (ControllerConfigService, error) {
+	var services services.ControllerDomainServices
+	if err := getter.Get(name, &services); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return services.ControllerConfig(), nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type manifoldSuite struct{}
+
+func TestManifoldSuite(t *testing.T) {
+	tc.Run(t, &manifoldSuite{})
+}
+
+func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
+	cfg := s.getConfig(c)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	cfg = s.getConfig(c)
+	cfg.AgentName = ""
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.DBAccessorName = ""
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.DomainServicesName = ""
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.ObjectStoreName = ""
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.GetControllerConfigService = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.NewBackupCreator = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.NewWorker = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.Clock = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.Logger = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+}
+
+func (s *manifoldSuite) getConfig(c *tc.C) ManifoldConfig {
+	return ManifoldConfig{
+		AgentName:          "agent",
+		DBAccessorName:     "db-accessor",
+		DomainServicesName: "domain-services",
+		ObjectStoreName:    "object-store",
+		GetControllerConfigService: func(dependency.Getter, string) (ControllerConfigService, error) {
+			return nil, nil
+		},
+		NewBackupCreator: NewBackupCreator,
+		NewWorker: func(Config) (worker.Worker, error) {
+			return nil, nil
+		},
+		Clock:  testclock.NewClock(time.Now()),
+		Logger: loggertesting.WrapCheckLog(c),
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package backupscheduler -destination watcher_mock_test.go github.com/juju/juju/core/watcher StringsWatcher
+//go:generate go run go.uber.org/mock/mockgen -typed -package backupscheduler -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStore
+//go:generate go run go.uber.org/mock/mockgen -typed -package backupscheduler -destination services_mock_test.go github.com/juju/juju/internal/worker/backupscheduler ControllerConfigService,BackupCreator
@@ -0,0 +1,185 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/backupscheduler (interfaces: ControllerConfigService,BackupCreator)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package backupscheduler -destination services_mock_test.go github.com/juju/juju/internal/worker/backupscheduler ControllerConfigService,BackupCreator
+//
+
+// Package backupscheduler is a generated GoMock package.
+package backupscheduler
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	controller "github.com/juju/juju/controller"
+	backups "github.com/juju/juju/core/backups"
+	watcher "github.com/juju/juju/core/watcher"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock *MockControllerConfigService
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(arg0 context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig", arg0)
+	ret0, _ := ret[0].(controller.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(arg0 any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).ControllerConfig), arg0)
+	return &MockControllerConfigServiceControllerConfigCall{Call: call}
+}
+
+// MockControllerConfigServiceControllerConfigCall wrap *gomock.Call
+type MockControllerConfigServiceControllerConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerConfigServiceControllerConfigCall) Return(arg0 controller.Config, arg1 error) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerConfigServiceControllerConfigCall) Do(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerConfigServiceControllerConfigCall) DoAndReturn(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchControllerConfig mocks base method.
+func (m *MockControllerConfigService) WatchControllerConfig(arg0 context.Context) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchControllerConfig", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchControllerConfig indicates an expected call of WatchControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) WatchControllerConfig(arg0 any) *MockControllerConfigServiceWatchControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).WatchControllerConfig), arg0)
+	return &MockControllerConfigServiceWatchControllerConfigCall{Call: call}
+}
+
+// MockControllerConfigServiceWatchControllerConfigCall wrap *gomock.Call
+type MockControllerConfigServiceWatchControllerConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerConfigServiceWatchControllerConfigCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockControllerConfigServiceWatchControllerConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerConfigServiceWatchControllerConfigCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockControllerConfigServiceWatchControllerConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerConfigServiceWatchControllerConfigCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockControllerConfigServiceWatchControllerConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockBackupCreator is a mock of BackupCreator interface.
+type MockBackupCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupCreatorMockRecorder
+}
+
+// MockBackupCreatorMockRecorder is the mock recorder for MockBackupCreator.
+type MockBackupCreatorMockRecorder struct {
+	mock *MockBackupCreator
+}
+
+// NewMockBackupCreator creates a new mock instance.
+func NewMockBackupCreator(ctrl *gomock.Controller) *MockBackupCreator {
+	mock := &MockBackupCreator{ctrl: ctrl}
+	mock.recorder = &MockBackupCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupCreator) EXPECT() *MockBackupCreatorMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBackupCreator) Create(arg0 context.Context) (io.ReadCloser, *backups.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(*backups.Metadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBackupCreatorMockRecorder) Create(arg0 any) *MockBackupCreatorCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBackupCreator)(nil).Create), arg0)
+	return &MockBackupCreatorCreateCall{Call: call}
+}
+
+// MockBackupCreatorCreateCall wrap *gomock.Call
+type MockBackupCreatorCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackupCreatorCreateCall) Return(arg0 io.ReadCloser, arg1 *backups.Metadata, arg2 error) *MockBackupCreatorCreateCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackupCreatorCreateCall) Do(f func(context.Context) (io.ReadCloser, *backups.Metadata, error)) *MockBackupCreatorCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackupCreatorCreateCall) DoAndReturn(f func(context.Context) (io.ReadCloser, *backups.Metadata, error)) *MockBackupCreatorCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
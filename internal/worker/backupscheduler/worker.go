@@ -0,0 +1,322 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/collections/set"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	"github.com/juju/juju/controller"
+	corebackups "github.com/juju/juju/core/backups"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/backups"
+	"github.com/juju/juju/internal/errors"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+// ControllerConfigService is an interface that provides access to the
+// controller configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller config.
+	ControllerConfig(context.Context) (controller.Config, error)
+	// WatchControllerConfig returns a watcher that returns keys for any
+	// changes to controller config.
+	WatchControllerConfig(context.Context) (watcher.StringsWatcher, error)
+}
+
+// BackupCreator creates backup archives of the controller.
+type BackupCreator interface {
+	// Create creates a new backup archive, returning a reader for the
+	// compressed archive and its metadata. The caller is responsible for
+	// closing the reader.
+	Create(context.Context) (io.ReadCloser, *corebackups.Metadata, error)
+}
+
+// Config is the configuration for the backup scheduler.
+type Config struct {
+	ControllerConfigService ControllerConfigService
+	ObjectStore             objectstore.ObjectStore
+	BackupCreator           BackupCreator
+	Clock                   clock.Clock
+	Logger                  logger.Logger
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.ControllerConfigService == nil {
+		return errors.Errorf("nil ControllerConfigService").Add(coreerrors.NotValid)
+	}
+	if config.ObjectStore == nil {
+		return errors.Errorf("nil ObjectStore").Add(coreerrors.NotValid)
+	}
+	if config.BackupCreator == nil {
+		return errors.Errorf("nil BackupCreator").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil clock.Clock").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// schedulerWorker is a worker that takes backups of the controller on a
+// schedule and prunes old ones.
+type schedulerWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	schedule   time.Duration
+	retention  int
+	lastBackup corebackups.IndexEntry
+}
+
+// NewWorker returns a new backup scheduler worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &schedulerWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "backup-scheduler",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *schedulerWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *schedulerWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *schedulerWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	report := map[string]any{
+		"schedule":  w.schedule.String(),
+		"retention": w.retention,
+	}
+	if w.lastBackup.Filename != "" {
+		report["last-backup"] = map[string]any{
+			"filename": w.lastBackup.Filename,
+			"started":  w.lastBackup.Started,
+			"status":   string(w.lastBackup.Status),
+		}
+	}
+	return report
+}
+
+// loop is the worker's main loop.
+//   - It watches for changes to the controller configuration to get
+//     up-to-date values for the backup schedule and retention.
+//   - It takes a backup each time the schedule elapses, measured from the
+//     start of the last backup recorded in the index, so that restarting
+//     the worker doesn't postpone the next backup.
+func (w *schedulerWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	watch, err := w.config.ControllerConfigService.WatchControllerConfig(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := w.catacomb.Add(watch); err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := w.updateConfig(ctx); err != nil {
+		return errors.Capture(err)
+	}
+
+	index, err := w.readIndex(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if n := len(index.Entries); n > 0 {
+		w.mu.Lock()
+		w.lastBackup = index.Entries[n-1]
+		w.mu.Unlock()
+	}
+
+	timer := w.nextBackup()
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+
+		case keys, ok := <-watch.Changes():
+			if !ok {
+				return errors.New("controller config watcher closed")
+			}
+			changes := set.NewStrings(keys...)
+			if !changes.Contains(controller.BackupSchedule) &&
+				!changes.Contains(controller.BackupRetention) {
+				continue
+			}
+
+			oldSchedule, _ := w.getConfig()
+			if err := w.updateConfig(ctx); err != nil {
+				return errors.Capture(err)
+			}
+			schedule, _ := w.getConfig()
+			if schedule == oldSchedule {
+				continue
+			}
+			timer = w.nextBackup()
+
+		case <-timer:
+			if err := w.backup(ctx); err != nil {
+				return errors.Capture(err)
+			}
+			timer = w.nextBackup()
+		}
+	}
+}
+
+// nextBackup returns a channel that receives when the next backup is due,
+// one schedule period after the last backup started, or straight away if
+// that time has already passed. A nil channel blocks forever, which is what
+// is returned when scheduled backups are disabled.
+func (w *schedulerWorker) nextBackup() <-chan time.Time {
+	w.mu.Lock()
+	schedule := w.schedule
+	lastStarted := w.lastBackup.Started
+	w.mu.Unlock()
+
+	if schedule <= 0 {
+		return nil
+	}
+	if lastStarted.IsZero() {
+		return w.config.Clock.After(schedule)
+	}
+	delay := lastStarted.Add(schedule).Sub(w.config.Clock.Now())
+	if delay < 0 {
+		delay = 0
+	}
+	return w.config.Clock.After(delay)
+}
+
+// backup takes a single backup and records the outcome in the index.
+// Failure to create or store the archive is recorded in the index rather
+// than returned; only failure to update the index is returned.
+func (w *schedulerWorker) backup(ctx context.Context) error {
+	started := w.config.Clock.Now().UTC()
+	entry := corebackups.IndexEntry{
+		Filename: started.Format(corebackups.FilenameTemplate),
+		Started:  started,
+	}
+
+	meta, err := w.createAndStore(ctx, entry.Filename)
+	if err != nil {
+		w.config.Logger.Errorf(ctx, "scheduled backup %q failed: %v", entry.Filename, err)
+		entry.Status = corebackups.StatusFailed
+		entry.Message = err.Error()
+	} else {
+		w.config.Logger.Infof(ctx, "scheduled backup %q stored", entry.Filename)
+		entry.Status = corebackups.StatusSucceeded
+		entry.Size = meta.Size()
+		entry.Checksum = meta.Checksum()
+	}
+	entry.Finished = w.config.Clock.Now().UTC()
+
+	_, retention := w.getConfig()
+	if err := w.updateIndex(ctx, entry, retention); err != nil {
+		return errors.Errorf("updating backups index: %w", err)
+	}
+
+	w.mu.Lock()
+	w.lastBackup = entry
+	w.mu.Unlock()
+	return nil
+}
+
+// createAndStore creates a backup archive and puts it in the object store.
+func (w *schedulerWorker) createAndStore(ctx context.Context, filename string) (*corebackups.Metadata, error) {
+	archive, meta, err := w.config.BackupCreator.Create(ctx)
+	if err != nil {
+		return nil, errors.Errorf("creating backup: %w", err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	path := corebackups.ObjectStorePath(filename)
+	if _, err := w.config.ObjectStore.Put(ctx, path, archive, meta.Size()); err != nil {
+		return nil, errors.Errorf("storing backup: %w", err)
+	}
+	return meta, nil
+}
+
+// updateIndex adds the entry to the index, removes the archives of any
+// entries dropped by the retention policy and writes the index back.
+func (w *schedulerWorker) updateIndex(ctx context.Context, entry corebackups.IndexEntry, retention int) error {
+	index, err := w.readIndex(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	dropped := index.Add(entry, retention)
+	for _, old := range dropped {
+		if old.Status != corebackups.StatusSucceeded {
+			continue
+		}
+		err := w.config.ObjectStore.Remove(ctx, corebackups.ObjectStorePath(old.Filename))
+		if err != nil && !errors.Is(err, objectstoreerrors.ObjectNotFound) {
+			return errors.Errorf("pruning backup %q: %w", old.Filename, err)
+		}
+		w.config.Logger.Debugf(ctx, "pruned scheduled backup %q", old.Filename)
+	}
+
+	return backups.WriteIndex(ctx, w.config.ObjectStore, index)
+}
+
+// readIndex reads the index from the object store, returning an empty index
+// if none has been written yet.
+func (w *schedulerWorker) readIndex(ctx context.Context) (corebackups.Index, error) {
+	return backups.ReadIndex(ctx, w.config.ObjectStore)
+}
+
+// getConfig returns the current schedule and retention. The returned values
+// are guarded by w.mu to avoid races with Report.
+func (w *schedulerWorker) getConfig() (time.Duration, int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.schedule, w.retention
+}
+
+// updateConfig reads the schedule and retention from controller config.
+func (w *schedulerWorker) updateConfig(ctx context.Context) error {
+	cfg, err := w.config.ControllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return errors.Errorf("getting controller config: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.schedule = cfg.BackupSchedule()
+	w.retention = cfg.BackupRetention()
+	w.config.Logger.Debugf(ctx, "config updated: schedule=%v, retention=%v", w.schedule, w.retention)
+	return nil
+}
@@ -0,0 +1,382 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/controller"
+	corebackups "github.com/juju/juju/core/backups"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	coretesting "github.com/juju/juju/core/testing"
+	corewatcher "github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+func TestConfigSuite(t *testing.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		ControllerConfigService: NewMockControllerConfigService(ctrl),
+		ObjectStore:             NewMockObjectStore(ctrl),
+		BackupCreator:           NewMockBackupCreator(ctrl),
+		Clock:                   testclock.NewClock(time.Now()),
+		Logger:                  loggertesting.WrapCheckLog(c),
+	}
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.ControllerConfigService = nil
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	testCfg = origCfg
+	testCfg.ObjectStore = nil
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	testCfg = origCfg
+	testCfg.BackupCreator = nil
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	testCfg = origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+}
+
+type workerSuite struct {
+	ctrl                    *gomock.Controller
+	clock                   *testclock.Clock
+	controllerConfigService *MockControllerConfigService
+	objectStore             *MockObjectStore
+	backupCreator           *MockBackupCreator
+	configChanges           chan []string
+}
+
+func (s *workerSuite) TestDisabledScheduleTakesNoBackups(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectReadIndex(nil)
+
+	w := s.startWorker(c, "0s", 7)
+	defer workertest.CleanKill(c, w)
+
+	// No timer is started when the schedule is disabled.
+	err := s.clock.WaitAdvance(time.Hour, coretesting.ShortWait, 0)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *workerSuite) TestScheduledBackupStored(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	meta := s.expectCreate(c, "archive")
+
+	stored := make(chan []byte, 1)
+	s.objectStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), int64(7)).DoAndReturn(
+		func(_ context.Context, path string, r io.Reader, _ int64) (objectstore.UUID, error) {
+			c.Check(path, tc.Matches, `backups/juju-backup-.*\.tar\.gz`)
+			return "", nil
+		})
+	// The index is read when the worker starts and again when the backup
+	// is recorded.
+	s.expectReadIndex(nil)
+	s.expectReadIndex(nil)
+	s.expectWriteIndex(c, stored)
+
+	w := s.startWorker(c, "1h", 7)
+	defer workertest.CleanKill(c, w)
+
+	err := s.clock.WaitAdvance(time.Hour, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	index := s.waitForIndex(c, stored)
+	c.Assert(index.Entries, tc.HasLen, 1)
+	c.Check(index.Entries[0].Status, tc.Equals, corebackups.StatusSucceeded)
+	c.Check(index.Entries[0].Size, tc.Equals, meta.Size())
+	c.Check(index.Entries[0].Checksum, tc.Equals, meta.Checksum())
+}
+
+func (s *workerSuite) TestScheduledBackupPrunesOldest(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	existing := corebackups.Index{
+		Entries: []corebackups.IndexEntry{{
+			Filename: "old-0.tar.gz",
+			Started:  s.clock.Now().Add(-90 * time.Minute),
+			Status:   corebackups.StatusSucceeded,
+		}, {
+			Filename: "old-1.tar.gz",
+			Started:  s.clock.Now().Add(-30 * time.Minute),
+			Status:   corebackups.StatusFailed,
+		}},
+	}
+	data, err := existing.Bytes()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.expectReadIndex(data)
+	s.expectCreate(c, "archive")
+	s.objectStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), int64(7)).Return("", nil)
+	s.expectReadIndex(data)
+
+	// Only the succeeded backup had an archive to remove.
+	s.objectStore.EXPECT().Remove(gomock.Any(), "backups/old-0.tar.gz").Return(nil)
+
+	stored := make(chan []byte, 1)
+	s.expectWriteIndex(c, stored)
+
+	w := s.startWorker(c, "1h", 1)
+	defer workertest.CleanKill(c, w)
+
+	// The next backup is due an hour after the last one started.
+	err = s.clock.WaitAdvance(30*time.Minute, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	index := s.waitForIndex(c, stored)
+	c.Assert(index.Entries, tc.HasLen, 1)
+	c.Check(index.Entries[0].Status, tc.Equals, corebackups.StatusSucceeded)
+}
+
+func (s *workerSuite) TestScheduledBackupFailureRecorded(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.backupCreator.EXPECT().Create(gomock.Any()).Return(nil, nil, errors.New("boom"))
+	s.expectReadIndex(nil)
+	s.expectReadIndex(nil)
+
+	stored := make(chan []byte, 1)
+	s.expectWriteIndex(c, stored)
+
+	w := s.startWorker(c, "1h", 7)
+	defer workertest.CleanKill(c, w)
+
+	err := s.clock.WaitAdvance(time.Hour, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	index := s.waitForIndex(c, stored)
+	c.Assert(index.Entries, tc.HasLen, 1)
+	c.Check(index.Entries[0].Status, tc.Equals, corebackups.StatusFailed)
+	c.Check(index.Entries[0].Message, tc.Equals, "creating backup: boom")
+}
+
+func (s *workerSuite) TestScheduleChangeRestartsTimer(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectReadIndex(nil)
+
+	w := s.startWorker(c, "0s", 7)
+	defer workertest.CleanKill(c, w)
+
+	s.expectControllerConfig("2h", 7)
+	s.configChanges <- []string{controller.BackupSchedule}
+
+	s.backupCreator.EXPECT().Create(gomock.Any()).Return(nil, nil, errors.New("boom"))
+	s.expectReadIndex(nil)
+	stored := make(chan []byte, 1)
+	s.expectWriteIndex(c, stored)
+
+	err := s.clock.WaitAdvance(2*time.Hour, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	s.waitForIndex(c, stored)
+}
+
+func (s *workerSuite) TestOverdueBackupTakenOnStart(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The last backup started more than a schedule period ago, for instance
+	// because the controller was down when it was next due.
+	existing := corebackups.Index{
+		Entries: []corebackups.IndexEntry{{
+			Filename: "old-0.tar.gz",
+			Started:  s.clock.Now().Add(-2 * time.Hour),
+			Status:   corebackups.StatusSucceeded,
+		}},
+	}
+	data, err := existing.Bytes()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.expectReadIndex(data)
+	s.backupCreator.EXPECT().Create(gomock.Any()).Return(nil, nil, errors.New("boom"))
+	s.expectReadIndex(data)
+	stored := make(chan []byte, 1)
+	s.expectWriteIndex(c, stored)
+
+	w := s.startWorker(c, "1h", 7)
+	defer workertest.CleanKill(c, w)
+
+	// The backup is taken without waiting for the schedule to elapse.
+	index := s.waitForIndex(c, stored)
+	c.Assert(index.Entries, tc.HasLen, 2)
+	c.Check(index.Entries[1].Status, tc.Equals, corebackups.StatusFailed)
+}
+
+func (s *workerSuite) TestRestartDoesNotPostponeBackup(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	existing := corebackups.Index{
+		Entries: []corebackups.IndexEntry{{
+			Filename: "old-0.tar.gz",
+			Started:  s.clock.Now().Add(-40 * time.Minute),
+			Status:   corebackups.StatusSucceeded,
+		}},
+	}
+	data, err := existing.Bytes()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.expectReadIndex(data)
+	s.backupCreator.EXPECT().Create(gomock.Any()).Return(nil, nil, errors.New("boom"))
+	s.expectReadIndex(data)
+	stored := make(chan []byte, 1)
+	s.expectWriteIndex(c, stored)
+
+	w := s.startWorker(c, "1h", 7)
+	defer workertest.CleanKill(c, w)
+
+	// The backup is due an hour after the last one started, not an hour
+	// after the worker started.
+	err = s.clock.WaitAdvance(20*time.Minute, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	s.waitForIndex(c, stored)
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.ctrl = ctrl
+	s.clock = testclock.NewClock(time.Now())
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.objectStore = NewMockObjectStore(ctrl)
+	s.backupCreator = NewMockBackupCreator(ctrl)
+	s.configChanges = make(chan []string)
+
+	c.Cleanup(func() {
+		s.ctrl = nil
+		s.clock = nil
+		s.controllerConfigService = nil
+		s.objectStore = nil
+		s.backupCreator = nil
+		s.configChanges = nil
+	})
+
+	return ctrl
+}
+
+func (s *workerSuite) startWorker(c *tc.C, schedule string, retention int) worker.Worker {
+	loopEntered := make(chan struct{}, 1)
+	watcher := NewMockStringsWatcher(s.ctrl)
+	watcher.EXPECT().Changes().DoAndReturn(func() corewatcher.StringsChannel {
+		select {
+		case loopEntered <- struct{}{}:
+		default:
+		}
+		return s.configChanges
+	}).AnyTimes()
+	watcher.EXPECT().Kill().AnyTimes()
+	watcher.EXPECT().Wait().AnyTimes()
+	s.controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).Return(watcher, nil)
+	s.expectControllerConfig(schedule, retention)
+
+	w, err := NewWorker(Config{
+		ControllerConfigService: s.controllerConfigService,
+		ObjectStore:             s.objectStore,
+		BackupCreator:           s.backupCreator,
+		Clock:                   s.clock,
+		Logger:                  loggertesting.WrapCheckLog(c),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Wait for the worker to reach its main loop, having read the index,
+	// before tests manipulate the clock.
+	select {
+	case <-loopEntered:
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for worker to enter main loop")
+	}
+	return w
+}
+
+func (s *workerSuite) expectControllerConfig(schedule string, retention int) {
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.BackupSchedule:  schedule,
+		controller.BackupRetention: retention,
+	}, nil)
+}
+
+func (s *workerSuite) expectCreate(c *tc.C, content string) *corebackups.Metadata {
+	meta := corebackups.NewMetadata()
+	err := meta.MarkComplete(7, "checksum")
+	c.Assert(err, tc.ErrorIsNil)
+	s.backupCreator.EXPECT().Create(gomock.Any()).Return(io.NopCloser(bytes.NewBufferString(content)), meta, nil)
+	return meta
+}
+
+func (s *workerSuite) expectReadIndex(data []byte) {
+	s.objectStore.EXPECT().Get(gomock.Any(), corebackups.IndexNextPath).Return(nil, objectstore.Digest{}, objectstoreerrors.ObjectNotFound)
+	if data == nil {
+		s.objectStore.EXPECT().Get(gomock.Any(), corebackups.IndexPath).Return(nil, objectstore.Digest{}, objectstoreerrors.ObjectNotFound)
+		return
+	}
+	s.objectStore.EXPECT().Get(gomock.Any(), corebackups.IndexPath).Return(io.NopCloser(bytes.NewReader(data)), objectstore.Digest{}, nil)
+}
+
+func (s *workerSuite) expectWriteIndex(c *tc.C, stored chan<- []byte) {
+	// The new index is written alongside the old one before replacing it.
+	var next []byte
+	gomock.InOrder(
+		s.objectStore.EXPECT().Get(gomock.Any(), corebackups.IndexNextPath).Return(nil, objectstore.Digest{}, objectstoreerrors.ObjectNotFound),
+		s.objectStore.EXPECT().Put(gomock.Any(), corebackups.IndexNextPath, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, r io.Reader, size int64) (objectstore.UUID, error) {
+				data, err := io.ReadAll(r)
+				c.Check(err, tc.ErrorIsNil)
+				c.Check(int64(len(data)), tc.Equals, size)
+				next = data
+				return "", nil
+			}),
+		s.objectStore.EXPECT().Remove(gomock.Any(), corebackups.IndexPath).Return(objectstoreerrors.ObjectNotFound),
+		s.objectStore.EXPECT().Put(gomock.Any(), corebackups.IndexPath, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, r io.Reader, size int64) (objectstore.UUID, error) {
+				data, err := io.ReadAll(r)
+				c.Check(err, tc.ErrorIsNil)
+				c.Check(data, tc.DeepEquals, next)
+				return "", nil
+			}),
+		s.objectStore.EXPECT().Remove(gomock.Any(), corebackups.IndexNextPath).DoAndReturn(
+			func(context.Context, string) error {
+				stored <- next
+				return nil
+			}),
+	)
+}
+
+func (s *workerSuite) waitForIndex(c *tc.C, stored <-chan []byte) corebackups.Index {
+	select {
+	case data := <-stored:
+		index, err := corebackups.ReadIndex(bytes.NewReader(data))
+		c.Assert(err, tc.ErrorIsNil)
+		return index
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for backups index to be written")
+	}
+	return corebackups.Index{}
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package bundledrift provides a worker that periodically compares a model
+// with its desired bundle and records any differences between them.
+//
+// # Overview
+//
+// Operators attach a desired bundle to a model with set-desired-bundle. On
+// each tick of the check interval, the worker builds a representation of
+// the model from the domain services and compares it with the desired
+// bundle using the same comparison as diff-bundle. The differences are
+// recorded against the desired bundle, and a model that has drifted from
+// its desired bundle says so in its status. A warning is logged whenever
+// the model starts to differ from the bundle, or the differences change.
+//
+// Only the applications and relations of the model are compared. Machines
+// and placement directives are ignored, as is the charm revision, channel
+// and base of an application that does not specify them in the bundle, so
+// that refreshing a charm that is not pinned is not reported as drift.
+//
+// # Integration
+//
+// The worker is intended to be run by the Juju controller, for each model.
+package bundledrift
@@ -0,0 +1,235 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundledrift
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/juju/collections/set"
+
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/network"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/deployment/charm"
+	statusservice "github.com/juju/juju/domain/status/service"
+	bundlechanges "github.com/juju/juju/internal/bundle/changes"
+	"github.com/juju/juju/internal/errors"
+)
+
+// buildModel returns a representation of the applications and relations of
+// the model, in the same form as diff-bundle builds from the model status.
+func (w *driftWorker) buildModel(ctx context.Context) (*bundlechanges.Model, error) {
+	apps, err := w.config.StatusService.GetApplicationAndUnitStatuses(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting applications: %w", err)
+	}
+	spaces, err := w.config.NetworkService.GetAllSpaces(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting spaces: %w", err)
+	}
+
+	model := &bundlechanges.Model{
+		Applications:     make(map[string]*bundlechanges.Application, len(apps)),
+		ConstraintsEqual: constraintsEqual,
+	}
+	for name, app := range apps {
+		modelApp, err := w.buildApplication(ctx, name, app, spaces)
+		if err != nil {
+			return nil, errors.Errorf("application %q: %w", name, err)
+		}
+		model.Applications[name] = modelApp
+	}
+
+	relations, err := w.config.RelationService.GetAllRelationDetails(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting relations: %w", err)
+	}
+	for _, rel := range relations {
+		// All relations have two endpoints except peers.
+		if len(rel.Endpoints) != 2 {
+			continue
+		}
+		model.Relations = append(model.Relations, bundlechanges.Relation{
+			App1:      rel.Endpoints[0].ApplicationName,
+			Endpoint1: rel.Endpoints[0].Name,
+			App2:      rel.Endpoints[1].ApplicationName,
+			Endpoint2: rel.Endpoints[1].Name,
+		})
+	}
+	return model, nil
+}
+
+func (w *driftWorker) buildApplication(
+	ctx context.Context, name string, app statusservice.Application, spaces network.SpaceInfos,
+) (*bundlechanges.Application, error) {
+	modelApp := &bundlechanges.Application{
+		Name:     name,
+		Charm:    charmAlias(app.CharmLocator),
+		Exposed:  app.Exposed,
+		Base:     platformBase(app.Platform),
+		Channel:  channelString(app.Channel),
+		Revision: app.CharmLocator.Revision,
+	}
+	if app.Scale != nil {
+		modelApp.Scale = *app.Scale
+	}
+	principals := set.NewStrings()
+	for unitName, unit := range app.Units {
+		modelApp.Units = append(modelApp.Units, bundlechanges.Unit{Name: unitName.String()})
+		if unit.PrincipalName != nil {
+			principals.Add(unit.PrincipalName.Application())
+		}
+	}
+	if app.Subordinate {
+		modelApp.SubordinateTo = principals.SortedValues()
+	}
+
+	appUUID, err := w.config.ApplicationService.GetApplicationUUIDByName(ctx, name)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	cfg, err := w.config.ApplicationService.GetApplicationAndCharmConfig(ctx, appUUID)
+	if err != nil {
+		return nil, errors.Errorf("getting config: %w", err)
+	}
+	modelApp.Options = make(map[string]any, len(cfg.ApplicationConfig))
+	for key, value := range cfg.ApplicationConfig {
+		if value != nil {
+			modelApp.Options[key] = value
+		}
+	}
+
+	if !app.Subordinate {
+		cons, err := w.config.ApplicationService.GetApplicationConstraints(ctx, appUUID)
+		if err != nil {
+			return nil, errors.Errorf("getting constraints: %w", err)
+		}
+		modelApp.Constraints = cons.String()
+	}
+
+	if app.Exposed {
+		exposed, err := w.config.ApplicationService.GetExposedEndpoints(ctx, name)
+		if err != nil {
+			return nil, errors.Errorf("getting exposed endpoints: %w", err)
+		}
+		if len(exposed) > 0 {
+			modelApp.ExposedEndpoints = make(map[string]bundlechanges.ExposedEndpoint, len(exposed))
+		}
+		for endpoint, details := range exposed {
+			var spaceNames []string
+			for _, id := range details.ExposeToSpaceIDs.SortedValues() {
+				if space := spaces.GetByID(network.SpaceUUID(id)); space != nil {
+					spaceNames = append(spaceNames, string(space.Name))
+				}
+			}
+			modelApp.ExposedEndpoints[endpoint] = bundlechanges.ExposedEndpoint{
+				ExposeToSpaces: spaceNames,
+				ExposeToCIDRs:  details.ExposeToCIDRs.SortedValues(),
+			}
+		}
+	}
+	return modelApp, nil
+}
+
+// prepareBundle removes the parts of the bundle that are not compared with
+// the model. Machines and placement are not compared, nor is the charm
+// revision, channel or base of an application that does not specify them.
+// Constraints are normalised so that equivalent constraints, such as mem=4G
+// and mem=4096M, are not reported as drift.
+func prepareBundle(data *charm.BundleData, model *bundlechanges.Model) {
+	data.Machines = nil
+	for name, spec := range data.Applications {
+		if spec == nil {
+			continue
+		}
+		spec.To = nil
+		if spec.Constraints != "" {
+			if cons, err := constraints.Parse(spec.Constraints); err == nil {
+				spec.Constraints = cons.String()
+			}
+		}
+
+		app, ok := model.Applications[name]
+		if !ok {
+			continue
+		}
+		if spec.Revision == nil {
+			app.Revision = -1
+		}
+		if spec.Channel == "" {
+			app.Channel = ""
+		}
+		if spec.Base == "" && data.DefaultBase == "" {
+			app.Base = corebase.Base{}
+		}
+		// The expose settings of each endpoint are compared in order.
+		for endpoint, exposed := range spec.ExposedEndpoints {
+			slices.Sort(exposed.ExposeToSpaces)
+			slices.Sort(exposed.ExposeToCIDRs)
+			spec.ExposedEndpoints[endpoint] = exposed
+		}
+	}
+}
+
+// charmAlias returns the charm of an application as diff-bundle shows it:
+// the name of a Charmhub charm, or the URL of a local charm.
+func charmAlias(locator applicationcharm.CharmLocator) string {
+	if locator.Source == applicationcharm.CharmHubSource {
+		return locator.Name
+	}
+	url := &charm.URL{
+		Schema:   charm.Local.String(),
+		Name:     locator.Name,
+		Revision: locator.Revision,
+	}
+	return url.String()
+}
+
+func channelString(ch *deployment.Channel) string {
+	if ch == nil {
+		return ""
+	}
+	return charm.Channel{
+		Track:  ch.Track,
+		Risk:   charm.Risk(ch.Risk),
+		Branch: ch.Branch,
+	}.Normalize().String()
+}
+
+func platformBase(platform deployment.Platform) corebase.Base {
+	if platform.OSType != deployment.Ubuntu {
+		return corebase.Base{}
+	}
+	base, err := corebase.ParseBase(corebase.UbuntuOS, platform.Channel)
+	if err != nil {
+		return corebase.Base{}
+	}
+	return base
+}
+
+func constraintsEqual(a, b string) bool {
+	// Constraints that cannot be parsed are never equal.
+	ac, err := constraints.Parse(a)
+	if err != nil {
+		return false
+	}
+	bc, err := constraints.Parse(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(ac, bc)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,490 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/bundledrift (interfaces: DesiredBundleService,ApplicationService,StatusService,RelationService,NetworkService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package bundledrift -destination services_mock_test.go github.com/juju/juju/internal/worker/bundledrift DesiredBundleService,ApplicationService,StatusService,RelationService,NetworkService
+//
+
+// Package bundledrift is a generated GoMock package.
+package bundledrift
+
+import (
+	context "context"
+	reflect "reflect"
+
+	application "github.com/juju/juju/core/application"
+	constraints "github.com/juju/juju/core/constraints"
+	network "github.com/juju/juju/core/network"
+	application0 "github.com/juju/juju/domain/application"
+	service "github.com/juju/juju/domain/application/service"
+	desiredbundle "github.com/juju/juju/domain/desiredbundle"
+	relation "github.com/juju/juju/domain/relation"
+	service0 "github.com/juju/juju/domain/status/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDesiredBundleService is a mock of DesiredBundleService interface.
+type MockDesiredBundleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDesiredBundleServiceMockRecorder
+}
+
+// MockDesiredBundleServiceMockRecorder is the mock recorder for MockDesiredBundleService.
+type MockDesiredBundleServiceMockRecorder struct {
+	mock *MockDesiredBundleService
+}
+
+// NewMockDesiredBundleService creates a new mock instance.
+func NewMockDesiredBundleService(ctrl *gomock.Controller) *MockDesiredBundleService {
+	mock := &MockDesiredBundleService{ctrl: ctrl}
+	mock.recorder = &MockDesiredBundleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDesiredBundleService) EXPECT() *MockDesiredBundleServiceMockRecorder {
+	return m.recorder
+}
+
+// GetDesiredBundle mocks base method.
+func (m *MockDesiredBundleService) GetDesiredBundle(arg0 context.Context) (desiredbundle.DesiredBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDesiredBundle", arg0)
+	ret0, _ := ret[0].(desiredbundle.DesiredBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDesiredBundle indicates an expected call of GetDesiredBundle.
+func (mr *MockDesiredBundleServiceMockRecorder) GetDesiredBundle(arg0 any) *MockDesiredBundleServiceGetDesiredBundleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDesiredBundle", reflect.TypeOf((*MockDesiredBundleService)(nil).GetDesiredBundle), arg0)
+	return &MockDesiredBundleServiceGetDesiredBundleCall{Call: call}
+}
+
+// MockDesiredBundleServiceGetDesiredBundleCall wrap *gomock.Call
+type MockDesiredBundleServiceGetDesiredBundleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDesiredBundleServiceGetDesiredBundleCall) Return(arg0 desiredbundle.DesiredBundle, arg1 error) *MockDesiredBundleServiceGetDesiredBundleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDesiredBundleServiceGetDesiredBundleCall) Do(f func(context.Context) (desiredbundle.DesiredBundle, error)) *MockDesiredBundleServiceGetDesiredBundleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDesiredBundleServiceGetDesiredBundleCall) DoAndReturn(f func(context.Context) (desiredbundle.DesiredBundle, error)) *MockDesiredBundleServiceGetDesiredBundleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetBundleDrift mocks base method.
+func (m *MockDesiredBundleService) SetBundleDrift(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBundleDrift", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBundleDrift indicates an expected call of SetBundleDrift.
+func (mr *MockDesiredBundleServiceMockRecorder) SetBundleDrift(arg0, arg1, arg2 any) *MockDesiredBundleServiceSetBundleDriftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBundleDrift", reflect.TypeOf((*MockDesiredBundleService)(nil).SetBundleDrift), arg0, arg1, arg2)
+	return &MockDesiredBundleServiceSetBundleDriftCall{Call: call}
+}
+
+// MockDesiredBundleServiceSetBundleDriftCall wrap *gomock.Call
+type MockDesiredBundleServiceSetBundleDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDesiredBundleServiceSetBundleDriftCall) Return(arg0 error) *MockDesiredBundleServiceSetBundleDriftCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDesiredBundleServiceSetBundleDriftCall) Do(f func(context.Context, string, string) error) *MockDesiredBundleServiceSetBundleDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDesiredBundleServiceSetBundleDriftCall) DoAndReturn(f func(context.Context, string, string) error) *MockDesiredBundleServiceSetBundleDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock *MockApplicationService
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationAndCharmConfig mocks base method.
+func (m *MockApplicationService) GetApplicationAndCharmConfig(arg0 context.Context, arg1 application.UUID) (service.ApplicationConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAndCharmConfig", arg0, arg1)
+	ret0, _ := ret[0].(service.ApplicationConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAndCharmConfig indicates an expected call of GetApplicationAndCharmConfig.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationAndCharmConfig(arg0, arg1 any) *MockApplicationServiceGetApplicationAndCharmConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAndCharmConfig", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationAndCharmConfig), arg0, arg1)
+	return &MockApplicationServiceGetApplicationAndCharmConfigCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationAndCharmConfigCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationAndCharmConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationAndCharmConfigCall) Return(arg0 service.ApplicationConfig, arg1 error) *MockApplicationServiceGetApplicationAndCharmConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationAndCharmConfigCall) Do(f func(context.Context, application.UUID) (service.ApplicationConfig, error)) *MockApplicationServiceGetApplicationAndCharmConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationAndCharmConfigCall) DoAndReturn(f func(context.Context, application.UUID) (service.ApplicationConfig, error)) *MockApplicationServiceGetApplicationAndCharmConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationConstraints mocks base method.
+func (m *MockApplicationService) GetApplicationConstraints(arg0 context.Context, arg1 application.UUID) (constraints.Value, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationConstraints", arg0, arg1)
+	ret0, _ := ret[0].(constraints.Value)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationConstraints indicates an expected call of GetApplicationConstraints.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationConstraints(arg0, arg1 any) *MockApplicationServiceGetApplicationConstraintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationConstraints", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationConstraints), arg0, arg1)
+	return &MockApplicationServiceGetApplicationConstraintsCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationConstraintsCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationConstraintsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationConstraintsCall) Return(arg0 constraints.Value, arg1 error) *MockApplicationServiceGetApplicationConstraintsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationConstraintsCall) Do(f func(context.Context, application.UUID) (constraints.Value, error)) *MockApplicationServiceGetApplicationConstraintsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationConstraintsCall) DoAndReturn(f func(context.Context, application.UUID) (constraints.Value, error)) *MockApplicationServiceGetApplicationConstraintsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationUUIDByName mocks base method.
+func (m *MockApplicationService) GetApplicationUUIDByName(arg0 context.Context, arg1 string) (application.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationUUIDByName", arg0, arg1)
+	ret0, _ := ret[0].(application.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationUUIDByName indicates an expected call of GetApplicationUUIDByName.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationUUIDByName(arg0, arg1 any) *MockApplicationServiceGetApplicationUUIDByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationUUIDByName", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationUUIDByName), arg0, arg1)
+	return &MockApplicationServiceGetApplicationUUIDByNameCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationUUIDByNameCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationUUIDByNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationUUIDByNameCall) Return(arg0 application.UUID, arg1 error) *MockApplicationServiceGetApplicationUUIDByNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationUUIDByNameCall) Do(f func(context.Context, string) (application.UUID, error)) *MockApplicationServiceGetApplicationUUIDByNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationUUIDByNameCall) DoAndReturn(f func(context.Context, string) (application.UUID, error)) *MockApplicationServiceGetApplicationUUIDByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetExposedEndpoints mocks base method.
+func (m *MockApplicationService) GetExposedEndpoints(arg0 context.Context, arg1 string) (map[string]application0.ExposedEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExposedEndpoints", arg0, arg1)
+	ret0, _ := ret[0].(map[string]application0.ExposedEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExposedEndpoints indicates an expected call of GetExposedEndpoints.
+func (mr *MockApplicationServiceMockRecorder) GetExposedEndpoints(arg0, arg1 any) *MockApplicationServiceGetExposedEndpointsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedEndpoints", reflect.TypeOf((*MockApplicationService)(nil).GetExposedEndpoints), arg0, arg1)
+	return &MockApplicationServiceGetExposedEndpointsCall{Call: call}
+}
+
+// MockApplicationServiceGetExposedEndpointsCall wrap *gomock.Call
+type MockApplicationServiceGetExposedEndpointsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetExposedEndpointsCall) Return(arg0 map[string]application0.ExposedEndpoint, arg1 error) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetExposedEndpointsCall) Do(f func(context.Context, string) (map[string]application0.ExposedEndpoint, error)) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetExposedEndpointsCall) DoAndReturn(f func(context.Context, string) (map[string]application0.ExposedEndpoint, error)) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStatusService is a mock of StatusService interface.
+type MockStatusService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusServiceMockRecorder
+}
+
+// MockStatusServiceMockRecorder is the mock recorder for MockStatusService.
+type MockStatusServiceMockRecorder struct {
+	mock *MockStatusService
+}
+
+// NewMockStatusService creates a new mock instance.
+func NewMockStatusService(ctrl *gomock.Controller) *MockStatusService {
+	mock := &MockStatusService{ctrl: ctrl}
+	mock.recorder = &MockStatusServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusService) EXPECT() *MockStatusServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationAndUnitStatuses mocks base method.
+func (m *MockStatusService) GetApplicationAndUnitStatuses(arg0 context.Context) (map[string]service0.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAndUnitStatuses", arg0)
+	ret0, _ := ret[0].(map[string]service0.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAndUnitStatuses indicates an expected call of GetApplicationAndUnitStatuses.
+func (mr *MockStatusServiceMockRecorder) GetApplicationAndUnitStatuses(arg0 any) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAndUnitStatuses", reflect.TypeOf((*MockStatusService)(nil).GetApplicationAndUnitStatuses), arg0)
+	return &MockStatusServiceGetApplicationAndUnitStatusesCall{Call: call}
+}
+
+// MockStatusServiceGetApplicationAndUnitStatusesCall wrap *gomock.Call
+type MockStatusServiceGetApplicationAndUnitStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceGetApplicationAndUnitStatusesCall) Return(arg0 map[string]service0.Application, arg1 error) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceGetApplicationAndUnitStatusesCall) Do(f func(context.Context) (map[string]service0.Application, error)) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceGetApplicationAndUnitStatusesCall) DoAndReturn(f func(context.Context) (map[string]service0.Application, error)) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockRelationService is a mock of RelationService interface.
+type MockRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelationServiceMockRecorder
+}
+
+// MockRelationServiceMockRecorder is the mock recorder for MockRelationService.
+type MockRelationServiceMockRecorder struct {
+	mock *MockRelationService
+}
+
+// NewMockRelationService creates a new mock instance.
+func NewMockRelationService(ctrl *gomock.Controller) *MockRelationService {
+	mock := &MockRelationService{ctrl: ctrl}
+	mock.recorder = &MockRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelationService) EXPECT() *MockRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllRelationDetails mocks base method.
+func (m *MockRelationService) GetAllRelationDetails(arg0 context.Context) ([]relation.RelationDetailsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllRelationDetails", arg0)
+	ret0, _ := ret[0].([]relation.RelationDetailsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllRelationDetails indicates an expected call of GetAllRelationDetails.
+func (mr *MockRelationServiceMockRecorder) GetAllRelationDetails(arg0 any) *MockRelationServiceGetAllRelationDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRelationDetails", reflect.TypeOf((*MockRelationService)(nil).GetAllRelationDetails), arg0)
+	return &MockRelationServiceGetAllRelationDetailsCall{Call: call}
+}
+
+// MockRelationServiceGetAllRelationDetailsCall wrap *gomock.Call
+type MockRelationServiceGetAllRelationDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRelationServiceGetAllRelationDetailsCall) Return(arg0 []relation.RelationDetailsResult, arg1 error) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRelationServiceGetAllRelationDetailsCall) Do(f func(context.Context) ([]relation.RelationDetailsResult, error)) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRelationServiceGetAllRelationDetailsCall) DoAndReturn(f func(context.Context) ([]relation.RelationDetailsResult, error)) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockNetworkService is a mock of NetworkService interface.
+type MockNetworkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkServiceMockRecorder
+}
+
+// MockNetworkServiceMockRecorder is the mock recorder for MockNetworkService.
+type MockNetworkServiceMockRecorder struct {
+	mock *MockNetworkService
+}
+
+// NewMockNetworkService creates a new mock instance.
+func NewMockNetworkService(ctrl *gomock.Controller) *MockNetworkService {
+	mock := &MockNetworkService{ctrl: ctrl}
+	mock.recorder = &MockNetworkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkService) EXPECT() *MockNetworkServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllSpaces mocks base method.
+func (m *MockNetworkService) GetAllSpaces(arg0 context.Context) (network.SpaceInfos, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSpaces", arg0)
+	ret0, _ := ret[0].(network.SpaceInfos)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSpaces indicates an expected call of GetAllSpaces.
+func (mr *MockNetworkServiceMockRecorder) GetAllSpaces(arg0 any) *MockNetworkServiceGetAllSpacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSpaces", reflect.TypeOf((*MockNetworkService)(nil).GetAllSpaces), arg0)
+	return &MockNetworkServiceGetAllSpacesCall{Call: call}
+}
+
+// MockNetworkServiceGetAllSpacesCall wrap *gomock.Call
+type MockNetworkServiceGetAllSpacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceGetAllSpacesCall) Return(arg0 network.SpaceInfos, arg1 error) *MockNetworkServiceGetAllSpacesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceGetAllSpacesCall) Do(f func(context.Context) (network.SpaceInfos, error)) *MockNetworkServiceGetAllSpacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceGetAllSpacesCall) DoAndReturn(f func(context.Context) (network.SpaceInfos, error)) *MockNetworkServiceGetAllSpacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
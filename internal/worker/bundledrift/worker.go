@@ -0,0 +1,291 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundledrift
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+	"gopkg.in/yaml.v2"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/constraints"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/domain/application"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/desiredbundle"
+	desiredbundleerrors "github.com/juju/juju/domain/desiredbundle/errors"
+	desiredbundleservice "github.com/juju/juju/domain/desiredbundle/service"
+	"github.com/juju/juju/domain/relation"
+	statusservice "github.com/juju/juju/domain/status/service"
+	bundlechanges "github.com/juju/juju/internal/bundle/changes"
+	"github.com/juju/juju/internal/errors"
+)
+
+// DesiredBundleService provides access to the desired bundle of the model.
+type DesiredBundleService interface {
+	// GetDesiredBundle returns the desired bundle of the model.
+	GetDesiredBundle(ctx context.Context) (desiredbundle.DesiredBundle, error)
+
+	// SetBundleDrift records the result of comparing the model with the
+	// desired bundle identified by bundleUUID.
+	SetBundleDrift(ctx context.Context, bundleUUID, drift string) error
+}
+
+// ApplicationService provides access to the config, constraints and expose
+// settings of applications.
+type ApplicationService interface {
+	// GetApplicationUUIDByName returns the UUID of the named application.
+	GetApplicationUUIDByName(ctx context.Context, name string) (coreapplication.UUID, error)
+
+	// GetApplicationAndCharmConfig returns the application and charm config
+	// for the specified application UUID.
+	GetApplicationAndCharmConfig(ctx context.Context, appUUID coreapplication.UUID) (applicationservice.ApplicationConfig, error)
+
+	// GetApplicationConstraints returns the application constraints for the
+	// specified application UUID.
+	GetApplicationConstraints(ctx context.Context, appUUID coreapplication.UUID) (constraints.Value, error)
+
+	// GetExposedEndpoints returns map where keys are endpoint names (or the ""
+	// value which represents all endpoints) and values are ExposedEndpoint
+	// instances that specify which sources (spaces or CIDRs) can access the
+	// opened ports for each endpoint once the application is exposed.
+	GetExposedEndpoints(ctx context.Context, appName string) (map[string]application.ExposedEndpoint, error)
+}
+
+// StatusService provides access to the applications and units of the model.
+type StatusService interface {
+	// GetApplicationAndUnitStatuses returns the application statuses of all
+	// the applications in the model, indexed by application name.
+	GetApplicationAndUnitStatuses(ctx context.Context) (map[string]statusservice.Application, error)
+}
+
+// RelationService provides access to the relations of the model.
+type RelationService interface {
+	// GetAllRelationDetails return RelationDetailResults of all relation
+	// for the current model.
+	GetAllRelationDetails(ctx context.Context) ([]relation.RelationDetailsResult, error)
+}
+
+// NetworkService provides access to the spaces of the model.
+type NetworkService interface {
+	// GetAllSpaces returns all spaces for the model.
+	GetAllSpaces(ctx context.Context) (network.SpaceInfos, error)
+}
+
+// Config is the configuration for the bundle drift worker.
+type Config struct {
+	DesiredBundleService DesiredBundleService
+	ApplicationService   ApplicationService
+	StatusService        StatusService
+	RelationService      RelationService
+	NetworkService       NetworkService
+	Clock                clock.Clock
+	Logger               logger.Logger
+
+	// CheckInterval is how often the model is compared with its desired
+	// bundle.
+	CheckInterval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.DesiredBundleService == nil {
+		return errors.Errorf("nil DesiredBundleService").Add(coreerrors.NotValid)
+	}
+	if config.ApplicationService == nil {
+		return errors.Errorf("nil ApplicationService").Add(coreerrors.NotValid)
+	}
+	if config.StatusService == nil {
+		return errors.Errorf("nil StatusService").Add(coreerrors.NotValid)
+	}
+	if config.RelationService == nil {
+		return errors.Errorf("nil RelationService").Add(coreerrors.NotValid)
+	}
+	if config.NetworkService == nil {
+		return errors.Errorf("nil NetworkService").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil Clock").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.CheckInterval <= 0 {
+		return errors.Errorf("check interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// driftWorker is a worker that compares the model with its desired bundle.
+type driftWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	bundleUUID string
+	drift      string
+	lastCheck  time.Time
+}
+
+// NewWorker returns a new bundle drift worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &driftWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "bundle-drift",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *driftWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *driftWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *driftWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]any{
+		"desired-bundle": w.bundleUUID,
+		"drifted":        w.drift != "",
+		"last-check":     w.lastCheck,
+	}
+}
+
+// loop compares the model with its desired bundle when the worker starts,
+// and then on every tick of the check interval.
+func (w *driftWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	timer := w.config.Clock.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			if err := w.check(ctx); err != nil {
+				return errors.Capture(err)
+			}
+			timer.Reset(w.config.CheckInterval)
+		}
+	}
+}
+
+// check compares the model with its desired bundle, if it has one, and
+// records the differences.
+func (w *driftWorker) check(ctx context.Context) error {
+	desired, err := w.config.DesiredBundleService.GetDesiredBundle(ctx)
+	if errors.Is(err, desiredbundleerrors.NotFound) {
+		w.recordCheck("", "")
+		return nil
+	} else if err != nil {
+		return errors.Errorf("getting desired bundle: %w", err)
+	}
+
+	diff, err := w.diff(ctx, desired.Bundle)
+	if err != nil {
+		return errors.Errorf("comparing model with desired bundle: %w", err)
+	}
+	var drift string
+	if !diff.Empty() {
+		out, err := yaml.Marshal(diff)
+		if err != nil {
+			return errors.Errorf("encoding bundle drift: %w", err)
+		}
+		drift = string(out)
+	}
+
+	err = w.config.DesiredBundleService.SetBundleDrift(ctx, desired.UUID, drift)
+	if errors.Is(err, desiredbundleerrors.BundleChanged) {
+		// The bundle was replaced or removed while the model was being
+		// compared with it; the new bundle is compared on the next check.
+		w.config.Logger.Debugf(ctx, "desired bundle changed during check")
+		return nil
+	} else if err != nil {
+		return errors.Errorf("recording bundle drift: %w", err)
+	}
+
+	if drift != "" && (desired.UUID != w.currentBundle() || drift != w.currentDrift()) {
+		w.config.Logger.Warningf(ctx, "model has drifted from its desired bundle: %s", summarise(diff))
+	} else if drift == "" && desired.UUID == w.currentBundle() && w.currentDrift() != "" {
+		w.config.Logger.Infof(ctx, "model matches its desired bundle again")
+	}
+	w.recordCheck(desired.UUID, drift)
+	return nil
+}
+
+// diff compares the model with the bundle.
+func (w *driftWorker) diff(ctx context.Context, bundle string) (*bundlechanges.BundleDiff, error) {
+	data, err := desiredbundleservice.ParseBundle(bundle)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	model, err := w.buildModel(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	prepareBundle(data, model)
+
+	return bundlechanges.BuildDiff(bundlechanges.DiffConfig{
+		Bundle: data,
+		Model:  model,
+		Logger: w.config.Logger,
+	})
+}
+
+func (w *driftWorker) recordCheck(bundleUUID, drift string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.bundleUUID = bundleUUID
+	w.drift = drift
+	w.lastCheck = w.config.Clock.Now()
+}
+
+func (w *driftWorker) currentBundle() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.bundleUUID
+}
+
+func (w *driftWorker) currentDrift() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.drift
+}
+
+// summarise returns a short description of the differences, naming the
+// applications that differ from the bundle.
+func summarise(diff *bundlechanges.BundleDiff) string {
+	var parts []string
+	if len(diff.Applications) > 0 {
+		parts = append(parts, "applications "+strings.Join(sortedKeys(diff.Applications), ", "))
+	}
+	if diff.Relations != nil {
+		parts = append(parts, "relations")
+	}
+	return strings.Join(parts, "; ")
+}
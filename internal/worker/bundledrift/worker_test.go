@@ -0,0 +1,281 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundledrift
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/network"
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/core/unit"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/deployment"
+	internalcharm "github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/domain/desiredbundle"
+	desiredbundleerrors "github.com/juju/juju/domain/desiredbundle/errors"
+	"github.com/juju/juju/domain/relation"
+	statusservice "github.com/juju/juju/domain/status/service"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+func TestConfigSuite(t *testing.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		DesiredBundleService: NewMockDesiredBundleService(ctrl),
+		ApplicationService:   NewMockApplicationService(ctrl),
+		StatusService:        NewMockStatusService(ctrl),
+		RelationService:      NewMockRelationService(ctrl),
+		NetworkService:       NewMockNetworkService(ctrl),
+		Clock:                testclock.NewClock(time.Now()),
+		Logger:               loggertesting.WrapCheckLog(c),
+		CheckInterval:        time.Minute,
+	}
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.DesiredBundleService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil DesiredBundleService.*")
+
+	testCfg = origCfg
+	testCfg.StatusService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil StatusService.*")
+
+	testCfg = origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Clock.*")
+
+	testCfg = origCfg
+	testCfg.CheckInterval = 0
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "check interval must be positive.*")
+}
+
+type workerSuite struct {
+	desiredBundleService *MockDesiredBundleService
+	applicationService   *MockApplicationService
+	statusService        *MockStatusService
+	relationService      *MockRelationService
+	networkService       *MockNetworkService
+	clock                *testclock.Clock
+}
+
+const desiredBundleYAML = `
+applications:
+  mysql:
+    charm: mysql
+    num_units: 2
+    options:
+      flavour: percona
+  wordpress:
+    charm: wordpress
+    num_units: 1
+    constraints: mem=4G
+relations:
+- [wordpress:db, mysql:server]
+`
+
+func (s *workerSuite) TestCheckNoDesiredBundle(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.desiredBundleService.EXPECT().GetDesiredBundle(gomock.Any()).Return(
+		desiredbundle.DesiredBundle{}, desiredbundleerrors.NotFound)
+
+	w := s.newDriftWorker(c)
+	err := w.check(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	report := w.Report(c.Context())
+	c.Check(report["desired-bundle"], tc.Equals, "")
+	c.Check(report["drifted"], tc.Equals, false)
+}
+
+func (s *workerSuite) TestCheckMatchesBundle(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectDesiredBundle("bundle-uuid")
+	s.expectModel(map[string]any{"flavour": "percona"}, 2)
+	s.desiredBundleService.EXPECT().SetBundleDrift(gomock.Any(), "bundle-uuid", "").Return(nil)
+
+	w := s.newDriftWorker(c)
+	err := w.check(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	report := w.Report(c.Context())
+	c.Check(report["desired-bundle"], tc.Equals, "bundle-uuid")
+	c.Check(report["drifted"], tc.Equals, false)
+}
+
+func (s *workerSuite) TestCheckDrifted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectDesiredBundle("bundle-uuid")
+	s.expectModel(map[string]any{"flavour": "mariadb"}, 3)
+
+	var drift string
+	s.desiredBundleService.EXPECT().SetBundleDrift(gomock.Any(), "bundle-uuid", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, d string) error {
+			drift = d
+			return nil
+		})
+
+	w := s.newDriftWorker(c)
+	err := w.check(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(drift, tc.Equals, `
+applications:
+  mysql:
+    num_units:
+      bundle: 2
+      model: 3
+    options:
+      flavour:
+        bundle: percona
+        model: mariadb
+`[1:])
+	c.Check(w.Report(c.Context())["drifted"], tc.Equals, true)
+}
+
+func (s *workerSuite) TestCheckBundleChanged(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectDesiredBundle("bundle-uuid")
+	s.expectModel(map[string]any{"flavour": "mariadb"}, 2)
+	s.desiredBundleService.EXPECT().SetBundleDrift(gomock.Any(), "bundle-uuid", gomock.Any()).
+		Return(desiredbundleerrors.BundleChanged)
+
+	w := s.newDriftWorker(c)
+	err := w.check(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The drift of a replaced bundle is not reported.
+	c.Check(w.Report(c.Context())["desired-bundle"], tc.Equals, "")
+}
+
+func (s *workerSuite) TestWorkerChecksOnInterval(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	checked := make(chan struct{}, 2)
+	s.desiredBundleService.EXPECT().GetDesiredBundle(gomock.Any()).DoAndReturn(
+		func(context.Context) (desiredbundle.DesiredBundle, error) {
+			checked <- struct{}{}
+			return desiredbundle.DesiredBundle{}, desiredbundleerrors.NotFound
+		}).Times(2)
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	// The model is checked as soon as the worker starts.
+	s.waitForCheck(c, checked)
+
+	err = s.clock.WaitAdvance(time.Minute, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	s.waitForCheck(c, checked)
+}
+
+func (s *workerSuite) waitForCheck(c *tc.C, checked <-chan struct{}) {
+	select {
+	case <-checked:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for check")
+	}
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.desiredBundleService = NewMockDesiredBundleService(ctrl)
+	s.applicationService = NewMockApplicationService(ctrl)
+	s.statusService = NewMockStatusService(ctrl)
+	s.relationService = NewMockRelationService(ctrl)
+	s.networkService = NewMockNetworkService(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+	return ctrl
+}
+
+func (s *workerSuite) newConfig(c *tc.C) Config {
+	return Config{
+		DesiredBundleService: s.desiredBundleService,
+		ApplicationService:   s.applicationService,
+		StatusService:        s.statusService,
+		RelationService:      s.relationService,
+		NetworkService:       s.networkService,
+		Clock:                s.clock,
+		Logger:               loggertesting.WrapCheckLog(c),
+		CheckInterval:        time.Minute,
+	}
+}
+
+func (s *workerSuite) newDriftWorker(c *tc.C) *driftWorker {
+	return &driftWorker{config: s.newConfig(c)}
+}
+
+func (s *workerSuite) expectDesiredBundle(uuid string) {
+	s.desiredBundleService.EXPECT().GetDesiredBundle(gomock.Any()).Return(desiredbundle.DesiredBundle{
+		UUID:   uuid,
+		Bundle: desiredBundleYAML,
+	}, nil)
+}
+
+// expectModel sets up a model with the applications and relation of the
+// desired bundle, where mysql has the given config and number of units.
+func (s *workerSuite) expectModel(mysqlConfig map[string]any, mysqlUnits int) {
+	mysqlUnitNames := make(map[unit.Name]statusservice.Unit, mysqlUnits)
+	for i := range mysqlUnits {
+		mysqlUnitNames[unit.Name("mysql/"+string(rune('0'+i)))] = statusservice.Unit{}
+	}
+	platform := deployment.Platform{Channel: "24.04", OSType: deployment.Ubuntu}
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]statusservice.Application{
+		"mysql": {
+			CharmLocator: applicationcharm.CharmLocator{Name: "mysql", Revision: 42, Source: applicationcharm.CharmHubSource},
+			Platform:     platform,
+			Units:        mysqlUnitNames,
+		},
+		"wordpress": {
+			CharmLocator: applicationcharm.CharmLocator{Name: "wordpress", Revision: 7, Source: applicationcharm.CharmHubSource},
+			Platform:     platform,
+			Units:        map[unit.Name]statusservice.Unit{"wordpress/0": {}},
+		},
+	}, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(network.SpaceInfos{}, nil)
+
+	s.applicationService.EXPECT().GetApplicationUUIDByName(gomock.Any(), "mysql").Return(coreapplication.UUID("mysql-uuid"), nil)
+	s.applicationService.EXPECT().GetApplicationAndCharmConfig(gomock.Any(), coreapplication.UUID("mysql-uuid")).Return(
+		applicationservice.ApplicationConfig{ApplicationConfig: internalcharm.Config(mysqlConfig)}, nil)
+	s.applicationService.EXPECT().GetApplicationConstraints(gomock.Any(), coreapplication.UUID("mysql-uuid")).Return(
+		constraints.Value{}, nil)
+
+	s.applicationService.EXPECT().GetApplicationUUIDByName(gomock.Any(), "wordpress").Return(coreapplication.UUID("wordpress-uuid"), nil)
+	s.applicationService.EXPECT().GetApplicationAndCharmConfig(gomock.Any(), coreapplication.UUID("wordpress-uuid")).Return(
+		applicationservice.ApplicationConfig{}, nil)
+	// Equivalent constraints are not reported as drift.
+	s.applicationService.EXPECT().GetApplicationConstraints(gomock.Any(), coreapplication.UUID("wordpress-uuid")).Return(
+		constraints.MustParse("mem=4096M"), nil)
+
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return([]relation.RelationDetailsResult{{
+		Endpoints: []relation.Endpoint{{
+			ApplicationName: "mysql",
+			Relation:        internalcharm.Relation{Name: "server"},
+		}, {
+			ApplicationName: "wordpress",
+			Relation:        internalcharm.Relation{Name: "db"},
+		}},
+	}}, nil)
+}
@@ -24,19 +24,23 @@ import (
 type AppFirewallerConfig struct {
 	ApplicationService ApplicationService
 	Broker             CAASBroker
+	ModelConfigService ModelConfigService
 	PortService        PortService
+	RelationService    RelationService
 	Logger             logger.Logger
 }
 
 // appFirewaller is a single application firewaller worker ensuring the exposed
-// ports of the application are reflected in the broker.
+// ports and network policy of the application are reflected in the broker.
 type appFirewaller struct {
 	catacomb catacomb.Catacomb
 
 	appUUID            application.UUID
 	applicationService ApplicationService
 	broker             CAASBroker
+	modelConfigService ModelConfigService
 	portService        PortService
+	relationService    RelationService
 
 	logger logger.Logger
 }
@@ -71,15 +75,16 @@ func (w *appFirewaller) ensureOpenPorts(
 	return changedPortRanges, nil
 }
 
-// getPortMutator returns the portMutator for the current application.
-func (w *appFirewaller) getPortMutator(ctx context.Context) (PortMutator, error) {
+// getApplication returns the name of the current application along with the
+// broker application used for mutating its ports and network policy.
+func (w *appFirewaller) getApplication(ctx context.Context) (string, caas.Application, error) {
 	appName, err := w.applicationService.GetApplicationName(ctx, w.appUUID)
 	if err != nil {
-		return nil, errors.Errorf("getting application %q name: %w", w.appUUID, err)
+		return "", nil, errors.Errorf("getting application %q name: %w", w.appUUID, err)
 	}
 
 	app := w.broker.Application(appName, caas.DeploymentStateful)
-	return app, nil
+	return appName, app, nil
 }
 
 // Kill is part of the worker.Worker interface.
@@ -115,10 +120,10 @@ func (w *appFirewaller) loop() (err error) {
 		}
 	}()
 
-	portMutator, err := w.getPortMutator(ctx)
+	appName, app, err := w.getApplication(ctx)
 	if err != nil {
 		return errors.Errorf(
-			"getting application %q port mutator: %w",
+			"getting application %q from broker: %w",
 			w.appUUID, err,
 		)
 	}
@@ -127,6 +132,10 @@ func (w *appFirewaller) loop() (err error) {
 	// applied to the application.
 	lastCheckPoint := network.GroupedPortRanges{}
 
+	// lastPolicyCheckPoint keeps track of the last network policy that has
+	// been applied to the application.
+	var lastPolicyCheckPoint networkPolicyCheckPoint
+
 	portsWatcher, err := w.portService.WatchOpenedPortsForApplication(ctx, w.appUUID)
 	if err != nil {
 		return errors.Errorf("getting application %q opened ports watcher: %w",
@@ -140,6 +149,39 @@ func (w *appFirewaller) loop() (err error) {
 		)
 	}
 
+	relationsWatcher, err := w.relationService.WatchAllRelations(ctx)
+	if err != nil {
+		return errors.Errorf("getting relations watcher: %w", err)
+	}
+	if err := w.catacomb.Add(relationsWatcher); err != nil {
+		return errors.Errorf(
+			"adding relations watcher to catacomb: %w", err,
+		)
+	}
+
+	exposedWatcher, err := w.applicationService.WatchApplicationExposed(ctx, appName)
+	if err != nil {
+		return errors.Errorf("getting application %q exposed watcher: %w",
+			w.appUUID, err,
+		)
+	}
+	if err := w.catacomb.Add(exposedWatcher); err != nil {
+		return errors.Errorf(
+			"adding application %q exposed watcher to catacomb: %w",
+			w.appUUID, err,
+		)
+	}
+
+	configWatcher, err := w.modelConfigService.Watch(ctx)
+	if err != nil {
+		return errors.Errorf("getting model config watcher: %w", err)
+	}
+	if err := w.catacomb.Add(configWatcher); err != nil {
+		return errors.Errorf(
+			"adding model config watcher to catacomb: %w", err,
+		)
+	}
+
 	for {
 		select {
 		case <-w.catacomb.Dying():
@@ -155,10 +197,48 @@ func (w *appFirewaller) loop() (err error) {
 				ctx, "received application %q port change event", w.appUUID,
 			)
 
-			lastCheckPoint, err = w.ensureOpenPorts(ctx, portMutator, lastCheckPoint)
+			lastCheckPoint, err = w.ensureOpenPorts(ctx, app, lastCheckPoint)
 			if err != nil {
 				return err
 			}
+		case _, ok := <-relationsWatcher.Changes():
+			if !ok {
+				return errors.New(
+					"relations watcher channel closed unexpectedly",
+				)
+			}
+
+			w.logger.Debugf(
+				ctx, "received relations change event for application %q", w.appUUID,
+			)
+		case _, ok := <-exposedWatcher.Changes():
+			if !ok {
+				return errors.New(
+					"application exposed watcher channel closed unexpectedly",
+				)
+			}
+
+			w.logger.Debugf(
+				ctx, "received application %q exposed change event", w.appUUID,
+			)
+		case _, ok := <-configWatcher.Changes():
+			if !ok {
+				return errors.New(
+					"model config watcher channel closed unexpectedly",
+				)
+			}
+
+			w.logger.Debugf(
+				ctx, "received model config change event for application %q", w.appUUID,
+			)
+		}
+
+		// The network policy depends on the opened ports, relations,
+		// exposed endpoints and model config, so it is checked after
+		// every event.
+		lastPolicyCheckPoint, err = w.ensureNetworkPolicy(ctx, app, appName, lastPolicyCheckPoint)
+		if err != nil {
+			return err
 		}
 	}
 }
@@ -179,7 +259,9 @@ func NewAppFirewallerWorker(
 		applicationService: config.ApplicationService,
 		appUUID:            appUUID,
 		broker:             config.Broker,
+		modelConfigService: config.ModelConfigService,
 		portService:        config.PortService,
+		relationService:    config.RelationService,
 		logger:             config.Logger,
 	}
 
@@ -233,12 +315,24 @@ func (c *AppFirewallerConfig) Validate() error {
 		)
 	}
 
+	if c.ModelConfigService == nil {
+		return errors.New("not valid nil ModelConfigService").Add(
+			coreerrors.NotValid,
+		)
+	}
+
 	if c.PortService == nil {
 		return errors.New("not valid nil PortService").Add(
 			coreerrors.NotValid,
 		)
 	}
 
+	if c.RelationService == nil {
+		return errors.New("not valid nil RelationService").Add(
+			coreerrors.NotValid,
+		)
+	}
+
 	if c.Logger == nil {
 		return errors.New("not valid nil Logger").Add(
 			coreerrors.NotValid,
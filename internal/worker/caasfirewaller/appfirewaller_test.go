@@ -6,6 +6,7 @@ package caasfirewaller
 import (
 	"testing"
 
+	"github.com/juju/collections/set"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5"
 	"go.uber.org/goleak"
@@ -15,10 +16,16 @@ import (
 	caasmocks "github.com/juju/juju/caas/mocks"
 	coreapplication "github.com/juju/juju/core/application"
 	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/watcher/watchertest"
+	domainapplication "github.com/juju/juju/domain/application"
 	domainapplicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/domain/relation"
+	"github.com/juju/juju/environs/config"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/caasfirewaller/mocks"
 )
 
@@ -27,6 +34,8 @@ import (
 type appFirewallerSuite struct {
 	portService        *mocks.MockPortService
 	applicationService *mocks.MockApplicationService
+	modelConfigService *mocks.MockModelConfigService
+	relationService    *mocks.MockRelationService
 	broker             *mocks.MockCAASBroker
 	brokerApp          *caasmocks.MockApplication
 }
@@ -44,12 +53,16 @@ func (s *appFirewallerSuite) setupMocks(c *tc.C) *gomock.Controller {
 
 	s.portService = mocks.NewMockPortService(ctrl)
 	s.applicationService = mocks.NewMockApplicationService(ctrl)
+	s.modelConfigService = mocks.NewMockModelConfigService(ctrl)
+	s.relationService = mocks.NewMockRelationService(ctrl)
 	s.broker = mocks.NewMockCAASBroker(ctrl)
 	s.brokerApp = caasmocks.NewMockApplication(ctrl)
 
 	c.Cleanup(func() {
 		s.portService = nil
 		s.applicationService = nil
+		s.modelConfigService = nil
+		s.relationService = nil
 		s.broker = nil
 		s.brokerApp = nil
 	})
@@ -68,7 +81,9 @@ func (s *appFirewallerSuite) makeWorker(
 		AppFirewallerConfig{
 			ApplicationService: s.applicationService,
 			Broker:             s.broker,
+			ModelConfigService: s.modelConfigService,
 			PortService:        s.portService,
+			RelationService:    s.relationService,
 			Logger:             loggertesting.WrapCheckLog(c),
 		},
 	)
@@ -76,6 +91,48 @@ func (s *appFirewallerSuite) makeWorker(
 	return w
 }
 
+// expectNetworkPolicyWatchers is a test suite helper establishing the
+// watchers used by the worker for maintaining the network policy of the
+// application. The returned channels are used for sending the relations,
+// exposed and model config change events.
+func (s *appFirewallerSuite) expectNetworkPolicyWatchers(
+	appName string,
+) (chan struct{}, chan struct{}, chan []string) {
+	relationsChangeCh := make(chan struct{})
+	exposedChangeCh := make(chan struct{})
+	configChangeCh := make(chan []string)
+
+	s.relationService.EXPECT().WatchAllRelations(gomock.Any()).Return(
+		watchertest.NewMockNotifyWatcher(relationsChangeCh), nil,
+	)
+	s.applicationService.EXPECT().WatchApplicationExposed(gomock.Any(), appName).Return(
+		watchertest.NewMockNotifyWatcher(exposedChangeCh), nil,
+	)
+	s.modelConfigService.EXPECT().Watch(gomock.Any()).Return(
+		watchertest.NewMockStringsWatcher(configChangeCh), nil,
+	)
+	return relationsChangeCh, exposedChangeCh, configChangeCh
+}
+
+// modelConfig returns a model config with the relation-network-policy set to
+// the supplied value.
+func (s *appFirewallerSuite) modelConfig(c *tc.C, relationNetworkPolicy bool) *config.Config {
+	return coretesting.CustomModelConfig(c, coretesting.Attrs{
+		config.RelationNetworkPolicyKey: relationNetworkPolicy,
+	})
+}
+
+// expectNetworkPolicyDisabled establishes the expectations for a model without
+// the relation-network-policy enabled. The network policy of the application
+// is removed once when the worker handles its first event.
+func (s *appFirewallerSuite) expectNetworkPolicyDisabled(c *tc.C, appName string) {
+	s.expectNetworkPolicyWatchers(appName)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(
+		s.modelConfig(c, false), nil,
+	).AnyTimes()
+	s.brokerApp.EXPECT().DeleteNetworkPolicy().Return(nil)
+}
+
 // TestWorkerCleanShutdownOnApplicationRemoval ensures that when an application
 // is removed the worker cleanly shuts down without error. This is a regression
 // test after moving to Dqlite. The wrong error type was being check and the
@@ -135,6 +192,8 @@ func (s *appFirewallerSuite) TestWorkerCleanShutdownOnApplicationRemoval(c *tc.C
 		},
 	}, false).Return(nil)
 
+	s.expectNetworkPolicyDisabled(c, appName)
+
 	w := s.makeWorker(c, appUUID)
 
 	// Initial watcher event on startup
@@ -203,6 +262,8 @@ func (s *appFirewallerSuite) TestWorkerPropogatesBrokerNotFoundError(c *tc.C) {
 		},
 	}, false).Return(coreerrors.NotFound) // NotFound error that cannot be ignored.
 
+	s.expectNetworkPolicyDisabled(c, appName)
+
 	w := s.makeWorker(c, appUUID)
 
 	// Initial watcher event on startup
@@ -299,6 +360,8 @@ func (s *appFirewallerSuite) TestWorkerPortChanges(c *tc.C) {
 		},
 	}, false).Return(nil)
 
+	s.expectNetworkPolicyDisabled(c, appName)
+
 	w := s.makeWorker(c, appUUID)
 
 	// Initial watcher event on startup
@@ -317,3 +380,118 @@ func (s *appFirewallerSuite) TestWorkerPortChanges(c *tc.C) {
 		tc.Commentf("expected clean worker shutdown on application removal"),
 	)
 }
+
+// TestWorkerNetworkPolicy asserts that the network policy of the application
+// follows its relations and exposed endpoints while the
+// relation-network-policy model config is enabled, and that it is removed once
+// the config is disabled.
+func (s *appFirewallerSuite) TestWorkerNetworkPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appName := "mysql"
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+
+	portsChangeCh := make(chan struct{})
+	portsWatcher := watchertest.NewMockNotifyWatcher(portsChangeCh)
+	relationsChangeCh, exposedChangeCh, configChangeCh := s.expectNetworkPolicyWatchers(appName)
+
+	s.applicationService.EXPECT().GetApplicationName(gomock.Any(), appUUID).Return(
+		appName, nil).AnyTimes()
+	s.applicationService.EXPECT().GetExposedEndpoints(gomock.Any(), appName).Return(
+		map[string]domainapplication.ExposedEndpoint{
+			"db": {ExposeToCIDRs: set.NewStrings("10.0.0.0/24")},
+			// Spaces are not supported by network policies.
+			"": {ExposeToSpaceIDs: set.NewStrings("alpha")},
+		}, nil,
+	).AnyTimes()
+
+	s.portService.EXPECT().WatchOpenedPortsForApplication(gomock.Any(), appUUID).Return(
+		portsWatcher, nil,
+	)
+	s.portService.EXPECT().GetApplicationOpenedPortsByEndpoint(gomock.Any(), appUUID).Return(
+		network.GroupedPortRanges{
+			"db": []network.PortRange{
+				network.MustParsePortRange("3306/tcp"),
+			},
+			"": []network.PortRange{
+				network.MustParsePortRange("icmp"),
+			},
+		}, nil,
+	).AnyTimes()
+
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return(
+		[]relation.RelationDetailsResult{{
+			ID:   1,
+			Life: life.Alive,
+			Endpoints: []relation.Endpoint{
+				{ApplicationName: "mysql", Relation: charm.Relation{Name: "db"}},
+				{ApplicationName: "wordpress", Relation: charm.Relation{Name: "db"}},
+			},
+		}, {
+			// Peer relation without any opened ports.
+			ID:   2,
+			Life: life.Alive,
+			Endpoints: []relation.Endpoint{
+				{ApplicationName: "mysql", Relation: charm.Relation{Name: "cluster"}},
+			},
+		}, {
+			ID:        3,
+			Life:      life.Alive,
+			Suspended: true,
+			Endpoints: []relation.Endpoint{
+				{ApplicationName: "mysql", Relation: charm.Relation{Name: "db"}},
+				{ApplicationName: "mediawiki", Relation: charm.Relation{Name: "db"}},
+			},
+		}, {
+			ID:   4,
+			Life: life.Alive,
+			Endpoints: []relation.Endpoint{
+				{ApplicationName: "wordpress", Relation: charm.Relation{Name: "cache"}},
+				{ApplicationName: "memcached", Relation: charm.Relation{Name: "cache"}},
+			},
+		}}, nil,
+	).AnyTimes()
+
+	// The policy is enabled for the first three events, then disabled.
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(
+		s.modelConfig(c, true), nil,
+	).Times(3)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(
+		s.modelConfig(c, false), nil,
+	)
+
+	s.broker.EXPECT().Application(appName, caas.DeploymentStateful).Return(s.brokerApp)
+
+	mysqlPort := caas.ServicePort{
+		Name:       "3306-tcp",
+		Port:       3306,
+		TargetPort: 3306,
+		Protocol:   "tcp",
+	}
+	s.brokerApp.EXPECT().UpdatePorts(gomock.Any(), false).Return(nil)
+	gomock.InOrder(
+		s.brokerApp.EXPECT().EnsureNetworkPolicy(caas.NetworkPolicy{
+			Ingress: []caas.NetworkPolicyIngressRule{{
+				Ports:        []caas.ServicePort{mysqlPort},
+				Applications: []string{"wordpress"},
+			}, {
+				Ports: []caas.ServicePort{mysqlPort},
+				CIDRs: []string{"10.0.0.0/24"},
+			}},
+		}).Return(nil),
+		s.brokerApp.EXPECT().DeleteNetworkPolicy().Return(nil),
+	)
+
+	w := s.makeWorker(c, appUUID)
+
+	// Initial watcher event on startup ensures the policy.
+	portsChangeCh <- struct{}{}
+	// Relations and exposed change events, the policy is unchanged.
+	relationsChangeCh <- struct{}{}
+	exposedChangeCh <- struct{}{}
+	// The policy is disabled.
+	configChangeCh <- []string{config.RelationNetworkPolicyKey}
+
+	w.Kill()
+	c.Check(w.Wait(), tc.ErrorIsNil)
+}
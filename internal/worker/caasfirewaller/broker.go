@@ -19,3 +19,15 @@ type PortMutator interface {
 	// matches the supplied values.
 	UpdatePorts(ports []caas.ServicePort, updateContainerPorts bool) error
 }
+
+// NetworkPolicyMutator describes the required interface for restricting the
+// inbound traffic of an application's units.
+type NetworkPolicyMutator interface {
+	// EnsureNetworkPolicy ensures that the inbound traffic of the
+	// application's units is restricted to the supplied policy.
+	EnsureNetworkPolicy(policy caas.NetworkPolicy) error
+
+	// DeleteNetworkPolicy removes any restriction of the inbound traffic
+	// of the application's units.
+	DeleteNetworkPolicy() error
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/watcher"
+	domainapplication "github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/relation"
+	"github.com/juju/juju/environs/config"
 )
 
 // PortService provides access to the port service.
@@ -42,4 +45,35 @@ type ApplicationService interface {
 	// WatchApplications returns a watcher that emits application uuids when
 	// applications are added or removed.
 	WatchApplications(context.Context) (watcher.StringsWatcher, error)
+
+	// GetExposedEndpoints returns map where keys are endpoint names (or the ""
+	// value which represents all endpoints) and values are ExposedEndpoint
+	// instances that specify which sources (spaces or CIDRs) can access the
+	// opened ports for each endpoint once the application is exposed.
+	GetExposedEndpoints(context.Context, string) (map[string]domainapplication.ExposedEndpoint, error)
+
+	// WatchApplicationExposed watches for changes to the specified
+	// application's exposed endpoints.
+	WatchApplicationExposed(context.Context, string) (watcher.NotifyWatcher, error)
+}
+
+// RelationService provides access to the relations of the model.
+type RelationService interface {
+	// GetAllRelationDetails returns the details of all the relations in the
+	// model.
+	GetAllRelationDetails(context.Context) ([]relation.RelationDetailsResult, error)
+
+	// WatchAllRelations returns a watcher that notifies when any relation
+	// in the model, or the units in scope of it, change.
+	WatchAllRelations(context.Context) (watcher.NotifyWatcher, error)
+}
+
+// ModelConfigService provides access to the model configuration.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(context.Context) (*config.Config, error)
+
+	// Watch returns a watcher that returns keys for any changes to model
+	// config.
+	Watch(context.Context) (watcher.StringsWatcher, error)
 }
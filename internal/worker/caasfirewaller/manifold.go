@@ -106,8 +106,10 @@ func (config ManifoldConfig) start(context context.Context, getter dependency.Ge
 		return config.NewAppFirewallWorker(
 			a, AppFirewallerConfig{
 				ApplicationService: domainServices.Application(),
-				PortService:        domainServices.Port(),
 				Broker:             broker,
+				ModelConfigService: domainServices.Config(),
+				PortService:        domainServices.Port(),
+				RelationService:    domainServices.Relation(),
 				Logger:             config.Logger,
 			},
 		)
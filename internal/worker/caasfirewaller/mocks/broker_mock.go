@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/caasfirewaller (interfaces: CAASBroker,PortMutator)
+// Source: github.com/juju/juju/internal/worker/caasfirewaller (interfaces: CAASBroker,NetworkPolicyMutator,PortMutator)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/broker_mock.go github.com/juju/juju/internal/worker/caasfirewaller CAASBroker,PortMutator
+//	mockgen -typed -package mocks -destination mocks/broker_mock.go github.com/juju/juju/internal/worker/caasfirewaller CAASBroker,NetworkPolicyMutator,PortMutator
 //
 
 // Package mocks is a generated GoMock package.
@@ -77,6 +77,105 @@ func (c *MockCAASBrokerApplicationCall) DoAndReturn(f func(string, caas.Deployme
 	return c
 }
 
+// MockNetworkPolicyMutator is a mock of NetworkPolicyMutator interface.
+type MockNetworkPolicyMutator struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkPolicyMutatorMockRecorder
+}
+
+// MockNetworkPolicyMutatorMockRecorder is the mock recorder for MockNetworkPolicyMutator.
+type MockNetworkPolicyMutatorMockRecorder struct {
+	mock *MockNetworkPolicyMutator
+}
+
+// NewMockNetworkPolicyMutator creates a new mock instance.
+func NewMockNetworkPolicyMutator(ctrl *gomock.Controller) *MockNetworkPolicyMutator {
+	mock := &MockNetworkPolicyMutator{ctrl: ctrl}
+	mock.recorder = &MockNetworkPolicyMutatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkPolicyMutator) EXPECT() *MockNetworkPolicyMutatorMockRecorder {
+	return m.recorder
+}
+
+// DeleteNetworkPolicy mocks base method.
+func (m *MockNetworkPolicyMutator) DeleteNetworkPolicy() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkPolicy")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetworkPolicy indicates an expected call of DeleteNetworkPolicy.
+func (mr *MockNetworkPolicyMutatorMockRecorder) DeleteNetworkPolicy() *MockNetworkPolicyMutatorDeleteNetworkPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkPolicy", reflect.TypeOf((*MockNetworkPolicyMutator)(nil).DeleteNetworkPolicy))
+	return &MockNetworkPolicyMutatorDeleteNetworkPolicyCall{Call: call}
+}
+
+// MockNetworkPolicyMutatorDeleteNetworkPolicyCall wrap *gomock.Call
+type MockNetworkPolicyMutatorDeleteNetworkPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkPolicyMutatorDeleteNetworkPolicyCall) Return(arg0 error) *MockNetworkPolicyMutatorDeleteNetworkPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkPolicyMutatorDeleteNetworkPolicyCall) Do(f func() error) *MockNetworkPolicyMutatorDeleteNetworkPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkPolicyMutatorDeleteNetworkPolicyCall) DoAndReturn(f func() error) *MockNetworkPolicyMutatorDeleteNetworkPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EnsureNetworkPolicy mocks base method.
+func (m *MockNetworkPolicyMutator) EnsureNetworkPolicy(arg0 caas.NetworkPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureNetworkPolicy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureNetworkPolicy indicates an expected call of EnsureNetworkPolicy.
+func (mr *MockNetworkPolicyMutatorMockRecorder) EnsureNetworkPolicy(arg0 any) *MockNetworkPolicyMutatorEnsureNetworkPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureNetworkPolicy", reflect.TypeOf((*MockNetworkPolicyMutator)(nil).EnsureNetworkPolicy), arg0)
+	return &MockNetworkPolicyMutatorEnsureNetworkPolicyCall{Call: call}
+}
+
+// MockNetworkPolicyMutatorEnsureNetworkPolicyCall wrap *gomock.Call
+type MockNetworkPolicyMutatorEnsureNetworkPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkPolicyMutatorEnsureNetworkPolicyCall) Return(arg0 error) *MockNetworkPolicyMutatorEnsureNetworkPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkPolicyMutatorEnsureNetworkPolicyCall) Do(f func(caas.NetworkPolicy) error) *MockNetworkPolicyMutatorEnsureNetworkPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkPolicyMutatorEnsureNetworkPolicyCall) DoAndReturn(f func(caas.NetworkPolicy) error) *MockNetworkPolicyMutatorEnsureNetworkPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockPortMutator is a mock of PortMutator interface.
 type MockPortMutator struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,193 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package caasfirewaller
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/juju/collections/set"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/internal/errors"
+)
+
+// networkPolicyCheckPoint records the network policy last applied to an
+// application.
+type networkPolicyCheckPoint struct {
+	// applied is true once a policy has been either ensured or deleted.
+	applied bool
+
+	// policy is the policy ensured for the application, or nil if the
+	// policy has been deleted.
+	policy *caas.NetworkPolicy
+}
+
+// ensureNetworkPolicy is responsible for making sure that the inbound traffic
+// of the application's units is restricted to its related applications and
+// exposed CIDRs when the relation-network-policy model config is enabled, and
+// unrestricted otherwise. This func returns the latest
+// [networkPolicyCheckPoint] applied to the application.
+func (w *appFirewaller) ensureNetworkPolicy(
+	ctx context.Context,
+	mutator NetworkPolicyMutator,
+	appName string,
+	lastCheckPoint networkPolicyCheckPoint,
+) (networkPolicyCheckPoint, error) {
+	cfg, err := w.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return networkPolicyCheckPoint{}, errors.Errorf("getting model config: %w", err)
+	}
+
+	var policy *caas.NetworkPolicy
+	if cfg.RelationNetworkPolicy() {
+		if policy, err = w.networkPolicy(ctx, appName); err != nil {
+			return networkPolicyCheckPoint{}, err
+		}
+	}
+
+	if lastCheckPoint.applied && reflect.DeepEqual(lastCheckPoint.policy, policy) {
+		w.logger.Debugf(ctx, "application %q network policy is up to date, no work to be performed", w.appUUID)
+		return lastCheckPoint, nil
+	}
+
+	if policy == nil {
+		w.logger.Debugf(ctx, "removing application %q network policy", w.appUUID)
+		err = mutator.DeleteNetworkPolicy()
+	} else {
+		w.logger.Infof(ctx, "applying application %q network policy changes", w.appUUID)
+		err = mutator.EnsureNetworkPolicy(*policy)
+	}
+	if err != nil {
+		return networkPolicyCheckPoint{}, errors.Errorf(
+			"updating application %q network policy in broker: %w", w.appUUID, err,
+		)
+	}
+
+	return networkPolicyCheckPoint{
+		applied: true,
+		policy:  policy,
+	}, nil
+}
+
+// networkPolicy returns the network policy accepting inbound traffic to the
+// application's opened ports from the applications related to it and from
+// the CIDRs it is exposed to.
+//
+// Kubernetes charms seldom open the ports they serve related applications
+// on, so when the application has no opened ports at all, related
+// applications are accepted on any port.
+func (w *appFirewaller) networkPolicy(ctx context.Context, appName string) (*caas.NetworkPolicy, error) {
+	portRanges, err := w.portService.GetApplicationOpenedPortsByEndpoint(ctx, w.appUUID)
+	if err != nil {
+		return nil, errors.Errorf("getting application %q opened ports: %w", w.appUUID, err)
+	}
+	portRanges = withoutICMP(portRanges)
+
+	relations, err := w.relationService.GetAllRelationDetails(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting relations: %w", err)
+	}
+	sort.Slice(relations, func(i, j int) bool { return relations[i].ID < relations[j].ID })
+
+	// The ingress rules are never nil, denying all inbound traffic if the
+	// application is neither related nor exposed.
+	policy := &caas.NetworkPolicy{
+		Ingress: []caas.NetworkPolicyIngressRule{},
+	}
+	for _, rel := range relations {
+		if rel.Life != life.Alive || rel.Suspended {
+			continue
+		}
+
+		var endpointName string
+		relatedApps := set.NewStrings()
+		for _, ep := range rel.Endpoints {
+			if ep.ApplicationName == appName {
+				endpointName = ep.Name
+			} else {
+				relatedApps.Add(ep.ApplicationName)
+			}
+		}
+		if endpointName == "" {
+			continue
+		}
+		if len(rel.Endpoints) == 1 {
+			// Units of a peer relation are related to each other.
+			relatedApps.Add(appName)
+		}
+
+		var ports []caas.ServicePort
+		if len(portRanges) > 0 {
+			ports = endpointServicePorts(portRanges, endpointName)
+			if len(ports) == 0 {
+				continue
+			}
+		}
+		policy.Ingress = append(policy.Ingress, caas.NetworkPolicyIngressRule{
+			Ports:        ports,
+			Applications: relatedApps.SortedValues(),
+		})
+	}
+
+	exposedEndpoints, err := w.applicationService.GetExposedEndpoints(ctx, appName)
+	if err != nil {
+		return nil, errors.Errorf("getting application %q exposed endpoints: %w", w.appUUID, err)
+	}
+	endpointNames := make([]string, 0, len(exposedEndpoints))
+	for endpointName := range exposedEndpoints {
+		endpointNames = append(endpointNames, endpointName)
+	}
+	sort.Strings(endpointNames)
+	for _, endpointName := range endpointNames {
+		// Spaces have no meaning in Kubernetes, only the CIDRs the
+		// application is exposed to are accepted.
+		cidrs := exposedEndpoints[endpointName].ExposeToCIDRs
+		if cidrs.IsEmpty() {
+			continue
+		}
+
+		var ports []caas.ServicePort
+		if endpointName == "" {
+			ports = toServicePorts(portRanges)
+		} else {
+			ports = endpointServicePorts(portRanges, endpointName)
+		}
+		if len(ports) == 0 {
+			continue
+		}
+		policy.Ingress = append(policy.Ingress, caas.NetworkPolicyIngressRule{
+			Ports: ports,
+			CIDRs: cidrs.SortedValues(),
+		})
+	}
+	return policy, nil
+}
+
+// endpointServicePorts returns the service ports opened for the endpoint,
+// including those opened for all endpoints.
+func endpointServicePorts(portRanges network.GroupedPortRanges, endpointName string) []caas.ServicePort {
+	return toServicePorts(network.GroupedPortRanges{
+		endpointName: portRanges[endpointName],
+		"":           portRanges[""],
+	})
+}
+
+// withoutICMP returns the port ranges without the ICMP ones, which cannot be
+// expressed in a network policy.
+func withoutICMP(in network.GroupedPortRanges) network.GroupedPortRanges {
+	out := make(network.GroupedPortRanges)
+	for endpointName, portRanges := range in {
+		for _, portRange := range portRanges {
+			if portRange.Protocol == "icmp" {
+				continue
+			}
+			out[endpointName] = append(out[endpointName], portRange)
+		}
+	}
+	return out
+}
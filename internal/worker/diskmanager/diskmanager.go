@@ -9,9 +9,11 @@ import (
 	"sort"
 	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/worker/v5"
 
 	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/core/storage"
 	internallogger "github.com/juju/juju/internal/logger"
 	jworker "github.com/juju/juju/internal/worker"
 )
@@ -44,10 +46,28 @@ var DefaultListBlockDevices ListBlockDevicesFunc
 
 // NewWorker returns a worker that lists block devices
 // attached to the machine, and records them in state.
-var NewWorker = func(l ListBlockDevicesFunc, b BlockDeviceSetter) worker.Worker {
+//
+// If a FilesystemUsageSetter is supplied, the worker also records
+// the usage of the filesystems mounted from those block devices.
+var NewWorker = func(l ListBlockDevicesFunc, b BlockDeviceSetter, u FilesystemUsageSetter) worker.Worker {
 	var old []blockdevice.BlockDevice
+	var oldUsage []storage.FilesystemUsage
 	f := func(ctx context.Context) error {
-		return doWork(ctx, l, b, &old)
+		if err := doWork(ctx, l, b, &old); err != nil {
+			return err
+		}
+		if u == nil {
+			return nil
+		}
+		err := doUsageWork(ctx, old, u, &oldUsage)
+		if errors.Is(err, errors.NotSupported) {
+			// The controller is too old to record filesystem
+			// usage, so stop trying.
+			logger.Infof(ctx, "not recording filesystem usage: %v", err)
+			u = nil
+			return nil
+		}
+		return err
 	}
 	return jworker.NewPeriodicWorker(f, listBlockDevicesPeriod, jworker.NewTimer)
 }
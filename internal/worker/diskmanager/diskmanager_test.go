@@ -8,9 +8,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/core/storage"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/diskmanager"
 )
@@ -41,7 +43,7 @@ func (s *DiskManagerWorkerSuite) TestWorker(c *tc.C) {
 		return []blockdevice.BlockDevice{{DeviceName: "whatever"}}, nil
 	}
 
-	w := diskmanager.NewWorker(listDevices, setDevices)
+	w := diskmanager.NewWorker(listDevices, setDevices, nil)
 	defer w.Wait()
 	defer w.Kill()
 
@@ -119,8 +121,129 @@ func (s *DiskManagerWorkerSuite) TestBlockDevicesSorted(c *tc.C) {
 	}}})
 }
 
+func (s *DiskManagerWorkerSuite) TestWorkerRecordsUsage(c *tc.C) {
+	s.PatchValue(diskmanager.FilesystemUsage, diskmanager.FilesystemUsageFunc(func(path string) (uint64, uint64, error) {
+		return 1024, 256, nil
+	}))
+
+	var setDevices BlockDeviceSetterFunc = func(context.Context, []blockdevice.BlockDevice) error {
+		return nil
+	}
+	done := make(chan []storage.FilesystemUsage, 1)
+	var setUsage FilesystemUsageSetterFunc = func(_ context.Context, usage []storage.FilesystemUsage) error {
+		done <- usage
+		return nil
+	}
+	var listDevices diskmanager.ListBlockDevicesFunc = func(context.Context) ([]blockdevice.BlockDevice, error) {
+		return []blockdevice.BlockDevice{{DeviceName: "sdb", MountPoint: "/srv/data"}}, nil
+	}
+
+	w := diskmanager.NewWorker(listDevices, setDevices, setUsage)
+	defer w.Wait()
+	defer w.Kill()
+
+	select {
+	case usage := <-done:
+		c.Check(usage, tc.DeepEquals, []storage.FilesystemUsage{{
+			MountPoint: "/srv/data",
+			SizeMiB:    1024,
+			UsedMiB:    256,
+		}})
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for diskmanager to record usage")
+	}
+}
+
+func (s *DiskManagerWorkerSuite) TestUsageChanges(c *tc.C) {
+	used := uint64(256)
+	s.PatchValue(diskmanager.FilesystemUsage, diskmanager.FilesystemUsageFunc(func(path string) (uint64, uint64, error) {
+		switch path {
+		case "/srv/data":
+			return 1024, used, nil
+		case "/srv/logs":
+			return 2048, 1024, nil
+		}
+		return 0, 0, errors.NotFoundf("mount point %q", path)
+	}))
+
+	var usageSet [][]storage.FilesystemUsage
+	var setUsage FilesystemUsageSetterFunc = func(_ context.Context, usage []storage.FilesystemUsage) error {
+		usageSet = append(usageSet, usage)
+		return nil
+	}
+
+	// Block devices without a mount point, or whose usage cannot be
+	// read, are not reported. Mount points are reported in order.
+	devices := []blockdevice.BlockDevice{
+		{DeviceName: "sda"},
+		{DeviceName: "sdc", MountPoint: "/srv/logs"},
+		{DeviceName: "sdb", MountPoint: "/srv/data"},
+		{DeviceName: "sdd", MountPoint: "/srv/gone"},
+	}
+	var oldUsage []storage.FilesystemUsage
+	err := diskmanager.DoUsageWork(c.Context(), devices, setUsage, &oldUsage)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(usageSet, tc.DeepEquals, [][]storage.FilesystemUsage{{
+		{MountPoint: "/srv/data", SizeMiB: 1024, UsedMiB: 256},
+		{MountPoint: "/srv/logs", SizeMiB: 2048, UsedMiB: 1024},
+	}})
+
+	// Unchanged usage is not reported again.
+	err = diskmanager.DoUsageWork(c.Context(), devices, setUsage, &oldUsage)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(usageSet, tc.HasLen, 1)
+
+	used = 512
+	err = diskmanager.DoUsageWork(c.Context(), devices, setUsage, &oldUsage)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(usageSet, tc.HasLen, 2)
+	c.Check(usageSet[1][0].UsedMiB, tc.Equals, uint64(512))
+}
+
+func (s *DiskManagerWorkerSuite) TestUsageNotSupported(c *tc.C) {
+	s.PatchValue(diskmanager.FilesystemUsage, diskmanager.FilesystemUsageFunc(func(path string) (uint64, uint64, error) {
+		return 1024, 256, nil
+	}))
+
+	listed := make(chan struct{}, 10)
+	var setDevices BlockDeviceSetterFunc = func(context.Context, []blockdevice.BlockDevice) error {
+		return nil
+	}
+	var usageCalls int
+	var setUsage FilesystemUsageSetterFunc = func(context.Context, []storage.FilesystemUsage) error {
+		usageCalls++
+		return errors.NotSupportedf("recording filesystem usage")
+	}
+	var listDevices diskmanager.ListBlockDevicesFunc = func(context.Context) ([]blockdevice.BlockDevice, error) {
+		listed <- struct{}{}
+		return []blockdevice.BlockDevice{{DeviceName: "sdb", MountPoint: "/srv/data"}}, nil
+	}
+
+	w := diskmanager.NewWorker(listDevices, setDevices, setUsage)
+	defer w.Wait()
+	defer w.Kill()
+
+	select {
+	case <-listed:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for diskmanager to list block devices")
+	}
+
+	// The worker keeps running when the controller does not support
+	// recording filesystem usage.
+	w.Kill()
+	c.Assert(w.Wait(), tc.ErrorIsNil)
+	c.Check(usageCalls, tc.Equals, 1)
+}
+
 type BlockDeviceSetterFunc func(context.Context, []blockdevice.BlockDevice) error
 
 func (f BlockDeviceSetterFunc) SetMachineBlockDevices(ctx context.Context, devices []blockdevice.BlockDevice) error {
 	return f(ctx, devices)
 }
+
+type FilesystemUsageSetterFunc func(context.Context, []storage.FilesystemUsage) error
+
+func (f FilesystemUsageSetterFunc) SetMachineFilesystemUsage(ctx context.Context, usage []storage.FilesystemUsage) error {
+	return f(ctx, usage)
+}
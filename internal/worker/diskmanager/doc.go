@@ -4,4 +4,8 @@
 // Package diskmanager defines a worker that periodically lists block devices
 // on the machine it runs on. This worker will be run on all Juju-managed
 // machines (one per machine agent).
+//
+// The worker also reports the usage of the filesystems mounted on the
+// machine. Units on Kubernetes models have no machine agent, so the usage of
+// their storage is not reported.
 package diskmanager
@@ -7,5 +7,7 @@ var (
 	ListBlockDevices = listBlockDevices
 	BlockDeviceInUse = &blockDeviceInUse
 	DoWork           = doWork
+	DoUsageWork      = doUsageWork
+	FilesystemUsage  = &filesystemUsage
 	NewWorkerFunc    = newWorker
 )
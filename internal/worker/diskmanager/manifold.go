@@ -37,5 +37,5 @@ func newWorker(_ context.Context, a agent.Agent, apiCaller base.APICaller) (work
 
 	api := apidiskmanager.NewState(apiCaller, tag)
 
-	return NewWorker(DefaultListBlockDevices, api), nil
+	return NewWorker(DefaultListBlockDevices, api, api), nil
 }
@@ -42,7 +42,7 @@ func (s *manifoldSuite) TestMachineDiskmanager(c *tc.C) {
 			return nil
 		})
 
-	s.PatchValue(&diskmanager.NewWorker, func(l diskmanager.ListBlockDevicesFunc, b diskmanager.BlockDeviceSetter) worker.Worker {
+	s.PatchValue(&diskmanager.NewWorker, func(l diskmanager.ListBlockDevicesFunc, b diskmanager.BlockDeviceSetter, u diskmanager.FilesystemUsageSetter) worker.Worker {
 		called = true
 
 		c.Assert(l, tc.FitsTypeOf, diskmanager.DefaultListBlockDevices)
@@ -51,6 +51,7 @@ func (s *manifoldSuite) TestMachineDiskmanager(c *tc.C) {
 		api, ok := b.(*apidiskmanager.State)
 		c.Assert(ok, tc.IsTrue)
 		c.Assert(api, tc.NotNil)
+		c.Assert(u, tc.Equals, api)
 
 		return nil
 	})
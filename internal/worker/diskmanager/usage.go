@@ -0,0 +1,80 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package diskmanager
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/core/storage"
+)
+
+// FilesystemUsageSetter is an interface that is supplied to
+// NewWorker for recording the usage of filesystems mounted on
+// the local host.
+type FilesystemUsageSetter interface {
+	SetMachineFilesystemUsage(context.Context, []storage.FilesystemUsage) error
+}
+
+// FilesystemUsageFunc is the type of a function that returns the
+// total size and the used space, in MiB, of the filesystem mounted
+// at the given path.
+type FilesystemUsageFunc func(path string) (sizeMiB, usedMiB uint64, err error)
+
+// filesystemUsage is the function used to measure filesystem usage
+// on the local host. It is nil on operating systems where filesystem
+// usage is not measured.
+var filesystemUsage FilesystemUsageFunc
+
+// doUsageWork measures the usage of the filesystems mounted from the
+// given block devices, and records it if it has changed since the last
+// time it was recorded.
+func doUsageWork(
+	ctx context.Context,
+	blockDevices []blockdevice.BlockDevice,
+	u FilesystemUsageSetter,
+	old *[]storage.FilesystemUsage,
+) error {
+	if filesystemUsage == nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	var usage []storage.FilesystemUsage
+	for _, blockDevice := range blockDevices {
+		mountPoint := blockDevice.MountPoint
+		if mountPoint == "" || seen[mountPoint] {
+			continue
+		}
+		seen[mountPoint] = true
+
+		sizeMiB, usedMiB, err := filesystemUsage(mountPoint)
+		if err != nil {
+			// The filesystem may have been unmounted since the
+			// block devices were listed; it will be picked up
+			// again on the next listing if it is still there.
+			logger.Debugf(ctx, "cannot get usage of filesystem mounted at %q: %v", mountPoint, err)
+			continue
+		}
+		usage = append(usage, storage.FilesystemUsage{
+			MountPoint: mountPoint,
+			SizeMiB:    sizeMiB,
+			UsedMiB:    usedMiB,
+		})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		return usage[i].MountPoint < usage[j].MountPoint
+	})
+	if reflect.DeepEqual(usage, *old) {
+		logger.Tracef(ctx, "no changes to filesystem usage detected")
+		return nil
+	}
+	logger.Tracef(ctx, "filesystem usage changed: %#v", usage)
+	if err := u.SetMachineFilesystemUsage(ctx, usage); err != nil {
+		return err
+	}
+	*old = usage
+	return nil
+}
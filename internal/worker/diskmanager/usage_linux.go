@@ -0,0 +1,31 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+//go:build linux
+
+package diskmanager
+
+import (
+	"github.com/juju/errors"
+	"golang.org/x/sys/unix"
+)
+
+func init() {
+	filesystemUsage = statfsUsage
+}
+
+// statfsUsage returns the total size and used space, in MiB, of the
+// filesystem mounted at the given path, as df(1) would report them.
+func statfsUsage(path string) (uint64, uint64, error) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(path, &st); err != nil {
+		return 0, 0, errors.Trace(err)
+	}
+	blockSize := uint64(st.Frsize)
+	if blockSize == 0 {
+		blockSize = uint64(st.Bsize)
+	}
+	size := st.Blocks * blockSize
+	free := st.Bfree * blockSize
+	return size / bytesInMiB, (size - free) / bytesInMiB, nil
+}
@@ -0,0 +1,20 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package dnsresponder provides a worker that answers DNS queries for the
+// names of the applications and units of the controller's models.
+//
+// The responder is enabled by setting the controller.DNSListenAddress
+// controller config key, and listens for queries over both UDP and TCP on
+// that address. Names are published under the "juju" domain, as described
+// by the [dns] package. The responder is authoritative for the domain, and
+// refuses queries for any other name, so it is meant to be used as the
+// forwarder for the domain by the resolvers of the model machines or of
+// the wider network.
+//
+// Addresses are read from the model databases on demand, and cached for
+// the TTL of the records served. A model name shared by more than one
+// model, under different qualifiers, is not resolved.
+//
+// The worker runs on every controller.
+package dnsresponder
@@ -0,0 +1,128 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsresponder
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+
+	"github.com/juju/juju/core/logger"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/network/dns"
+	"github.com/juju/juju/internal/services"
+)
+
+// ManifoldConfig describes the resources used by the DNS responder worker.
+type ManifoldConfig struct {
+	DomainServicesName string
+
+	// GetControllerConfigService is used to extract the controller config
+	// service from the domain services dependency.
+	GetControllerConfigService func(getter dependency.Getter, name string) (ControllerConfigService, error)
+
+	// NewResolver is used to create the resolver looking up the names of
+	// the controller's models from the domain services dependency.
+	NewResolver func(getter dependency.Getter, name string, clock clock.Clock) (dns.Resolver, error)
+
+	NewWorker func(Config) (worker.Worker, error)
+	Clock     clock.Clock
+	Logger    logger.Logger
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.DomainServicesName == "" {
+		return errors.NotValidf("empty DomainServicesName")
+	}
+	if config.GetControllerConfigService == nil {
+		return errors.NotValidf("nil GetControllerConfigService")
+	}
+	if config.NewResolver == nil {
+		return errors.NotValidf("nil NewResolver")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// start starts the DNS responder worker.
+func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	controllerConfigService, err := config.GetControllerConfigService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	resolver, err := config.NewResolver(getter, config.DomainServicesName, config.Clock)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := config.NewWorker(Config{
+		ControllerConfigService: controllerConfigService,
+		Resolver:                resolver,
+		Logger:                  config.Logger,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Manifold returns a Manifold that encapsulates the DNS responder worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.DomainServicesName,
+		},
+		Start: config.start,
+	}
+}
+
+// GetControllerConfigService extracts the controller config service from the
+// controller domain services dependency.
+func GetControllerConfigService(getter dependency.Getter, name string) (ControllerConfigService, error) {
+	var services services.ControllerDomainServices
+	if err := getter.Get(name, &services); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return services.ControllerConfig(), nil
+}
+
+// NewModelResolver returns a resolver looking up the names of the
+// controller's models, using the model service from the controller domain
+// services dependency and the network service of each model from the
+// domain services getter.
+func NewModelResolver(getter dependency.Getter, name string, clock clock.Clock) (dns.Resolver, error) {
+	var controllerServices services.ControllerDomainServices
+	if err := getter.Get(name, &controllerServices); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var servicesGetter services.DomainServicesGetter
+	if err := getter.Get(name, &servicesGetter); err != nil {
+		return nil, errors.Trace(err)
+	}
+	getNetworkService := func(ctx context.Context, modelUUID coremodel.UUID) (NetworkService, error) {
+		modelServices, err := servicesGetter.ServicesForModel(ctx, modelUUID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return modelServices.Network(), nil
+	}
+	return NewResolver(controllerServices.Model(), getNetworkService, clock), nil
+}
@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsresponder
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package dnsresponder -destination watcher_mock_test.go github.com/juju/juju/core/watcher StringsWatcher
+//go:generate go run go.uber.org/mock/mockgen -typed -package dnsresponder -destination services_mock_test.go github.com/juju/juju/internal/worker/dnsresponder ControllerConfigService,ModelService,NetworkService
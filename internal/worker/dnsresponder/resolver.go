@@ -0,0 +1,169 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsresponder
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/core/life"
+	coremodel "github.com/juju/juju/core/model"
+	domainnetwork "github.com/juju/juju/domain/network"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/network/dns"
+)
+
+// cacheTTL is how long the models of the controller, and the records of
+// each model, are cached for. It matches the TTL of the records served.
+const cacheTTL = dns.TTL * time.Second
+
+// ModelService provides access to the models of the controller.
+type ModelService interface {
+	// GetAllModels returns all the models in the controller.
+	GetAllModels(ctx context.Context) ([]coremodel.Model, error)
+}
+
+// NetworkService provides the addresses of a model's units.
+type NetworkService interface {
+	// GetApplicationAddresses returns, for each application in the model,
+	// the addresses of its units in the space that the application's
+	// default endpoint binding is bound to.
+	GetApplicationAddresses(ctx context.Context) ([]domainnetwork.ApplicationAddresses, error)
+}
+
+// NetworkServiceGetter returns the network service of a model.
+type NetworkServiceGetter func(ctx context.Context, modelUUID coremodel.UUID) (NetworkService, error)
+
+// modelRecords are the cached records of a model.
+type modelRecords struct {
+	records map[string][]net.IP
+	expiry  time.Time
+}
+
+// modelResolver is a dns.Resolver looking up the names of the applications
+// and units of the controller's models.
+type modelResolver struct {
+	modelService      ModelService
+	getNetworkService NetworkServiceGetter
+	clock             clock.Clock
+
+	// mu guards the fields below it.
+	mu           sync.Mutex
+	models       map[string][]coremodel.Model
+	modelsExpiry time.Time
+	records      map[coremodel.UUID]modelRecords
+}
+
+// NewResolver returns a dns.Resolver looking up the names of the
+// applications and units of the controller's models, published under the
+// default domain.
+func NewResolver(modelService ModelService, getNetworkService NetworkServiceGetter, clock clock.Clock) dns.Resolver {
+	return &modelResolver{
+		modelService:      modelService,
+		getNetworkService: getNetworkService,
+		clock:             clock,
+		records:           make(map[coremodel.UUID]modelRecords),
+	}
+}
+
+// Lookup is part of the dns.Resolver interface. Names of models that are
+// not alive, or whose name is shared by more than one model, are not found.
+func (r *modelResolver) Lookup(ctx context.Context, name string) ([]net.IP, bool, error) {
+	domain := dns.Name(dns.DefaultDomain)
+	if !dns.InDomain(name, domain) {
+		return nil, false, nil
+	}
+	name = dns.Name(name)
+	labels := strings.Split(strings.TrimSuffix(name, "."+domain), ".")
+	if name == domain || len(labels) > 3 {
+		return nil, false, nil
+	}
+	modelName := labels[len(labels)-1]
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	model, found, err := r.model(ctx, modelName)
+	if err != nil || !found {
+		return nil, false, errors.Capture(err)
+	}
+	if len(labels) == 1 {
+		// The model name is published, but resolves to no addresses.
+		return nil, true, nil
+	}
+
+	records, err := r.modelRecords(ctx, model)
+	if err != nil {
+		return nil, false, errors.Capture(err)
+	}
+	addrs, found := records[name]
+	return addrs, found, nil
+}
+
+// model returns the alive model with the input name, if there is exactly
+// one such model.
+func (r *modelResolver) model(ctx context.Context, name string) (coremodel.Model, bool, error) {
+	now := r.clock.Now()
+	if r.models == nil || !now.Before(r.modelsExpiry) {
+		models, err := r.modelService.GetAllModels(ctx)
+		if err != nil {
+			return coremodel.Model{}, false, errors.Errorf("getting models: %w", err)
+		}
+		r.models = make(map[string][]coremodel.Model)
+		for _, model := range models {
+			if model.Life != life.Alive {
+				continue
+			}
+			key := strings.ToLower(model.Name)
+			r.models[key] = append(r.models[key], model)
+		}
+		r.modelsExpiry = now.Add(cacheTTL)
+	}
+
+	models := r.models[name]
+	if len(models) != 1 {
+		return coremodel.Model{}, false, nil
+	}
+	return models[0], true, nil
+}
+
+// modelRecords returns the records of the model, keyed by name.
+func (r *modelResolver) modelRecords(ctx context.Context, model coremodel.Model) (map[string][]net.IP, error) {
+	now := r.clock.Now()
+	if cached, ok := r.records[model.UUID]; ok && now.Before(cached.expiry) {
+		return cached.records, nil
+	}
+
+	networkService, err := r.getNetworkService(ctx, model.UUID)
+	if err != nil {
+		return nil, errors.Errorf("getting network service for model %q: %w", model.Name, err)
+	}
+	apps, err := networkService.GetApplicationAddresses(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting application addresses for model %q: %w", model.Name, err)
+	}
+
+	records := make(map[string][]net.IP)
+	for _, record := range dns.ModelRecords(dns.DefaultDomain, model.Name, apps) {
+		records[record.Name] = record.Addresses
+	}
+
+	// Drop the expired records of other models, so that the records of
+	// removed models do not build up.
+	for uuid, cached := range r.records {
+		if !now.Before(cached.expiry) {
+			delete(r.records, uuid)
+		}
+	}
+	r.records[model.UUID] = modelRecords{
+		records: records,
+		expiry:  now.Add(cacheTTL),
+	}
+	return records, nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsresponder
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/life"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/unit"
+	domainnetwork "github.com/juju/juju/domain/network"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/network/dns"
+)
+
+type resolverSuite struct {
+	modelService   *MockModelService
+	networkService *MockNetworkService
+	clock          *testclock.Clock
+}
+
+func TestResolverSuite(t *testing.T) {
+	tc.Run(t, &resolverSuite{})
+}
+
+func (s *resolverSuite) TestLookup(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModels(coremodel.Model{Name: "foo", UUID: "foo-uuid", Life: life.Alive})
+	s.expectApplicationAddresses()
+
+	resolver := s.newResolver()
+
+	addrs, found, err := resolver.Lookup(c.Context(), "0.mysql.foo.juju.")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(found, tc.IsTrue)
+	c.Check(addrs, tc.DeepEquals, []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("fd00::1")})
+
+	// Both the models and the records are cached.
+	addrs, found, err = resolver.Lookup(c.Context(), "MySQL.Foo.Juju")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(found, tc.IsTrue)
+	c.Check(addrs, tc.DeepEquals, []net.IP{
+		net.ParseIP("10.0.0.1"), net.ParseIP("fd00::1"), net.ParseIP("10.0.0.2"),
+	})
+
+	addrs, found, err = resolver.Lookup(c.Context(), "foo.juju.")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(found, tc.IsTrue)
+	c.Check(addrs, tc.HasLen, 0)
+
+	_, found, err = resolver.Lookup(c.Context(), "2.mysql.foo.juju.")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(found, tc.IsFalse)
+}
+
+func (s *resolverSuite) TestLookupCacheExpires(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModels(coremodel.Model{Name: "foo", UUID: "foo-uuid", Life: life.Alive})
+	s.expectApplicationAddresses()
+
+	resolver := s.newResolver()
+	_, found, err := resolver.Lookup(c.Context(), "mysql.foo.juju.")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(found, tc.IsTrue)
+
+	s.clock.Advance(cacheTTL)
+
+	s.expectModels(coremodel.Model{Name: "foo", UUID: "foo-uuid", Life: life.Alive})
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).Return(nil, nil)
+
+	_, found, err = resolver.Lookup(c.Context(), "mysql.foo.juju.")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(found, tc.IsFalse)
+}
+
+func (s *resolverSuite) TestLookupNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModels(
+		coremodel.Model{Name: "foo", UUID: "foo-uuid", Qualifier: "prod", Life: life.Alive},
+		coremodel.Model{Name: "foo", UUID: "foo-uuid2", Qualifier: "staging", Life: life.Alive},
+		coremodel.Model{Name: "bar", UUID: "bar-uuid", Life: life.Dying},
+	)
+
+	resolver := s.newResolver()
+	for _, name := range []string{
+		// Ambiguous model name.
+		"mysql.foo.juju.",
+		// Model not alive.
+		"mysql.bar.juju.",
+		// Unknown model.
+		"mysql.baz.juju.",
+		// Too many labels.
+		"a.0.mysql.foo.juju.",
+		// The domain itself.
+		"juju.",
+		// Outside of the domain.
+		"example.com.",
+	} {
+		_, found, err := resolver.Lookup(c.Context(), name)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(found, tc.IsFalse, tc.Commentf("%s", name))
+	}
+}
+
+func (s *resolverSuite) TestLookupError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModels(coremodel.Model{Name: "foo", UUID: "foo-uuid", Life: life.Alive})
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).Return(nil, errors.New("boom"))
+
+	_, _, err := s.newResolver().Lookup(c.Context(), "mysql.foo.juju.")
+	c.Assert(err, tc.ErrorMatches, `getting application addresses for model "foo": boom`)
+}
+
+func (s *resolverSuite) expectModels(models ...coremodel.Model) {
+	s.modelService.EXPECT().GetAllModels(gomock.Any()).Return(models, nil)
+}
+
+func (s *resolverSuite) expectApplicationAddresses() {
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).Return([]domainnetwork.ApplicationAddresses{{
+		ApplicationName: "mysql",
+		Units: []domainnetwork.UnitAddresses{
+			{UnitName: unit.Name("mysql/0"), Addresses: []string{"10.0.0.1", "fd00::1"}},
+			{UnitName: unit.Name("mysql/1"), Addresses: []string{"10.0.0.2"}},
+		},
+	}}, nil)
+}
+
+func (s *resolverSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.modelService = NewMockModelService(ctrl)
+	s.networkService = NewMockNetworkService(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+	return ctrl
+}
+
+func (s *resolverSuite) newResolver() dns.Resolver {
+	return NewResolver(s.modelService, func(ctx context.Context, modelUUID coremodel.UUID) (NetworkService, error) {
+		return s.networkService, nil
+	}, s.clock)
+}
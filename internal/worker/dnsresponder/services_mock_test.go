@@ -0,0 +1,246 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/dnsresponder (interfaces: ControllerConfigService,ModelService,NetworkService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package dnsresponder -destination services_mock_test.go github.com/juju/juju/internal/worker/dnsresponder ControllerConfigService,ModelService,NetworkService
+//
+
+// Package dnsresponder is a generated GoMock package.
+package dnsresponder
+
+import (
+	context "context"
+	reflect "reflect"
+
+	controller "github.com/juju/juju/controller"
+	model "github.com/juju/juju/core/model"
+	watcher "github.com/juju/juju/core/watcher"
+	network "github.com/juju/juju/domain/network"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock *MockControllerConfigService
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(arg0 context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig", arg0)
+	ret0, _ := ret[0].(controller.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(arg0 any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).ControllerConfig), arg0)
+	return &MockControllerConfigServiceControllerConfigCall{Call: call}
+}
+
+// MockControllerConfigServiceControllerConfigCall wrap *gomock.Call
+type MockControllerConfigServiceControllerConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerConfigServiceControllerConfigCall) Return(arg0 controller.Config, arg1 error) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerConfigServiceControllerConfigCall) Do(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerConfigServiceControllerConfigCall) DoAndReturn(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchControllerConfig mocks base method.
+func (m *MockControllerConfigService) WatchControllerConfig(arg0 context.Context) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchControllerConfig", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchControllerConfig indicates an expected call of WatchControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) WatchControllerConfig(arg0 any) *MockControllerConfigServiceWatchControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).WatchControllerConfig), arg0)
+	return &MockControllerConfigServiceWatchControllerConfigCall{Call: call}
+}
+
+// MockControllerConfigServiceWatchControllerConfigCall wrap *gomock.Call
+type MockControllerConfigServiceWatchControllerConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerConfigServiceWatchControllerConfigCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockControllerConfigServiceWatchControllerConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerConfigServiceWatchControllerConfigCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockControllerConfigServiceWatchControllerConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerConfigServiceWatchControllerConfigCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockControllerConfigServiceWatchControllerConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelService is a mock of ModelService interface.
+type MockModelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelServiceMockRecorder
+}
+
+// MockModelServiceMockRecorder is the mock recorder for MockModelService.
+type MockModelServiceMockRecorder struct {
+	mock *MockModelService
+}
+
+// NewMockModelService creates a new mock instance.
+func NewMockModelService(ctrl *gomock.Controller) *MockModelService {
+	mock := &MockModelService{ctrl: ctrl}
+	mock.recorder = &MockModelServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelService) EXPECT() *MockModelServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllModels mocks base method.
+func (m *MockModelService) GetAllModels(arg0 context.Context) ([]model.Model, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllModels", arg0)
+	ret0, _ := ret[0].([]model.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllModels indicates an expected call of GetAllModels.
+func (mr *MockModelServiceMockRecorder) GetAllModels(arg0 any) *MockModelServiceGetAllModelsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllModels", reflect.TypeOf((*MockModelService)(nil).GetAllModels), arg0)
+	return &MockModelServiceGetAllModelsCall{Call: call}
+}
+
+// MockModelServiceGetAllModelsCall wrap *gomock.Call
+type MockModelServiceGetAllModelsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelServiceGetAllModelsCall) Return(arg0 []model.Model, arg1 error) *MockModelServiceGetAllModelsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelServiceGetAllModelsCall) Do(f func(context.Context) ([]model.Model, error)) *MockModelServiceGetAllModelsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelServiceGetAllModelsCall) DoAndReturn(f func(context.Context) ([]model.Model, error)) *MockModelServiceGetAllModelsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockNetworkService is a mock of NetworkService interface.
+type MockNetworkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkServiceMockRecorder
+}
+
+// MockNetworkServiceMockRecorder is the mock recorder for MockNetworkService.
+type MockNetworkServiceMockRecorder struct {
+	mock *MockNetworkService
+}
+
+// NewMockNetworkService creates a new mock instance.
+func NewMockNetworkService(ctrl *gomock.Controller) *MockNetworkService {
+	mock := &MockNetworkService{ctrl: ctrl}
+	mock.recorder = &MockNetworkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkService) EXPECT() *MockNetworkServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationAddresses mocks base method.
+func (m *MockNetworkService) GetApplicationAddresses(arg0 context.Context) ([]network.ApplicationAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAddresses", arg0)
+	ret0, _ := ret[0].([]network.ApplicationAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAddresses indicates an expected call of GetApplicationAddresses.
+func (mr *MockNetworkServiceMockRecorder) GetApplicationAddresses(arg0 any) *MockNetworkServiceGetApplicationAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAddresses", reflect.TypeOf((*MockNetworkService)(nil).GetApplicationAddresses), arg0)
+	return &MockNetworkServiceGetApplicationAddressesCall{Call: call}
+}
+
+// MockNetworkServiceGetApplicationAddressesCall wrap *gomock.Call
+type MockNetworkServiceGetApplicationAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceGetApplicationAddressesCall) Return(arg0 []network.ApplicationAddresses, arg1 error) *MockNetworkServiceGetApplicationAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceGetApplicationAddressesCall) Do(f func(context.Context) ([]network.ApplicationAddresses, error)) *MockNetworkServiceGetApplicationAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceGetApplicationAddressesCall) DoAndReturn(f func(context.Context) ([]network.ApplicationAddresses, error)) *MockNetworkServiceGetApplicationAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
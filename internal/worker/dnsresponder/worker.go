@@ -0,0 +1,272 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsresponder
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/network/dns"
+)
+
+// ControllerConfigService is an interface that provides access to the
+// controller configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller config.
+	ControllerConfig(context.Context) (controller.Config, error)
+	// WatchControllerConfig returns a watcher that returns keys for any
+	// changes to controller config.
+	WatchControllerConfig(context.Context) (watcher.StringsWatcher, error)
+}
+
+// Config is the configuration for the DNS responder worker.
+type Config struct {
+	ControllerConfigService ControllerConfigService
+	Resolver                dns.Resolver
+	Logger                  logger.Logger
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.ControllerConfigService == nil {
+		return errors.Errorf("nil ControllerConfigService").Add(coreerrors.NotValid)
+	}
+	if config.Resolver == nil {
+		return errors.Errorf("nil Resolver").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// responderWorker is a worker that answers DNS queries for the names of the
+// applications and units of the controller's models.
+type responderWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+	server   *dns.Server
+
+	// The following are only accessed from the loop goroutine.
+	listenAddress string
+	listener      *listenerWorker
+
+	// mu guards the fields below it, which are only used for reporting.
+	mu      sync.Mutex
+	address string
+}
+
+// NewWorker returns a new DNS responder worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &responderWorker{
+		config: config,
+		server: dns.NewServer(dns.DefaultDomain, config.Resolver, config.Logger),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "dns-responder",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *responderWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *responderWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *responderWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]any{
+		"address": w.address,
+	}
+}
+
+// loop is the worker's main loop. It watches for changes to the controller
+// configuration, and starts, replaces or stops the listeners accordingly.
+func (w *responderWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	watch, err := w.config.ControllerConfigService.WatchControllerConfig(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := w.catacomb.Add(watch); err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := w.updateConfig(ctx); err != nil {
+		return errors.Capture(err)
+	}
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+
+		case keys, ok := <-watch.Changes():
+			if !ok {
+				return errors.New("controller config watcher closed")
+			}
+			if !set.NewStrings(keys...).Contains(controller.DNSListenAddress) {
+				continue
+			}
+			if err := w.updateConfig(ctx); err != nil {
+				return errors.Capture(err)
+			}
+		}
+	}
+}
+
+// updateConfig reads the listen address from controller config and
+// replaces the listeners if it has changed.
+func (w *responderWorker) updateConfig(ctx context.Context) error {
+	cfg, err := w.config.ControllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return errors.Errorf("getting controller config: %w", err)
+	}
+	listenAddress := cfg.DNSListenAddress()
+	if w.listener != nil && listenAddress == w.listenAddress {
+		return nil
+	}
+
+	if w.listener != nil {
+		if err := worker.Stop(w.listener); err != nil {
+			return errors.Capture(err)
+		}
+		w.listener = nil
+	}
+	w.listenAddress = listenAddress
+	w.setAddress("")
+
+	if listenAddress == "" {
+		w.config.Logger.Debugf(ctx, "DNS responder disabled")
+		return nil
+	}
+
+	listener, err := newListenerWorker(w.server, listenAddress)
+	if err != nil {
+		return errors.Errorf("listening on %q: %w", listenAddress, err)
+	}
+	if err := w.catacomb.Add(listener); err != nil {
+		return errors.Capture(err)
+	}
+	w.listener = listener
+	w.setAddress(listener.address)
+	w.config.Logger.Infof(ctx, "answering DNS queries for %q on %s", dns.DefaultDomain, listener.address)
+	return nil
+}
+
+func (w *responderWorker) setAddress(address string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.address = address
+}
+
+// listenerWorker serves DNS queries received over both UDP and TCP on an
+// address.
+type listenerWorker struct {
+	catacomb catacomb.Catacomb
+	server   *dns.Server
+	address  string
+
+	packetConn net.PacketConn
+	listener   net.Listener
+}
+
+func newListenerWorker(server *dns.Server, address string) (*listenerWorker, error) {
+	packetConn, err := net.ListenPacket("udp", address)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	// Listen for TCP on the port chosen for UDP, so that both use the same
+	// port when the configured port is 0.
+	address = packetConn.LocalAddr().String()
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		_ = packetConn.Close()
+		return nil, errors.Capture(err)
+	}
+
+	w := &listenerWorker{
+		server:     server,
+		address:    address,
+		packetConn: packetConn,
+		listener:   listener,
+	}
+	err = catacomb.Invoke(catacomb.Plan{
+		Name: "dns-listener",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	if err != nil {
+		w.close()
+		return nil, errors.Capture(err)
+	}
+	return w, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *listenerWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *listenerWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *listenerWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	serve := func(f func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- f()
+		}()
+	}
+	serve(func() error { return w.server.ServePacketConn(ctx, w.packetConn) })
+	serve(func() error { return w.server.ServeListener(ctx, w.listener) })
+
+	var err error
+	select {
+	case <-w.catacomb.Dying():
+		err = w.catacomb.ErrDying()
+	case err = <-errs:
+		if err == nil {
+			err = errors.New("DNS listener stopped")
+		}
+	}
+	w.close()
+	wg.Wait()
+	return err
+}
+
+func (w *listenerWorker) close() {
+	_ = w.packetConn.Close()
+	_ = w.listener.Close()
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsresponder
+
+import (
+	"context"
+	"net"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/juju/juju/controller"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+)
+
+type workerSuite struct {
+	testing.BaseSuite
+
+	controllerConfigService *MockControllerConfigService
+	watcher                 *MockStringsWatcher
+	changes                 chan []string
+}
+
+func TestWorkerSuite(t *stdtesting.T) {
+	tc.Run(t, &workerSuite{})
+}
+
+func (s *workerSuite) TestValidateConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newConfig(c)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	cfg = s.newConfig(c)
+	cfg.ControllerConfigService = nil
+	c.Check(cfg.Validate(), tc.ErrorMatches, "nil ControllerConfigService.*")
+
+	cfg = s.newConfig(c)
+	cfg.Resolver = nil
+	c.Check(cfg.Validate(), tc.ErrorMatches, "nil Resolver.*")
+
+	cfg = s.newConfig(c)
+	cfg.Logger = nil
+	c.Check(cfg.Validate(), tc.ErrorMatches, "nil Logger.*")
+}
+
+func (s *workerSuite) TestDisabled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	called := s.expectControllerConfig(c, "")
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	select {
+	case <-called:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for controller config")
+	}
+	workertest.CheckAlive(c, w)
+	s.waitForAddress(c, w, func(address string) bool { return address == "" })
+}
+
+func (s *workerSuite) TestAnswersQueries(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerConfig(c, "127.0.0.1:0")
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	address := s.waitForAddress(c, w, func(address string) bool { return address != "" })
+	s.checkQuery(c, "udp", address)
+	s.checkQuery(c, "tcp", address)
+}
+
+func (s *workerSuite) TestListenAddressChanged(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerConfig(c, "127.0.0.1:0")
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	address := s.waitForAddress(c, w, func(address string) bool { return address != "" })
+
+	// Unrelated changes are ignored.
+	s.sendChange(c, controller.APIPort)
+
+	s.expectControllerConfig(c, "")
+	s.sendChange(c, controller.DNSListenAddress)
+	s.waitForAddress(c, w, func(address string) bool { return address == "" })
+
+	// The previous listener is closed.
+	conn, err := net.Dial("tcp", address)
+	if err == nil {
+		_ = conn.Close()
+		c.Fatalf("listener on %s still open", address)
+	}
+}
+
+func (s *workerSuite) checkQuery(c *tc.C, network, address string) {
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: 42})
+	c.Assert(b.StartQuestions(), tc.ErrorIsNil)
+	c.Assert(b.Question(dnsmessage.Question{
+		Name:  dnsmessage.MustNewName("0.mysql.foo.juju."),
+		Type:  dnsmessage.TypeA,
+		Class: dnsmessage.ClassINET,
+	}), tc.ErrorIsNil)
+	req, err := b.Finish()
+	c.Assert(err, tc.ErrorIsNil)
+
+	conn, err := net.Dial(network, address)
+	c.Assert(err, tc.ErrorIsNil)
+	defer conn.Close()
+	c.Assert(conn.SetDeadline(time.Now().Add(testing.LongWait)), tc.ErrorIsNil)
+
+	if network == "tcp" {
+		req = append([]byte{byte(len(req) >> 8), byte(len(req))}, req...)
+	}
+	_, err = conn.Write(req)
+	c.Assert(err, tc.ErrorIsNil)
+
+	buf := make([]byte, 512)
+	n, err := conn.Read(buf)
+	c.Assert(err, tc.ErrorIsNil)
+	resp := buf[:n]
+	if network == "tcp" {
+		resp = resp[2:]
+	}
+
+	var msg dnsmessage.Message
+	c.Assert(msg.Unpack(resp), tc.ErrorIsNil)
+	c.Check(msg.Header.ID, tc.Equals, uint16(42))
+	c.Check(msg.Header.RCode, tc.Equals, dnsmessage.RCodeSuccess)
+	c.Assert(msg.Answers, tc.HasLen, 1)
+	c.Check(msg.Answers[0].Body, tc.DeepEquals, &dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}})
+}
+
+func (s *workerSuite) waitForAddress(c *tc.C, w worker.Worker, match func(string) bool) string {
+	reporter, ok := w.(worker.Reporter)
+	c.Assert(ok, tc.IsTrue)
+	timeout := time.After(testing.LongWait)
+	for {
+		address, _ := reporter.Report(c.Context())["address"].(string)
+		if match(address) {
+			return address
+		}
+		select {
+		case <-time.After(testing.ShortWait):
+		case <-timeout:
+			c.Fatalf("timed out waiting for address, last %q", address)
+		}
+	}
+}
+
+func (s *workerSuite) sendChange(c *tc.C, keys ...string) {
+	select {
+	case s.changes <- keys:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out sending change")
+	}
+}
+
+func (s *workerSuite) expectControllerConfig(c *tc.C, listenAddress string) <-chan struct{} {
+	cfg := testing.FakeControllerConfig()
+	cfg[controller.DNSListenAddress] = listenAddress
+	called := make(chan struct{})
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).DoAndReturn(
+		func(context.Context) (controller.Config, error) {
+			close(called)
+			return cfg, nil
+		})
+	return called
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.watcher = NewMockStringsWatcher(ctrl)
+	s.changes = make(chan []string)
+
+	s.watcher.EXPECT().Changes().Return(s.changes).AnyTimes()
+	s.watcher.EXPECT().Kill().AnyTimes()
+	s.watcher.EXPECT().Wait().Return(nil).AnyTimes()
+	s.controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).Return(s.watcher, nil).AnyTimes()
+	return ctrl
+}
+
+func (s *workerSuite) newConfig(c *tc.C) Config {
+	return Config{
+		ControllerConfigService: s.controllerConfigService,
+		Resolver:                fakeResolver{},
+		Logger:                  loggertesting.WrapCheckLog(c),
+	}
+}
+
+type fakeResolver struct{}
+
+func (fakeResolver) Lookup(ctx context.Context, name string) ([]net.IP, bool, error) {
+	if name == "0.mysql.foo.juju." {
+		return []net.IP{net.ParseIP("10.0.0.1")}, true, nil
+	}
+	return nil, false, nil
+}
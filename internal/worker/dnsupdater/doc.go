@@ -0,0 +1,29 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package dnsupdater provides a worker that publishes the names of a
+// model's applications and units to an external DNS server using RFC 2136
+// dynamic updates.
+//
+// # Overview
+//
+// When the dns-update-server model config is set, on each tick of the
+// update interval the worker builds the records of the model, as described
+// by the [dns] package, under the dns-update-zone, and sends the server an
+// update for the names whose addresses changed since the last successful
+// update and for the names no longer published. Updates are signed with
+// the TSIG key held in the user secret referenced by
+// dns-update-tsig-key-secret, if set. Only user secrets are accepted, so
+// that charms cannot read or replace the key. A new revision of the secret
+// is picked up on the next tick; while the key cannot be read, no updates
+// are sent.
+//
+// When the server, zone or key secret change, the names published so far are
+// removed from the previous server, on a best effort basis, and all names
+// are published again. A server that cannot be reached is logged and the
+// update retried on the next tick.
+//
+// # Integration
+//
+// The worker is intended to be run by the Juju controller, for each model.
+package dnsupdater
@@ -0,0 +1,82 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsupdater
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/services"
+	internalworker "github.com/juju/juju/internal/worker"
+)
+
+// ManifoldConfig describes the resources used by the DNS updater worker.
+type ManifoldConfig struct {
+	DomainServicesName string
+	Clock              clock.Clock
+	Logger             logger.Logger
+	// UpdateInterval specifies how often the DNS server is updated.
+	UpdateInterval time.Duration
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.DomainServicesName == "" {
+		return errors.NotValidf("empty DomainServicesName")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.UpdateInterval <= 0 {
+		return errors.NotValidf("non-positive UpdateInterval")
+	}
+	return nil
+}
+
+// start starts the DNS updater worker.
+func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var domainServices services.ModelDomainServices
+	if err := getter.Get(config.DomainServicesName, &domainServices); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := NewWorker(Config{
+		ModelConfigService: domainServices.Config(),
+		ModelInfoService:   domainServices.ModelInfo(),
+		NetworkService:     domainServices.Network(),
+		SecretService:      domainServices.Secret(),
+		NewUpdater:         NewUpdater,
+		Clock:              config.Clock,
+		Logger:             config.Logger,
+		UpdateInterval:     config.UpdateInterval,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Manifold returns a Manifold that encapsulates the DNS updater worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.DomainServicesName,
+		},
+		Start:  config.start,
+		Filter: internalworker.ShouldWorkerUninstall,
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsupdater
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package dnsupdater -destination services_mock_test.go github.com/juju/juju/internal/worker/dnsupdater ModelConfigService,ModelInfoService,NetworkService,SecretService,Updater
+//go:generate go run go.uber.org/mock/mockgen -typed -package dnsupdater -destination watcher_mock_test.go github.com/juju/juju/core/watcher StringsWatcher
@@ -0,0 +1,411 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/dnsupdater (interfaces: ModelConfigService,ModelInfoService,NetworkService,SecretService,Updater)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package dnsupdater -destination services_mock_test.go github.com/juju/juju/internal/worker/dnsupdater ModelConfigService,ModelInfoService,NetworkService,SecretService,Updater
+//
+
+// Package dnsupdater is a generated GoMock package.
+package dnsupdater
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/juju/juju/core/model"
+	secrets "github.com/juju/juju/core/secrets"
+	watcher "github.com/juju/juju/core/watcher"
+	network "github.com/juju/juju/domain/network"
+	secret "github.com/juju/juju/domain/secret"
+	config "github.com/juju/juju/environs/config"
+	dns "github.com/juju/juju/internal/network/dns"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock *MockModelConfigService
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(arg0 context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(arg0 any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockModelConfigService)(nil).ModelConfig), arg0)
+	return &MockModelConfigServiceModelConfigCall{Call: call}
+}
+
+// MockModelConfigServiceModelConfigCall wrap *gomock.Call
+type MockModelConfigServiceModelConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceModelConfigCall) Return(arg0 *config.Config, arg1 error) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceModelConfigCall) Do(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceModelConfigCall) DoAndReturn(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Watch mocks base method.
+func (m *MockModelConfigService) Watch(arg0 context.Context) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockModelConfigServiceMockRecorder) Watch(arg0 any) *MockModelConfigServiceWatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockModelConfigService)(nil).Watch), arg0)
+	return &MockModelConfigServiceWatchCall{Call: call}
+}
+
+// MockModelConfigServiceWatchCall wrap *gomock.Call
+type MockModelConfigServiceWatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceWatchCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceWatchCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceWatchCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelInfoService is a mock of ModelInfoService interface.
+type MockModelInfoService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelInfoServiceMockRecorder
+}
+
+// MockModelInfoServiceMockRecorder is the mock recorder for MockModelInfoService.
+type MockModelInfoServiceMockRecorder struct {
+	mock *MockModelInfoService
+}
+
+// NewMockModelInfoService creates a new mock instance.
+func NewMockModelInfoService(ctrl *gomock.Controller) *MockModelInfoService {
+	mock := &MockModelInfoService{ctrl: ctrl}
+	mock.recorder = &MockModelInfoServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelInfoService) EXPECT() *MockModelInfoServiceMockRecorder {
+	return m.recorder
+}
+
+// GetModelInfo mocks base method.
+func (m *MockModelInfoService) GetModelInfo(arg0 context.Context) (model.ModelInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelInfo", arg0)
+	ret0, _ := ret[0].(model.ModelInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelInfo indicates an expected call of GetModelInfo.
+func (mr *MockModelInfoServiceMockRecorder) GetModelInfo(arg0 any) *MockModelInfoServiceGetModelInfoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelInfo", reflect.TypeOf((*MockModelInfoService)(nil).GetModelInfo), arg0)
+	return &MockModelInfoServiceGetModelInfoCall{Call: call}
+}
+
+// MockModelInfoServiceGetModelInfoCall wrap *gomock.Call
+type MockModelInfoServiceGetModelInfoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelInfoServiceGetModelInfoCall) Return(arg0 model.ModelInfo, arg1 error) *MockModelInfoServiceGetModelInfoCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelInfoServiceGetModelInfoCall) Do(f func(context.Context) (model.ModelInfo, error)) *MockModelInfoServiceGetModelInfoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelInfoServiceGetModelInfoCall) DoAndReturn(f func(context.Context) (model.ModelInfo, error)) *MockModelInfoServiceGetModelInfoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockNetworkService is a mock of NetworkService interface.
+type MockNetworkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkServiceMockRecorder
+}
+
+// MockNetworkServiceMockRecorder is the mock recorder for MockNetworkService.
+type MockNetworkServiceMockRecorder struct {
+	mock *MockNetworkService
+}
+
+// NewMockNetworkService creates a new mock instance.
+func NewMockNetworkService(ctrl *gomock.Controller) *MockNetworkService {
+	mock := &MockNetworkService{ctrl: ctrl}
+	mock.recorder = &MockNetworkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkService) EXPECT() *MockNetworkServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationAddresses mocks base method.
+func (m *MockNetworkService) GetApplicationAddresses(arg0 context.Context) ([]network.ApplicationAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAddresses", arg0)
+	ret0, _ := ret[0].([]network.ApplicationAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAddresses indicates an expected call of GetApplicationAddresses.
+func (mr *MockNetworkServiceMockRecorder) GetApplicationAddresses(arg0 any) *MockNetworkServiceGetApplicationAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAddresses", reflect.TypeOf((*MockNetworkService)(nil).GetApplicationAddresses), arg0)
+	return &MockNetworkServiceGetApplicationAddressesCall{Call: call}
+}
+
+// MockNetworkServiceGetApplicationAddressesCall wrap *gomock.Call
+type MockNetworkServiceGetApplicationAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceGetApplicationAddressesCall) Return(arg0 []network.ApplicationAddresses, arg1 error) *MockNetworkServiceGetApplicationAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceGetApplicationAddressesCall) Do(f func(context.Context) ([]network.ApplicationAddresses, error)) *MockNetworkServiceGetApplicationAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceGetApplicationAddressesCall) DoAndReturn(f func(context.Context) ([]network.ApplicationAddresses, error)) *MockNetworkServiceGetApplicationAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock *MockSecretService
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSecret mocks base method.
+func (m *MockSecretService) GetSecret(arg0 context.Context, arg1 *secrets.URI) (*secrets.SecretMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", arg0, arg1)
+	ret0, _ := ret[0].(*secrets.SecretMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockSecretServiceMockRecorder) GetSecret(arg0, arg1 any) *MockSecretServiceGetSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockSecretService)(nil).GetSecret), arg0, arg1)
+	return &MockSecretServiceGetSecretCall{Call: call}
+}
+
+// MockSecretServiceGetSecretCall wrap *gomock.Call
+type MockSecretServiceGetSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceGetSecretCall) Return(arg0 *secrets.SecretMetadata, arg1 error) *MockSecretServiceGetSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceGetSecretCall) Do(f func(context.Context, *secrets.URI) (*secrets.SecretMetadata, error)) *MockSecretServiceGetSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceGetSecretCall) DoAndReturn(f func(context.Context, *secrets.URI) (*secrets.SecretMetadata, error)) *MockSecretServiceGetSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSecretContentFromBackend mocks base method.
+func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int, arg3 secret.SecretAccessor) (secrets.SecretValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretContentFromBackend", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(secrets.SecretValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretContentFromBackend indicates an expected call of GetSecretContentFromBackend.
+func (mr *MockSecretServiceMockRecorder) GetSecretContentFromBackend(arg0, arg1, arg2, arg3 any) *MockSecretServiceGetSecretContentFromBackendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentFromBackend", reflect.TypeOf((*MockSecretService)(nil).GetSecretContentFromBackend), arg0, arg1, arg2, arg3)
+	return &MockSecretServiceGetSecretContentFromBackendCall{Call: call}
+}
+
+// MockSecretServiceGetSecretContentFromBackendCall wrap *gomock.Call
+type MockSecretServiceGetSecretContentFromBackendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceGetSecretContentFromBackendCall) Return(arg0 secrets.SecretValue, arg1 error) *MockSecretServiceGetSecretContentFromBackendCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceGetSecretContentFromBackendCall) Do(f func(context.Context, *secrets.URI, int, secret.SecretAccessor) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceGetSecretContentFromBackendCall) DoAndReturn(f func(context.Context, *secrets.URI, int, secret.SecretAccessor) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockUpdater is a mock of Updater interface.
+type MockUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdaterMockRecorder
+}
+
+// MockUpdaterMockRecorder is the mock recorder for MockUpdater.
+type MockUpdaterMockRecorder struct {
+	mock *MockUpdater
+}
+
+// NewMockUpdater creates a new mock instance.
+func NewMockUpdater(ctrl *gomock.Controller) *MockUpdater {
+	mock := &MockUpdater{ctrl: ctrl}
+	mock.recorder = &MockUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdater) EXPECT() *MockUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockUpdater) Update(arg0 context.Context, arg1 []dns.Record, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdaterMockRecorder) Update(arg0, arg1, arg2 any) *MockUpdaterUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdater)(nil).Update), arg0, arg1, arg2)
+	return &MockUpdaterUpdateCall{Call: call}
+}
+
+// MockUpdaterUpdateCall wrap *gomock.Call
+type MockUpdaterUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUpdaterUpdateCall) Return(arg0 error) *MockUpdaterUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUpdaterUpdateCall) Do(f func(context.Context, []dns.Record, []string) error) *MockUpdaterUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUpdaterUpdateCall) DoAndReturn(f func(context.Context, []dns.Record, []string) error) *MockUpdaterUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,407 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsupdater
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/collections/set"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/watcher"
+	domainnetwork "github.com/juju/juju/domain/network"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/network/dns"
+)
+
+// ModelConfigService is an interface that provides access to the
+// model configuration.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(ctx context.Context) (*config.Config, error)
+	// Watch returns a watcher that returns keys for any changes to model
+	// config.
+	Watch(ctx context.Context) (watcher.StringsWatcher, error)
+}
+
+// ModelInfoService provides access to information about the model.
+type ModelInfoService interface {
+	// GetModelInfo returns the read-only information about the model.
+	GetModelInfo(ctx context.Context) (coremodel.ModelInfo, error)
+}
+
+// NetworkService provides the addresses of the model's units.
+type NetworkService interface {
+	// GetApplicationAddresses returns, for each application in the model,
+	// the addresses of its units in the space that the application's
+	// default endpoint binding is bound to.
+	GetApplicationAddresses(ctx context.Context) ([]domainnetwork.ApplicationAddresses, error)
+}
+
+// SecretService provides access to the secret holding the TSIG key.
+type SecretService interface {
+	// GetSecret returns the secret with the specified URI.
+	GetSecret(ctx context.Context, uri *secrets.URI) (*secrets.SecretMetadata, error)
+	// GetSecretContentFromBackend retrieves the content for the specified
+	// secret revision, recording the read against the accessor.
+	GetSecretContentFromBackend(
+		ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor,
+	) (secrets.SecretValue, error)
+}
+
+// Updater publishes records to a zone on a DNS server.
+type Updater interface {
+	// Update replaces the addresses of the names of the input records, and
+	// removes all records of the names to be removed.
+	Update(ctx context.Context, set []dns.Record, remove []string) error
+}
+
+// NewUpdaterFunc returns an Updater sending updates for the zone to the
+// server, signed with the key if it is not nil.
+type NewUpdaterFunc func(server, zone string, key *dns.TSIGKey, clock clock.Clock) Updater
+
+// NewUpdater returns an Updater sending RFC 2136 dynamic updates.
+func NewUpdater(server, zone string, key *dns.TSIGKey, clock clock.Clock) Updater {
+	return dns.NewUpdater(server, zone, key, clock)
+}
+
+// Config is the configuration for the DNS updater.
+type Config struct {
+	ModelConfigService ModelConfigService
+	ModelInfoService   ModelInfoService
+	NetworkService     NetworkService
+	SecretService      SecretService
+	NewUpdater         NewUpdaterFunc
+	Clock              clock.Clock
+	Logger             logger.Logger
+
+	// UpdateInterval is the interval at which the DNS server is updated.
+	UpdateInterval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.ModelConfigService == nil {
+		return errors.Errorf("nil ModelConfigService").Add(coreerrors.NotValid)
+	}
+	if config.ModelInfoService == nil {
+		return errors.Errorf("nil ModelInfoService").Add(coreerrors.NotValid)
+	}
+	if config.NetworkService == nil {
+		return errors.Errorf("nil NetworkService").Add(coreerrors.NotValid)
+	}
+	if config.SecretService == nil {
+		return errors.Errorf("nil SecretService").Add(coreerrors.NotValid)
+	}
+	if config.NewUpdater == nil {
+		return errors.Errorf("nil NewUpdater").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil Clock").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.UpdateInterval <= 0 {
+		return errors.Errorf("update interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// settings are the model config settings of the DNS server to update.
+type settings struct {
+	server    string
+	zone      string
+	keySecret string
+}
+
+// updaterWorker is a worker that publishes the names of the model's
+// applications and units to an external DNS server.
+type updaterWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	modelName string
+	modelUUID string
+	updater   Updater
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	settings    settings
+	keyRevision int
+	published   map[string]string
+	lastUpdate  time.Time
+}
+
+// NewWorker returns a new DNS updater worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &updaterWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "dns-updater",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *updaterWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *updaterWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *updaterWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]any{
+		"server":      w.settings.server,
+		"zone":        w.settings.zone,
+		"published":   len(w.published),
+		"last-update": w.lastUpdate,
+	}
+}
+
+// loop is the worker's main loop.
+//   - It watches for changes to the model configuration to get the DNS
+//     server to update.
+//   - It periodically updates the names published on the server.
+func (w *updaterWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	watch, err := w.config.ModelConfigService.Watch(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := w.catacomb.Add(watch); err != nil {
+		return errors.Capture(err)
+	}
+
+	modelInfo, err := w.config.ModelInfoService.GetModelInfo(ctx)
+	if err != nil {
+		return errors.Errorf("getting model info: %w", err)
+	}
+	w.modelName = modelInfo.Name
+	w.modelUUID = modelInfo.UUID.String()
+
+	if err := w.updateSettings(ctx); err != nil {
+		return errors.Capture(err)
+	}
+
+	timer := w.config.Clock.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case keys, ok := <-watch.Changes():
+			if !ok {
+				return errors.New("model config watcher closed")
+			}
+			changes := set.NewStrings(keys...)
+			if !changes.Contains(config.DNSUpdateServerKey) &&
+				!changes.Contains(config.DNSUpdateZoneKey) &&
+				!changes.Contains(config.DNSUpdateTSIGKeySecretKey) {
+				continue
+			}
+			if err := w.updateSettings(ctx); err != nil {
+				return errors.Capture(err)
+			}
+			timer.Reset(0)
+		case <-timer.Chan():
+			if err := w.update(ctx); err != nil {
+				return errors.Capture(err)
+			}
+			timer.Reset(w.config.UpdateInterval)
+		}
+	}
+}
+
+// updateSettings reads the DNS server settings from the model config. If
+// they changed, the names published to the previous server are removed
+// from it and a new updater is created.
+func (w *updaterWorker) updateSettings(ctx context.Context) error {
+	cfg, err := w.config.ModelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return errors.Errorf("getting model config: %w", err)
+	}
+	newSettings := settings{
+		server:    cfg.DNSUpdateServer(),
+		zone:      cfg.DNSUpdateZone(),
+		keySecret: cfg.DNSUpdateTSIGKeySecret(),
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if newSettings == w.settings && (w.updater != nil || newSettings.server == "") {
+		return nil
+	}
+
+	if w.updater != nil && len(w.published) > 0 {
+		names := slices.Sorted(maps.Keys(w.published))
+		if err := w.updater.Update(ctx, nil, names); err != nil {
+			w.config.Logger.Warningf(ctx, "removing names from %s: %v", w.settings.server, err)
+		}
+	}
+	w.settings = newSettings
+	w.published = nil
+	w.updater = nil
+	if newSettings.server == "" {
+		w.config.Logger.Debugf(ctx, "dynamic DNS updates disabled")
+		return nil
+	}
+
+	w.ensureUpdater(ctx)
+	w.config.Logger.Infof(ctx, "publishing names under %q to %s", newSettings.zone, newSettings.server)
+	return nil
+}
+
+// ensureUpdater creates the updater if there is none, and recreates it when
+// a new revision of the TSIG key secret has been added. While the key cannot
+// be read, no updates are sent. The caller must hold the mutex.
+func (w *updaterWorker) ensureUpdater(ctx context.Context) {
+	if w.settings.keySecret == "" {
+		if w.updater == nil {
+			w.keyRevision = 0
+			w.updater = w.config.NewUpdater(w.settings.server, w.settings.zone, nil, w.config.Clock)
+		}
+		return
+	}
+
+	key, revision, err := w.readTSIGKey(ctx)
+	if err != nil {
+		w.config.Logger.Warningf(ctx, "reading TSIG key from %s: %v", w.settings.keySecret, err)
+		w.updater = nil
+		return
+	}
+	if key == nil {
+		return
+	}
+	w.keyRevision = revision
+	w.updater = w.config.NewUpdater(w.settings.server, w.settings.zone, key, w.config.Clock)
+}
+
+// readTSIGKey reads the TSIG key from the latest revision of the user secret
+// in the model config. It returns a nil key if the revision has already been
+// read and the updater is still in use.
+func (w *updaterWorker) readTSIGKey(ctx context.Context) (*dns.TSIGKey, int, error) {
+	// The URI has been validated with the model config.
+	uri, err := secrets.ParseURI(w.settings.keySecret)
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+	md, err := w.config.SecretService.GetSecret(ctx, uri)
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+	// Only user secrets can hold the key, so that a charm cannot provide or
+	// read the key used to update the model's names.
+	if md.Owner.Kind != secrets.ModelOwner {
+		return nil, 0, errors.Errorf("secret is not a user secret").Add(coreerrors.NotValid)
+	}
+	if w.updater != nil && md.LatestRevision == w.keyRevision {
+		return nil, 0, nil
+	}
+
+	// The key is read on behalf of the model, so the read is recorded
+	// against it.
+	value, err := w.config.SecretService.GetSecretContentFromBackend(ctx, uri, md.LatestRevision, domainsecret.SecretAccessor{
+		Kind: domainsecret.ModelAccessor,
+		ID:   w.modelUUID,
+	})
+	if err != nil {
+		return nil, 0, errors.Errorf("getting revision %d: %w", md.LatestRevision, err)
+	}
+	content, err := value.Values()
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+	for _, k := range []string{"name", "secret"} {
+		if content[k] == "" {
+			return nil, 0, errors.Errorf("secret has no %q key", k).Add(coreerrors.NotValid)
+		}
+	}
+	key, err := dns.NewTSIGKey(content["algorithm"], content["name"], content["secret"])
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+	return &key, md.LatestRevision, nil
+}
+
+// update sends the DNS server the records that changed since the last
+// successful update. Errors sending the update are logged, and the update
+// retried next time.
+func (w *updaterWorker) update(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.settings.server == "" {
+		return nil
+	}
+	w.ensureUpdater(ctx)
+	if w.updater == nil {
+		return nil
+	}
+
+	apps, err := w.config.NetworkService.GetApplicationAddresses(ctx)
+	if err != nil {
+		return errors.Errorf("getting application addresses: %w", err)
+	}
+	records := dns.ModelRecords(w.settings.zone, w.modelName, apps)
+
+	current := make(map[string]string, len(records))
+	var changed []dns.Record
+	for _, record := range records {
+		addrs := make([]string, len(record.Addresses))
+		for i, addr := range record.Addresses {
+			addrs[i] = addr.String()
+		}
+		slices.Sort(addrs)
+		current[record.Name] = strings.Join(addrs, ",")
+		if w.published[record.Name] != current[record.Name] {
+			changed = append(changed, record)
+		}
+	}
+	var removed []string
+	for name := range w.published {
+		if _, ok := current[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	slices.Sort(removed)
+	if len(changed) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	if err := w.updater.Update(ctx, changed, removed); err != nil {
+		w.config.Logger.Warningf(ctx, "publishing names to %s: %v", w.settings.server, err)
+		return nil
+	}
+	w.config.Logger.Debugf(ctx, "published %d names and removed %d names", len(changed), len(removed))
+	w.published = current
+	w.lastUpdate = w.config.Clock.Now()
+	return nil
+}
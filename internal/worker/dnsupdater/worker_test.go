@@ -0,0 +1,401 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dnsupdater
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/unit"
+	domainnetwork "github.com/juju/juju/domain/network"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/network/dns"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+func TestConfigSuite(t *testing.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		ModelConfigService: NewMockModelConfigService(ctrl),
+		ModelInfoService:   NewMockModelInfoService(ctrl),
+		NetworkService:     NewMockNetworkService(ctrl),
+		SecretService:      NewMockSecretService(ctrl),
+		NewUpdater:         NewUpdater,
+		Clock:              testclock.NewClock(time.Now()),
+		Logger:             loggertesting.WrapCheckLog(c),
+		UpdateInterval:     time.Minute,
+	}
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.ModelConfigService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil ModelConfigService.*")
+
+	testCfg = origCfg
+	testCfg.ModelInfoService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil ModelInfoService.*")
+
+	testCfg = origCfg
+	testCfg.NetworkService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil NetworkService.*")
+
+	testCfg = origCfg
+	testCfg.SecretService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil SecretService.*")
+
+	testCfg = origCfg
+	testCfg.NewUpdater = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil NewUpdater.*")
+
+	testCfg = origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Clock.*")
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Logger.*")
+
+	testCfg = origCfg
+	testCfg.UpdateInterval = 0
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "update interval must be positive.*")
+}
+
+type workerSuite struct {
+	modelConfigService *MockModelConfigService
+	modelInfoService   *MockModelInfoService
+	networkService     *MockNetworkService
+	secretService      *MockSecretService
+	updater            *MockUpdater
+	clock              *testclock.Clock
+
+	server string
+	key    *dns.TSIGKey
+}
+
+func (s *workerSuite) TestUpdatePublishesChanges(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelConfig(c, coretesting.Attrs{
+		config.DNSUpdateServerKey: "ns1.example.com",
+		config.DNSUpdateZoneKey:   "example.com",
+	})
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).Return([]domainnetwork.ApplicationAddresses{{
+		ApplicationName: "mysql",
+		Units: []domainnetwork.UnitAddresses{
+			{UnitName: unit.Name("mysql/0"), Addresses: []string{"10.0.0.1"}},
+		},
+	}}, nil)
+	s.updater.EXPECT().Update(gomock.Any(), []dns.Record{
+		{Name: "0.mysql.foo.example.com.", Addresses: []net.IP{net.ParseIP("10.0.0.1")}},
+		{Name: "mysql.foo.example.com.", Addresses: []net.IP{net.ParseIP("10.0.0.1")}},
+	}, nil).Return(nil)
+
+	w := s.newWorker(c)
+	err := w.updateSettings(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.server, tc.Equals, "ns1.example.com")
+
+	err = w.update(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Only the names whose addresses changed are updated, and names no
+	// longer published are removed.
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).Return([]domainnetwork.ApplicationAddresses{{
+		ApplicationName: "mysql",
+		Units: []domainnetwork.UnitAddresses{
+			{UnitName: unit.Name("mysql/1"), Addresses: []string{"10.0.0.2"}},
+		},
+	}}, nil)
+	s.updater.EXPECT().Update(gomock.Any(), []dns.Record{
+		{Name: "1.mysql.foo.example.com.", Addresses: []net.IP{net.ParseIP("10.0.0.2")}},
+		{Name: "mysql.foo.example.com.", Addresses: []net.IP{net.ParseIP("10.0.0.2")}},
+	}, []string{"0.mysql.foo.example.com."}).Return(nil)
+
+	err = w.update(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Nothing is sent when nothing changed.
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).Return([]domainnetwork.ApplicationAddresses{{
+		ApplicationName: "mysql",
+		Units: []domainnetwork.UnitAddresses{
+			{UnitName: unit.Name("mysql/1"), Addresses: []string{"10.0.0.2"}},
+		},
+	}}, nil)
+
+	err = w.update(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	report := w.Report(c.Context())
+	c.Check(report["published"], tc.Equals, 2)
+	c.Check(report["last-update"], tc.Equals, s.clock.Now())
+}
+
+func (s *workerSuite) TestUpdateErrorRetried(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelConfig(c, coretesting.Attrs{
+		config.DNSUpdateServerKey: "ns1.example.com",
+	})
+	apps := []domainnetwork.ApplicationAddresses{{
+		ApplicationName: "mysql",
+		Units: []domainnetwork.UnitAddresses{
+			{UnitName: unit.Name("mysql/0"), Addresses: []string{"10.0.0.1"}},
+		},
+	}}
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).Return(apps, nil).Times(2)
+	s.updater.EXPECT().Update(gomock.Any(), gomock.Len(2), nil).Return(errors.New("boom"))
+	s.updater.EXPECT().Update(gomock.Any(), gomock.Len(2), nil).Return(nil)
+
+	w := s.newWorker(c)
+	err := w.updateSettings(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = w.update(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(w.Report(c.Context())["published"], tc.Equals, 0)
+
+	err = w.update(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(w.Report(c.Context())["published"], tc.Equals, 2)
+}
+
+func (s *workerSuite) TestUpdateDisabled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelConfig(c, nil)
+
+	w := s.newWorker(c)
+	err := w.updateSettings(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = w.update(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *workerSuite) TestSettingsChangeRemovesPublishedNames(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelConfig(c, coretesting.Attrs{
+		config.DNSUpdateServerKey: "ns1.example.com",
+	})
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).Return([]domainnetwork.ApplicationAddresses{{
+		ApplicationName: "mysql",
+		Units: []domainnetwork.UnitAddresses{
+			{UnitName: unit.Name("mysql/0"), Addresses: []string{"10.0.0.1"}},
+		},
+	}}, nil)
+	s.updater.EXPECT().Update(gomock.Any(), gomock.Len(2), nil).Return(nil)
+
+	w := s.newWorker(c)
+	err := w.updateSettings(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	err = w.update(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.expectModelConfig(c, coretesting.Attrs{
+		config.DNSUpdateServerKey: "ns2.example.com",
+	})
+	s.updater.EXPECT().Update(gomock.Any(), nil, []string{
+		"0.mysql.foo.juju.",
+		"mysql.foo.juju.",
+	}).Return(nil)
+
+	err = w.updateSettings(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.server, tc.Equals, "ns2.example.com")
+	c.Check(w.Report(c.Context())["published"], tc.Equals, 0)
+}
+
+func (s *workerSuite) TestTSIGKeyFromSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := secrets.NewURI()
+	s.expectModelConfig(c, coretesting.Attrs{
+		config.DNSUpdateServerKey:        "ns1.example.com",
+		config.DNSUpdateTSIGKeySecretKey: uri.String(),
+	})
+	s.expectKeySecret(uri, secrets.ModelOwner, 1, map[string]string{
+		"algorithm": "hmac-sha512",
+		"name":      "juju-key",
+		"secret":    "c2VjcmV0",
+	})
+
+	w := s.newWorker(c)
+	err := w.updateSettings(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.key, tc.NotNil)
+	c.Check(s.key.Name, tc.Equals, "juju-key")
+	c.Check(s.key.Algorithm, tc.Equals, "hmac-sha512")
+
+	// A new revision of the secret rotates the key.
+	s.expectKeySecret(uri, secrets.ModelOwner, 2, map[string]string{
+		"name":   "juju-key2",
+		"secret": "c2VjcmV0",
+	})
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).Return(nil, nil)
+
+	err = w.update(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.key.Name, tc.Equals, "juju-key2")
+	c.Check(s.key.Algorithm, tc.Equals, "hmac-sha256")
+
+	// The key is not read again while the revision is unchanged.
+	s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(&secrets.SecretMetadata{
+		URI:            uri,
+		Owner:          secrets.Owner{Kind: secrets.ModelOwner},
+		LatestRevision: 2,
+	}, nil)
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).Return(nil, nil)
+
+	err = w.update(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *workerSuite) TestTSIGKeyNotUserSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := secrets.NewURI()
+	s.expectModelConfig(c, coretesting.Attrs{
+		config.DNSUpdateServerKey:        "ns1.example.com",
+		config.DNSUpdateTSIGKeySecretKey: uri.String(),
+	})
+	s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(&secrets.SecretMetadata{
+		URI:            uri,
+		Owner:          secrets.Owner{Kind: secrets.ApplicationOwner, ID: "mysql"},
+		LatestRevision: 1,
+	}, nil).Times(2)
+
+	w := s.newWorker(c)
+	err := w.updateSettings(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.server, tc.Equals, "")
+
+	// No updates are sent while the key cannot be read.
+	err = w.update(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *workerSuite) TestWorkerUpdatesOnInterval(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	changes := make(chan []string)
+	watcher := NewMockStringsWatcher(ctrl)
+	watcher.EXPECT().Changes().Return(changes).AnyTimes()
+	watcher.EXPECT().Kill().AnyTimes()
+	watcher.EXPECT().Wait().Return(nil).AnyTimes()
+	s.modelConfigService.EXPECT().Watch(gomock.Any()).Return(watcher, nil)
+	s.modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{Name: "foo"}, nil)
+	s.expectModelConfig(c, coretesting.Attrs{
+		config.DNSUpdateServerKey: "ns1.example.com",
+	})
+
+	updated := make(chan struct{}, 2)
+	s.networkService.EXPECT().GetApplicationAddresses(gomock.Any()).DoAndReturn(
+		func(context.Context) ([]domainnetwork.ApplicationAddresses, error) {
+			updated <- struct{}{}
+			return nil, nil
+		}).Times(2)
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	// Names are published as soon as the worker starts.
+	s.waitForUpdate(c, updated)
+
+	err = s.clock.WaitAdvance(time.Minute, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	s.waitForUpdate(c, updated)
+}
+
+func (s *workerSuite) waitForUpdate(c *tc.C, updated <-chan struct{}) {
+	select {
+	case <-updated:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for update")
+	}
+}
+
+func (s *workerSuite) expectModelConfig(c *tc.C, attrs coretesting.Attrs) {
+	cfg, err := config.New(config.NoDefaults, coretesting.FakeConfig().Merge(attrs))
+	c.Assert(err, tc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+}
+
+func (s *workerSuite) expectKeySecret(uri *secrets.URI, owner secrets.OwnerKind, rev int, content map[string]string) {
+	encoded := make(map[string]string, len(content))
+	for k, v := range content {
+		encoded[k] = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+	s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(&secrets.SecretMetadata{
+		URI:            uri,
+		Owner:          secrets.Owner{Kind: owner},
+		LatestRevision: rev,
+	}, nil)
+	s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, rev, domainsecret.SecretAccessor{
+		Kind: domainsecret.ModelAccessor,
+		ID:   coretesting.ModelTag.Id(),
+	}).Return(
+		secrets.NewSecretValue(encoded), nil)
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	s.modelInfoService = NewMockModelInfoService(ctrl)
+	s.networkService = NewMockNetworkService(ctrl)
+	s.secretService = NewMockSecretService(ctrl)
+	s.updater = NewMockUpdater(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+	s.server = ""
+	s.key = nil
+	return ctrl
+}
+
+func (s *workerSuite) newWorker(c *tc.C) *updaterWorker {
+	return &updaterWorker{
+		config:    s.newConfig(c),
+		modelName: "foo",
+		modelUUID: coretesting.ModelTag.Id(),
+	}
+}
+
+func (s *workerSuite) newConfig(c *tc.C) Config {
+	return Config{
+		ModelConfigService: s.modelConfigService,
+		ModelInfoService:   s.modelInfoService,
+		NetworkService:     s.networkService,
+		SecretService:      s.secretService,
+		NewUpdater: func(server, zone string, key *dns.TSIGKey, clock clock.Clock) Updater {
+			s.server = server
+			s.key = key
+			return s.updater
+		},
+		Clock:          s.clock,
+		Logger:         loggertesting.WrapCheckLog(c),
+		UpdateInterval: time.Minute,
+	}
+}
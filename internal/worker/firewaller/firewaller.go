@@ -5,6 +5,7 @@ package firewaller
 
 import (
 	"context"
+	"net"
 	"sort"
 	"time"
 
@@ -49,6 +50,10 @@ const (
 	offererWorkerPrefix          = "offerer "
 )
 
+// egressRetryDelay is how long to wait before retrying to apply egress
+// rules to machines which have not been provisioned yet.
+const egressRetryDelay = 10 * time.Second
+
 type newCrossModelFacadeFunc func(context.Context, *api.Info) (CrossModelFirewallerFacadeCloser, error)
 
 // Config defines the operation of a Worker.
@@ -64,6 +69,7 @@ type Config struct {
 	EnvironModelFirewaller    EnvironModelFirewaller
 	EnvironInstances          EnvironInstances
 	EnvironIPV6CIDRSupport    bool
+	EnvironEgressSupport      bool
 
 	NewCrossModelFacadeFunc newCrossModelFacadeFunc
 
@@ -140,6 +146,7 @@ type Firewaller struct {
 	portsWatcher         watcher.StringsWatcher
 	subnetWatcher        watcher.StringsWatcher
 	modelFirewallWatcher watcher.NotifyWatcher
+	modelConfigWatcher   watcher.NotifyWatcher
 	// Watch the remote relations, only emits in the consuming model.
 	consumerRelationsWatcher watcher.StringsWatcher
 	// Watch the remote relations, only emits in the offering model.
@@ -150,6 +157,7 @@ type Firewaller struct {
 	unitds               map[coreunit.Name]*unitData
 	applicationids       map[names.ApplicationTag]*applicationData
 	exposedChange        chan *exposedChange
+	egressChange         chan *egressChange
 	spaceInfos           network.SpaceInfos
 	globalMode           bool
 	globalIngressRuleRef map[string]int // map of rule names to count of occurrences
@@ -159,6 +167,18 @@ type Firewaller struct {
 	envIPV6CIDRSupport bool
 	needsToFlushModel  bool
 
+	// Set to true if the environment can apply egress rules to its
+	// instances.
+	envEgressSupport bool
+
+	// Set to true if outbound traffic is denied for machines hosting
+	// units of applications without egress rules.
+	egressDefaultDeny bool
+	// Machines whose egress rules could not be applied because they
+	// have not been provisioned yet, and the timer to retry them.
+	pendingEgress map[machine.Name]bool
+	egressRetry   <-chan time.Time
+
 	modelUUID                  string
 	newRemoteFirewallerAPIFunc newCrossModelFacadeFunc
 	localRelationsChange       chan *remoteRelationNetworkChange
@@ -210,6 +230,7 @@ func NewFirewaller(cfg Config) (worker.Worker, error) {
 		environModelFirewaller:     cfg.EnvironModelFirewaller,
 		environInstances:           cfg.EnvironInstances,
 		envIPV6CIDRSupport:         cfg.EnvironIPV6CIDRSupport,
+		envEgressSupport:           cfg.EnvironEgressSupport,
 		newRemoteFirewallerAPIFunc: cfg.NewCrossModelFacadeFunc,
 		modelUUID:                  cfg.ModelUUID,
 		machineds:                  make(map[machine.Name]*machineData),
@@ -217,6 +238,8 @@ func NewFirewaller(cfg Config) (worker.Worker, error) {
 		unitds:                     make(map[coreunit.Name]*unitData),
 		applicationids:             make(map[names.ApplicationTag]*applicationData),
 		exposedChange:              make(chan *exposedChange),
+		egressChange:               make(chan *egressChange),
+		pendingEgress:              make(map[machine.Name]bool),
 		relationIngress:            make(map[relation.UUID]*remoteRelationData),
 		localRelationsChange:       make(chan *remoteRelationNetworkChange),
 		clk:                        clk,
@@ -301,6 +324,25 @@ func (fw *Firewaller) setUp(ctx context.Context) error {
 		}
 	}
 
+	if !fw.globalMode && !fw.envEgressSupport {
+		fw.logger.Infof(ctx, "environment does not support egress rules, outbound traffic is not restricted")
+	}
+	if fw.egressEnabled() {
+		cfg, err := fw.firewallerAPI.ModelConfig(ctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		fw.egressDefaultDeny = cfg.EgressDefaultDeny()
+
+		fw.modelConfigWatcher, err = fw.firewallerAPI.WatchForModelConfigChanges(ctx)
+		if err != nil {
+			return errors.Annotatef(err, "starting model config watcher")
+		}
+		if err := fw.catacomb.Add(fw.modelConfigWatcher); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	if fw.spaceInfos, err = fw.firewallerAPI.AllSpaceInfos(ctx); err != nil {
 		return errors.Trace(err)
 	}
@@ -327,6 +369,10 @@ func (fw *Firewaller) loop() error {
 	if fw.modelFirewallWatcher != nil {
 		modelFirewallChanges = fw.modelFirewallWatcher.Changes()
 	}
+	var modelConfigChanges watcher.NotifyChannel
+	if fw.modelConfigWatcher != nil {
+		modelConfigChanges = fw.modelConfigWatcher.Changes()
+	}
 
 	for {
 		select {
@@ -348,6 +394,18 @@ func (fw *Firewaller) loop() error {
 			if ensureModelFirewalls == nil {
 				ensureModelFirewalls = fw.clk.After(0)
 			}
+		case _, ok := <-modelConfigChanges:
+			if !ok {
+				return errors.New("model config watcher closed")
+			}
+			if err := fw.modelConfigChanged(ctx); err != nil {
+				return errors.Trace(err)
+			}
+		case <-fw.egressRetry:
+			fw.egressRetry = nil
+			if err := fw.retryPendingEgress(ctx); err != nil {
+				return errors.Trace(err)
+			}
 		case change, ok := <-fw.machinesWatcher.Changes():
 			if !ok {
 				return errors.New("machines watcher closed")
@@ -411,6 +469,15 @@ func (fw *Firewaller) loop() error {
 			if err := fw.flushUnits(ctx, unitds); err != nil {
 				return errors.Annotate(err, "changing firewall ports")
 			}
+		case change := <-fw.egressChange:
+			change.applicationd.egressRules = change.rules
+			var unitds []*unitData
+			for _, unitd := range change.applicationd.unitds {
+				unitds = append(unitds, unitd)
+			}
+			if err := fw.flushUnits(ctx, unitds); err != nil {
+				return errors.Annotate(err, "changing firewall egress rules")
+			}
 
 		case change, ok := <-fw.consumerRelationsWatcher.Changes():
 			if !ok {
@@ -650,12 +717,20 @@ func (fw *Firewaller) startApplication(ctx context.Context, app Application) err
 	if err != nil {
 		return internalerrors.Capture(err)
 	}
+	var egressRules firewall.EgressRules
+	if fw.egressEnabled() {
+		egressRules, err = fw.applicationService.GetApplicationEgressRules(ctx, app.Name())
+		if err != nil {
+			return internalerrors.Capture(err)
+		}
+	}
 	applicationd := &applicationData{
 		fw:                 fw,
 		applicationTag:     app.Tag(),
 		applicationService: fw.applicationService,
 		exposed:            isExposed,
 		exposedEndpoints:   exposedEndpoints,
+		egressRules:        egressRules,
 		unitds:             make(map[coreunit.Name]*unitData),
 	}
 	fw.applicationids[app.Tag()] = applicationd
@@ -664,7 +739,7 @@ func (fw *Firewaller) startApplication(ctx context.Context, app Application) err
 		Name: "firewaller-application",
 		Site: &applicationd.catacomb,
 		Work: func() error {
-			return applicationd.watchLoop(isExposed, exposedEndpoints)
+			return applicationd.watchLoop(isExposed, exposedEndpoints, egressRules)
 		},
 	})
 	if err != nil {
@@ -776,6 +851,25 @@ func (fw *Firewaller) reconcileInstances(ctx context.Context) error {
 				return errors.Annotatef(err, "closing instance ports %v for %q", toOpen, machineName)
 			}
 		}
+
+		if !fw.egressEnabled() {
+			continue
+		}
+		egressInstance, ok := envInstances[0].(instances.InstanceEgressFirewaller)
+		if !ok {
+			continue
+		}
+		initialEgress, err := egressInstance.EgressRules(ctx, machineName.String())
+		if err != nil {
+			return errors.Annotatef(err, "retrieving egress rules for %q", machineName)
+		}
+		if equalEgressRules(initialEgress, machined.egressRules) {
+			continue
+		}
+		fw.logger.Infof(ctx, "setting egress rules %v for %q", machined.egressRules, machineName)
+		if err := egressInstance.SetEgressRules(ctx, machineName.String(), machined.egressRules); err != nil {
+			return errors.Annotatef(err, "setting egress rules for %q", machineName)
+		}
 	}
 	return nil
 }
@@ -913,7 +1007,181 @@ func (fw *Firewaller) flushMachine(ctx context.Context, machined *machineData) e
 	if fw.globalMode {
 		return fw.flushGlobalPorts(toOpen, toClose)
 	}
-	return fw.flushInstancePorts(ctx, machined, toOpen, toClose)
+	if err := fw.flushInstancePorts(ctx, machined, toOpen, toClose); err != nil {
+		return errors.Trace(err)
+	}
+	if !fw.egressEnabled() {
+		return nil
+	}
+	return fw.flushInstanceEgress(ctx, machined)
+}
+
+// egressEnabled returns true if egress rules are applied to instances. This
+// requires each machine to have its own firewall, and an environment which
+// can restrict the outbound traffic of its instances.
+func (fw *Firewaller) egressEnabled() bool {
+	return !fw.globalMode && fw.envEgressSupport
+}
+
+// gatherEgressRules returns the egress rules for the specified machine.
+// A nil result means that outbound traffic from the machine is
+// unrestricted. Restricted machines are always allowed to connect to the
+// controller.
+func (fw *Firewaller) gatherEgressRules(ctx context.Context, machined *machineData) (firewall.EgressRules, error) {
+	restricted := fw.egressDefaultDeny
+	var rules firewall.EgressRules
+	for _, unitd := range machined.unitds {
+		if len(unitd.applicationd.egressRules) == 0 {
+			continue
+		}
+		restricted = true
+		rules = append(rules, unitd.applicationd.egressRules...)
+	}
+	if !restricted {
+		return nil, nil
+	}
+
+	controllerRules, err := fw.controllerEgressRules(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	want := append(rules, controllerRules...).Compact()
+
+	// Substrates that do not support IPV6 CIDRs will complain if we pass
+	// an IPV6 CIDR.
+	if !fw.envIPV6CIDRSupport {
+		want = want.RemoveCIDRsMatchingAddressType(network.IPv6Address)
+	}
+	return want, nil
+}
+
+// controllerEgressRules returns the egress rules required for agents to
+// connect to the controller API.
+func (fw *Firewaller) controllerEgressRules(ctx context.Context) (firewall.EgressRules, error) {
+	apiInfo, err := fw.firewallerAPI.ControllerAPIInfoForModel(ctx, fw.modelUUID)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting controller API addresses")
+	}
+	var rules firewall.EgressRules
+	for _, addr := range apiInfo.Addrs {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ip := net.ParseIP(host)
+		if ip == nil {
+			fw.logger.Debugf(ctx, "skipping non-IP controller address %q for egress rules", addr)
+			continue
+		}
+		cidr := host + "/32"
+		if ip.To4() == nil {
+			cidr = host + "/128"
+		}
+		portRange, err := network.ParsePortRange(port + "/tcp")
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		rules = append(rules, firewall.NewEgressRule(portRange, cidr))
+	}
+	return rules, nil
+}
+
+// flushInstanceEgress applies the egress rules for the passed machine to
+// its instance. Machines that have not been provisioned yet are retried
+// later.
+func (fw *Firewaller) flushInstanceEgress(ctx context.Context, machined *machineData) (err error) {
+	defer func() {
+		if params.IsCodeNotFound(err) {
+			err = nil
+		}
+	}()
+
+	want, err := fw.gatherEgressRules(ctx, machined)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if equalEgressRules(machined.egressRules, want) {
+		return nil
+	}
+
+	m, err := machined.machine(ctx)
+	if err != nil {
+		return err
+	}
+	instanceId, err := m.InstanceId(ctx)
+	if errors.Is(err, errors.NotProvisioned) {
+		fw.logger.Debugf(ctx, "deferring egress rules for unprovisioned %q", machined.name)
+		fw.pendingEgress[machined.name] = true
+		if fw.egressRetry == nil {
+			fw.egressRetry = fw.clk.After(egressRetryDelay)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	envInstances, err := fw.environInstances.Instances(ctx, []instance.Id{instanceId})
+	if err != nil {
+		return err
+	}
+	fwInstance, ok := envInstances[0].(instances.InstanceEgressFirewaller)
+	if !ok {
+		return errors.NotSupportedf("egress rules on %q with instance of type %T", machined.name, envInstances[0])
+	}
+	if err := fwInstance.SetEgressRules(ctx, machined.name.String(), want); err != nil {
+		return errors.Annotatef(err, "setting egress rules for %q", machined.name)
+	}
+	machined.egressRules = want
+	fw.logger.Infof(ctx, "set egress rules %v on %q", want, machined.name)
+	return nil
+}
+
+// retryPendingEgress applies egress rules to the machines which were
+// previously not provisioned.
+func (fw *Firewaller) retryPendingEgress(ctx context.Context) error {
+	pending := fw.pendingEgress
+	fw.pendingEgress = make(map[machine.Name]bool)
+	for name := range pending {
+		machined, ok := fw.machineds[name]
+		if !ok {
+			continue
+		}
+		if err := fw.flushInstanceEgress(ctx, machined); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// modelConfigChanged re-evaluates the egress rules of all machines when
+// the egress-default-deny model config value changes.
+func (fw *Firewaller) modelConfigChanged(ctx context.Context) error {
+	cfg, err := fw.firewallerAPI.ModelConfig(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	deny := cfg.EgressDefaultDeny()
+	if deny == fw.egressDefaultDeny {
+		return nil
+	}
+	fw.logger.Infof(ctx, "egress default deny changed to %v", deny)
+	fw.egressDefaultDeny = deny
+	for _, machined := range fw.machineds {
+		if err := fw.flushMachine(ctx, machined); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// equalEgressRules returns true if both rule lists are equal. A nil list,
+// which means unrestricted, is never equal to an empty list, which means
+// deny all.
+func equalEgressRules(a, b firewall.EgressRules) bool {
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	return a.EqualTo(b)
 }
 
 // gatherIngressRules returns the ingress rules to open and close
@@ -1369,6 +1637,9 @@ type machineData struct {
 	name         machine.Name
 	unitds       map[coreunit.Name]*unitData
 	ingressRules firewall.IngressRules
+	// egressRules are the egress rules applied to the machine's instance;
+	// nil means unrestricted.
+	egressRules firewall.EgressRules
 	// ports defined by units on this machine
 	openedPortRangesByEndpoint map[coreunit.Name]network.GroupedPortRanges
 }
@@ -1428,6 +1699,13 @@ type exposedChange struct {
 	exposedEndpoints map[string]application.ExposedEndpoint
 }
 
+// egressChange contains the changed egress rules for one specific
+// application.
+type egressChange struct {
+	applicationd *applicationData
+	rules        firewall.EgressRules
+}
+
 // applicationData holds application details and watches exposure changes.
 type applicationData struct {
 	catacomb           catacomb.Catacomb
@@ -1436,11 +1714,17 @@ type applicationData struct {
 	applicationService ApplicationService
 	exposed            bool
 	exposedEndpoints   map[string]application.ExposedEndpoint
+	egressRules        firewall.EgressRules
 	unitds             map[coreunit.Name]*unitData
 }
 
-// watchLoop watches the application's exposed flag for changes.
-func (ad *applicationData) watchLoop(curExposed bool, curExposedEndpoints map[string]application.ExposedEndpoint) error {
+// watchLoop watches the application's exposed flag and egress rules for
+// changes.
+func (ad *applicationData) watchLoop(
+	curExposed bool,
+	curExposedEndpoints map[string]application.ExposedEndpoint,
+	curEgressRules firewall.EgressRules,
+) error {
 	ctx, cancel := ad.scopedContext()
 	defer cancel()
 
@@ -1454,10 +1738,47 @@ func (ad *applicationData) watchLoop(curExposed bool, curExposedEndpoints map[st
 	if err := ad.catacomb.Add(appWatcher); err != nil {
 		return errors.Trace(err)
 	}
+
+	var egressChanges watcher.NotifyChannel
+	if ad.fw.egressEnabled() {
+		egressWatcher, err := ad.applicationService.WatchApplicationEgressRules(ctx, ad.applicationTag.Name)
+		if err != nil {
+			if errors.Is(err, applicationerrors.ApplicationNotFound) {
+				return nil
+			}
+			return errors.Trace(err)
+		}
+		if err := ad.catacomb.Add(egressWatcher); err != nil {
+			return errors.Trace(err)
+		}
+		egressChanges = egressWatcher.Changes()
+	}
 	for {
 		select {
 		case <-ad.catacomb.Dying():
 			return ad.catacomb.ErrDying()
+		case _, ok := <-egressChanges:
+			if !ok {
+				return errors.New("application egress watcher closed")
+			}
+			newEgressRules, err := ad.applicationService.GetApplicationEgressRules(ctx, ad.applicationTag.Name)
+			if errors.Is(err, applicationerrors.ApplicationNotFound) {
+				ad.fw.logger.Debugf(ctx, "egress rules for application %q, app not found: %v", ad.applicationTag.Name, err)
+				return nil
+			} else if err != nil {
+				return internalerrors.Capture(err)
+			}
+			if curEgressRules.EqualTo(newEgressRules) {
+				continue
+			}
+			ad.fw.logger.Tracef(ctx, "application %q egress rules changed: %v", ad.applicationTag.Name, newEgressRules)
+
+			curEgressRules = newEgressRules
+			select {
+			case <-ad.catacomb.Dying():
+				return ad.catacomb.ErrDying()
+			case ad.fw.egressChange <- &egressChange{ad, newEgressRules}:
+			}
 		case _, ok := <-appWatcher.Changes():
 			if !ok {
 				return errors.New("application watcher closed")
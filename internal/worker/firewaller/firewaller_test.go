@@ -71,6 +71,7 @@ type firewallerBaseSuite struct {
 	offererRelCh   chan []string
 	subnetsCh      chan []string
 	modelFwRulesCh chan struct{}
+	modelConfigCh  chan struct{}
 
 	clock testclock.AdvanceableClock
 
@@ -82,6 +83,7 @@ type firewallerBaseSuite struct {
 
 	mode                string
 	withIpv6            bool
+	withEgress          bool
 	withModelFirewaller bool
 
 	modelIngressRules firewall.IngressRules
@@ -94,6 +96,9 @@ type firewallerBaseSuite struct {
 	instancePorts map[string]firewall.IngressRules
 	envPorts      firewall.IngressRules
 
+	instanceEgress map[string]firewall.EgressRules
+	appEgress      map[string]firewall.EgressRules
+
 	mu             sync.Mutex
 	unitPortRanges *unitPortRanges
 }
@@ -102,6 +107,7 @@ func (s *firewallerBaseSuite) SetUpTest(c *tc.C) {
 	s.IsolationSuite.SetUpTest(c)
 
 	s.withIpv6 = true
+	s.withEgress = true
 	s.withModelFirewaller = true
 	s.firewaller = nil
 	s.firewallerStarted = false
@@ -112,6 +118,8 @@ func (s *firewallerBaseSuite) SetUpTest(c *tc.C) {
 
 	s.unitPortRanges = newUnitPortRanges()
 	s.instancePorts = make(map[string]firewall.IngressRules)
+	s.instanceEgress = make(map[string]firewall.EgressRules)
+	s.appEgress = make(map[string]firewall.EgressRules)
 	s.envPorts = firewall.IngressRules{}
 
 	s.modelIngressRules = firewall.IngressRules{}
@@ -144,6 +152,7 @@ func (s *firewallerBaseSuite) ensureMocks(c *tc.C, ctrl *gomock.Controller) {
 	s.offererRelCh = make(chan []string, 5)
 	s.subnetsCh = make(chan []string, 5)
 	s.modelFwRulesCh = make(chan struct{}, 5)
+	s.modelConfigCh = make(chan struct{}, 5)
 
 	// This is the controller machine.
 	m, _ := s.addMachine(ctrl)
@@ -196,6 +205,7 @@ func (s *firewallerBaseSuite) ensureMocks(c *tc.C, ctrl *gomock.Controller) {
 		s.offererRelCh = nil
 		s.subnetsCh = nil
 		s.modelFwRulesCh = nil
+		s.modelConfigCh = nil
 	})
 }
 
@@ -223,6 +233,7 @@ func (s *firewallerBaseSuite) ensureMocksWithoutMachine(ctrl *gomock.Controller)
 	s.offererRelCh = make(chan []string, 5)
 	s.subnetsCh = make(chan []string, 5)
 	s.modelFwRulesCh = make(chan struct{}, 5)
+	s.modelConfigCh = make(chan struct{}, 5)
 
 	// Initial event.
 	if s.withModelFirewaller {
@@ -246,6 +257,7 @@ func (s *firewallerBaseSuite) ensureMocksWithoutMachine(ctrl *gomock.Controller)
 		s.offererRelCh = nil
 		s.subnetsCh = nil
 		s.modelFwRulesCh = nil
+		s.modelConfigCh = nil
 	})
 }
 
@@ -269,6 +281,26 @@ func (s *firewallerBaseSuite) assertIngressRules(c *tc.C, machineId string,
 	}
 }
 
+// assertEgressRules retrieves the egress rules applied to the provided
+// instance and compares them to the expected value.
+func (s *firewallerBaseSuite) assertEgressRules(c *tc.C, machineId string,
+	expected firewall.EgressRules) {
+	start := time.Now()
+	for {
+		s.mu.Lock()
+		got := s.instanceEgress[machineId]
+		if (expected == nil) == (got == nil) && expected.EqualTo(got) {
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+		if time.Since(start) > coretesting.LongWait {
+			c.Fatalf("timed out: expected %v; got %v", expected, got)
+		}
+		time.Sleep(coretesting.ShortWait)
+	}
+}
+
 // assertEnvironPorts retrieves the open ports of environment and compares them
 // to the expected.
 func (s *firewallerBaseSuite) assertEnvironPorts(c *tc.C, expected firewall.IngressRules) {
@@ -377,6 +409,14 @@ func (s *firewallerBaseSuite) addModelMachine(ctrl *gomock.Controller, manual bo
 }
 
 func (s *firewallerBaseSuite) addApplication(ctrl *gomock.Controller, appName string, exposed bool) (*mocks.MockApplication, chan struct{}) {
+	app, appCh, _ := s.addApplicationWithEgress(ctrl, appName, exposed)
+	return app, appCh
+}
+
+// addApplicationWithEgress adds an application and also returns the channel
+// used to notify the firewaller of changes to the application's egress
+// rules, which are read from s.appEgress.
+func (s *firewallerBaseSuite) addApplicationWithEgress(ctrl *gomock.Controller, appName string, exposed bool) (*mocks.MockApplication, chan struct{}, chan struct{}) {
 	app := mocks.NewMockApplication(ctrl)
 	appCh := make(chan struct{}, 5)
 	appWatch := watchertest.NewMockNotifyWatcher(appCh)
@@ -384,9 +424,19 @@ func (s *firewallerBaseSuite) addApplication(ctrl *gomock.Controller, appName st
 	s.applicationService.EXPECT().IsApplicationExposed(gomock.Any(), appName).Return(exposed, nil)
 	s.applicationService.EXPECT().GetExposedEndpoints(gomock.Any(), appName).Return(map[string]application.ExposedEndpoint{
 		allEndpoints: {ExposeToCIDRs: set.NewStrings(firewall.AllNetworksIPV4CIDR)}}, nil)
+	egressCh := make(chan struct{}, 5)
+	if s.mode == config.FwInstance && s.withEgress {
+		egressWatch := watchertest.NewMockNotifyWatcher(egressCh)
+		s.applicationService.EXPECT().WatchApplicationEgressRules(gomock.Any(), appName).Return(egressWatch, nil).AnyTimes()
+		s.applicationService.EXPECT().GetApplicationEgressRules(gomock.Any(), appName).DoAndReturn(func(context.Context, string) (firewall.EgressRules, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return s.appEgress[appName], nil
+		}).AnyTimes()
+	}
 	app.EXPECT().Name().Return(appName).AnyTimes()
 	app.EXPECT().Tag().Return(names.NewApplicationTag(appName)).AnyTimes()
-	return app, appCh
+	return app, appCh, egressCh
 }
 
 func (s *firewallerBaseSuite) addUnit(c *tc.C, ctrl *gomock.Controller, app *mocks.MockApplication) (coreunit.UUID, *mocks.MockUnit, *mocks.MockMachine, chan []string) {
@@ -465,6 +515,7 @@ func (s *firewallerBaseSuite) newFirewaller(c *tc.C, ctrl *gomock.Controller) wo
 		EnvironFirewaller:         s.envFirewaller,
 		EnvironInstances:          s.envInstances,
 		EnvironIPV6CIDRSupport:    s.withIpv6,
+		EnvironEgressSupport:      s.withEgress,
 		FirewallerAPI:             s.firewaller,
 		PortsService:              s.portService,
 		ApplicationService:        s.applicationService,
@@ -504,6 +555,15 @@ func (s *firewallerBaseSuite) newFirewaller(c *tc.C, ctrl *gomock.Controller) wo
 		s.firewaller.EXPECT().WatchModelFirewallRules(gomock.Any()).Return(fwRulesWatcher, nil)
 	}
 
+	if s.mode == config.FwInstance && s.withEgress {
+		modelCfg, err := config.New(config.UseDefaults, coretesting.FakeConfig())
+		c.Assert(err, tc.ErrorIsNil)
+		s.firewaller.EXPECT().ModelConfig(gomock.Any()).Return(modelCfg, nil).MaxTimes(1)
+
+		modelConfigWatcher := watchertest.NewMockNotifyWatcher(s.modelConfigCh)
+		s.firewaller.EXPECT().WatchForModelConfigChanges(gomock.Any()).Return(modelConfigWatcher, nil)
+	}
+
 	initialised := make(chan bool)
 	s.firewaller.EXPECT().AllSpaceInfos(gomock.Any()).DoAndReturn(func(context.Context) (network.SpaceInfos, error) {
 		defer close(initialised)
@@ -611,6 +671,21 @@ func (s *firewallerBaseSuite) startInstance(c *tc.C, ctrl *gomock.Controller, m
 		return nil
 	}).AnyTimes()
 
+	inst.EXPECT().EgressRules(gomock.Any(), m.Tag().Id()).DoAndReturn(func(_ context.Context, machineId string) (firewall.EgressRules, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.instanceEgress[machineId], nil
+	}).AnyTimes()
+
+	inst.EXPECT().SetEgressRules(gomock.Any(), m.Tag().Id(), gomock.Any()).DoAndReturn(func(_ context.Context, machineId string, rules firewall.EgressRules) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		c.Logf("set egress rules for %q: %v\n", instID, rules)
+		s.instanceEgress[machineId] = rules
+		return nil
+	}).AnyTimes()
+
 	// Start the machine.
 	s.machinesCh <- []string{m.Tag().Id()}
 	if s.firewallerStarted {
@@ -795,6 +870,116 @@ func (s *InstanceModeSuite) TestMultipleExposedApplications(c *tc.C) {
 	s.assertIngressRules(c, m2.Tag().Id(), nil)
 }
 
+func (s *InstanceModeSuite) TestApplicationEgressRules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	s.ensureMocks(c, ctrl)
+	s.firewaller.EXPECT().ControllerAPIInfoForModel(gomock.Any(), coretesting.ModelTag.Id()).Return(&api.Info{
+		Addrs: []string{"10.1.1.1:17070", "controller.example.com:17070"},
+	}, nil).AnyTimes()
+
+	fw := s.newFirewaller(c, ctrl)
+	defer workertest.CleanKill(c, fw)
+
+	s.appEgress["mysql"] = firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	}
+	app, _, egressCh := s.addApplicationWithEgress(ctrl, "mysql", false)
+	_, _, m, _ := s.addUnit(c, ctrl, app)
+	s.startInstance(c, ctrl, m)
+
+	// The controller API address is always allowed; the non-IP address is
+	// skipped.
+	s.assertEgressRules(c, m.Tag().Id(), firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+		firewall.NewEgressRule(network.MustParsePortRange("17070/tcp"), "10.1.1.1/32"),
+	})
+
+	s.mu.Lock()
+	s.appEgress["mysql"] = firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+		firewall.NewEgressRule(network.MustParsePortRange("53/udp"), "10.0.0.2/32"),
+	}
+	s.mu.Unlock()
+	egressCh <- struct{}{}
+
+	s.assertEgressRules(c, m.Tag().Id(), firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("53/udp"), "10.0.0.2/32"),
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+		firewall.NewEgressRule(network.MustParsePortRange("17070/tcp"), "10.1.1.1/32"),
+	})
+
+	// Removing the rules makes outbound traffic unrestricted again.
+	s.mu.Lock()
+	s.appEgress["mysql"] = firewall.EgressRules{}
+	s.mu.Unlock()
+	egressCh <- struct{}{}
+
+	s.assertEgressRules(c, m.Tag().Id(), nil)
+}
+
+func (s *InstanceModeSuite) TestEgressDefaultDeny(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	s.ensureMocks(c, ctrl)
+	s.firewaller.EXPECT().ControllerAPIInfoForModel(gomock.Any(), coretesting.ModelTag.Id()).Return(&api.Info{
+		Addrs: []string{"10.1.1.1:17070"},
+	}, nil).AnyTimes()
+
+	fw := s.newFirewaller(c, ctrl)
+	defer workertest.CleanKill(c, fw)
+
+	app, _ := s.addApplication(ctrl, "wordpress", false)
+	_, _, m, _ := s.addUnit(c, ctrl, app)
+	s.startInstance(c, ctrl, m)
+
+	s.assertEgressRules(c, m.Tag().Id(), nil)
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		config.EgressDefaultDenyKey: true,
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	s.firewaller.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+	s.modelConfigCh <- struct{}{}
+
+	// Only the controller can be reached.
+	s.assertEgressRules(c, m.Tag().Id(), firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("17070/tcp"), "10.1.1.1/32"),
+	})
+}
+
+func (s *InstanceModeSuite) TestEgressNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	s.withEgress = false
+	s.ensureMocks(c, ctrl)
+
+	fw := s.newFirewaller(c, ctrl)
+	defer workertest.CleanKill(c, fw)
+
+	s.appEgress["mysql"] = firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	}
+	app, _ := s.addApplication(ctrl, "mysql", true)
+	unitUUID, u, m, _ := s.addUnit(c, ctrl, app)
+	s.startInstance(c, ctrl, m)
+	s.mustOpenPortRanges(c, unitUUID, u, allEndpoints, []network.PortRange{
+		network.MustParsePortRange("3306/tcp"),
+	})
+
+	// Ingress rules are still applied, but egress rules are neither read
+	// nor applied.
+	s.assertIngressRules(c, m.Tag().Id(), firewall.IngressRules{
+		firewall.NewIngressRule(network.MustParsePortRange("3306/tcp"), firewall.AllNetworksIPV4CIDR),
+	})
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c.Check(s.instanceEgress[m.Tag().Id()], tc.IsNil)
+}
+
 func (s *InstanceModeSuite) TestMachineWithoutInstanceId(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -1021,6 +1206,7 @@ func (s *InstanceModeSuite) addMachineUnitAndEnsureMocks(c *tc.C, ctrl *gomock.C
 	inst := mocks.NewMockEnvironInstance(ctrl)
 	s.envInstances.EXPECT().Instances(gomock.Any(), []instance.Id{instId}).Return([]instances.Instance{inst}, nil).Times(1)
 	inst.EXPECT().IngressRules(gomock.Any(), m.Tag().Id()).Return(nil, nil).Times(1)
+	inst.EXPECT().EgressRules(gomock.Any(), m.Tag().Id()).Return(nil, nil).Times(1)
 
 	// Add a machine.
 	s.machinesCh <- []string{tag.Id()}
@@ -36,6 +36,7 @@ type FirewallerAPI interface {
 	WatchModelFirewallRules(context.Context) (watcher.NotifyWatcher, error)
 	ModelFirewallRules(context.Context) (firewall.IngressRules, error)
 	ModelConfig(context.Context) (*config.Config, error)
+	WatchForModelConfigChanges(context.Context) (watcher.NotifyWatcher, error)
 	Machine(ctx context.Context, tag names.MachineTag) (Machine, error)
 	Unit(ctx context.Context, tag names.UnitTag) (Unit, error)
 	Relation(ctx context.Context, tag names.RelationTag) (*firewaller.Relation, error)
@@ -162,6 +163,14 @@ type ApplicationService interface {
 	// opened ports for each endpoint once the application is exposed.
 	GetExposedEndpoints(ctx context.Context, appName string) (map[string]application.ExposedEndpoint, error)
 
+	// WatchApplicationEgressRules watches for changes to the specified
+	// application's egress rules.
+	WatchApplicationEgressRules(ctx context.Context, name string) (watcher.NotifyWatcher, error)
+
+	// GetApplicationEgressRules returns the egress rules for the specified
+	// application. An empty list means that no rules have been set.
+	GetApplicationEgressRules(ctx context.Context, appName string) (firewall.EgressRules, error)
+
 	// GetUnitMachineName gets the name of the unit's machine.
 	//
 	// The following errors may be returned:
@@ -196,6 +205,7 @@ type EnvironInstances interface {
 type EnvironInstance interface {
 	instances.Instance
 	instances.InstanceFirewaller
+	instances.InstanceEgressFirewaller
 }
 
 // Machine represents a model machine.
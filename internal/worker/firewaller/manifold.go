@@ -139,6 +139,15 @@ func (cfg ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (
 		}
 	}
 
+	// Check if the env can restrict the outbound traffic of instances.
+	var envEgressSupport bool
+	if featQuerier, ok := environ.(environs.EgressFirewallFeatureQuerier); ok {
+		var err error
+		if envEgressSupport, err = featQuerier.SupportsEgressRules(ctx); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
 	w, err := cfg.NewFirewallerWorker(Config{
 		ModelUUID:                 agent.CurrentConfig().Model().Id(),
 		CrossModelRelationService: domainServices.CrossModelRelation(),
@@ -150,6 +159,7 @@ func (cfg ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (
 		EnvironModelFirewaller:    modelFw,
 		EnvironInstances:          environ,
 		EnvironIPV6CIDRSupport:    envIPV6CIDRSupport,
+		EnvironEgressSupport:      envEgressSupport,
 		Mode:                      mode,
 		NewCrossModelFacadeFunc:   crossmodelFirewallerFacadeFunc(cfg.NewControllerConnection),
 		Logger:                    cfg.Logger,
@@ -16,6 +16,7 @@ import (
 	machine "github.com/juju/juju/core/machine"
 	model "github.com/juju/juju/core/model"
 	network "github.com/juju/juju/core/network"
+	firewall "github.com/juju/juju/core/network/firewall"
 	relation "github.com/juju/juju/core/relation"
 	unit "github.com/juju/juju/core/unit"
 	watcher "github.com/juju/juju/core/watcher"
@@ -149,6 +150,45 @@ func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
 	return m.recorder
 }
 
+// GetApplicationEgressRules mocks base method.
+func (m *MockApplicationService) GetApplicationEgressRules(arg0 context.Context, arg1 string) (firewall.EgressRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationEgressRules", arg0, arg1)
+	ret0, _ := ret[0].(firewall.EgressRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationEgressRules indicates an expected call of GetApplicationEgressRules.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationEgressRules(arg0, arg1 any) *MockApplicationServiceGetApplicationEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationEgressRules", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationEgressRules), arg0, arg1)
+	return &MockApplicationServiceGetApplicationEgressRulesCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationEgressRulesCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationEgressRulesCall) Return(arg0 firewall.EgressRules, arg1 error) *MockApplicationServiceGetApplicationEgressRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationEgressRulesCall) Do(f func(context.Context, string) (firewall.EgressRules, error)) *MockApplicationServiceGetApplicationEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationEgressRulesCall) DoAndReturn(f func(context.Context, string) (firewall.EgressRules, error)) *MockApplicationServiceGetApplicationEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetExposedEndpoints mocks base method.
 func (m *MockApplicationService) GetExposedEndpoints(arg0 context.Context, arg1 string) (map[string]application.ExposedEndpoint, error) {
 	m.ctrl.T.Helper()
@@ -345,6 +385,45 @@ func (c *MockApplicationServiceIsApplicationExposedCall) DoAndReturn(f func(cont
 	return c
 }
 
+// WatchApplicationEgressRules mocks base method.
+func (m *MockApplicationService) WatchApplicationEgressRules(arg0 context.Context, arg1 string) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchApplicationEgressRules", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchApplicationEgressRules indicates an expected call of WatchApplicationEgressRules.
+func (mr *MockApplicationServiceMockRecorder) WatchApplicationEgressRules(arg0, arg1 any) *MockApplicationServiceWatchApplicationEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchApplicationEgressRules", reflect.TypeOf((*MockApplicationService)(nil).WatchApplicationEgressRules), arg0, arg1)
+	return &MockApplicationServiceWatchApplicationEgressRulesCall{Call: call}
+}
+
+// MockApplicationServiceWatchApplicationEgressRulesCall wrap *gomock.Call
+type MockApplicationServiceWatchApplicationEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceWatchApplicationEgressRulesCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockApplicationServiceWatchApplicationEgressRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceWatchApplicationEgressRulesCall) Do(f func(context.Context, string) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchApplicationEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceWatchApplicationEgressRulesCall) DoAndReturn(f func(context.Context, string) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchApplicationEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchApplicationExposed mocks base method.
 func (m *MockApplicationService) WatchApplicationExposed(arg0 context.Context, arg1 string) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
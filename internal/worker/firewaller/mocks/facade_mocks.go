@@ -362,6 +362,45 @@ func (c *MockFirewallerAPIUnitCall) DoAndReturn(f func(context.Context, names.Un
 	return c
 }
 
+// WatchForModelConfigChanges mocks base method.
+func (m *MockFirewallerAPI) WatchForModelConfigChanges(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchForModelConfigChanges", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchForModelConfigChanges indicates an expected call of WatchForModelConfigChanges.
+func (mr *MockFirewallerAPIMockRecorder) WatchForModelConfigChanges(arg0 any) *MockFirewallerAPIWatchForModelConfigChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchForModelConfigChanges", reflect.TypeOf((*MockFirewallerAPI)(nil).WatchForModelConfigChanges), arg0)
+	return &MockFirewallerAPIWatchForModelConfigChangesCall{Call: call}
+}
+
+// MockFirewallerAPIWatchForModelConfigChangesCall wrap *gomock.Call
+type MockFirewallerAPIWatchForModelConfigChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFirewallerAPIWatchForModelConfigChangesCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockFirewallerAPIWatchForModelConfigChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFirewallerAPIWatchForModelConfigChangesCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockFirewallerAPIWatchForModelConfigChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFirewallerAPIWatchForModelConfigChangesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockFirewallerAPIWatchForModelConfigChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchModelFirewallRules mocks base method.
 func (m *MockFirewallerAPI) WatchModelFirewallRules(arg0 context.Context) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
@@ -1055,6 +1094,45 @@ func (c *MockEnvironInstanceClosePortsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// EgressRules mocks base method.
+func (m *MockEnvironInstance) EgressRules(arg0 context.Context, arg1 string) (firewall.EgressRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EgressRules", arg0, arg1)
+	ret0, _ := ret[0].(firewall.EgressRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EgressRules indicates an expected call of EgressRules.
+func (mr *MockEnvironInstanceMockRecorder) EgressRules(arg0, arg1 any) *MockEnvironInstanceEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EgressRules", reflect.TypeOf((*MockEnvironInstance)(nil).EgressRules), arg0, arg1)
+	return &MockEnvironInstanceEgressRulesCall{Call: call}
+}
+
+// MockEnvironInstanceEgressRulesCall wrap *gomock.Call
+type MockEnvironInstanceEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEnvironInstanceEgressRulesCall) Return(arg0 firewall.EgressRules, arg1 error) *MockEnvironInstanceEgressRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEnvironInstanceEgressRulesCall) Do(f func(context.Context, string) (firewall.EgressRules, error)) *MockEnvironInstanceEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEnvironInstanceEgressRulesCall) DoAndReturn(f func(context.Context, string) (firewall.EgressRules, error)) *MockEnvironInstanceEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Id mocks base method.
 func (m *MockEnvironInstance) Id() instance.Id {
 	m.ctrl.T.Helper()
@@ -1170,6 +1248,44 @@ func (c *MockEnvironInstanceOpenPortsCall) DoAndReturn(f func(context.Context, s
 	return c
 }
 
+// SetEgressRules mocks base method.
+func (m *MockEnvironInstance) SetEgressRules(arg0 context.Context, arg1 string, arg2 firewall.EgressRules) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEgressRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEgressRules indicates an expected call of SetEgressRules.
+func (mr *MockEnvironInstanceMockRecorder) SetEgressRules(arg0, arg1, arg2 any) *MockEnvironInstanceSetEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEgressRules", reflect.TypeOf((*MockEnvironInstance)(nil).SetEgressRules), arg0, arg1, arg2)
+	return &MockEnvironInstanceSetEgressRulesCall{Call: call}
+}
+
+// MockEnvironInstanceSetEgressRulesCall wrap *gomock.Call
+type MockEnvironInstanceSetEgressRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEnvironInstanceSetEgressRulesCall) Return(arg0 error) *MockEnvironInstanceSetEgressRulesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEnvironInstanceSetEgressRulesCall) Do(f func(context.Context, string, firewall.EgressRules) error) *MockEnvironInstanceSetEgressRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEnvironInstanceSetEgressRulesCall) DoAndReturn(f func(context.Context, string, firewall.EgressRules) error) *MockEnvironInstanceSetEgressRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Status mocks base method.
 func (m *MockEnvironInstance) Status(arg0 context.Context) instance.Status {
 	m.ctrl.T.Helper()
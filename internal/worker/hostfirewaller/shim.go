@@ -0,0 +1,44 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hostfirewaller
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/worker/v5"
+
+	apihostfirewaller "github.com/juju/juju/api/agent/hostfirewaller"
+	"github.com/juju/juju/api/base"
+)
+
+// NewFacade returns a Facade for the machine identified by tag.
+func NewFacade(apiCaller base.APICaller, tag names.MachineTag) (Facade, error) {
+	return apihostfirewaller.NewClient(apiCaller, tag), nil
+}
+
+// NewWorker returns a hostfirewaller worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	worker, err := New(config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return worker, nil
+}
+
+// ApplyNftables passes the script to "nft -f" on the standard input.
+func ApplyNftables(ctx context.Context, script string) error {
+	cmd := exec.CommandContext(ctx, "nft", "-f", "-")
+	cmd.Stdin = strings.NewReader(script)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if len(out) > 0 {
+			return errors.Annotatef(err, "running nft: %s", strings.TrimSpace(string(out)))
+		}
+		return errors.Annotate(err, "running nft")
+	}
+	return nil
+}
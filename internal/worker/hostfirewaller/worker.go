@@ -0,0 +1,126 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hostfirewaller
+
+import (
+	"context"
+	"os/exec"
+
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+
+	"github.com/juju/juju/api/agent/hostfirewaller"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/network/nftables"
+)
+
+// Facade exposes controller functionality to a Worker.
+type Facade interface {
+	// WatchHostFirewallRules returns a NotifyWatcher which fires when the
+	// inbound traffic the machine should accept may have changed.
+	WatchHostFirewallRules(ctx context.Context) (watcher.NotifyWatcher, error)
+
+	// HostFirewallRules returns the inbound traffic the machine should
+	// accept.
+	HostFirewallRules(ctx context.Context) (hostfirewaller.Rules, error)
+}
+
+// Config defines the parameters of the hostfirewaller worker.
+type Config struct {
+	Facade Facade
+
+	// ApplyNftables passes the script to "nft -f".
+	ApplyNftables func(ctx context.Context, script string) error
+
+	Logger logger.Logger
+}
+
+// Validate returns an error if Config cannot drive a hostfirewaller.
+func (config Config) Validate() error {
+	if config.Facade == nil {
+		return errors.NotValidf("nil Facade")
+	}
+	if config.ApplyNftables == nil {
+		return errors.NotValidf("nil ApplyNftables")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// New returns a worker which enforces the ports opened by the units on the
+// machine with the backend set by the host-firewall model config.
+func New(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	w, err := watcher.NewNotifyWorker(watcher.NotifyConfig{
+		Handler: &hostFirewaller{config: config},
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// hostFirewaller is a watcher.NotifyHandler which keeps the rule set
+// installed on the machine up to date.
+type hostFirewaller struct {
+	config Config
+
+	// applied is the script most recently applied, used to avoid
+	// rewriting the rule set when nothing has changed.
+	applied string
+}
+
+// SetUp is defined on the watcher.NotifyHandler interface.
+func (w *hostFirewaller) SetUp(ctx context.Context) (watcher.NotifyWatcher, error) {
+	return w.config.Facade.WatchHostFirewallRules(ctx)
+}
+
+// Handle is defined on the watcher.NotifyHandler interface.
+func (w *hostFirewaller) Handle(ctx context.Context) error {
+	rules, err := w.config.Facade.HostFirewallRules(ctx)
+	if err != nil {
+		return errors.Annotate(err, "getting host firewall rules")
+	}
+
+	enabled := rules.Backend == config.HostFirewallNftables
+	script := nftables.RenderDelete()
+	if enabled {
+		script = nftables.Ruleset{
+			IngressRules: rules.IngressRules,
+			ModelCIDRs:   rules.ModelCIDRs,
+		}.Render()
+	}
+	if script == w.applied {
+		return nil
+	}
+
+	err = w.config.ApplyNftables(ctx, script)
+	if !enabled && errors.Is(err, exec.ErrNotFound) {
+		// There can't be any rules to remove without nftables.
+		w.config.Logger.Debugf(ctx, "nftables not installed, no host firewall rules to remove")
+	} else if err != nil {
+		return errors.Annotate(err, "applying nftables rule set")
+	}
+
+	if enabled {
+		w.config.Logger.Infof(ctx, "applied host firewall ingress rules %v", rules.IngressRules)
+	} else if w.applied != "" {
+		w.config.Logger.Infof(ctx, "removed host firewall rules")
+	}
+	w.applied = script
+	return nil
+}
+
+// TearDown is defined on the watcher.NotifyHandler interface. The rule set
+// is left in place, so that the machine stays protected while the agent is
+// restarting.
+func (w *hostFirewaller) TearDown() error {
+	return nil
+}
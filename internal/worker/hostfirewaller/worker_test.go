@@ -0,0 +1,179 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hostfirewaller_test
+
+import (
+	"context"
+	"os/exec"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	apihostfirewaller "github.com/juju/juju/api/agent/hostfirewaller"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/network/nftables"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/hostfirewaller"
+)
+
+type Suite struct {
+	testhelpers.IsolationSuite
+
+	facade  *stubFacade
+	applied chan string
+	applyFn func(string) error
+	config  hostfirewaller.Config
+}
+
+func TestSuite(t *testing.T) {
+	tc.Run(t, &Suite{})
+}
+
+func (s *Suite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.facade = &stubFacade{
+		changes: make(chan struct{}, 1),
+	}
+	s.applied = make(chan string, 10)
+	s.applyFn = func(string) error { return nil }
+	s.config = hostfirewaller.Config{
+		Facade: s.facade,
+		ApplyNftables: func(_ context.Context, script string) error {
+			s.applied <- script
+			return s.applyFn(script)
+		},
+		Logger: loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *Suite) TestInvalidConfig(c *tc.C) {
+	s.config.ApplyNftables = nil
+	_, err := hostfirewaller.New(s.config)
+	c.Check(err, tc.ErrorMatches, "nil ApplyNftables not valid")
+}
+
+func (s *Suite) TestDisabledRemovesRules(c *tc.C) {
+	s.facade.setRules(apihostfirewaller.Rules{Backend: "none"})
+
+	w, err := hostfirewaller.New(s.config)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(s.nextApplied(c), tc.Equals, nftables.RenderDelete())
+
+	// Nothing is applied if nothing has changed.
+	s.facade.changes <- struct{}{}
+	s.assertNothingApplied(c)
+}
+
+func (s *Suite) TestDisabledWithoutNftables(c *tc.C) {
+	s.facade.setRules(apihostfirewaller.Rules{Backend: "none"})
+	s.applyFn = func(string) error {
+		return errors.Annotate(exec.ErrNotFound, "running nft")
+	}
+
+	w, err := hostfirewaller.New(s.config)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(s.nextApplied(c), tc.Equals, nftables.RenderDelete())
+	workertest.CheckAlive(c, w)
+}
+
+func (s *Suite) TestEnabledAppliesRules(c *tc.C) {
+	rules := apihostfirewaller.Rules{
+		Backend: "nftables",
+		IngressRules: firewall.IngressRules{
+			firewall.NewIngressRule(network.MustParsePortRange("80/tcp"), "0.0.0.0/0"),
+		},
+		ModelCIDRs: []string{"10.0.0.0/24"},
+	}
+	s.facade.setRules(rules)
+
+	w, err := hostfirewaller.New(s.config)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(s.nextApplied(c), tc.Equals, nftables.Ruleset{
+		IngressRules: rules.IngressRules,
+		ModelCIDRs:   rules.ModelCIDRs,
+	}.Render())
+
+	// Disabling the host firewall removes the rules.
+	s.facade.setRules(apihostfirewaller.Rules{Backend: "none"})
+	s.facade.changes <- struct{}{}
+	c.Check(s.nextApplied(c), tc.Equals, nftables.RenderDelete())
+}
+
+func (s *Suite) TestApplyError(c *tc.C) {
+	s.facade.setRules(apihostfirewaller.Rules{Backend: "nftables"})
+	s.applyFn = func(string) error { return errors.New("boom") }
+
+	w, err := hostfirewaller.New(s.config)
+	c.Assert(err, tc.ErrorIsNil)
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, tc.ErrorMatches, "applying nftables rule set: boom")
+}
+
+func (s *Suite) TestRulesError(c *tc.C) {
+	s.facade.rulesErr = errors.New("boom")
+
+	w, err := hostfirewaller.New(s.config)
+	c.Assert(err, tc.ErrorIsNil)
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, tc.ErrorMatches, "getting host firewall rules: boom")
+}
+
+func (s *Suite) nextApplied(c *tc.C) string {
+	select {
+	case script := <-s.applied:
+		return script
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for rule set to be applied")
+	}
+	return ""
+}
+
+func (s *Suite) assertNothingApplied(c *tc.C) {
+	select {
+	case script := <-s.applied:
+		c.Fatalf("unexpected rule set applied: %q", script)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
+
+type stubFacade struct {
+	changes  chan struct{}
+	rulesErr error
+
+	mu    sync.Mutex
+	rules apihostfirewaller.Rules
+}
+
+func (f *stubFacade) setRules(rules apihostfirewaller.Rules) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rules = rules
+}
+
+func (f *stubFacade) WatchHostFirewallRules(context.Context) (watcher.NotifyWatcher, error) {
+	f.changes <- struct{}{}
+	return watchertest.NewMockNotifyWatcher(f.changes), nil
+}
+
+func (f *stubFacade) HostFirewallRules(context.Context) (apihostfirewaller.Rules, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rules, f.rulesErr
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package logexporter provides a worker that forwards the log records
+// written to the controller agent log sink to an external collector.
+//
+// The collector is configured with the controller.LogExportProtocol,
+// controller.LogExportEndpoint and controller.LogExportCACert controller
+// config keys, and the worker replaces its exporter whenever any of them
+// change. Records can be sent using OTLP logs over HTTP, or as RFC 5424
+// syslog messages over TCP or TLS. An empty protocol disables exporting.
+//
+// Records keep their model UUID, entity and labels. They are held in memory
+// briefly and then appended to an on-disk buffer in the agent data dir, so
+// that records survive both collector outages and agent restarts. The size
+// of the buffer is bounded by controller.LogExportBufferSize; when it is
+// full the oldest records are dropped. Exports that fail are retried with
+// exponential backoff, while records the collector rejects outright are
+// dropped.
+//
+// See github.com/juju/juju/internal/logexport for the exporters and buffer.
+//
+// The worker runs on every controller, exporting the records of the agents
+// connected to that controller.
+package logexporter
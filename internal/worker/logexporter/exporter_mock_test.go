@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/logexport (interfaces: Exporter)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package logexporter -destination exporter_mock_test.go github.com/juju/juju/internal/logexport Exporter
+//
+
+// Package logexporter is a generated GoMock package.
+package logexporter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	logger "github.com/juju/juju/core/logger"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExporter is a mock of Exporter interface.
+type MockExporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockExporterMockRecorder
+}
+
+// MockExporterMockRecorder is the mock recorder for MockExporter.
+type MockExporterMockRecorder struct {
+	mock *MockExporter
+}
+
+// NewMockExporter creates a new mock instance.
+func NewMockExporter(ctrl *gomock.Controller) *MockExporter {
+	mock := &MockExporter{ctrl: ctrl}
+	mock.recorder = &MockExporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExporter) EXPECT() *MockExporterMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockExporter) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockExporterMockRecorder) Close() *MockExporterCloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockExporter)(nil).Close))
+	return &MockExporterCloseCall{Call: call}
+}
+
+// MockExporterCloseCall wrap *gomock.Call
+type MockExporterCloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExporterCloseCall) Return(arg0 error) *MockExporterCloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExporterCloseCall) Do(f func() error) *MockExporterCloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExporterCloseCall) DoAndReturn(f func() error) *MockExporterCloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Export mocks base method.
+func (m *MockExporter) Export(arg0 context.Context, arg1 []logger.LogRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockExporterMockRecorder) Export(arg0, arg1 any) *MockExporterExportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockExporter)(nil).Export), arg0, arg1)
+	return &MockExporterExportCall{Call: call}
+}
+
+// MockExporterExportCall wrap *gomock.Call
+type MockExporterExportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExporterExportCall) Return(arg0 error) *MockExporterExportCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExporterExportCall) Do(f func(context.Context, []logger.LogRecord) error) *MockExporterExportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExporterExportCall) DoAndReturn(f func(context.Context, []logger.LogRecord) error) *MockExporterExportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexporter
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/logexport"
+	"github.com/juju/juju/internal/services"
+)
+
+// bufferDirName is the name of the directory, within the agent data dir,
+// holding records waiting to be exported.
+const bufferDirName = "log-export"
+
+// ManifoldConfig describes the resources used by the log exporter worker.
+type ManifoldConfig struct {
+	AgentName          string
+	DomainServicesName string
+
+	// LogForwarder is the forwarder wrapping the agent log sink.
+	LogForwarder LogForwarder
+
+	// GetControllerConfigService is used to extract the controller config
+	// service from the domain services dependency.
+	GetControllerConfigService func(getter dependency.Getter, name string) (ControllerConfigService, error)
+
+	NewExporter func(logexport.Config) (logexport.Exporter, error)
+	NewWorker   func(Config) (worker.Worker, error)
+	Clock       clock.Clock
+	Logger      logger.Logger
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.AgentName == "" {
+		return errors.NotValidf("empty AgentName")
+	}
+	if config.DomainServicesName == "" {
+		return errors.NotValidf("empty DomainServicesName")
+	}
+	if config.LogForwarder == nil {
+		return errors.NotValidf("nil LogForwarder")
+	}
+	if config.GetControllerConfigService == nil {
+		return errors.NotValidf("nil GetControllerConfigService")
+	}
+	if config.NewExporter == nil {
+		return errors.NotValidf("nil NewExporter")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// start starts the log exporter worker.
+func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var a agent.Agent
+	if err := getter.Get(config.AgentName, &a); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	controllerConfigService, err := config.GetControllerConfigService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := config.NewWorker(Config{
+		ControllerConfigService: controllerConfigService,
+		LogForwarder:            config.LogForwarder,
+		BufferDir:               filepath.Join(a.CurrentConfig().DataDir(), bufferDirName),
+		NewExporter:             config.NewExporter,
+		Clock:                   config.Clock,
+		Logger:                  config.Logger,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Manifold returns a Manifold that encapsulates the log exporter worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.AgentName,
+			config.DomainServicesName,
+		},
+		Start: config.start,
+	}
+}
+
+// GetControllerConfigService extracts the controller config service from the
+// controller domain services dependency.
+func GetControllerConfigService(getter dependency.Getter, name string) (ControllerConfigService, error) {
+	var services services.ControllerDomainServices
+	if err := getter.Get(name, &services); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return services.ControllerConfig(), nil
+}
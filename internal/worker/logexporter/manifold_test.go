@@ -0,0 +1,82 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/internal/logexport"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type manifoldSuite struct{}
+
+func TestManifoldSuite(t *testing.T) {
+	tc.Run(t, &manifoldSuite{})
+}
+
+func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	cfg := s.getConfig(c, ctrl)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	cfg = s.getConfig(c, ctrl)
+	cfg.AgentName = ""
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c, ctrl)
+	cfg.DomainServicesName = ""
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c, ctrl)
+	cfg.LogForwarder = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c, ctrl)
+	cfg.GetControllerConfigService = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c, ctrl)
+	cfg.NewExporter = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c, ctrl)
+	cfg.NewWorker = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c, ctrl)
+	cfg.Clock = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c, ctrl)
+	cfg.Logger = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+}
+
+func (s *manifoldSuite) getConfig(c *tc.C, ctrl *gomock.Controller) ManifoldConfig {
+	return ManifoldConfig{
+		AgentName:          "agent",
+		DomainServicesName: "domain-services",
+		LogForwarder:       NewMockLogForwarder(ctrl),
+		GetControllerConfigService: func(dependency.Getter, string) (ControllerConfigService, error) {
+			return nil, nil
+		},
+		NewExporter: logexport.NewExporter,
+		NewWorker: func(Config) (worker.Worker, error) {
+			return nil, nil
+		},
+		Clock:  testclock.NewClock(time.Now()),
+		Logger: loggertesting.WrapCheckLog(c),
+	}
+}
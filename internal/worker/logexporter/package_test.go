@@ -0,0 +1,8 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexporter
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package logexporter -destination watcher_mock_test.go github.com/juju/juju/core/watcher StringsWatcher
+//go:generate go run go.uber.org/mock/mockgen -typed -package logexporter -destination exporter_mock_test.go github.com/juju/juju/internal/logexport Exporter
+//go:generate go run go.uber.org/mock/mockgen -typed -package logexporter -destination services_mock_test.go github.com/juju/juju/internal/worker/logexporter ControllerConfigService,LogForwarder
@@ -0,0 +1,379 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexporter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/collections/set"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/logexport"
+)
+
+const (
+	// flushInterval is how often pending records are written to the
+	// buffer and an export is attempted.
+	flushInterval = time.Second
+
+	// maxPending is the maximum number of records held in memory between
+	// flushes. The oldest records are dropped beyond this.
+	maxPending = 10000
+
+	// maxSegmentsPerFlush bounds the number of buffered segments exported
+	// in a single flush, so that config changes and shutdown are not held
+	// up by a large backlog.
+	maxSegmentsPerFlush = 10
+
+	// minBackoff and maxBackoff bound the delay between attempts to export
+	// to a collector that is failing.
+	minBackoff = time.Second
+	maxBackoff = 5 * time.Minute
+)
+
+// configKeys are the controller config keys the worker responds to.
+var configKeys = set.NewStrings(
+	controller.LogExportProtocol,
+	controller.LogExportEndpoint,
+	controller.LogExportCACert,
+	controller.LogExportBufferSize,
+)
+
+// ControllerConfigService is an interface that provides access to the
+// controller configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller config.
+	ControllerConfig(context.Context) (controller.Config, error)
+	// WatchControllerConfig returns a watcher that returns keys for any
+	// changes to controller config.
+	WatchControllerConfig(context.Context) (watcher.StringsWatcher, error)
+}
+
+// LogForwarder forwards the records written to the agent log sink to a
+// secondary log writer.
+type LogForwarder interface {
+	// SetLogWriter sets the writer that records are forwarded to. A nil
+	// writer stops forwarding.
+	SetLogWriter(logger.LogWriter)
+}
+
+// Config is the configuration for the log exporter worker.
+type Config struct {
+	ControllerConfigService ControllerConfigService
+	LogForwarder            LogForwarder
+	BufferDir               string
+	NewExporter             func(logexport.Config) (logexport.Exporter, error)
+	Clock                   clock.Clock
+	Logger                  logger.Logger
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.ControllerConfigService == nil {
+		return errors.Errorf("nil ControllerConfigService").Add(coreerrors.NotValid)
+	}
+	if config.LogForwarder == nil {
+		return errors.Errorf("nil LogForwarder").Add(coreerrors.NotValid)
+	}
+	if config.BufferDir == "" {
+		return errors.Errorf("empty BufferDir").Add(coreerrors.NotValid)
+	}
+	if config.NewExporter == nil {
+		return errors.Errorf("nil NewExporter").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil clock.Clock").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// exporterWorker is a worker that forwards agent log records to an external
+// collector.
+type exporterWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+	buffer   *logexport.Buffer
+
+	// The following are only accessed from the loop goroutine.
+	exporter     logexport.Exporter
+	exportConfig logexport.Config
+	backoff      time.Duration
+	nextAttempt  time.Time
+
+	// pendingMu guards pending and droppedRecords. It is taken by Log,
+	// which is called for every record written to the agent log sink,
+	// so nothing must be logged while it is held.
+	pendingMu      sync.Mutex
+	pending        []logger.LogRecord
+	droppedRecords int
+
+	// mu guards the fields below it, which are only used for reporting.
+	mu        sync.Mutex
+	protocol  string
+	endpoint  string
+	lastError string
+}
+
+// NewWorker returns a new log exporter worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	buffer, err := logexport.NewBuffer(config.BufferDir, int64(controller.DefaultLogExportBufferSize)<<20)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &exporterWorker{
+		config: config,
+		buffer: buffer,
+	}
+	err = catacomb.Invoke(catacomb.Plan{
+		Name: "log-exporter",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *exporterWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *exporterWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Log is part of the logger.LogWriter interface. The records are held in
+// memory until the next flush, so that writing to the agent log sink is
+// never blocked on the disk buffer or the collector.
+func (w *exporterWorker) Log(records []logger.LogRecord) error {
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+
+	w.pending = append(w.pending, records...)
+	if over := len(w.pending) - maxPending; over > 0 {
+		w.pending = w.pending[over:]
+		w.droppedRecords += over
+	}
+	return nil
+}
+
+// Report shows up in the dependency engine report.
+func (w *exporterWorker) Report(ctx context.Context) map[string]any {
+	w.pendingMu.Lock()
+	pending := len(w.pending)
+	droppedRecords := w.droppedRecords
+	w.pendingMu.Unlock()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	report := map[string]any{
+		"protocol":         w.protocol,
+		"endpoint":         w.endpoint,
+		"pending":          pending,
+		"buffered":         w.buffer.Len(),
+		"dropped-records":  droppedRecords,
+		"dropped-segments": w.buffer.Dropped(),
+	}
+	if w.lastError != "" {
+		report["last-error"] = w.lastError
+	}
+	return report
+}
+
+// loop is the worker's main loop.
+//   - It watches for changes to the controller configuration, and starts,
+//     replaces or stops the exporter accordingly.
+//   - It periodically moves pending records to the disk buffer and exports
+//     the buffer, backing off while the collector is failing.
+func (w *exporterWorker) loop() (err error) {
+	ctx := w.catacomb.Context(context.Background())
+
+	defer func() {
+		w.config.LogForwarder.SetLogWriter(nil)
+		if w.exporter != nil {
+			// Keep whatever has not been exported for the next run.
+			if flushErr := w.flushPending(); flushErr != nil && err == nil {
+				err = flushErr
+			}
+			_ = w.exporter.Close()
+		}
+	}()
+
+	watch, err := w.config.ControllerConfigService.WatchControllerConfig(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := w.catacomb.Add(watch); err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := w.updateConfig(ctx); err != nil {
+		return errors.Capture(err)
+	}
+
+	timer := w.config.Clock.NewTimer(flushInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+
+		case keys, ok := <-watch.Changes():
+			if !ok {
+				return errors.New("controller config watcher closed")
+			}
+			changes := set.NewStrings(keys...)
+			if changes.Intersection(configKeys).IsEmpty() {
+				continue
+			}
+			if err := w.updateConfig(ctx); err != nil {
+				return errors.Capture(err)
+			}
+
+		case <-timer.Chan():
+			if w.exporter != nil {
+				if err := w.flushPending(); err != nil {
+					return errors.Capture(err)
+				}
+				if err := w.export(ctx); err != nil {
+					return errors.Capture(err)
+				}
+			}
+			timer.Reset(flushInterval)
+		}
+	}
+}
+
+// flushPending moves the pending records to the disk buffer.
+func (w *exporterWorker) flushPending() error {
+	w.pendingMu.Lock()
+	records := w.pending
+	w.pending = nil
+	w.pendingMu.Unlock()
+
+	if err := w.buffer.Append(records); err != nil {
+		return errors.Errorf("buffering log records: %w", err)
+	}
+	return nil
+}
+
+// export sends the oldest buffered segments to the collector. A segment is
+// removed once it has been exported, or if the collector rejects it. Any
+// other failure leaves it in the buffer and backs off before trying again.
+func (w *exporterWorker) export(ctx context.Context) error {
+	now := w.config.Clock.Now()
+	if now.Before(w.nextAttempt) {
+		return nil
+	}
+
+	for i := 0; i < maxSegmentsPerFlush; i++ {
+		seq, records, ok, err := w.buffer.Oldest()
+		if err != nil {
+			return errors.Capture(err)
+		} else if !ok {
+			return nil
+		}
+
+		err = w.exporter.Export(ctx, records)
+		if errors.Is(err, logexport.ErrRejected) {
+			w.config.Logger.Warningf(ctx, "dropping %d log records: %v", len(records), err)
+			w.setLastError(err)
+		} else if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			w.backoff = min(max(w.backoff*2, minBackoff), maxBackoff)
+			w.nextAttempt = now.Add(w.backoff)
+			w.config.Logger.Warningf(ctx, "exporting log records, retrying in %v: %v", w.backoff, err)
+			w.setLastError(err)
+			return nil
+		} else {
+			w.backoff = 0
+		}
+
+		if err := w.buffer.Remove(seq); err != nil {
+			return errors.Capture(err)
+		}
+	}
+	return nil
+}
+
+// updateConfig reads the log export settings from controller config and
+// replaces the exporter if they have changed.
+func (w *exporterWorker) updateConfig(ctx context.Context) error {
+	cfg, err := w.config.ControllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return errors.Errorf("getting controller config: %w", err)
+	}
+	w.buffer.SetMaxSize(int64(cfg.LogExportBufferSizeMB()) << 20)
+
+	exportConfig := logexport.Config{
+		Protocol:       cfg.LogExportProtocol(),
+		Endpoint:       cfg.LogExportEndpoint(),
+		CACert:         cfg.LogExportCACert(),
+		ControllerUUID: cfg.ControllerUUID(),
+	}
+	if w.exporter != nil && exportConfig == w.exportConfig {
+		return nil
+	}
+
+	if w.exporter != nil {
+		_ = w.exporter.Close()
+		w.exporter = nil
+	}
+	w.exportConfig = exportConfig
+	w.backoff = 0
+	w.nextAttempt = time.Time{}
+
+	w.mu.Lock()
+	w.protocol = exportConfig.Protocol
+	w.endpoint = exportConfig.Endpoint
+	w.lastError = ""
+	w.mu.Unlock()
+
+	if exportConfig.Protocol == "" {
+		// Exporting is disabled, so discard anything still waiting to be
+		// sent rather than sending it if exporting is enabled later.
+		w.config.LogForwarder.SetLogWriter(nil)
+		w.pendingMu.Lock()
+		w.pending = nil
+		w.pendingMu.Unlock()
+		if err := w.buffer.Clear(); err != nil {
+			return errors.Errorf("clearing log export buffer: %w", err)
+		}
+		w.config.Logger.Debugf(ctx, "log export disabled")
+		return nil
+	}
+
+	exporter, err := w.config.NewExporter(exportConfig)
+	if err != nil {
+		return errors.Errorf("creating log exporter: %w", err)
+	}
+	w.exporter = exporter
+	w.config.LogForwarder.SetLogWriter(w)
+	w.config.Logger.Infof(ctx, "exporting logs to %s using %s", exportConfig.Endpoint, exportConfig.Protocol)
+	return nil
+}
+
+func (w *exporterWorker) setLastError(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastError = err.Error()
+}
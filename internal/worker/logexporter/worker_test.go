@@ -0,0 +1,383 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logexporter
+
+import (
+	"context"
+	"fmt"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
+	corelogger "github.com/juju/juju/core/logger"
+	coretesting "github.com/juju/juju/core/testing"
+	corewatcher "github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/logexport"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+)
+
+func TestConfigSuite(t *stdtesting.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *stdtesting.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		ControllerConfigService: NewMockControllerConfigService(ctrl),
+		LogForwarder:            NewMockLogForwarder(ctrl),
+		BufferDir:               c.MkDir(),
+		NewExporter: func(logexport.Config) (logexport.Exporter, error) {
+			return nil, nil
+		},
+		Clock:  testclock.NewClock(time.Now()),
+		Logger: loggertesting.WrapCheckLog(c),
+	}
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.ControllerConfigService = nil
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	testCfg = origCfg
+	testCfg.LogForwarder = nil
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	testCfg = origCfg
+	testCfg.BufferDir = ""
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	testCfg = origCfg
+	testCfg.NewExporter = nil
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	testCfg = origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+}
+
+type workerSuite struct {
+	ctrl                    *gomock.Controller
+	clock                   *testclock.Clock
+	controllerConfigService *MockControllerConfigService
+	forwarder               *MockLogForwarder
+	exporter                *MockExporter
+	configChanges           chan []string
+	bufferDir               string
+	exportConfigs           chan logexport.Config
+}
+
+func (s *workerSuite) TestDisabledExportsNothing(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.forwarder.EXPECT().SetLogWriter(nil).Times(2)
+
+	w := s.startWorker(c, "")
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(w.(corelogger.LogWriter).Log(makeRecords(1)), tc.ErrorIsNil)
+	err := s.clock.WaitAdvance(flushInterval, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	select {
+	case cfg := <-s.exportConfigs:
+		c.Fatalf("unexpected exporter created: %v", cfg)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
+
+func (s *workerSuite) TestExport(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectForwarding()
+	exported := s.expectExport(nil)
+	s.exporter.EXPECT().Close().Return(nil)
+
+	w := s.startWorker(c, controller.LogExportOTLPHTTP)
+	defer workertest.CleanKill(c, w)
+
+	cfg := s.waitForExporter(c)
+	c.Check(cfg, tc.DeepEquals, logexport.Config{
+		Protocol:       controller.LogExportOTLPHTTP,
+		Endpoint:       "https://collector.example.com/v1/logs",
+		ControllerUUID: testing.ControllerTag.Id(),
+	})
+
+	records := makeRecords(2)
+	c.Assert(w.(corelogger.LogWriter).Log(records), tc.ErrorIsNil)
+	err := s.clock.WaitAdvance(flushInterval, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(s.waitForExport(c, exported), tc.DeepEquals, records)
+}
+
+func (s *workerSuite) TestExportRetriedAfterFailure(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectForwarding()
+	failed := s.expectExport(errors.New("connection refused"))
+	exported := s.expectExport(nil)
+	s.exporter.EXPECT().Close().Return(nil)
+
+	w := s.startWorker(c, controller.LogExportOTLPHTTP)
+	defer workertest.CleanKill(c, w)
+	s.waitForExporter(c)
+
+	records := makeRecords(1)
+	c.Assert(w.(corelogger.LogWriter).Log(records), tc.ErrorIsNil)
+	err := s.clock.WaitAdvance(flushInterval, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.waitForExport(c, failed), tc.DeepEquals, records)
+
+	// The records are kept in the buffer and sent on the next attempt.
+	err = s.clock.WaitAdvance(minBackoff, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.waitForExport(c, exported), tc.DeepEquals, records)
+}
+
+func (s *workerSuite) TestExportRejectedDropsRecords(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectForwarding()
+	rejected := s.expectExport(errors.Errorf("bad request: %w", logexport.ErrRejected))
+	exported := s.expectExport(nil)
+	s.exporter.EXPECT().Close().Return(nil)
+
+	w := s.startWorker(c, controller.LogExportSyslogTCP)
+	defer workertest.CleanKill(c, w)
+	s.waitForExporter(c)
+
+	records := makeRecords(2)
+	c.Assert(w.(corelogger.LogWriter).Log(records[:1]), tc.ErrorIsNil)
+	err := s.clock.WaitAdvance(flushInterval, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.waitForExport(c, rejected), tc.DeepEquals, records[:1])
+
+	// The rejected records are not sent again.
+	c.Assert(w.(corelogger.LogWriter).Log(records[1:]), tc.ErrorIsNil)
+	err = s.clock.WaitAdvance(flushInterval, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.waitForExport(c, exported), tc.DeepEquals, records[1:])
+}
+
+func (s *workerSuite) TestConfigChangeDisablesExport(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectForwarding()
+	closed := make(chan struct{})
+	s.exporter.EXPECT().Close().DoAndReturn(func() error {
+		close(closed)
+		return nil
+	})
+
+	w := s.startWorker(c, controller.LogExportOTLPHTTP)
+	defer workertest.CleanKill(c, w)
+	s.waitForExporter(c)
+
+	s.expectControllerConfig("")
+	select {
+	case s.configChanges <- []string{controller.LogExportProtocol}:
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out sending config change")
+	}
+
+	select {
+	case <-closed:
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for exporter to be closed")
+	}
+}
+
+func (s *workerSuite) TestUnrelatedConfigChangeIgnored(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectForwarding()
+	s.exporter.EXPECT().Close().Return(nil)
+
+	w := s.startWorker(c, controller.LogExportOTLPHTTP)
+	defer workertest.CleanKill(c, w)
+	s.waitForExporter(c)
+
+	// No further controller config is read.
+	select {
+	case s.configChanges <- []string{controller.AuditingEnabled}:
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out sending config change")
+	}
+	workertest.CheckAlive(c, w)
+}
+
+func (s *workerSuite) TestPendingRecordsKeptOnStop(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectForwarding()
+	s.exporter.EXPECT().Close().Return(nil).Times(2)
+
+	w := s.startWorker(c, controller.LogExportOTLPHTTP)
+	s.waitForExporter(c)
+
+	records := makeRecords(2)
+	c.Assert(w.(corelogger.LogWriter).Log(records), tc.ErrorIsNil)
+	workertest.CleanKill(c, w)
+
+	// A new worker using the same buffer exports the records.
+	s.forwarder = NewMockLogForwarder(s.ctrl)
+	s.expectForwarding()
+	exported := s.expectExport(nil)
+
+	w = s.startWorker(c, controller.LogExportOTLPHTTP)
+	defer workertest.CleanKill(c, w)
+	s.waitForExporter(c)
+
+	err := s.clock.WaitAdvance(flushInterval, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.waitForExport(c, exported), tc.DeepEquals, records)
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.ctrl = ctrl
+	s.clock = testclock.NewClock(time.Now())
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.forwarder = NewMockLogForwarder(ctrl)
+	s.exporter = NewMockExporter(ctrl)
+	s.configChanges = make(chan []string)
+	s.bufferDir = c.MkDir()
+	s.exportConfigs = make(chan logexport.Config, 1)
+
+	c.Cleanup(func() {
+		s.ctrl = nil
+		s.clock = nil
+		s.controllerConfigService = nil
+		s.forwarder = nil
+		s.exporter = nil
+		s.configChanges = nil
+		s.exportConfigs = nil
+	})
+
+	return ctrl
+}
+
+func (s *workerSuite) startWorker(c *tc.C, protocol string) worker.Worker {
+	loopEntered := make(chan struct{}, 1)
+	watcher := NewMockStringsWatcher(s.ctrl)
+	watcher.EXPECT().Changes().DoAndReturn(func() corewatcher.StringsChannel {
+		select {
+		case loopEntered <- struct{}{}:
+		default:
+		}
+		return s.configChanges
+	}).AnyTimes()
+	watcher.EXPECT().Kill().AnyTimes()
+	watcher.EXPECT().Wait().AnyTimes()
+	s.controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).Return(watcher, nil)
+	s.expectControllerConfig(protocol)
+
+	w, err := NewWorker(Config{
+		ControllerConfigService: s.controllerConfigService,
+		LogForwarder:            s.forwarder,
+		BufferDir:               s.bufferDir,
+		NewExporter: func(cfg logexport.Config) (logexport.Exporter, error) {
+			s.exportConfigs <- cfg
+			return s.exporter, nil
+		},
+		Clock:  s.clock,
+		Logger: loggertesting.WrapCheckLog(c),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Wait for the worker to reach its main loop before tests manipulate
+	// the clock.
+	select {
+	case <-loopEntered:
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for worker to enter main loop")
+	}
+	return w
+}
+
+func (s *workerSuite) expectControllerConfig(protocol string) {
+	cfg := controller.Config{
+		controller.ControllerUUIDKey: testing.ControllerTag.Id(),
+	}
+	if protocol != "" {
+		cfg[controller.LogExportProtocol] = protocol
+		cfg[controller.LogExportEndpoint] = "https://collector.example.com/v1/logs"
+	}
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(cfg, nil)
+}
+
+// expectForwarding expects the worker to register itself with the
+// forwarder when it starts, and to unregister when it stops.
+func (s *workerSuite) expectForwarding() {
+	gomock.InOrder(
+		s.forwarder.EXPECT().SetLogWriter(gomock.Not(gomock.Nil())),
+		s.forwarder.EXPECT().SetLogWriter(nil).AnyTimes(),
+	)
+}
+
+// expectExport expects a single export, returning the given error, and
+// returns a channel receiving the exported records.
+func (s *workerSuite) expectExport(err error) <-chan []corelogger.LogRecord {
+	exported := make(chan []corelogger.LogRecord, 1)
+	s.exporter.EXPECT().Export(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, records []corelogger.LogRecord) error {
+			exported <- records
+			return err
+		})
+	return exported
+}
+
+func (s *workerSuite) waitForExporter(c *tc.C) logexport.Config {
+	select {
+	case cfg := <-s.exportConfigs:
+		return cfg
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for exporter")
+	}
+	return logexport.Config{}
+}
+
+func (s *workerSuite) waitForExport(c *tc.C, exported <-chan []corelogger.LogRecord) []corelogger.LogRecord {
+	select {
+	case records := <-exported:
+		return records
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for export")
+	}
+	return nil
+}
+
+func makeRecords(n int) []corelogger.LogRecord {
+	start := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	records := make([]corelogger.LogRecord, n)
+	for i := range records {
+		records[i] = corelogger.LogRecord{
+			Time:      start.Add(time.Duration(i) * time.Second),
+			ModelUUID: testing.ModelTag.Id(),
+			Entity:    "machine-0",
+			Level:     corelogger.INFO,
+			Module:    "juju.worker.test",
+			Message:   fmt.Sprintf("message %d", i),
+			Labels:    map[string]string{"domain": "test"},
+		}
+	}
+	return records
+}
@@ -11,6 +11,9 @@
 //   - config.MaxActionResultsAge: maximum age to retain operation results.
 //   - config.MaxActionResultsSize: maximum total size (in MB) of stored
 //     operation results or logs
+//   - config.OperationRetentionPolicy: rules keeping operations of given
+//     actions or applications for longer or shorter than the maximum age,
+//     keeping only the last few of an action, or never pruning them.
 //
 // On a fixed interval, configured via the worker Config.PruneInterval,
 // the worker asks an OperationService to prune operations older than the
@@ -23,13 +26,14 @@
 //   - ModelConfigService abstracts access to the model configuration and
 //     provides a watcher used by the worker to stay up to date.
 //   - OperationService performs the actual pruning when invoked by the
-//     worker, given the current age and size limits.
+//     worker, given the current age and size limits and retention policy.
 //
 // # Behavior
 //
 // When started, the worker:
 //  1. Subscribes to model config changes.
-//  2. Reads the initial MaxActionResultsAge and MaxActionResultsSizeMB.
+//  2. Reads the initial MaxActionResultsAge, MaxActionResultsSizeMB and
+//     OperationRetentionPolicy.
 //  3. On each tick of the prune interval, calls OperationService.PruneOperations
 //     with the latest limits.
 //  4. Updates limits whenever the relevant model config keys change, and then
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	operation "github.com/juju/juju/core/operation"
 	watcher "github.com/juju/juju/core/watcher"
 	config "github.com/juju/juju/environs/config"
 	gomock "go.uber.org/mock/gomock"
@@ -144,17 +145,17 @@ func (m *MockOperationService) EXPECT() *MockOperationServiceMockRecorder {
 }
 
 // PruneOperations mocks base method.
-func (m *MockOperationService) PruneOperations(arg0 context.Context, arg1 time.Duration, arg2 int) error {
+func (m *MockOperationService) PruneOperations(arg0 context.Context, arg1 time.Duration, arg2 int, arg3 operation.RetentionPolicy) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PruneOperations", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "PruneOperations", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PruneOperations indicates an expected call of PruneOperations.
-func (mr *MockOperationServiceMockRecorder) PruneOperations(arg0, arg1, arg2 any) *MockOperationServicePruneOperationsCall {
+func (mr *MockOperationServiceMockRecorder) PruneOperations(arg0, arg1, arg2, arg3 any) *MockOperationServicePruneOperationsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneOperations", reflect.TypeOf((*MockOperationService)(nil).PruneOperations), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneOperations", reflect.TypeOf((*MockOperationService)(nil).PruneOperations), arg0, arg1, arg2, arg3)
 	return &MockOperationServicePruneOperationsCall{Call: call}
 }
 
@@ -170,13 +171,13 @@ func (c *MockOperationServicePruneOperationsCall) Return(arg0 error) *MockOperat
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockOperationServicePruneOperationsCall) Do(f func(context.Context, time.Duration, int) error) *MockOperationServicePruneOperationsCall {
+func (c *MockOperationServicePruneOperationsCall) Do(f func(context.Context, time.Duration, int, operation.RetentionPolicy) error) *MockOperationServicePruneOperationsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockOperationServicePruneOperationsCall) DoAndReturn(f func(context.Context, time.Duration, int) error) *MockOperationServicePruneOperationsCall {
+func (c *MockOperationServicePruneOperationsCall) DoAndReturn(f func(context.Context, time.Duration, int, operation.RetentionPolicy) error) *MockOperationServicePruneOperationsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -16,6 +16,7 @@ import (
 
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/logger"
+	coreoperation "github.com/juju/juju/core/operation"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/errors"
@@ -34,7 +35,9 @@ type ModelConfigService interface {
 // OperationService provides access to operations
 type OperationService interface {
 	// PruneOperations removes operations older than maxAge or larger than maxSizeMB.
-	PruneOperations(context context.Context, maxAge time.Duration, maxSizeMB int) error
+	// Operations matched by a rule of the retention policy are kept as the
+	// rule describes instead.
+	PruneOperations(context context.Context, maxAge time.Duration, maxSizeMB int, policy coreoperation.RetentionPolicy) error
 }
 
 // Config is the configuration for the operation pruner.
@@ -78,6 +81,7 @@ type prunerWorker struct {
 
 	maxAge     time.Duration
 	maxSizeMB  int
+	policy     coreoperation.RetentionPolicy
 	lastUpdate time.Time
 	lastPrune  time.Time
 }
@@ -113,10 +117,11 @@ func (w *prunerWorker) Report(ctx context.Context) map[string]any {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 	return map[string]any{
-		"max-age":     w.maxAge,
-		"max-size-mb": w.maxSizeMB,
-		"last-update": w.lastUpdate,
-		"last-prune":  w.lastPrune,
+		"max-age":         w.maxAge,
+		"max-size-mb":     w.maxSizeMB,
+		"retention-rules": len(w.policy),
+		"last-update":     w.lastUpdate,
+		"last-prune":      w.lastPrune,
 	}
 }
 
@@ -129,7 +134,8 @@ func jitter(period time.Duration) time.Duration {
 
 // loop is the worker's main loop.
 //   - It watches for changes to the model configuration to get up-to-date values
-//     for the pruning interval and the maximum size of operation results.
+//     for the pruning interval, the maximum size of operation results and
+//     the operation retention policy.
 //   - It periodically prunes operations.
 func (w *prunerWorker) loop() error {
 	ctx := w.catacomb.Context(context.Background())
@@ -162,7 +168,8 @@ func (w *prunerWorker) loop() error {
 			}
 			changes := set.NewStrings(keys...)
 			if !changes.Contains(config.MaxActionResultsSize) &&
-				!changes.Contains(config.MaxActionResultsAge) {
+				!changes.Contains(config.MaxActionResultsAge) &&
+				!changes.Contains(config.OperationRetentionPolicy) {
 				continue
 			}
 
@@ -186,8 +193,8 @@ func (w *prunerWorker) loop() error {
 
 // doPrune prunes operations.
 func (w *prunerWorker) doPrune(ctx context.Context, pruneTimer clock.Timer) error {
-	maxAge, maxSizeMB := w.getPruneArgs()
-	err := w.config.OperationService.PruneOperations(ctx, maxAge, maxSizeMB)
+	maxAge, maxSizeMB, policy := w.getPruneArgs()
+	err := w.config.OperationService.PruneOperations(ctx, maxAge, maxSizeMB, policy)
 	if err != nil {
 		return errors.Errorf("pruning operations: %w", err)
 	}
@@ -208,11 +215,11 @@ func (w *prunerWorker) nextPruneInterval(ctx context.Context) time.Duration {
 
 // getPruneArgs returns the current prune arguments. The returned values are
 // guarded by w.mu to avoid races
-func (w *prunerWorker) getPruneArgs() (time.Duration, int) {
+func (w *prunerWorker) getPruneArgs() (time.Duration, int, coreoperation.RetentionPolicy) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	return w.maxAge, w.maxSizeMB
+	return w.maxAge, w.maxSizeMB, w.policy
 }
 
 // updateConfig updates the pruner's configuration. It is guarded by w.mu to
@@ -223,6 +230,8 @@ func (w *prunerWorker) updateConfig(ctx context.Context, initCfg *config.Config)
 
 	w.maxAge = initCfg.MaxActionResultsAge()
 	w.maxSizeMB = int(initCfg.MaxActionResultsSizeMB())
+	w.policy = initCfg.OperationRetentionPolicy()
 	w.lastUpdate = w.config.Clock.Now()
-	w.config.Logger.Debugf(ctx, "config updated: max-age=%v, max-size-mb=%v", w.maxAge, w.maxSizeMB)
+	w.config.Logger.Debugf(ctx, "config updated: max-age=%v, max-size-mb=%v, retention rules=%d",
+		w.maxAge, w.maxSizeMB, len(w.policy))
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/juju/worker/v5/workertest"
 	"go.uber.org/mock/gomock"
 
+	coreoperation "github.com/juju/juju/core/operation"
 	coretesting "github.com/juju/juju/core/testing"
 	corewatcher "github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/environs/config"
@@ -92,8 +93,8 @@ func (s *workerSuite) TestPrunesAfterBothConfigValues(c *tc.C) {
 
 func (w *workerMocks) expectPruneOperation(c *tc.C, duration time.Duration, sizeMB int, times int) (waitForMe func()) {
 	waitForIt := make(chan struct{})
-	w.operationService.EXPECT().PruneOperations(gomock.Any(), duration, sizeMB).DoAndReturn(
-		func(ctx context.Context, duration time.Duration, sizeMB int) error {
+	w.operationService.EXPECT().PruneOperations(gomock.Any(), duration, sizeMB, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, duration time.Duration, sizeMB int, _ coreoperation.RetentionPolicy) error {
 			times--
 			if times == 0 {
 				close(waitForIt)
@@ -134,6 +135,39 @@ func (s *workerSuite) TestPrunesAfterBothConfigValuesSequentially(c *tc.C) {
 	mocked.advancePruneInterval(c)
 }
 
+// TestPrunesWithRetentionPolicy tests that the worker prunes operations with
+// the retention policy of the model when it changes.
+func (s *workerSuite) TestPrunesWithRetentionPolicy(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+
+	cfg, err := buildModelConfig(c, "1h", "20M").Apply(map[string]any{
+		config.OperationRetentionPolicy: "action=backup max-age=8760h",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	mocked.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+
+	done := make(chan struct{})
+	mocked.operationService.EXPECT().PruneOperations(gomock.Any(), time.Hour, 20, coreoperation.RetentionPolicy{{
+		Action: "backup",
+		MaxAge: 8760 * time.Hour,
+	}}).DoAndReturn(func(context.Context, time.Duration, int, coreoperation.RetentionPolicy) error {
+		close(done)
+		return nil
+	})
+
+	mocked.pushConfigChanges(c, config.OperationRetentionPolicy)
+
+	select {
+	case <-done:
+	case <-time.After(coretesting.ShortWait):
+		c.Fatalf("Prune operation should have been called")
+	}
+}
+
 // TestModelConfigErrorOnGetModelConfig tests that the worker does not prune
 // operations when the model config fails to be retrieved.
 func (s *workerSuite) TestModelConfigErrorOnGetModelConfig(c *tc.C) {
@@ -152,7 +186,7 @@ func (s *workerSuite) TestModelConfigErrorOnGetModelConfig(c *tc.C) {
 	mocked.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(nil, expectedError)
 
 	// Expect no call because getting model config failed.
-	mocked.operationService.EXPECT().PruneOperations(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(0)
+	mocked.operationService.EXPECT().PruneOperations(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(0)
 
 	// Emit a change to trigger the failure
 	mocked.pushConfigChanges(c, config.MaxActionResultsAge)
@@ -175,7 +209,7 @@ func (s *workerSuite) TestPruneError(c *tc.C) {
 	mocked.expectModelConfig(c, "1h", "10M").AnyTimes()
 
 	// Expect one prune call after timer fires with the values above.
-	mocked.operationService.EXPECT().PruneOperations(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedError)
+	mocked.operationService.EXPECT().PruneOperations(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedError)
 
 	// Emit model changes
 	mocked.pushConfigChanges(c, config.MaxActionResultsAge, config.MaxActionResultsSize)
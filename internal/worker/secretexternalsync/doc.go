@@ -0,0 +1,23 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package secretexternalsync provides a worker that periodically syncs the
+// content of user secrets from their external sources.
+//
+// # Overview
+//
+// A user secret added with add-secret --source holds a reference to a path
+// in a secret backend, such as a vault KV path, rather than content given by
+// the operator. On each tick of the sync interval, the worker reads the
+// content at the path of every such secret in the model. When the content
+// differs from the latest revision of the secret, a new revision is created,
+// so that units tracking the secret are notified with secret-changed as
+// they would be for any other update.
+//
+// A secret whose source cannot be read is logged and retried on the next
+// tick; it does not prevent the other secrets from being synced.
+//
+// # Integration
+//
+// The worker is intended to be run by the Juju controller, for each model.
+package secretexternalsync
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/secretexternalsync (interfaces: SecretService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package secretexternalsync -destination services_mock_test.go github.com/juju/juju/internal/worker/secretexternalsync SecretService
+//
+
+// Package secretexternalsync is a generated GoMock package.
+package secretexternalsync
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock *MockSecretService
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// SyncExternalSecrets mocks base method.
+func (m *MockSecretService) SyncExternalSecrets(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncExternalSecrets", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncExternalSecrets indicates an expected call of SyncExternalSecrets.
+func (mr *MockSecretServiceMockRecorder) SyncExternalSecrets(arg0 any) *MockSecretServiceSyncExternalSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncExternalSecrets", reflect.TypeOf((*MockSecretService)(nil).SyncExternalSecrets), arg0)
+	return &MockSecretServiceSyncExternalSecretsCall{Call: call}
+}
+
+// MockSecretServiceSyncExternalSecretsCall wrap *gomock.Call
+type MockSecretServiceSyncExternalSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceSyncExternalSecretsCall) Return(arg0 int, arg1 error) *MockSecretServiceSyncExternalSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceSyncExternalSecretsCall) Do(f func(context.Context) (int, error)) *MockSecretServiceSyncExternalSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceSyncExternalSecretsCall) DoAndReturn(f func(context.Context) (int, error)) *MockSecretServiceSyncExternalSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretexternalsync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SecretService syncs user secrets from their external sources.
+type SecretService interface {
+	// SyncExternalSecrets reads the content of each user secret with an
+	// external source, creating a new revision of any secret whose source
+	// content has changed. It returns the number of secrets updated.
+	SyncExternalSecrets(ctx context.Context) (int, error)
+}
+
+// Config is the configuration for the secret external sync worker.
+type Config struct {
+	SecretService SecretService
+	Clock         clock.Clock
+	Logger        logger.Logger
+
+	// SyncInterval is how often secrets are synced from their external
+	// sources.
+	SyncInterval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.SecretService == nil {
+		return errors.Errorf("nil SecretService").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil Clock").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.SyncInterval <= 0 {
+		return errors.Errorf("sync interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// syncWorker is a worker that syncs user secrets from their external
+// sources.
+type syncWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	lastSync time.Time
+	updated  int
+}
+
+// NewWorker returns a new secret external sync worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &syncWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "secret-external-sync",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *syncWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *syncWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *syncWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]any{
+		"last-sync": w.lastSync,
+		"updated":   w.updated,
+	}
+}
+
+// loop syncs secrets when the worker starts, and then on every tick of the
+// sync interval.
+func (w *syncWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	timer := w.config.Clock.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			if err := w.sync(ctx); err != nil {
+				return errors.Capture(err)
+			}
+			timer.Reset(w.config.SyncInterval)
+		}
+	}
+}
+
+// sync syncs secrets from their external sources and records the outcome.
+func (w *syncWorker) sync(ctx context.Context) error {
+	updated, err := w.config.SecretService.SyncExternalSecrets(ctx)
+	if err != nil {
+		return errors.Errorf("syncing external secrets: %w", err)
+	}
+	if updated > 0 {
+		w.config.Logger.Debugf(ctx, "synced %d secrets from external sources", updated)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastSync = w.config.Clock.Now()
+	w.updated = updated
+	return nil
+}
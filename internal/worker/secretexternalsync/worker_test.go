@@ -0,0 +1,128 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretexternalsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+func TestConfigSuite(t *testing.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		SecretService: NewMockSecretService(ctrl),
+		Clock:         testclock.NewClock(time.Now()),
+		Logger:        loggertesting.WrapCheckLog(c),
+		SyncInterval:  time.Minute,
+	}
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.SecretService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil SecretService.*")
+
+	testCfg = origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Clock.*")
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Logger.*")
+
+	testCfg = origCfg
+	testCfg.SyncInterval = 0
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "sync interval must be positive.*")
+}
+
+type workerSuite struct {
+	secretService *MockSecretService
+	clock         *testclock.Clock
+}
+
+func (s *workerSuite) TestSync(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.secretService.EXPECT().SyncExternalSecrets(gomock.Any()).Return(2, nil)
+
+	w := &syncWorker{config: s.newConfig(c)}
+	err := w.sync(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	report := w.Report(c.Context())
+	c.Check(report["updated"], tc.Equals, 2)
+	c.Check(report["last-sync"], tc.Equals, s.clock.Now())
+}
+
+func (s *workerSuite) TestSyncError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.secretService.EXPECT().SyncExternalSecrets(gomock.Any()).Return(0, errors.New("boom"))
+
+	w := &syncWorker{config: s.newConfig(c)}
+	err := w.sync(c.Context())
+	c.Assert(err, tc.ErrorMatches, "syncing external secrets: boom")
+}
+
+func (s *workerSuite) TestWorkerSyncsOnInterval(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	synced := make(chan struct{}, 2)
+	s.secretService.EXPECT().SyncExternalSecrets(gomock.Any()).DoAndReturn(
+		func(context.Context) (int, error) {
+			synced <- struct{}{}
+			return 0, nil
+		}).Times(2)
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	// Secrets are synced as soon as the worker starts.
+	s.waitForSync(c, synced)
+
+	err = s.clock.WaitAdvance(time.Minute, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	s.waitForSync(c, synced)
+}
+
+func (s *workerSuite) waitForSync(c *tc.C, synced <-chan struct{}) {
+	select {
+	case <-synced:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for sync")
+	}
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.secretService = NewMockSecretService(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+	return ctrl
+}
+
+func (s *workerSuite) newConfig(c *tc.C) Config {
+	return Config{
+		SecretService: s.secretService,
+		Clock:         s.clock,
+		Logger:        loggertesting.WrapCheckLog(c),
+		SyncInterval:  time.Minute,
+	}
+}
@@ -18,7 +18,8 @@ import (
 
 // machineBlockDevicesChanged is called when the block devices of the scoped
 // machine have been seen to have changed. This triggers a refresh of all
-// block devices for attached volumes backing pending filesystems.
+// block devices for attached volumes backing pending filesystems, and grows
+// attached filesystems whose backing volumes have grown.
 func machineBlockDevicesChanged(ctx context.Context, deps *dependencies) error {
 	deps.config.Logger.Tracef(ctx, "machineBlockDevicesChanged")
 	volumeTags := make([]names.VolumeTag, 0, len(deps.incompleteFilesystemParams))
@@ -99,6 +100,18 @@ func machineBlockDevicesChanged(ctx context.Context, deps *dependencies) error {
 		return errors.Trace(err)
 	}
 
+	// Filesystems on volumes which have been resized are grown to fill
+	// the larger block devices.
+	attached := make([]storage.Filesystem, 0, len(mountedAttachments))
+	for _, a := range mountedAttachments {
+		if filesystem, ok := deps.filesystems[a.Filesystem]; ok {
+			attached = append(attached, filesystem)
+		}
+	}
+	if err := growVolumeBackedFilesystems(ctx, deps, attached); err != nil {
+		return errors.Annotate(err, "growing filesystems")
+	}
+
 	// For filesystems backed by volumes (managed filesystems), we re-run the attachment logic
 	// to allow for the fact that the mount (and its UUID) may have become available after
 	// we noticed that the volume appeared.
@@ -338,16 +338,19 @@ func processAliveFilesystems(ctx context.Context, deps *dependencies, tags []nam
 	deps.config.Logger.Tracef(ctx, "processAliveFilesystems: %#v %#v", tags, filesystemResults)
 	// Filter out the already-provisioned filesystems.
 	pending := make([]names.FilesystemTag, 0, len(tags))
+	provisioned := make([]storage.Filesystem, 0, len(tags))
 	for i, result := range filesystemResults {
 		tag := tags[i]
 		if result.Error == nil {
-			// Filesystem is already provisioned: skip.
-			deps.config.Logger.Debugf(ctx, "filesystem %q is already provisioned, nothing to do", tag.Id())
+			// Filesystem is already provisioned: it may need to be
+			// resized, but nothing else.
+			deps.config.Logger.Debugf(ctx, "filesystem %q is already provisioned", tag.Id())
 			filesystem, err := filesystemFromParams(result.Result)
 			if err != nil {
 				return errors.Annotate(err, "getting filesystem info")
 			}
 			updateFilesystem(ctx, deps, filesystem)
+			provisioned = append(provisioned, filesystem)
 			if filesystem.Volume != (names.VolumeTag{}) {
 				// Ensure that volume-backed filesystems' block
 				// devices are present even after creating the
@@ -365,6 +368,11 @@ func processAliveFilesystems(ctx context.Context, deps *dependencies, tags []nam
 		// to enquire about parameters below.
 		pending = append(pending, tag)
 	}
+	if len(provisioned) > 0 {
+		if err := resizeFilesystems(ctx, deps, provisioned); err != nil {
+			return errors.Annotate(err, "resizing filesystems")
+		}
+	}
 	if len(pending) == 0 {
 		return nil
 	}
@@ -543,6 +551,7 @@ func filesystemParamsFromParams(in params.FilesystemParamsV5) (storage.Filesyste
 		ProviderId:   in.ProviderId,
 		Attributes:   in.Attributes,
 		ResourceTags: in.Tags,
+		SnapshotId:   in.SnapshotId,
 	}, nil
 }
 
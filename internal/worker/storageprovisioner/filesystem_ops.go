@@ -604,3 +604,162 @@ func (op *detachFilesystemOp) key() any {
 		AttachmentTag: op.args.Filesystem.String(),
 	}
 }
+
+// resizeFilesystems grows the specified provisioned filesystems whose
+// requested size exceeds their provisioned size, if their filesystem
+// source supports resizing. Volume-backed filesystems are grown to fill
+// their backing volume, once the volume has been resized.
+func resizeFilesystems(ctx context.Context, deps *dependencies, filesystems []storage.Filesystem) error {
+	deps.config.Logger.Tracef(ctx, "resizeFilesystems: %#v", filesystems)
+	tags := make([]names.FilesystemTag, 0, len(filesystems))
+	volumeBacked := make([]storage.Filesystem, 0, len(filesystems))
+	for _, f := range filesystems {
+		if f.Volume == (names.VolumeTag{}) {
+			tags = append(tags, f.Tag)
+		} else {
+			volumeBacked = append(volumeBacked, f)
+		}
+	}
+	if err := growVolumeBackedFilesystems(ctx, deps, volumeBacked); err != nil {
+		return errors.Trace(err)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	allParams, err := filesystemParams(ctx, deps, tags)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var growParams []storage.FilesystemParams
+	resizeParams := make(map[names.FilesystemTag]storage.ResizeParams)
+	for _, p := range allParams {
+		// A provisioned size of zero means that the size is unknown,
+		// so we cannot tell whether the filesystem needs to grow.
+		filesystem := deps.filesystems[p.Tag]
+		if filesystem.Size == 0 || p.Size <= filesystem.Size {
+			continue
+		}
+		growParams = append(growParams, p)
+		resizeParams[p.Tag] = storage.ResizeParams{
+			Tag:        p.Tag,
+			ProviderId: filesystem.ProviderId,
+			Size:       p.Size,
+		}
+	}
+	if len(growParams) == 0 {
+		return nil
+	}
+	paramsBySource, filesystemSources, err := filesystemParamsBySource(
+		deps.config.StorageDir,
+		growParams,
+		deps.managedFilesystemSource,
+		deps.config.Registry,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var resized []storage.Filesystem
+	for sourceName, filesystemParams := range paramsBySource {
+		resizer, ok := filesystemSources[sourceName].(storage.StorageResizer)
+		if !ok {
+			deps.config.Logger.Warningf(ctx,
+				"storage source %q does not support resizing filesystems", sourceName,
+			)
+			continue
+		}
+		args := make([]storage.ResizeParams, len(filesystemParams))
+		for i, p := range filesystemParams {
+			args[i] = resizeParams[p.Tag]
+		}
+		deps.config.Logger.Debugf(ctx, "resizing filesystems: %v", args)
+		results, err := resizer.ResizeStorage(ctx, args)
+		if err != nil {
+			return errors.Annotatef(err, "resizing filesystems from source %q", sourceName)
+		}
+		for i, result := range results {
+			tag := filesystemParams[i].Tag
+			if result.Error != nil {
+				deps.config.Logger.Errorf(ctx,
+					"failed to resize %s: %v", names.ReadableString(tag), result.Error,
+				)
+				continue
+			}
+			filesystem := deps.filesystems[tag]
+			filesystem.Size = result.Size
+			resized = append(resized, filesystem)
+		}
+	}
+	return setResizedFilesystemInfo(ctx, deps, resized)
+}
+
+// growVolumeBackedFilesystems grows the specified volume-backed filesystems
+// to fill their backing volumes, if the block devices of the volumes are
+// larger than the filesystems. The block devices are larger once the volumes
+// have been resized and the machine has observed the new size, which is
+// reported by the block device watcher.
+func growVolumeBackedFilesystems(ctx context.Context, deps *dependencies, filesystems []storage.Filesystem) error {
+	resizer, ok := deps.managedFilesystemSource.(storage.StorageResizer)
+	if !ok || len(filesystems) == 0 {
+		return nil
+	}
+	var args []storage.ResizeParams
+	for _, f := range filesystems {
+		// A provisioned size of zero means that the size is unknown,
+		// so we cannot tell whether the filesystem needs to grow.
+		blockDevice, ok := deps.volumeBlockDevices[f.Volume]
+		if !ok || f.Size == 0 || blockDevice.SizeMiB <= f.Size {
+			continue
+		}
+		args = append(args, storage.ResizeParams{
+			Tag:        f.Tag,
+			ProviderId: f.ProviderId,
+			Size:       blockDevice.SizeMiB,
+		})
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	deps.config.Logger.Debugf(ctx, "growing volume-backed filesystems: %v", args)
+	results, err := resizer.ResizeStorage(ctx, args)
+	if err != nil {
+		return errors.Annotate(err, "growing volume-backed filesystems")
+	}
+	var resized []storage.Filesystem
+	for i, result := range results {
+		tag := args[i].Tag.(names.FilesystemTag)
+		if result.Error != nil {
+			deps.config.Logger.Errorf(ctx,
+				"failed to grow %s: %v", names.ReadableString(tag), result.Error,
+			)
+			continue
+		}
+		filesystem := deps.filesystems[tag]
+		filesystem.Size = result.Size
+		resized = append(resized, filesystem)
+	}
+	return setResizedFilesystemInfo(ctx, deps, resized)
+}
+
+// setResizedFilesystemInfo publishes the sizes of the resized filesystems
+// to state.
+func setResizedFilesystemInfo(ctx context.Context, deps *dependencies, resized []storage.Filesystem) error {
+	if len(resized) == 0 {
+		return nil
+	}
+	errorResults, err := deps.config.Filesystems.SetFilesystemInfo(ctx, filesystemsFromStorage(resized))
+	if err != nil {
+		return errors.Annotate(err, "publishing resized filesystems to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			deps.config.Logger.Errorf(ctx,
+				"publishing resized filesystem %s to state: %v",
+				resized[i].Tag.Id(),
+				result.Error,
+			)
+			continue
+		}
+		updateFilesystem(ctx, deps, resized[i])
+	}
+	return nil
+}
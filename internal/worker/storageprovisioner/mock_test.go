@@ -131,6 +131,7 @@ type mockVolumeAccessor struct {
 	provisionedVolumes     map[string]params.Volume
 	provisionedAttachments map[params.MachineStorageId]params.VolumeAttachment
 	blockDevices           map[params.MachineStorageId]params.BlockDevice
+	snapshotIds            map[string]string
 
 	setVolumeInfo               func([]params.Volume) ([]params.ErrorResult, error)
 	setVolumeAttachmentInfo     func([]params.VolumeAttachment) ([]params.ErrorResult, error)
@@ -232,6 +233,7 @@ func (v *mockVolumeAccessor) VolumeParams(_ context.Context, volumes []names.Vol
 			Tags: map[string]string{
 				"very": "fancy",
 			},
+			SnapshotId: v.snapshotIds[tag.String()],
 		}
 		if tag.Id() != noAttachmentVolumeId {
 			volumeParams.Attachment = &params.VolumeAttachmentParams{
@@ -338,6 +340,7 @@ type mockFilesystemAccessor struct {
 	provisionedMachinesFilesystems map[string]params.Filesystem
 	provisionedFilesystems         map[string]params.Filesystem
 	provisionedAttachments         map[params.MachineStorageId]params.FilesystemAttachment
+	snapshotIds                    map[string]string
 
 	setFilesystemInfo           func([]params.Filesystem) ([]params.ErrorResult, error)
 	setFilesystemAttachmentInfo func([]params.FilesystemAttachment) ([]params.ErrorResult, error)
@@ -401,6 +404,7 @@ func (v *mockFilesystemAccessor) FilesystemParams(_ context.Context, filesystems
 			Tags: map[string]string{
 				"very": "fancy",
 			},
+			SnapshotId: v.snapshotIds[tag.String()],
 		}
 		if _, ok := names.FilesystemMachine(tag); ok {
 			// place all volume-backed filesystems on machine-scoped
@@ -451,6 +455,10 @@ func (f *mockFilesystemAccessor) FilesystemAttachmentParams(_ context.Context, i
 	return result, nil
 }
 
+func (f *mockFilesystemAccessor) FilesystemEncryptionKeys(_ context.Context, ids []params.MachineStorageId) ([]params.StringResult, error) {
+	return make([]params.StringResult, len(ids)), nil
+}
+
 func (f *mockFilesystemAccessor) SetFilesystemInfo(_ context.Context, filesystems []params.Filesystem) ([]params.ErrorResult, error) {
 	if f.setFilesystemInfo != nil {
 		return f.setFilesystemInfo(filesystems)
@@ -561,6 +569,7 @@ type dummyProvider struct {
 	releaseFilesystemsFunc       func([]string) ([]error, error)
 	validateVolumeParamsFunc     func(storage.VolumeParams) error
 	validateFilesystemParamsFunc func(storage.FilesystemParams) error
+	resizeStorageFunc            func([]storage.ResizeParams) ([]storage.ResizeStorageResult, error)
 }
 
 type dummyVolumeSource struct {
@@ -589,6 +598,17 @@ func (p *dummyProvider) FilesystemSource(providerConfig *storage.Config) (storag
 	return &dummyFilesystemSource{provider: p}, nil
 }
 
+func (p *dummyProvider) resizeStorage(params []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+	if p.resizeStorageFunc != nil {
+		return p.resizeStorageFunc(params)
+	}
+	results := make([]storage.ResizeStorageResult, len(params))
+	for i, p := range params {
+		results[i].Size = p.Size
+	}
+	return results, nil
+}
+
 func (p *dummyProvider) Dynamic() bool {
 	return p.dynamic
 }
@@ -676,6 +696,11 @@ func (s *dummyVolumeSource) DetachVolumes(ctx context.Context, params []storage.
 	return make([]error, len(params)), nil
 }
 
+// ResizeStorage grows volumes to the requested size.
+func (s *dummyVolumeSource) ResizeStorage(ctx context.Context, params []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+	return s.provider.resizeStorage(params)
+}
+
 func (s *dummyFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
 	if s.provider != nil && s.provider.validateFilesystemParamsFunc != nil {
 		return s.provider.validateFilesystemParamsFunc(params)
@@ -755,10 +780,16 @@ func (s *dummyFilesystemSource) DetachFilesystems(ctx context.Context, params []
 	return make([]error, len(params)), nil
 }
 
+// ResizeStorage grows filesystems to the requested size.
+func (s *dummyFilesystemSource) ResizeStorage(ctx context.Context, params []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+	return s.provider.resizeStorage(params)
+}
+
 type mockManagedFilesystemSource struct {
 	blockDevices        map[names.VolumeTag]blockdevice.BlockDevice
 	filesystems         map[names.FilesystemTag]storage.Filesystem
 	attachedFilesystems chan any
+	resizedFilesystems  chan any
 }
 
 func (s *mockManagedFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
@@ -824,6 +855,18 @@ func (s *mockManagedFilesystemSource) DetachFilesystems(ctx context.Context, par
 	return nil, errors.NotImplementedf("DetachFilesystems")
 }
 
+// ResizeStorage grows filesystems to the requested size.
+func (s *mockManagedFilesystemSource) ResizeStorage(ctx context.Context, args []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+	results := make([]storage.ResizeStorageResult, len(args))
+	for i, arg := range args {
+		results[i].Size = arg.Size
+	}
+	if s.resizedFilesystems != nil {
+		s.resizedFilesystems <- args
+	}
+	return results, nil
+}
+
 type mockMachineAccessor struct {
 	instanceIds map[names.MachineTag]instance.Id
 	watcher     *mockNotifyWatcher
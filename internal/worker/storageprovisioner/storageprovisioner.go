@@ -116,6 +116,11 @@ type FilesystemAccessor interface {
 	// filesystem attachments with the specified tags.
 	FilesystemAttachmentParams(context.Context, []params.MachineStorageId) ([]params.FilesystemAttachmentParamsResultV5, error)
 
+	// FilesystemEncryptionKeys returns the encryption keys for the
+	// filesystems of the specified filesystem attachments. An empty key
+	// is returned for filesystems that are not encrypted.
+	FilesystemEncryptionKeys(context.Context, []params.MachineStorageId) ([]params.StringResult, error)
+
 	// SetFilesystemInfo records the details of newly provisioned filesystems.
 	SetFilesystemInfo(context.Context, []params.Filesystem) ([]params.ErrorResult, error)
 
@@ -256,7 +261,7 @@ func (w *storageProvisioner) loop() error {
 		pendingVolumeBlockDevices:            names.NewSet(),
 	}
 	deps.managedFilesystemSource = newManagedFilesystemSource(
-		deps.volumeBlockDevices, deps.filesystems,
+		deps.volumeBlockDevices, deps.filesystems, w.filesystemEncryptionKey,
 	)
 
 	// Units don't have unit-scoped volumes - all volumes are
@@ -425,6 +430,34 @@ func (w *storageProvisioner) processDependentChanges(ctx context.Context, deps *
 	}
 }
 
+// filesystemEncryptionKey returns the encryption key of the filesystem
+// attached to the machine the worker is responsible for, or an empty
+// string if the filesystem is not encrypted.
+func (w *storageProvisioner) filesystemEncryptionKey(ctx context.Context, tag names.FilesystemTag) (string, error) {
+	machineTag, ok := w.config.Scope.(names.MachineTag)
+	if !ok {
+		return "", nil
+	}
+	results, err := w.config.Filesystems.FilesystemEncryptionKeys(ctx, []params.MachineStorageId{{
+		MachineTag:    machineTag.String(),
+		AttachmentTag: tag.String(),
+	}})
+	if errors.Is(err, errors.NotSupported) {
+		// The controller predates filesystem encryption, so
+		// no filesystem can have been encrypted.
+		return "", nil
+	} else if err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(results) != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Error != nil {
+		return "", errors.Trace(results[0].Error)
+	}
+	return results[0].Result, nil
+}
+
 func (w *storageProvisioner) scopedContext() (context.Context, context.CancelFunc) {
 	return context.WithCancel(w.catacomb.Context(context.Background()))
 }
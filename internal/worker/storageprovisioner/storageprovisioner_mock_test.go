@@ -767,6 +767,45 @@ func (c *MockFilesystemAccessorFilesystemAttachmentsCall) DoAndReturn(f func(con
 	return c
 }
 
+// FilesystemEncryptionKeys mocks base method.
+func (m *MockFilesystemAccessor) FilesystemEncryptionKeys(arg0 context.Context, arg1 []params.MachineStorageId) ([]params.StringResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilesystemEncryptionKeys", arg0, arg1)
+	ret0, _ := ret[0].([]params.StringResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilesystemEncryptionKeys indicates an expected call of FilesystemEncryptionKeys.
+func (mr *MockFilesystemAccessorMockRecorder) FilesystemEncryptionKeys(arg0, arg1 any) *MockFilesystemAccessorFilesystemEncryptionKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilesystemEncryptionKeys", reflect.TypeOf((*MockFilesystemAccessor)(nil).FilesystemEncryptionKeys), arg0, arg1)
+	return &MockFilesystemAccessorFilesystemEncryptionKeysCall{Call: call}
+}
+
+// MockFilesystemAccessorFilesystemEncryptionKeysCall wrap *gomock.Call
+type MockFilesystemAccessorFilesystemEncryptionKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFilesystemAccessorFilesystemEncryptionKeysCall) Return(arg0 []params.StringResult, arg1 error) *MockFilesystemAccessorFilesystemEncryptionKeysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFilesystemAccessorFilesystemEncryptionKeysCall) Do(f func(context.Context, []params.MachineStorageId) ([]params.StringResult, error)) *MockFilesystemAccessorFilesystemEncryptionKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFilesystemAccessorFilesystemEncryptionKeysCall) DoAndReturn(f func(context.Context, []params.MachineStorageId) ([]params.StringResult, error)) *MockFilesystemAccessorFilesystemEncryptionKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FilesystemParams mocks base method.
 func (m *MockFilesystemAccessor) FilesystemParams(arg0 context.Context, arg1 []names.FilesystemTag) ([]params.FilesystemParamsResultV5, error) {
 	m.ctrl.T.Helper()
@@ -20,6 +20,7 @@ import (
 	"github.com/juju/juju/core/watcher"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/storage/provider"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/storageprovisioner"
 	"github.com/juju/juju/rpc/params"
@@ -51,6 +52,7 @@ func (s *storageProvisionerSuite) SetUpTest(c *tc.C) {
 		func(
 			blockDevices map[names.VolumeTag]blockdevice.BlockDevice,
 			filesystems map[names.FilesystemTag]storage.Filesystem,
+			_ provider.FilesystemEncryptionKeyFunc,
 		) storage.FilesystemSource {
 			s.managedFilesystemSource = &mockManagedFilesystemSource{
 				blockDevices: blockDevices,
@@ -892,6 +894,119 @@ func (s *storageProvisionerSuite) TestFilesystemAdded(c *tc.C) {
 	waitChannel(c, filesystemInfoSet, "waiting for filesystem info to be set")
 }
 
+func (s *storageProvisionerSuite) TestResizeVolume(c *tc.C) {
+	var resizeArgs []storage.ResizeParams
+	s.provider.resizeStorageFunc = func(args []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+		resizeArgs = append(resizeArgs, args...)
+		return []storage.ResizeStorageResult{{Size: 2048}}, nil
+	}
+
+	volumeInfoSet := make(chan any)
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedVolumes["volume-1"] = params.Volume{
+		VolumeTag: "volume-1",
+		Info: params.VolumeInfo{
+			ProviderId: "vol-123",
+			SizeMiB:    512,
+		},
+	}
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		defer close(volumeInfoSet)
+		c.Assert(volumes, tc.DeepEquals, []params.Volume{{
+			VolumeTag: "volume-1",
+			Info: params.VolumeInfo{
+				ProviderId: "vol-123",
+				SizeMiB:    2048,
+			},
+		}})
+		return nil, nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.volumesWatcher.changes <- []string{"1"}
+	waitChannel(c, volumeInfoSet, "waiting for volume info to be set")
+	c.Assert(resizeArgs, tc.DeepEquals, []storage.ResizeParams{{
+		Tag:        names.NewVolumeTag("1"),
+		ProviderId: "vol-123",
+		Size:       1024,
+	}})
+}
+
+func (s *storageProvisionerSuite) TestResizeVolumeNotNeeded(c *tc.C) {
+	s.provider.resizeStorageFunc = func(args []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+		c.Fatalf("unexpected resize of %v", args)
+		return nil, nil
+	}
+
+	volumeInfoSet := make(chan any)
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedVolumes["volume-1"] = params.Volume{
+		VolumeTag: "volume-1",
+		Info: params.VolumeInfo{
+			ProviderId: "vol-123",
+			SizeMiB:    1024,
+		},
+	}
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		defer close(volumeInfoSet)
+		return nil, nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.volumesWatcher.changes <- []string{"1"}
+	assertNoEvent(c, volumeInfoSet, "volume info set")
+}
+
+func (s *storageProvisionerSuite) TestResizeFilesystem(c *tc.C) {
+	var resizeArgs []storage.ResizeParams
+	s.provider.resizeStorageFunc = func(args []storage.ResizeParams) ([]storage.ResizeStorageResult, error) {
+		resizeArgs = append(resizeArgs, args...)
+		return []storage.ResizeStorageResult{{Size: 1024}}, nil
+	}
+
+	filesystemInfoSet := make(chan any)
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.provisionedFilesystems["filesystem-1"] = params.Filesystem{
+		FilesystemTag: "filesystem-1",
+		Info: params.FilesystemInfo{
+			ProviderId: "fs-1",
+			SizeMiB:    512,
+		},
+	}
+	filesystemAccessor.setFilesystemInfo = func(filesystems []params.Filesystem) ([]params.ErrorResult, error) {
+		defer close(filesystemInfoSet)
+		c.Assert(filesystems, tc.DeepEquals, []params.Filesystem{{
+			FilesystemTag: "filesystem-1",
+			Info: params.FilesystemInfo{
+				ProviderId: "fs-1",
+				SizeMiB:    1024,
+			},
+		}})
+		return nil, nil
+	}
+
+	args := &workerArgs{filesystems: filesystemAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	filesystemAccessor.filesystemsWatcher.changes <- []string{"1"}
+	waitChannel(c, filesystemInfoSet, "waiting for filesystem info to be set")
+	c.Assert(resizeArgs, tc.DeepEquals, []storage.ResizeParams{{
+		Tag:        names.NewFilesystemTag("1"),
+		ProviderId: "fs-1",
+		Size:       1024,
+	}})
+}
+
 func (s *storageProvisionerSuite) TestVolumeNeedsInstance(c *tc.C) {
 	volumeInfoSet := make(chan any)
 	volumeAccessor := newMockVolumeAccessor()
@@ -1372,6 +1487,93 @@ func (s *storageProvisionerSuite) TestAttachVolumeBackedFilesystem(c *tc.C) {
 
 }
 
+func (s *storageProvisionerSuite) TestGrowVolumeBackedFilesystem(c *tc.C) {
+	attachmentInfoSet := make(chan any)
+	filesystemInfoSet := make(chan any)
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.setFilesystemAttachmentInfo = func(attachments []params.FilesystemAttachment) ([]params.ErrorResult, error) {
+		attachmentInfoSet <- attachments
+		return make([]params.ErrorResult, len(attachments)), nil
+	}
+	filesystemAccessor.setFilesystemInfo = func(filesystems []params.Filesystem) ([]params.ErrorResult, error) {
+		filesystemInfoSet <- filesystems
+		return make([]params.ErrorResult, len(filesystems)), nil
+	}
+
+	args := &workerArgs{
+		scope:       names.NewMachineTag("0"),
+		filesystems: filesystemAccessor,
+		registry:    s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	filesystemAccessor.provisionedFilesystems["filesystem-0-0"] = params.Filesystem{
+		FilesystemTag: "filesystem-0-0",
+		VolumeTag:     "volume-0-0",
+		Info: params.FilesystemInfo{
+			ProviderId: "whatever",
+			SizeMiB:    123,
+		},
+	}
+	filesystemAccessor.provisionedMachines["machine-0"] = "already-provisioned-0"
+	args.volumes.setBlockDevice(
+		params.MachineStorageId{
+			MachineTag:    "machine-0",
+			AttachmentTag: "volume-0-0",
+		},
+		params.BlockDevice{
+			DeviceName: "xvdf1",
+			SizeMiB:    123,
+			Provenance: params.BlockDeviceProvenanceMachine,
+		},
+	)
+	filesystemAccessor.attachmentsWatcher.changes <- []watcher.MachineStorageID{{
+		MachineTag:    "machine-0",
+		AttachmentTag: "filesystem-0-0",
+	}}
+	filesystemAccessor.filesystemsWatcher.changes <- []string{"0/0"}
+	args.volumes.blockDevicesWatcher.changes <- struct{}{}
+	waitChannel(c, attachmentInfoSet, "waiting for filesystem attachment info to be set")
+
+	// The backing volume has been resized, and the machine observes the
+	// larger block device.
+	args.volumes.setBlockDevice(
+		params.MachineStorageId{
+			MachineTag:    "machine-0",
+			AttachmentTag: "volume-0-0",
+		},
+		params.BlockDevice{
+			DeviceName: "xvdf1",
+			SizeMiB:    456,
+			Provenance: params.BlockDeviceProvenanceMachine,
+		},
+	)
+	s.managedFilesystemSource.resizedFilesystems = make(chan any, 1)
+	args.volumes.blockDevicesWatcher.changes <- struct{}{}
+	resized := waitChannel(
+		c, s.managedFilesystemSource.resizedFilesystems,
+		"waiting for filesystem to be grown",
+	).([]storage.ResizeParams)
+	c.Assert(resized, tc.DeepEquals, []storage.ResizeParams{{
+		Tag:        names.NewFilesystemTag("0/0"),
+		ProviderId: "whatever",
+		Size:       456,
+	}})
+	info := waitChannel(
+		c, filesystemInfoSet, "waiting for filesystem info to be set",
+	).([]params.Filesystem)
+	c.Assert(info, tc.DeepEquals, []params.Filesystem{{
+		FilesystemTag: "filesystem-0-0",
+		VolumeTag:     "volume-0-0",
+		Info: params.FilesystemInfo{
+			ProviderId: "whatever",
+			SizeMiB:    456,
+		},
+	}})
+}
+
 func (s *storageProvisionerSuite) TestResourceTags(c *tc.C) {
 	volumeInfoSet := make(chan any)
 	volumeAccessor := newMockVolumeAccessor()
@@ -1436,6 +1638,56 @@ func (s *storageProvisionerSuite) TestResourceTags(c *tc.C) {
 	}}})
 }
 
+func (s *storageProvisionerSuite) TestCreateFromSnapshot(c *tc.C) {
+	volumeInfoSet := make(chan any)
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedMachines["machine-1"] = "already-provisioned-1"
+	volumeAccessor.snapshotIds = map[string]string{"volume-1": "vol-snapshot"}
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		defer close(volumeInfoSet)
+		return nil, nil
+	}
+
+	filesystemInfoSet := make(chan any)
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.provisionedMachines["machine-1"] = "already-provisioned-1"
+	filesystemAccessor.snapshotIds = map[string]string{"filesystem-1": "fs-snapshot"}
+	filesystemAccessor.setFilesystemInfo = func(filesystems []params.Filesystem) ([]params.ErrorResult, error) {
+		defer close(filesystemInfoSet)
+		return nil, nil
+	}
+
+	var volumeSource dummyVolumeSource
+	s.provider.volumeSourceFunc = func(sourceConfig *storage.Config) (storage.VolumeSource, error) {
+		return &volumeSource, nil
+	}
+
+	var filesystemSource dummyFilesystemSource
+	s.provider.filesystemSourceFunc = func(sourceConfig *storage.Config) (storage.FilesystemSource, error) {
+		return &filesystemSource, nil
+	}
+
+	args := &workerArgs{
+		volumes:     volumeAccessor,
+		filesystems: filesystemAccessor,
+		registry:    s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.volumesWatcher.changes <- []string{"1"}
+	filesystemAccessor.filesystemsWatcher.changes <- []string{"1"}
+	waitChannel(c, volumeInfoSet, "waiting for volume info to be set")
+	waitChannel(c, filesystemInfoSet, "waiting for filesystem info to be set")
+	c.Assert(volumeSource.createVolumesArgs, tc.HasLen, 1)
+	c.Assert(volumeSource.createVolumesArgs[0], tc.HasLen, 1)
+	c.Check(volumeSource.createVolumesArgs[0][0].SnapshotId, tc.Equals, "vol-snapshot")
+	c.Assert(filesystemSource.createFilesystemsArgs, tc.HasLen, 1)
+	c.Assert(filesystemSource.createFilesystemsArgs[0], tc.HasLen, 1)
+	c.Check(filesystemSource.createFilesystemsArgs[0][0].SnapshotId, tc.Equals, "fs-snapshot")
+}
+
 func (s *storageProvisionerSuite) TestSetVolumeInfoErrorStopsWorker(c *tc.C) {
 	volumeAccessor := newMockVolumeAccessor()
 	volumeAccessor.provisionedMachines["machine-1"] = "already-provisioned-1"
@@ -447,17 +447,20 @@ func processAliveVolumes(
 
 	// Filter out the already-provisioned volumes.
 	pending := make([]names.VolumeTag, 0, len(tags))
+	provisioned := make([]storage.Volume, 0, len(tags))
 	for i, result := range volumeResults {
 		volumeTag := tags[i].(names.VolumeTag)
 		if result.Error == nil {
-			// Volume is already provisioned: skip.
-			deps.config.Logger.Debugf(ctx, "volume %q is already provisioned, nothing to do", tags[i].Id())
+			// Volume is already provisioned: it may need to be
+			// resized, but nothing else.
+			deps.config.Logger.Debugf(ctx, "volume %q is already provisioned", tags[i].Id())
 			volume, err := volumeFromParams(result.Result)
 			if err != nil {
 				return errors.Annotate(err, "getting volume info")
 			}
 			updateVolume(ctx, deps, volume)
 			removePendingVolume(ctx, deps, volumeTag)
+			provisioned = append(provisioned, volume)
 			continue
 		}
 		if !params.IsCodeNotProvisioned(result.Error) {
@@ -469,6 +472,11 @@ func processAliveVolumes(
 		// to enquire about parameters below.
 		pending = append(pending, volumeTag)
 	}
+	if len(provisioned) > 0 {
+		if err := resizeVolumes(ctx, deps, provisioned); err != nil {
+			return errors.Annotate(err, "resizing volumes")
+		}
+	}
 	if len(pending) == 0 {
 		return nil
 	}
@@ -713,6 +721,7 @@ func volumeParamsFromParams(in params.VolumeParams) (storage.VolumeParams, error
 		Attributes:   in.Attributes,
 		ResourceTags: in.Tags,
 		Attachment:   attachment,
+		SnapshotId:   in.SnapshotId,
 	}, nil
 }
 
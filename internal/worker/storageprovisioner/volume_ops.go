@@ -591,3 +591,92 @@ func (op *detachVolumeOp) key() any {
 		AttachmentTag: op.args.Volume.String(),
 	}
 }
+
+// resizeVolumes grows the specified provisioned volumes whose requested
+// size exceeds their provisioned size, if their volume source supports
+// resizing.
+func resizeVolumes(ctx context.Context, deps *dependencies, volumes []storage.Volume) error {
+	deps.config.Logger.Tracef(ctx, "resizeVolumes: %#v", volumes)
+	tags := make([]names.VolumeTag, len(volumes))
+	for i, v := range volumes {
+		tags[i] = v.Tag
+	}
+	allParams, err := volumeParams(ctx, deps, tags)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var growParams []storage.VolumeParams
+	resizeParams := make(map[names.VolumeTag]storage.ResizeParams)
+	for i, v := range volumes {
+		// A provisioned size of zero means that the size is unknown,
+		// so we cannot tell whether the volume needs to grow.
+		if v.Size == 0 || allParams[i].Size <= v.Size {
+			continue
+		}
+		growParams = append(growParams, allParams[i])
+		resizeParams[v.Tag] = storage.ResizeParams{
+			Tag:        v.Tag,
+			ProviderId: v.VolumeId,
+			Size:       allParams[i].Size,
+		}
+	}
+	if len(growParams) == 0 {
+		return nil
+	}
+	paramsBySource, volumeSources, err := volumeParamsBySource(
+		deps.config.StorageDir, growParams, deps.config.Registry,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var resized []storage.Volume
+	for sourceName, volumeParams := range paramsBySource {
+		resizer, ok := volumeSources[sourceName].(storage.StorageResizer)
+		if !ok {
+			deps.config.Logger.Warningf(ctx,
+				"storage source %q does not support resizing volumes", sourceName,
+			)
+			continue
+		}
+		args := make([]storage.ResizeParams, len(volumeParams))
+		for i, p := range volumeParams {
+			args[i] = resizeParams[p.Tag]
+		}
+		deps.config.Logger.Debugf(ctx, "resizing volumes: %v", args)
+		results, err := resizer.ResizeStorage(ctx, args)
+		if err != nil {
+			return errors.Annotatef(err, "resizing volumes from source %q", sourceName)
+		}
+		for i, result := range results {
+			tag := volumeParams[i].Tag
+			if result.Error != nil {
+				deps.config.Logger.Errorf(ctx,
+					"failed to resize %s: %v", names.ReadableString(tag), result.Error,
+				)
+				continue
+			}
+			volume := deps.volumes[tag]
+			volume.Size = result.Size
+			resized = append(resized, volume)
+		}
+	}
+	if len(resized) == 0 {
+		return nil
+	}
+	errorResults, err := deps.config.Volumes.SetVolumeInfo(ctx, volumesFromStorage(resized))
+	if err != nil {
+		return errors.Annotate(err, "publishing resized volumes to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			deps.config.Logger.Errorf(ctx,
+				"publishing resized volume %s to state: %v",
+				resized[i].Tag.Id(),
+				result.Error,
+			)
+			continue
+		}
+		updateVolume(ctx, deps, resized[i])
+	}
+	return nil
+}
@@ -58,6 +58,13 @@ If a key has the ` + "`#file`" + ` suffix, the value is read from the correspond
 By default, a secret is owned by the application, meaning only the unit
 leader can manage it. Use ` + "`--owner unit`" + ` to create a secret owned by the
 specific unit which created it.
+
+The rotation policy is one of ` + "`hourly`, `daily`, `weekly`, `monthly`, `quarterly`" + `
+or ` + "`yearly`" + `, or a custom schedule. A custom schedule is an interval such as
+` + "`36h`" + ` or ` + "`45d`" + `, a cron expression evaluated in UTC such as ` + "`0 3 * * SUN`" + `,
+or both, such as ` + "`45d@0 3 * * SUN`" + ` to rotate in the first window after the
+interval has passed. Append a jitter such as ` + "`~1h`" + ` to add a random delay of
+up to that long, so secrets sharing a schedule do not all rotate at once.
 `
 	examples := `
     secret-add token=34ae35facd4
@@ -65,6 +72,8 @@ specific unit which created it.
     secret-add key#file=/path/to/file another-key=s3cret
     secret-add --owner unit token=s3cret 
     secret-add --rotate monthly token=s3cret 
+    secret-add --rotate 36h token=s3cret
+    secret-add --rotate "45d@0 3 * * SUN~1h" token=s3cret
     secret-add --expire 24h token=s3cret 
     secret-add --expire 2025-01-01T06:06:06 token=s3cret 
     secret-add --label db-password \
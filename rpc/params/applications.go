@@ -727,3 +727,37 @@ type ApplicationStorageUpdate struct {
 	// Holds the application storage constraints where the key is the storage name.
 	StorageConstraints map[string]StorageDirectives `json:"storage-constraints"`
 }
+
+// EgressRule describes a port range that an application is allowed to
+// connect to on a set of destination CIDRs.
+type EgressRule struct {
+	PortRange        PortRange `json:"port-range"`
+	DestinationCIDRs []string  `json:"destination-cidrs"`
+}
+
+// ApplicationEgressRules holds the complete set of egress rules for a
+// single application.
+type ApplicationEgressRules struct {
+	ApplicationTag string       `json:"application-tag"`
+	Rules          []EgressRule `json:"rules"`
+}
+
+// SetApplicationEgressRulesArgs defines the parameters for replacing the
+// egress rules of one or more applications in bulk.
+type SetApplicationEgressRulesArgs struct {
+	Args []ApplicationEgressRules `json:"args"`
+}
+
+// ApplicationEgressRulesResult holds the egress rules and any error
+// information for a single application.
+type ApplicationEgressRulesResult struct {
+	Rules []EgressRule `json:"rules"`
+	Error *Error       `json:"error,omitempty"`
+}
+
+// ApplicationEgressRulesResults aggregates the per-application results for
+// a bulk egress rules request. The number and order of results match the
+// number and order of input entities.
+type ApplicationEgressRulesResults struct {
+	Results []ApplicationEgressRulesResult `json:"results"`
+}
@@ -21,6 +21,23 @@ type BackupsDownloadArgs struct {
 	ID string `json:"id"`
 }
 
+// BackupsListResult holds the list of scheduled backups as returned by the
+// API List method.
+type BackupsListResult struct {
+	List []BackupsIndexEntry `json:"list"`
+}
+
+// BackupsIndexEntry holds the status of a single scheduled backup.
+type BackupsIndexEntry struct {
+	Filename string    `json:"filename"`
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+	Size     int64     `json:"size,omitempty"`
+	Checksum string    `json:"checksum,omitempty"`
+	Status   string    `json:"status"`
+	Message  string    `json:"message,omitempty"`
+}
+
 // BackupsMetadataResult holds the metadata for a backup as returned by
 // an API backups method (such as Create).
 type BackupsMetadataResult struct {
@@ -850,6 +850,20 @@ type IngressRule struct {
 	SourceCIDRs []string  `json:"source-cidrs"`
 }
 
+// HostFirewallRulesResult holds the inbound traffic a machine agent should
+// accept on its machine, along with the backend used to enforce it.
+type HostFirewallRulesResult struct {
+	Backend      string        `json:"backend"`
+	IngressRules []IngressRule `json:"ingress-rules"`
+	ModelCIDRs   []string      `json:"model-cidrs"`
+	Error        *Error        `json:"error,omitempty"`
+}
+
+// HostFirewallRulesResults holds the results of a HostFirewallRules call.
+type HostFirewallRulesResults struct {
+	Results []HostFirewallRulesResult `json:"results"`
+}
+
 // APIHostPortsResult holds the result of an APIHostPorts
 // call. Each element in the top level slice holds
 // the addresses for one API server.
@@ -976,6 +990,19 @@ type RenameSpacesParams struct {
 	Changes []RenameSpaceParams `json:"changes"`
 }
 
+// SpaceAddressPreference holds the type of IP address preferred on dual-stack
+// machines in a space.
+type SpaceAddressPreference struct {
+	SpaceTag    string `json:"space-tag"`
+	AddressType string `json:"address-type"`
+}
+
+// SetSpaceAddressPreferencesParams holds the arguments of the
+// SetSpaceAddressPreferences API call.
+type SetSpaceAddressPreferencesParams struct {
+	Preferences []SpaceAddressPreference `json:"preferences"`
+}
+
 // CreateSpacesParams holds the arguments of the AddSpaces API call.
 type CreateSpacesParams struct {
 	Spaces []CreateSpaceParams `json:"spaces"`
@@ -1069,6 +1096,10 @@ type Space struct {
 	Name    string   `json:"name"`
 	Subnets []Subnet `json:"subnets"`
 	Error   *Error   `json:"error,omitempty"`
+
+	// PreferredAddressType is the type of IP address, ipv4 or ipv6,
+	// preferred on dual-stack machines in the space.
+	PreferredAddressType string `json:"preferred-address-type,omitempty"`
 }
 
 // ProviderSpace holds the information about a single space and its associated subnets.
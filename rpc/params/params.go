@@ -1136,6 +1136,34 @@ type ExportBundleParams struct {
 	IncludeCharmDefaults bool `json:"include-charm-defaults,omitempty"`
 }
 
+// SetDesiredBundleParams holds parameters for making Bundle.SetDesiredBundle
+// calls.
+type SetDesiredBundleParams struct {
+	// BundleDataYAML is the YAML-encoded bundle the model is expected
+	// to match.
+	BundleDataYAML string `json:"yaml"`
+}
+
+// DesiredBundleResult holds the result of a Bundle.GetDesiredBundle call.
+type DesiredBundleResult struct {
+	// BundleDataYAML is the YAML-encoded desired bundle of the model.
+	BundleDataYAML string `json:"yaml"`
+
+	// SetAt is when the desired bundle was set.
+	SetAt time.Time `json:"set-at"`
+
+	// Drift is the YAML-encoded difference between the model and the
+	// desired bundle, in the form shown by diff-bundle. It is empty when
+	// the model matches the bundle.
+	Drift string `json:"drift,omitempty"`
+
+	// CheckedAt is when the model was last compared with the desired
+	// bundle, or nil if it has not yet been compared.
+	CheckedAt *time.Time `json:"checked-at,omitempty"`
+
+	Error *Error `json:"error,omitempty"`
+}
+
 // BundleChangesParams holds parameters for making Bundle.GetChanges calls.
 type BundleChangesParams struct {
 	// BundleDataYAML is the YAML-encoded charm bundle data
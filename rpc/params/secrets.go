@@ -99,6 +99,10 @@ type CreateSecretArg struct {
 	URI *string `json:"uri,omitempty"`
 	// OwnerTag is the owner of the secret.
 	OwnerTag string `json:"owner-tag"`
+	// Source is the secret backend path from which the content of a
+	// user secret is synced, in the form <backend>:<path>[#<key>].
+	// If set, no content may be specified.
+	Source *string `json:"source,omitempty"`
 }
 
 // UpdateSecretArgs holds args for updating secrets.
@@ -255,6 +259,88 @@ type ListSecretsArgs struct {
 	Filter      SecretsFilter `json:"filter"`
 }
 
+// SecretAccessLogArgs holds the args for querying the secret access log.
+type SecretAccessLogArgs struct {
+	URI         *string    `json:"uri,omitempty"`
+	AccessorTag *string    `json:"accessor-tag,omitempty"`
+	Since       *time.Time `json:"since,omitempty"`
+	Until       *time.Time `json:"until,omitempty"`
+	Limit       int        `json:"limit,omitempty"`
+}
+
+// SecretAccessLogResults holds entries of the secret access log.
+type SecretAccessLogResults struct {
+	Results []SecretAccessLogEntry `json:"results"`
+}
+
+// SecretAccessLogEntry records a read of a secret revision.
+type SecretAccessLogEntry struct {
+	URI         string    `json:"uri"`
+	Revision    int       `json:"revision"`
+	AccessorTag string    `json:"accessor-tag"`
+	AccessedAt  time.Time `json:"accessed-at"`
+}
+
+// RollbackSecretArg holds the args for rolling back a user secret to the
+// content of an earlier revision.
+type RollbackSecretArg struct {
+	URI      string `json:"uri"`
+	Label    string `json:"label,omitempty"`
+	Revision int    `json:"revision"`
+}
+
+// SecretRevisionDiffArg holds the args for comparing the content of two
+// revisions of a secret.
+type SecretRevisionDiffArg struct {
+	URI          string `json:"uri"`
+	Label        string `json:"label,omitempty"`
+	FromRevision int    `json:"from-revision"`
+	ToRevision   int    `json:"to-revision"`
+	Reveal       bool   `json:"reveal,omitempty"`
+}
+
+// SecretKeyChange describes a key of the content of a secret which was
+// added, removed or changed between two revisions. The values are encoded
+// and only set if they were requested.
+type SecretKeyChange struct {
+	Key    string `json:"key"`
+	Change string `json:"change"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+}
+
+// SecretRevisionDiffResult holds the changes to the content of a secret
+// between two revisions.
+type SecretRevisionDiffResult struct {
+	URI     string            `json:"uri"`
+	Changes []SecretKeyChange `json:"changes"`
+	Error   *Error            `json:"error,omitempty"`
+}
+
+// MigrateSecretsArgs holds the args for moving secret revisions between
+// secret backends.
+type MigrateSecretsArgs struct {
+	FromBackend string  `json:"from-backend"`
+	ToBackend   string  `json:"to-backend"`
+	URI         *string `json:"uri,omitempty"`
+	DryRun      bool    `json:"dry-run,omitempty"`
+}
+
+// SecretMigrationResults holds the outcome of migrating secret revisions.
+type SecretMigrationResults struct {
+	Results []SecretRevisionMigrationResult `json:"results"`
+}
+
+// SecretRevisionMigrationResult holds the outcome of migrating a secret
+// revision.
+type SecretRevisionMigrationResult struct {
+	URI      string `json:"uri"`
+	Revision int    `json:"revision"`
+	Status   string `json:"status"`
+	Checksum string `json:"checksum,omitempty"`
+	Error    *Error `json:"error,omitempty"`
+}
+
 // ListSecretResults holds secret metadata results.
 type ListSecretResults struct {
 	Results []ListSecretResult `json:"results"`
@@ -497,6 +583,13 @@ type RemoveSecretBackendArg struct {
 	Force bool   `json:"force,omitempty"`
 }
 
+// RotateSecretEncryptionKeyResult holds the result of rewrapping the secret
+// data keys of each model with the active master key.
+type RotateSecretEncryptionKeyResult struct {
+	// Rewrapped is the number of model data keys rewrapped.
+	Rewrapped int `json:"rewrapped"`
+}
+
 // RotateSecretBackendArgs holds the args for updating rotated secret backend info.
 type RotateSecretBackendArgs struct {
 	BackendIDs []string `json:"backend-ids"`
@@ -283,6 +283,53 @@ type StatusHistoryResults struct {
 	Results []StatusHistoryResult `json:"results"`
 }
 
+// StatusConditionsArgs holds the conditions that an entity in a model must
+// meet, each of the form <field><operator><value>.
+type StatusConditionsArgs struct {
+	// Kind is the kind of entity: model, application, unit or machine.
+	Kind       string   `json:"kind"`
+	Name       string   `json:"name"`
+	Conditions []string `json:"conditions"`
+}
+
+// StatusConditionsResult holds a description of each condition that the
+// entity does not meet. The conditions are all met when there are none.
+type StatusConditionsResult struct {
+	Unmet []string `json:"unmet,omitempty"`
+	Error *Error   `json:"error,omitempty"`
+}
+
+// ExportStatusHistoryArgs holds the time range of the status history to
+// export for every entity in a model, and the page of it to return.
+type ExportStatusHistoryArgs struct {
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+
+	// Cursor is the NextCursor of the previous page, or zero for the
+	// first page.
+	Cursor int `json:"cursor,omitempty"`
+	// Limit is the maximum number of entries to return. The controller
+	// caps it, and uses the cap if it is zero.
+	Limit int `json:"limit,omitempty"`
+}
+
+// StatusHistoryEntry holds a single status history record for an entity.
+type StatusHistoryEntry struct {
+	Kind   string         `json:"kind"`
+	ID     string         `json:"id"`
+	Status DetailedStatus `json:"status"`
+}
+
+// ExportStatusHistoryResult holds a page of the exported status history of
+// a model.
+type ExportStatusHistoryResult struct {
+	Entries []StatusHistoryEntry `json:"entries"`
+	// NextCursor is the cursor with which to request the next page, or
+	// zero if this is the last page.
+	NextCursor int    `json:"next-cursor,omitempty"`
+	Error      *Error `json:"error,omitempty"`
+}
+
 // StatusResult holds an entity status, extra information, or an
 // error.
 type StatusResult struct {
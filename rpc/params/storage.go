@@ -53,6 +53,27 @@ type SetMachineBlockDevices struct {
 	MachineBlockDevices []MachineBlockDevices `json:"machine-block-devices"`
 }
 
+// FilesystemUsage holds the size and usage of a filesystem mounted
+// on a machine.
+type FilesystemUsage struct {
+	MountPoint string `json:"mount-point"`
+	SizeMiB    uint64 `json:"size-mib"`
+	UsedMiB    uint64 `json:"used-mib"`
+}
+
+// MachineFilesystemUsage holds a machine tag and the usage of the
+// filesystems mounted on that machine.
+type MachineFilesystemUsage struct {
+	Machine string            `json:"machine"`
+	Usage   []FilesystemUsage `json:"usage,omitempty"`
+}
+
+// SetMachineFilesystemUsage holds the arguments for recording the
+// filesystem usage on a set of machines.
+type SetMachineFilesystemUsage struct {
+	MachineFilesystemUsage []MachineFilesystemUsage `json:"machine-filesystem-usage"`
+}
+
 // BlockDeviceResult holds the result of an API call to retrieve details
 // of a block device.
 type BlockDeviceResult struct {
@@ -289,6 +310,10 @@ type VolumeParams struct {
 	Attributes map[string]any          `json:"attributes,omitempty"`
 	Tags       map[string]string       `json:"tags,omitempty"`
 	Attachment *VolumeAttachmentParams `json:"attachment,omitempty"`
+
+	// SnapshotId, when not empty, is the provider ID of the snapshot the
+	// volume is created from.
+	SnapshotId string `json:"snapshot-id,omitempty"`
 }
 
 // RemoveVolumeParams holds the parameters for destroying or releasing a
@@ -467,6 +492,10 @@ type FilesystemParamsV5 struct {
 	Attributes    map[string]any                `json:"attributes,omitempty"`
 	Tags          map[string]string             `json:"tags,omitempty"`
 	Attachment    *FilesystemAttachmentParamsV5 `json:"attachment,omitempty"`
+
+	// SnapshotId, when not empty, is the provider ID of the snapshot the
+	// filesystem is created from.
+	SnapshotId string `json:"snapshot-id,omitempty"`
 }
 
 // RemoveFilesystemParams holds the parameters for destroying or releasing
@@ -703,6 +732,18 @@ type StorageAttachmentDetails struct {
 	// Juju controllers older than 2.2 do not populate this
 	// field, so it may be omitted.
 	Life life.Value `json:"life,omitempty"`
+
+	// Usage holds the most recently reported usage of the attached
+	// filesystem, if the machine agent has reported one.
+	Usage *StorageUsage `json:"usage,omitempty"`
+}
+
+// StorageUsage holds the size and usage of attached storage, as last
+// reported by the machine agent.
+type StorageUsage struct {
+	SizeMiB uint64    `json:"size-mib"`
+	UsedMiB uint64    `json:"used-mib"`
+	Updated time.Time `json:"updated"`
 }
 
 // StoragePool holds data for a pool instance.
@@ -968,6 +1009,10 @@ type StorageAddParams struct {
 
 	// Directives are specified storage directives.
 	Directives StorageDirectives `json:"storage"`
+
+	// SnapshotId is the provider id of a snapshot to create the new
+	// storage from, if any.
+	SnapshotId string `json:"snapshot-id,omitempty"`
 }
 
 // StoragesAddParams holds storage details to add to units dynamically.
@@ -975,6 +1020,38 @@ type StoragesAddParams struct {
 	Storages []StorageAddParams `json:"storages"`
 }
 
+// ResizeStorageArg holds the parameters for resizing a storage instance.
+type ResizeStorageArg struct {
+	// StorageTag is the tag of the storage instance to resize.
+	StorageTag string `json:"storage-tag"`
+
+	// SizeMiB is the requested minimum size of the storage instance,
+	// in MiB.
+	SizeMiB uint64 `json:"size-mib"`
+}
+
+// ResizeStorageArgs holds the parameters for resizing storage instances.
+type ResizeStorageArgs struct {
+	Storage []ResizeStorageArg `json:"storage"`
+}
+
+// CreateStorageSnapshotArg holds the parameters for snapshotting a storage
+// instance.
+type CreateStorageSnapshotArg struct {
+	// StorageTag is the tag of the storage instance to snapshot.
+	StorageTag string `json:"storage-tag"`
+
+	// Name is the name to give the snapshot. A name is generated when
+	// it is empty.
+	Name string `json:"name,omitempty"`
+}
+
+// CreateStorageSnapshotArgs holds the parameters for snapshotting storage
+// instances.
+type CreateStorageSnapshotArgs struct {
+	Storage []CreateStorageSnapshotArg `json:"storage"`
+}
+
 // RemoveStorage holds the parameters for removing storage from the model.
 type RemoveStorage struct {
 	Storage []RemoveStorageInstance `json:"storage"`